@@ -0,0 +1,10 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package alert warns administrators when feed refreshes or backend services are failing.
+
+*/
+package alert // import "miniflux.app/alert"
@@ -0,0 +1,126 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package alert // import "miniflux.app/alert"
+
+import (
+	"fmt"
+	"sync"
+
+	"miniflux.app/logger"
+	"miniflux.app/webhook"
+)
+
+// Monitored components.
+const (
+	ComponentDatabase  = "database"
+	ComponentPublisher = "publisher"
+)
+
+// Monitor counts the failures of feed refreshes and backend components,
+// and alerts the administrators when they exceed the configured thresholds.
+// Alerts are logged and sent to webhooks. A nil Monitor does nothing.
+type Monitor struct {
+	feedErrorPercentage int
+	errorThreshold      int
+	send                func(data *webhook.AlertData)
+
+	mu        sync.Mutex
+	refreshed int
+	failed    int
+	errors    map[string]int
+}
+
+// NewMonitor returns a Monitor, or nil when both thresholds are disabled.
+func NewMonitor(feedErrorPercentage, errorThreshold int, webhooks *webhook.Dispatcher) *Monitor {
+	if feedErrorPercentage <= 0 && errorThreshold <= 0 {
+		return nil
+	}
+
+	return &Monitor{
+		feedErrorPercentage: feedErrorPercentage,
+		errorThreshold:      errorThreshold,
+		errors:              make(map[string]int),
+		send: func(data *webhook.AlertData) {
+			webhooks.Send(webhook.EventAlert, data)
+		},
+	}
+}
+
+// FeedRefreshed records the result of a feed refresh.
+func (m *Monitor) FeedRefreshed(err error) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.refreshed++
+	if err != nil {
+		m.failed++
+	}
+}
+
+// EndRefreshCycle checks the proportion of failed refreshes since the previous cycle.
+func (m *Monitor) EndRefreshCycle() {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	refreshed, failed := m.refreshed, m.failed
+	m.refreshed, m.failed = 0, 0
+	m.mu.Unlock()
+
+	if m.feedErrorPercentage <= 0 || refreshed == 0 || failed*100 <= refreshed*m.feedErrorPercentage {
+		return
+	}
+
+	m.alert(&webhook.AlertData{
+		Type:           webhook.AlertFeedErrors,
+		Message:        fmt.Sprintf("%d of %d feeds failed to refresh during the last cycle", failed, refreshed),
+		FeedsRefreshed: refreshed,
+		FeedsFailed:    failed,
+	})
+}
+
+// Failure records an error of a component, the alert is sent once when the number of consecutive errors reaches the threshold.
+func (m *Monitor) Failure(component string, err error) {
+	if m == nil || m.errorThreshold <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	m.errors[component]++
+	count := m.errors[component]
+	m.mu.Unlock()
+
+	if count != m.errorThreshold {
+		return
+	}
+
+	m.alert(&webhook.AlertData{
+		Type:              webhook.AlertComponentErrors,
+		Component:         component,
+		Message:           fmt.Sprintf("The %s failed %d times in a row: %v", component, count, err),
+		ConsecutiveErrors: count,
+	})
+}
+
+// Success resets the number of consecutive errors of a component.
+func (m *Monitor) Success(component string) {
+	if m == nil || m.errorThreshold <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	delete(m.errors, component)
+	m.mu.Unlock()
+}
+
+func (m *Monitor) alert(data *webhook.AlertData) {
+	logger.Error("[Alert] %s", data.Message)
+	m.send(data)
+}
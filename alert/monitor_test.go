@@ -0,0 +1,89 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package alert // import "miniflux.app/alert"
+
+import (
+	"errors"
+	"testing"
+
+	"miniflux.app/webhook"
+)
+
+func newTestMonitor(feedErrorPercentage, errorThreshold int, alerts *[]*webhook.AlertData) *Monitor {
+	m := NewMonitor(feedErrorPercentage, errorThreshold, nil)
+	m.send = func(data *webhook.AlertData) {
+		*alerts = append(*alerts, data)
+	}
+	return m
+}
+
+func TestNewMonitorWhenDisabled(t *testing.T) {
+	if m := NewMonitor(0, 0, nil); m != nil {
+		t.Error(`The monitor should be disabled without thresholds`)
+	}
+
+	var m *Monitor
+	m.FeedRefreshed(errors.New("error"))
+	m.EndRefreshCycle()
+	m.Failure(ComponentDatabase, errors.New("error"))
+	m.Success(ComponentDatabase)
+}
+
+func TestFeedErrorPercentage(t *testing.T) {
+	var alerts []*webhook.AlertData
+	m := newTestMonitor(50, 0, &alerts)
+
+	m.FeedRefreshed(nil)
+	m.FeedRefreshed(errors.New("error"))
+	m.EndRefreshCycle()
+
+	if len(alerts) != 0 {
+		t.Fatalf(`Exactly 50%% of failed feeds should not raise an alert`)
+	}
+
+	m.FeedRefreshed(nil)
+	m.FeedRefreshed(errors.New("error"))
+	m.FeedRefreshed(errors.New("error"))
+	m.EndRefreshCycle()
+
+	if len(alerts) != 1 {
+		t.Fatalf(`Unexpected number of alerts: %d`, len(alerts))
+	}
+
+	if alerts[0].Type != webhook.AlertFeedErrors || alerts[0].FeedsRefreshed != 3 || alerts[0].FeedsFailed != 2 {
+		t.Errorf(`Unexpected alert: %+v`, alerts[0])
+	}
+
+	m.EndRefreshCycle()
+	if len(alerts) != 1 {
+		t.Error(`The counters should be reset at the end of each cycle`)
+	}
+}
+
+func TestComponentErrorThreshold(t *testing.T) {
+	var alerts []*webhook.AlertData
+	m := newTestMonitor(0, 3, &alerts)
+
+	for i := 0; i < 5; i++ {
+		m.Failure(ComponentPublisher, errors.New("timeout"))
+	}
+
+	if len(alerts) != 1 {
+		t.Fatalf(`The alert should be sent once, got %d alerts`, len(alerts))
+	}
+
+	if alerts[0].Component != ComponentPublisher || alerts[0].ConsecutiveErrors != 3 {
+		t.Errorf(`Unexpected alert: %+v`, alerts[0])
+	}
+
+	m.Success(ComponentPublisher)
+	m.Failure(ComponentPublisher, errors.New("timeout"))
+	m.Failure(ComponentDatabase, errors.New("connection refused"))
+	m.Failure(ComponentPublisher, errors.New("timeout"))
+
+	if len(alerts) != 1 {
+		t.Errorf(`A success should reset the consecutive errors of the component only`)
+	}
+}
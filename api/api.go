@@ -5,44 +5,259 @@
 package api // import "miniflux.app/api"
 
 import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/ebook"
+	"miniflux.app/embedding"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
 	"miniflux.app/reader/feed"
+	"miniflux.app/reader/subscription"
 	"miniflux.app/storage"
+	"miniflux.app/summarizer"
+	"miniflux.app/worker"
 
 	"github.com/gorilla/mux"
 )
 
+var entryFilterParams = []*parameter{
+	queryStringList("status", "Filter by entry status", model.EntryStatusUnread, model.EntryStatusRead, model.EntryStatusRemoved),
+	queryString("order", "Sorting order", "id", "status", "published_at", "read_at", "category_title", "category_id", "feed_title", "score", "random"),
+	queryString("direction", "Sorting direction", "asc", "desc"),
+	queryInteger("limit", "Maximum number of entries"),
+	queryInteger("offset", "Number of entries to skip"),
+	queryInteger("seed", "Seed used by the random sorting order"),
+	queryInteger("before", "Entries published before this Unix timestamp"),
+	queryInteger("after", "Entries published after this Unix timestamp"),
+	queryInteger("published_before", "Entries published before this Unix timestamp"),
+	queryInteger("published_after", "Entries published after this Unix timestamp"),
+	queryInteger("changed_after", "Entries changed after this Unix timestamp"),
+	queryInteger("before_entry_id", "Entries located before this entry ID"),
+	queryInteger("after_entry_id", "Entries located after this entry ID"),
+	queryString("starred", "Filter by starred flag, use false or 0 to exclude starred entries"),
+	queryNumber("min_score", "Entries with a score greater than or equal to this value"),
+	queryNumber("max_score", "Entries with a score less than or equal to this value"),
+	queryInteger("min_junk_score", "Entries with a junk score, between 0 and 100, greater than or equal to this value"),
+	queryString("search", "Full-text search query"),
+	queryString("group_by", "Group the entries by publication day in the user timezone or by story, the response contains a list of days or of stories instead of a list of entries", model.EntryGroupingDay, model.EntryGroupingStory),
+}
+
+// sharedEntryFilterParams are the filters of the entries of shared categories, they cannot be grouped.
+var sharedEntryFilterParams = withoutParameter(entryFilterParams, "group_by")
+
+var routes = []*route{
+	{method: "POST", path: "/users", handler: (*handler).createUser, operationID: "createUser", summary: "Create a user", tag: "users",
+		body: &model.User{}, bodyRequired: []string{"username", "password"}, status: http.StatusCreated, response: &model.User{}, admin: true},
+	{method: "GET", path: "/users", handler: (*handler).users, operationID: "getUsers", summary: "Get all users", tag: "users",
+		response: model.Users{}},
+	{method: "GET", path: "/users/{userID:[0-9]+}", handler: (*handler).userByID, operationID: "getUser", summary: "Get a user by ID", tag: "users",
+		response: &model.User{}},
+	{method: "PUT", path: "/users/{userID:[0-9]+}", handler: (*handler).updateUser, operationID: "updateUser", summary: "Update a user", tag: "users",
+		body: &userModification{}, status: http.StatusCreated, response: &model.User{}, admin: true},
+	{method: "DELETE", path: "/users/{userID:[0-9]+}", handler: (*handler).removeUser, operationID: "removeUser", summary: "Remove a user", tag: "users",
+		status: http.StatusNoContent, admin: true},
+	{method: "PUT", path: "/users/{userID:[0-9]+}/freeze", handler: (*handler).freezeUser, operationID: "freezeUser", summary: "Reject the changes of a user during a migration or an export, the API answers 503 with a Retry-After header", tag: "users",
+		body: &userFreeze{}, bodyRequired: []string{"duration"}, response: &model.User{}, admin: true},
+	{method: "DELETE", path: "/users/{userID:[0-9]+}/freeze", handler: (*handler).unfreezeUser, operationID: "unfreezeUser", summary: "End the write freeze of a user", tag: "users",
+		status: http.StatusNoContent, admin: true},
+	{method: "PUT", path: "/users/{userID:[0-9]+}/approve", handler: (*handler).approveUser, operationID: "approveUser", summary: "Enable an account created from the signup page (admin only)", tag: "users",
+		status: http.StatusNoContent, admin: true},
+	{method: "GET", path: "/invitations", handler: (*handler).getInvitations, operationID: "getInvitations", summary: "Get the unexpired signup invitations (admin only)", tag: "users",
+		response: model.Tokens{}},
+	{method: "POST", path: "/invitations", handler: (*handler).createInvitation, operationID: "createInvitation", summary: "Create a signup invitation, the code is returned once (admin only)", tag: "users",
+		body: &invitationCreation{}, status: http.StatusCreated, response: &invitationCreationResult{}, admin: true},
+	{method: "DELETE", path: "/invitations/{invitationID}", handler: (*handler).removeInvitation, operationID: "removeInvitation", summary: "Revoke a signup invitation (admin only)", tag: "users",
+		status: http.StatusNoContent, admin: true},
+	{method: "GET", path: "/users/{username}", handler: (*handler).userByUsername, operationID: "getUserByUsername", summary: "Get a user by username", tag: "users",
+		response: &model.User{}},
+	{method: "GET", path: "/me", handler: (*handler).currentUser, operationID: "getCurrentUser", summary: "Get the authenticated user", tag: "users",
+		response: &model.User{}},
+	{method: "DELETE", path: "/me", handler: (*handler).deleteCurrentUser, operationID: "deleteCurrentUser", summary: "Close the account of the authenticated user, its data is purged by the next cleanup job", tag: "users",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/me/keyboard-shortcuts", handler: (*handler).keyboardShortcuts, operationID: "getKeyboardShortcuts", summary: "Get the keyboard shortcuts of the authenticated user", tag: "users",
+		response: model.KeyboardShortcuts{}},
+	{method: "PUT", path: "/me/keyboard-shortcuts", handler: (*handler).updateKeyboardShortcuts, operationID: "updateKeyboardShortcuts", summary: "Change the keys of user interface actions, an empty list disables an action", tag: "users",
+		body: model.KeyboardShortcuts{}, response: model.KeyboardShortcuts{}},
+	{method: "GET", path: "/service-accounts", handler: (*handler).getServiceAccounts, operationID: "getServiceAccounts", summary: "Get the service accounts acting on the data of the authenticated user", tag: "service-accounts",
+		response: model.ServiceAccounts{}},
+	{method: "POST", path: "/service-accounts", handler: (*handler).createServiceAccount, operationID: "createServiceAccount", summary: "Create a service account limited to the given scopes and optionally to some categories", tag: "service-accounts",
+		body: &serviceAccountModification{}, bodyRequired: []string{"name", "scopes"}, status: http.StatusCreated, response: &model.ServiceAccount{}},
+	{method: "GET", path: "/service-accounts/{serviceAccountID}", handler: (*handler).getServiceAccount, operationID: "getServiceAccount", summary: "Get a service account", tag: "service-accounts",
+		response: &model.ServiceAccount{}},
+	{method: "PUT", path: "/service-accounts/{serviceAccountID}", handler: (*handler).updateServiceAccount, operationID: "updateServiceAccount", summary: "Change the name, the scopes or the categories of a service account", tag: "service-accounts",
+		body: &serviceAccountModification{}, response: &model.ServiceAccount{}},
+	{method: "DELETE", path: "/service-accounts/{serviceAccountID}", handler: (*handler).removeServiceAccount, operationID: "removeServiceAccount", summary: "Remove a service account and revoke its API keys", tag: "service-accounts",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/service-accounts/{serviceAccountID}/keys", handler: (*handler).getAPIKeys, operationID: "getAPIKeys", summary: "Get the API keys of a service account", tag: "service-accounts",
+		response: model.APIKeys{}},
+	{method: "POST", path: "/service-accounts/{serviceAccountID}/keys", handler: (*handler).createAPIKey, operationID: "createAPIKey", summary: "Create an API key, the key is returned once and is sent in the X-Auth-Token header", tag: "service-accounts",
+		body: &apiKeyCreation{}, status: http.StatusCreated, response: &apiKeyCreationResult{}},
+	{method: "DELETE", path: "/service-accounts/{serviceAccountID}/keys/{keyID}", handler: (*handler).removeAPIKey, operationID: "removeAPIKey", summary: "Revoke an API key", tag: "service-accounts",
+		status: http.StatusNoContent},
+	{method: "POST", path: "/categories", handler: (*handler).createCategory, operationID: "createCategory", summary: "Create a category", tag: "categories",
+		body: &model.Category{}, bodyRequired: []string{"title"}, status: http.StatusCreated, response: &model.Category{}},
+	{method: "GET", path: "/categories", handler: (*handler).getCategories, operationID: "getCategories", summary: "Get all categories", tag: "categories",
+		response: model.Categories{}, categoryFiltered: true},
+	{method: "PUT", path: "/categories/{categoryID}", handler: (*handler).updateCategory, operationID: "updateCategory", summary: "Update a category", tag: "categories",
+		body: &categoryModification{}, status: http.StatusCreated, response: &model.Category{}, versioned: true},
+	{method: "DELETE", path: "/categories/{categoryID}", handler: (*handler).removeCategory, operationID: "removeCategory", summary: "Move a category and its feeds to the trash", tag: "categories",
+		status: http.StatusNoContent},
+	{method: "PUT", path: "/categories/{categoryID}/restore", handler: (*handler).restoreCategory, operationID: "restoreCategory", summary: "Restore a category from the trash", tag: "categories",
+		status: http.StatusNoContent},
+	{method: "PUT", path: "/categories/{categoryID}/refresh", handler: (*handler).refreshCategory, operationID: "refreshCategory", summary: "Refresh all feeds of a category", tag: "categories",
+		status: http.StatusAccepted, response: &refreshJobCreation{}},
+	{method: "GET", path: "/categories/{categoryID}/shares", handler: (*handler).getCategoryShares, operationID: "getCategoryShares", summary: "Get the users allowed to read a category", tag: "categories",
+		response: model.CategoryShares{}},
+	{method: "POST", path: "/categories/{categoryID}/shares", handler: (*handler).shareCategory, operationID: "shareCategory", summary: "Give another user a read-only access to a category", tag: "categories",
+		body: &categoryShareCreation{}, bodyRequired: []string{"username"}, status: http.StatusNoContent},
+	{method: "DELETE", path: "/categories/{categoryID}/shares/{userID:[0-9]+}", handler: (*handler).unshareCategory, operationID: "unshareCategory", summary: "Remove the access of a user to a category", tag: "categories",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/shared/categories", handler: (*handler).getSharedCategories, operationID: "getSharedCategories", summary: "Get the categories shared by other users", tag: "categories",
+		response: model.SharedCategories{}},
+	{method: "GET", path: "/shared/categories/{categoryID}/entries", handler: (*handler).getSharedCategoryEntries, operationID: "getSharedCategoryEntries", summary: "Get the entries of a category shared by another user, the statuses are the ones of the owner", tag: "entries",
+		parameters: sharedEntryFilterParams, response: &entriesResponse{}},
+	{method: "POST", path: "/discover", handler: (*handler).getSubscriptions, operationID: "discoverSubscriptions", summary: "Discover subscriptions from a website", tag: "feeds",
+		body: &subscriptionDiscovery{}, bodyRequired: []string{"url"}, response: subscription.Subscriptions{}, safe: true},
+	{method: "POST", path: "/feeds", handler: (*handler).createFeed, operationID: "createFeed", summary: "Subscribe to a feed", tag: "feeds",
+		body: &feedCreation{}, bodyRequired: []string{"feed_url", "category_id"}, status: http.StatusCreated, response: &feedCreationResult{}, idempotent: true, categoryFiltered: true},
+	{method: "GET", path: "/feeds", handler: (*handler).getFeeds, operationID: "getFeeds", summary: "Get all feeds", tag: "feeds",
+		response: model.Feeds{}, categoryFiltered: true},
+	{method: "PUT", path: "/feeds/refresh", handler: (*handler).refreshAllFeeds, operationID: "refreshAllFeeds", summary: "Refresh all feeds", tag: "feeds",
+		status: http.StatusAccepted, response: &refreshJobCreation{}},
+	{method: "PUT", path: "/feeds/category", handler: (*handler).setFeedsCategory, operationID: "updateFeedsCategory", summary: "Move a list of feeds to another category", tag: "feeds",
+		body: &feedCategoryModification{}, bodyRequired: []string{"feed_ids", "category_id"}, status: http.StatusNoContent},
+	{method: "POST", path: "/feeds/preview", handler: (*handler).previewFeed, operationID: "previewFeed", summary: "Preview a feed without subscribing", tag: "feeds",
+		body: &feedPreviewRequest{}, bodyRequired: []string{"feed_url"}, response: &feedPreview{}},
+	{method: "GET", path: "/feeds/least-read", handler: (*handler).getLeastReadFeeds, operationID: "getLeastReadFeeds", summary: "Get the feeds without any entry read for the given number of months", tag: "feeds",
+		parameters: []*parameter{queryInteger("months", "Number of months without reading, 3 by default")}, response: model.LeastReadFeeds{}},
+	{method: "GET", path: "/feeds/pending", handler: (*handler).getPendingFeeds, operationID: "getPendingFeeds", summary: "Get the subscriptions of all users waiting for an approval (admin only)", tag: "feeds",
+		response: model.PendingFeeds{}},
+	{method: "PUT", path: "/feeds/{feedID}/approve", handler: (*handler).approveFeed, operationID: "approveFeed", summary: "Approve a pending subscription, its entries are downloaded in the background (admin only)", tag: "feeds",
+		status: http.StatusNoContent, admin: true},
+	{method: "PUT", path: "/feeds/{feedID}/reject", handler: (*handler).rejectFeed, operationID: "rejectFeed", summary: "Reject a pending subscription, the feed is removed (admin only)", tag: "feeds",
+		status: http.StatusNoContent, admin: true},
+	{method: "PUT", path: "/feeds/{feedID}/refresh", handler: (*handler).refreshFeed, operationID: "refreshFeed", summary: "Refresh a feed", tag: "feeds",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/feeds/{feedID}", handler: (*handler).getFeed, operationID: "getFeed", summary: "Get a feed", tag: "feeds",
+		response: &model.Feed{}},
+	{method: "PUT", path: "/feeds/{feedID}", handler: (*handler).updateFeed, operationID: "updateFeed", summary: "Update a feed", tag: "feeds",
+		body: &feedModification{}, status: http.StatusCreated, response: &model.Feed{}, versioned: true},
+	{method: "DELETE", path: "/feeds/{feedID}", handler: (*handler).removeFeed, operationID: "removeFeed", summary: "Unsubscribe from a feed and move it to the trash", tag: "feeds",
+		status: http.StatusNoContent},
+	{method: "PUT", path: "/feeds/{feedID}/restore", handler: (*handler).restoreFeed, operationID: "restoreFeed", summary: "Restore a feed from the trash", tag: "feeds",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/feeds/{feedID}/icon", handler: (*handler).feedIcon, operationID: "getFeedIcon", summary: "Get the icon of a feed", tag: "feeds",
+		response: &feedIcon{}},
+	{method: "GET", path: "/feeds/{feedID}/stats", handler: (*handler).getFeedStats, operationID: "getFeedStats", summary: "Get the publishing, reading and fetching statistics of a feed", tag: "feeds",
+		response: &model.FeedStats{}},
+	{method: "GET", path: "/feeds/{feedID}/responses", handler: (*handler).getFeedResponses, operationID: "getFeedResponses", summary: "Get the archived documents of a feed (admin only)", tag: "feeds",
+		response: model.FeedResponses{}},
+	{method: "GET", path: "/feeds/{feedID}/responses/{responseID}", handler: (*handler).getFeedResponseContent, operationID: "getFeedResponseContent", summary: "Download an archived document of a feed (admin only)", tag: "feeds",
+		responseType: "application/octet-stream"},
+	{method: "GET", path: "/jobs/{jobID}", handler: (*handler).getRefreshJob, operationID: "getRefreshJob", summary: "Get the progress of a refresh job", tag: "feeds",
+		response: &model.RefreshJob{}},
+	{method: "GET", path: "/trash", handler: (*handler).getTrash, operationID: "getTrash", summary: "Get the removed categories and feeds that can be restored", tag: "feeds",
+		response: &model.Trash{}},
+	{method: "GET", path: "/pubsub/outbox", handler: (*handler).getOutbox, operationID: "getOutbox", summary: "Get the backlog and the Pub/Sub events that could not be published (admin only)", tag: "pubsub",
+		parameters: []*parameter{queryString("status", "Event status, failed by default", model.OutboxStatusFailed, model.OutboxStatusDiscarded), queryInteger("limit", "Maximum number of events")}, response: &outboxResponse{}},
+	{method: "POST", path: "/pubsub/outbox/replay", handler: (*handler).replayOutbox, operationID: "replayOutbox", summary: "Publish again the given failed events, or the oldest ones when the list is empty (admin only)", tag: "pubsub",
+		body: &outboxModification{}, response: &model.OutboxReplay{}},
+	{method: "POST", path: "/pubsub/outbox/discard", handler: (*handler).discardOutbox, operationID: "discardOutbox", summary: "Mark failed events as discarded, they are no longer replayed (admin only)", tag: "pubsub",
+		body: &outboxModification{}, bodyRequired: []string{"event_ids"}, status: http.StatusNoContent},
+	{method: "GET", path: "/stats", handler: (*handler).getInstanceStats, operationID: "getInstanceStats", summary: "Get the number of users, feeds and entries, the database size, the shared feeds, the refresh throughput and the domains with the most errors (admin only)", tag: "stats",
+		response: &model.InstanceStats{}},
+	{method: "GET", path: "/logs", handler: (*handler).tailLogs, operationID: "tailLogs", summary: "Stream the recent and the new log messages as server-sent events, resumed after the Last-Event-ID header (admin only)", tag: "logs",
+		parameters: []*parameter{queryString("level", "Minimum severity of the messages, info by default", "fatal", "error", "info", "debug")}, responseType: "text/event-stream"},
+	{method: "GET", path: "/export", handler: (*handler).exportFeeds, operationID: "exportFeeds", summary: "Export subscriptions as OPML", tag: "opml",
+		responseType: "application/xml"},
+	{method: "POST", path: "/import", handler: (*handler).importFeeds, operationID: "importFeeds", summary: "Import subscriptions from an OPML file", tag: "opml",
+		bodyType: "application/xml", status: http.StatusCreated, response: map[string]string{}},
+	{method: "GET", path: "/feeds/{feedID}/entries", handler: (*handler).getFeedEntries, operationID: "getFeedEntries", summary: "Get the entries of a feed", tag: "entries",
+		parameters: entryFilterParams, response: &entriesResponse{}},
+	{method: "GET", path: "/feeds/{feedID}/entries/{entryID}", handler: (*handler).getFeedEntry, operationID: "getFeedEntry", summary: "Get an entry of a feed", tag: "entries",
+		response: &model.Entry{}},
+	{method: "GET", path: "/entries", handler: (*handler).getEntries, operationID: "getEntries", summary: "Get entries", tag: "entries",
+		parameters: entryFilterParams, response: &entriesResponse{}, categoryFiltered: true},
+	{method: "PUT", path: "/entries", handler: (*handler).setEntryStatus, operationID: "updateEntries", summary: "Change the status of a list of entries", tag: "entries",
+		body: &entryStatusModification{}, bodyRequired: []string{"entry_ids", "status"}, status: http.StatusNoContent, idempotent: true},
+	{method: "PUT", path: "/entries/bookmark", handler: (*handler).setEntriesBookmark, operationID: "updateEntriesBookmark", summary: "Star or unstar a list of entries", tag: "entries",
+		body: &entryBookmarkModification{}, bodyRequired: []string{"entry_ids", "starred"}, status: http.StatusNoContent},
+	{method: "PUT", path: "/entries/score", handler: (*handler).setEntriesScore, operationID: "updateEntriesScore", summary: "Set the score of a list of entries, used by the score sorting order and filters", tag: "entries",
+		body: &entryScoreModification{}, bodyRequired: []string{"scores"}, status: http.StatusNoContent},
+	{method: "PUT", path: "/users/{userID:[0-9]+}/mark-all-as-read", handler: (*handler).markUserAsRead, operationID: "markUserEntriesAsRead", summary: "Mark all unread entries of the authenticated user as read", tag: "entries",
+		status: http.StatusNoContent, idempotent: true},
+	{method: "PUT", path: "/entries/undo-mark-as-read", handler: (*handler).undoMarkAsRead, operationID: "undoMarkAsRead", summary: "Mark as unread the entries of the last mark all as read operation", tag: "entries",
+		response: &undoMarkAsReadResult{}},
+	{method: "POST", path: "/entries/save-url", handler: (*handler).saveURL, operationID: "saveURL", summary: "Save a web page as an entry of the Saved pages feed", tag: "entries",
+		body: &urlSaveRequest{}, bodyRequired: []string{"url"}, status: http.StatusCreated, response: &model.Entry{}},
+	{method: "GET", path: "/entries/history", handler: (*handler).getRecentlyReadEntries, operationID: "getRecentlyReadEntries", summary: "Get the last read entries, most recently read first", tag: "entries",
+		parameters: []*parameter{queryInteger("limit", "Maximum number of entries")}, response: &entriesResponse{}},
+	{method: "GET", path: "/entries/export", handler: (*handler).exportEntries, operationID: "exportEntries", summary: "Export the starred entries, or the given entries, as an EPUB book or a printable HTML document", tag: "entries",
+		parameters: []*parameter{queryString("format", "Document format", ebook.FormatEPUB, ebook.FormatHTML), queryIntegerList("entry_id", "Entries to export, the starred entries are exported by default")}, responseType: "application/epub+zip"},
+	{method: "GET", path: "/entries/semantic-search", handler: (*handler).semanticSearch, operationID: "semanticSearchEntries", summary: "Get the entries closest in meaning to a query, even without common words, the semantic search must be configured", tag: "entries",
+		parameters: []*parameter{queryString("query", "Description of the searched entries"), queryInteger("limit", "Maximum number of entries")}, response: &entriesResponse{}},
+	{method: "GET", path: "/entries/{entryID}", handler: (*handler).getEntry, operationID: "getEntry", summary: "Get an entry", tag: "entries",
+		response: &model.Entry{}},
+	{method: "GET", path: "/entries/{entryID}/enclosures", handler: (*handler).getEntryEnclosures, operationID: "getEntryEnclosures", summary: "Get the enclosures of an entry", tag: "entries",
+		response: model.EnclosureList{}},
+	{method: "GET", path: "/entries/{entryID}/related", handler: (*handler).getRelatedEntries, operationID: "getRelatedEntries", summary: "Get the entries covering the same story as an entry, sharing a link or with a similar title", tag: "entries",
+		parameters: []*parameter{queryInteger("limit", "Maximum number of entries")}, response: &entriesResponse{}},
+	{method: "GET", path: "/entries/{entryID}/snapshot", handler: (*handler).getEntrySnapshot, operationID: "getEntrySnapshot", summary: "Get the saved copy of the web page of an entry", tag: "entries",
+		response: &model.EntrySnapshot{}},
+	{method: "PUT", path: "/entries/{entryID}/snapshot", handler: (*handler).refreshEntrySnapshot, operationID: "refreshEntrySnapshot", summary: "Save a new copy of the web page of an entry", tag: "entries",
+		status: http.StatusCreated, response: &model.EntrySnapshot{}},
+	{method: "PUT", path: "/entries/{entryID}/summary", handler: (*handler).summarizeEntry, operationID: "summarizeEntry", summary: "Generate the summary of an entry, the summarizer must be configured", tag: "entries",
+		response: &entrySummary{}},
+	{method: "PUT", path: "/entries/{entryID}/bookmark", handler: (*handler).toggleBookmark, operationID: "toggleBookmark", summary: "Star or unstar an entry", tag: "entries",
+		status: http.StatusNoContent},
+	{method: "GET", path: "/sync", handler: (*handler).syncEntries, operationID: "syncEntries", summary: "Get the entries changed and deleted since a sync token, for clients keeping a local copy of the entries", tag: "entries",
+		parameters: []*parameter{queryString("token", "Sync token returned by the previous request, all the entries are returned without token"), queryInteger("limit", "Maximum number of entries")}, response: &model.SyncChanges{}},
+	{method: "POST", path: "/batch", handler: (*handler).batch, operationID: "batch", summary: "Execute a list of API requests in order and return the response of each one", tag: "batch",
+		body: []*batchRequest{}, response: []*batchResponse{}, safe: true},
+}
+
 // Serve declares API routes for the application.
-func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler) {
-	handler := &handler{store, feedHandler}
+func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
+	handler := &handler{store: store, pool: pool, feedHandler: feedHandler, summarizer: summarizer.New(cfg), embedder: embedding.New(cfg)}
+	document := newOpenAPIDocument(routes)
+	batchRouter := mux.NewRouter()
+	handler.router = batchRouter
+
+	router.Handle("/v1/openapi.json", document).Methods("GET")
 
 	sr := router.PathPrefix("/v1").Subrouter()
+	origins := cfg.CORSAllowedOrigins()
+	if len(origins) > 0 {
+		sr.Use(newCORS(origins, cfg.CORSAllowedHeaders(), cfg.HasCORSCredentials()).serve)
+	}
 	sr.Use(newMiddleware(store).serve)
-	sr.HandleFunc("/users", handler.createUser).Methods("POST")
-	sr.HandleFunc("/users", handler.users).Methods("GET")
-	sr.HandleFunc("/users/{userID:[0-9]+}", handler.userByID).Methods("GET")
-	sr.HandleFunc("/users/{userID:[0-9]+}", handler.updateUser).Methods("PUT")
-	sr.HandleFunc("/users/{userID:[0-9]+}", handler.removeUser).Methods("DELETE")
-	sr.HandleFunc("/users/{username}", handler.userByUsername).Methods("GET")
-	sr.HandleFunc("/me", handler.currentUser).Methods("GET")
-	sr.HandleFunc("/categories", handler.createCategory).Methods("POST")
-	sr.HandleFunc("/categories", handler.getCategories).Methods("GET")
-	sr.HandleFunc("/categories/{categoryID}", handler.updateCategory).Methods("PUT")
-	sr.HandleFunc("/categories/{categoryID}", handler.removeCategory).Methods("DELETE")
-	sr.HandleFunc("/discover", handler.getSubscriptions).Methods("POST")
-	sr.HandleFunc("/feeds", handler.createFeed).Methods("POST")
-	sr.HandleFunc("/feeds", handler.getFeeds).Methods("GET")
-	sr.HandleFunc("/feeds/{feedID}/refresh", handler.refreshFeed).Methods("PUT")
-	sr.HandleFunc("/feeds/{feedID}", handler.getFeed).Methods("GET")
-	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods("PUT")
-	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods("DELETE")
-	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods("GET")
-	sr.HandleFunc("/export", handler.exportFeeds).Methods("GET")
-	sr.HandleFunc("/import", handler.importFeeds).Methods("POST")
-	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods("GET")
-	sr.HandleFunc("/feeds/{feedID}/entries/{entryID}", handler.getFeedEntry).Methods("GET")
-	sr.HandleFunc("/entries", handler.getEntries).Methods("GET")
-	sr.HandleFunc("/entries", handler.setEntryStatus).Methods("PUT")
-	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods("GET")
-	sr.HandleFunc("/entries/{entryID}/enclosures", handler.getEntryEnclosures).Methods("GET")
-	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods("PUT")
+	idempotency := newIdempotency(store)
+	writeFreeze := newWriteFreeze(store)
+	for _, route := range routes {
+		h := route.handlerFunc(handler)
+		if route.idempotent {
+			h = idempotency.serve(h)
+		}
+		if route.isMutation() && !route.admin {
+			h = writeFreeze.serve(h)
+		}
+		h = newValidator(document, route).serve(h)
+		h = newScopes(store, route).serve(h)
+		if cfg.IsReadOnly() && route.isMutation() {
+			h = readOnly
+		}
+		sr.Handle(route.path, h).Methods(route.method)
+		batchRouter.Handle("/v1"+route.path, h).Methods(route.method)
+	}
+
+	if len(origins) > 0 {
+		// Preflight requests are answered by the CORS middleware before authentication.
+		sr.PathPrefix("/").Methods("OPTIONS").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	}
 }
+
+// readOnly rejects the changes on read-only instances.
+var readOnly = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	json.ForbiddenError(w, r, config.ErrReadOnly)
+})
@@ -0,0 +1,105 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+)
+
+const (
+	maxBatchRequests = 50
+	batchPath        = "/v1/batch"
+)
+
+// batch executes the sub-requests in order, the response contains the result of each one.
+// The sub-requests are authenticated as the batch request.
+func (h *handler) batch(w http.ResponseWriter, r *http.Request) {
+	requests, err := decodeBatchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if len(requests) == 0 {
+		json.BadRequest(w, r, errors.New("The batch must contain at least one request"))
+		return
+	}
+
+	if len(requests) > maxBatchRequests {
+		json.BadRequest(w, r, fmt.Errorf("The batch must not contain more than %d requests", maxBatchRequests))
+		return
+	}
+
+	responses := make([]*batchResponse, 0, len(requests))
+	for _, item := range requests {
+		responses = append(responses, h.serveBatchRequest(r, item))
+	}
+
+	json.OK(w, r, responses)
+}
+
+func (h *handler) serveBatchRequest(r *http.Request, item *batchRequest) *batchResponse {
+	method := strings.ToUpper(item.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	if !strings.HasPrefix(item.Path, "/v1/") || strings.HasPrefix(item.Path, batchPath) {
+		return newBatchError(http.StatusBadRequest, fmt.Errorf("Invalid path %q, batches can only contain API requests", item.Path))
+	}
+
+	body, err := encodeBatchBody(item.Body)
+	if err != nil {
+		return newBatchError(http.StatusBadRequest, err)
+	}
+
+	sub, err := http.NewRequest(method, item.Path, body)
+	if err != nil {
+		return newBatchError(http.StatusBadRequest, err)
+	}
+
+	// The context holds the authenticated user, the responses are not compressed.
+	sub = sub.WithContext(r.Context())
+	sub.Host = r.Host
+	sub.RemoteAddr = r.RemoteAddr
+	for _, name := range []string{"User-Agent", "X-Forwarded-For", "X-Real-Ip", "X-Request-Id"} {
+		if value := r.Header.Get(name); value != "" {
+			sub.Header.Set(name, value)
+		}
+	}
+	sub.Header.Set("Content-Type", "application/json")
+
+	recorder := &batchRecorder{header: make(http.Header), statusCode: http.StatusOK}
+	h.router.ServeHTTP(recorder, sub)
+
+	logger.Debug("[API:Batch] %s %s => %d", method, item.Path, recorder.statusCode)
+	return newBatchResponse(recorder.statusCode, recorder.header.Get("Content-Type"), recorder.body.Bytes())
+}
+
+// batchRecorder keeps the response of a sub-request in memory.
+type batchRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (b *batchRecorder) Header() http.Header {
+	return b.header
+}
+
+func (b *batchRecorder) WriteHeader(statusCode int) {
+	b.statusCode = statusCode
+}
+
+func (b *batchRecorder) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+
+	"github.com/gorilla/mux"
+)
+
+func serveBatch(body string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.HandleFunc("/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		json.OK(w, r, map[string]int64{"id": request.UserID(r)})
+	}).Methods("GET")
+	router.HandleFunc("/v1/echo", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write(data)
+	}).Methods("POST")
+	router.HandleFunc("/v1/export", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("plain text"))
+	}).Methods("GET")
+
+	h := &handler{router: router}
+	r := httptest.NewRequest("POST", "/v1/batch", strings.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), request.UserIDContextKey, int64(42)))
+	w := httptest.NewRecorder()
+	h.batch(w, r)
+	return w
+}
+
+func TestBatch(t *testing.T) {
+	w := serveBatch(`[
+		{"method": "GET", "path": "/v1/me"},
+		{"method": "POST", "path": "/v1/echo", "body": {"entry_ids": [9007199254740993]}},
+		{"path": "/v1/export"},
+		{"method": "GET", "path": "/v1/missing"},
+		{"method": "POST", "path": "/v1/batch", "body": []},
+		{"method": "GET", "path": "/ui/feeds"}
+	]`)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, w.Code, http.StatusOK)
+	}
+
+	expected := `[{"status":200,"body":{"id":42}},` +
+		`{"status":201,"body":{"entry_ids":[9007199254740993]}},` +
+		`{"status":200,"body":"plain text"},` +
+		`{"status":404,"body":"404 page not found\n"},` +
+		`{"status":400,"body":{"error_message":"Invalid path \"/v1/batch\", batches can only contain API requests"}},` +
+		`{"status":400,"body":{"error_message":"Invalid path \"/ui/feeds\", batches can only contain API requests"}}]`
+	if w.Body.String() != expected {
+		t.Fatalf(`Unexpected body, got %s`, w.Body.String())
+	}
+}
+
+func TestBatchLimits(t *testing.T) {
+	if w := serveBatch(`[]`); w.Code != http.StatusBadRequest {
+		t.Errorf(`An empty batch should be rejected, got %d`, w.Code)
+	}
+
+	items := make([]string, maxBatchRequests+1)
+	for i := range items {
+		items[i] = `{"path": "/v1/me"}`
+	}
+
+	if w := serveBatch("[" + strings.Join(items, ",") + "]"); w.Code != http.StatusBadRequest {
+		t.Errorf(`A batch over the limit should be rejected, got %d`, w.Code)
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/storage"
 )
 
 func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
@@ -26,12 +28,12 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if c, err := h.store.CategoryByTitle(userID, category.Title); err != nil || c != nil {
+	if c, err := h.store.CategoryByTitle(r.Context(), userID, category.Title); err != nil || c != nil {
 		json.BadRequest(w, r, errors.New("This category already exists"))
 		return
 	}
 
-	if err := h.store.CreateCategory(category); err != nil {
+	if err := h.store.CreateCategory(r.Context(), category); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -42,20 +44,35 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 	categoryID := request.RouteInt64Param(r, "categoryID")
 
-	category, err := decodeCategoryPayload(r.Body)
+	categoryChanges, err := decodeCategoryModificationPayload(r.Body)
 	if err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
-	category.UserID = request.UserID(r)
-	category.ID = categoryID
+	category, err := h.store.Category(r.Context(), request.UserID(r), categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	categoryChanges.Update(category)
 	if err := category.ValidateCategoryModification(); err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
-	err = h.store.UpdateCategory(category)
+	err = h.store.UpdateCategory(r.Context(), category)
+	if err == storage.ErrConflict {
+		json.Conflict(w, r, errors.New("This category has been modified since this version"))
+		return
+	}
+
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -65,28 +82,63 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) getCategories(w http.ResponseWriter, r *http.Request) {
-	categories, err := h.store.Categories(request.UserID(r))
+	categories, err := h.store.Categories(r.Context(), request.UserID(r))
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.OK(w, r, categories)
+	allowed := make(model.Categories, 0, len(categories))
+	for _, category := range categories {
+		if isCategoryAllowed(r, category.ID) {
+			allowed = append(allowed, category)
+		}
+	}
+
+	json.OK(w, r, allowed)
 }
 
 func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	categoryID := request.RouteInt64Param(r, "categoryID")
 
-	if !h.store.CategoryExists(userID, categoryID) {
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
 		json.NotFound(w, r)
 		return
 	}
 
-	if err := h.store.RemoveCategory(userID, categoryID); err != nil {
+	if err := h.store.RemoveCategory(r.Context(), userID, categoryID); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
 	json.NoContent(w, r)
 }
+
+func (h *handler) refreshCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	jobs, err := h.store.NewCategoryBatch(r.Context(), userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	refreshJob, err := h.store.CreateRefreshJob(r.Context(), userID, jobs)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	go func() {
+		h.pool.Push(jobs)
+	}()
+
+	json.Accepted(w, r, &refreshJobCreation{JobID: refreshJob.ID})
+}
@@ -0,0 +1,148 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getCategoryShares(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	shares, err := h.store.CategoryShares(r.Context(), userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, shares)
+}
+
+func (h *handler) shareCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	share, err := decodeCategoryShareCreationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	user, err := h.store.UserByUsername(r.Context(), share.Username)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.BadRequest(w, r, errors.New("This user doesn't exists"))
+		return
+	}
+
+	if user.ID == userID {
+		json.BadRequest(w, r, errors.New("A category cannot be shared with its owner"))
+		return
+	}
+
+	if err := h.store.ShareCategory(r.Context(), userID, categoryID, user.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) unshareCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.UnshareCategory(r.Context(), userID, categoryID, request.RouteInt64Param(r, "userID")); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getSharedCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.store.SharedCategories(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, categories)
+}
+
+// getSharedCategoryEntries returns the entries of a category shared by another user,
+// the entries are read with the ID of the owner once the access has been checked.
+func (h *handler) getSharedCategoryEntries(w http.ResponseWriter, r *http.Request) {
+	shared, err := h.store.SharedCategory(r.Context(), request.UserID(r), request.RouteInt64Param(r, "categoryID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if shared == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	statuses := request.QueryStringParamList(r, "status")
+	for _, status := range statuses {
+		if err := model.ValidateEntryStatus(status); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	order := request.QueryStringParam(r, "order", model.DefaultSortingOrder)
+	if err := model.ValidateEntryOrder(order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	direction := request.QueryStringParam(r, "direction", model.DefaultSortingDirection)
+	if err := model.ValidateDirection(direction); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(shared.Category.UserID)
+	builder.WithCategoryID(shared.Category.ID)
+	builder.WithStatuses(statuses)
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithOffset(offset)
+	builder.WithLimit(limit)
+	configureFilters(builder, r)
+
+	h.findEntries(w, r, builder, "")
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"strings"
+)
+
+const corsAllowedMethods = "GET, POST, PUT, DELETE"
+
+// cors lets browser applications served from other origins call the API.
+type cors struct {
+	origins     map[string]bool
+	anyOrigin   bool
+	headers     string
+	credentials bool
+}
+
+func newCORS(origins, headers []string, credentials bool) *cors {
+	c := &cors{
+		origins:     make(map[string]bool),
+		headers:     strings.Join(headers, ", "),
+		credentials: credentials,
+	}
+
+	for _, origin := range origins {
+		if origin == "*" {
+			c.anyOrigin = true
+		}
+		c.origins[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return c
+}
+
+func (c *cors) isAllowed(origin string) bool {
+	return c.anyOrigin || c.origins[origin]
+}
+
+// serve adds the CORS headers for allowed origins and answers preflight requests before authentication.
+func (c *cors) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !c.isAllowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Browsers reject the wildcard when credentials are allowed, the origin is sent back instead.
+		if c.anyOrigin && !c.credentials {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+
+		if c.credentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", c.headers)
+			w.Header().Set("Access-Control-Max-Age", "3600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Access-Control-Expose-Headers", "X-Correlation-Id")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serveCORS(c *cors, method, origin string, preflight bool) (*httptest.ResponseRecorder, bool) {
+	called := false
+	handler := c.serve(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := httptest.NewRequest(method, "/v1/feeds", nil)
+	if origin != "" {
+		r.Header.Set("Origin", origin)
+	}
+	if preflight {
+		r.Header.Set("Access-Control-Request-Method", "PUT")
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w, called
+}
+
+func TestCORSAllowedOrigin(t *testing.T) {
+	c := newCORS([]string{"https://app.example.org/"}, []string{"Authorization", "Content-Type"}, false)
+
+	w, called := serveCORS(c, "GET", "https://app.example.org", false)
+	if !called {
+		t.Fatal(`The request should reach the handler`)
+	}
+
+	if origin := w.Header().Get("Access-Control-Allow-Origin"); origin != "https://app.example.org" {
+		t.Errorf(`Unexpected allowed origin: %q`, origin)
+	}
+
+	if w.Header().Get("Vary") != "Origin" {
+		t.Errorf(`The response should vary by origin`)
+	}
+
+	if w.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Errorf(`Credentials should not be allowed`)
+	}
+}
+
+func TestCORSRejectedOrigin(t *testing.T) {
+	c := newCORS([]string{"https://app.example.org"}, nil, false)
+
+	w, called := serveCORS(c, "OPTIONS", "https://evil.example.org", true)
+	if !called {
+		t.Fatal(`The request of an unknown origin should be handled like other requests`)
+	}
+
+	if w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf(`The origin should not be allowed`)
+	}
+}
+
+func TestCORSPreflight(t *testing.T) {
+	c := newCORS([]string{"https://app.example.org"}, []string{"Authorization", "Content-Type"}, false)
+
+	w, called := serveCORS(c, "OPTIONS", "https://app.example.org", true)
+	if called {
+		t.Fatal(`Preflight requests should not reach the handler`)
+	}
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf(`Unexpected status code: %d`, w.Code)
+	}
+
+	if headers := w.Header().Get("Access-Control-Allow-Headers"); headers != "Authorization, Content-Type" {
+		t.Errorf(`Unexpected allowed headers: %q`, headers)
+	}
+
+	if methods := w.Header().Get("Access-Control-Allow-Methods"); methods != corsAllowedMethods {
+		t.Errorf(`Unexpected allowed methods: %q`, methods)
+	}
+}
+
+func TestCORSAnyOrigin(t *testing.T) {
+	c := newCORS([]string{"*"}, nil, false)
+
+	w, _ := serveCORS(c, "GET", "https://app.example.org", false)
+	if origin := w.Header().Get("Access-Control-Allow-Origin"); origin != "*" {
+		t.Errorf(`Unexpected allowed origin: %q`, origin)
+	}
+}
+
+func TestCORSAnyOriginWithCredentials(t *testing.T) {
+	c := newCORS([]string{"*"}, nil, true)
+
+	w, _ := serveCORS(c, "GET", "https://app.example.org", false)
+	if origin := w.Header().Get("Access-Control-Allow-Origin"); origin != "https://app.example.org" {
+		t.Errorf(`The origin should be sent back when credentials are allowed, got %q`, origin)
+	}
+
+	if w.Header().Get("Access-Control-Allow-Credentials") != "true" {
+		t.Errorf(`Credentials should be allowed`)
+	}
+}
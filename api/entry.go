@@ -11,6 +11,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/locale"
 	"miniflux.app/model"
 	"miniflux.app/storage"
 )
@@ -23,7 +24,7 @@ func (h *handler) getFeedEntry(w http.ResponseWriter, r *http.Request) {
 	builder.WithFeedID(feedID)
 	builder.WithEntryID(entryID)
 
-	entry, err := builder.GetEntry()
+	entry, err := builder.GetEntry(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -34,6 +35,11 @@ func (h *handler) getFeedEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.localizeEntryDates(r, entry); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	json.OK(w, r, entry)
 }
 
@@ -42,7 +48,7 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
 
-	entry, err := builder.GetEntry()
+	entry, err := builder.GetEntry(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -53,14 +59,19 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.localizeEntryDates(r, entry); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	json.OK(w, r, entry)
 }
 
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
-	status := request.QueryStringParam(r, "status", "")
-	if status != "" {
+	statuses := request.QueryStringParamList(r, "status")
+	for _, status := range statuses {
 		if err := model.ValidateEntryStatus(status); err != nil {
 			json.BadRequest(w, r, err)
 			return
@@ -79,6 +90,12 @@ func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	grouping := request.QueryStringParam(r, "group_by", "")
+	if err := model.ValidateEntryGrouping(grouping, order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	limit := request.QueryIntParam(r, "limit", 100)
 	offset := request.QueryIntParam(r, "offset", 0)
 	if err := model.ValidateRange(offset, limit); err != nil {
@@ -88,31 +105,19 @@ func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithFeedID(feedID)
-	builder.WithStatus(status)
+	builder.WithStatuses(statuses)
 	builder.WithOrder(order)
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
 	configureFilters(builder, r)
 
-	entries, err := builder.GetEntries()
-	if err != nil {
-		json.ServerError(w, r, err)
-		return
-	}
-
-	count, err := builder.CountEntries()
-	if err != nil {
-		json.ServerError(w, r, err)
-		return
-	}
-
-	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
+	h.findEntries(w, r, builder, grouping)
 }
 
 func (h *handler) getEntries(w http.ResponseWriter, r *http.Request) {
-	status := request.QueryStringParam(r, "status", "")
-	if status != "" {
+	statuses := request.QueryStringParamList(r, "status")
+	for _, status := range statuses {
 		if err := model.ValidateEntryStatus(status); err != nil {
 			json.BadRequest(w, r, err)
 			return
@@ -131,6 +136,12 @@ func (h *handler) getEntries(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	grouping := request.QueryStringParam(r, "group_by", "")
+	if err := model.ValidateEntryGrouping(grouping, order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	limit := request.QueryIntParam(r, "limit", 100)
 	offset := request.QueryIntParam(r, "offset", 0)
 	if err := model.ValidateRange(offset, limit); err != nil {
@@ -139,28 +150,150 @@ func (h *handler) getEntries(w http.ResponseWriter, r *http.Request) {
 	}
 
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
-	builder.WithStatus(status)
+	builder.WithStatuses(statuses)
 	builder.WithOrder(order)
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
 	configureFilters(builder, r)
 
-	entries, err := builder.GetEntries()
+	if categoryIDs := request.ServiceAccountCategoryIDs(r); len(categoryIDs) > 0 {
+		builder.WithCategoryIDs(categoryIDs)
+	}
+
+	h.findEntries(w, r, builder, grouping)
+}
+
+func (h *handler) getRecentlyReadEntries(w http.ResponseWriter, r *http.Request) {
+	limit := request.QueryIntParam(r, "limit", model.DefaultEntriesPerPage)
+	if err := model.ValidateEntriesPerPage(limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithStatus(model.EntryStatusRead)
+	builder.WithOrder("read_at")
+	builder.WithDirection("desc")
+	builder.WithLimit(limit)
+
+	h.findEntries(w, r, builder, "")
+}
+
+func (h *handler) getRelatedEntries(w http.ResponseWriter, r *http.Request) {
+	limit := request.QueryIntParam(r, "limit", model.DefaultRelatedEntries)
+	if err := model.ValidateEntriesPerPage(limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	entries, err := h.store.RelatedEntries(r.Context(), entry, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.localizeEntryDates(r, entries...); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: len(entries), Entries: entries})
+}
+
+func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, builder *storage.EntryQueryBuilder, grouping string) {
+	entries, err := builder.GetEntries(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	count, err := builder.CountEntries()
+	count, err := builder.CountEntries(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	if err := h.localizeEntryDates(r, entries...); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if grouping == model.EntryGroupingDay {
+		days, err := builder.CountEntriesByDay(r.Context())
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		json.OK(w, r, &entryDaysResponse{Total: count, Days: groupEntriesByDay(entries, days)})
+		return
+	}
+
+	if grouping == model.EntryGroupingStory {
+		var clusterIDs []int64
+		for _, entry := range entries {
+			clusterIDs = append(clusterIDs, entry.ClusterID)
+		}
+
+		builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+		builder.WithClusterIDs(clusterIDs)
+		builder.WithoutStatus(model.EntryStatusRemoved)
+		builder.WithOrder("published_at")
+		builder.WithDirection("asc")
+
+		members, err := builder.GetEntries(r.Context())
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if err := h.localizeEntryDates(r, members...); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		json.OK(w, r, &entryClustersResponse{Total: count, Clusters: groupEntriesByStory(entries, members)})
+		return
+	}
+
 	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
 }
 
+// localizeEntryDates formats the publication date of the entries with the language and timezone of the user.
+func (h *handler) localizeEntryDates(r *http.Request, entries ...*model.Entry) error {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	for _, entry := range entries {
+		entry.DateRelative = printer.ElapsedTime(user.Timezone, entry.Date)
+		entry.DateAbsolute = printer.AbsoluteTime(user.Timezone, entry.Date)
+	}
+
+	return nil
+}
+
 func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
 	entryIDs, status, err := decodeEntryStatusPayload(r.Body)
 	if err != nil {
@@ -173,7 +306,22 @@ func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.SetEntriesStatus(request.UserID(r), entryIDs, status); err != nil {
+	if err := h.store.SetEntriesStatus(r.Context(), request.UserID(r), entryIDs, status); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) markUserAsRead(w http.ResponseWriter, r *http.Request) {
+	userID := request.RouteInt64Param(r, "userID")
+	if userID != request.UserID(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if err := h.store.MarkAllAsRead(r.Context(), userID); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -181,9 +329,80 @@ func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) undoMarkAsRead(w http.ResponseWriter, r *http.Request) {
+	count, err := h.store.UndoMarkAsRead(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &undoMarkAsReadResult{Count: count})
+}
+
+func (h *handler) saveURL(w http.ResponseWriter, r *http.Request) {
+	payload, err := decodeURLSavePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if payload.URL == "" {
+		json.BadRequest(w, r, errors.New("The url is required"))
+		return
+	}
+
+	userID := request.UserID(r)
+	saved, err := h.feedHandler.SaveURL(r.Context(), userID, payload.URL)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(saved.ID)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, entry)
+}
+
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
-	if err := h.store.ToggleBookmark(request.UserID(r), entryID); err != nil {
+	if err := h.store.ToggleBookmark(r.Context(), request.UserID(r), entryID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) setEntriesBookmark(w http.ResponseWriter, r *http.Request) {
+	modification, err := decodeEntryBookmarkPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.SetEntriesStarred(r.Context(), request.UserID(r), modification.EntryIDs, modification.Starred); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) setEntriesScore(w http.ResponseWriter, r *http.Request) {
+	modification, err := decodeEntryScorePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.SetEntriesScore(r.Context(), request.UserID(r), modification.Scores); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -196,7 +415,7 @@ func (h *handler) getEntryEnclosures(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
 
-	entry, err := builder.GetEntry()
+	entry, err := builder.GetEntry(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -207,7 +426,7 @@ func (h *handler) getEntryEnclosures(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	enclosures, err := h.store.GetEnclosures(entryID)
+	enclosures, err := h.store.GetEnclosures(r.Context(), entryID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -222,6 +441,9 @@ func (h *handler) getEntryEnclosures(w http.ResponseWriter, r *http.Request) {
 }
 
 func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
+	// The API follows the NSFW display mode of the user, like the web interface.
+	builder.WithoutHiddenNSFW()
+
 	beforeEntryID := request.QueryInt64Param(r, "before_entry_id", 0)
 	if beforeEntryID != 0 {
 		builder.BeforeEntryID(beforeEntryID)
@@ -242,8 +464,45 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.AfterDate(time.Unix(afterTimestamp, 0))
 	}
 
+	seed := request.QueryInt64Param(r, "seed", 0)
+	if seed != 0 {
+		builder.WithSeed(seed)
+	}
+
+	publishedBeforeTimestamp := request.QueryInt64Param(r, "published_before", 0)
+	if publishedBeforeTimestamp != 0 {
+		builder.BeforeDate(time.Unix(publishedBeforeTimestamp, 0))
+	}
+
+	publishedAfterTimestamp := request.QueryInt64Param(r, "published_after", 0)
+	if publishedAfterTimestamp != 0 {
+		builder.AfterDate(time.Unix(publishedAfterTimestamp, 0))
+	}
+
+	changedAfterTimestamp := request.QueryInt64Param(r, "changed_after", 0)
+	if changedAfterTimestamp != 0 {
+		builder.ChangedAfter(time.Unix(changedAfterTimestamp, 0))
+	}
+
 	if request.HasQueryParam(r, "starred") {
-		builder.WithStarred()
+		switch request.QueryStringParam(r, "starred", "") {
+		case "false", "0":
+			builder.WithoutStarred()
+		default:
+			builder.WithStarred()
+		}
+	}
+
+	if request.HasQueryParam(r, "min_score") {
+		builder.WithMinScore(request.QueryFloat64Param(r, "min_score", 0))
+	}
+
+	if request.HasQueryParam(r, "max_score") {
+		builder.WithMaxScore(request.QueryFloat64Param(r, "max_score", 0))
+	}
+
+	if request.HasQueryParam(r, "min_junk_score") {
+		builder.WithMinJunkScore(request.QueryIntParam(r, "min_junk_score", 0))
 	}
 
 	searchQuery := request.QueryStringParam(r, "search", "")
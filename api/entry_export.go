@@ -0,0 +1,89 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"miniflux.app/ebook"
+	"miniflux.app/http/request"
+	builder "miniflux.app/http/response"
+	"miniflux.app/http/response/json"
+	"miniflux.app/locale"
+	"miniflux.app/mediaproxy"
+	"miniflux.app/model"
+)
+
+// exportEntries bundles the given entries, or the starred entries when no entry is specified,
+// into a document for offline reading.
+func (h *handler) exportEntries(w http.ResponseWriter, r *http.Request) {
+	format := request.QueryStringParam(r, "format", ebook.FormatEPUB)
+	if err := ebook.ValidateFormat(format); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	var entryIDs []int64
+	for _, value := range request.QueryStringParamList(r, "entry_id") {
+		entryID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	title := "Miniflux"
+	query := h.store.NewEntryQueryBuilder(user.ID)
+	query.WithoutStatus(model.EntryStatusRemoved)
+	query.WithOrder(model.DefaultSortingOrder)
+	query.WithDirection(user.EntryDirection)
+	query.WithLimit(model.MaxEntriesPerPage)
+	if len(entryIDs) > 0 {
+		query.WithEntryIDs(entryIDs)
+	} else {
+		query.WithStarred()
+		title = locale.NewPrinter(user.Language).Printf("page.starred.title")
+	}
+
+	entries, err := query.GetEntries(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	book := ebook.New(title, user.Language, mediaproxy.Loader(h.store.BlobStore()))
+	for _, entry := range entries {
+		if err := book.AddEntry(entry); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer, format); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	builder.New(w, r).
+		WithHeader("Content-Type", ebook.ContentType(format)).
+		WithAttachment(book.Filename(format)).
+		WithBody(buffer.Bytes()).
+		Write()
+}
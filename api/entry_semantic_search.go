@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) semanticSearch(w http.ResponseWriter, r *http.Request) {
+	if h.embedder == nil {
+		json.BadRequest(w, r, errors.New("The semantic search is not configured"))
+		return
+	}
+
+	query := strings.TrimSpace(request.QueryStringParam(r, "query", ""))
+	if query == "" {
+		json.BadRequest(w, r, errors.New("The query is empty"))
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", model.DefaultRelatedEntries)
+	if err := model.ValidateEntriesPerPage(limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	vectors, err := h.embedder.Embed([]string{query})
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	entries, err := h.store.SemanticSearch(r.Context(), request.UserID(r), h.embedder.Model(), vectors[0], limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.localizeEntryDates(r, entries...); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: len(entries), Entries: entries})
+}
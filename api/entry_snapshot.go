@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/processor"
+)
+
+func (h *handler) getEntrySnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, err := h.store.EntrySnapshot(r.Context(), request.UserID(r), request.RouteInt64Param(r, "entryID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if snapshot == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, snapshot)
+}
+
+func (h *handler) refreshEntrySnapshot(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	snapshot, fetchErr := processor.SnapshotEntryWebPage(entry)
+	if err := h.store.SaveEntrySnapshot(r.Context(), snapshot); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if fetchErr != nil {
+		json.ServerError(w, r, fetchErr)
+		return
+	}
+
+	snapshot, err = h.store.EntrySnapshot(r.Context(), userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, snapshot)
+}
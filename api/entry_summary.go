@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/summarizer"
+)
+
+func (h *handler) summarizeEntry(w http.ResponseWriter, r *http.Request) {
+	if h.summarizer == nil {
+		json.BadRequest(w, r, errors.New("The summarizer is not configured"))
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	entry.Summary, err = summarizer.SummarizeEntry(h.summarizer, entry)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateEntrySummary(r.Context(), entry); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entrySummary{Summary: entry.Summary})
+}
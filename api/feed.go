@@ -6,12 +6,24 @@ package api // import "miniflux.app/api"
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/script"
+	"miniflux.app/storage"
 )
 
+const (
+	defaultPreviewEntries = 10
+	maxPreviewEntries     = 100
+)
+
+var errScriptNotAllowed = errors.New("Only the administrators can change the script of a feed")
+
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 	feedInfo, err := decodeFeedCreationPayload(r.Body)
 	if err != nil {
@@ -31,47 +43,105 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 
 	userID := request.UserID(r)
 
-	if h.store.FeedURLExists(userID, feedInfo.FeedURL) {
+	if h.store.FeedURLExists(r.Context(), userID, feedInfo.FeedURL) {
 		json.BadRequest(w, r, errors.New("This feed_url already exists"))
 		return
 	}
 
-	if !h.store.CategoryExists(userID, feedInfo.CategoryID) {
+	if !h.store.CategoryExists(r.Context(), userID, feedInfo.CategoryID) {
 		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
-		userID,
-		feedInfo.CategoryID,
-		feedInfo.FeedURL,
-		feedInfo.Crawler,
-		feedInfo.UserAgent,
-		feedInfo.Username,
-		feedInfo.Password,
-	)
+	if !isCategoryAllowed(r, feedInfo.CategoryID) {
+		json.ForbiddenError(w, r, errCategoryNotAllowed)
+		return
+	}
+
+	var feed *model.Feed
+	if feedInfo.WatchSelector != "" {
+		feed, err = h.feedHandler.CreatePageWatchFeed(
+			r.Context(),
+			userID,
+			feedInfo.CategoryID,
+			feedInfo.FeedURL,
+			feedInfo.WatchSelector,
+			feedInfo.UserAgent,
+			feedInfo.Username,
+			feedInfo.Password,
+		)
+	} else {
+		feed, err = h.feedHandler.CreateFeed(
+			r.Context(),
+			userID,
+			feedInfo.CategoryID,
+			feedInfo.FeedURL,
+			feedInfo.Crawler,
+			feedInfo.UserAgent,
+			feedInfo.Username,
+			feedInfo.Password,
+		)
+	}
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	type result struct {
-		FeedID int64 `json:"feed_id"`
+	json.Created(w, r, &feedCreationResult{FeedID: feed.ID, Pending: feed.Pending})
+}
+
+func (h *handler) previewFeed(w http.ResponseWriter, r *http.Request) {
+	previewInfo, err := decodeFeedPreviewPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if previewInfo.FeedURL == "" {
+		json.BadRequest(w, r, errors.New("The feed_url is required"))
+		return
+	}
+
+	if previewInfo.Limit < 0 || previewInfo.Limit > maxPreviewEntries {
+		json.BadRequest(w, r, fmt.Errorf("The limit must be between 1 and %d", maxPreviewEntries))
+		return
+	}
+
+	if previewInfo.Limit == 0 {
+		previewInfo.Limit = defaultPreviewEntries
 	}
 
-	json.Created(w, r, &result{FeedID: feed.ID})
+	feed, err := h.feedHandler.PreviewFeed(
+		r.Context(),
+		previewInfo.FeedURL,
+		previewInfo.Limit,
+		previewInfo.UserAgent,
+		previewInfo.Username,
+		previewInfo.Password,
+	)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &feedPreview{
+		FeedURL: feed.FeedURL,
+		SiteURL: feed.SiteURL,
+		Title:   feed.Title,
+		Entries: feed.Entries,
+	})
 }
 
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
 
-	if !h.store.FeedExists(userID, feedID) {
+	if !h.store.FeedExists(r.Context(), userID, feedID) {
 		json.NotFound(w, r)
 		return
 	}
 
-	err := h.feedHandler.RefreshFeed(userID, feedID)
+	err := h.feedHandler.RefreshFeed(r.Context(), userID, feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -80,6 +150,27 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	jobs, err := h.store.NewUserBatch(r.Context(), userID, h.store.CountFeeds(r.Context(), userID))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	refreshJob, err := h.store.CreateRefreshJob(r.Context(), userID, jobs)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	go func() {
+		h.pool.Push(jobs)
+	}()
+
+	json.Accepted(w, r, &refreshJobCreation{JobID: refreshJob.ID})
+}
+
 func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	feedChanges, err := decodeFeedModificationPayload(r.Body)
@@ -90,7 +181,7 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 
 	userID := request.UserID(r)
 
-	originalFeed, err := h.store.FeedByID(userID, feedID)
+	originalFeed, err := h.store.FeedByID(r.Context(), userID, feedID)
 	if err != nil {
 		json.NotFound(w, r)
 		return
@@ -101,19 +192,80 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if feedChanges.Script != nil && *feedChanges.Script != originalFeed.Script && !request.IsAdminUser(r) {
+		json.ForbiddenError(w, r, errScriptNotAllowed)
+		return
+	}
+
 	feedChanges.Update(originalFeed)
 
-	if !h.store.CategoryExists(userID, originalFeed.Category.ID) {
+	if !h.store.CategoryExists(r.Context(), userID, originalFeed.Category.ID) {
 		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
 		return
 	}
 
-	if err := h.store.UpdateFeed(originalFeed); err != nil {
+	if !isCategoryAllowed(r, originalFeed.Category.ID) {
+		json.ForbiddenError(w, r, errCategoryNotAllowed)
+		return
+	}
+
+	if err := script.Validate(originalFeed.Script); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateEntryOpenMode(originalFeed.EntryOpenMode); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateImageDisplay(originalFeed.ImageDisplay); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateFeedPriority(originalFeed.Priority); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateMarkReadAfterDays(originalFeed.MarkReadAfterDays); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateEntryLimit(originalFeed.MaxEntries, originalFeed.OverflowPolicy); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateScraperMaxPages(originalFeed.ScraperMaxPages); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateSummaryWords(originalFeed.SummaryWords); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateEntryMatching(originalFeed.EntryMatching); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	err = h.store.UpdateFeed(r.Context(), originalFeed)
+	if err == storage.ErrConflict {
+		json.Conflict(w, r, errors.New("This feed has been modified since this version"))
+		return
+	}
+
+	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	originalFeed, err = h.store.FeedByID(userID, feedID)
+	originalFeed, err = h.store.FeedByID(r.Context(), userID, feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -123,18 +275,25 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) getFeeds(w http.ResponseWriter, r *http.Request) {
-	feeds, err := h.store.Feeds(request.UserID(r))
+	feeds, err := h.store.Feeds(r.Context(), request.UserID(r))
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.OK(w, r, feeds)
+	allowed := make(model.Feeds, 0, len(feeds))
+	for _, feed := range feeds {
+		if isCategoryAllowed(r, feed.Category.ID) {
+			allowed = append(allowed, feed)
+		}
+	}
+
+	json.OK(w, r, allowed)
 }
 
 func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	feed, err := h.store.FeedByID(request.UserID(r), feedID)
+	feed, err := h.store.FeedByID(r.Context(), request.UserID(r), feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -148,16 +307,71 @@ func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, feed)
 }
 
+func (h *handler) getFeedStats(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(r.Context(), userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	stats, err := h.store.FeedStats(r.Context(), userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, stats)
+}
+
+func (h *handler) getLeastReadFeeds(w http.ResponseWriter, r *http.Request) {
+	months := request.QueryIntParam(r, "months", model.DefaultLeastReadMonths)
+	if err := model.ValidateLeastReadMonths(months); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	feeds, err := h.store.LeastReadFeeds(r.Context(), request.UserID(r), time.Now().AddDate(0, -months, 0))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, feeds)
+}
+
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
 
-	if !h.store.FeedExists(userID, feedID) {
+	if !h.store.FeedExists(r.Context(), userID, feedID) {
 		json.NotFound(w, r)
 		return
 	}
 
-	if err := h.store.RemoveFeed(userID, feedID); err != nil {
+	if err := h.store.RemoveFeed(r.Context(), userID, feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) setFeedsCategory(w http.ResponseWriter, r *http.Request) {
+	modification, err := decodeFeedCategoryPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if !h.store.CategoryExists(r.Context(), userID, modification.CategoryID) {
+		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
+		return
+	}
+
+	if err := h.store.SetFeedsCategory(r.Context(), userID, modification.FeedIDs, modification.CategoryID); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -0,0 +1,71 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getPendingFeeds(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	feeds, err := h.store.PendingFeeds(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, feeds)
+}
+
+func (h *handler) approveFeed(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID, err := h.store.ApproveFeed(r.Context(), feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if userID == 0 {
+		json.NotFound(w, r)
+		return
+	}
+
+	// The entries are downloaded in the background.
+	h.pool.Push(model.JobList{{UserID: userID, FeedID: feedID}})
+	json.NoContent(w, r)
+}
+
+func (h *handler) rejectFeed(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	userID, err := h.store.RejectFeed(r.Context(), request.RouteInt64Param(r, "feedID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if userID == 0 {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
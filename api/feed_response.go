@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	builder "miniflux.app/http/response"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getFeedResponses(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	responses, err := h.store.FeedResponses(r.Context(), request.RouteInt64Param(r, "feedID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, responses)
+}
+
+func (h *handler) getFeedResponseContent(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	responseID := request.RouteInt64Param(r, "responseID")
+
+	feedResponse, err := h.store.FeedResponse(r.Context(), feedID, responseID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feedResponse == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	contentType := feedResponse.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	builder.New(w, r).
+		WithHeader("Content-Type", contentType).
+		WithAttachment(fmt.Sprintf("feed-%d-response-%d", feedID, responseID)).
+		WithBody(feedResponse.Content).
+		Write()
+}
@@ -5,11 +5,22 @@
 package api // import "miniflux.app/api"
 
 import (
+	"net/http"
+
+	"miniflux.app/embedding"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
+	"miniflux.app/summarizer"
+	"miniflux.app/worker"
 )
 
 type handler struct {
 	store       *storage.Storage
+	pool        *worker.Pool
 	feedHandler *feed.Handler
+	summarizer  summarizer.Summarizer
+	embedder    embedding.Embedder
+
+	// router serves the sub-requests of batches, they are already authenticated.
+	router http.Handler
 }
@@ -14,12 +14,12 @@ import (
 func (h *handler) feedIcon(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
-	if !h.store.HasIcon(feedID) {
+	if !h.store.HasIcon(r.Context(), feedID) {
 		json.NotFound(w, r)
 		return
 	}
 
-	icon, err := h.store.IconByFeedID(request.UserID(r), feedID)
+	icon, err := h.store.IconByFeedID(r.Context(), request.UserID(r), feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -0,0 +1,131 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"miniflux.app/crypto"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// idempotency executes once the requests sent with the same Idempotency-Key header,
+// the retries of a client receive the response of the first request.
+type idempotency struct {
+	store *storage.Storage
+}
+
+func newIdempotency(store *storage.Storage) *idempotency {
+	return &idempotency{store}
+}
+
+func (i *idempotency) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > model.IdempotencyKeyMaxLength {
+			json.BadRequest(w, r, fmt.Errorf("The idempotency key must not exceed %d characters", model.IdempotencyKeyMaxLength))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		userID := request.UserID(r)
+		fingerprint := crypto.HashFromBytes(append([]byte(r.Method+" "+r.URL.Path+"\n"), body...))
+
+		previous, err := i.store.ClaimIdempotencyKey(r.Context(), userID, key, fingerprint)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if previous != nil {
+			switch {
+			case previous.Fingerprint != fingerprint:
+				json.BadRequest(w, r, errors.New("The idempotency key has already been used for another request"))
+			case previous.InProgress():
+				json.Conflict(w, r, errors.New("A request with the same idempotency key is in progress"))
+			default:
+				logger.Debug("[API:Idempotency] Replaying the response of the key %q for the user #%d", key, userID)
+				if previous.ContentType != "" {
+					w.Header().Set("Content-Type", previous.ContentType)
+				}
+				w.Header().Set("Idempotent-Replayed", "true")
+				w.WriteHeader(previous.StatusCode)
+				w.Write(previous.Body)
+			}
+			return
+		}
+
+		// The kept response must not depend on the encodings accepted by the first request.
+		r.Header.Del("Accept-Encoding")
+
+		// The request context is canceled when the client goes away, which is the
+		// case retried by clients, the response is kept with another context.
+		recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		completed := false
+		defer func() {
+			// Panics and server errors are not kept, the client can retry with the same key.
+			if !completed || recorder.statusCode >= http.StatusInternalServerError {
+				if err := i.store.ReleaseIdempotencyKey(context.Background(), userID, key); err != nil {
+					logger.Error("[API:Idempotency] %v", err)
+				}
+			}
+		}()
+
+		next.ServeHTTP(recorder, r)
+		completed = true
+
+		if recorder.statusCode >= http.StatusInternalServerError {
+			return
+		}
+
+		response := &model.IdempotentResponse{
+			Fingerprint: fingerprint,
+			StatusCode:  recorder.statusCode,
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+
+		if err := i.store.SaveIdempotentResponse(context.Background(), userID, key, response); err != nil {
+			logger.Error("[API:Idempotency] %v", err)
+		}
+	})
+}
+
+// responseRecorder writes the response to the client and keeps a copy.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
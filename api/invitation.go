@@ -0,0 +1,90 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getInvitations(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	invitations, err := h.store.Tokens(r.Context(), model.TokenTypeInvitation)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, invitations)
+}
+
+func (h *handler) createInvitation(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	creation, err := decodeInvitationCreationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	invitation := &model.Token{UserID: request.UserID(r), Type: model.TokenTypeInvitation, Email: creation.Email}
+	code, err := h.store.CreateToken(r.Context(), invitation, model.InvitationTokenTTL)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &invitationCreationResult{Invitation: invitation, Code: code})
+}
+
+func (h *handler) removeInvitation(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	removed, err := h.store.RemoveToken(r.Context(), model.TokenTypeInvitation, request.RouteInt64Param(r, "invitationID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !removed {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) approveUser(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	approved, err := h.store.ApproveUser(r.Context(), request.RouteInt64Param(r, "userID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !approved {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
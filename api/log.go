@@ -0,0 +1,81 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+)
+
+const logsKeepAliveInterval = 30 * time.Second
+
+// tailLogs streams the log messages as server-sent events, starting with the messages kept in memory.
+// The stream ends with the request timeout, the clients reconnect with the Last-Event-ID header
+// to receive the next messages only.
+func (h *handler) tailLogs(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	level, err := logger.ParseLevel(request.QueryStringParam(r, "level", logger.InfoLevel.String()))
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		json.BadRequest(w, r, errors.New("The logs can only be streamed over a direct connection"))
+		return
+	}
+
+	lastID, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	// Subscribing first ensures no message is lost between the history and the stream.
+	entries, cancel := logger.Subscribe()
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for _, entry := range logger.Recent(level, lastID) {
+		writeLogEvent(w, entry)
+		lastID = entry.ID
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(logsKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		case entry := <-entries:
+			if entry.ID > lastID && entry.Level <= level {
+				writeLogEvent(w, entry)
+				flusher.Flush()
+				lastID = entry.ID
+			}
+		}
+	}
+}
+
+func writeLogEvent(w http.ResponseWriter, entry *logger.Entry) {
+	fmt.Fprintf(w, "id: %d\nevent: log\ndata: %s\n\n", entry.ID, encodeLogEntry(entry))
+}
@@ -22,12 +22,20 @@ func newMiddleware(s *storage.Storage) *middleware {
 	return &middleware{s}
 }
 
-// BasicAuth handles HTTP basic authentication.
+// apiKeyHeader is the header containing the API key of a service account.
+const apiKeyHeader = "X-Auth-Token"
+
+// BasicAuth handles HTTP basic authentication, service accounts send an API key instead.
 func (m *middleware) serve(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
 
 		clientIP := request.ClientIP(r)
+		if key := r.Header.Get(apiKeyHeader); key != "" {
+			m.serveServiceAccount(w, r, next, key)
+			return
+		}
+
 		username, password, authOK := r.BasicAuth()
 		if !authOK {
 			logger.Debug("[API] No authentication headers sent")
@@ -35,13 +43,13 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 			return
 		}
 
-		if err := m.store.CheckPassword(username, password); err != nil {
+		if err := m.store.CheckPassword(r.Context(), username, password); err != nil {
 			logger.Error("[API] [ClientIP=%s] Invalid username or password: %s", clientIP, username)
 			json.Unauthorized(w, r)
 			return
 		}
 
-		user, err := m.store.UserByUsername(username)
+		user, err := m.store.UserByUsername(r.Context(), username)
 		if err != nil {
 			logger.Error("[API] %v", err)
 			json.ServerError(w, r, err)
@@ -55,7 +63,7 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		}
 
 		logger.Info("[API] User authenticated: %s", username)
-		m.store.SetLastLogin(user.ID)
+		m.store.SetLastLogin(r.Context(), user.ID)
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
@@ -66,3 +74,45 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// serveServiceAccount authenticates a service account, the request acts on the data of its owner
+// but never as an administrator.
+func (m *middleware) serveServiceAccount(w http.ResponseWriter, r *http.Request, next http.Handler, key string) {
+	clientIP := request.ClientIP(r)
+	account, err := m.store.ServiceAccountByAPIKey(r.Context(), key)
+	if err != nil {
+		logger.Error("[API] %v", err)
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		logger.Error("[API] [ClientIP=%s] Invalid API key", clientIP)
+		json.Unauthorized(w, r)
+		return
+	}
+
+	user, err := m.store.UserByID(r.Context(), account.UserID)
+	if err != nil {
+		logger.Error("[API] %v", err)
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.Unauthorized(w, r)
+		return
+	}
+
+	logger.Info("[API] Service account authenticated: %s (%s)", account.Name, user.Username)
+
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
+	ctx = context.WithValue(ctx, request.IsAdminUserContextKey, false)
+	ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+	ctx = context.WithValue(ctx, request.ServiceAccountScopesContextKey, account.Scopes)
+	ctx = context.WithValue(ctx, request.ServiceAccountCategoryIDsContextKey, account.CategoryIDs)
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
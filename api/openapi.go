@@ -0,0 +1,322 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/http/response/json"
+	"miniflux.app/version"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{([^}:]+)(:[^}]+)?\}`)
+
+type openAPIDocument struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       openAPIInfo                      `json:"info"`
+	Servers    []openAPIServer                  `json:"servers"`
+	Security   []map[string][]string            `json:"security"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components openAPIComponents                `json:"components"`
+
+	operations map[*route]*operation
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*schema         `json:"schemas"`
+	SecuritySchemes map[string]*securityScheme `json:"securitySchemes"`
+}
+
+type securityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+	In     string `json:"in,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Tags        []string             `json:"tags"`
+	Parameters  []*openAPIParameter  `json:"parameters,omitempty"`
+	RequestBody *requestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
+	Schema      *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Description string                `json:"description"`
+	Content     map[string]*mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*schema          `json:"allOf,omitempty"`
+}
+
+// ServeHTTP sends the OpenAPI document to the client.
+func (d *openAPIDocument) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	json.OK(w, r, d)
+}
+
+// newOpenAPIDocument generates an OpenAPI 3 document from the route definitions.
+// Schemas are derived from the Go types used by the handlers.
+func newOpenAPIDocument(routes []*route) *openAPIDocument {
+	d := &openAPIDocument{
+		OpenAPI:  "3.0.2",
+		Info:     openAPIInfo{Title: "Miniflux API", Version: version.Version},
+		Servers:  []openAPIServer{{URL: "/v1"}},
+		Security: []map[string][]string{{"basicAuth": {}}, {"apiKey": {}}},
+		Paths:    make(map[string]map[string]*operation),
+		Components: openAPIComponents{
+			Schemas: map[string]*schema{
+				"Error": {
+					Type:       "object",
+					Properties: map[string]*schema{"error_message": {Type: "string"}},
+				},
+			},
+			SecuritySchemes: map[string]*securityScheme{
+				"basicAuth": {Type: "http", Scheme: "basic"},
+				"apiKey":    {Type: "apiKey", In: "header", Name: apiKeyHeader},
+			},
+		},
+		operations: make(map[*route]*operation),
+	}
+
+	g := &schemaGenerator{schemas: d.Components.Schemas, types: make(map[string]reflect.Type)}
+	for _, route := range routes {
+		path := pathParamRegex.ReplaceAllString(route.path, "{$1}")
+		if _, found := d.Paths[path]; !found {
+			d.Paths[path] = make(map[string]*operation)
+		}
+
+		op := g.operation(route)
+		d.Paths[path][strings.ToLower(route.method)] = op
+		d.operations[route] = op
+	}
+
+	return d
+}
+
+type schemaGenerator struct {
+	schemas map[string]*schema
+	types   map[string]reflect.Type
+}
+
+func (g *schemaGenerator) operation(r *route) *operation {
+	op := &operation{
+		OperationID: r.operationID,
+		Summary:     r.summary,
+		Tags:        []string{r.tag},
+		Responses:   make(map[string]*response),
+	}
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(r.path, -1) {
+		p := &openAPIParameter{Name: match[1], In: "path", Required: true, Schema: &schema{Type: "string"}}
+		if strings.HasSuffix(match[1], "ID") {
+			p.Schema = &schema{Type: "integer", Format: "int64"}
+		}
+		op.Parameters = append(op.Parameters, p)
+	}
+
+	for _, param := range r.parameters {
+		p := &openAPIParameter{Name: param.name, In: "query", Description: param.description}
+		p.Schema = &schema{Type: param.kind, Enum: param.enum}
+		switch param.kind {
+		case "integer":
+			p.Schema.Format = "int64"
+		case "number":
+			p.Schema.Format = "double"
+		}
+		if param.list {
+			explode := true
+			p.Explode = &explode
+			p.Schema = &schema{Type: "array", Items: p.Schema}
+		}
+		op.Parameters = append(op.Parameters, p)
+	}
+
+	if r.idempotent {
+		op.Parameters = append(op.Parameters, &openAPIParameter{
+			Name:        "Idempotency-Key",
+			In:          "header",
+			Description: "Unique key of the request, the retries sent with the same key receive the response of the first request without executing it again",
+			Schema:      &schema{Type: "string"},
+		})
+	}
+
+	switch {
+	case r.bodyType != "":
+		op.RequestBody = &requestBody{Required: true, Content: map[string]*mediaType{r.bodyType: {Schema: &schema{Type: "string"}}}}
+	case r.body != nil:
+		s := g.inline(reflect.TypeOf(r.body))
+		s.Required = r.bodyRequired
+		op.RequestBody = &requestBody{Required: true, Content: map[string]*mediaType{"application/json": {Schema: s}}}
+	}
+
+	status := r.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := &response{Description: http.StatusText(status)}
+	switch {
+	case r.responseType != "":
+		success.Content = map[string]*mediaType{r.responseType: {Schema: &schema{Type: "string"}}}
+	case r.response != nil:
+		success.Content = map[string]*mediaType{"application/json": {Schema: g.schema(reflect.TypeOf(r.response))}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	errorStatuses := []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError}
+	if strings.Contains(r.path, "{") {
+		errorStatuses = append(errorStatuses, http.StatusNotFound)
+	}
+	if r.idempotent || r.versioned {
+		errorStatuses = append(errorStatuses, http.StatusConflict)
+	}
+	if r.isMutation() {
+		errorStatuses = append(errorStatuses, http.StatusForbidden)
+		if !r.admin {
+			errorStatuses = append(errorStatuses, http.StatusServiceUnavailable)
+		}
+	}
+
+	for _, errorStatus := range errorStatuses {
+		op.Responses[strconv.Itoa(errorStatus)] = &response{
+			Description: http.StatusText(errorStatus),
+			Content:     map[string]*mediaType{"application/json": {Schema: &schema{Ref: "#/components/schemas/Error"}}},
+		}
+	}
+
+	return op
+}
+
+// schema returns the schema of a type, named structs are referenced from the components section.
+func (g *schemaGenerator) schema(t reflect.Type) *schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t.Name() == "" || t == reflect.TypeOf(time.Time{}) {
+		return g.inline(t)
+	}
+
+	name := g.componentName(t)
+	if _, found := g.schemas[name]; !found {
+		g.types[name] = t
+		g.schemas[name] = &schema{}
+		*g.schemas[name] = *g.inline(t)
+	}
+
+	return &schema{Ref: "#/components/schemas/" + name}
+}
+
+// inline returns the schema of a type without referencing the top-level struct.
+func (g *schemaGenerator) inline(t reflect.Type) *schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &schema{Type: "string", Format: "byte"}
+		}
+		return &schema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Map:
+		return &schema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if t == reflect.TypeOf(time.Time{}) {
+			return &schema{Type: "string", Format: "date-time"}
+		}
+
+		s := &schema{Type: "object", Properties: make(map[string]*schema)}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if field.PkgPath != "" || name == "-" {
+				continue
+			}
+
+			if name == "" {
+				name = field.Name
+			}
+
+			property := g.schema(field.Type)
+			switch field.Type.Kind() {
+			case reflect.Ptr, reflect.Slice, reflect.Map:
+				if property.Ref != "" {
+					property = &schema{AllOf: []*schema{property}}
+				}
+				property.Nullable = true
+			}
+			s.Properties[name] = property
+		}
+		return s
+	}
+
+	return &schema{}
+}
+
+// componentName returns a unique component name for a struct type.
+func (g *schemaGenerator) componentName(t reflect.Type) string {
+	name := strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+	if existing, found := g.types[name]; !found || existing == t {
+		return name
+	}
+
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + name
+}
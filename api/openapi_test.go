@@ -0,0 +1,122 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOpenAPIDocumentPaths(t *testing.T) {
+	d := newOpenAPIDocument(routes)
+
+	for _, r := range routes {
+		if d.operations[r] == nil {
+			t.Errorf(`Missing operation for %s %s`, r.method, r.path)
+		}
+	}
+
+	if _, found := d.Paths["/users/{userID}"]["delete"]; !found {
+		t.Error(`Route regular expressions should be removed from the path`)
+	}
+
+	op := d.Paths["/feeds/{feedID}/entries"]["get"]
+	if op.Parameters[0].Name != "feedID" || op.Parameters[0].In != "path" || op.Parameters[0].Schema.Type != "integer" {
+		t.Errorf(`Unexpected path parameter: %+v`, op.Parameters[0])
+	}
+
+	if _, found := op.Responses["200"]; !found {
+		t.Error(`The operation should have a 200 response`)
+	}
+
+	if _, found := d.Paths["/feeds"]["post"].Responses["201"]; !found {
+		t.Error(`The feed creation should have a 201 response`)
+	}
+}
+
+func TestOpenAPIDocumentOperationIDsAreUnique(t *testing.T) {
+	d := newOpenAPIDocument(routes)
+	seen := make(map[string]bool)
+
+	for _, methods := range d.Paths {
+		for _, op := range methods {
+			if seen[op.OperationID] {
+				t.Errorf(`Duplicate operation ID: %s`, op.OperationID)
+			}
+			seen[op.OperationID] = true
+		}
+	}
+}
+
+func TestOpenAPIDocumentIdempotencyKey(t *testing.T) {
+	d := newOpenAPIDocument(routes)
+
+	op := d.Paths["/entries"]["put"]
+	last := op.Parameters[len(op.Parameters)-1]
+	if last.Name != "Idempotency-Key" || last.In != "header" || last.Required {
+		t.Errorf(`Unexpected idempotency key parameter: %+v`, last)
+	}
+
+	if _, found := op.Responses["409"]; !found {
+		t.Error(`Idempotent operations should have a 409 response`)
+	}
+
+	for _, param := range d.Paths["/entries"]["get"].Parameters {
+		if param.In == "header" {
+			t.Errorf(`Other operations should not accept an idempotency key`)
+		}
+	}
+}
+
+func TestOpenAPIDocumentReadOnlyResponses(t *testing.T) {
+	d := newOpenAPIDocument(routes)
+
+	if _, found := d.Paths["/feeds"]["post"].Responses["403"]; !found {
+		t.Error(`Mutations should have a 403 response`)
+	}
+
+	if _, found := d.Paths["/feeds"]["get"].Responses["403"]; found {
+		t.Error(`Read operations should not have a 403 response`)
+	}
+
+	if _, found := d.Paths["/discover"]["post"].Responses["403"]; found {
+		t.Error(`Safe operations should not have a 403 response`)
+	}
+}
+
+func TestOpenAPIDocumentSchemas(t *testing.T) {
+	d := newOpenAPIDocument(routes)
+
+	entry, found := d.Components.Schemas["Entry"]
+	if !found {
+		t.Fatal(`The Entry schema is missing`)
+	}
+
+	if entry.Properties["published_at"].Format != "date-time" {
+		t.Errorf(`Unexpected published_at schema: %+v`, entry.Properties["published_at"])
+	}
+
+	if entry.Properties["score"].Type != "number" {
+		t.Errorf(`Unexpected score schema: %+v`, entry.Properties["score"])
+	}
+
+	feed := entry.Properties["feed"]
+	if !feed.Nullable || len(feed.AllOf) != 1 || feed.AllOf[0].Ref != "#/components/schemas/Feed" {
+		t.Errorf(`Unexpected feed schema: %+v`, feed)
+	}
+
+	if _, found := d.Components.Schemas["ApiFeedIcon"]; !found {
+		t.Error(`Schemas with the same name should be prefixed by their package name`)
+	}
+
+	body := d.Paths["/feeds"]["post"].RequestBody.Content["application/json"].Schema
+	if len(body.Required) != 2 || body.Properties["category_id"].Type != "integer" {
+		t.Errorf(`Unexpected feed creation schema: %+v`, body)
+	}
+
+	if _, err := json.Marshal(d); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -15,7 +15,7 @@ import (
 
 func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 	opmlHandler := opml.NewHandler(h.store)
-	opml, err := opmlHandler.Export(request.UserID(r))
+	opml, err := opmlHandler.Export(r.Context(), request.UserID(r))
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -26,7 +26,11 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) importFeeds(w http.ResponseWriter, r *http.Request) {
 	opmlHandler := opml.NewHandler(h.store)
-	err := opmlHandler.Import(request.UserID(r), r.Body)
+	if h.feedHandler.NeedsApproval(r.Context(), request.UserID(r)) {
+		opmlHandler.MarkPending()
+	}
+
+	err := opmlHandler.Import(r.Context(), request.UserID(r), r.Body)
 	defer r.Body.Close()
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -0,0 +1,83 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getOutbox(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	status := request.QueryStringParam(r, "status", model.OutboxStatusFailed)
+	if err := model.ValidateOutboxStatus(status); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", model.DefaultOutboxLimit)
+
+	backlog, err := h.store.OutboxBacklog(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	events, err := h.store.OutboxEvents(r.Context(), status, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &outboxResponse{Backlog: backlog, Events: events})
+}
+
+func (h *handler) replayOutbox(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	modification, err := decodeOutboxPayload(r.Body, false)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	result, err := h.store.ReplayOutboxEvents(r.Context(), modification.EventIDs, model.DefaultOutboxLimit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, result)
+}
+
+func (h *handler) discardOutbox(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	modification, err := decodeOutboxPayload(r.Body, true)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if _, err := h.store.DiscardOutboxEvents(r.Context(), modification.EventIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -5,10 +5,17 @@
 package api // import "miniflux.app/api"
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
 
+	"miniflux.app/logger"
 	"miniflux.app/model"
 )
 
@@ -23,13 +30,147 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type entryDay struct {
+	Day     string        `json:"day"`
+	Count   int           `json:"count"`
+	Entries model.Entries `json:"entries"`
+}
+
+type entryDaysResponse struct {
+	Total int         `json:"total"`
+	Days  []*entryDay `json:"days"`
+}
+
+// groupEntriesByDay nests the sorted entries in their publication day,
+// the number of entries of each day is computed by the database and includes the entries of other pages.
+func groupEntriesByDay(entries model.Entries, counts model.EntryDays) []*entryDay {
+	countByDay := make(map[string]int, len(counts))
+	for _, day := range counts {
+		countByDay[day.Day] = day.Count
+	}
+
+	days := make([]*entryDay, 0)
+	for _, entry := range entries {
+		name := entry.Date.Format("2006-01-02")
+		if len(days) == 0 || days[len(days)-1].Day != name {
+			days = append(days, &entryDay{Day: name, Count: countByDay[name]})
+		}
+
+		current := days[len(days)-1]
+		current.Entries = append(current.Entries, entry)
+	}
+
+	return days
+}
+
+type entryCluster struct {
+	ClusterID      int64         `json:"cluster_id"`
+	Representative *model.Entry  `json:"representative"`
+	Entries        model.Entries `json:"entries"`
+}
+
+type entryClustersResponse struct {
+	Total    int             `json:"total"`
+	Clusters []*entryCluster `json:"clusters"`
+}
+
+// groupEntriesByStory nests the entries in their story, in the order of the first entry of each story.
+// The first entry of a story is its representative, the members include the entries of other pages.
+func groupEntriesByStory(entries, members model.Entries) []*entryCluster {
+	clusters := make([]*entryCluster, 0)
+	byID := make(map[int64]*entryCluster)
+	for _, entry := range entries {
+		if _, found := byID[entry.ClusterID]; !found {
+			cluster := &entryCluster{ClusterID: entry.ClusterID, Representative: entry, Entries: make(model.Entries, 0)}
+			byID[entry.ClusterID] = cluster
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	for _, member := range members {
+		if cluster, found := byID[member.ClusterID]; found && member.ID != cluster.Representative.ID {
+			cluster.Entries = append(cluster.Entries, member)
+		}
+	}
+
+	return clusters
+}
+
 type feedCreation struct {
-	FeedURL    string `json:"feed_url"`
-	CategoryID int64  `json:"category_id"`
-	UserAgent  string `json:"user_agent"`
-	Username   string `json:"username"`
-	Password   string `json:"password"`
-	Crawler    bool   `json:"crawler"`
+	FeedURL       string `json:"feed_url"`
+	CategoryID    int64  `json:"category_id"`
+	UserAgent     string `json:"user_agent"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	Crawler       bool   `json:"crawler"`
+	WatchSelector string `json:"watch_selector"`
+}
+
+type feedPreviewRequest struct {
+	FeedURL   string `json:"feed_url"`
+	UserAgent string `json:"user_agent"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	Limit     int    `json:"limit"`
+}
+
+type feedPreview struct {
+	FeedURL string        `json:"feed_url"`
+	SiteURL string        `json:"site_url"`
+	Title   string        `json:"title"`
+	Entries model.Entries `json:"entries"`
+}
+
+type feedCreationResult struct {
+	FeedID  int64 `json:"feed_id"`
+	Pending bool  `json:"pending"`
+}
+
+type refreshJobCreation struct {
+	JobID int64 `json:"job_id"`
+}
+
+type entrySummary struct {
+	Summary string `json:"summary"`
+}
+
+type undoMarkAsReadResult struct {
+	Count int64 `json:"count"`
+}
+
+type outboxResponse struct {
+	Backlog *model.OutboxBacklog `json:"backlog"`
+	Events  model.OutboxEvents   `json:"events"`
+}
+
+type outboxModification struct {
+	EventIDs []int64 `json:"event_ids"`
+}
+
+type entryStatusModification struct {
+	EntryIDs []int64 `json:"entry_ids"`
+	Status   string  `json:"status"`
+}
+
+// Maximum number of entries or feeds changed by a batch request.
+const maxBatchSize = 1000
+
+type entryBookmarkModification struct {
+	EntryIDs []int64 `json:"entry_ids"`
+	Starred  bool    `json:"starred"`
+}
+
+type entryScoreModification struct {
+	Scores model.EntryScores `json:"scores"`
+}
+
+type feedCategoryModification struct {
+	FeedIDs    []int64 `json:"feed_ids"`
+	CategoryID int64   `json:"category_id"`
+}
+
+type urlSaveRequest struct {
+	URL string `json:"url"`
 }
 
 type subscriptionDiscovery struct {
@@ -40,16 +181,31 @@ type subscriptionDiscovery struct {
 }
 
 type feedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL          *string `json:"feed_url"`
+	SiteURL          *string `json:"site_url"`
+	Title            *string `json:"title"`
+	ScraperRules     *string `json:"scraper_rules"`
+	RewriteRules     *string `json:"rewrite_rules"`
+	Script           *string `json:"script"`
+	Crawler          *bool   `json:"crawler"`
+	ScraperMaxPages  *int    `json:"scraper_max_pages"`
+	EntryOpenMode    *string `json:"entry_open_mode"`
+	ImageDisplay     *string `json:"image_display"`
+	SummaryWords     *int    `json:"summary_words"`
+	SummarizeEntries *bool   `json:"summarize_entries"`
+	NSFW             *bool   `json:"nsfw"`
+	Priority         *string `json:"priority"`
+	MutedUntil       *string `json:"muted_until"`
+	MarkReadAfter    *int    `json:"mark_read_after_days"`
+	MaxEntries       *int    `json:"max_entries"`
+	OverflowPolicy   *string `json:"overflow_policy"`
+	EntryMatching    *string `json:"entry_matching"`
+	WatchSelector    *string `json:"watch_selector"`
+	UserAgent        *string `json:"user_agent"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	CategoryID       *int64  `json:"category_id"`
+	Version          *int    `json:"version"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
@@ -73,10 +229,71 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.RewriteRules = *f.RewriteRules
 	}
 
+	if f.Script != nil {
+		feed.Script = *f.Script
+	}
+
 	if f.Crawler != nil {
 		feed.Crawler = *f.Crawler
 	}
 
+	if f.ScraperMaxPages != nil {
+		feed.ScraperMaxPages = *f.ScraperMaxPages
+	}
+
+	if f.EntryOpenMode != nil {
+		feed.EntryOpenMode = *f.EntryOpenMode
+	}
+
+	if f.ImageDisplay != nil {
+		feed.ImageDisplay = *f.ImageDisplay
+	}
+
+	if f.SummaryWords != nil {
+		feed.SummaryWords = *f.SummaryWords
+	}
+
+	if f.SummarizeEntries != nil {
+		feed.SummarizeEntries = *f.SummarizeEntries
+	}
+
+	if f.NSFW != nil {
+		feed.NSFW = *f.NSFW
+	}
+
+	if f.Priority != nil {
+		feed.Priority = *f.Priority
+	}
+
+	if f.MarkReadAfter != nil {
+		feed.MarkReadAfterDays = *f.MarkReadAfter
+	}
+
+	if f.MaxEntries != nil {
+		feed.MaxEntries = *f.MaxEntries
+	}
+
+	if f.OverflowPolicy != nil {
+		feed.OverflowPolicy = *f.OverflowPolicy
+	}
+
+	if f.EntryMatching != nil {
+		feed.EntryMatching = *f.EntryMatching
+	}
+
+	// An empty value or a date in the past unmutes the feed.
+	if f.MutedUntil != nil {
+		feed.MutedUntil = nil
+		if mutedUntil, err := time.Parse(time.RFC3339, *f.MutedUntil); err == nil && mutedUntil.After(time.Now()) {
+			feed.MutedUntil = &mutedUntil
+		}
+	}
+
+	// A feed and a watched page cannot be converted into each other.
+	if f.WatchSelector != nil && *f.WatchSelector != "" && feed.IsPageWatch() {
+		feed.WatchSelector = *f.WatchSelector
+	}
+
 	if f.UserAgent != nil {
 		feed.UserAgent = *f.UserAgent
 	}
@@ -92,16 +309,30 @@ func (f *feedModification) Update(feed *model.Feed) {
 	if f.CategoryID != nil && *f.CategoryID > 0 {
 		feed.Category.ID = *f.CategoryID
 	}
+
+	// The update is rejected when the feed has been changed since the given version.
+	if f.Version != nil {
+		feed.Version = *f.Version
+	}
 }
 
 type userModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	IsAdmin          *bool   `json:"is_admin"`
+	Theme            *string `json:"theme"`
+	Language         *string `json:"language"`
+	Timezone         *string `json:"timezone"`
+	EntryDirection   *string `json:"entry_sorting_direction"`
+	EntriesPerPage   *int    `json:"entries_per_page"`
+	ShowReadEntries  *bool   `json:"show_read_entries"`
+	ShowAbsoluteTime *bool   `json:"show_absolute_time"`
+	ImageDisplay     *string `json:"image_display"`
+	NSFWDisplay      *string `json:"nsfw_display"`
+	JunkThreshold    *int    `json:"junk_threshold"`
+	QuietHoursStart  *string `json:"quiet_hours_start"`
+	QuietHoursEnd    *string `json:"quiet_hours_end"`
+	Email            *string `json:"email"`
 }
 
 func (u *userModification) Update(user *model.User) {
@@ -132,6 +363,64 @@ func (u *userModification) Update(user *model.User) {
 	if u.EntryDirection != nil {
 		user.EntryDirection = *u.EntryDirection
 	}
+
+	if u.EntriesPerPage != nil {
+		user.EntriesPerPage = *u.EntriesPerPage
+	}
+
+	if u.ShowReadEntries != nil {
+		user.ShowReadEntries = *u.ShowReadEntries
+	}
+
+	if u.ShowAbsoluteTime != nil {
+		user.ShowAbsoluteTime = *u.ShowAbsoluteTime
+	}
+
+	if u.ImageDisplay != nil {
+		user.ImageDisplay = *u.ImageDisplay
+	}
+
+	if u.NSFWDisplay != nil {
+		user.NSFWDisplay = *u.NSFWDisplay
+	}
+
+	if u.JunkThreshold != nil {
+		user.JunkThreshold = *u.JunkThreshold
+	}
+
+	if u.QuietHoursStart != nil {
+		user.QuietHoursStart = *u.QuietHoursStart
+	}
+
+	if u.QuietHoursEnd != nil {
+		user.QuietHoursEnd = *u.QuietHoursEnd
+	}
+
+	if u.Email != nil {
+		user.Email = *u.Email
+	}
+}
+
+type userFreeze struct {
+	// Duration is the number of seconds before the end of the write freeze.
+	Duration int    `json:"duration"`
+	Message  string `json:"message"`
+}
+
+func decodeUserFreezePayload(r io.ReadCloser) (*userFreeze, error) {
+	defer r.Close()
+
+	var freeze userFreeze
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&freeze); err != nil {
+		return nil, fmt.Errorf("Unable to decode user freeze JSON object: %v", err)
+	}
+
+	if err := model.ValidateWriteFreezeDuration(freeze.Duration); err != nil {
+		return nil, err
+	}
+
+	return &freeze, nil
 }
 
 func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
@@ -143,9 +432,27 @@ func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
 		return nil, fmt.Errorf("Unable to decode user modification JSON object: %v", err)
 	}
 
+	if user.EntriesPerPage != nil {
+		if err := model.ValidateEntriesPerPage(*user.EntriesPerPage); err != nil {
+			return nil, err
+		}
+	}
+
 	return &user, nil
 }
 
+func decodeKeyboardShortcutsPayload(r io.ReadCloser) (model.KeyboardShortcuts, error) {
+	defer r.Close()
+
+	var shortcuts model.KeyboardShortcuts
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&shortcuts); err != nil {
+		return nil, fmt.Errorf("Unable to decode keyboard shortcuts JSON object: %v", err)
+	}
+
+	return shortcuts, nil
+}
+
 func decodeUserCreationPayload(r io.ReadCloser) (*model.User, error) {
 	defer r.Close()
 
@@ -170,13 +477,20 @@ func decodeURLPayload(r io.ReadCloser) (*subscriptionDiscovery, error) {
 	return &s, nil
 }
 
-func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
-	type payload struct {
-		EntryIDs []int64 `json:"entry_ids"`
-		Status   string  `json:"status"`
+func decodeURLSavePayload(r io.ReadCloser) (*urlSaveRequest, error) {
+	defer r.Close()
+
+	var s urlSaveRequest
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&s); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
 	}
 
-	var p payload
+	return &s, nil
+}
+
+func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
+	var p entryStatusModification
 	decoder := json.NewDecoder(r)
 	defer r.Close()
 	if err := decoder.Decode(&p); err != nil {
@@ -186,6 +500,87 @@ func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
 	return p.EntryIDs, p.Status, nil
 }
 
+func decodeEntryBookmarkPayload(r io.ReadCloser) (*entryBookmarkModification, error) {
+	defer r.Close()
+
+	var modification entryBookmarkModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&modification); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := validateBatchSize(len(modification.EntryIDs)); err != nil {
+		return nil, err
+	}
+
+	return &modification, nil
+}
+
+func decodeEntryScorePayload(r io.ReadCloser) (*entryScoreModification, error) {
+	defer r.Close()
+
+	var modification entryScoreModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&modification); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := validateBatchSize(len(modification.Scores)); err != nil {
+		return nil, err
+	}
+
+	for _, score := range modification.Scores {
+		if score == nil || score.EntryID == 0 {
+			return nil, errors.New("Each score must have an entry ID")
+		}
+	}
+
+	return &modification, nil
+}
+
+func decodeFeedCategoryPayload(r io.ReadCloser) (*feedCategoryModification, error) {
+	defer r.Close()
+
+	var modification feedCategoryModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&modification); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := validateBatchSize(len(modification.FeedIDs)); err != nil {
+		return nil, err
+	}
+
+	return &modification, nil
+}
+
+func decodeOutboxPayload(r io.ReadCloser, idsRequired bool) (*outboxModification, error) {
+	defer r.Close()
+
+	var modification outboxModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&modification); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if idsRequired || len(modification.EventIDs) > 0 {
+		if err := validateBatchSize(len(modification.EventIDs)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &modification, nil
+}
+
+// validateBatchSize limits the number of items changed by a single request.
+func validateBatchSize(size int) error {
+	if size == 0 || size > maxBatchSize {
+		return fmt.Errorf("The list of IDs must contain between 1 and %d items", maxBatchSize)
+	}
+
+	return nil
+}
+
 func decodeFeedCreationPayload(r io.ReadCloser) (*feedCreation, error) {
 	defer r.Close()
 
@@ -198,6 +593,18 @@ func decodeFeedCreationPayload(r io.ReadCloser) (*feedCreation, error) {
 	return &fc, nil
 }
 
+func decodeFeedPreviewPayload(r io.ReadCloser) (*feedPreviewRequest, error) {
+	defer r.Close()
+
+	var fp feedPreviewRequest
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&fp); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return &fp, nil
+}
+
 func decodeFeedModificationPayload(r io.ReadCloser) (*feedModification, error) {
 	defer r.Close()
 
@@ -207,9 +614,163 @@ func decodeFeedModificationPayload(r io.ReadCloser) (*feedModification, error) {
 		return nil, fmt.Errorf("Unable to decode feed modification JSON object: %v", err)
 	}
 
+	if feed.MutedUntil != nil && *feed.MutedUntil != "" {
+		if _, err := time.Parse(time.RFC3339, *feed.MutedUntil); err != nil {
+			return nil, fmt.Errorf("The muted_until value must be a RFC 3339 date: %v", err)
+		}
+	}
+
 	return &feed, nil
 }
 
+type categoryModification struct {
+	Title             *string `json:"title"`
+	MarkReadAfterDays *int    `json:"mark_read_after_days"`
+	ImageDisplay      *string `json:"image_display"`
+	NSFW              *bool   `json:"nsfw"`
+	Version           *int    `json:"version"`
+}
+
+func (c *categoryModification) Update(category *model.Category) {
+	if c.Title != nil {
+		category.Title = *c.Title
+	}
+
+	if c.MarkReadAfterDays != nil {
+		category.MarkReadAfterDays = *c.MarkReadAfterDays
+	}
+
+	if c.ImageDisplay != nil {
+		category.ImageDisplay = *c.ImageDisplay
+	}
+
+	if c.NSFW != nil {
+		category.NSFW = *c.NSFW
+	}
+
+	// The update is rejected when the category has been changed since the given version.
+	if c.Version != nil {
+		category.Version = *c.Version
+	}
+}
+
+func decodeCategoryModificationPayload(r io.ReadCloser) (*categoryModification, error) {
+	defer r.Close()
+
+	var category categoryModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("Unable to decode category modification JSON object: %v", err)
+	}
+
+	return &category, nil
+}
+
+type categoryShareCreation struct {
+	Username string `json:"username"`
+}
+
+func decodeCategoryShareCreationPayload(r io.ReadCloser) (*categoryShareCreation, error) {
+	defer r.Close()
+
+	var share categoryShareCreation
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&share); err != nil {
+		return nil, fmt.Errorf("Unable to decode category share JSON object: %v", err)
+	}
+
+	if share.Username == "" {
+		return nil, errors.New("The username is required")
+	}
+
+	return &share, nil
+}
+
+type invitationCreation struct {
+	// Email restricts the invitation to an address, any address can use it when empty.
+	Email string `json:"email"`
+}
+
+type invitationCreationResult struct {
+	Invitation *model.Token `json:"invitation"`
+
+	// Code is returned once, only its hash is stored.
+	Code string `json:"code"`
+}
+
+func decodeInvitationCreationPayload(r io.ReadCloser) (*invitationCreation, error) {
+	defer r.Close()
+
+	var invitation invitationCreation
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&invitation); err != nil {
+		return nil, fmt.Errorf("Unable to decode invitation JSON object: %v", err)
+	}
+
+	if invitation.Email != "" {
+		if err := model.ValidateEmail(invitation.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	return &invitation, nil
+}
+
+type serviceAccountModification struct {
+	Name        *string   `json:"name"`
+	Scopes      *[]string `json:"scopes"`
+	CategoryIDs *[]int64  `json:"category_ids"`
+}
+
+func (s *serviceAccountModification) Update(account *model.ServiceAccount) {
+	if s.Name != nil {
+		account.Name = strings.TrimSpace(*s.Name)
+	}
+
+	if s.Scopes != nil {
+		account.Scopes = *s.Scopes
+	}
+
+	if s.CategoryIDs != nil {
+		account.CategoryIDs = *s.CategoryIDs
+	}
+}
+
+func decodeServiceAccountPayload(r io.ReadCloser) (*serviceAccountModification, error) {
+	defer r.Close()
+
+	var modification serviceAccountModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&modification); err != nil {
+		return nil, fmt.Errorf("Unable to decode service account JSON object: %v", err)
+	}
+
+	return &modification, nil
+}
+
+type apiKeyCreation struct {
+	Description string `json:"description"`
+}
+
+type apiKeyCreationResult struct {
+	APIKey *model.APIKey `json:"api_key"`
+
+	// Key is returned once, only its hash is stored.
+	Key string `json:"key"`
+}
+
+func decodeAPIKeyCreationPayload(r io.ReadCloser) (*apiKeyCreation, error) {
+	defer r.Close()
+
+	var creation apiKeyCreation
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&creation); err != nil {
+		return nil, fmt.Errorf("Unable to decode API key JSON object: %v", err)
+	}
+
+	return &creation, nil
+}
+
 func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 	var category model.Category
 
@@ -221,3 +782,95 @@ func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 
 	return &category, nil
 }
+
+// batchRequest is a sub-request of a batch, the path starts with /v1/ and can have a query string.
+type batchRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Body   interface{} `json:"body"`
+}
+
+// batchResponse is the status and the body of a sub-request, JSON bodies are embedded as is.
+type batchResponse struct {
+	Status int         `json:"status"`
+	Body   interface{} `json:"body"`
+}
+
+func newBatchResponse(status int, contentType string, body []byte) *batchResponse {
+	response := &batchResponse{Status: status}
+	switch {
+	case len(body) == 0:
+	case strings.HasPrefix(contentType, "application/json") && json.Valid(body):
+		response.Body = json.RawMessage(body)
+	default:
+		response.Body = string(body)
+	}
+	return response
+}
+
+func newBatchError(status int, err error) *batchResponse {
+	return &batchResponse{Status: status, Body: map[string]string{"error_message": err.Error()}}
+}
+
+func decodeBatchPayload(r io.ReadCloser) ([]*batchRequest, error) {
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read batch requests: %v", err)
+	}
+
+	var requests []*batchRequest
+	if err := decodeJSON(data, &requests); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// encodeBatchBody returns the body of a sub-request, numbers are kept as decoded.
+func encodeBatchBody(body interface{}) (io.Reader, error) {
+	if body == nil {
+		return http.NoBody, nil
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to encode the request body: %v", err)
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+// decodeJSON keeps numbers as json.Number to distinguish integers from floats.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return nil
+}
+
+func toInteger(value interface{}) (int64, bool) {
+	if n, ok := value.(json.Number); ok {
+		i, err := n.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// encodeLogEntry returns the data of a log event, a message is always encodable.
+func encodeLogEntry(entry *logger.Entry) []byte {
+	data, _ := json.Marshal(entry)
+	return data
+}
@@ -5,7 +5,12 @@
 package api // import "miniflux.app/api"
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"miniflux.app/model"
 )
@@ -106,6 +111,39 @@ func TestUpdateFeedTitleWhenNotSet(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedWatchSelector(t *testing.T) {
+	selector := "#content"
+	changes := &feedModification{WatchSelector: &selector}
+	feed := &model.Feed{WatchSelector: "#main"}
+	changes.Update(feed)
+
+	if feed.WatchSelector != selector {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.WatchSelector, selector)
+	}
+}
+
+func TestUpdateFeedWatchSelectorWithEmptyString(t *testing.T) {
+	selector := ""
+	changes := &feedModification{WatchSelector: &selector}
+	feed := &model.Feed{WatchSelector: "#main"}
+	changes.Update(feed)
+
+	if feed.WatchSelector != "#main" {
+		t.Fatal(`The WatchSelector should not be modified`)
+	}
+}
+
+func TestUpdateFeedWatchSelectorOfRegularFeed(t *testing.T) {
+	selector := "#content"
+	changes := &feedModification{WatchSelector: &selector}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if feed.IsPageWatch() {
+		t.Fatal(`A regular feed should not become a watched page`)
+	}
+}
+
 func TestUpdateFeedUsername(t *testing.T) {
 	username := "Alice"
 	changes := &feedModification{Username: &username}
@@ -170,6 +208,125 @@ func TestUpdateFeedCategoryWhenNotSet(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedMutedUntil(t *testing.T) {
+	mutedUntil := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	changes := &feedModification{MutedUntil: &mutedUntil}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.IsMuted() {
+		t.Fatal(`The feed should be muted`)
+	}
+
+	mutedUntil = ""
+	changes.Update(feed)
+
+	if feed.MutedUntil != nil {
+		t.Fatal(`An empty value should unmute the feed`)
+	}
+}
+
+func TestDecodeFeedModificationPayloadWithInvalidMutedUntil(t *testing.T) {
+	_, err := decodeFeedModificationPayload(ioutil.NopCloser(strings.NewReader(`{"muted_until": "tomorrow"}`)))
+	if err == nil {
+		t.Fatal(`An invalid muted_until date should generate an error`)
+	}
+}
+
+func TestUpdateFeedMarkReadAfterDays(t *testing.T) {
+	days := 3
+	changes := &feedModification{MarkReadAfter: &days}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if feed.MarkReadAfterDays != days {
+		t.Fatalf(`Unexpected value, got %d instead of %d`, feed.MarkReadAfterDays, days)
+	}
+}
+
+func TestUpdateFeedEntryLimit(t *testing.T) {
+	maxEntries := 50
+	changes := &feedModification{MaxEntries: &maxEntries}
+	feed := &model.Feed{OverflowPolicy: model.OverflowPolicyDelete}
+	changes.Update(feed)
+
+	if feed.MaxEntries != maxEntries || feed.OverflowPolicy != model.OverflowPolicyDelete {
+		t.Fatalf(`Unexpected values, got %d and %q`, feed.MaxEntries, feed.OverflowPolicy)
+	}
+}
+
+func TestUpdateFeedEntryMatching(t *testing.T) {
+	matching := model.EntryMatchingURL
+	changes := &feedModification{EntryMatching: &matching}
+	feed := &model.Feed{EntryMatching: model.EntryMatchingGUID}
+	changes.Update(feed)
+
+	if feed.EntryMatching != matching {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.EntryMatching, matching)
+	}
+}
+
+func TestUpdateFeedScraperMaxPages(t *testing.T) {
+	pages := 3
+	changes := &feedModification{ScraperMaxPages: &pages}
+	feed := &model.Feed{Crawler: true}
+	changes.Update(feed)
+
+	if feed.ScraperMaxPages != pages || !feed.Crawler {
+		t.Fatalf(`Unexpected values, got %d and %v`, feed.ScraperMaxPages, feed.Crawler)
+	}
+}
+
+func TestUpdateFeedSummaryWords(t *testing.T) {
+	words := 50
+	changes := &feedModification{SummaryWords: &words}
+	feed := &model.Feed{Crawler: true}
+	changes.Update(feed)
+
+	if feed.SummaryWords != words || !feed.Crawler {
+		t.Fatalf(`Unexpected values, got %d and %v`, feed.SummaryWords, feed.Crawler)
+	}
+}
+
+func TestUpdateFeedSummarizeEntries(t *testing.T) {
+	enabled := true
+	changes := &feedModification{SummarizeEntries: &enabled}
+	feed := &model.Feed{SummaryWords: 50}
+	changes.Update(feed)
+
+	if !feed.SummarizeEntries || feed.SummaryWords != 50 {
+		t.Fatalf(`Unexpected values, got %v and %d`, feed.SummarizeEntries, feed.SummaryWords)
+	}
+}
+
+func TestUpdateCategoryMarkReadAfterDaysKeepsTitle(t *testing.T) {
+	days := 7
+	changes := &categoryModification{MarkReadAfterDays: &days}
+	category := &model.Category{Title: "News"}
+	changes.Update(category)
+
+	if category.Title != "News" || category.MarkReadAfterDays != days {
+		t.Fatalf(`Unexpected category, got %q and %d`, category.Title, category.MarkReadAfterDays)
+	}
+}
+
+func TestUpdateImageDisplay(t *testing.T) {
+	mode := model.ImageDisplayBlur
+
+	feed := &model.Feed{}
+	(&feedModification{ImageDisplay: &mode}).Update(feed)
+
+	category := &model.Category{}
+	(&categoryModification{ImageDisplay: &mode}).Update(category)
+
+	user := &model.User{}
+	(&userModification{ImageDisplay: &mode}).Update(user)
+
+	if feed.ImageDisplay != mode || category.ImageDisplay != mode || user.ImageDisplay != mode {
+		t.Fatalf(`Unexpected values, got %q, %q and %q`, feed.ImageDisplay, category.ImageDisplay, user.ImageDisplay)
+	}
+}
+
 func TestUpdateUserTheme(t *testing.T) {
 	theme := "Example 2"
 	changes := &userModification{Theme: &theme}
@@ -190,3 +347,137 @@ func TestUserThemeWhenNotSet(t *testing.T) {
 		t.Fatal(`The user Theme should not be modified`)
 	}
 }
+
+func TestUpdateUserQuietHours(t *testing.T) {
+	start := "22:00"
+	end := "07:00"
+	changes := &userModification{QuietHoursStart: &start, QuietHoursEnd: &end}
+	user := &model.User{}
+	changes.Update(user)
+
+	if user.QuietHoursStart != start || user.QuietHoursEnd != end {
+		t.Fatalf(`Unexpected quiet hours, got %q-%q`, user.QuietHoursStart, user.QuietHoursEnd)
+	}
+}
+
+func TestUpdateUserShowAbsoluteTime(t *testing.T) {
+	showAbsoluteTime := true
+	changes := &userModification{ShowAbsoluteTime: &showAbsoluteTime}
+	user := &model.User{}
+	changes.Update(user)
+
+	if !user.ShowAbsoluteTime {
+		t.Fatal(`The user ShowAbsoluteTime should be modified`)
+	}
+}
+
+func TestGroupEntriesByDay(t *testing.T) {
+	entries := model.Entries{
+		{ID: 1, Date: time.Date(2019, time.March, 5, 9, 0, 0, 0, time.UTC)},
+		{ID: 2, Date: time.Date(2019, time.March, 5, 8, 0, 0, 0, time.UTC)},
+		{ID: 3, Date: time.Date(2019, time.March, 4, 23, 0, 0, 0, time.UTC)},
+	}
+
+	counts := model.EntryDays{
+		{Day: "2019-03-05", Count: 2},
+		{Day: "2019-03-04", Count: 7},
+	}
+
+	days := groupEntriesByDay(entries, counts)
+	if len(days) != 2 {
+		t.Fatalf(`Unexpected number of days, got %d`, len(days))
+	}
+
+	if days[0].Day != "2019-03-05" || days[0].Count != 2 || len(days[0].Entries) != 2 {
+		t.Errorf(`Unexpected first day: %s, count=%d, entries=%d`, days[0].Day, days[0].Count, len(days[0].Entries))
+	}
+
+	if days[1].Day != "2019-03-04" || days[1].Count != 7 || len(days[1].Entries) != 1 || days[1].Entries[0].ID != 3 {
+		t.Errorf(`Unexpected second day: %s, count=%d, entries=%d`, days[1].Day, days[1].Count, len(days[1].Entries))
+	}
+}
+
+func TestGroupEntriesByDayWithoutEntries(t *testing.T) {
+	days := groupEntriesByDay(nil, nil)
+	if days == nil || len(days) != 0 {
+		t.Fatalf(`An empty list of days is expected, got %v`, days)
+	}
+}
+
+func TestGroupEntriesByStory(t *testing.T) {
+	entries := model.Entries{
+		{ID: 5, ClusterID: 2},
+		{ID: 4, ClusterID: 4},
+		{ID: 2, ClusterID: 2},
+	}
+
+	members := model.Entries{
+		{ID: 1, ClusterID: 2},
+		{ID: 2, ClusterID: 2},
+		{ID: 4, ClusterID: 4},
+		{ID: 5, ClusterID: 2},
+	}
+
+	clusters := groupEntriesByStory(entries, members)
+	if len(clusters) != 2 {
+		t.Fatalf(`Unexpected number of clusters, got %d`, len(clusters))
+	}
+
+	if clusters[0].ClusterID != 2 || clusters[0].Representative.ID != 5 || len(clusters[0].Entries) != 2 {
+		t.Errorf(`Unexpected first cluster: %d, representative=%d, entries=%d`, clusters[0].ClusterID, clusters[0].Representative.ID, len(clusters[0].Entries))
+	}
+
+	if clusters[1].ClusterID != 4 || clusters[1].Representative.ID != 4 || len(clusters[1].Entries) != 0 {
+		t.Errorf(`Unexpected second cluster: %d, representative=%d, entries=%d`, clusters[1].ClusterID, clusters[1].Representative.ID, len(clusters[1].Entries))
+	}
+}
+
+func TestDecodeEntryBookmarkPayload(t *testing.T) {
+	modification, err := decodeEntryBookmarkPayload(ioutil.NopCloser(strings.NewReader(`{"entry_ids": [1, 2], "starred": true}`)))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(modification.EntryIDs) != 2 || !modification.Starred {
+		t.Errorf(`Unexpected payload: %+v`, modification)
+	}
+}
+
+func TestDecodeEntryBookmarkPayloadWithoutEntries(t *testing.T) {
+	if _, err := decodeEntryBookmarkPayload(ioutil.NopCloser(strings.NewReader(`{"entry_ids": [], "starred": true}`))); err == nil {
+		t.Fatal(`An empty list of entries should be rejected`)
+	}
+}
+
+func TestDecodeEntryScorePayload(t *testing.T) {
+	modification, err := decodeEntryScorePayload(ioutil.NopCloser(strings.NewReader(`{"scores": [{"entry_id": 1, "score": 0.5}, {"entry_id": 2, "score": -3}]}`)))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(modification.Scores) != 2 || modification.Scores[0].Score != 0.5 || modification.Scores[1].EntryID != 2 {
+		t.Errorf(`Unexpected payload: %+v`, modification)
+	}
+}
+
+func TestDecodeEntryScorePayloadWithoutEntryID(t *testing.T) {
+	if _, err := decodeEntryScorePayload(ioutil.NopCloser(strings.NewReader(`{"scores": [{"score": 1}]}`))); err == nil {
+		t.Fatal(`A score without entry ID should be rejected`)
+	}
+
+	if _, err := decodeEntryScorePayload(ioutil.NopCloser(strings.NewReader(`{"scores": []}`))); err == nil {
+		t.Fatal(`An empty list of scores should be rejected`)
+	}
+}
+
+func TestDecodeFeedCategoryPayloadWithTooManyFeeds(t *testing.T) {
+	ids := make([]string, maxBatchSize+1)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	payload := fmt.Sprintf(`{"feed_ids": [%s], "category_id": 1}`, strings.Join(ids, ","))
+	if _, err := decodeFeedCategoryPayload(ioutil.NopCloser(strings.NewReader(payload))); err == nil {
+		t.Fatal(`A list of feeds bigger than the batch size should be rejected`)
+	}
+}
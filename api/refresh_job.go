@@ -0,0 +1,28 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getRefreshJob(w http.ResponseWriter, r *http.Request) {
+	jobID := request.RouteInt64Param(r, "jobID")
+	refreshJob, err := h.store.RefreshJob(r.Context(), request.UserID(r), jobID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if refreshJob == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, refreshJob)
+}
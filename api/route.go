@@ -0,0 +1,106 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+)
+
+// route describes an API endpoint, it is used to register the handler and to generate the OpenAPI document.
+type route struct {
+	method       string
+	path         string
+	handler      func(*handler, http.ResponseWriter, *http.Request)
+	operationID  string
+	summary      string
+	tag          string
+	parameters   []*parameter
+	body         interface{}
+	bodyType     string
+	bodyRequired []string
+	status       int
+	response     interface{}
+	responseType string
+
+	// idempotent routes execute once the requests sent with the same Idempotency-Key header.
+	idempotent bool
+
+	// versioned routes reject the updates based on a stale version with a conflict.
+	versioned bool
+
+	// admin routes manage the users and the subscriptions of the instance, the write freeze of the calling user does not apply.
+	admin bool
+
+	// safe routes do not change anything even if their method is not GET, they are allowed on read-only instances.
+	safe bool
+
+	// categoryFiltered routes only return or change the data of the categories allowed to the service account,
+	// service accounts restricted to some categories can call them without a category in the path.
+	categoryFiltered bool
+}
+
+// isMutation returns true if the route changes data, it is rejected on read-only instances.
+func (r *route) isMutation() bool {
+	return r.method != "GET" && !r.safe
+}
+
+// scope returns the scope required to call the route with a service account.
+// The batch route has no scope since each of its requests is checked.
+func (r *route) scope() string {
+	switch {
+	case r.tag == "batch":
+		return ""
+	case r.isMutation():
+		return r.tag + ":write"
+	default:
+		return r.tag + ":read"
+	}
+}
+
+func (r *route) handlerFunc(h *handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.handler(h, w, req)
+	})
+}
+
+// parameter describes a query string parameter.
+type parameter struct {
+	name        string
+	description string
+	kind        string
+	list        bool
+	enum        []string
+}
+
+// withoutParameter returns a copy of the list without the given parameter.
+func withoutParameter(params []*parameter, name string) []*parameter {
+	var result []*parameter
+	for _, param := range params {
+		if param.name != name {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+func queryString(name, description string, enum ...string) *parameter {
+	return &parameter{name: name, description: description, kind: "string", enum: enum}
+}
+
+func queryStringList(name, description string, enum ...string) *parameter {
+	return &parameter{name: name, description: description, kind: "string", list: true, enum: enum}
+}
+
+func queryInteger(name, description string) *parameter {
+	return &parameter{name: name, description: description, kind: "integer"}
+}
+
+func queryNumber(name, description string) *parameter {
+	return &parameter{name: name, description: description, kind: "number"}
+}
+
+func queryIntegerList(name, description string) *parameter {
+	return &parameter{name: name, description: description, kind: "integer", list: true}
+}
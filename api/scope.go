@@ -0,0 +1,127 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+var errCategoryNotAllowed = errors.New("This service account does not have access to this category")
+
+// scopes rejects the requests of service accounts without the scope of the route.
+// The accounts restricted to some categories can only reach the categories, feeds and entries of those categories.
+type scopes struct {
+	store *storage.Storage
+	route *route
+}
+
+func newScopes(store *storage.Storage, route *route) *scopes {
+	return &scopes{store, route}
+}
+
+func (s *scopes) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !request.IsServiceAccount(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		scope := s.route.scope()
+		if scope == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		account := serviceAccountFromRequest(r)
+		if !account.HasScope(scope) {
+			json.ForbiddenError(w, r, fmt.Errorf("This service account does not have the %q scope", scope))
+			return
+		}
+
+		if account.IsRestricted() {
+			allowed, err := s.isAllowed(r, account)
+			if err != nil {
+				json.ServerError(w, r, err)
+				return
+			}
+
+			if !allowed {
+				json.ForbiddenError(w, r, errCategoryNotAllowed)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isAllowed checks the category of the resources in the path, the routes without resource
+// are allowed only if they filter their data themselves.
+func (s *scopes) isAllowed(r *http.Request, account *model.ServiceAccount) (bool, error) {
+	vars := mux.Vars(r)
+	userID := request.UserID(r)
+	found := false
+
+	if _, ok := vars["categoryID"]; ok {
+		found = true
+		if !account.HasCategory(request.RouteInt64Param(r, "categoryID")) {
+			return false, nil
+		}
+	}
+
+	if _, ok := vars["feedID"]; ok {
+		found = true
+		feed, err := s.store.FeedByID(r.Context(), userID, request.RouteInt64Param(r, "feedID"))
+		if err != nil {
+			return false, err
+		}
+
+		if feed == nil || !account.HasCategory(feed.Category.ID) {
+			return false, nil
+		}
+	}
+
+	if _, ok := vars["entryID"]; ok {
+		found = true
+		builder := s.store.NewEntryQueryBuilder(userID)
+		builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+		entry, err := builder.GetEntry(r.Context())
+		if err != nil {
+			return false, err
+		}
+
+		if entry == nil || !account.HasCategory(entry.Feed.Category.ID) {
+			return false, nil
+		}
+	}
+
+	return found || s.route.categoryFiltered || s.route.tag == "users", nil
+}
+
+func serviceAccountFromRequest(r *http.Request) *model.ServiceAccount {
+	return &model.ServiceAccount{
+		UserID:      request.UserID(r),
+		Scopes:      request.ServiceAccountScopes(r),
+		CategoryIDs: request.ServiceAccountCategoryIDs(r),
+	}
+}
+
+// isCategoryAllowed returns false if the request comes from a service account restricted to other categories.
+func isCategoryAllowed(r *http.Request, categoryID int64) bool {
+	if !request.IsServiceAccount(r) {
+		return true
+	}
+
+	return serviceAccountFromRequest(r).HasCategory(categoryID)
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/http/request"
+	"miniflux.app/model"
+)
+
+func TestRouteScope(t *testing.T) {
+	scenarios := []struct {
+		route    *route
+		expected string
+	}{
+		{&route{method: "GET", tag: "entries"}, model.ScopeEntriesRead},
+		{&route{method: "PUT", tag: "entries"}, model.ScopeEntriesWrite},
+		{&route{method: "POST", tag: "feeds", safe: true}, model.ScopeFeedsRead},
+		{&route{method: "POST", tag: "batch"}, ""},
+	}
+
+	for _, scenario := range scenarios {
+		if scope := scenario.route.scope(); scope != scenario.expected {
+			t.Errorf(`Unexpected scope for %s %s, got %q instead of %q`, scenario.route.method, scenario.route.tag, scope, scenario.expected)
+		}
+	}
+}
+
+func TestServiceAccountScopesMatchRoutes(t *testing.T) {
+	for _, scope := range model.ServiceAccountScopes {
+		found := false
+		for _, r := range routes {
+			if r.scope() == scope {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf(`The scope %q does not give access to any route`, scope)
+		}
+	}
+}
+
+func TestServiceAccountsCannotManageServiceAccounts(t *testing.T) {
+	for _, r := range routes {
+		if r.tag != "service-accounts" {
+			continue
+		}
+
+		for _, scope := range model.ServiceAccountScopes {
+			if r.scope() == scope {
+				t.Errorf(`The route %s %s should not be reachable with the %q scope`, r.method, r.path, scope)
+			}
+		}
+	}
+}
+
+func TestRestrictedServiceAccountCannotMoveFeedToAnotherCategory(t *testing.T) {
+	r := httptest.NewRequest("PUT", "/v1/feeds/1", nil)
+	ctx := context.WithValue(r.Context(), request.ServiceAccountScopesContextKey, []string{model.ScopeFeedsWrite})
+	ctx = context.WithValue(ctx, request.ServiceAccountCategoryIDsContextKey, []int64{1})
+	r = r.WithContext(ctx)
+
+	feed := &model.Feed{ID: 1, Category: &model.Category{ID: 1}}
+	if !isCategoryAllowed(r, feed.Category.ID) {
+		t.Fatal(`The service account should have access to the current category of the feed`)
+	}
+
+	changes, err := decodeFeedModificationPayload(ioutil.NopCloser(strings.NewReader(`{"category_id": 2}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes.Update(feed)
+	if isCategoryAllowed(r, feed.Category.ID) {
+		t.Error(`The service account should not be allowed to move the feed to another category`)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	accounts, err := h.store.ServiceAccounts(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, accounts)
+}
+
+func (h *handler) getServiceAccount(w http.ResponseWriter, r *http.Request) {
+	account, err := h.store.ServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, account)
+}
+
+func (h *handler) createServiceAccount(w http.ResponseWriter, r *http.Request) {
+	modification, err := decodeServiceAccountPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	account := &model.ServiceAccount{UserID: request.UserID(r), Scopes: []string{}, CategoryIDs: []int64{}}
+	modification.Update(account)
+
+	if err := h.validateServiceAccount(r, account); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.ServiceAccountExists(r.Context(), account.UserID, account.Name) {
+		json.BadRequest(w, r, errors.New("This service account already exists"))
+		return
+	}
+
+	if err := h.store.CreateServiceAccount(r.Context(), account); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, account)
+}
+
+func (h *handler) updateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	modification, err := decodeServiceAccountPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	account, err := h.store.ServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	name := account.Name
+	modification.Update(account)
+
+	if err := h.validateServiceAccount(r, account); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if account.Name != name && h.store.ServiceAccountExists(r.Context(), account.UserID, account.Name) {
+		json.BadRequest(w, r, errors.New("This service account already exists"))
+		return
+	}
+
+	if err := h.store.UpdateServiceAccount(r.Context(), account); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, account)
+}
+
+func (h *handler) removeServiceAccount(w http.ResponseWriter, r *http.Request) {
+	removed, err := h.store.RemoveServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !removed {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getAPIKeys(w http.ResponseWriter, r *http.Request) {
+	account, err := h.store.ServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	keys, err := h.store.APIKeys(r.Context(), account.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, keys)
+}
+
+// createAPIKey returns the key once since only its hash is stored.
+func (h *handler) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	creation, err := decodeAPIKeyCreationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	account, err := h.store.ServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	key := &model.APIKey{ServiceAccountID: account.ID, Description: creation.Description}
+	value, err := h.store.CreateAPIKey(r.Context(), key)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &apiKeyCreationResult{APIKey: key, Key: value})
+}
+
+func (h *handler) removeAPIKey(w http.ResponseWriter, r *http.Request) {
+	account, err := h.store.ServiceAccount(r.Context(), request.UserID(r), request.RouteInt64Param(r, "serviceAccountID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if account == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	removed, err := h.store.RemoveAPIKey(r.Context(), account.ID, request.RouteInt64Param(r, "keyID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !removed {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+// validateServiceAccount makes sure the scopes are valid and the categories belong to the owner.
+func (h *handler) validateServiceAccount(r *http.Request, account *model.ServiceAccount) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+
+	for _, categoryID := range account.CategoryIDs {
+		if !h.store.CategoryExists(r.Context(), account.UserID, categoryID) {
+			return fmt.Errorf("The category #%d doesn't exists or doesn't belongs to this user", categoryID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+const (
+	defaultSyncEntries = 1000
+	maxSyncEntries     = 10000
+)
+
+// syncEntries returns the entries changed since the sync token, without token all the entries are returned.
+func (h *handler) syncEntries(w http.ResponseWriter, r *http.Request) {
+	var token *model.SyncToken
+	if value := request.QueryStringParam(r, "token", ""); value != "" {
+		var err error
+		if token, err = model.ParseSyncToken(value); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	limit := request.QueryIntParam(r, "limit", defaultSyncEntries)
+	if limit < 1 || limit > maxSyncEntries {
+		json.BadRequest(w, r, fmt.Errorf("The limit must be between 1 and %d", maxSyncEntries))
+		return
+	}
+
+	changes, err := h.store.EntryChanges(r.Context(), request.UserID(r), token, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, changes)
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getTrash(w http.ResponseWriter, r *http.Request) {
+	trash, err := h.store.Trash(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, trash)
+}
+
+func (h *handler) restoreFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	feed, err := h.store.TrashedFeed(r.Context(), userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if h.store.FeedURLExists(r.Context(), userID, feed.FeedURL) {
+		json.BadRequest(w, r, errors.New("This feed_url already exists"))
+		return
+	}
+
+	if !h.store.CategoryExists(r.Context(), userID, feed.Category.ID) {
+		json.BadRequest(w, r, errors.New("The category of this feed is in the trash, restore the category instead"))
+		return
+	}
+
+	if err := h.store.RestoreFeed(r.Context(), userID, feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) restoreCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	category, err := h.store.TrashedCategory(r.Context(), userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	existingCategory, err := h.store.CategoryByTitle(r.Context(), userID, category.Title)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if existingCategory != nil {
+		json.BadRequest(w, r, errors.New("This category already exists"))
+		return
+	}
+
+	if err := h.store.RestoreCategory(r.Context(), userID, categoryID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
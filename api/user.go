@@ -7,13 +7,14 @@ package api // import "miniflux.app/api"
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 )
 
 func (h *handler) currentUser(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -22,6 +23,51 @@ func (h *handler) currentUser(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, user)
 }
 
+// deleteCurrentUser closes the account of the authenticated user, administrators are removed by another administrator.
+func (h *handler) deleteCurrentUser(w http.ResponseWriter, r *http.Request) {
+	if request.IsAdminUser(r) {
+		json.ForbiddenError(w, r, errors.New("An administrator account must be removed by another administrator"))
+		return
+	}
+
+	if err := h.store.RequestUserDeletion(r.Context(), request.UserID(r)); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) keyboardShortcuts(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, user.KeyboardShortcuts.WithDefaults())
+}
+
+func (h *handler) updateKeyboardShortcuts(w http.ResponseWriter, r *http.Request) {
+	shortcuts, err := decodeKeyboardShortcutsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := shortcuts.Validate(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateKeyboardShortcuts(r.Context(), request.UserID(r), shortcuts); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, shortcuts.WithDefaults())
+}
+
 func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
 	if !request.IsAdminUser(r) {
 		json.Forbidden(w, r)
@@ -39,12 +85,12 @@ func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if h.store.UserExists(user.Username) {
+	if h.store.UserExists(r.Context(), user.Username) {
 		json.BadRequest(w, r, errors.New("This user already exists"))
 		return
 	}
 
-	err = h.store.CreateUser(user)
+	err = h.store.CreateUser(r.Context(), user)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -67,7 +113,7 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	originalUser, err := h.store.UserByID(userID)
+	originalUser, err := h.store.UserByID(r.Context(), userID)
 	if err != nil {
 		json.BadRequest(w, r, errors.New("Unable to fetch this user from the database"))
 		return
@@ -84,7 +130,12 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = h.store.UpdateUser(originalUser); err != nil {
+	if originalUser.Email != "" && h.store.AnotherEmailExists(r.Context(), originalUser.ID, originalUser.Email) {
+		json.BadRequest(w, r, errors.New("This email address is already used"))
+		return
+	}
+
+	if err = h.store.UpdateUser(r.Context(), originalUser); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -98,7 +149,7 @@ func (h *handler) users(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	users, err := h.store.Users()
+	users, err := h.store.Users(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -115,7 +166,7 @@ func (h *handler) userByID(w http.ResponseWriter, r *http.Request) {
 	}
 
 	userID := request.RouteInt64Param(r, "userID")
-	user, err := h.store.UserByID(userID)
+	user, err := h.store.UserByID(r.Context(), userID)
 	if err != nil {
 		json.BadRequest(w, r, errors.New("Unable to fetch this user from the database"))
 		return
@@ -137,7 +188,7 @@ func (h *handler) userByUsername(w http.ResponseWriter, r *http.Request) {
 	}
 
 	username := request.RouteStringParam(r, "username")
-	user, err := h.store.UserByUsername(username)
+	user, err := h.store.UserByUsername(r.Context(), username)
 	if err != nil {
 		json.BadRequest(w, r, errors.New("Unable to fetch this user from the database"))
 		return
@@ -158,7 +209,7 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	userID := request.RouteInt64Param(r, "userID")
-	user, err := h.store.UserByID(userID)
+	user, err := h.store.UserByID(r.Context(), userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -169,10 +220,72 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.RemoveUser(user.ID); err != nil {
+	if err := h.store.RemoveUser(r.Context(), user.ID); err != nil {
 		json.BadRequest(w, r, errors.New("Unable to remove this user from the database"))
 		return
 	}
 
 	json.NoContent(w, r)
 }
+
+func (h *handler) freezeUser(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	freeze, err := decodeUserFreezePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.RouteInt64Param(r, "userID")
+	user, err := h.store.UserByID(r.Context(), userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	until := time.Now().Add(time.Duration(freeze.Duration) * time.Second)
+	if err := h.store.FreezeUser(r.Context(), user.ID, until, freeze.Message); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	user.FrozenUntil = &until
+	user.FreezeMessage = freeze.Message
+	user.UseTimezone(request.UserTimezone(r))
+	json.OK(w, r, user)
+}
+
+func (h *handler) unfreezeUser(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	userID := request.RouteInt64Param(r, "userID")
+	user, err := h.store.UserByID(r.Context(), userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.UnfreezeUser(r.Context(), user.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
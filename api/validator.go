@@ -0,0 +1,253 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+
+	"github.com/gorilla/mux"
+)
+
+const maxValidatedBodySize = 10 << 20
+
+// validator rejects requests that do not match the OpenAPI operation of the route.
+type validator struct {
+	schemas   map[string]*schema
+	operation *operation
+}
+
+func newValidator(d *openAPIDocument, r *route) *validator {
+	return &validator{schemas: d.Components.Schemas, operation: d.operations[r]}
+}
+
+func (v *validator) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.validateParameters(r); err != nil {
+			logger.Debug("[API:Validator] %s %s => %v", r.Method, r.URL.Path, err)
+			json.BadRequest(w, r, err)
+			return
+		}
+
+		if err := v.validateBody(w, r); err != nil {
+			logger.Debug("[API:Validator] %s %s => %v", r.Method, r.URL.Path, err)
+			json.BadRequest(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (v *validator) validateParameters(r *http.Request) error {
+	for _, p := range v.operation.Parameters {
+		var values []string
+		switch p.In {
+		case "path":
+			if value, found := mux.Vars(r)[p.Name]; found {
+				values = []string{value}
+			}
+		case "header":
+			if value := r.Header.Get(p.Name); value != "" {
+				values = []string{value}
+			}
+		case "query":
+			if p.Schema.Type == "array" {
+				values = request.QueryStringParamList(r, p.Name)
+			} else if request.HasQueryParam(r, p.Name) {
+				values = []string{r.URL.Query().Get(p.Name)}
+			}
+		}
+
+		if len(values) == 0 {
+			if p.Required {
+				return fmt.Errorf("The parameter %q is required", p.Name)
+			}
+			continue
+		}
+
+		s := p.Schema
+		if s.Type == "array" {
+			s = s.Items
+		}
+
+		for _, value := range values {
+			if err := validateParameterValue(s, value); err != nil {
+				return fmt.Errorf("Invalid value for the parameter %q: %v", p.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateParameterValue(s *schema, value string) error {
+	switch s.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+	}
+
+	return validateEnum(s, value)
+}
+
+func (v *validator) validateBody(w http.ResponseWriter, r *http.Request) error {
+	if v.operation.RequestBody == nil {
+		return nil
+	}
+
+	media, found := v.operation.RequestBody.Content["application/json"]
+	if !found {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxValidatedBodySize))
+	r.Body.Close()
+	if err != nil {
+		return fmt.Errorf("Unable to read the request body: %v", err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fmt.Errorf("The request body is required")
+	}
+
+	var payload interface{}
+	if err := decodeJSON(data, &payload); err != nil {
+		return err
+	}
+
+	return v.validateValue(media.Schema, payload, "")
+}
+
+func (v *validator) resolve(s *schema) *schema {
+	for s.Ref != "" {
+		s = v.schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+	}
+	return s
+}
+
+func (v *validator) validateValue(s *schema, value interface{}, path string) error {
+	s = v.resolve(s)
+
+	if value == nil {
+		if s.Nullable || s.Type == "" && len(s.AllOf) == 0 {
+			return nil
+		}
+		return fmt.Errorf("%s must not be null", describePath(path))
+	}
+
+	for _, sub := range s.AllOf {
+		if err := v.validateValue(sub, value, path); err != nil {
+			return err
+		}
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be an object", describePath(path))
+		}
+
+		for _, name := range s.Required {
+			if _, found := object[name]; !found {
+				return fmt.Errorf("%s is required", describePath(joinPath(path, name)))
+			}
+		}
+
+		for name, item := range object {
+			property, found := s.Properties[name]
+			if !found {
+				property = s.AdditionalProperties
+			}
+
+			if property != nil {
+				if err := v.validateValue(property, item, joinPath(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be an array", describePath(path))
+		}
+
+		for i, item := range list {
+			if err := v.validateValue(s.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", describePath(path))
+		}
+
+		if err := validateEnum(s, str); err != nil {
+			return fmt.Errorf("%s: %v", describePath(path), err)
+		}
+	case "integer":
+		if _, ok := toInteger(value); !ok {
+			return fmt.Errorf("%s must be an integer", describePath(path))
+		}
+	case "number":
+		if _, ok := toNumber(value); !ok {
+			return fmt.Errorf("%s must be a number", describePath(path))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", describePath(path))
+		}
+	}
+
+	return nil
+}
+
+func validateEnum(s *schema, value string) error {
+	if len(s.Enum) == 0 {
+		return nil
+	}
+
+	for _, allowed := range s.Enum {
+		if value == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is not one of %s", value, strings.Join(s.Enum, ", "))
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func describePath(path string) string {
+	if path == "" {
+		return "The request body"
+	}
+	return fmt.Sprintf("The field %q", path)
+}
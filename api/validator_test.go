@@ -0,0 +1,96 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+type validatorTestPayload struct {
+	Title    string   `json:"title"`
+	Count    int64    `json:"count"`
+	Enabled  *bool    `json:"enabled"`
+	EntryIDs []int64  `json:"entry_ids"`
+	Ratio    float64  `json:"ratio"`
+	Tags     []string `json:"tags"`
+}
+
+func newValidatorTestRouter(body string) *mux.Router {
+	r := &route{
+		method:       "POST",
+		path:         "/feeds/{feedID}",
+		parameters:   []*parameter{queryInteger("limit", ""), queryNumber("min", ""), queryStringList("status", "", "read", "unread")},
+		body:         &validatorTestPayload{},
+		bodyRequired: []string{"title"},
+		handler: func(h *handler, w http.ResponseWriter, r *http.Request) {
+			data, _ := ioutil.ReadAll(r.Body)
+			if string(data) != body {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	}
+
+	router := mux.NewRouter()
+	router.Handle(r.path, newValidator(newOpenAPIDocument([]*route{r}), r).serve(r.handlerFunc(nil))).Methods(r.method)
+	return router
+}
+
+func TestValidator(t *testing.T) {
+	scenarios := []struct {
+		url    string
+		body   string
+		status int
+	}{
+		{"/feeds/1", `{"title": "x"}`, http.StatusNoContent},
+		{"/feeds/1?limit=10&status=read,unread", `{"title": "x", "count": 3, "enabled": null, "entry_ids": [1, 2], "ratio": 0.5, "unknown": true}`, http.StatusNoContent},
+		{"/feeds/abc", `{"title": "x"}`, http.StatusBadRequest},
+		{"/feeds/1?limit=abc", `{"title": "x"}`, http.StatusBadRequest},
+		{"/feeds/1?min=-0.5", `{"title": "x"}`, http.StatusNoContent},
+		{"/feeds/1?min=abc", `{"title": "x"}`, http.StatusBadRequest},
+		{"/feeds/1?status=read,invalid", `{"title": "x"}`, http.StatusBadRequest},
+		{"/feeds/1", ``, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x"`, http.StatusBadRequest},
+		{"/feeds/1", `[]`, http.StatusBadRequest},
+		{"/feeds/1", `{}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": 1}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": null}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x", "count": 1.5}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x", "enabled": "true"}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x", "entry_ids": ["1"]}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x", "ratio": "high"}`, http.StatusBadRequest},
+		{"/feeds/1", `{"title": "x", "tags": "a"}`, http.StatusBadRequest},
+	}
+
+	for _, scenario := range scenarios {
+		router := newValidatorTestRouter(scenario.body)
+		req := httptest.NewRequest("POST", scenario.url, strings.NewReader(scenario.body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != scenario.status {
+			t.Errorf(`Unexpected status code for %s %s: got %d instead of %d (%s)`, scenario.url, scenario.body, w.Code, scenario.status, w.Body.String())
+		}
+	}
+}
+
+func TestValidatorErrorMessage(t *testing.T) {
+	router := newValidatorTestRouter("")
+	req := httptest.NewRequest("POST", "/feeds/1", strings.NewReader(`{"title": "x", "entry_ids": [1, "2"]}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	expected := `{"error_message":"The field \"entry_ids[1]\" must be an integer"}`
+	if w.Body.String() != expected {
+		t.Errorf(`Unexpected error message, got %s instead of %s`, w.Body.String(), expected)
+	}
+}
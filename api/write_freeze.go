@@ -0,0 +1,42 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/storage"
+)
+
+// writeFreeze rejects the changes of the users frozen by an administrator,
+// clients are asked to retry after the end of the freeze.
+type writeFreeze struct {
+	store *storage.Storage
+}
+
+func newWriteFreeze(store *storage.Storage) *writeFreeze {
+	return &writeFreeze{store}
+}
+
+func (f *writeFreeze) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := f.store.UserByID(r.Context(), request.UserID(r))
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		now := time.Now()
+		if user == nil || !user.IsFrozen(now) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		json.ServiceUnavailable(w, r, user.FreezeError(), user.FreezeRetryAfter(now))
+	})
+}
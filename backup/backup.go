@@ -0,0 +1,239 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package backup // import "miniflux.app/backup"
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"miniflux.app/database"
+)
+
+const (
+	formatName    = "miniflux-backup"
+	formatVersion = 1
+)
+
+// tables are dumped in this order to satisfy foreign keys on restore.
+// Sessions, refresh jobs and archived feed responses are not saved, users have to log in again after a restore.
+var tables = []string{
+	"users",
+	"categories",
+	"feeds",
+	"icons",
+	"feed_icons",
+	"entries",
+	"enclosures",
+	"integrations",
+}
+
+// Header is the first record of a backup.
+type Header struct {
+	Format         string    `json:"format"`
+	Version        int       `json:"version"`
+	SchemaVersion  int       `json:"schema_version"`
+	CreatedAt      time.Time `json:"created_at"`
+	WithoutContent bool      `json:"without_content"`
+}
+
+// Record is a table row.
+type Record struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// Options changes the content of a backup.
+type Options struct {
+	// WithoutContent replaces the content of entries by an empty string to make the backup smaller.
+	WithoutContent bool
+}
+
+// Dump writes a consistent snapshot of the database, one JSON document per line.
+func Dump(db *sql.DB, w io.Writer, opts Options) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// All tables are read from the same snapshot.
+	if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY`); err != nil {
+		return fmt.Errorf("unable to set transaction isolation level: %v", err)
+	}
+
+	var schemaVersion int
+	if err := tx.QueryRow(`SELECT version FROM schema_version`).Scan(&schemaVersion); err != nil {
+		return fmt.Errorf("unable to fetch the schema version: %v", err)
+	}
+
+	encoder := json.NewEncoder(w)
+	header := &Header{
+		Format:         formatName,
+		Version:        formatVersion,
+		SchemaVersion:  schemaVersion,
+		CreatedAt:      time.Now(),
+		WithoutContent: opts.WithoutContent,
+	}
+	if err := encoder.Encode(header); err != nil {
+		return fmt.Errorf("unable to write backup header: %v", err)
+	}
+
+	for _, table := range tables {
+		if err := dumpTable(tx, encoder, table, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dumpTable(tx *sql.Tx, encoder *json.Encoder, table string, opts Options) error {
+	query := fmt.Sprintf(`SELECT to_jsonb(t) FROM %s t`, table)
+	switch table {
+	case "users":
+		// There is no conversion from a JSON object to hstore, the text representation is used instead.
+		query = `SELECT to_jsonb(t) || jsonb_build_object('extra', t.extra::text) FROM users t`
+	case "entries":
+		// The search index is rebuilt on restore, it is as big as the content.
+		query = `SELECT to_jsonb(t) - 'document_vectors' FROM entries t`
+		if opts.WithoutContent {
+			query = `SELECT jsonb_set(to_jsonb(t) - 'document_vectors', '{content}', '""') FROM entries t`
+		}
+	}
+
+	rows, err := tx.Query(query)
+	if err != nil {
+		return fmt.Errorf("unable to dump table %s: %v", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return fmt.Errorf("unable to dump table %s: %v", table, err)
+		}
+
+		if err := encoder.Encode(&Record{Table: table, Row: row}); err != nil {
+			return fmt.Errorf("unable to write backup: %v", err)
+		}
+	}
+
+	return rows.Err()
+}
+
+// Restore loads a backup into an empty database migrated to the same schema version.
+// All records are inserted in a single transaction.
+func Restore(db *sql.DB, r io.Reader) error {
+	decoder := json.NewDecoder(r)
+
+	var header Header
+	if err := decoder.Decode(&header); err != nil {
+		return fmt.Errorf("unable to read backup header: %v", err)
+	}
+
+	if header.Format != formatName || header.Version != formatVersion {
+		return fmt.Errorf("unsupported backup format %q version %d", header.Format, header.Version)
+	}
+
+	schemaVersion, err := database.CurrentSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	if header.SchemaVersion != schemaVersion {
+		return fmt.Errorf("the backup schema version %d does not match the database schema version %d", header.SchemaVersion, schemaVersion)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var users int
+	if err := tx.QueryRow(`SELECT count(*) FROM users`).Scan(&users); err != nil {
+		return fmt.Errorf("unable to count users: %v", err)
+	}
+
+	if users > 0 {
+		return fmt.Errorf("the database is not empty, a backup can only be restored into a new database")
+	}
+
+	statements := make(map[string]*sql.Stmt)
+	for _, table := range tables {
+		stmt, err := tx.Prepare(fmt.Sprintf(`INSERT INTO %s SELECT * FROM jsonb_populate_record(NULL::%s, $1)`, table, table))
+		if err != nil {
+			return fmt.Errorf("unable to prepare restore of table %s: %v", table, err)
+		}
+		defer stmt.Close()
+		statements[table] = stmt
+	}
+
+	for {
+		var record Record
+		err := decoder.Decode(&record)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read backup: %v", err)
+		}
+
+		stmt, found := statements[record.Table]
+		if !found {
+			return fmt.Errorf("unknown table %q in backup", record.Table)
+		}
+
+		if _, err := stmt.Exec([]byte(record.Row)); err != nil {
+			return fmt.Errorf("unable to restore a row of table %s: %v", record.Table, err)
+		}
+	}
+
+	if _, err := tx.Exec(`UPDATE entries SET document_vectors = to_tsvector(substring(title || ' ' || coalesce(content, '') for 1000000))`); err != nil {
+		return fmt.Errorf("unable to rebuild the search index: %v", err)
+	}
+
+	for _, table := range tables {
+		if err := resetSequence(tx, table); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit restore: %v", err)
+	}
+
+	return nil
+}
+
+// resetSequence moves the sequence of the primary key after the restored rows.
+func resetSequence(tx *sql.Tx, table string) error {
+	query := `
+		SELECT pg_get_serial_sequence(table_name, column_name)
+		FROM information_schema.columns
+		WHERE table_schema=current_schema() AND table_name=$1 AND column_name='id'
+	`
+
+	var sequence sql.NullString
+	err := tx.QueryRow(query, table).Scan(&sequence)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to find the sequence of table %s: %v", table, err)
+	case !sequence.Valid:
+		return nil
+	}
+
+	query = fmt.Sprintf(`SELECT setval($1, (SELECT coalesce(max(id), 0) + 1 FROM %s), false)`, table)
+	if _, err := tx.Exec(query, sequence.String); err != nil {
+		return fmt.Errorf("unable to reset the sequence of table %s: %v", table, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"miniflux.app/config"
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("blob: object not found")
+
+// Object is the content of a stored object, it must be closed by the caller.
+type Object struct {
+	io.ReadCloser
+	ContentType string
+}
+
+// Store keeps binary objects outside of the database.
+type Store interface {
+	// Get returns ErrNotFound when the object does not exist.
+	Get(key string) (*Object, error)
+
+	// NewWriter streams an object, it is visible once the writer is closed without error.
+	NewWriter(key, contentType string) (io.WriteCloser, error)
+
+	Delete(key string) error
+}
+
+// Put stores an object.
+func Put(s Store, key string, data []byte, contentType string) error {
+	w, err := s.NewWriter(key, contentType)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// New returns the store configured with BLOB_STORE_URL, or nil when large binaries are kept in the database.
+func New(cfg *config.Config) (Store, error) {
+	rawURL := cfg.BlobStoreURL()
+	if rawURL == "" {
+		return nil, nil
+	}
+
+	store, prefix, err := Open(cfg, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix == "" {
+		return store, nil
+	}
+
+	return &prefixedStore{store: store, prefix: prefix}, nil
+}
+
+// Open returns the store of a URL and the key of the URL in this store.
+// Supported URLs are file:///path/to/key, s3://bucket/path/to/key and gs://bucket/path/to/key.
+func Open(cfg *config.Config, rawURL string) (Store, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("blob: invalid URL %q: %v", rawURL, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return NewLocal("/"), strings.TrimPrefix(path.Clean(u.Path), "/"), nil
+	case "s3":
+		if u.Host == "" {
+			return nil, "", fmt.Errorf("blob: missing bucket in URL %q", rawURL)
+		}
+		s3 := NewS3(cfg.S3Endpoint(), cfg.S3Region(), cfg.S3AccessKeyID(), cfg.S3SecretAccessKey(), u.Host)
+		return s3, strings.TrimPrefix(u.Path, "/"), nil
+	case "gs":
+		if u.Host == "" {
+			return nil, "", fmt.Errorf("blob: missing bucket in URL %q", rawURL)
+		}
+		gcs, err := NewGCS(u.Host)
+		if err != nil {
+			return nil, "", err
+		}
+		return gcs, strings.TrimPrefix(u.Path, "/"), nil
+	default:
+		return nil, "", fmt.Errorf("blob: unsupported URL %q", rawURL)
+	}
+}
+
+// prefixedStore keeps all objects under a common path.
+type prefixedStore struct {
+	store  Store
+	prefix string
+}
+
+func (p *prefixedStore) Get(key string) (*Object, error) {
+	return p.store.Get(path.Join(p.prefix, key))
+}
+
+func (p *prefixedStore) NewWriter(key, contentType string) (io.WriteCloser, error) {
+	return p.store.NewWriter(path.Join(p.prefix, key), contentType)
+}
+
+func (p *prefixedStore) Delete(key string) error {
+	return p.store.Delete(path.Join(p.prefix, key))
+}
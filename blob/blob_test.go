@@ -0,0 +1,100 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestOpenS3URL(t *testing.T) {
+	os.Clearenv()
+
+	store, key, err := Open(config.NewConfig(), "s3://backups/miniflux/2019-01-01.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s3, ok := store.(*S3)
+	if !ok {
+		t.Fatalf(`Unexpected store type %T`, store)
+	}
+
+	if s3.bucket != "backups" || key != "miniflux/2019-01-01.json" {
+		t.Errorf(`Unexpected bucket and key, got %q and %q`, s3.bucket, key)
+	}
+
+	if s3.endpoint != "https://s3.us-east-1.amazonaws.com" {
+		t.Errorf(`Unexpected endpoint, got %q`, s3.endpoint)
+	}
+}
+
+func TestOpenFileURL(t *testing.T) {
+	store, key, err := Open(config.NewConfig(), "file:///var/lib/miniflux/../blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := store.(*Local); !ok {
+		t.Fatalf(`Unexpected store type %T`, store)
+	}
+
+	if key != "var/lib/blobs" {
+		t.Errorf(`Unexpected key, got %q`, key)
+	}
+}
+
+func TestOpenInvalidURL(t *testing.T) {
+	for _, rawURL := range []string{"https://backups/key", "s3:///key", "gs:///key", "/var/lib/miniflux"} {
+		if _, _, err := Open(config.NewConfig(), rawURL); err == nil {
+			t.Errorf(`The URL %q should be invalid`, rawURL)
+		}
+	}
+}
+
+func TestNewWithoutURL(t *testing.T) {
+	os.Clearenv()
+
+	store, err := New(config.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store != nil {
+		t.Errorf(`No store should be configured`)
+	}
+}
+
+func TestPrefixedLocalStore(t *testing.T) {
+	directory, err := ioutil.TempDir("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	os.Clearenv()
+	os.Setenv("BLOB_STORE_URL", "file://"+directory+"/prefix")
+
+	store, err := New(config.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Put(store, "icons/abc", []byte("data"), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(directory + "/prefix/icons/abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "data" {
+		t.Errorf(`Unexpected file content, got %q`, data)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+)
+
+// GCS stores objects in a Google Cloud Storage bucket.
+type GCS struct {
+	bucket *storage.BucketHandle
+}
+
+// NewGCS returns a store writing objects in the given bucket.
+func NewGCS(bucket string) (*GCS, error) {
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("gcs: unable to create client: %v", err)
+	}
+
+	return &GCS{bucket: client.Bucket(bucket)}, nil
+}
+
+// Get returns the content of an object.
+func (g *GCS) Get(key string) (*Object, error) {
+	r, err := g.bucket.Object(key).NewReader(context.Background())
+	if err == storage.ErrObjectNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("gcs: unable to read %q: %v", key, err)
+	}
+
+	return &Object{ReadCloser: r, ContentType: r.ContentType()}, nil
+}
+
+// NewWriter streams an object to the bucket.
+func (g *GCS) NewWriter(key, contentType string) (io.WriteCloser, error) {
+	w := g.bucket.Object(key).NewWriter(context.Background())
+	w.ContentType = contentType
+	return w, nil
+}
+
+// Delete removes an object, missing objects are ignored.
+func (g *GCS) Delete(key string) error {
+	err := g.bucket.Object(key).Delete(context.Background())
+	if err != nil && err != storage.ErrObjectNotExist {
+		return fmt.Errorf("gcs: unable to delete %q: %v", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// contentTypeSuffix is the suffix of the file holding the content type of an object.
+const contentTypeSuffix = ".content-type"
+
+// Local stores objects in a directory.
+type Local struct {
+	directory string
+}
+
+// NewLocal returns a store writing objects in the given directory.
+func NewLocal(directory string) *Local {
+	return &Local{directory: directory}
+}
+
+// Get returns the content of an object.
+func (l *Local) Get(key string) (*Object, error) {
+	filename, err := l.filename(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("blob: %v", err)
+	}
+
+	contentType, _ := ioutil.ReadFile(filename + contentTypeSuffix)
+	return &Object{ReadCloser: f, ContentType: string(contentType)}, nil
+}
+
+// NewWriter writes an object in a temporary file renamed on close.
+func (l *Local) NewWriter(key, contentType string) (io.WriteCloser, error) {
+	filename, err := l.filename(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return nil, fmt.Errorf("blob: %v", err)
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(filename), ".tmp-")
+	if err != nil {
+		return nil, fmt.Errorf("blob: %v", err)
+	}
+
+	return &localWriter{File: f, filename: filename, contentType: contentType}, nil
+}
+
+// Delete removes an object, missing objects are ignored.
+func (l *Local) Delete(key string) error {
+	filename, err := l.filename(key)
+	if err != nil {
+		return err
+	}
+
+	os.Remove(filename + contentTypeSuffix)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("blob: %v", err)
+	}
+
+	return nil
+}
+
+// filename rejects keys leaving the directory.
+func (l *Local) filename(key string) (string, error) {
+	root := filepath.Clean(l.directory)
+	filename := filepath.Join(root, filepath.FromSlash(key))
+
+	relative, err := filepath.Rel(root, filename)
+	if err != nil || relative == "." || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) || strings.HasSuffix(key, contentTypeSuffix) {
+		return "", fmt.Errorf("blob: invalid key %q", key)
+	}
+
+	return filename, nil
+}
+
+type localWriter struct {
+	*os.File
+	filename    string
+	contentType string
+}
+
+func (w *localWriter) Close() error {
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("blob: %v", err)
+	}
+
+	if w.contentType != "" {
+		if err := ioutil.WriteFile(w.filename+contentTypeSuffix, []byte(w.contentType), 0644); err != nil {
+			os.Remove(w.File.Name())
+			return fmt.Errorf("blob: %v", err)
+		}
+	}
+
+	if err := os.Rename(w.File.Name(), w.filename); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("blob: %v", err)
+	}
+
+	return nil
+}
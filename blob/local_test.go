@@ -0,0 +1,65 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	directory, err := ioutil.TempDir("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	store := NewLocal(directory)
+	if _, err := store.Get("icons/abc"); err != ErrNotFound {
+		t.Fatalf(`Missing objects should return ErrNotFound, got %v`, err)
+	}
+
+	if err := Put(store, "icons/abc", []byte("data"), "image/png"); err != nil {
+		t.Fatal(err)
+	}
+
+	object, err := store.Get("icons/abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := ioutil.ReadAll(object)
+	object.Close()
+
+	if string(data) != "data" {
+		t.Errorf(`Unexpected content, got %q`, data)
+	}
+
+	if object.ContentType != "image/png" {
+		t.Errorf(`Unexpected content type, got %q`, object.ContentType)
+	}
+
+	if err := store.Delete("icons/abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Get("icons/abc"); err != ErrNotFound {
+		t.Errorf(`Deleted objects should return ErrNotFound, got %v`, err)
+	}
+
+	if err := store.Delete("icons/abc"); err != nil {
+		t.Errorf(`Deleting a missing object should not fail, got %v`, err)
+	}
+}
+
+func TestLocalStoreRejectsInvalidKeys(t *testing.T) {
+	store := NewLocal("/var/lib/miniflux")
+	for _, key := range []string{"", ".", "../etc/passwd", "icons/../../etc", "icons/abc.content-type"} {
+		if _, err := store.NewWriter(key, ""); err == nil {
+			t.Errorf(`The key %q should be rejected`, key)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package blob // import "miniflux.app/blob"
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// s3PartSize is the size of each part of a multipart upload, S3 requires at least 5 MiB except for the last part.
+const s3PartSize = 8 << 20
+
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// S3 stores objects in a bucket of Amazon S3 or a compatible service, requests are signed with AWS Signature Version 4.
+type S3 struct {
+	bucket    string
+	endpoint  string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3 returns a store writing objects in the given bucket, an empty endpoint means the AWS endpoint of the region.
+func NewS3(endpoint, region, accessKey, secretKey, bucket string) *S3 {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+
+	return &S3{
+		bucket:    bucket,
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: 10 * time.Minute},
+		now:       time.Now,
+	}
+}
+
+// Get returns the content of an object.
+func (s *S3) Get(key string) (*Object, error) {
+	response, err := s.do("GET", key, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Object{ReadCloser: response.Body, ContentType: response.Header.Get("Content-Type")}, nil
+}
+
+// NewWriter returns a writer streaming an object with a multipart upload.
+// The object is created when the writer is closed, the upload is aborted if an error occurred.
+func (s *S3) NewWriter(key, contentType string) (io.WriteCloser, error) {
+	return &s3Writer{s3: s, key: key, contentType: contentType}, nil
+}
+
+// Delete removes an object.
+func (s *S3) Delete(key string) error {
+	response, err := s.do("DELETE", key, nil, nil, "")
+	if err != nil {
+		return err
+	}
+
+	response.Body.Close()
+	return nil
+}
+
+func (s *S3) put(key string, data []byte, contentType string) error {
+	response, err := s.do("PUT", key, nil, data, contentType)
+	if err != nil {
+		return err
+	}
+
+	response.Body.Close()
+	return nil
+}
+
+type s3Writer struct {
+	s3          *S3
+	key         string
+	contentType string
+	uploadID    string
+	etags       []string
+	buffer      bytes.Buffer
+	err         error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	n, _ := w.buffer.Write(p)
+	for w.buffer.Len() >= s3PartSize {
+		if err := w.uploadPart(w.buffer.Next(s3PartSize)); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+func (w *s3Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if w.uploadID == "" {
+		return w.s3.put(w.key, w.buffer.Bytes(), w.contentType)
+	}
+
+	if w.buffer.Len() > 0 {
+		if err := w.uploadPart(w.buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	var body bytes.Buffer
+	body.WriteString("<CompleteMultipartUpload>")
+	for i, etag := range w.etags {
+		fmt.Fprintf(&body, "<Part><PartNumber>%d</PartNumber><ETag>%s</ETag></Part>", i+1, etag)
+	}
+	body.WriteString("</CompleteMultipartUpload>")
+
+	response, err := w.s3.do("POST", w.key, url.Values{"uploadId": {w.uploadID}}, body.Bytes(), "")
+	if err != nil {
+		w.abort(err)
+		return err
+	}
+	defer response.Body.Close()
+
+	// S3 may return an error in the body of a successful response.
+	var result struct {
+		XMLName xml.Name
+		Message string
+	}
+	if err := xml.NewDecoder(response.Body).Decode(&result); err == nil && result.XMLName.Local == "Error" {
+		err = fmt.Errorf("s3: unable to complete the upload of %q: %s", w.key, result.Message)
+		w.abort(err)
+		return err
+	}
+
+	return nil
+}
+
+func (w *s3Writer) uploadPart(data []byte) error {
+	if w.uploadID == "" {
+		response, err := w.s3.do("POST", w.key, url.Values{"uploads": {""}}, nil, w.contentType)
+		if err != nil {
+			w.err = err
+			return err
+		}
+		defer response.Body.Close()
+
+		var result struct {
+			UploadID string `xml:"UploadId"`
+		}
+		if err := xml.NewDecoder(response.Body).Decode(&result); err != nil {
+			w.err = fmt.Errorf("s3: unable to start the upload of %q: %v", w.key, err)
+			return w.err
+		}
+		w.uploadID = result.UploadID
+	}
+
+	query := url.Values{"partNumber": {fmt.Sprint(len(w.etags) + 1)}, "uploadId": {w.uploadID}}
+	response, err := w.s3.do("PUT", w.key, query, data, "")
+	if err != nil {
+		w.abort(err)
+		return err
+	}
+	response.Body.Close()
+
+	w.etags = append(w.etags, response.Header.Get("ETag"))
+	return nil
+}
+
+func (w *s3Writer) abort(err error) {
+	w.err = err
+	if response, err := w.s3.do("DELETE", w.key, url.Values{"uploadId": {w.uploadID}}, nil, ""); err == nil {
+		response.Body.Close()
+	}
+}
+
+func (s *S3) do(method, key string, query url.Values, payload []byte, contentType string) (*http.Response, error) {
+	endpoint := fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucket, escapePath(key))
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequest(method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("s3: %v", err)
+	}
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	s.sign(request, payload)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("s3: %s %s/%s: %v", method, s.bucket, key, err)
+	}
+
+	if response.StatusCode == http.StatusNotFound && method == "GET" {
+		response.Body.Close()
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode >= 300 {
+		defer response.Body.Close()
+
+		var result struct {
+			Code    string
+			Message string
+		}
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 1<<16))
+		if err := xml.Unmarshal(body, &result); err == nil && result.Code != "" {
+			return nil, fmt.Errorf("s3: %s %s/%s: %s: %s", method, s.bucket, key, result.Code, result.Message)
+		}
+		return nil, fmt.Errorf("s3: %s %s/%s: status=%d", method, s.bucket, key, response.StatusCode)
+	}
+
+	return response, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization header to the request.
+func (s *S3) sign(request *http.Request, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := emptyPayloadHash
+	if len(payload) > 0 {
+		payloadHash = hashHex(payload)
+	}
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		canonicalQueryString(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func canonicalQueryString(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, escape(key)+"="+escape(value))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// escape encodes a string as required by AWS, only unreserved characters are kept.
+func escape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func escapePath(key string) string {
+	parts := strings.Split(key, "/")
+	for i := range parts {
+		parts[i] = escape(parts[i])
+	}
+	return strings.Join(parts, "/")
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
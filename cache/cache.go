@@ -0,0 +1,63 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+)
+
+// Cache stores values for a limited time.
+// A cache is best effort: errors are logged and reported as cache misses.
+type Cache interface {
+	// Get returns the value of a key, the boolean is false when the key is missing or expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores a value, a zero TTL means no expiration.
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes keys.
+	Delete(keys ...string)
+
+	// Flush removes all keys.
+	Flush()
+}
+
+// New returns a Redis cache when REDIS_URL is defined, an in-memory cache otherwise.
+// Caching is disabled when the size of the memory cache is zero.
+func New(cfg *config.Config) Cache {
+	if redisURL := cfg.RedisURL(); redisURL != "" {
+		logger.Info("[Cache] Using Redis")
+		return NewRedisCache(redisURL)
+	}
+
+	if size := cfg.CacheSize(); size > 0 {
+		logger.Info("[Cache] Using an in-memory cache of %d keys", size)
+		return NewMemoryCache(size)
+	}
+
+	return NewNullCache()
+}
+
+// IsShared returns true if the values are shared by all the instances, instances
+// behind a load balancer must not keep values changed by other instances.
+func IsShared(c Cache) bool {
+	_, shared := c.(*RedisCache)
+	return shared
+}
+
+type nullCache struct{}
+
+// NewNullCache returns a cache that never stores anything.
+func NewNullCache() Cache {
+	return nullCache{}
+}
+
+func (nullCache) Get(key string) ([]byte, bool)                   { return nil, false }
+func (nullCache) Set(key string, value []byte, ttl time.Duration) {}
+func (nullCache) Delete(keys ...string)                           {}
+func (nullCache) Flush()                                          {}
@@ -0,0 +1,21 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import "testing"
+
+func TestIsShared(t *testing.T) {
+	if !IsShared(NewRedisCache("redis://localhost:6379/0")) {
+		t.Error(`The Redis cache should be shared`)
+	}
+
+	if IsShared(NewMemoryCache(10)) {
+		t.Error(`The in-memory cache should not be shared`)
+	}
+
+	if IsShared(NewNullCache()) {
+		t.Error(`The null cache should not be shared`)
+	}
+}
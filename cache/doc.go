@@ -0,0 +1,11 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package cache provides a key/value cache backed by Redis or by the process memory,
+and LRU caches for values read frequently by the storage.
+
+*/
+package cache // import "miniflux.app/cache"
@@ -0,0 +1,113 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type lruItem struct {
+	key       interface{}
+	value     interface{}
+	expiresAt time.Time
+}
+
+// LRU is a process-local cache of Go values that removes the least recently used keys when it is full.
+// Values are stored as is, callers must not modify them.
+type LRU struct {
+	mutex   sync.Mutex
+	size    int
+	ttl     time.Duration
+	items   map[interface{}]*list.Element
+	order   *list.List
+	nowFunc func() time.Time
+}
+
+// NewLRU returns a cache that holds at most size keys during ttl, a zero size disables the cache.
+func NewLRU(size int, ttl time.Duration) *LRU {
+	return &LRU{
+		size:    size,
+		ttl:     ttl,
+		items:   make(map[interface{}]*list.Element),
+		order:   list.New(),
+		nowFunc: time.Now,
+	}
+}
+
+// Get returns the value of a key.
+func (l *LRU) Get(key interface{}) (interface{}, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	element, found := l.items[key]
+	if !found {
+		return nil, false
+	}
+
+	item := element.Value.(*lruItem)
+	if l.ttl > 0 && l.nowFunc().After(item.expiresAt) {
+		l.removeElement(element)
+		return nil, false
+	}
+
+	l.order.MoveToFront(element)
+	return item.value, true
+}
+
+// Add stores a value.
+func (l *LRU) Add(key, value interface{}) {
+	if l.size <= 0 {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	expiresAt := l.nowFunc().Add(l.ttl)
+	if element, found := l.items[key]; found {
+		element.Value = &lruItem{key: key, value: value, expiresAt: expiresAt}
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{key: key, value: value, expiresAt: expiresAt})
+	if l.order.Len() > l.size {
+		l.removeElement(l.order.Back())
+	}
+}
+
+// Remove deletes a key.
+func (l *LRU) Remove(key interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if element, found := l.items[key]; found {
+		l.removeElement(element)
+	}
+}
+
+// Purge deletes all keys.
+func (l *LRU) Purge() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.items = make(map[interface{}]*list.Element)
+	l.order.Init()
+}
+
+// Len returns the number of keys.
+func (l *LRU) Len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.order.Len()
+}
+
+func (l *LRU) removeElement(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.items, element.Value.(*lruItem).key)
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUGetAdd(t *testing.T) {
+	l := NewLRU(2, time.Minute)
+
+	if _, found := l.Get(int64(1)); found {
+		t.Fatal(`The key should not exist`)
+	}
+
+	l.Add(int64(1), "one")
+	value, found := l.Get(int64(1))
+	if !found || value.(string) != "one" {
+		t.Fatalf(`Unexpected value, got %v`, value)
+	}
+
+	l.Add(int64(1), "uno")
+	if value, _ := l.Get(int64(1)); value.(string) != "uno" {
+		t.Fatalf(`The value should be replaced, got %v`, value)
+	}
+
+	if l.Len() != 1 {
+		t.Fatalf(`Unexpected number of keys, got %d`, l.Len())
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	l := NewLRU(2, time.Minute)
+	l.Add(1, "one")
+	l.Add(2, "two")
+
+	// The first key becomes the most recently used.
+	l.Get(1)
+	l.Add(3, "three")
+
+	if _, found := l.Get(2); found {
+		t.Error(`The least recently used key should be evicted`)
+	}
+
+	if _, found := l.Get(1); !found {
+		t.Error(`The most recently used key should be kept`)
+	}
+
+	if _, found := l.Get(3); !found {
+		t.Error(`The new key should be kept`)
+	}
+}
+
+func TestLRUExpiration(t *testing.T) {
+	now := time.Now()
+	l := NewLRU(2, time.Minute)
+	l.nowFunc = func() time.Time { return now }
+
+	l.Add(1, "one")
+	now = now.Add(2 * time.Minute)
+
+	if _, found := l.Get(1); found {
+		t.Fatal(`The key should be expired`)
+	}
+
+	if l.Len() != 0 {
+		t.Fatalf(`Expired keys should be removed, got %d keys`, l.Len())
+	}
+}
+
+func TestLRURemoveAndPurge(t *testing.T) {
+	l := NewLRU(10, time.Minute)
+	l.Add(1, "one")
+	l.Add(2, "two")
+	l.Add(3, "three")
+
+	l.Remove(1)
+	if _, found := l.Get(1); found {
+		t.Error(`The key should be removed`)
+	}
+
+	l.Purge()
+	if l.Len() != 0 {
+		t.Errorf(`The cache should be empty, got %d keys`, l.Len())
+	}
+}
+
+func TestDisabledLRU(t *testing.T) {
+	l := NewLRU(0, time.Minute)
+	l.Add(1, "one")
+
+	if _, found := l.Get(1); found {
+		t.Fatal(`A cache of size zero should not store anything`)
+	}
+}
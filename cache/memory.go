@@ -0,0 +1,110 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"sync"
+	"time"
+)
+
+type memoryItem struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (i *memoryItem) expired(now time.Time) bool {
+	return !i.expiresAt.IsZero() && now.After(i.expiresAt)
+}
+
+// MemoryCache is a cache local to the process.
+// When the cache is full, expired keys are removed first, then arbitrary keys.
+type MemoryCache struct {
+	mutex   sync.Mutex
+	size    int
+	items   map[string]*memoryItem
+	nowFunc func() time.Time
+}
+
+// NewMemoryCache returns a cache that holds at most size keys.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{size: size, items: make(map[string]*memoryItem), nowFunc: time.Now}
+}
+
+// Get returns the value of a key.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	item, found := m.items[key]
+	if !found {
+		return nil, false
+	}
+
+	if item.expired(m.nowFunc()) {
+		delete(m.items, key)
+		return nil, false
+	}
+
+	return item.value, true
+}
+
+// Set stores a value.
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, found := m.items[key]; !found && len(m.items) >= m.size {
+		m.evict()
+	}
+
+	item := &memoryItem{value: value}
+	if ttl > 0 {
+		item.expiresAt = m.nowFunc().Add(ttl)
+	}
+	m.items[key] = item
+}
+
+// Delete removes keys.
+func (m *MemoryCache) Delete(keys ...string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(m.items, key)
+	}
+}
+
+// Flush removes all keys.
+func (m *MemoryCache) Flush() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.items = make(map[string]*memoryItem)
+}
+
+// Len returns the number of keys, including the expired ones not removed yet.
+func (m *MemoryCache) Len() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return len(m.items)
+}
+
+func (m *MemoryCache) evict() {
+	now := m.nowFunc()
+	for key, item := range m.items {
+		if item.expired(now) {
+			delete(m.items, key)
+		}
+	}
+
+	// Map iteration order is random, this removes an arbitrary tenth of the keys.
+	for key := range m.items {
+		if len(m.items) < m.size-m.size/10 {
+			break
+		}
+		delete(m.items, key)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheGetSet(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	if _, found := c.Get("key"); found {
+		t.Fatal(`The key should not exist`)
+	}
+
+	c.Set("key", []byte("value"), 0)
+	value, found := c.Get("key")
+	if !found || string(value) != "value" {
+		t.Fatalf(`Unexpected value, got %q`, value)
+	}
+
+	c.Delete("key")
+	if _, found := c.Get("key"); found {
+		t.Fatal(`The key should be removed`)
+	}
+}
+
+func TestMemoryCacheExpiration(t *testing.T) {
+	now := time.Now()
+	c := NewMemoryCache(10)
+	c.nowFunc = func() time.Time { return now }
+
+	c.Set("key", []byte("value"), time.Minute)
+	if _, found := c.Get("key"); !found {
+		t.Fatal(`The key should exist`)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, found := c.Get("key"); found {
+		t.Fatal(`The key should be expired`)
+	}
+
+	if c.Len() != 0 {
+		t.Fatalf(`Expired keys should be removed, got %d keys`, c.Len())
+	}
+}
+
+func TestMemoryCacheSize(t *testing.T) {
+	c := NewMemoryCache(20)
+	for i := 0; i < 100; i++ {
+		c.Set(fmt.Sprintf("key%d", i), []byte("value"), 0)
+	}
+
+	if c.Len() > 20 {
+		t.Fatalf(`The cache should not contain more than 20 keys, got %d`, c.Len())
+	}
+
+	if _, found := c.Get("key99"); !found {
+		t.Fatal(`The last key should exist`)
+	}
+}
+
+func TestMemoryCacheEvictsExpiredKeysFirst(t *testing.T) {
+	now := time.Now()
+	c := NewMemoryCache(2)
+	c.nowFunc = func() time.Time { return now }
+
+	c.Set("expired", []byte("value"), time.Second)
+	c.Set("valid", []byte("value"), time.Hour)
+	now = now.Add(time.Minute)
+	c.Set("new", []byte("value"), time.Hour)
+
+	if _, found := c.Get("valid"); !found {
+		t.Fatal(`Valid keys should be kept when expired keys can be removed`)
+	}
+}
+
+func TestMemoryCacheFlush(t *testing.T) {
+	c := NewMemoryCache(10)
+	c.Set("key1", []byte("value"), 0)
+	c.Set("key2", []byte("value"), 0)
+	c.Flush()
+
+	if c.Len() != 0 {
+		t.Fatalf(`The cache should be empty, got %d keys`, c.Len())
+	}
+}
+
+func TestNullCache(t *testing.T) {
+	c := NewNullCache()
+	c.Set("key", []byte("value"), 0)
+
+	if _, found := c.Get("key"); found {
+		t.Fatal(`The null cache should not store anything`)
+	}
+}
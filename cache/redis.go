@@ -0,0 +1,135 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/logger"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	redisKeyPrefix   = "miniflux:"
+	redisMaxIdle     = 10
+	redisIdleTimeout = 5 * time.Minute
+	redisTimeout     = 2 * time.Second
+)
+
+// RedisCache is a cache shared by all the instances connected to the same Redis server.
+type RedisCache struct {
+	pool *redis.Pool
+}
+
+// NewRedisCache returns a cache connected to the given Redis URL, for example redis://localhost:6379/0.
+func NewRedisCache(redisURL string) *RedisCache {
+	return &RedisCache{
+		pool: &redis.Pool{
+			MaxIdle:     redisMaxIdle,
+			IdleTimeout: redisIdleTimeout,
+			Dial: func() (redis.Conn, error) {
+				return redis.DialURL(
+					redisURL,
+					redis.DialConnectTimeout(redisTimeout),
+					redis.DialReadTimeout(redisTimeout),
+					redis.DialWriteTimeout(redisTimeout),
+				)
+			},
+		},
+	}
+}
+
+// Get returns the value of a key.
+func (r *RedisCache) Get(key string) ([]byte, bool) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	value, err := redis.Bytes(conn.Do("GET", redisKeyPrefix+key))
+	if err == redis.ErrNil {
+		return nil, false
+	} else if err != nil {
+		logger.Error("[Cache:Redis] Unable to get %q: %v", key, err)
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set stores a value.
+func (r *RedisCache) Set(key string, value []byte, ttl time.Duration) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	args := redis.Args{redisKeyPrefix + key, value}
+	if ttl > 0 {
+		args = args.Add("PX", int64(ttl/time.Millisecond))
+	}
+
+	if _, err := conn.Do("SET", args...); err != nil {
+		logger.Error("[Cache:Redis] Unable to set %q: %v", key, err)
+	}
+}
+
+// Delete removes keys.
+func (r *RedisCache) Delete(keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	args := make(redis.Args, len(keys))
+	for i, key := range keys {
+		args[i] = redisKeyPrefix + key
+	}
+
+	if _, err := conn.Do("DEL", args...); err != nil {
+		logger.Error("[Cache:Redis] Unable to delete %v: %v", keys, err)
+	}
+}
+
+// Flush removes all the keys of the application, other keys of the database are left untouched.
+func (r *RedisCache) Flush() {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	cursor := int64(0)
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", redisKeyPrefix+"*", "COUNT", 1000))
+		if err == nil && len(values) != 2 {
+			err = fmt.Errorf("unexpected reply %v", values)
+		}
+
+		if err != nil {
+			logger.Error("[Cache:Redis] Unable to scan keys: %v", err)
+			return
+		}
+
+		cursor, _ = redis.Int64(values[0], nil)
+		keys, _ := redis.Strings(values[1], nil)
+
+		if len(keys) > 0 {
+			if _, err := conn.Do("DEL", stringsToInterfaces(keys)...); err != nil {
+				logger.Error("[Cache:Redis] Unable to delete keys: %v", err)
+				return
+			}
+		}
+
+		if cursor == 0 {
+			return
+		}
+	}
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"miniflux.app/backup"
+	"miniflux.app/blob"
+	"miniflux.app/config"
+)
+
+// backupDatabase handles the "backup" command, the destination is a file, a blob store URL or the standard output.
+func backupDatabase(cfg *config.Config, db *sql.DB, args []string) {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	withoutContent := flags.Bool("without-content", false, "Do not save the content of entries")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: miniflux backup [-without-content] [file | s3://bucket/key | gs://bucket/key | -]")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	w, err := openBackupWriter(cfg, flags.Arg(0))
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if err := backup.Dump(db, w, backup.Options{WithoutContent: *withoutContent}); err != nil {
+		exitWithError(err)
+	}
+
+	if err := w.Close(); err != nil {
+		exitWithError(err)
+	}
+}
+
+// restoreDatabase handles the "restore" command, the source is a file, a blob store URL or the standard input.
+func restoreDatabase(cfg *config.Config, db *sql.DB, args []string) {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: miniflux restore [file | s3://bucket/key | gs://bucket/key | -]")
+	}
+	flags.Parse(args)
+
+	r, err := openBackupReader(cfg, flags.Arg(0))
+	if err != nil {
+		exitWithError(err)
+	}
+	defer r.Close()
+
+	if err := backup.Restore(db, r); err != nil {
+		exitWithError(err)
+	}
+
+	fmt.Fprintln(os.Stderr, "Backup restored, users have to log in again")
+}
+
+func openBackupWriter(cfg *config.Config, destination string) (io.WriteCloser, error) {
+	switch {
+	case destination == "" || destination == "-":
+		return nopWriteCloser{os.Stdout}, nil
+	case strings.Contains(destination, "://"):
+		store, key, err := blob.Open(cfg, destination)
+		if err != nil {
+			return nil, err
+		}
+		return store.NewWriter(key, "application/x-ndjson")
+	default:
+		return os.Create(destination)
+	}
+}
+
+func openBackupReader(cfg *config.Config, source string) (io.ReadCloser, error) {
+	switch {
+	case source == "" || source == "-":
+		return os.Stdin, nil
+	case strings.Contains(source, "://"):
+		store, key, err := blob.Open(cfg, source)
+		if err != nil {
+			return nil, err
+		}
+		return store.Get(key)
+	default:
+		return os.Open(source)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "%v\n", err)
+	os.Exit(1)
+}
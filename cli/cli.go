@@ -5,15 +5,32 @@
 package cli // import "miniflux.app/cli"
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"time"
 
+	"miniflux.app/alert"
+	"miniflux.app/blob"
+	"miniflux.app/cache"
 	"miniflux.app/config"
 	"miniflux.app/database"
+	"miniflux.app/encryption"
+	"miniflux.app/eventbus"
+	"miniflux.app/hook"
+	"miniflux.app/http/client"
 	"miniflux.app/logger"
+	"miniflux.app/reader/plugin"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/reader/script"
 	"miniflux.app/storage"
+	"miniflux.app/template"
 	"miniflux.app/version"
+	"miniflux.app/integration"
+	"miniflux.app/integration/gcpbigquery"
 	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/webhook"
 )
 
 const (
@@ -79,14 +96,124 @@ func Parse() {
 		return
 	}
 
-	store := storage.NewStorage(db)
+	switch flag.Arg(0) {
+	case "migrate":
+		migrate(db, flag.Args()[1:])
+		return
+	case "backup":
+		backupDatabase(cfg, db, flag.Args()[1:])
+		return
+	case "restore":
+		restoreDatabase(cfg, db, flag.Args()[1:])
+		return
+	}
+
+	// The publisher receives the sync events of every 'storage' method changing a category, a feed, an entry or a user.
+	publisher, err := eventbus.New(cfg)
+	if err != nil {
+		logger.Fatal("Unable to configure the event bus: %v", err)
+	}
+
+	store := storage.NewStorage(db, publisher)
+	store.AddCache(cache.New(cfg))
+	store.EnableLocalCaches(cfg.LocalCacheSize(), time.Duration(cfg.LocalCacheTTL())*time.Second)
+	store.LogSlowQueries(time.Duration(cfg.DatabaseSlowQueryThreshold())*time.Millisecond, cfg.HasDebugMode())
+
+	switch cfg.EventPayloadMode() {
+	case gcppubsub.PayloadModeFull:
+		store.EnableFullEventPayloads()
+	case gcppubsub.PayloadModeIDOnly:
+	default:
+		logger.Fatal("Unsupported event payload mode: %q", cfg.EventPayloadMode())
+	}
+
+	blobs, err := blob.New(cfg)
+	if err != nil {
+		logger.Fatal("Unable to configure the blob store: %v", err)
+	}
+	store.AddBlobStore(blobs)
+
+	keyring, err := encryption.New(context.Background(), cfg)
+	if err != nil {
+		logger.Fatal("Unable to configure the entry encryption: %v", err)
+	}
+	if keyring != nil {
+		store.AddEntryKeyring(keyring)
+	}
+
+	if replicaURL := cfg.DatabaseReplicaURL(); replicaURL != "" {
+		replicaDB, err := database.NewConnectionPool(replicaURL, cfg.DatabaseMinConns(), cfg.DatabaseMaxConns())
+		if err != nil {
+			logger.Fatal("Unable to connect to the database replica: %v", err)
+		}
+		defer replicaDB.Close()
 
-	// Add pubsub publisher to 'storage' instance so we can call the Publish method on every 'storage' methods.
-	publisher := gcppubsub.NewPublisher(cfg)
-	store.AddPubsubPublisher(publisher)
+		store.AddReadReplica(replicaDB, time.Duration(cfg.DatabaseReplicaMaxLag())*time.Second)
+	}
+
+	if cfg.BigQueryDataset() != "" {
+		exporter, err := gcpbigquery.NewExporter(cfg)
+		if err != nil {
+			logger.Fatal("Unable to configure the BigQuery export: %v", err)
+		}
+		publisher.AddSink(exporter)
+	}
+
+	var dispatcher *webhook.Dispatcher
+	if urls := cfg.WebhookURLs(); len(urls) > 0 {
+		dispatcher = webhook.NewDispatcher(urls, cfg.WebhookSecret(), cfg.WebhookEvents(), cfg.WebhookMaxRetries())
+		store.AddWebhookDispatcher(dispatcher)
+	}
+
+	monitor := alert.NewMonitor(cfg.AlertFeedErrorPercentage(), cfg.AlertErrorThreshold(), dispatcher)
+	store.AddAlertMonitor(monitor)
+	publisher.SetAlertMonitor(monitor)
+	publisher.SetOutbox(store)
+
+	client.SetCertificateStore(store)
+	client.SetTorProxy(cfg.TorProxy())
+	client.SetLocalDirectories(cfg.LocalFeedDirectories())
+	store.AddNotifier(integration.NewNotifier(cfg))
+	store.AddEntryHooks(hook.New(cfg))
+
+	if filename := cfg.EntryScriptFile(); filename != "" {
+		source, err := ioutil.ReadFile(filename)
+		if err != nil {
+			logger.Fatal("Unable to read the entry script: %v", err)
+		}
+
+		if err := script.SetGlobalScript(string(source)); err != nil {
+			logger.Fatal("%v", err)
+		}
+	}
+
+	if err := sanitizer.SetAllowedSchemes(cfg.SanitizerAllowedSchemes()); err != nil {
+		logger.Fatal("%v", err)
+	}
+
+	if directory := cfg.PluginsDirectory(); directory != "" {
+		if err := plugin.LoadDirectory(directory); err != nil {
+			logger.Fatal("%v", err)
+		}
+	}
+
+	if directory := cfg.TemplateDirectory(); directory != "" {
+		if err := template.LoadDirectory(directory); err != nil {
+			logger.Fatal("%v", err)
+		}
+	}
+
+	switch flag.Arg(0) {
+	case "outbox":
+		manageOutbox(store, flag.Args()[1:])
+		return
+	case "feed":
+		manageFeeds(store, flag.Args()[1:])
+		return
+	}
 
 	if flagResetFeedErrors {
-		store.ResetFeedErrors()
+		store.ResetFeedErrors(context.Background())
 		return
 	}
 
@@ -96,7 +223,7 @@ func Parse() {
 	}
 
 	if flagCreateAdmin {
-		createAdmin(store)
+		createAdmin(cfg, store)
 		return
 	}
 
@@ -112,7 +239,7 @@ func Parse() {
 
 	// Create admin user and start the deamon.
 	if cfg.CreateAdmin() {
-		createAdmin(store)
+		createAdmin(cfg, store)
 	}
 
 	startDaemon(cfg, store)
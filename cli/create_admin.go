@@ -5,18 +5,20 @@
 package cli // import "miniflux.app/cli"
 
 import (
+	"context"
 	"fmt"
 	"os"
 
+	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/storage"
 )
 
-func createAdmin(store *storage.Storage) {
+func createAdmin(cfg *config.Config, store *storage.Storage) {
 	user := model.NewUser()
-	user.Username = os.Getenv("ADMIN_USERNAME")
-	user.Password = os.Getenv("ADMIN_PASSWORD")
+	user.Username = cfg.AdminUsername()
+	user.Password = cfg.AdminPassword()
 	user.IsAdmin = true
 
 	if user.Username == "" || user.Password == "" {
@@ -28,12 +30,12 @@ func createAdmin(store *storage.Storage) {
 		os.Exit(1)
 	}
 
-	if store.UserExists(user.Username) {
+	if store.UserExists(context.Background(), user.Username) {
 		logger.Info(`User %q already exists, skipping creation`, user.Username)
 		return
 	}
 
-	if err := store.CreateUser(user); err != nil {
+	if err := store.CreateUser(context.Background(), user); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
@@ -14,12 +14,17 @@ import (
 	"time"
 
 	"miniflux.app/config"
+	"miniflux.app/integration/gcppubsub"
 	"miniflux.app/logger"
 	"miniflux.app/reader/feed"
+	"miniflux.app/service/grpcd"
 	"miniflux.app/service/scheduler"
 	"miniflux.app/service/httpd"
 	"miniflux.app/storage"
+	"miniflux.app/summarizer"
 	"miniflux.app/worker"
+
+	"google.golang.org/grpc"
 )
 
 func startDaemon(cfg *config.Config, store *storage.Storage) {
@@ -30,10 +35,21 @@ func startDaemon(cfg *config.Config, store *storage.Storage) {
 	signal.Notify(stop, syscall.SIGTERM)
 
 	feedHandler := feed.NewFeedHandler(store)
-	pool := worker.NewPool(feedHandler, cfg.WorkerPoolSize())
+	feedHandler.EnableResponseArchive(cfg.FeedArchiveSize())
+	if cfg.HasSubscriptionApproval() {
+		feedHandler.RequireSubscriptionApproval()
+	}
+	if s := summarizer.New(cfg); s != nil {
+		feedHandler.EnableSummaries(s)
+	}
+	pool := worker.NewPool(store, feedHandler, cfg.WorkerPoolSize())
 
 	go showProcessStatistics()
 
+	if cfg.GcpPubsubCacheSubscription() != "" {
+		go invalidateLocalCaches(cfg, store)
+	}
+
 	if cfg.HasSchedulerService() {
 		scheduler.Serve(cfg, store, pool)
 	}
@@ -43,6 +59,11 @@ func startDaemon(cfg *config.Config, store *storage.Storage) {
 		httpServer = httpd.Serve(cfg, store, pool, feedHandler)
 	}
 
+	var grpcServer *grpc.Server
+	if cfg.GRPCListenAddr() != "" {
+		grpcServer = grpcd.Serve(cfg, store, feedHandler)
+	}
+
 	<-stop
 	logger.Info("Shutting down the process...")
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -52,9 +73,27 @@ func startDaemon(cfg *config.Config, store *storage.Storage) {
 		httpServer.Shutdown(ctx)
 	}
 
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
+
 	logger.Info("Process gracefully stopped")
 }
 
+func invalidateLocalCaches(cfg *config.Config, store *storage.Storage) {
+	subscriber, err := gcppubsub.NewSubscriber(cfg)
+	if err != nil {
+		logger.Error("[Daemon] Unable to create the Pub/Sub subscriber: %v", err)
+		return
+	}
+
+	for {
+		err := subscriber.Receive(context.Background(), store.InvalidateLocalCaches)
+		logger.Error("[Daemon] Pub/Sub subscription stopped: %v", err)
+		time.Sleep(30 * time.Second)
+	}
+}
+
 func showProcessStatistics() {
 	for {
 		var m runtime.MemStats
@@ -0,0 +1,195 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+const feedUsage = `Usage: miniflux feed [import -csv [file | -] | export -csv [-user username] [file | -]]
+
+  import -csv   Create the feeds listed in a CSV file, the existing feeds of a user are skipped
+  export -csv   Write the feeds of all users, or of the given user, in the CSV format`
+
+// manageFeeds handles the "feed" command, it creates or lists the feeds of many users at once.
+func manageFeeds(store *storage.Storage, args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, feedUsage)
+		os.Exit(1)
+	}
+
+	flags := flag.NewFlagSet("feed "+args[0], flag.ExitOnError)
+	withCSV := flags.Bool("csv", false, "Use the CSV format")
+	username := flags.String("user", "", "Export only the feeds of this user")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, feedUsage)
+	}
+	flags.Parse(args[1:])
+
+	if !*withCSV {
+		exitWithError(fmt.Errorf("CSV is the only supported format, add the -csv option"))
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "import":
+		r := io.ReadCloser(os.Stdin)
+		if filename := flags.Arg(0); filename != "" && filename != "-" {
+			f, err := os.Open(filename)
+			if err != nil {
+				exitWithError(err)
+			}
+			r = f
+		}
+		defer r.Close()
+
+		created, skipped, err := importFeedCSV(ctx, store, r)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		fmt.Printf("%d feeds created, %d skipped\n", created, skipped)
+	case "export":
+		w := io.WriteCloser(nopWriteCloser{os.Stdout})
+		if filename := flags.Arg(0); filename != "" && filename != "-" {
+			f, err := os.Create(filename)
+			if err != nil {
+				exitWithError(err)
+			}
+			w = f
+		}
+
+		if err := exportFeedCSV(ctx, store, w, *username); err != nil {
+			exitWithError(err)
+		}
+
+		if err := w.Close(); err != nil {
+			exitWithError(err)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, feedUsage)
+		os.Exit(1)
+	}
+}
+
+// importFeedCSV creates the feeds of a CSV file once every row is valid and every user exists.
+// The missing categories are created, an empty category means the first category of the user.
+func importFeedCSV(ctx context.Context, store *storage.Storage, r io.Reader) (created, skipped int, err error) {
+	records, err := readFeedCSV(r)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	users := make(map[string]*model.User)
+	for _, record := range records {
+		if _, found := users[record.Username]; found {
+			continue
+		}
+
+		user, err := store.UserByUsername(ctx, record.Username)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if user == nil {
+			return 0, 0, fmt.Errorf("row %d: the user %q does not exist", record.Row, record.Username)
+		}
+		users[record.Username] = user
+	}
+
+	for _, record := range records {
+		feed := record.Feed
+		feed.UserID = users[record.Username].ID
+
+		if store.FeedURLExists(ctx, feed.UserID, feed.FeedURL) {
+			skipped++
+			continue
+		}
+
+		feed.Category, err = findOrCreateCategory(ctx, store, feed.UserID, record.Category)
+		if err != nil {
+			return created, skipped, fmt.Errorf("row %d: %v", record.Row, err)
+		}
+
+		if err := store.CreateFeed(ctx, feed); err != nil {
+			return created, skipped, fmt.Errorf("row %d: %v", record.Row, err)
+		}
+		created++
+	}
+
+	return created, skipped, nil
+}
+
+func findOrCreateCategory(ctx context.Context, store *storage.Storage, userID int64, title string) (*model.Category, error) {
+	if title == "" {
+		category, err := store.FirstCategory(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if category == nil {
+			return nil, fmt.Errorf("unable to find the first category of the user")
+		}
+		return category, nil
+	}
+
+	category, err := store.CategoryByTitle(ctx, userID, title)
+	if err != nil || category != nil {
+		return category, err
+	}
+
+	category = &model.Category{UserID: userID, Title: title}
+	if err := store.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// exportFeedCSV writes the feeds of all users, the virtual feeds of saved pages are not exported.
+func exportFeedCSV(ctx context.Context, store *storage.Storage, w io.Writer, username string) error {
+	var users model.Users
+	if username != "" {
+		user, err := store.UserByUsername(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("the user %q does not exist", username)
+		}
+		users = model.Users{user}
+	} else {
+		var err error
+		if users, err = store.Users(ctx); err != nil {
+			return err
+		}
+	}
+
+	var records []*feedCSVRecord
+	for _, user := range users {
+		feeds, err := store.Feeds(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, feed := range feeds {
+			if feed.IsSavedPages() {
+				continue
+			}
+
+			records = append(records, &feedCSVRecord{Username: user.Username, Category: feed.Category.Title, Feed: feed})
+		}
+	}
+
+	return writeFeedCSV(w, records)
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+)
+
+// feedCSVColumns are the columns written by the export, the import requires only user and feed_url.
+var feedCSVColumns = []string{
+	"user",
+	"feed_url",
+	"category",
+	"title",
+	"site_url",
+	"crawler",
+	"user_agent",
+	"scraper_rules",
+	"rewrite_rules",
+	"priority",
+	"entry_open_mode",
+	"watch_selector",
+	"feed_username",
+	"feed_password",
+}
+
+// feedCSVRecord is a row of a CSV file, the category of the feed is created during the import when it doesn't exist.
+type feedCSVRecord struct {
+	Row      int
+	Username string
+	Category string
+	Feed     *model.Feed
+}
+
+// readFeedCSV parses and validates all rows before anything is imported, the first row is the header.
+func readFeedCSV(r io.Reader) ([]*feedCSVRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the CSV file is empty")
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read the CSV header: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isFeedCSVColumn(name) {
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+
+		if _, found := columns[name]; found {
+			return nil, fmt.Errorf("duplicate CSV column %q", name)
+		}
+		columns[name] = i
+	}
+
+	for _, name := range []string{"user", "feed_url"} {
+		if _, found := columns[name]; !found {
+			return nil, fmt.Errorf("missing CSV column %q", name)
+		}
+	}
+
+	var records []*feedCSVRecord
+	for row := 2; ; row++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to read the CSV file: %v", err)
+		}
+
+		value := func(name string) string {
+			if i, found := columns[name]; found {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		}
+
+		record, err := newFeedCSVRecord(row, value)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", row, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func newFeedCSVRecord(row int, value func(name string) string) (*feedCSVRecord, error) {
+	record := &feedCSVRecord{
+		Row:      row,
+		Username: value("user"),
+		Category: value("category"),
+		Feed: &model.Feed{
+			FeedURL:       value("feed_url"),
+			Title:         value("title"),
+			SiteURL:       value("site_url"),
+			UserAgent:     value("user_agent"),
+			ScraperRules:  value("scraper_rules"),
+			RewriteRules:  value("rewrite_rules"),
+			Priority:      value("priority"),
+			EntryOpenMode: value("entry_open_mode"),
+			WatchSelector: value("watch_selector"),
+			Username:      value("feed_username"),
+			Password:      value("feed_password"),
+		},
+	}
+
+	if record.Username == "" {
+		return nil, fmt.Errorf("the user is empty")
+	}
+
+	if record.Feed.FeedURL == "" {
+		return nil, fmt.Errorf("the feed URL is empty")
+	}
+
+	if record.Feed.SiteURL == "" {
+		record.Feed.SiteURL = record.Feed.FeedURL
+	}
+
+	if record.Feed.Title == "" {
+		record.Feed.Title = record.Feed.FeedURL
+	}
+
+	if crawler := value("crawler"); crawler != "" {
+		enabled, err := strconv.ParseBool(crawler)
+		if err != nil {
+			return nil, fmt.Errorf("invalid crawler value %q", crawler)
+		}
+		record.Feed.Crawler = enabled
+	}
+
+	if record.Feed.Priority != "" {
+		if err := model.ValidateFeedPriority(record.Feed.Priority); err != nil {
+			return nil, fmt.Errorf("invalid priority %q", record.Feed.Priority)
+		}
+	}
+
+	if record.Feed.EntryOpenMode != "" {
+		if err := model.ValidateEntryOpenMode(record.Feed.EntryOpenMode); err != nil {
+			return nil, fmt.Errorf("invalid entry open mode %q", record.Feed.EntryOpenMode)
+		}
+	}
+
+	return record, nil
+}
+
+// writeFeedCSV writes the header and one row per feed.
+func writeFeedCSV(w io.Writer, records []*feedCSVRecord) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(feedCSVColumns); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		feed := record.Feed
+		err := writer.Write([]string{
+			record.Username,
+			feed.FeedURL,
+			record.Category,
+			feed.Title,
+			feed.SiteURL,
+			strconv.FormatBool(feed.Crawler),
+			feed.UserAgent,
+			feed.ScraperRules,
+			feed.RewriteRules,
+			feed.Priority,
+			feed.EntryOpenMode,
+			feed.WatchSelector,
+			feed.Username,
+			feed.Password,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func isFeedCSVColumn(name string) bool {
+	for _, column := range feedCSVColumns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
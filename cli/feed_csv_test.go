@@ -0,0 +1,75 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadFeedCSV(t *testing.T) {
+	data := `user,feed_url,category,crawler,priority
+alice,https://example.org/feed.xml,News,true,high
+bob, https://example.com/rss ,,,
+`
+
+	records, err := readFeedCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf(`Unexpected number of records: %d`, len(records))
+	}
+
+	alice := records[0]
+	if alice.Username != "alice" || alice.Category != "News" || !alice.Feed.Crawler || alice.Feed.Priority != "high" {
+		t.Errorf(`Unexpected first record: %+v %v`, alice, alice.Feed)
+	}
+
+	bob := records[1]
+	if bob.Row != 3 || bob.Feed.FeedURL != "https://example.com/rss" || bob.Feed.Title != bob.Feed.FeedURL || bob.Category != "" {
+		t.Errorf(`Unexpected second record: %+v %v`, bob, bob.Feed)
+	}
+}
+
+func TestReadFeedCSVWithInvalidRows(t *testing.T) {
+	scenarios := map[string]string{
+		"feed_url\nhttps://example.org/feed.xml\n":                      `missing CSV column "user"`,
+		"user,feed_url,color\nalice,https://example.org/feed.xml,red\n": `unknown CSV column "color"`,
+		"user,feed_url\nalice,\n":                                       `row 2: the feed URL is empty`,
+		"user,feed_url,crawler\nalice,https://example.org/,maybe\n":     `row 2: invalid crawler value "maybe"`,
+		"user,feed_url,priority\nalice,https://example.org/,urgent\n":   `row 2: invalid priority "urgent"`,
+		"": `the CSV file is empty`,
+	}
+
+	for data, expected := range scenarios {
+		_, err := readFeedCSV(strings.NewReader(data))
+		if err == nil || err.Error() != expected {
+			t.Errorf(`Unexpected error for %q: got "%v" instead of %q`, data, err, expected)
+		}
+	}
+}
+
+func TestWriteAndReadFeedCSV(t *testing.T) {
+	data := `user,feed_url,category,title,site_url,crawler,user_agent,scraper_rules,rewrite_rules,priority,entry_open_mode,watch_selector,feed_username,feed_password
+alice,https://example.org/feed.xml,News,"Example, Inc.",https://example.org/,true,Bot/1.0,article,add_image_title,low,original,,me,secret
+`
+
+	records, err := readFeedCSV(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := writeFeedCSV(&buffer, records); err != nil {
+		t.Fatal(err)
+	}
+
+	if buffer.String() != data {
+		t.Fatalf(`Unexpected export: got %q instead of %q`, buffer.String(), data)
+	}
+}
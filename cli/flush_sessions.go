@@ -5,6 +5,7 @@
 package cli // import "miniflux.app/cli"
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -13,7 +14,7 @@ import (
 
 func flushSessions(store *storage.Storage) {
 	fmt.Println("Flushing all sessions (disconnect users)")
-	if err := store.FlushAllSessions(); err != nil {
+	if err := store.FlushAllSessions(context.Background()); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,88 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	"miniflux.app/database"
+)
+
+const migrateUsage = `Usage: miniflux migrate [up | down [version] | status]
+
+  up              Apply all pending migrations (default)
+  down [version]  Roll back to the given version, or roll back the last migration
+  status          Show applied and pending migrations`
+
+// migrate handles the "migrate" command.
+func migrate(db *sql.DB, args []string) {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		database.Migrate(db)
+	case "down":
+		targetVersion, err := rollbackTarget(db, args[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		database.Rollback(db, targetVersion)
+	case "status":
+		migrationStatus(db)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(1)
+	}
+}
+
+func rollbackTarget(db *sql.DB, args []string) (int, error) {
+	if len(args) > 0 {
+		targetVersion, err := strconv.Atoi(args[0])
+		if err != nil {
+			return 0, fmt.Errorf("Invalid version: %q", args[0])
+		}
+		return targetVersion, nil
+	}
+
+	currentVersion, err := database.CurrentSchemaVersion(db)
+	if err != nil {
+		return 0, err
+	}
+
+	return currentVersion - 1, nil
+}
+
+func migrationStatus(db *sql.DB) {
+	statuses, err := database.Status(db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	pending := 0
+	for _, status := range statuses {
+		state := "applied"
+		if !status.Applied {
+			state = "pending"
+			pending++
+		}
+
+		reversible := ""
+		if !status.Reversible() {
+			reversible = " (irreversible)"
+		}
+
+		fmt.Printf("%-8s %3d  %s%s\n", state, status.Version, status.Name, reversible)
+	}
+
+	fmt.Printf("\n%d migrations, %d pending\n", len(statuses), pending)
+}
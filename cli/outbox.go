@@ -0,0 +1,107 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+const outboxUsage = `Usage: miniflux outbox [status | list [failed | discarded] | replay [id...] | discard id...]
+
+  status                      Show the number of failed and discarded Pub/Sub events (default)
+  list [failed | discarded]   List the events with the given status, failed by default
+  replay [id...]              Publish again the given failed events, or all of them
+  discard id...               Mark the given failed events as discarded`
+
+// manageOutbox handles the "outbox" command, the outbox keeps the Pub/Sub events that could not be published.
+func manageOutbox(store *storage.Storage, args []string) {
+	command := "status"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "status":
+		backlog, err := store.OutboxBacklog(ctx)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		fmt.Printf("%d failed, %d discarded\n", backlog.Failed, backlog.Discarded)
+		if backlog.OldestEvent != nil {
+			fmt.Printf("Oldest failed event: %v\n", backlog.OldestEvent)
+		}
+	case "list":
+		status := model.OutboxStatusFailed
+		if len(args) > 1 {
+			status = args[1]
+		}
+
+		if err := model.ValidateOutboxStatus(status); err != nil {
+			exitWithError(err)
+		}
+
+		events, err := store.OutboxEvents(ctx, status, model.DefaultOutboxLimit)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		for _, event := range events {
+			fmt.Printf("%-8d %-8s %-6s %-10d attempts=%d  %v  %s\n", event.ID, event.EntityType, event.EntityOp, event.EntityID, event.Attempts, event.CreatedAt, event.ErrorMsg)
+		}
+	case "replay":
+		eventIDs, err := parseOutboxEventIDs(args[1:])
+		if err != nil {
+			exitWithError(err)
+		}
+
+		result, err := store.ReplayOutboxEvents(ctx, eventIDs, model.DefaultOutboxLimit)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		fmt.Printf("%d events replayed, %d failed\n", result.Replayed, result.Failed)
+	case "discard":
+		eventIDs, err := parseOutboxEventIDs(args[1:])
+		if err != nil {
+			exitWithError(err)
+		}
+
+		if len(eventIDs) == 0 {
+			fmt.Fprintln(os.Stderr, outboxUsage)
+			os.Exit(1)
+		}
+
+		count, err := store.DiscardOutboxEvents(ctx, eventIDs)
+		if err != nil {
+			exitWithError(err)
+		}
+
+		fmt.Printf("%d events discarded\n", count)
+	default:
+		fmt.Fprintln(os.Stderr, outboxUsage)
+		os.Exit(1)
+	}
+}
+
+func parseOutboxEventIDs(args []string) ([]int64, error) {
+	var eventIDs []int64
+	for _, arg := range args {
+		eventID, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || eventID <= 0 {
+			return nil, fmt.Errorf("Invalid event ID: %q", arg)
+		}
+		eventIDs = append(eventIDs, eventID)
+	}
+
+	return eventIDs, nil
+}
@@ -5,6 +5,7 @@
 package cli // import "miniflux.app/cli"
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -13,7 +14,7 @@ import (
 
 func resetPassword(store *storage.Storage) {
 	username, password := askCredentials()
-	user, err := store.UserByUsername(username)
+	user, err := store.UserByUsername(context.Background(), username)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -30,7 +31,7 @@ func resetPassword(store *storage.Storage) {
 		os.Exit(1)
 	}
 
-	if err := store.UpdateUser(user); err != nil {
+	if err := store.UpdateUser(context.Background(), user); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
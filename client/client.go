@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 // Client holds API procedure calls.
@@ -35,6 +37,50 @@ func (c *Client) Me() (*User, error) {
 	return user, nil
 }
 
+// DeleteAccount closes the account of the authenticated user, the data is purged later by the server.
+func (c *Client) DeleteAccount() error {
+	body, err := c.request.Delete("/v1/me")
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// KeyboardShortcuts returns the keyboard shortcuts of the authenticated user.
+func (c *Client) KeyboardShortcuts() (KeyboardShortcuts, error) {
+	body, err := c.request.Get("/v1/me/keyboard-shortcuts")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var shortcuts KeyboardShortcuts
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&shortcuts); err != nil {
+		return nil, fmt.Errorf("miniflux: json error (%v)", err)
+	}
+
+	return shortcuts, nil
+}
+
+// UpdateKeyboardShortcuts changes the keys of the given actions, an empty list disables an action.
+func (c *Client) UpdateKeyboardShortcuts(shortcuts KeyboardShortcuts) (KeyboardShortcuts, error) {
+	body, err := c.request.Put("/v1/me/keyboard-shortcuts", shortcuts)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var updatedShortcuts KeyboardShortcuts
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&updatedShortcuts); err != nil {
+		return nil, fmt.Errorf("miniflux: json error (%v)", err)
+	}
+
+	return updatedShortcuts, nil
+}
+
 // Users returns all users.
 func (c *Client) Users() (Users, error) {
 	body, err := c.request.Get("/v1/users")
@@ -130,201 +176,203 @@ func (c *Client) DeleteUser(userID int64) error {
 	return nil
 }
 
-// Discover try to find subscriptions from a website.
-func (c *Client) Discover(url string) (Subscriptions, error) {
-	body, err := c.request.Post("/v1/discover", map[string]string{"url": url})
+// FreezeUser rejects the changes of a user for the given number of seconds.
+func (c *Client) FreezeUser(userID int64, duration int, message string) (*User, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/users/%d/freeze", userID), map[string]interface{}{
+		"duration": duration,
+		"message":  message,
+	})
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var subscriptions Subscriptions
+	var u *User
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&subscriptions); err != nil {
+	if err := decoder.Decode(&u); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return subscriptions, nil
+	return u, nil
 }
 
-// Categories gets the list of categories.
-func (c *Client) Categories() (Categories, error) {
-	body, err := c.request.Get("/v1/categories")
+// UnfreezeUser allows the changes of a frozen user again.
+func (c *Client) UnfreezeUser(userID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/users/%d/freeze", userID))
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer body.Close()
+	body.Close()
+	return nil
+}
 
-	var categories Categories
-	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&categories); err != nil {
-		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+// ApproveUser enables an account created from the signup page (admin only).
+func (c *Client) ApproveUser(userID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/users/%d/approve", userID), nil)
+	if err != nil {
+		return err
 	}
-
-	return categories, nil
+	body.Close()
+	return nil
 }
 
-// CreateCategory creates a new category.
-func (c *Client) CreateCategory(title string) (*Category, error) {
-	body, err := c.request.Post("/v1/categories", map[string]interface{}{
-		"title": title,
-	})
-
+// Invitations gets the unexpired signup invitations (admin only).
+func (c *Client) Invitations() ([]*Invitation, error) {
+	body, err := c.request.Get("/v1/invitations")
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var category *Category
+	var invitations []*Invitation
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&category); err != nil {
+	if err := decoder.Decode(&invitations); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return category, nil
+	return invitations, nil
 }
 
-// UpdateCategory updates a category.
-func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, error) {
-	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), map[string]interface{}{
-		"title": title,
+// CreateInvitation creates a signup invitation and returns its code, any address can use it when the email is empty (admin only).
+func (c *Client) CreateInvitation(email string) (*Invitation, string, error) {
+	body, err := c.request.Post("/v1/invitations", map[string]interface{}{
+		"email": email,
 	})
-
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer body.Close()
 
-	var category *Category
+	var result struct {
+		Invitation *Invitation `json:"invitation"`
+		Code       string      `json:"code"`
+	}
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&category); err != nil {
-		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return category, nil
+	return result.Invitation, result.Code, nil
 }
 
-// DeleteCategory removes a category.
-func (c *Client) DeleteCategory(categoryID int64) error {
-	body, err := c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
+// DeleteInvitation revokes a signup invitation (admin only).
+func (c *Client) DeleteInvitation(invitationID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/invitations/%d", invitationID))
 	if err != nil {
 		return err
 	}
-	defer body.Close()
-
+	body.Close()
 	return nil
 }
 
-// Feeds gets all feeds.
-func (c *Client) Feeds() (Feeds, error) {
-	body, err := c.request.Get("/v1/feeds")
+// ServiceAccounts returns the service accounts of the authenticated user.
+func (c *Client) ServiceAccounts() ([]*ServiceAccount, error) {
+	body, err := c.request.Get("/v1/service-accounts")
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var feeds Feeds
+	var accounts []*ServiceAccount
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&feeds); err != nil {
+	if err := decoder.Decode(&accounts); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return feeds, nil
+	return accounts, nil
 }
 
-// Export creates OPML file.
-func (c *Client) Export() ([]byte, error) {
-	body, err := c.request.Get("/v1/export")
+// CreateServiceAccount creates a service account limited to the given scopes,
+// it has access to all categories when the list of categories is empty.
+func (c *Client) CreateServiceAccount(name string, scopes []string, categoryIDs []int64) (*ServiceAccount, error) {
+	body, err := c.request.Post("/v1/service-accounts", &ServiceAccountModification{
+		Name:        &name,
+		Scopes:      &scopes,
+		CategoryIDs: &categoryIDs,
+	})
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	opml, err := ioutil.ReadAll(body)
-	if err != nil {
-		return nil, err
+	var account *ServiceAccount
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&account); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return opml, nil
-}
-
-// Import imports an OPML file.
-func (c *Client) Import(f io.ReadCloser) error {
-	_, err := c.request.PostFile("/v1/import", f)
-	return err
+	return account, nil
 }
 
-// Feed gets a feed.
-func (c *Client) Feed(feedID int64) (*Feed, error) {
-	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d", feedID))
+// UpdateServiceAccount changes the name, the scopes or the categories of a service account.
+func (c *Client) UpdateServiceAccount(accountID int64, changes *ServiceAccountModification) (*ServiceAccount, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/service-accounts/%d", accountID), changes)
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var feed *Feed
+	var account *ServiceAccount
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&feed); err != nil {
+	if err := decoder.Decode(&account); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return feed, nil
+	return account, nil
 }
 
-// CreateFeed creates a new feed.
-func (c *Client) CreateFeed(url string, categoryID int64) (int64, error) {
-	body, err := c.request.Post("/v1/feeds", map[string]interface{}{
-		"feed_url":    url,
-		"category_id": categoryID,
-	})
+// DeleteServiceAccount removes a service account and revokes its API keys.
+func (c *Client) DeleteServiceAccount(accountID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/service-accounts/%d", accountID))
 	if err != nil {
-		return 0, err
-	}
-	defer body.Close()
-
-	type result struct {
-		FeedID int64 `json:"feed_id"`
-	}
-
-	var r result
-	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&r); err != nil {
-		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+		return err
 	}
-
-	return r.FeedID, nil
+	body.Close()
+	return nil
 }
 
-// UpdateFeed updates a feed.
-func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModification) (*Feed, error) {
-	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d", feedID), feedChanges)
+// APIKeys returns the keys of a service account.
+func (c *Client) APIKeys(accountID int64) ([]*APIKey, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/service-accounts/%d/keys", accountID))
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var f *Feed
+	var keys []*APIKey
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&f); err != nil {
+	if err := decoder.Decode(&keys); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return f, nil
+	return keys, nil
 }
 
-// RefreshFeed refresh a feed.
-func (c *Client) RefreshFeed(feedID int64) error {
-	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/refresh", feedID), nil)
+// CreateAPIKey creates a key for a service account and returns its secret value, it cannot be retrieved later.
+func (c *Client) CreateAPIKey(accountID int64, description string) (*APIKey, string, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/service-accounts/%d/keys", accountID), map[string]interface{}{
+		"description": description,
+	})
 	if err != nil {
-		return err
+		return nil, "", err
 	}
-	body.Close()
-	return nil
+	defer body.Close()
+
+	var result struct {
+		APIKey *APIKey `json:"api_key"`
+		Key    string  `json:"key"`
+	}
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.APIKey, result.Key, nil
 }
 
-// DeleteFeed removes a feed.
-func (c *Client) DeleteFeed(feedID int64) error {
-	body, err := c.request.Delete(fmt.Sprintf("/v1/feeds/%d", feedID))
+// DeleteAPIKey revokes a key of a service account.
+func (c *Client) DeleteAPIKey(accountID, keyID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/service-accounts/%d/keys/%d", accountID, keyID))
 	if err != nil {
 		return err
 	}
@@ -332,135 +380,1084 @@ func (c *Client) DeleteFeed(feedID int64) error {
 	return nil
 }
 
-// FeedIcon gets a feed icon.
-func (c *Client) FeedIcon(feedID int64) (*FeedIcon, error) {
-	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/icon", feedID))
+// Discover try to find subscriptions from a website.
+func (c *Client) Discover(url string) (Subscriptions, error) {
+	body, err := c.request.Post("/v1/discover", map[string]string{"url": url})
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var feedIcon *FeedIcon
+	var subscriptions Subscriptions
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&feedIcon); err != nil {
+	if err := decoder.Decode(&subscriptions); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return feedIcon, nil
+	return subscriptions, nil
 }
 
-// FeedEntry gets a single feed entry.
-func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
-	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/entries/%d", feedID, entryID))
+// Categories gets the list of categories.
+func (c *Client) Categories() (Categories, error) {
+	body, err := c.request.Get("/v1/categories")
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var entry *Entry
+	var categories Categories
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&entry); err != nil {
+	if err := decoder.Decode(&categories); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return entry, nil
+	return categories, nil
 }
 
-// Entry gets a single entry.
-func (c *Client) Entry(entryID int64) (*Entry, error) {
-	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d", entryID))
+// CreateCategory creates a new category.
+func (c *Client) CreateCategory(title string) (*Category, error) {
+	body, err := c.request.Post("/v1/categories", map[string]interface{}{
+		"title": title,
+	})
+
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var entry *Entry
+	var category *Category
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&entry); err != nil {
+	if err := decoder.Decode(&category); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return entry, nil
+	return category, nil
 }
 
-// Entries fetch entries.
-func (c *Client) Entries(filter *Filter) (*EntryResultSet, error) {
-	path := buildFilterQueryString("/v1/entries", filter)
+// UpdateCategory updates a category.
+func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), map[string]interface{}{
+		"title": title,
+	})
 
-	body, err := c.request.Get(path)
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var result EntryResultSet
+	var category *Category
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&result); err != nil {
+	if err := decoder.Decode(&category); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return &result, nil
+	return category, nil
 }
 
-// FeedEntries fetch feed entries.
-func (c *Client) FeedEntries(feedID int64, filter *Filter) (*EntryResultSet, error) {
-	path := buildFilterQueryString(fmt.Sprintf("/v1/feeds/%d/entries", feedID), filter)
-
-	body, err := c.request.Get(path)
+// ModifyCategory updates the given fields of a category.
+func (c *Client) ModifyCategory(categoryID int64, categoryChanges *CategoryModification) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), categoryChanges)
 	if err != nil {
 		return nil, err
 	}
 	defer body.Close()
 
-	var result EntryResultSet
+	var category *Category
 	decoder := json.NewDecoder(body)
-	if err := decoder.Decode(&result); err != nil {
+	if err := decoder.Decode(&category); err != nil {
 		return nil, fmt.Errorf("miniflux: response error (%v)", err)
 	}
 
-	return &result, nil
+	return category, nil
 }
 
-// UpdateEntries updates the status of a list of entries.
-func (c *Client) UpdateEntries(entryIDs []int64, status string) error {
-	type payload struct {
-		EntryIDs []int64 `json:"entry_ids"`
-		Status   string  `json:"status"`
-	}
-
-	body, err := c.request.Put("/v1/entries", &payload{EntryIDs: entryIDs, Status: status})
+// DeleteCategory removes a category.
+func (c *Client) DeleteCategory(categoryID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
 	if err != nil {
 		return err
 	}
-	body.Close()
+	defer body.Close()
 
 	return nil
 }
 
-// ToggleBookmark toggles entry bookmark value.
-func (c *Client) ToggleBookmark(entryID int64) error {
-	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/bookmark", entryID), nil)
+// RestoreCategory restores a category and its feeds from the trash.
+func (c *Client) RestoreCategory(categoryID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d/restore", categoryID), nil)
 	if err != nil {
 		return err
 	}
 	body.Close()
-
 	return nil
 }
 
-// New returns a new Miniflux client.
-func New(endpoint, username, password string) *Client {
-	return &Client{request: &request{endpoint: endpoint, username: username, password: password}}
-}
+// CategoryShares gets the users having a read-only access to a category.
+func (c *Client) CategoryShares(categoryID int64) ([]*CategoryShare, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/categories/%d/shares", categoryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
 
-func buildFilterQueryString(path string, filter *Filter) string {
-	if filter != nil {
-		values := url.Values{}
+	var shares []*CategoryShare
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&shares); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
 
-		if filter.Status != "" {
+	return shares, nil
+}
+
+// ShareCategory gives another user a read-only access to a category.
+func (c *Client) ShareCategory(categoryID int64, username string) error {
+	body, err := c.request.Post(fmt.Sprintf("/v1/categories/%d/shares", categoryID), map[string]interface{}{
+		"username": username,
+	})
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// UnshareCategory revokes the access of another user to a category.
+func (c *Client) UnshareCategory(categoryID, userID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/categories/%d/shares/%d", categoryID, userID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// SharedCategories gets the categories shared by other users.
+func (c *Client) SharedCategories() ([]*SharedCategory, error) {
+	body, err := c.request.Get("/v1/shared/categories")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var categories []*SharedCategory
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&categories); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return categories, nil
+}
+
+// SharedCategoryEntries fetch the entries of a category shared by another user.
+func (c *Client) SharedCategoryEntries(categoryID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/shared/categories/%d/entries", categoryID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// RefreshCategory refreshes all feeds of a category in the background and returns the job ID.
+func (c *Client) RefreshCategory(categoryID int64) (int64, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d/refresh", categoryID), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		JobID int64 `json:"job_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.JobID, nil
+}
+
+// Feeds gets all feeds.
+func (c *Client) Feeds() (Feeds, error) {
+	body, err := c.request.Get("/v1/feeds")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feeds Feeds
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feeds); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feeds, nil
+}
+
+// Export creates OPML file.
+func (c *Client) Export() ([]byte, error) {
+	body, err := c.request.Get("/v1/export")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	opml, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return opml, nil
+}
+
+// Import imports an OPML file.
+func (c *Client) Import(f io.ReadCloser) error {
+	_, err := c.request.PostFile("/v1/import", f)
+	return err
+}
+
+// Feed gets a feed.
+func (c *Client) Feed(feedID int64) (*Feed, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feed *Feed
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feed); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feed, nil
+}
+
+// CreateFeed creates a new feed.
+func (c *Client) CreateFeed(url string, categoryID int64) (int64, error) {
+	body, err := c.request.Post("/v1/feeds", map[string]interface{}{
+		"feed_url":    url,
+		"category_id": categoryID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		FeedID int64 `json:"feed_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.FeedID, nil
+}
+
+// CreatePageWatchFeed subscribes to the changes of the region of a web page matching a CSS selector.
+func (c *Client) CreatePageWatchFeed(url string, categoryID int64, selector string) (int64, error) {
+	body, err := c.request.Post("/v1/feeds", map[string]interface{}{
+		"feed_url":       url,
+		"category_id":    categoryID,
+		"watch_selector": selector,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		FeedID int64 `json:"feed_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.FeedID, nil
+}
+
+// PreviewFeed fetches the latest entries of a feed without subscribing to it.
+func (c *Client) PreviewFeed(url string, limit int) (*FeedPreview, error) {
+	body, err := c.request.Post("/v1/feeds/preview", map[string]interface{}{
+		"feed_url": url,
+		"limit":    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var preview *FeedPreview
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&preview); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return preview, nil
+}
+
+// UpdateFeedsCategory moves a list of feeds to another category.
+func (c *Client) UpdateFeedsCategory(feedIDs []int64, categoryID int64) error {
+	type payload struct {
+		FeedIDs    []int64 `json:"feed_ids"`
+		CategoryID int64   `json:"category_id"`
+	}
+
+	body, err := c.request.Put("/v1/feeds/category", &payload{FeedIDs: feedIDs, CategoryID: categoryID})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// UpdateFeed updates a feed.
+func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModification) (*Feed, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d", feedID), feedChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var f *Feed
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&f); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return f, nil
+}
+
+// RefreshFeed refresh a feed.
+func (c *Client) RefreshFeed(feedID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/refresh", feedID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// RefreshAllFeeds refreshes all feeds in the background and returns the job ID.
+func (c *Client) RefreshAllFeeds() (int64, error) {
+	body, err := c.request.Put("/v1/feeds/refresh", nil)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		JobID int64 `json:"job_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.JobID, nil
+}
+
+// RefreshJob gets the progress of a refresh job.
+func (c *Client) RefreshJob(jobID int64) (*RefreshJob, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/jobs/%d", jobID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var refreshJob *RefreshJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&refreshJob); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return refreshJob, nil
+}
+
+// DeleteFeed removes a feed.
+func (c *Client) DeleteFeed(feedID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/feeds/%d", feedID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// RestoreFeed restores a feed from the trash.
+func (c *Client) RestoreFeed(feedID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/restore", feedID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// Trash gets the removed categories and feeds.
+func (c *Client) Trash() (*Trash, error) {
+	body, err := c.request.Get("/v1/trash")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var trash *Trash
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&trash); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return trash, nil
+}
+
+// FeedIcon gets a feed icon.
+func (c *Client) FeedIcon(feedID int64) (*FeedIcon, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/icon", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedIcon *FeedIcon
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feedIcon); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedIcon, nil
+}
+
+// FeedStats gets the publishing, reading and fetching statistics of a feed.
+func (c *Client) FeedStats(feedID int64) (*FeedStats, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/stats", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var stats *FeedStats
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&stats); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return stats, nil
+}
+
+// LeastReadFeeds gets the feeds without any entry read for the given number of months.
+func (c *Client) LeastReadFeeds(months int) ([]*LeastReadFeed, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/least-read?months=%d", months))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feeds []*LeastReadFeed
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feeds); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feeds, nil
+}
+
+// PendingFeeds gets the subscriptions of all users waiting for an approval (admin only).
+func (c *Client) PendingFeeds() ([]*PendingFeed, error) {
+	body, err := c.request.Get("/v1/feeds/pending")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feeds []*PendingFeed
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feeds); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feeds, nil
+}
+
+// ApproveFeed approves a pending subscription, its entries are downloaded in the background (admin only).
+func (c *Client) ApproveFeed(feedID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/approve", feedID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// RejectFeed rejects a pending subscription, the feed is removed (admin only).
+func (c *Client) RejectFeed(feedID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/reject", feedID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+// FeedResponses gets the documents archived for a feed (admin only).
+func (c *Client) FeedResponses(feedID int64) ([]*FeedResponse, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/responses", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var responses []*FeedResponse
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&responses); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return responses, nil
+}
+
+// Outbox gets the backlog and the Pub/Sub events with the given status, "failed" or "discarded" (admin only).
+func (c *Client) Outbox(status string, limit int) (*OutboxResultSet, error) {
+	values := url.Values{}
+	values.Set("status", status)
+	values.Set("limit", strconv.Itoa(limit))
+
+	body, err := c.request.Get("/v1/pubsub/outbox?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result OutboxResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// ReplayOutbox publishes again the given failed events, or the oldest ones when no ID is given (admin only).
+func (c *Client) ReplayOutbox(eventIDs []int64) (*OutboxReplay, error) {
+	type payload struct {
+		EventIDs []int64 `json:"event_ids,omitempty"`
+	}
+
+	body, err := c.request.Post("/v1/pubsub/outbox/replay", &payload{EventIDs: eventIDs})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result OutboxReplay
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// DiscardOutbox marks the given failed events as discarded (admin only).
+func (c *Client) DiscardOutbox(eventIDs []int64) error {
+	body, err := c.request.Post("/v1/pubsub/outbox/discard", map[string]interface{}{"event_ids": eventIDs})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// InstanceStats gets the statistics of all users (admin only).
+func (c *Client) InstanceStats() (*InstanceStats, error) {
+	body, err := c.request.Get("/v1/stats")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var stats InstanceStats
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&stats); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &stats, nil
+}
+
+// FeedResponseContent downloads a document archived for a feed (admin only).
+func (c *Client) FeedResponseContent(feedID, responseID int64) ([]byte, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/responses/%d", feedID, responseID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+// FeedEntry gets a single feed entry.
+func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/entries/%d", feedID, entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entry *Entry
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entry); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entry, nil
+}
+
+// Entry gets a single entry.
+func (c *Client) Entry(entryID int64) (*Entry, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entry *Entry
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entry); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entry, nil
+}
+
+// Entries fetch entries.
+func (c *Client) Entries(filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString("/v1/entries", filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// FeedEntries fetch feed entries.
+func (c *Client) FeedEntries(feedID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/feeds/%d/entries", feedID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// RecentlyReadEntries fetch the last read entries, most recently read first.
+func (c *Client) RecentlyReadEntries(limit int) (*EntryResultSet, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/history?limit=%d", limit))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// RelatedEntries fetch the entries covering the same story as the given entry.
+func (c *Client) RelatedEntries(entryID int64, limit int) (*EntryResultSet, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/related?limit=%d", entryID, limit))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// SemanticSearch fetch the entries closest in meaning to the query, closest first.
+func (c *Client) SemanticSearch(query string, limit int) (*EntryResultSet, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("limit", strconv.Itoa(limit))
+
+	body, err := c.request.Get("/v1/entries/semantic-search?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryChanges returns the entries changed and deleted since the token of the previous call, an empty token returns all entries.
+// Unlike SyncEntries, it reports the deleted entries and does not depend on the client clock.
+func (c *Client) EntryChanges(token string, limit int) (*SyncChanges, error) {
+	values := url.Values{}
+	values.Set("limit", strconv.Itoa(limit))
+	if token != "" {
+		values.Set("token", token)
+	}
+
+	body, err := c.request.Get("/v1/sync?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var changes SyncChanges
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&changes); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &changes, nil
+}
+
+// ExportEntries downloads the starred entries, or the given entries, as an EPUB book ("epub") or a printable HTML document ("html").
+func (c *Client) ExportEntries(format string, entryIDs []int64) ([]byte, error) {
+	values := url.Values{}
+	values.Set("format", format)
+	for _, entryID := range entryIDs {
+		values.Add("entry_id", strconv.FormatInt(entryID, 10))
+	}
+
+	body, err := c.request.Get("/v1/entries/export?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+// EntryDays fetch entries grouped by publication day.
+func (c *Client) EntryDays(filter *Filter) (*EntryDayResultSet, error) {
+	return c.entryDays(buildFilterQueryString("/v1/entries", filter))
+}
+
+// FeedEntryDays fetch feed entries grouped by publication day.
+func (c *Client) FeedEntryDays(feedID int64, filter *Filter) (*EntryDayResultSet, error) {
+	return c.entryDays(buildFilterQueryString(fmt.Sprintf("/v1/feeds/%d/entries", feedID), filter))
+}
+
+func (c *Client) entryDays(path string) (*EntryDayResultSet, error) {
+	if strings.Contains(path, "?") {
+		path += "&group_by=day"
+	} else {
+		path += "?group_by=day"
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryDayResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryClusters fetch entries grouped by story.
+func (c *Client) EntryClusters(filter *Filter) (*EntryClusterResultSet, error) {
+	path := buildFilterQueryString("/v1/entries", filter)
+	if strings.Contains(path, "?") {
+		path += "&group_by=story"
+	} else {
+		path += "?group_by=story"
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryClusterResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateEntries updates the status of a list of entries.
+func (c *Client) UpdateEntries(entryIDs []int64, status string) error {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+		Status   string  `json:"status"`
+	}
+
+	body, err := c.request.Put("/v1/entries", &payload{EntryIDs: entryIDs, Status: status})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// UpdateEntriesBookmark stars or unstars a list of entries.
+func (c *Client) UpdateEntriesBookmark(entryIDs []int64, starred bool) error {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+		Starred  bool    `json:"starred"`
+	}
+
+	body, err := c.request.Put("/v1/entries/bookmark", &payload{EntryIDs: entryIDs, Starred: starred})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// UpdateEntriesScore sets the score of a list of entries.
+func (c *Client) UpdateEntriesScore(scores []*EntryScore) error {
+	type payload struct {
+		Scores []*EntryScore `json:"scores"`
+	}
+
+	body, err := c.request.Put("/v1/entries/score", &payload{Scores: scores})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// SaveURL fetches a web page and stores it as an entry of the "Saved pages" feed.
+func (c *Client) SaveURL(url string) (*Entry, error) {
+	body, err := c.request.Post("/v1/entries/save-url", map[string]interface{}{"url": url})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entry *Entry
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entry); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entry, nil
+}
+
+// EntrySnapshot gets the saved copy of the web page of an entry.
+func (c *Client) EntrySnapshot(entryID int64) (*EntrySnapshot, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/snapshot", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var snapshot *EntrySnapshot
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return snapshot, nil
+}
+
+// RefreshEntrySnapshot saves a new copy of the web page of an entry.
+func (c *Client) RefreshEntrySnapshot(entryID int64) (*EntrySnapshot, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/snapshot", entryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var snapshot *EntrySnapshot
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return snapshot, nil
+}
+
+// SummarizeEntry generates and saves the summary of an entry.
+func (c *Client) SummarizeEntry(entryID int64) (string, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/summary", entryID), nil)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	var result struct {
+		Summary string `json:"summary"`
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return "", fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Summary, nil
+}
+
+// UndoMarkAsRead marks as unread the entries of the last mark all as read operation
+// and returns the number of entries restored.
+func (c *Client) UndoMarkAsRead() (int64, error) {
+	body, err := c.request.Put("/v1/entries/undo-mark-as-read", nil)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var result struct {
+		Count int64 `json:"count"`
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Count, nil
+}
+
+// MarkAllAsRead marks all unread entries of the given user as read.
+func (c *Client) MarkAllAsRead(userID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/users/%d/mark-all-as-read", userID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// ToggleBookmark toggles entry bookmark value.
+func (c *Client) ToggleBookmark(entryID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/bookmark", entryID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// Batch executes the requests in order, the response of each request is returned even when some of them fail.
+func (c *Client) Batch(requests []*BatchRequest) ([]*BatchResponse, error) {
+	body, err := c.request.Post("/v1/batch", requests)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var responses []*BatchResponse
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&responses); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return responses, nil
+}
+
+// WithIdempotencyKey returns a client sending the given Idempotency-Key header, the server
+// executes once the entry status changes, feed creations and mark all as read requests sent with the same key.
+func (c *Client) WithIdempotencyKey(key string) *Client {
+	r := *c.request
+	r.headers = make(map[string]string)
+	for name, value := range c.request.headers {
+		r.headers[name] = value
+	}
+	r.headers["Idempotency-Key"] = key
+	return &Client{request: &r}
+}
+
+// New returns a new Miniflux client.
+func New(endpoint, username, password string) *Client {
+	return &Client{request: &request{endpoint: endpoint, username: username, password: password}}
+}
+
+// NewWithAPIKey returns a new Miniflux client authenticated with the API key of a service account.
+func NewWithAPIKey(endpoint, apiKey string) *Client {
+	return &Client{request: &request{endpoint: endpoint, apiKey: apiKey}}
+}
+
+// NewWithHTTPClient returns a new Miniflux client that sends requests with the given HTTP client.
+func NewWithHTTPClient(endpoint, username, password string, httpClient *http.Client) *Client {
+	return &Client{request: &request{endpoint: endpoint, username: username, password: password, client: httpClient}}
+}
+
+func buildFilterQueryString(path string, filter *Filter) string {
+	if filter != nil {
+		values := url.Values{}
+
+		if filter.Status != "" {
 			values.Set("status", filter.Status)
 		}
 
+		for _, status := range filter.Statuses {
+			values.Add("status", status)
+		}
+
 		if filter.Direction != "" {
 			values.Set("direction", filter.Direction)
 		}
@@ -469,6 +1466,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("order", filter.Order)
 		}
 
+		if filter.Seed != 0 {
+			values.Set("seed", strconv.FormatInt(filter.Seed, 10))
+		}
+
 		if filter.Limit >= 0 {
 			values.Set("limit", strconv.Itoa(filter.Limit))
 		}
@@ -493,14 +1494,40 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("before_entry_id", strconv.FormatInt(filter.BeforeEntryID, 10))
 		}
 
+		if filter.PublishedBefore > 0 {
+			values.Set("published_before", strconv.FormatInt(filter.PublishedBefore, 10))
+		}
+
+		if filter.PublishedAfter > 0 {
+			values.Set("published_after", strconv.FormatInt(filter.PublishedAfter, 10))
+		}
+
+		if filter.ChangedAfter > 0 {
+			values.Set("changed_after", strconv.FormatInt(filter.ChangedAfter, 10))
+		}
+
 		if filter.Starred {
 			values.Set("starred", "1")
+		} else if filter.Unstarred {
+			values.Set("starred", "0")
 		}
 
 		if filter.Search != "" {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.MinScore != nil {
+			values.Set("min_score", strconv.FormatFloat(*filter.MinScore, 'f', -1, 64))
+		}
+
+		if filter.MaxScore != nil {
+			values.Set("max_score", strconv.FormatFloat(*filter.MaxScore, 'f', -1, 64))
+		}
+
+		if filter.MinJunkScore > 0 {
+			values.Set("min_junk_score", strconv.Itoa(filter.MinJunkScore))
+		}
+
 		path = fmt.Sprintf("%s?%s", path, values.Encode())
 	}
 
@@ -5,6 +5,7 @@
 package client // import "miniflux.app/client"
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -18,41 +19,116 @@ const (
 
 // User represents a user in the system.
 type User struct {
-	ID             int64             `json:"id"`
-	Username       string            `json:"username"`
-	Password       string            `json:"password,omitempty"`
-	IsAdmin        bool              `json:"is_admin"`
-	Theme          string            `json:"theme"`
-	Language       string            `json:"language"`
-	Timezone       string            `json:"timezone"`
-	EntryDirection string            `json:"entry_sorting_direction"`
-	LastLoginAt    *time.Time        `json:"last_login_at"`
-	Extra          map[string]string `json:"extra"`
+	ID               int64             `json:"id"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password,omitempty"`
+	IsAdmin          bool              `json:"is_admin"`
+	Theme            string            `json:"theme"`
+	Language         string            `json:"language"`
+	Timezone         string            `json:"timezone"`
+	EntryDirection   string            `json:"entry_sorting_direction"`
+	EntriesPerPage   int               `json:"entries_per_page"`
+	ShowReadEntries  bool              `json:"show_read_entries"`
+	ShowAbsoluteTime bool              `json:"show_absolute_time"`
+	ImageDisplay     string            `json:"image_display"`
+	NSFWDisplay      string            `json:"nsfw_display"`
+	JunkThreshold    int               `json:"junk_threshold"`
+	QuietHoursStart  string            `json:"quiet_hours_start"`
+	QuietHoursEnd    string            `json:"quiet_hours_end"`
+	LastLoginAt      *time.Time        `json:"last_login_at"`
+	FrozenUntil      *time.Time        `json:"frozen_until,omitempty"`
+	FreezeMessage    string            `json:"freeze_message,omitempty"`
+	Email            string            `json:"email,omitempty"`
+	Verified         bool              `json:"verified"`
+	Pending          bool              `json:"pending"`
+	Extra            map[string]string `json:"extra"`
 }
 
 func (u User) String() string {
 	return fmt.Sprintf("#%d - %s (admin=%v)", u.ID, u.Username, u.IsAdmin)
 }
 
+// Invitation represents a signup invitation created by an administrator.
+type Invitation struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	Email     string     `json:"email"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+// ServiceAccount represents a non-interactive identity acting on the data of its owner with API keys.
+type ServiceAccount struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Name        string    `json:"name"`
+	Scopes      []string  `json:"scopes"`
+	CategoryIDs []int64   `json:"category_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ServiceAccountModification is used to create or update a service account.
+type ServiceAccountModification struct {
+	Name        *string   `json:"name"`
+	Scopes      *[]string `json:"scopes"`
+	CategoryIDs *[]int64  `json:"category_ids"`
+}
+
+// APIKey represents a key of a service account, the secret value is only returned at creation.
+type APIKey struct {
+	ID               int64      `json:"id"`
+	ServiceAccountID int64      `json:"service_account_id"`
+	Description      string     `json:"description"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+}
+
 // UserModification is used to update a user.
 type UserModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	IsAdmin          *bool   `json:"is_admin"`
+	Theme            *string `json:"theme"`
+	Language         *string `json:"language"`
+	Timezone         *string `json:"timezone"`
+	EntryDirection   *string `json:"entry_sorting_direction"`
+	EntriesPerPage   *int    `json:"entries_per_page"`
+	ShowReadEntries  *bool   `json:"show_read_entries"`
+	ShowAbsoluteTime *bool   `json:"show_absolute_time"`
+	ImageDisplay     *string `json:"image_display"`
+	NSFWDisplay      *string `json:"nsfw_display"`
+	JunkThreshold    *int    `json:"junk_threshold"`
+	QuietHoursStart  *string `json:"quiet_hours_start"`
+	QuietHoursEnd    *string `json:"quiet_hours_end"`
+	Email            *string `json:"email"`
 }
 
 // Users represents a list of users.
 type Users []User
 
+// KeyboardShortcuts maps a user interface action to the keys that trigger it.
+type KeyboardShortcuts map[string][]string
+
 // Category represents a category in the system.
 type Category struct {
-	ID     int64  `json:"id,omitempty"`
-	Title  string `json:"title,omitempty"`
-	UserID int64  `json:"user_id,omitempty"`
+	ID                int64      `json:"id,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	UserID            int64      `json:"user_id,omitempty"`
+	MarkReadAfterDays int        `json:"mark_read_after_days"`
+	ImageDisplay      string     `json:"image_display"`
+	NSFW              bool       `json:"nsfw"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+	Version           int        `json:"version,omitempty"`
+}
+
+// CategoryModification represents changes for a category.
+type CategoryModification struct {
+	Title             *string `json:"title,omitempty"`
+	MarkReadAfterDays *int    `json:"mark_read_after_days,omitempty"`
+	ImageDisplay      *string `json:"image_display,omitempty"`
+	NSFW              *bool   `json:"nsfw,omitempty"`
+	Version           *int    `json:"version,omitempty"`
 }
 
 func (c Category) String() string {
@@ -62,6 +138,20 @@ func (c Category) String() string {
 // Categories represents a list of categories.
 type Categories []*Category
 
+// CategoryShare represents a user with a read-only access to a category.
+type CategoryShare struct {
+	CategoryID int64     `json:"category_id"`
+	UserID     int64     `json:"user_id"`
+	Username   string    `json:"username"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// SharedCategory represents a category shared by another user.
+type SharedCategory struct {
+	Category      *Category `json:"category"`
+	OwnerUsername string    `json:"owner_username"`
+}
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -78,38 +168,78 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at,omitempty"`
-	EtagHeader         string    `json:"etag_header,omitempty"`
-	LastModifiedHeader string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg    string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount  int       `json:"parsing_error_count,omitempty"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
+	ID                 int64      `json:"id"`
+	UserID             int64      `json:"user_id"`
+	FeedURL            string     `json:"feed_url"`
+	SiteURL            string     `json:"site_url"`
+	Title              string     `json:"title"`
+	CheckedAt          time.Time  `json:"checked_at,omitempty"`
+	EtagHeader         string     `json:"etag_header,omitempty"`
+	LastModifiedHeader string     `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg    string     `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount  int        `json:"parsing_error_count,omitempty"`
+	ScraperRules       string     `json:"scraper_rules"`
+	RewriteRules       string     `json:"rewrite_rules"`
+	Script             string     `json:"script"`
+	Crawler            bool       `json:"crawler"`
+	ScraperMaxPages    int        `json:"scraper_max_pages"`
+	EntryOpenMode      string     `json:"entry_open_mode"`
+	ImageDisplay       string     `json:"image_display"`
+	SummaryWords       int        `json:"summary_words"`
+	SummarizeEntries   bool       `json:"summarize_entries"`
+	NSFW               bool       `json:"nsfw"`
+	Priority           string     `json:"priority"`
+	MutedUntil         *time.Time `json:"muted_until"`
+	MarkReadAfterDays  int        `json:"mark_read_after_days"`
+	MaxEntries         int        `json:"max_entries"`
+	OverflowPolicy     string     `json:"overflow_policy"`
+	EntryMatching      string     `json:"entry_matching"`
+	WatchSelector      string     `json:"watch_selector"`
+	UserAgent          string     `json:"user_agent"`
+	Username           string     `json:"username"`
+	Password           string     `json:"password"`
+	Category           *Category  `json:"category,omitempty"`
+	Entries            Entries    `json:"entries,omitempty"`
+	DeletedAt          *time.Time `json:"deleted_at,omitempty"`
+	Pending            bool       `json:"pending"`
+	Version            int        `json:"version"`
+}
+
+// FeedPreview represents a feed fetched without being saved.
+type FeedPreview struct {
+	FeedURL string  `json:"feed_url"`
+	SiteURL string  `json:"site_url"`
+	Title   string  `json:"title"`
+	Entries Entries `json:"entries"`
 }
 
 // FeedModification represents changes for a feed.
 type FeedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL          *string `json:"feed_url"`
+	SiteURL          *string `json:"site_url"`
+	Title            *string `json:"title"`
+	ScraperRules     *string `json:"scraper_rules"`
+	RewriteRules     *string `json:"rewrite_rules"`
+	Script           *string `json:"script"`
+	Crawler          *bool   `json:"crawler"`
+	ScraperMaxPages  *int    `json:"scraper_max_pages"`
+	EntryOpenMode    *string `json:"entry_open_mode"`
+	ImageDisplay     *string `json:"image_display"`
+	SummaryWords     *int    `json:"summary_words"`
+	SummarizeEntries *bool   `json:"summarize_entries"`
+	NSFW             *bool   `json:"nsfw"`
+	Priority         *string `json:"priority"`
+	MutedUntil       *string `json:"muted_until"`
+	MarkReadAfter    *int    `json:"mark_read_after_days"`
+	MaxEntries       *int    `json:"max_entries"`
+	OverflowPolicy   *string `json:"overflow_policy"`
+	EntryMatching    *string `json:"entry_matching"`
+	WatchSelector    *string `json:"watch_selector"`
+	UserAgent        *string `json:"user_agent"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	CategoryID       *int64  `json:"category_id"`
+	Version          *int    `json:"version"`
 }
 
 // FeedIcon represents the feed icon.
@@ -122,22 +252,133 @@ type FeedIcon struct {
 // Feeds represents a list of feeds.
 type Feeds []*Feed
 
+// Trash contains the removed categories and feeds that can be restored.
+type Trash struct {
+	Categories Categories `json:"categories"`
+	Feeds      Feeds      `json:"feeds"`
+}
+
+// FeedStats represents the publishing, reading and fetching statistics of a feed.
+type FeedStats struct {
+	FeedID           int64   `json:"feed_id"`
+	EntriesPerWeek   float64 `json:"entries_per_week"`
+	AverageEntrySize int     `json:"average_entry_size"`
+	ReadRatio        float64 `json:"read_ratio"`
+	FetchCount       int     `json:"fetch_count"`
+	FetchSuccessRate float64 `json:"fetch_success_rate"`
+}
+
+// LeastReadFeed represents a feed without any entry read during the report period.
+type LeastReadFeed struct {
+	Feed        *Feed      `json:"feed"`
+	LastReadAt  *time.Time `json:"last_read_at"`
+	UnreadCount int        `json:"unread_count"`
+}
+
+// PendingFeed represents a subscription waiting for the approval of an administrator.
+type PendingFeed struct {
+	Feed     *Feed  `json:"feed"`
+	Username string `json:"username"`
+}
+
+// FeedResponse represents a feed document archived on the server.
+type FeedResponse struct {
+	ID           int64     `json:"id"`
+	FeedID       int64     `json:"feed_id"`
+	URL          string    `json:"url"`
+	StatusCode   int       `json:"status_code"`
+	ContentType  string    `json:"content_type"`
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"last_modified"`
+	Size         int       `json:"size"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// OutboxEvent represents a Pub/Sub event that could not be published.
+type OutboxEvent struct {
+	ID         int64     `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   int64     `json:"entity_id"`
+	EntityOp   string    `json:"entity_op"`
+	UserID     int64     `json:"user_id,omitempty"`
+	Status     string    `json:"status"`
+	ErrorMsg   string    `json:"error_message"`
+	Attempts   int       `json:"attempts"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// OutboxBacklog represents the number of outbox events by status.
+type OutboxBacklog struct {
+	Failed      int        `json:"failed"`
+	Discarded   int        `json:"discarded"`
+	OldestEvent *time.Time `json:"oldest_event"`
+}
+
+// OutboxResultSet represents the response of the outbox endpoint.
+type OutboxResultSet struct {
+	Backlog *OutboxBacklog `json:"backlog"`
+	Events  []*OutboxEvent `json:"events"`
+}
+
+// OutboxReplay represents the result of the replay of outbox events.
+type OutboxReplay struct {
+	Replayed int `json:"replayed"`
+	Failed   int `json:"failed"`
+}
+
+// InstanceStats represents the statistics of all users.
+type InstanceStats struct {
+	Users            int            `json:"users"`
+	Feeds            int            `json:"feeds"`
+	Entries          int64          `json:"entries"`
+	DatabaseSize     int64          `json:"database_size"`
+	SharedFeedURLs   int            `json:"shared_feed_urls"`
+	SharedFeeds      []*SharedFeed  `json:"shared_feeds"`
+	FetchesLastHour  int            `json:"fetches_last_hour"`
+	FetchesLastDay   int            `json:"fetches_last_day"`
+	FetchSuccessRate float64        `json:"fetch_success_rate"`
+	ErrorDomains     []*ErrorDomain `json:"error_domains"`
+}
+
+// SharedFeed represents a feed URL subscribed by several users.
+type SharedFeed struct {
+	FeedURL     string `json:"feed_url"`
+	Subscribers int    `json:"subscribers"`
+}
+
+// ErrorDomain represents the feeds of a domain that could not be refreshed.
+type ErrorDomain struct {
+	Domain     string `json:"domain"`
+	ErrorFeeds int    `json:"error_feeds"`
+	Feeds      int    `json:"feeds"`
+}
+
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
-	Category   *Category  `json:"category,omitempty"`
+	ID           int64      `json:"id"`
+	UserID       int64      `json:"user_id"`
+	FeedID       int64      `json:"feed_id"`
+	Status       string     `json:"status"`
+	Hash         string     `json:"hash"`
+	Title        string     `json:"title"`
+	URL          string     `json:"url"`
+	Date         time.Time  `json:"published_at"`
+	DateRelative string     `json:"published_at_relative,omitempty"`
+	DateAbsolute string     `json:"published_at_absolute,omitempty"`
+	ChangedAt    time.Time  `json:"changed_at"`
+	ReadAt       *time.Time `json:"read_at,omitempty"`
+	Content      string     `json:"content"`
+	Summary      string     `json:"summary"`
+	Author       string     `json:"author"`
+	Starred      bool       `json:"starred"`
+	Score        float64    `json:"score"`
+	JunkScore    int        `json:"junk_score"`
+	NSFW         bool       `json:"nsfw"`
+	ClusterID    int64      `json:"cluster_id"`
+	Enclosures   Enclosures `json:"enclosures,omitempty"`
+	Feed         *Feed      `json:"feed,omitempty"`
+	Category     *Category  `json:"category,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -156,19 +397,69 @@ type Enclosure struct {
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// EntrySnapshot represents a saved copy of the web page of an entry.
+type EntrySnapshot struct {
+	EntryID   int64     `json:"entry_id"`
+	URL       string    `json:"url"`
+	Size      int       `json:"size"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Content   string    `json:"content"`
+}
+
+// RefreshJob represents the progress of a refresh of all feeds.
+type RefreshJob struct {
+	ID        int64           `json:"id"`
+	UserID    int64           `json:"user_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Status    string          `json:"status"`
+	Total     int             `json:"total"`
+	Pending   int             `json:"pending"`
+	Succeeded int             `json:"succeeded"`
+	Failed    int             `json:"failed"`
+	Feeds     RefreshJobFeeds `json:"feeds"`
+}
+
+// RefreshJobFeed represents the progress of a single feed in a refresh job.
+type RefreshJobFeed struct {
+	FeedID    int64     `json:"feed_id"`
+	FeedTitle string    `json:"feed_title"`
+	Status    string    `json:"status"`
+	ErrorMsg  string    `json:"error_message"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RefreshJobFeeds represents a list of feed progress.
+type RefreshJobFeeds []*RefreshJobFeed
+
 // Filter is used to filter entries.
 type Filter struct {
-	Status        string
-	Offset        int
-	Limit         int
-	Order         string
-	Direction     string
-	Starred       bool
-	Before        int64
-	After         int64
-	BeforeEntryID int64
-	AfterEntryID  int64
-	Search        string
+	Status          string
+	Statuses        []string
+	Offset          int
+	Limit           int
+	Order           string
+	Direction       string
+	Seed            int64
+	Starred         bool
+	Unstarred       bool
+	Before          int64
+	After           int64
+	BeforeEntryID   int64
+	AfterEntryID    int64
+	PublishedBefore int64
+	PublishedAfter  int64
+	ChangedAfter    int64
+	Search          string
+	MinScore        *float64
+	MaxScore        *float64
+	MinJunkScore    int
+}
+
+// EntryScore represents the score given to an entry.
+type EntryScore struct {
+	EntryID int64   `json:"entry_id"`
+	Score   float64 `json:"score"`
 }
 
 // EntryResultSet represents the response when fetching entries.
@@ -176,3 +467,61 @@ type EntryResultSet struct {
 	Total   int     `json:"total"`
 	Entries Entries `json:"entries"`
 }
+
+// EntryDay represents the entries published on a day, Count includes the entries of other pages.
+type EntryDay struct {
+	Day     string  `json:"day"`
+	Count   int     `json:"count"`
+	Entries Entries `json:"entries"`
+}
+
+// EntryDayResultSet represents the response when fetching entries grouped by day.
+type EntryDayResultSet struct {
+	Total int         `json:"total"`
+	Days  []*EntryDay `json:"days"`
+}
+
+// EntryCluster represents the entries covering the same story, Entries includes the entries of other pages.
+type EntryCluster struct {
+	ClusterID      int64   `json:"cluster_id"`
+	Representative *Entry  `json:"representative"`
+	Entries        Entries `json:"entries"`
+}
+
+// EntryClusterResultSet represents the response when fetching entries grouped by story.
+type EntryClusterResultSet struct {
+	Total    int             `json:"total"`
+	Clusters []*EntryCluster `json:"clusters"`
+}
+
+// SyncEntry represents the state of an entry returned by the sync endpoint.
+type SyncEntry struct {
+	ID        int64     `json:"id"`
+	FeedID    int64     `json:"feed_id"`
+	Status    string    `json:"status"`
+	Starred   bool      `json:"starred"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// SyncChanges represents the entries changed and deleted since a sync token.
+// When Reset is true, the local copy must be replaced by the returned entries.
+type SyncChanges struct {
+	Token           string       `json:"sync_token"`
+	Reset           bool         `json:"reset"`
+	HasMore         bool         `json:"has_more"`
+	Entries         []*SyncEntry `json:"entries"`
+	DeletedEntryIDs []int64      `json:"deleted_entry_ids"`
+}
+
+// BatchRequest represents an API request executed in a batch, the path starts with /v1/.
+type BatchRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Body   interface{} `json:"body,omitempty"`
+}
+
+// BatchResponse represents the status code and the body of a request executed in a batch.
+type BatchResponse struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
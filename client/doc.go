@@ -31,5 +31,15 @@ This one discover subscriptions on a website:
 	}
 	fmt.Println(subscriptions)
 
+This one iterates through all unread entries, page by page:
+
+	it := client.IterateEntries(&miniflux.Filter{Status: miniflux.EntryStatusUnread})
+	for it.Next() {
+		fmt.Println(it.Entry().Title)
+	}
+	if err := it.Err(); err != nil {
+		fmt.Println(err)
+	}
+
 */
 package client // import "miniflux.app/client"
@@ -0,0 +1,136 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/client"
+
+import (
+	"time"
+)
+
+// DefaultPageSize is the number of entries fetched per request by iterators when the filter has no limit.
+const DefaultPageSize = 100
+
+// EntryIterator fetches entries page by page.
+//
+//	it := client.IterateEntries(&miniflux.Filter{Status: miniflux.EntryStatusUnread})
+//	for it.Next() {
+//		fmt.Println(it.Entry().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		fmt.Println(err)
+//	}
+type EntryIterator struct {
+	fetch   func(filter *Filter) (*EntryResultSet, error)
+	filter  Filter
+	entries Entries
+	index   int
+	total   int
+	done    bool
+	err     error
+}
+
+// IterateEntries returns an iterator over all entries matching the filter.
+func (c *Client) IterateEntries(filter *Filter) *EntryIterator {
+	return newEntryIterator(c.Entries, filter)
+}
+
+// IterateFeedEntries returns an iterator over all entries of a feed matching the filter.
+func (c *Client) IterateFeedEntries(feedID int64, filter *Filter) *EntryIterator {
+	return newEntryIterator(func(f *Filter) (*EntryResultSet, error) {
+		return c.FeedEntries(feedID, f)
+	}, filter)
+}
+
+// AllEntries fetches all entries matching the filter, one page at a time.
+func (c *Client) AllEntries(filter *Filter) (Entries, error) {
+	var entries Entries
+	it := c.IterateEntries(filter)
+	for it.Next() {
+		entries = append(entries, it.Entry())
+	}
+
+	return entries, it.Err()
+}
+
+// SyncEntries returns entries changed since the given Unix timestamp, zero means all entries.
+// The returned timestamp must be given to the next call, it is taken before the first request
+// on the client clock, so the server and the client should be synchronized.
+func (c *Client) SyncEntries(changedAfter int64) (Entries, int64, error) {
+	timestamp := time.Now().Unix()
+
+	entries, err := c.AllEntries(&Filter{ChangedAfter: changedAfter, Order: "id", Direction: "asc"})
+	if err != nil {
+		return nil, changedAfter, err
+	}
+
+	return entries, timestamp, nil
+}
+
+func newEntryIterator(fetch func(filter *Filter) (*EntryResultSet, error), filter *Filter) *EntryIterator {
+	it := &EntryIterator{fetch: fetch, total: -1}
+	if filter != nil {
+		it.filter = *filter
+	}
+
+	if it.filter.Limit <= 0 {
+		it.filter.Limit = DefaultPageSize
+	}
+
+	if it.filter.Offset < 0 {
+		it.filter.Offset = 0
+	}
+
+	return it
+}
+
+// Next advances to the next entry, it returns false when there are no more entries or an error occurred.
+func (it *EntryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.entries) {
+		return true
+	}
+
+	if it.done {
+		return false
+	}
+
+	result, err := it.fetch(&it.filter)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.total = result.Total
+	it.entries = result.Entries
+	it.index = 0
+	it.filter.Offset += len(result.Entries)
+
+	if len(result.Entries) < it.filter.Limit || it.filter.Offset >= result.Total {
+		it.done = true
+	}
+
+	return len(it.entries) > 0
+}
+
+// Entry returns the current entry.
+func (it *EntryIterator) Entry() *Entry {
+	if it.index < len(it.entries) {
+		return it.entries[it.index]
+	}
+	return nil
+}
+
+// Total returns the number of entries matching the filter, -1 before the first page is fetched.
+func (it *EntryIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration.
+func (it *EntryIterator) Err() error {
+	return it.err
+}
@@ -28,6 +28,9 @@ var (
 	ErrForbidden     = errors.New("miniflux: access forbidden")
 	ErrServerError   = errors.New("miniflux: internal server error")
 	ErrNotFound      = errors.New("miniflux: resource not found")
+	ErrConflict      = errors.New("miniflux: resource modified by another request")
+
+	ErrServiceUnavailable = errors.New("miniflux: service temporarily unavailable")
 )
 
 type errorResponse struct {
@@ -38,6 +41,9 @@ type request struct {
 	endpoint string
 	username string
 	password string
+	apiKey   string
+	client   *http.Client
+	headers  map[string]string
 }
 
 func (r *request) Get(path string) (io.ReadCloser, error) {
@@ -75,7 +81,11 @@ func (r *request) execute(method, path string, data interface{}) (io.ReadCloser,
 		Method: method,
 		Header: r.buildHeaders(),
 	}
-	request.SetBasicAuth(r.username, r.password)
+	if r.apiKey != "" {
+		request.Header.Set("X-Auth-Token", r.apiKey)
+	} else {
+		request.SetBasicAuth(r.username, r.password)
+	}
 
 	if data != nil {
 		switch data.(type) {
@@ -86,8 +96,7 @@ func (r *request) execute(method, path string, data interface{}) (io.ReadCloser,
 		}
 	}
 
-	client := r.buildClient()
-	response, err := client.Do(request)
+	response, err := r.buildClient().Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +110,10 @@ func (r *request) execute(method, path string, data interface{}) (io.ReadCloser,
 		return nil, ErrServerError
 	case http.StatusNotFound:
 		return nil, ErrNotFound
+	case http.StatusConflict:
+		return nil, ErrConflict
+	case http.StatusServiceUnavailable:
+		return nil, ErrServiceUnavailable
 	case http.StatusBadRequest:
 		defer response.Body.Close()
 
@@ -120,8 +133,12 @@ func (r *request) execute(method, path string, data interface{}) (io.ReadCloser,
 	return response.Body, nil
 }
 
-func (r *request) buildClient() http.Client {
-	return http.Client{
+func (r *request) buildClient() *http.Client {
+	if r.client != nil {
+		return r.client
+	}
+
+	return &http.Client{
 		Timeout: time.Duration(defaultTimeout * time.Second),
 	}
 }
@@ -131,6 +148,9 @@ func (r *request) buildHeaders() http.Header {
 	headers.Add("User-Agent", userAgent)
 	headers.Add("Content-Type", "application/json")
 	headers.Add("Accept", "application/json")
+	for key, value := range r.headers {
+		headers.Set(key, value)
+	}
 	return headers
 }
 
@@ -5,6 +5,7 @@
 package config // import "miniflux.app/config"
 
 import (
+	"errors"
 	"net/url"
 	"os"
 	"strconv"
@@ -13,28 +14,88 @@ import (
 	"miniflux.app/logger"
 )
 
+// ErrReadOnly is returned when a change is requested on a read-only instance.
+var ErrReadOnly = errors.New("This instance is read-only, changes are not allowed")
+
 const (
 	defaultBaseURL            = "http://localhost"
 	defaultDatabaseURL        = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
 	defaultWorkerPoolSize     = 5
 	defaultPollingFrequency   = 60
 	defaultBatchSize          = 10
+	defaultPushRefreshSecret  = ""
+	defaultPushRefreshTTL     = 60
 	defaultDatabaseMaxConns   = 20
 	defaultDatabaseMinConns   = 1
 	defaultArchiveReadDays    = 60
+	defaultTrashRetentionDays = 30
 	defaultListenAddr         = "127.0.0.1:8080"
+	defaultHTTPServerTimeout  = 30
 	defaultCertFile           = ""
 	defaultKeyFile            = ""
 	defaultCertDomain         = ""
 	defaultCertCache          = "/tmp/cert_cache"
 	defaultCleanupFrequency   = 24
 	defaultProxyImages        = "http-only"
+	defaultProxyImageMaxWidth = 0
+	defaultProxyImageQuality  = 80
+	defaultWebPCommand        = ""
+	defaultAVIFCommand        = ""
 	defaultOAuth2ClientID     = ""
 	defaultOAuth2ClientSecret = ""
 	defaultOAuth2RedirectURL  = ""
 	defaultOAuth2Provider     = ""
 	defaultGcpProjectID       = "gatrabali"
 	defaultGcpPubsubTopic     = "SyncData"
+	defaultEventBusBackend    = "gcppubsub"
+	defaultEventBusURL        = ""
+	defaultEventBusTopic      = "SyncData"
+	defaultEventPayloadMode   = "id_only"
+	defaultGRPCListenAddr     = ""
+	defaultWebhookSecret      = ""
+	defaultWebhookMaxRetries  = 5
+	defaultAlertFeedErrors    = 0
+	defaultAlertErrorCount    = 0
+	defaultEntryHookCommand   = ""
+	defaultEntryHookTimeout   = 30
+	defaultEntryScriptFile    = ""
+	defaultPluginsDirectory   = ""
+	defaultTemplateDirectory  = ""
+	defaultRedisURL           = ""
+	defaultCacheSize          = 10000
+	defaultLocalCacheSize     = 1000
+	defaultLocalCacheTTL      = 300
+	defaultGcpPubsubCacheSub  = ""
+	defaultBigQueryDataset    = ""
+	defaultBigQueryCredFile   = ""
+	defaultBigQueryBatchSize  = 500
+	defaultSentryDSN          = ""
+	defaultMaintenanceFreq    = 0
+	defaultMaintenanceTables  = 5
+	defaultDatabaseReplicaURL = ""
+	defaultReplicaMaxLag      = 5
+	defaultSlowQueryThreshold = 500
+	defaultCORSAllowedHeaders = "Authorization,Content-Type"
+	defaultS3Endpoint         = ""
+	defaultS3Region           = "us-east-1"
+	defaultS3AccessKeyID      = ""
+	defaultS3SecretAccessKey  = ""
+	defaultBlobStoreURL       = ""
+	defaultFeedArchiveSize    = 0
+	defaultTorProxy           = ""
+	defaultSnapshotFrequency  = 0
+	defaultClusterFrequency   = 0
+	defaultFilterListInterval = 24
+	defaultSMTPHost           = ""
+	defaultSMTPPort           = 587
+	defaultSMTPUsername       = ""
+	defaultSMTPPassword       = ""
+	defaultMailFrom           = ""
+	defaultSummarizerURL      = ""
+	defaultSummarizerModel    = "gpt-4o-mini"
+	defaultEmbeddingURL       = ""
+	defaultEmbeddingModel     = "text-embedding-3-small"
+	defaultEmbeddingFrequency = 5
 )
 
 // Config manages configuration parameters.
@@ -96,16 +157,13 @@ func (c *Config) BasePath() string {
 
 // DatabaseURL returns the database URL.
 func (c *Config) DatabaseURL() string {
-	value, exists := os.LookupEnv("DATABASE_URL")
-	if !exists {
+	_, exists := os.LookupEnv("DATABASE_URL")
+	_, fileExists := os.LookupEnv("DATABASE_URL_FILE")
+	if !exists && !fileExists {
 		logger.Info("The environment variable DATABASE_URL is not configured (the default value is used instead)")
 	}
 
-	if value == "" {
-		value = defaultDatabaseURL
-	}
-
-	return value
+	return getSecretValue("DATABASE_URL", defaultDatabaseURL)
 }
 
 // DatabaseMaxConns returns the maximum number of database connections.
@@ -118,6 +176,21 @@ func (c *Config) DatabaseMinConns() int {
 	return getIntValue("DATABASE_MIN_CONNS", defaultDatabaseMinConns)
 }
 
+// DatabaseReplicaURL returns the URL of the read-only database used for entry lists and counters.
+func (c *Config) DatabaseReplicaURL() string {
+	return getSecretValue("DATABASE_REPLICA_URL", defaultDatabaseReplicaURL)
+}
+
+// DatabaseReplicaMaxLag returns the maximum replication delay in seconds before reads are sent to the primary.
+func (c *Config) DatabaseReplicaMaxLag() int {
+	return getIntValue("DATABASE_REPLICA_MAX_LAG", defaultReplicaMaxLag)
+}
+
+// DatabaseSlowQueryThreshold returns the duration in milliseconds above which queries are logged, 0 disables the log.
+func (c *Config) DatabaseSlowQueryThreshold() int {
+	return getIntValue("DATABASE_SLOW_QUERY_THRESHOLD", defaultSlowQueryThreshold)
+}
+
 // ListenAddr returns the listen address for the HTTP server.
 func (c *Config) ListenAddr() string {
 	if port := os.Getenv("PORT"); port != "" {
@@ -127,6 +200,12 @@ func (c *Config) ListenAddr() string {
 	return getStringValue("LISTEN_ADDR", defaultListenAddr)
 }
 
+// HTTPServerTimeout returns the maximum duration in seconds to read a request and write the response,
+// the database queries made for a request are canceled after this delay.
+func (c *Config) HTTPServerTimeout() int {
+	return getIntValue("HTTP_SERVER_TIMEOUT", defaultHTTPServerTimeout)
+}
+
 // CertFile returns the SSL certificate filename if any.
 func (c *Config) CertFile() string {
 	return getStringValue("CERT_FILE", defaultCertFile)
@@ -167,6 +246,21 @@ func (c *Config) BatchSize() int {
 	return getIntValue("BATCH_SIZE", defaultBatchSize)
 }
 
+// PushRefreshSecret returns the secret authenticating the refresh requests sent by an external scheduler.
+func (c *Config) PushRefreshSecret() string {
+	return getSecretValue("PUSH_REFRESH_SECRET", defaultPushRefreshSecret)
+}
+
+// PushRefreshTTL returns the validity in minutes of the signed feed refresh URLs.
+func (c *Config) PushRefreshTTL() int {
+	return getIntValue("PUSH_REFRESH_TTL", defaultPushRefreshTTL)
+}
+
+// HasPushRefresh returns true if feeds are refreshed by HTTP requests instead of the internal scheduler.
+func (c *Config) HasPushRefresh() bool {
+	return c.PushRefreshSecret() != ""
+}
+
 // IsOAuth2UserCreationAllowed returns true if user creation is allowed for OAuth2 users.
 func (c *Config) IsOAuth2UserCreationAllowed() bool {
 	return getBooleanValue("OAUTH2_USER_CREATION")
@@ -179,7 +273,7 @@ func (c *Config) OAuth2ClientID() string {
 
 // OAuth2ClientSecret returns the OAuth2 client secret.
 func (c *Config) OAuth2ClientSecret() string {
-	return getStringValue("OAUTH2_CLIENT_SECRET", defaultOAuth2ClientSecret)
+	return getSecretValue("OAUTH2_CLIENT_SECRET", defaultOAuth2ClientSecret)
 }
 
 // OAuth2RedirectURL returns the OAuth2 redirect URL.
@@ -197,6 +291,37 @@ func (c *Config) HasHSTS() bool {
 	return !getBooleanValue("DISABLE_HSTS")
 }
 
+// CORSAllowedOrigins returns the origins of the browser applications allowed to call the REST API, "*" allows all origins.
+// Cross-origin requests are rejected when the list is empty.
+func (c *Config) CORSAllowedOrigins() []string {
+	return getListValue("API_CORS_ALLOWED_ORIGINS")
+}
+
+// CORSAllowedHeaders returns the request headers browser applications can send to the REST API.
+func (c *Config) CORSAllowedHeaders() []string {
+	headers := getListValue("API_CORS_ALLOWED_HEADERS")
+	if len(headers) == 0 {
+		return strings.Split(defaultCORSAllowedHeaders, ",")
+	}
+
+	return headers
+}
+
+// HasCORSCredentials returns true if browser applications can send cookies and basic authentication to the REST API.
+func (c *Config) HasCORSCredentials() bool {
+	return getBooleanValue("API_CORS_ALLOW_CREDENTIALS")
+}
+
+// AdminUsername returns the username of the admin user created with CREATE_ADMIN.
+func (c *Config) AdminUsername() string {
+	return getStringValue("ADMIN_USERNAME", "")
+}
+
+// AdminPassword returns the password of the admin user created with CREATE_ADMIN.
+func (c *Config) AdminPassword() string {
+	return getSecretValue("ADMIN_PASSWORD", "")
+}
+
 // RunMigrations returns true if the environment variable RUN_MIGRATIONS is not empty.
 func (c *Config) RunMigrations() bool {
 	return getBooleanValue("RUN_MIGRATIONS")
@@ -209,7 +334,7 @@ func (c *Config) CreateAdmin() bool {
 
 // PocketConsumerKey returns the Pocket Consumer Key if defined as environment variable.
 func (c *Config) PocketConsumerKey(defaultValue string) string {
-	return getStringValue("POCKET_CONSUMER_KEY", defaultValue)
+	return getSecretValue("POCKET_CONSUMER_KEY", defaultValue)
 }
 
 // ProxyImages returns "none" to never proxy, "http-only" to proxy non-HTTPS, "all" to always proxy.
@@ -217,14 +342,39 @@ func (c *Config) ProxyImages() string {
 	return getStringValue("PROXY_IMAGES", defaultProxyImages)
 }
 
+// ProxyImageMaxWidth returns the width in pixels above which proxied images are scaled down, zero keeps the original size.
+func (c *Config) ProxyImageMaxWidth() int {
+	return getIntValue("PROXY_IMAGE_MAX_WIDTH", defaultProxyImageMaxWidth)
+}
+
+// ProxyImageQuality returns the quality between 1 and 100 used to recompress proxied images.
+func (c *Config) ProxyImageQuality() int {
+	return getIntValue("PROXY_IMAGE_QUALITY", defaultProxyImageQuality)
+}
+
+// ProxyImageWebPCommand returns the shell command converting proxied images to WebP.
+func (c *Config) ProxyImageWebPCommand() string {
+	return getStringValue("PROXY_IMAGE_WEBP_COMMAND", defaultWebPCommand)
+}
+
+// ProxyImageAVIFCommand returns the shell command converting proxied images to AVIF.
+func (c *Config) ProxyImageAVIFCommand() string {
+	return getStringValue("PROXY_IMAGE_AVIF_COMMAND", defaultAVIFCommand)
+}
+
 // HasHTTPService returns true if the HTTP service is enabled.
 func (c *Config) HasHTTPService() bool {
 	return !getBooleanValue("DISABLE_HTTP_SERVICE")
 }
 
-// HasSchedulerService returns true if the scheduler service is enabled.
+// IsReadOnly returns true if the instance only allows browsing, the changes are rejected and the scheduler is disabled.
+func (c *Config) IsReadOnly() bool {
+	return getBooleanValue("READ_ONLY")
+}
+
+// HasSchedulerService returns true if the scheduler service is enabled, it is always disabled on read-only instances.
 func (c *Config) HasSchedulerService() bool {
-	return !getBooleanValue("DISABLE_SCHEDULER_SERVICE")
+	return !getBooleanValue("DISABLE_SCHEDULER_SERVICE") && !c.IsReadOnly()
 }
 
 // ArchiveReadDays returns the number of days after which marking read items as removed.
@@ -232,6 +382,11 @@ func (c *Config) ArchiveReadDays() int {
 	return getIntValue("ARCHIVE_READ_DAYS", defaultArchiveReadDays)
 }
 
+// TrashRetentionDays returns the number of days after which removed feeds and categories are deleted.
+func (c *Config) TrashRetentionDays() int {
+	return getIntValue("TRASH_RETENTION_DAYS", defaultTrashRetentionDays)
+}
+
 // GcpProjectID return GCP Project ID this backend will belongs to, default "gatrabali"
 func (c *Config) GcpProjectID() string {
 	return getStringValue("GCP_PROJECT_ID", defaultGcpProjectID)
@@ -242,6 +397,352 @@ func (c *Config) GcpPubsubTopic() string {
 	return getStringValue("GCP_PUBSUB_TOPIC", defaultGcpPubsubTopic)
 }
 
+// EventBusBackend returns the backend receiving the sync events: gcppubsub, nats, kafka or none.
+func (c *Config) EventBusBackend() string {
+	return strings.ToLower(getStringValue("EVENT_BUS_BACKEND", defaultEventBusBackend))
+}
+
+// EventBusURL returns the address of the NATS server or of the Kafka REST proxy.
+func (c *Config) EventBusURL() string {
+	return getStringValue("EVENT_BUS_URL", defaultEventBusURL)
+}
+
+// EventBusTopic returns the NATS subject or the Kafka topic of the sync events.
+func (c *Config) EventBusTopic() string {
+	return getStringValue("EVENT_BUS_TOPIC", defaultEventBusTopic)
+}
+
+// EventPayloadMode returns "full" when the sync events embed the model of the written entities, "id_only" by default.
+func (c *Config) EventPayloadMode() string {
+	return strings.ToLower(getStringValue("EVENT_PAYLOAD_MODE", defaultEventPayloadMode))
+}
+
+// HasGraphQL returns true if the GraphQL endpoint is enabled.
+func (c *Config) HasGraphQL() bool {
+	return getBooleanValue("ENABLE_GRAPHQL")
+}
+
+// GRPCListenAddr returns the listen address of the gRPC server, the gRPC service is disabled when empty.
+func (c *Config) GRPCListenAddr() string {
+	return getStringValue("GRPC_LISTEN_ADDR", defaultGRPCListenAddr)
+}
+
+// WebhookURLs returns the list of endpoints notified of administrative events.
+func (c *Config) WebhookURLs() []string {
+	return getListValue("WEBHOOK_URLS")
+}
+
+// WebhookSecret returns the key used to sign webhook payloads.
+func (c *Config) WebhookSecret() string {
+	return getSecretValue("WEBHOOK_SECRET", defaultWebhookSecret)
+}
+
+// WebhookEvents returns the list of events sent to webhooks, all events are sent when empty.
+func (c *Config) WebhookEvents() []string {
+	return getListValue("WEBHOOK_EVENTS")
+}
+
+// WebhookMaxRetries returns the number of delivery attempts after a failure.
+func (c *Config) WebhookMaxRetries() int {
+	return getIntValue("WEBHOOK_MAX_RETRIES", defaultWebhookMaxRetries)
+}
+
+// AlertFeedErrorPercentage returns the percentage of failed feed refreshes during a cycle above which administrators are alerted, 0 disables the alert.
+func (c *Config) AlertFeedErrorPercentage() int {
+	return getIntValue("ALERT_FEED_ERROR_PERCENTAGE", defaultAlertFeedErrors)
+}
+
+// AlertErrorThreshold returns the number of consecutive errors of the database or the event publisher raising an alert, 0 disables the alert.
+func (c *Config) AlertErrorThreshold() int {
+	return getIntValue("ALERT_ERROR_THRESHOLD", defaultAlertErrorCount)
+}
+
+// EntryHookCommand returns the shell command executed when an entry is starred or saved.
+func (c *Config) EntryHookCommand() string {
+	return getStringValue("ENTRY_HOOK_COMMAND", defaultEntryHookCommand)
+}
+
+// EntryHookURLs returns the list of URLs called when an entry is starred or saved.
+func (c *Config) EntryHookURLs() []string {
+	return getListValue("ENTRY_HOOK_URLS")
+}
+
+// EntryHookTimeout returns the maximum execution time of entry hooks in seconds.
+func (c *Config) EntryHookTimeout() int {
+	return getIntValue("ENTRY_HOOK_TIMEOUT", defaultEntryHookTimeout)
+}
+
+// EntryScriptFile returns the path of the Starlark script applied to the entries of all feeds.
+func (c *Config) EntryScriptFile() string {
+	return getStringValue("ENTRY_SCRIPT_FILE", defaultEntryScriptFile)
+}
+
+// SanitizerAllowedSchemes returns the URL schemes allowed in the links of entries in addition to the built-in ones.
+func (c *Config) SanitizerAllowedSchemes() []string {
+	return getListValue("SANITIZER_ALLOWED_SCHEMES")
+}
+
+// PluginsDirectory returns the directory of WebAssembly content filters.
+func (c *Config) PluginsDirectory() string {
+	return getStringValue("PLUGINS_DIRECTORY", defaultPluginsDirectory)
+}
+
+// TemplateDirectory returns the directory of templates overriding the built-in ones.
+func (c *Config) TemplateDirectory() string {
+	return getStringValue("TEMPLATE_DIRECTORY", defaultTemplateDirectory)
+}
+
+// RedisURL returns the URL of the Redis server used as cache.
+func (c *Config) RedisURL() string {
+	return getSecretValue("REDIS_URL", defaultRedisURL)
+}
+
+// CacheSize returns the maximum number of keys of the in-memory cache used when Redis is not configured.
+func (c *Config) CacheSize() int {
+	return getIntValue("CACHE_SIZE", defaultCacheSize)
+}
+
+// LocalCacheSize returns the number of users, category lists and feeds kept in memory by each process.
+func (c *Config) LocalCacheSize() int {
+	return getIntValue("LOCAL_CACHE_SIZE", defaultLocalCacheSize)
+}
+
+// LocalCacheTTL returns the number of seconds values are kept in the local caches.
+func (c *Config) LocalCacheTTL() int {
+	return getIntValue("LOCAL_CACHE_TTL", defaultLocalCacheTTL)
+}
+
+// GcpPubsubCacheSubscription returns the Pub/Sub subscription used to invalidate local caches.
+func (c *Config) GcpPubsubCacheSubscription() string {
+	return getStringValue("GCP_PUBSUB_CACHE_SUBSCRIPTION", defaultGcpPubsubCacheSub)
+}
+
+// BigQueryDataset returns the BigQuery dataset receiving the sync events, an empty value disables the export.
+func (c *Config) BigQueryDataset() string {
+	return getStringValue("BIGQUERY_DATASET", defaultBigQueryDataset)
+}
+
+// BigQueryCredentialsFile returns the service account key used to write to BigQuery,
+// the default credentials of the environment are used when empty.
+func (c *Config) BigQueryCredentialsFile() string {
+	return getStringValue("BIGQUERY_CREDENTIALS_FILE", defaultBigQueryCredFile)
+}
+
+// BigQueryBatchSize returns the maximum number of events written to BigQuery in a single insert.
+func (c *Config) BigQueryBatchSize() int {
+	return getIntValue("BIGQUERY_BATCH_SIZE", defaultBigQueryBatchSize)
+}
+
+// SentryDSN returns the Sentry project receiving the panics recovered while serving requests.
+func (c *Config) SentryDSN() string {
+	return getStringValue("SENTRY_DSN", defaultSentryDSN)
+}
+
+// HasGcpErrorReporting returns true if the panics recovered while serving requests are sent to Google Error Reporting.
+func (c *Config) HasGcpErrorReporting() bool {
+	return getBooleanValue("GCP_ERROR_REPORTING")
+}
+
+// HasMetricsCollector returns true if the metrics endpoint is enabled.
+func (c *Config) HasMetricsCollector() bool {
+	return getBooleanValue("METRICS_COLLECTOR")
+}
+
+// MaintenanceFrequency returns the interval in hours of the database maintenance job, zero disables the job.
+func (c *Config) MaintenanceFrequency() int {
+	return getIntValue("MAINTENANCE_FREQUENCY", defaultMaintenanceFreq)
+}
+
+// MaintenanceTables returns the number of biggest tables vacuumed by the database maintenance job.
+func (c *Config) MaintenanceTables() int {
+	return getIntValue("MAINTENANCE_TABLES", defaultMaintenanceTables)
+}
+
+// S3Endpoint returns the URL of the S3 compatible service, the AWS endpoint of the region is used when empty.
+func (c *Config) S3Endpoint() string {
+	return getStringValue("S3_ENDPOINT", defaultS3Endpoint)
+}
+
+// S3Region returns the region of the S3 buckets.
+func (c *Config) S3Region() string {
+	return getStringValue("S3_REGION", defaultS3Region)
+}
+
+// S3AccessKeyID returns the access key used to sign S3 requests.
+func (c *Config) S3AccessKeyID() string {
+	return getStringValue("S3_ACCESS_KEY_ID", defaultS3AccessKeyID)
+}
+
+// S3SecretAccessKey returns the secret key used to sign S3 requests.
+func (c *Config) S3SecretAccessKey() string {
+	return getSecretValue("S3_SECRET_ACCESS_KEY", defaultS3SecretAccessKey)
+}
+
+// BlobStoreURL returns the location of icons and proxied images, for example s3://bucket/prefix.
+func (c *Config) BlobStoreURL() string {
+	return getStringValue("BLOB_STORE_URL", defaultBlobStoreURL)
+}
+
+// FeedArchiveSize returns the number of fetched documents kept for each feed, zero disables the archive.
+func (c *Config) FeedArchiveSize() int {
+	return getIntValue("FEED_ARCHIVE_SIZE", defaultFeedArchiveSize)
+}
+
+// TorProxy returns the address of the Tor SOCKS proxy used to fetch the .onion feeds.
+func (c *Config) TorProxy() string {
+	return getStringValue("TOR_PROXY", defaultTorProxy)
+}
+
+// LocalFeedDirectories returns the directories where feeds can be read with file:// URLs.
+func (c *Config) LocalFeedDirectories() []string {
+	return getListValue("LOCAL_FEED_DIRECTORIES")
+}
+
+// HasSubscriptionApproval returns true if the new subscriptions of the users who are not administrators
+// are pending until an administrator approves them.
+func (c *Config) HasSubscriptionApproval() bool {
+	return getBooleanValue("SUBSCRIPTION_APPROVAL")
+}
+
+// HasSignup returns true if the visitors can create an account, the email address of the new users is verified
+// so the option is ignored when the mailer is not configured.
+func (c *Config) HasSignup() bool {
+	return getBooleanValue("SIGNUP") && c.HasMailer()
+}
+
+// IsSignupInvitationOnly returns true if an invitation code is required to create an account.
+func (c *Config) IsSignupInvitationOnly() bool {
+	return getBooleanValue("SIGNUP_INVITATION_ONLY")
+}
+
+// SignupAllowedDomains returns the email domains accepted during the signup, any domain is accepted when empty.
+func (c *Config) SignupAllowedDomains() []string {
+	var domains []string
+	for _, domain := range getListValue("SIGNUP_ALLOWED_DOMAINS") {
+		domains = append(domains, strings.ToLower(strings.TrimPrefix(domain, "@")))
+	}
+	return domains
+}
+
+// HasSignupModeration returns true if the new accounts are disabled until an administrator approves them.
+func (c *Config) HasSignupModeration() bool {
+	return getBooleanValue("SIGNUP_MODERATION")
+}
+
+// SnapshotFrequency returns the interval in minutes of the job saving a copy of the web page of starred entries, zero disables the job.
+func (c *Config) SnapshotFrequency() int {
+	return getIntValue("SNAPSHOT_FREQUENCY", defaultSnapshotFrequency)
+}
+
+// ClusterFrequency returns the interval in minutes of the job grouping the entries covering the same story, zero disables the job.
+func (c *Config) ClusterFrequency() int {
+	return getIntValue("CLUSTER_FREQUENCY", defaultClusterFrequency)
+}
+
+// FilterLists returns the URLs of the EasyList-style filter lists whose element hiding rules are applied to the entries.
+func (c *Config) FilterLists() []string {
+	return getListValue("FILTER_LISTS")
+}
+
+// FilterListFrequency returns the interval in hours between two downloads of the filter lists.
+func (c *Config) FilterListFrequency() int {
+	return getIntValue("FILTER_LIST_FREQUENCY", defaultFilterListInterval)
+}
+
+// SMTPHost returns the SMTP server used to send emails.
+func (c *Config) SMTPHost() string {
+	return getStringValue("SMTP_HOST", defaultSMTPHost)
+}
+
+// SMTPPort returns the port of the SMTP server.
+func (c *Config) SMTPPort() int {
+	return getIntValue("SMTP_PORT", defaultSMTPPort)
+}
+
+// SMTPUsername returns the username used to authenticate to the SMTP server, authentication is disabled when empty.
+func (c *Config) SMTPUsername() string {
+	return getStringValue("SMTP_USERNAME", defaultSMTPUsername)
+}
+
+// SMTPPassword returns the password used to authenticate to the SMTP server.
+func (c *Config) SMTPPassword() string {
+	return getSecretValue("SMTP_PASSWORD", defaultSMTPPassword)
+}
+
+// MailFrom returns the sender address of the emails.
+func (c *Config) MailFrom() string {
+	return getStringValue("MAIL_FROM", defaultMailFrom)
+}
+
+// HasMailer returns true if an SMTP server and a sender address are configured.
+func (c *Config) HasMailer() bool {
+	return c.SMTPHost() != "" && c.MailFrom() != ""
+}
+
+// EntryEncryptionKey returns the base64 encoded master key used to encrypt the titles and contents of entries,
+// the encryption is disabled when empty.
+func (c *Config) EntryEncryptionKey() string {
+	return getSecretValue("ENTRY_ENCRYPTION_KEY", "")
+}
+
+// EntryEncryptionKMSKey returns the Cloud KMS key which encrypted ENTRY_ENCRYPTION_KEY,
+// for example projects/p/locations/global/keyRings/r/cryptoKeys/k. The master key is used as is when empty.
+func (c *Config) EntryEncryptionKMSKey() string {
+	return getStringValue("ENTRY_ENCRYPTION_KMS_KEY", "")
+}
+
+// HasEntryEncryption returns true if the titles and contents of entries are encrypted.
+func (c *Config) HasEntryEncryption() bool {
+	return c.EntryEncryptionKey() != ""
+}
+
+// SummarizerURL returns the base URL of the OpenAI compatible API generating the summaries of entries,
+// for example https://api.openai.com/v1 or http://localhost:11434/v1. The summaries are disabled when empty.
+func (c *Config) SummarizerURL() string {
+	return getStringValue("SUMMARIZER_URL", defaultSummarizerURL)
+}
+
+// SummarizerAPIKey returns the key sent as bearer token to the summarizer API.
+func (c *Config) SummarizerAPIKey() string {
+	return getSecretValue("SUMMARIZER_API_KEY", "")
+}
+
+// SummarizerModel returns the name of the model generating the summaries.
+func (c *Config) SummarizerModel() string {
+	return getStringValue("SUMMARIZER_MODEL", defaultSummarizerModel)
+}
+
+// HasSummarizer returns true if the summaries of entries can be generated.
+func (c *Config) HasSummarizer() bool {
+	return c.SummarizerURL() != ""
+}
+
+// EmbeddingURL returns the base URL of the OpenAI compatible API computing the vectors of entries
+// used by the semantic search. The semantic search is disabled when empty.
+func (c *Config) EmbeddingURL() string {
+	return getStringValue("EMBEDDING_URL", defaultEmbeddingURL)
+}
+
+// EmbeddingAPIKey returns the key sent as bearer token to the embedding API.
+func (c *Config) EmbeddingAPIKey() string {
+	return getSecretValue("EMBEDDING_API_KEY", "")
+}
+
+// EmbeddingModel returns the name of the model computing the vectors of entries.
+func (c *Config) EmbeddingModel() string {
+	return getStringValue("EMBEDDING_MODEL", defaultEmbeddingModel)
+}
+
+// EmbeddingFrequency returns the interval in minutes of the job computing the vectors of new entries.
+func (c *Config) EmbeddingFrequency() int {
+	return getIntValue("EMBEDDING_FREQUENCY", defaultEmbeddingFrequency)
+}
+
+// HasEmbeddings returns true if the semantic search is enabled.
+func (c *Config) HasEmbeddings() bool {
+	return c.EmbeddingURL() != ""
+}
+
 // NewConfig returns a new Config.
 func NewConfig() *Config {
 	cfg := &Config{
@@ -284,3 +785,15 @@ func getIntValue(key string, fallback int) int {
 
 	return v
 }
+
+func getListValue(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
@@ -6,6 +6,7 @@ package config // import "miniflux.app/config"
 
 import (
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -316,6 +317,31 @@ func TestDefaultListenAddrValue(t *testing.T) {
 	}
 }
 
+func TestHTTPServerTimeout(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_SERVER_TIMEOUT", "120")
+
+	cfg := NewConfig()
+	expected := 120
+	result := cfg.HTTPServerTimeout()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_SERVER_TIMEOUT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultHTTPServerTimeoutValue(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultHTTPServerTimeout
+	result := cfg.HTTPServerTimeout()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_SERVER_TIMEOUT value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestCertFile(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("CERT_FILE", "foobar")
@@ -512,6 +538,64 @@ func TestBatchSize(t *testing.T) {
 	}
 }
 
+func TestPushRefreshSecret(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PUSH_REFRESH_SECRET", "secret")
+
+	cfg := NewConfig()
+	expected := "secret"
+	result := cfg.PushRefreshSecret()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PUSH_REFRESH_SECRET value, got %q instead of %q`, result, expected)
+	}
+
+	if !cfg.HasPushRefresh() {
+		t.Fatal(`The push refresh mode should be enabled`)
+	}
+}
+
+func TestPushRefreshSecretWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultPushRefreshSecret
+	result := cfg.PushRefreshSecret()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PUSH_REFRESH_SECRET value, got %q instead of %q`, result, expected)
+	}
+
+	if cfg.HasPushRefresh() {
+		t.Fatal(`The push refresh mode should be disabled`)
+	}
+}
+
+func TestPushRefreshTTL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PUSH_REFRESH_TTL", "15")
+
+	cfg := NewConfig()
+	expected := 15
+	result := cfg.PushRefreshTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PUSH_REFRESH_TTL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPushRefreshTTLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultPushRefreshTTL
+	result := cfg.PushRefreshTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PUSH_REFRESH_TTL value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestOAuth2UserCreationWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -658,6 +742,80 @@ func TestHSTS(t *testing.T) {
 	}
 }
 
+func TestCORSAllowedOriginsWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	result := cfg.CORSAllowedOrigins()
+
+	if len(result) != 0 {
+		t.Fatalf(`Unexpected API_CORS_ALLOWED_ORIGINS value, got %v instead of an empty list`, result)
+	}
+}
+
+func TestCORSAllowedOrigins(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("API_CORS_ALLOWED_ORIGINS", "https://app.example.org, https://example.com")
+
+	cfg := NewConfig()
+	expected := []string{"https://app.example.org", "https://example.com"}
+	result := cfg.CORSAllowedOrigins()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected API_CORS_ALLOWED_ORIGINS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCORSAllowedHeadersWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := []string{"Authorization", "Content-Type"}
+	result := cfg.CORSAllowedHeaders()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected API_CORS_ALLOWED_HEADERS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCORSAllowedHeaders(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("API_CORS_ALLOWED_HEADERS", "Authorization,Content-Type,X-Client-Version")
+
+	cfg := NewConfig()
+	expected := []string{"Authorization", "Content-Type", "X-Client-Version"}
+	result := cfg.CORSAllowedHeaders()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected API_CORS_ALLOWED_HEADERS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCORSCredentialsWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := false
+	result := cfg.HasCORSCredentials()
+
+	if result != expected {
+		t.Fatalf(`Unexpected API_CORS_ALLOW_CREDENTIALS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCORSCredentials(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("API_CORS_ALLOW_CREDENTIALS", "1")
+
+	cfg := NewConfig()
+	expected := true
+	result := cfg.HasCORSCredentials()
+
+	if result != expected {
+		t.Fatalf(`Unexpected API_CORS_ALLOW_CREDENTIALS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDisableHTTPServiceWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -721,6 +879,31 @@ func TestArchiveReadDays(t *testing.T) {
 	}
 }
 
+func TestDefaultTrashRetentionDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultTrashRetentionDays
+	result := cfg.TrashRetentionDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRASH_RETENTION_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestTrashRetentionDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRASH_RETENTION_DAYS", "7")
+
+	cfg := NewConfig()
+	expected := 7
+	result := cfg.TrashRetentionDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRASH_RETENTION_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestRunMigrationsWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -820,6 +1003,106 @@ func TestDefaultProxyImagesValue(t *testing.T) {
 	}
 }
 
+func TestProxyImageMaxWidth(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGE_MAX_WIDTH", "800")
+
+	cfg := NewConfig()
+	expected := 800
+	result := cfg.ProxyImageMaxWidth()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_MAX_WIDTH value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestProxyImageMaxWidthWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultProxyImageMaxWidth
+	result := cfg.ProxyImageMaxWidth()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_MAX_WIDTH value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestProxyImageQuality(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGE_QUALITY", "65")
+
+	cfg := NewConfig()
+	expected := 65
+	result := cfg.ProxyImageQuality()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_QUALITY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestProxyImageQualityWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultProxyImageQuality
+	result := cfg.ProxyImageQuality()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_QUALITY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestProxyImageWebPCommand(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGE_WEBP_COMMAND", "cwebp -quiet -o - -- -")
+
+	cfg := NewConfig()
+	expected := "cwebp -quiet -o - -- -"
+	result := cfg.ProxyImageWebPCommand()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_WEBP_COMMAND value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestProxyImageWebPCommandWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultWebPCommand
+	result := cfg.ProxyImageWebPCommand()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_WEBP_COMMAND value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestProxyImageAVIFCommand(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGE_AVIF_COMMAND", "/usr/local/bin/to-avif")
+
+	cfg := NewConfig()
+	expected := "/usr/local/bin/to-avif"
+	result := cfg.ProxyImageAVIFCommand()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_AVIF_COMMAND value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestProxyImageAVIFCommandWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultAVIFCommand
+	result := cfg.ProxyImageAVIFCommand()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_IMAGE_AVIF_COMMAND value, got %q instead of %q`, result, expected)
+	}
+}
+
 func TestHTTPSOff(t *testing.T) {
 	os.Clearenv()
 	cfg := NewConfig()
@@ -838,3 +1121,1294 @@ func TestHTTPSOn(t *testing.T) {
 		t.Fatalf(`Unexpected HTTPS value, got "%v"`, cfg.IsHTTPS)
 	}
 }
+
+func TestGraphQLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := false
+	result := cfg.HasGraphQL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENABLE_GRAPHQL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENABLE_GRAPHQL", "1")
+
+	cfg := NewConfig()
+	expected := true
+	result := cfg.HasGraphQL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENABLE_GRAPHQL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestGRPCListenAddrWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := ""
+	result := cfg.GRPCListenAddr()
+
+	if result != expected {
+		t.Fatalf(`Unexpected GRPC_LISTEN_ADDR value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestGRPCListenAddr(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GRPC_LISTEN_ADDR", ":9090")
+
+	cfg := NewConfig()
+	expected := ":9090"
+	result := cfg.GRPCListenAddr()
+
+	if result != expected {
+		t.Fatalf(`Unexpected GRPC_LISTEN_ADDR value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestWebhookURLsWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	result := cfg.WebhookURLs()
+
+	if len(result) != 0 {
+		t.Fatalf(`Unexpected WEBHOOK_URLS value, got %v instead of an empty list`, result)
+	}
+}
+
+func TestWebhookURLs(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBHOOK_URLS", "https://example.org/hook, https://example.com/hook,")
+
+	cfg := NewConfig()
+	expected := []string{"https://example.org/hook", "https://example.com/hook"}
+	result := cfg.WebhookURLs()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected WEBHOOK_URLS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWebhookSecret(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBHOOK_SECRET", "secret")
+
+	cfg := NewConfig()
+	expected := "secret"
+	result := cfg.WebhookSecret()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WEBHOOK_SECRET value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestWebhookEvents(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBHOOK_EVENTS", "feed.created,user.created")
+
+	cfg := NewConfig()
+	expected := []string{"feed.created", "user.created"}
+	result := cfg.WebhookEvents()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected WEBHOOK_EVENTS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWebhookMaxRetriesWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultWebhookMaxRetries
+	result := cfg.WebhookMaxRetries()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WEBHOOK_MAX_RETRIES value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestWebhookMaxRetries(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBHOOK_MAX_RETRIES", "2")
+
+	cfg := NewConfig()
+	expected := 2
+	result := cfg.WebhookMaxRetries()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WEBHOOK_MAX_RETRIES value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestAlertFeedErrorPercentageWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultAlertFeedErrors
+	result := cfg.AlertFeedErrorPercentage()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALERT_FEED_ERROR_PERCENTAGE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestAlertFeedErrorPercentage(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ALERT_FEED_ERROR_PERCENTAGE", "25")
+
+	cfg := NewConfig()
+	expected := 25
+	result := cfg.AlertFeedErrorPercentage()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALERT_FEED_ERROR_PERCENTAGE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestAlertErrorThresholdWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultAlertErrorCount
+	result := cfg.AlertErrorThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALERT_ERROR_THRESHOLD value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestAlertErrorThreshold(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ALERT_ERROR_THRESHOLD", "5")
+
+	cfg := NewConfig()
+	expected := 5
+	result := cfg.AlertErrorThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALERT_ERROR_THRESHOLD value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestEntryHookCommand(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENTRY_HOOK_COMMAND", "/usr/local/bin/on-entry")
+
+	cfg := NewConfig()
+	expected := "/usr/local/bin/on-entry"
+	result := cfg.EntryHookCommand()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_HOOK_COMMAND value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestEntryHookURLs(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENTRY_HOOK_URLS", "https://example.org/hook")
+
+	cfg := NewConfig()
+	expected := []string{"https://example.org/hook"}
+	result := cfg.EntryHookURLs()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected ENTRY_HOOK_URLS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestEntryHookTimeoutWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultEntryHookTimeout
+	result := cfg.EntryHookTimeout()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_HOOK_TIMEOUT value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestEntryScriptFile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENTRY_SCRIPT_FILE", "/etc/miniflux/entries.star")
+
+	cfg := NewConfig()
+	expected := "/etc/miniflux/entries.star"
+	result := cfg.EntryScriptFile()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_SCRIPT_FILE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSanitizerAllowedSchemesWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	result := cfg.SanitizerAllowedSchemes()
+
+	if len(result) != 0 {
+		t.Fatalf(`Unexpected SANITIZER_ALLOWED_SCHEMES value, got %v instead of an empty list`, result)
+	}
+}
+
+func TestSanitizerAllowedSchemes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_ALLOWED_SCHEMES", "magnet, gemini,dat")
+
+	cfg := NewConfig()
+	expected := []string{"magnet", "gemini", "dat"}
+	result := cfg.SanitizerAllowedSchemes()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected SANITIZER_ALLOWED_SCHEMES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestEntryScriptFileWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultEntryScriptFile
+	result := cfg.EntryScriptFile()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ENTRY_SCRIPT_FILE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestPluginsDirectory(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PLUGINS_DIRECTORY", "/var/lib/miniflux/plugins")
+
+	cfg := NewConfig()
+	expected := "/var/lib/miniflux/plugins"
+	result := cfg.PluginsDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PLUGINS_DIRECTORY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestPluginsDirectoryWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultPluginsDirectory
+	result := cfg.PluginsDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PLUGINS_DIRECTORY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTemplateDirectory(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TEMPLATE_DIRECTORY", "/etc/miniflux/templates")
+
+	cfg := NewConfig()
+	expected := "/etc/miniflux/templates"
+	result := cfg.TemplateDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TEMPLATE_DIRECTORY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTemplateDirectoryWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultTemplateDirectory
+	result := cfg.TemplateDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TEMPLATE_DIRECTORY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestRedisURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("REDIS_URL", "redis://localhost:6379/0")
+
+	cfg := NewConfig()
+	expected := "redis://localhost:6379/0"
+	result := cfg.RedisURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected REDIS_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestRedisURLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultRedisURL
+	result := cfg.RedisURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected REDIS_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestCacheSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CACHE_SIZE", "0")
+
+	cfg := NewConfig()
+	expected := 0
+	result := cfg.CacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestCacheSizeWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultCacheSize
+	result := cfg.CacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestLocalCacheSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOCAL_CACHE_SIZE", "50")
+
+	cfg := NewConfig()
+	expected := 50
+	result := cfg.LocalCacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOCAL_CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestLocalCacheSizeWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultLocalCacheSize
+	result := cfg.LocalCacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOCAL_CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestLocalCacheTTL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOCAL_CACHE_TTL", "30")
+
+	cfg := NewConfig()
+	expected := 30
+	result := cfg.LocalCacheTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOCAL_CACHE_TTL value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestLocalCacheTTLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultLocalCacheTTL
+	result := cfg.LocalCacheTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOCAL_CACHE_TTL value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestGcpPubsubCacheSubscription(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GCP_PUBSUB_CACHE_SUBSCRIPTION", "miniflux-instance-1")
+
+	cfg := NewConfig()
+	expected := "miniflux-instance-1"
+	result := cfg.GcpPubsubCacheSubscription()
+
+	if result != expected {
+		t.Fatalf(`Unexpected GCP_PUBSUB_CACHE_SUBSCRIPTION value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestGcpPubsubCacheSubscriptionWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultGcpPubsubCacheSub
+	result := cfg.GcpPubsubCacheSubscription()
+
+	if result != expected {
+		t.Fatalf(`Unexpected GCP_PUBSUB_CACHE_SUBSCRIPTION value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBigQueryDataset(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BIGQUERY_DATASET", "miniflux_events")
+
+	cfg := NewConfig()
+	expected := "miniflux_events"
+	result := cfg.BigQueryDataset()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BIGQUERY_DATASET value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBigQueryDatasetWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultBigQueryDataset
+	result := cfg.BigQueryDataset()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BIGQUERY_DATASET value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBigQueryCredentialsFile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BIGQUERY_CREDENTIALS_FILE", "/etc/miniflux/bigquery.json")
+
+	cfg := NewConfig()
+	expected := "/etc/miniflux/bigquery.json"
+	result := cfg.BigQueryCredentialsFile()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BIGQUERY_CREDENTIALS_FILE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBigQueryBatchSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BIGQUERY_BATCH_SIZE", "100")
+
+	cfg := NewConfig()
+	expected := 100
+	result := cfg.BigQueryBatchSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BIGQUERY_BATCH_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestBigQueryBatchSizeWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultBigQueryBatchSize
+	result := cfg.BigQueryBatchSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BIGQUERY_BATCH_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestSentryDSN(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SENTRY_DSN", "https://key@sentry.example.org/42")
+
+	cfg := NewConfig()
+	expected := "https://key@sentry.example.org/42"
+	result := cfg.SentryDSN()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SENTRY_DSN value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSentryDSNWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSentryDSN
+	result := cfg.SentryDSN()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SENTRY_DSN value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestHasGcpErrorReporting(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("GCP_ERROR_REPORTING", "1")
+
+	cfg := NewConfig()
+	if !cfg.HasGcpErrorReporting() {
+		t.Fatalf(`Unexpected GCP_ERROR_REPORTING value, got false instead of true`)
+	}
+}
+
+func TestHasGcpErrorReportingWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasGcpErrorReporting() {
+		t.Fatalf(`Unexpected GCP_ERROR_REPORTING value, got true instead of false`)
+	}
+}
+
+func TestHasMetricsCollector(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("METRICS_COLLECTOR", "1")
+
+	cfg := NewConfig()
+	if !cfg.HasMetricsCollector() {
+		t.Fatalf(`Unexpected METRICS_COLLECTOR value, got false instead of true`)
+	}
+}
+
+func TestHasMetricsCollectorWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasMetricsCollector() {
+		t.Fatalf(`Unexpected METRICS_COLLECTOR value, got true instead of false`)
+	}
+}
+
+func TestMaintenanceFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MAINTENANCE_FREQUENCY", "168")
+
+	cfg := NewConfig()
+	expected := 168
+	result := cfg.MaintenanceFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAINTENANCE_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestMaintenanceFrequencyWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultMaintenanceFreq
+	result := cfg.MaintenanceFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAINTENANCE_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestMaintenanceTables(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MAINTENANCE_TABLES", "3")
+
+	cfg := NewConfig()
+	expected := 3
+	result := cfg.MaintenanceTables()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAINTENANCE_TABLES value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestMaintenanceTablesWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultMaintenanceTables
+	result := cfg.MaintenanceTables()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAINTENANCE_TABLES value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDatabaseReplicaURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DATABASE_REPLICA_URL", "postgres://replica/miniflux")
+
+	cfg := NewConfig()
+	expected := "postgres://replica/miniflux"
+	result := cfg.DatabaseReplicaURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_REPLICA_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDatabaseReplicaURLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultDatabaseReplicaURL
+	result := cfg.DatabaseReplicaURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_REPLICA_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDatabaseReplicaMaxLag(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DATABASE_REPLICA_MAX_LAG", "30")
+
+	cfg := NewConfig()
+	expected := 30
+	result := cfg.DatabaseReplicaMaxLag()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_REPLICA_MAX_LAG value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDatabaseReplicaMaxLagWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultReplicaMaxLag
+	result := cfg.DatabaseReplicaMaxLag()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_REPLICA_MAX_LAG value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDatabaseSlowQueryThreshold(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DATABASE_SLOW_QUERY_THRESHOLD", "100")
+
+	cfg := NewConfig()
+	expected := 100
+	result := cfg.DatabaseSlowQueryThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_SLOW_QUERY_THRESHOLD value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDatabaseSlowQueryThresholdWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSlowQueryThreshold
+	result := cfg.DatabaseSlowQueryThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DATABASE_SLOW_QUERY_THRESHOLD value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestS3Endpoint(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("S3_ENDPOINT", "http://localhost:9000")
+
+	cfg := NewConfig()
+	expected := "http://localhost:9000"
+	result := cfg.S3Endpoint()
+
+	if result != expected {
+		t.Fatalf(`Unexpected S3_ENDPOINT value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestS3EndpointWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultS3Endpoint
+	result := cfg.S3Endpoint()
+
+	if result != expected {
+		t.Fatalf(`Unexpected S3_ENDPOINT value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestS3Region(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("S3_REGION", "eu-west-3")
+
+	cfg := NewConfig()
+	expected := "eu-west-3"
+	result := cfg.S3Region()
+
+	if result != expected {
+		t.Fatalf(`Unexpected S3_REGION value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestS3RegionWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultS3Region
+	result := cfg.S3Region()
+
+	if result != expected {
+		t.Fatalf(`Unexpected S3_REGION value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBlobStoreURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BLOB_STORE_URL", "gs://miniflux/blobs")
+
+	cfg := NewConfig()
+	expected := "gs://miniflux/blobs"
+	result := cfg.BlobStoreURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BLOB_STORE_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestBlobStoreURLWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultBlobStoreURL
+	result := cfg.BlobStoreURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected BLOB_STORE_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestFeedArchiveSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FEED_ARCHIVE_SIZE", "3")
+
+	cfg := NewConfig()
+	expected := 3
+	result := cfg.FeedArchiveSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FEED_ARCHIVE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestFeedArchiveSizeWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultFeedArchiveSize
+	result := cfg.FeedArchiveSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FEED_ARCHIVE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestTorProxy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TOR_PROXY", "127.0.0.1:9050")
+
+	cfg := NewConfig()
+	expected := "127.0.0.1:9050"
+	result := cfg.TorProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TOR_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTorProxyWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultTorProxy
+	result := cfg.TorProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TOR_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestLocalFeedDirectoriesWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	result := cfg.LocalFeedDirectories()
+
+	if len(result) != 0 {
+		t.Fatalf(`Unexpected LOCAL_FEED_DIRECTORIES value, got %v instead of an empty list`, result)
+	}
+}
+
+func TestLocalFeedDirectories(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOCAL_FEED_DIRECTORIES", "/var/lib/feeds, /srv/pipeline/output")
+
+	cfg := NewConfig()
+	expected := []string{"/var/lib/feeds", "/srv/pipeline/output"}
+	result := cfg.LocalFeedDirectories()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected LOCAL_FEED_DIRECTORIES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSnapshotFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SNAPSHOT_FREQUENCY", "30")
+
+	cfg := NewConfig()
+	expected := 30
+	result := cfg.SnapshotFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SNAPSHOT_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestSnapshotFrequencyWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSnapshotFrequency
+	result := cfg.SnapshotFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SNAPSHOT_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestClusterFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLUSTER_FREQUENCY", "15")
+
+	cfg := NewConfig()
+	expected := 15
+	result := cfg.ClusterFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLUSTER_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestClusterFrequencyWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultClusterFrequency
+	result := cfg.ClusterFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLUSTER_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestFilterLists(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FILTER_LISTS", "https://example.org/easylist.txt, https://example.org/fanboy.txt")
+
+	cfg := NewConfig()
+	result := cfg.FilterLists()
+
+	if len(result) != 2 || result[0] != "https://example.org/easylist.txt" || result[1] != "https://example.org/fanboy.txt" {
+		t.Fatalf(`Unexpected FILTER_LISTS value, got %v`, result)
+	}
+}
+
+func TestFilterListsWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if result := cfg.FilterLists(); len(result) != 0 {
+		t.Fatalf(`Unexpected FILTER_LISTS value, got %v`, result)
+	}
+}
+
+func TestFilterListFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FILTER_LIST_FREQUENCY", "12")
+
+	cfg := NewConfig()
+	expected := 12
+	result := cfg.FilterListFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FILTER_LIST_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestFilterListFrequencyWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultFilterListInterval
+	result := cfg.FilterListFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FILTER_LIST_FREQUENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestSMTPHost(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+
+	cfg := NewConfig()
+	expected := "smtp.example.org"
+	result := cfg.SMTPHost()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_HOST value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSMTPHostWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSMTPHost
+	result := cfg.SMTPHost()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_HOST value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSMTPPort(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_PORT", "465")
+
+	cfg := NewConfig()
+	expected := 465
+	result := cfg.SMTPPort()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PORT value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestSMTPPortWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSMTPPort
+	result := cfg.SMTPPort()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PORT value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestSMTPUsername(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_USERNAME", "miniflux")
+
+	cfg := NewConfig()
+	expected := "miniflux"
+	result := cfg.SMTPUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_USERNAME value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSMTPUsernameWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSMTPUsername
+	result := cfg.SMTPUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_USERNAME value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSMTPPassword(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_PASSWORD", "secret")
+
+	cfg := NewConfig()
+	expected := "secret"
+	result := cfg.SMTPPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSMTPPasswordWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultSMTPPassword
+	result := cfg.SMTPPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestMailFrom(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MAIL_FROM", "miniflux@example.org")
+
+	cfg := NewConfig()
+	expected := "miniflux@example.org"
+	result := cfg.MailFrom()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAIL_FROM value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestMailFromWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	expected := defaultMailFrom
+	result := cfg.MailFrom()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MAIL_FROM value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestHasMailer(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+
+	cfg := NewConfig()
+	if cfg.HasMailer() {
+		t.Fatal(`The mailer should be disabled without sender address`)
+	}
+
+	os.Setenv("MAIL_FROM", "miniflux@example.org")
+	if !cfg.HasMailer() {
+		t.Fatal(`The mailer should be enabled`)
+	}
+}
+
+func TestEntryEncryptionWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasEntryEncryption() {
+		t.Fatal(`The entry encryption should be disabled by default`)
+	}
+
+	if result := cfg.EntryEncryptionKMSKey(); result != "" {
+		t.Fatalf(`Unexpected ENTRY_ENCRYPTION_KMS_KEY value, got %q instead of an empty string`, result)
+	}
+}
+
+func TestEntryEncryptionKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENTRY_ENCRYPTION_KEY", "c2VjcmV0")
+	os.Setenv("ENTRY_ENCRYPTION_KMS_KEY", "projects/p/locations/global/keyRings/r/cryptoKeys/k")
+
+	cfg := NewConfig()
+	if !cfg.HasEntryEncryption() {
+		t.Fatal(`The entry encryption should be enabled`)
+	}
+
+	if result := cfg.EntryEncryptionKey(); result != "c2VjcmV0" {
+		t.Fatalf(`Unexpected ENTRY_ENCRYPTION_KEY value, got %q`, result)
+	}
+
+	expected := "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+	if result := cfg.EntryEncryptionKMSKey(); result != expected {
+		t.Fatalf(`Unexpected ENTRY_ENCRYPTION_KMS_KEY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestReadOnlyDisablesScheduler(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.IsReadOnly() || !cfg.HasSchedulerService() {
+		t.Fatal(`The instance should not be read-only by default`)
+	}
+
+	os.Setenv("READ_ONLY", "1")
+	if !cfg.IsReadOnly() {
+		t.Fatal(`The instance should be read-only`)
+	}
+
+	if cfg.HasSchedulerService() {
+		t.Fatal(`The scheduler should be disabled on read-only instances`)
+	}
+}
+
+func TestSubscriptionApproval(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasSubscriptionApproval() {
+		t.Fatal(`The subscription approval should be disabled by default`)
+	}
+
+	os.Setenv("SUBSCRIPTION_APPROVAL", "1")
+	if !cfg.HasSubscriptionApproval() {
+		t.Fatal(`The subscription approval should be enabled`)
+	}
+}
+
+func TestSignupRequiresMailer(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SIGNUP", "1")
+
+	cfg := NewConfig()
+	if cfg.HasSignup() {
+		t.Fatal(`The signup should be disabled without mailer`)
+	}
+
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+	os.Setenv("MAIL_FROM", "miniflux@example.org")
+	if !cfg.HasSignup() {
+		t.Fatal(`The signup should be enabled`)
+	}
+}
+
+func TestSignupOptions(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.IsSignupInvitationOnly() || cfg.HasSignupModeration() || len(cfg.SignupAllowedDomains()) != 0 {
+		t.Fatal(`The signup restrictions should be disabled by default`)
+	}
+
+	os.Setenv("SIGNUP_INVITATION_ONLY", "1")
+	os.Setenv("SIGNUP_MODERATION", "1")
+	os.Setenv("SIGNUP_ALLOWED_DOMAINS", "Example.org, @example.com")
+
+	if !cfg.IsSignupInvitationOnly() || !cfg.HasSignupModeration() {
+		t.Fatal(`The signup restrictions should be enabled`)
+	}
+
+	domains := cfg.SignupAllowedDomains()
+	if len(domains) != 2 || domains[0] != "example.org" || domains[1] != "example.com" {
+		t.Fatalf(`Unexpected SIGNUP_ALLOWED_DOMAINS value, got %v`, domains)
+	}
+}
+
+func TestSummarizerOptions(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasSummarizer() {
+		t.Fatal(`The summarizer should be disabled by default`)
+	}
+
+	if cfg.SummarizerModel() != defaultSummarizerModel {
+		t.Fatalf(`Unexpected SUMMARIZER_MODEL value, got %q`, cfg.SummarizerModel())
+	}
+
+	os.Setenv("SUMMARIZER_URL", "http://localhost:11434/v1")
+	os.Setenv("SUMMARIZER_API_KEY", "secret")
+	os.Setenv("SUMMARIZER_MODEL", "llama3")
+
+	if !cfg.HasSummarizer() || cfg.SummarizerURL() != "http://localhost:11434/v1" {
+		t.Fatalf(`Unexpected SUMMARIZER_URL value, got %q`, cfg.SummarizerURL())
+	}
+
+	if cfg.SummarizerAPIKey() != "secret" {
+		t.Fatalf(`Unexpected SUMMARIZER_API_KEY value, got %q`, cfg.SummarizerAPIKey())
+	}
+
+	if cfg.SummarizerModel() != "llama3" {
+		t.Fatalf(`Unexpected SUMMARIZER_MODEL value, got %q`, cfg.SummarizerModel())
+	}
+}
+
+func TestEmbeddingOptions(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if cfg.HasEmbeddings() {
+		t.Fatal(`The semantic search should be disabled by default`)
+	}
+
+	if cfg.EmbeddingModel() != defaultEmbeddingModel || cfg.EmbeddingFrequency() != defaultEmbeddingFrequency {
+		t.Fatalf(`Unexpected default values, got %q and %d`, cfg.EmbeddingModel(), cfg.EmbeddingFrequency())
+	}
+
+	os.Setenv("EMBEDDING_URL", "http://localhost:11434/v1")
+	os.Setenv("EMBEDDING_API_KEY", "secret")
+	os.Setenv("EMBEDDING_MODEL", "nomic-embed-text")
+	os.Setenv("EMBEDDING_FREQUENCY", "10")
+
+	if !cfg.HasEmbeddings() || cfg.EmbeddingURL() != "http://localhost:11434/v1" {
+		t.Fatalf(`Unexpected EMBEDDING_URL value, got %q`, cfg.EmbeddingURL())
+	}
+
+	if cfg.EmbeddingAPIKey() != "secret" {
+		t.Fatalf(`Unexpected EMBEDDING_API_KEY value, got %q`, cfg.EmbeddingAPIKey())
+	}
+
+	if cfg.EmbeddingModel() != "nomic-embed-text" {
+		t.Fatalf(`Unexpected EMBEDDING_MODEL value, got %q`, cfg.EmbeddingModel())
+	}
+
+	if cfg.EmbeddingFrequency() != 10 {
+		t.Fatalf(`Unexpected EMBEDDING_FREQUENCY value, got %d`, cfg.EmbeddingFrequency())
+	}
+}
+
+func TestEventBusBackend(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("EVENT_BUS_BACKEND", "NATS")
+	os.Setenv("EVENT_BUS_URL", "nats://127.0.0.1:4222")
+	os.Setenv("EVENT_BUS_TOPIC", "miniflux.sync")
+
+	cfg := NewConfig()
+	if result := cfg.EventBusBackend(); result != "nats" {
+		t.Fatalf(`Unexpected EVENT_BUS_BACKEND value, got %q instead of "nats"`, result)
+	}
+
+	if result := cfg.EventBusURL(); result != "nats://127.0.0.1:4222" {
+		t.Fatalf(`Unexpected EVENT_BUS_URL value, got %q`, result)
+	}
+
+	if result := cfg.EventBusTopic(); result != "miniflux.sync" {
+		t.Fatalf(`Unexpected EVENT_BUS_TOPIC value, got %q`, result)
+	}
+}
+
+func TestEventBusBackendWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if result := cfg.EventBusBackend(); result != defaultEventBusBackend {
+		t.Fatalf(`Unexpected EVENT_BUS_BACKEND value, got %q instead of %q`, result, defaultEventBusBackend)
+	}
+
+	if result := cfg.EventBusTopic(); result != defaultEventBusTopic {
+		t.Fatalf(`Unexpected EVENT_BUS_TOPIC value, got %q instead of %q`, result, defaultEventBusTopic)
+	}
+}
+
+func TestEventPayloadMode(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("EVENT_PAYLOAD_MODE", "Full")
+
+	cfg := NewConfig()
+	if result := cfg.EventPayloadMode(); result != "full" {
+		t.Fatalf(`Unexpected EVENT_PAYLOAD_MODE value, got %q instead of "full"`, result)
+	}
+}
+
+func TestEventPayloadModeWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	cfg := NewConfig()
+	if result := cfg.EventPayloadMode(); result != defaultEventPayloadMode {
+		t.Fatalf(`Unexpected EVENT_PAYLOAD_MODE value, got %q instead of %q`, result, defaultEventPayloadMode)
+	}
+}
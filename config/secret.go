@@ -0,0 +1,202 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package config // import "miniflux.app/config"
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
+
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	// googleSecretPrefix references a Google Secret Manager version, for example
+	// gcp-secret://projects/my-project/secrets/db-password/versions/latest.
+	googleSecretPrefix = "gcp-secret://"
+
+	// vaultSecretPrefix references a field of a Vault secret, for example
+	// vault://secret/data/miniflux#db_password. Vault is found with VAULT_ADDR and VAULT_TOKEN.
+	vaultSecretPrefix = "vault://"
+
+	secretRequestTimeout = 10 * time.Second
+)
+
+// secrets keeps the values read from secret managers, they are not requested again.
+var secrets = struct {
+	sync.Mutex
+	values map[string]string
+}{values: make(map[string]string)}
+
+// getSecretValue returns the value of a secret option. The value is read from the file
+// given by the variable KEY_FILE when KEY is not set, and from a secret manager when it is a reference.
+func getSecretValue(key, fallback string) string {
+	value := getFileValue(key, "")
+	if value == "" {
+		return fallback
+	}
+
+	if !isSecretReference(value) {
+		return value
+	}
+
+	secret, err := resolveSecret(value)
+	if err != nil {
+		logger.Error("[Config] Unable to read %s from the secret manager: %v", key, err)
+		return fallback
+	}
+
+	return secret
+}
+
+// getFileValue returns the value of the variable KEY, or the content of the file given by KEY_FILE.
+func getFileValue(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	filename := os.Getenv(key + "_FILE")
+	if filename == "" {
+		return fallback
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		logger.Error("[Config] Unable to read %s: %v", key+"_FILE", err)
+		return fallback
+	}
+
+	return strings.TrimRight(string(data), "\r\n")
+}
+
+func isSecretReference(value string) bool {
+	return strings.HasPrefix(value, googleSecretPrefix) || strings.HasPrefix(value, vaultSecretPrefix)
+}
+
+func resolveSecret(reference string) (string, error) {
+	secrets.Lock()
+	defer secrets.Unlock()
+
+	if value, found := secrets.values[reference]; found {
+		return value, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretRequestTimeout)
+	defer cancel()
+
+	var value string
+	var err error
+	if strings.HasPrefix(reference, googleSecretPrefix) {
+		value, err = readGoogleSecret(ctx, strings.TrimPrefix(reference, googleSecretPrefix))
+	} else {
+		value, err = readVaultSecret(ctx, strings.TrimPrefix(reference, vaultSecretPrefix))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	secrets.values[reference] = value
+	return value, nil
+}
+
+// readGoogleSecret reads a secret version from Google Secret Manager.
+// The REST API is called with the application default credentials.
+func readGoogleSecret(ctx context.Context, name string) (string, error) {
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return "", fmt.Errorf("unable to find Google credentials: %v", err)
+	}
+
+	var result struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	url := "https://secretmanager.googleapis.com/v1/" + name + ":access"
+	if err := getSecretJSON(ctx, client, url, nil, &result); err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(result.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("invalid payload of secret %q: %v", name, err)
+	}
+
+	return string(data), nil
+}
+
+// readVaultSecret reads a field of a secret from Vault, path#field.
+// The key/value secrets engines version 1 and 2 are supported.
+func readVaultSecret(ctx context.Context, reference string) (string, error) {
+	parts := strings.SplitN(reference, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid Vault reference %q, the expected format is vault://path#field", reference)
+	}
+	path, field := parts[0], parts[1]
+
+	address := getStringValue("VAULT_ADDR", "")
+	if address == "" {
+		return "", fmt.Errorf("VAULT_ADDR is not configured")
+	}
+
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	url := strings.TrimRight(address, "/") + "/v1/" + strings.TrimLeft(path, "/")
+	headers := map[string]string{"X-Vault-Token": getFileValue("VAULT_TOKEN", "")}
+	if err := getSecretJSON(ctx, http.DefaultClient, url, headers, &result); err != nil {
+		return "", err
+	}
+
+	data := result.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, versioned := data["metadata"]; versioned {
+			data = nested
+		}
+	}
+
+	value, ok := data[field].(string)
+	if !ok {
+		return "", fmt.Errorf("the Vault secret %q has no field %q", path, field)
+	}
+
+	return value, nil
+}
+
+func getSecretJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, v interface{}) error {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to request %s: %v", url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to request %s: status code %d", url, response.StatusCode)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid response from %s: %v", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package config // import "miniflux.app/config"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSecretFromFile(t *testing.T) {
+	os.Clearenv()
+
+	dir, err := ioutil.TempDir("", "miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(filename, []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("SMTP_PASSWORD_FILE", filename)
+
+	cfg := NewConfig()
+	if result := cfg.SMTPPassword(); result != "secret" {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %q instead of "secret"`, result)
+	}
+
+	// The variable has priority over the file.
+	os.Setenv("SMTP_PASSWORD", "other")
+	if result := cfg.SMTPPassword(); result != "other" {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %q instead of "other"`, result)
+	}
+}
+
+func TestSecretFromMissingFile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DATABASE_URL_FILE", "/does/not/exist")
+
+	cfg := NewConfig()
+	if result := cfg.DatabaseURL(); result != defaultDatabaseURL {
+		t.Fatalf(`Unexpected DATABASE_URL value, got %q instead of the default value`, result)
+	}
+}
+
+func TestSecretFromVault(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/secret/data/miniflux":
+			fmt.Fprint(w, `{"data": {"data": {"oauth2": "from-kv2"}, "metadata": {"version": 1}}}`)
+		case "/v1/kv/miniflux":
+			fmt.Fprint(w, `{"data": {"oauth2": "from-kv1"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	scenarios := map[string]string{
+		"vault://secret/data/miniflux#oauth2": "from-kv2",
+		"vault://kv/miniflux#oauth2":          "from-kv1",
+		"vault://kv/miniflux#missing":         defaultOAuth2ClientSecret,
+		"vault://kv/unknown#oauth2":           defaultOAuth2ClientSecret,
+	}
+
+	for reference, expected := range scenarios {
+		os.Clearenv()
+		os.Setenv("VAULT_ADDR", server.URL)
+		os.Setenv("VAULT_TOKEN", "token")
+		os.Setenv("OAUTH2_CLIENT_SECRET", reference)
+
+		cfg := NewConfig()
+		if result := cfg.OAuth2ClientSecret(); result != expected {
+			t.Errorf(`Unexpected value for %q, got %q instead of %q`, reference, result, expected)
+		}
+	}
+
+	// The secrets are requested only once.
+	requests = 0
+	cfg := NewConfig()
+	os.Setenv("OAUTH2_CLIENT_SECRET", "vault://secret/data/miniflux#oauth2")
+	cfg.OAuth2ClientSecret()
+	if requests != 0 {
+		t.Fatalf(`The secret should be cached, got %d requests`, requests)
+	}
+}
+
+func TestSecretReferenceIsNotUsedForOtherOptions(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("OAUTH2_PROVIDER", "vault://secret/data/miniflux#provider")
+
+	cfg := NewConfig()
+	if result := cfg.OAuth2Provider(); result != "vault://secret/data/miniflux#provider" {
+		t.Fatalf(`Unexpected OAUTH2_PROVIDER value, got %q`, result)
+	}
+}
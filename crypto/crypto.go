@@ -5,9 +5,11 @@
 package crypto // import "miniflux.app/crypto"
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 )
 
@@ -22,6 +24,13 @@ func Hash(value string) string {
 	return HashFromBytes([]byte(value))
 }
 
+// HMAC returns the hex encoded HMAC-SHA256 of a string.
+func HMAC(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 // GenerateRandomBytes returns random bytes.
 func GenerateRandomBytes(size int) []byte {
 	b := make([]byte, size)
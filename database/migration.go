@@ -12,44 +12,274 @@ import (
 	"miniflux.app/logger"
 )
 
-const schemaVersion = 21
+// migrationLockID is the key of the Postgres advisory lock held while a migration runs,
+// it prevents several instances started at the same time from applying the same migration.
+const migrationLockID = 7283621
+
+// Migration describes a schema change stored in the "sql" folder.
+// The up script is named "schema_version_N.sql" and the optional down script "schema_version_N_down.sql".
+type Migration struct {
+	Version int
+	Name    string
+}
+
+// Reversible returns true if the migration has a down script.
+func (m Migration) Reversible() bool {
+	_, found := SqlMap[m.downKey()]
+	return found
+}
+
+func (m Migration) upKey() string {
+	return "schema_version_" + strconv.Itoa(m.Version)
+}
+
+func (m Migration) downKey() string {
+	return m.upKey() + "_down"
+}
+
+// Migrations is the ordered list of schema changes, new migrations must be appended at the end.
+var Migrations = []Migration{
+	{1, "create_initial_schema"},
+	{2, "add_users_extra"},
+	{3, "create_tokens"},
+	{4, "add_users_entry_direction"},
+	{5, "create_integrations"},
+	{6, "add_feeds_scraper_rules"},
+	{7, "add_feeds_rewrite_rules"},
+	{8, "add_feeds_crawler"},
+	{9, "rename_sessions_to_user_sessions"},
+	{10, "replace_tokens_with_sessions"},
+	{11, "add_wallabag_integration"},
+	{12, "add_entries_starred"},
+	{13, "add_entries_and_feeds_indexes"},
+	{14, "add_nunux_keeper_integration"},
+	{15, "use_bigint_for_enclosures_size"},
+	{16, "add_entries_comments_url"},
+	{17, "add_pocket_integration"},
+	{18, "use_inet_for_user_sessions_ip"},
+	{19, "add_feeds_credentials"},
+	{20, "add_entries_document_vectors"},
+	{21, "add_feeds_user_agent"},
+	{22, "create_refresh_jobs"},
+	{23, "add_entries_score"},
+	{24, "add_entries_changed_at"},
+	{25, "add_feeds_script"},
+	{26, "create_feed_responses"},
+	{27, "add_feeds_and_categories_deleted_at"},
+	{28, "create_entry_read_snapshots"},
+	{29, "add_users_keyboard_shortcuts"},
+	{30, "add_users_entries_per_page_and_show_read_entries"},
+	{31, "add_feeds_entry_open_mode"},
+	{32, "create_entry_snapshots"},
+	{33, "add_feeds_watch_selector"},
+	{34, "add_users_quiet_hours"},
+	{35, "add_integrations_notifications"},
+	{36, "add_integrations_filters"},
+	{37, "add_users_show_absolute_time"},
+	{38, "add_entries_read_at"},
+	{39, "add_integrations_kindle"},
+	{40, "add_integrations_readeck_omnivore"},
+	{41, "add_feeds_priority"},
+	{42, "add_feeds_muted_until"},
+	{43, "add_mark_read_after_days"},
+	{44, "add_feeds_max_entries"},
+	{45, "add_feeds_entry_matching"},
+	{46, "create_feed_fetches"},
+	{47, "add_feeds_created_at"},
+	{48, "add_entries_title_trgm_index"},
+	{49, "add_entries_clusters"},
+	{50, "create_pubsub_outbox"},
+	{51, "create_secrets"},
+	{52, "create_scheduler_leases"},
+	{53, "create_api_idempotency_keys"},
+	{54, "add_feeds_categories_version"},
+	{55, "create_entry_tombstones"},
+	{56, "add_users_write_freeze"},
+	{57, "add_feeds_pending"},
+	{58, "create_category_shares"},
+	{59, "add_users_signup"},
+	{60, "add_users_deletion"},
+	{61, "create_service_accounts"},
+	{62, "add_feeds_scraper_max_pages"},
+	{63, "create_filter_lists"},
+	{64, "add_image_display"},
+	{65, "add_feeds_summary_words"},
+	{66, "add_entries_summary"},
+	{67, "create_entry_embeddings"},
+	{68, "add_junk_score"},
+	{69, "add_nsfw"},
+	{70, "create_gemini_certificates"},
+	{71, "add_pubsub_outbox_payload"},
+	{72, "create_held_notifications"},
+}
+
+// MigrationStatus describes a migration and whether it has been applied.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
 
 // Migrate executes database migrations.
 func Migrate(db *sql.DB) {
-	var currentVersion int
-	db.QueryRow(`select version from schema_version`).Scan(&currentVersion)
+	currentVersion, err := CurrentSchemaVersion(db)
+	if err != nil {
+		logger.Fatal("[Migrate] %v", err)
+	}
 
 	fmt.Println("Current schema version:", currentVersion)
-	fmt.Println("Latest schema version:", schemaVersion)
+	fmt.Println("Latest schema version:", LatestSchemaVersion())
 
-	for version := currentVersion + 1; version <= schemaVersion; version++ {
-		fmt.Println("Migrating to version:", version)
+	for _, migration := range Migrations {
+		if migration.Version <= currentVersion {
+			continue
+		}
 
-		tx, err := db.Begin()
+		fmt.Printf("Migrating to version: %d (%s)\n", migration.Version, migration.Name)
+
+		applied, err := execMigration(db, migration.Version-1, SqlMap[migration.upKey()], migration.Version)
 		if err != nil {
 			logger.Fatal("[Migrate] %v", err)
 		}
 
-		rawSQL := SqlMap["schema_version_"+strconv.Itoa(version)]
-		// fmt.Println(rawSQL)
-		_, err = tx.Exec(rawSQL)
-		if err != nil {
-			tx.Rollback()
-			logger.Fatal("[Migrate] %v", err)
+		if !applied {
+			fmt.Println("Migration already applied by another instance:", migration.Version)
 		}
+	}
+}
 
-		if _, err := tx.Exec(`delete from schema_version`); err != nil {
-			tx.Rollback()
-			logger.Fatal("[Migrate] %v", err)
+// Rollback executes the down scripts of all migrations newer than the given version.
+func Rollback(db *sql.DB, targetVersion int) {
+	currentVersion, err := CurrentSchemaVersion(db)
+	if err != nil {
+		logger.Fatal("[Rollback] %v", err)
+	}
+
+	if targetVersion < 0 || targetVersion >= currentVersion {
+		logger.Fatal("[Rollback] The target version must be lower than the current schema version %d", currentVersion)
+	}
+
+	for i := len(Migrations) - 1; i >= 0; i-- {
+		migration := Migrations[i]
+		if migration.Version > currentVersion {
+			continue
 		}
 
-		if _, err := tx.Exec(`insert into schema_version (version) values($1)`, version); err != nil {
-			tx.Rollback()
-			logger.Fatal("[Migrate] %v", err)
+		if migration.Version <= targetVersion {
+			break
 		}
 
-		if err := tx.Commit(); err != nil {
-			logger.Fatal("[Migrate] %v", err)
+		if !migration.Reversible() {
+			logger.Fatal("[Rollback] The migration %d (%s) cannot be rolled back", migration.Version, migration.Name)
 		}
+
+		fmt.Printf("Rolling back version: %d (%s)\n", migration.Version, migration.Name)
+
+		applied, err := execMigration(db, migration.Version, SqlMap[migration.downKey()], migration.Version-1)
+		if err != nil {
+			logger.Fatal("[Rollback] %v", err)
+		}
+
+		if !applied {
+			logger.Fatal("[Rollback] The schema version has been changed by another instance")
+		}
+	}
+}
+
+// Status returns the list of migrations with their state.
+func Status(db *sql.DB) ([]MigrationStatus, error) {
+	currentVersion, err := CurrentSchemaVersion(db)
+	if err != nil {
+		return nil, err
 	}
+
+	var statuses []MigrationStatus
+	for _, migration := range Migrations {
+		statuses = append(statuses, MigrationStatus{Migration: migration, Applied: migration.Version <= currentVersion})
+	}
+
+	return statuses, nil
+}
+
+// CurrentSchemaVersion returns the version of the database schema, zero means an empty database.
+func CurrentSchemaVersion(db *sql.DB) (int, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("unable to start transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	return schemaVersion(tx)
+}
+
+// LatestSchemaVersion returns the version of the last known migration.
+func LatestSchemaVersion() int {
+	return Migrations[len(Migrations)-1].Version
+}
+
+func schemaVersion(tx *sql.Tx) (int, error) {
+	var exists bool
+	if err := tx.QueryRow(`select to_regclass('schema_version') is not null`).Scan(&exists); err != nil {
+		return 0, fmt.Errorf("unable to check the schema_version table: %v", err)
+	}
+
+	if !exists {
+		return 0, nil
+	}
+
+	var version int
+	err := tx.QueryRow(`select version from schema_version`).Scan(&version)
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch the schema version: %v", err)
+	}
+
+	return version, nil
+}
+
+// execMigration runs the script in a transaction protected by an advisory lock.
+// It returns false without doing anything if the schema is no longer at the expected version.
+func execMigration(db *sql.DB, expectedVersion int, rawSQL string, newVersion int) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	if _, err := tx.Exec(`select pg_advisory_xact_lock($1)`, migrationLockID); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("unable to acquire the migration lock: %v", err)
+	}
+
+	version, err := schemaVersion(tx)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if version != expectedVersion {
+		tx.Rollback()
+		return false, nil
+	}
+
+	if _, err := tx.Exec(rawSQL); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("unable to migrate to version %d: %v", newVersion, err)
+	}
+
+	if _, err := tx.Exec(`delete from schema_version`); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("unable to delete the schema version: %v", err)
+	}
+
+	if _, err := tx.Exec(`insert into schema_version (version) values($1)`, newVersion); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("unable to update the schema version: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("unable to commit the migration: %v", err)
+	}
+
+	return true, nil
 }
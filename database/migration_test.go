@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package database // import "miniflux.app/database"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrationsAreSequential(t *testing.T) {
+	for i, migration := range Migrations {
+		if migration.Version != i+1 {
+			t.Fatalf(`Unexpected version for migration %q, got %d instead of %d`, migration.Name, migration.Version, i+1)
+		}
+
+		if migration.Name == "" {
+			t.Errorf(`The migration %d has no name`, migration.Version)
+		}
+	}
+
+	if LatestSchemaVersion() != len(Migrations) {
+		t.Errorf(`Unexpected latest schema version, got %d instead of %d`, LatestSchemaVersion(), len(Migrations))
+	}
+}
+
+func TestMigrationsHaveScripts(t *testing.T) {
+	for _, migration := range Migrations {
+		if _, found := SqlMap[migration.upKey()]; !found {
+			t.Errorf(`The migration %d has no SQL script`, migration.Version)
+		}
+	}
+}
+
+func TestDownScriptsMatchMigrations(t *testing.T) {
+	known := make(map[string]bool)
+	for _, migration := range Migrations {
+		known[migration.downKey()] = true
+	}
+
+	for name := range SqlMap {
+		if strings.HasSuffix(name, "_down") && !known[name] {
+			t.Errorf(`The down script %q does not match any migration`, name)
+		}
+	}
+}
+
+func TestInitialSchemaIsNotReversible(t *testing.T) {
+	if Migrations[0].Reversible() {
+		t.Error(`The initial schema should not be reversible`)
+	}
+
+	if !Migrations[len(Migrations)-1].Reversible() {
+		t.Error(`The latest migration should be reversible`)
+	}
+}
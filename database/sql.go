@@ -115,28 +115,66 @@ create table sessions (
     created_at timestamp with time zone not null default now(),
     primary key(id)
 );`,
+	"schema_version_10_down": `drop table sessions;
+
+create table tokens (
+    id text not null,
+    value text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key(id, value)
+);
+`,
 	"schema_version_11": `alter table integrations add column wallabag_enabled bool default 'f';
 alter table integrations add column wallabag_url text default '';
 alter table integrations add column wallabag_client_id text default '';
 alter table integrations add column wallabag_client_secret text default '';
 alter table integrations add column wallabag_username text default '';
 alter table integrations add column wallabag_password text default '';`,
+	"schema_version_11_down": `alter table integrations drop column wallabag_enabled;
+alter table integrations drop column wallabag_url;
+alter table integrations drop column wallabag_client_id;
+alter table integrations drop column wallabag_client_secret;
+alter table integrations drop column wallabag_username;
+alter table integrations drop column wallabag_password;
+`,
 	"schema_version_12": `alter table entries add column starred bool default 'f';`,
+	"schema_version_12_down": `alter table entries drop column starred;
+`,
 	"schema_version_13": `create index entries_user_status_idx on entries(user_id, status);
 create index feeds_user_category_idx on feeds(user_id, category_id);
+`,
+	"schema_version_13_down": `drop index entries_user_status_idx;
+drop index feeds_user_category_idx;
 `,
 	"schema_version_14": `alter table integrations add column nunux_keeper_enabled bool default 'f';
 alter table integrations add column nunux_keeper_url text default '';
 alter table integrations add column nunux_keeper_api_key text default '';`,
+	"schema_version_14_down": `alter table integrations drop column nunux_keeper_enabled;
+alter table integrations drop column nunux_keeper_url;
+alter table integrations drop column nunux_keeper_api_key;
+`,
 	"schema_version_15": `alter table enclosures alter column size set data type bigint;`,
+	"schema_version_15_down": `alter table enclosures alter column size set data type int;
+`,
 	"schema_version_16": `alter table entries add column comments_url text default '';`,
+	"schema_version_16_down": `alter table entries drop column comments_url;
+`,
 	"schema_version_17": `alter table integrations add column pocket_enabled bool default 'f';
 alter table integrations add column pocket_access_token text default '';
 alter table integrations add column pocket_consumer_key text default '';
+`,
+	"schema_version_17_down": `alter table integrations drop column pocket_enabled;
+alter table integrations drop column pocket_access_token;
+alter table integrations drop column pocket_consumer_key;
 `,
 	"schema_version_18": `alter table user_sessions alter column ip set data type inet using ip::inet;`,
+	"schema_version_18_down": `alter table user_sessions alter column ip set data type text using host(ip);
+`,
 	"schema_version_19": `alter table feeds add column username text default '';
 alter table feeds add column password text default '';`,
+	"schema_version_19_down": `alter table feeds drop column username;
+alter table feeds drop column password;
+`,
 	"schema_version_2": `create extension if not exists hstore;
 alter table users add column extra hstore;
 create index users_extra_idx on users using gin(extra);
@@ -144,15 +182,270 @@ create index users_extra_idx on users using gin(extra);
 	"schema_version_20": `alter table entries add column document_vectors tsvector;
 update entries set document_vectors = to_tsvector(substring(title || ' ' || coalesce(content, '') for 1000000));
 create index document_vectors_idx on entries using gin(document_vectors);`,
+	"schema_version_20_down": `drop index document_vectors_idx;
+alter table entries drop column document_vectors;
+`,
 	"schema_version_21": `alter table feeds add column user_agent text default '';`,
+	"schema_version_21_down": `alter table feeds drop column user_agent;
+`,
+	"schema_version_22": `create table refresh_jobs (
+    id serial not null,
+    user_id int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table refresh_job_feeds (
+    job_id int not null,
+    feed_id int not null,
+    status text not null default 'pending',
+    error_msg text not null default '',
+    updated_at timestamp with time zone not null default now(),
+    primary key (job_id, feed_id),
+    foreign key (job_id) references refresh_jobs(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+`,
+	"schema_version_22_down": `drop table refresh_job_feeds;
+drop table refresh_jobs;
+`,
+	"schema_version_23": `alter table entries add column score double precision not null default 0;
+`,
+	"schema_version_23_down": `alter table entries drop column score;
+`,
+	"schema_version_24": `alter table entries add column changed_at timestamp with time zone not null default now();
+create index entries_changed_at_idx on entries(user_id, changed_at);
+`,
+	"schema_version_24_down": `drop index entries_changed_at_idx;
+alter table entries drop column changed_at;
+`,
+	"schema_version_25": `alter table feeds add column script text not null default '';
+`,
+	"schema_version_25_down": `alter table feeds drop column script;
+`,
+	"schema_version_26": `create table feed_responses (
+    id bigserial not null,
+    feed_id bigint not null,
+    url text not null default '',
+    status_code int not null,
+    content_type text not null default '',
+    etag text not null default '',
+    last_modified text not null default '',
+    size int not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_responses_feed_idx on feed_responses(feed_id, created_at);
+`,
+	"schema_version_26_down": `drop table feed_responses;
+`,
+	"schema_version_27": `alter table feeds add column deleted_at timestamp with time zone;
+alter table categories add column deleted_at timestamp with time zone;
+
+alter table feeds drop constraint feeds_user_id_feed_url_key;
+create unique index feeds_user_id_feed_url_idx on feeds(user_id, feed_url) where deleted_at is null;
+
+alter table categories drop constraint categories_user_id_title_key;
+create unique index categories_user_id_title_idx on categories(user_id, title) where deleted_at is null;
+
+create index feeds_deleted_at_idx on feeds(deleted_at) where deleted_at is not null;
+`,
+	"schema_version_27_down": `delete from feeds where deleted_at is not null;
+delete from categories where deleted_at is not null;
+
+drop index feeds_deleted_at_idx;
+
+drop index categories_user_id_title_idx;
+alter table categories add constraint categories_user_id_title_key unique (user_id, title);
+
+drop index feeds_user_id_feed_url_idx;
+alter table feeds add constraint feeds_user_id_feed_url_key unique (user_id, feed_url);
+
+alter table categories drop column deleted_at;
+alter table feeds drop column deleted_at;
+`,
+	"schema_version_28": `create table entry_read_snapshots (
+    user_id int not null,
+    entry_ids bigint[] not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+`,
+	"schema_version_28_down": `drop table entry_read_snapshots;
+`,
+	"schema_version_29": `alter table users add column keyboard_shortcuts jsonb not null default '{}';
+`,
+	"schema_version_29_down": `alter table users drop column keyboard_shortcuts;
+`,
+	"schema_version_2_down": `drop index users_extra_idx;
+alter table users drop column extra;
+`,
 	"schema_version_3": `create table tokens (
     id text not null,
     value text not null,
     created_at timestamp with time zone not null default now(),
     primary key(id, value)
 );`,
+	"schema_version_30": `alter table users add column entries_per_page int not null default 100;
+alter table users add column show_read_entries bool not null default 'f';
+`,
+	"schema_version_30_down": `alter table users drop column show_read_entries;
+alter table users drop column entries_per_page;
+`,
+	"schema_version_31": `alter table feeds add column entry_open_mode text not null default 'content';
+`,
+	"schema_version_31_down": `alter table feeds drop column entry_open_mode;
+`,
+	"schema_version_32": `create table entry_snapshots (
+    entry_id bigint not null,
+    url text not null,
+    size int not null default 0,
+    error text not null default '',
+    content bytea not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id)
+);
+`,
+	"schema_version_32_down": `drop table entry_snapshots;
+`,
+	"schema_version_33": `alter table feeds add column watch_selector text not null default '';
+alter table feeds add column watch_content text not null default '';
+`,
+	"schema_version_33_down": `alter table feeds drop column watch_content;
+alter table feeds drop column watch_selector;
+`,
+	"schema_version_34": `alter table users add column quiet_hours_start text not null default '';
+alter table users add column quiet_hours_end text not null default '';
+`,
+	"schema_version_34_down": `alter table users drop column quiet_hours_end;
+alter table users drop column quiet_hours_start;
+`,
+	"schema_version_35": `alter table integrations add column ntfy_enabled bool default 'f';
+alter table integrations add column ntfy_url text default '';
+alter table integrations add column ntfy_topic text default '';
+alter table integrations add column ntfy_token text default '';
+alter table integrations add column pushover_enabled bool default 'f';
+alter table integrations add column pushover_token text default '';
+alter table integrations add column pushover_user text default '';
+alter table integrations add column apprise_enabled bool default 'f';
+alter table integrations add column apprise_url text default '';
+alter table integrations add column apprise_service_urls text default '';
+`,
+	"schema_version_35_down": `alter table integrations drop column ntfy_enabled;
+alter table integrations drop column ntfy_url;
+alter table integrations drop column ntfy_topic;
+alter table integrations drop column ntfy_token;
+alter table integrations drop column pushover_enabled;
+alter table integrations drop column pushover_token;
+alter table integrations drop column pushover_user;
+alter table integrations drop column apprise_enabled;
+alter table integrations drop column apprise_url;
+alter table integrations drop column apprise_service_urls;
+`,
+	"schema_version_36": `alter table integrations add column filters jsonb not null default '{}';
+`,
+	"schema_version_36_down": `alter table integrations drop column filters;
+`,
+	"schema_version_37": `alter table users add column show_absolute_time bool not null default 'f';
+`,
+	"schema_version_37_down": `alter table users drop column show_absolute_time;
+`,
+	"schema_version_38": `alter table entries add column read_at timestamp with time zone;
+update entries set read_at=changed_at where status='read';
+create index entries_user_read_at_idx on entries (user_id, read_at) where read_at is not null;
+`,
+	"schema_version_38_down": `drop index entries_user_read_at_idx;
+alter table entries drop column read_at;
+`,
+	"schema_version_39": `alter table integrations add column kindle_enabled bool default 'f';
+alter table integrations add column kindle_email text default '';
+`,
+	"schema_version_39_down": `alter table integrations drop column kindle_enabled;
+alter table integrations drop column kindle_email;
+`,
+	"schema_version_3_down": `drop table tokens;
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `alter table integrations add column readeck_enabled bool default 'f';
+alter table integrations add column readeck_url text default '';
+alter table integrations add column readeck_api_key text default '';
+alter table integrations add column readeck_labels text default '';
+alter table integrations add column omnivore_enabled bool default 'f';
+alter table integrations add column omnivore_url text default '';
+alter table integrations add column omnivore_api_key text default '';
+`,
+	"schema_version_40_down": `alter table integrations drop column readeck_enabled;
+alter table integrations drop column readeck_url;
+alter table integrations drop column readeck_api_key;
+alter table integrations drop column readeck_labels;
+alter table integrations drop column omnivore_enabled;
+alter table integrations drop column omnivore_url;
+alter table integrations drop column omnivore_api_key;
+`,
+	"schema_version_41": `alter table feeds add column priority text not null default 'normal';
+`,
+	"schema_version_41_down": `alter table feeds drop column priority;
+`,
+	"schema_version_42": `alter table feeds add column muted_until timestamp with time zone;
+`,
+	"schema_version_42_down": `alter table feeds drop column muted_until;
+`,
+	"schema_version_43": `alter table feeds add column mark_read_after_days int not null default 0;
+alter table categories add column mark_read_after_days int not null default 0;
+`,
+	"schema_version_43_down": `alter table categories drop column mark_read_after_days;
+alter table feeds drop column mark_read_after_days;
+`,
+	"schema_version_44": `alter table feeds add column max_entries int not null default 0;
+alter table feeds add column overflow_policy text not null default 'archive';
+`,
+	"schema_version_44_down": `alter table feeds drop column overflow_policy;
+alter table feeds drop column max_entries;
+`,
+	"schema_version_45": `alter table feeds add column entry_matching text not null default 'guid';
+`,
+	"schema_version_45_down": `alter table feeds drop column entry_matching;
+`,
+	"schema_version_46": `create table feed_fetches (
+    id bigserial not null,
+    feed_id bigint not null,
+    success bool not null,
+    fetched_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_fetches_feed_idx on feed_fetches(feed_id, fetched_at);
+`,
+	"schema_version_46_down": `drop table feed_fetches;
+`,
+	"schema_version_47": `alter table feeds add column created_at timestamp with time zone not null default now();
+update feeds set created_at=coalesce((select min(changed_at) from entries where entries.feed_id=feeds.id), now());
+`,
+	"schema_version_47_down": `alter table feeds drop column created_at;
+`,
+	"schema_version_48": `create extension if not exists pg_trgm;
+create index entries_title_trgm_idx on entries using gin (title gin_trgm_ops);
+`,
+	"schema_version_48_down": `drop index entries_title_trgm_idx;
+`,
+	"schema_version_49": `alter table entries add column simhash bigint;
+alter table entries add column cluster_id bigint;
+create index entries_user_cluster_idx on entries(user_id, cluster_id);
+create index entries_unclustered_idx on entries(id) where simhash is null;
+`,
+	"schema_version_49_down": `alter table entries drop column cluster_id;
+alter table entries drop column simhash;
+`,
+	"schema_version_4_down": `alter table users drop column entry_direction;
+drop type entry_sorting_direction;
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -169,36 +462,416 @@ alter table users add column entry_direction entry_sorting_direction default 'as
     fever_token text default '',
     primary key(user_id)
 )
+`,
+	"schema_version_50": `create table pubsub_outbox (
+    id bigserial not null,
+    entity_type text not null,
+    entity_id bigint not null,
+    entity_op text not null,
+    status text not null default 'failed',
+    error_msg text not null default '',
+    attempts int not null default 1,
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id)
+);
+
+create index pubsub_outbox_status_idx on pubsub_outbox(status, created_at);
+`,
+	"schema_version_50_down": `drop table pubsub_outbox;
+`,
+	"schema_version_51": `create table secrets (
+    name text not null,
+    value bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (name)
+);
+`,
+	"schema_version_51_down": `drop table secrets;
+`,
+	"schema_version_52": `create table scheduler_leases (
+    name text not null,
+    holder text not null,
+    expires_at timestamp with time zone not null,
+    primary key (name)
+);
+`,
+	"schema_version_52_down": `drop table scheduler_leases;
+`,
+	"schema_version_53": `create table api_idempotency_keys (
+    user_id bigint not null,
+    key text not null,
+    fingerprint text not null,
+    status_code int not null default 0,
+    content_type text not null default '',
+    body bytea,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, key),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index api_idempotency_keys_created_at_idx on api_idempotency_keys(created_at);
+`,
+	"schema_version_53_down": `drop table api_idempotency_keys;
+`,
+	"schema_version_54": `alter table feeds add column version int not null default 1;
+alter table categories add column version int not null default 1;
+`,
+	"schema_version_54_down": `alter table feeds drop column version;
+alter table categories drop column version;
+`,
+	"schema_version_55": `create table entry_tombstones (
+    user_id int not null,
+    entry_id bigint not null,
+    deleted_at timestamp with time zone not null default now(),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_tombstones_user_id_deleted_at_idx on entry_tombstones(user_id, deleted_at);
+`,
+	"schema_version_55_down": `drop table entry_tombstones;
+`,
+	"schema_version_56": `alter table users add column frozen_until timestamp with time zone;
+alter table users add column freeze_message text not null default '';
+`,
+	"schema_version_56_down": `alter table users drop column frozen_until;
+alter table users drop column freeze_message;
+`,
+	"schema_version_57": `alter table feeds add column pending bool not null default 'f';
+create index feeds_pending_idx on feeds(id) where pending;
+`,
+	"schema_version_57_down": `drop index feeds_pending_idx;
+alter table feeds drop column pending;
+`,
+	"schema_version_58": `create table category_shares (
+    category_id bigint not null,
+    user_id bigint not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (category_id, user_id),
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index category_shares_user_id_idx on category_shares(user_id);
+`,
+	"schema_version_58_down": `drop table category_shares;
+`,
+	"schema_version_59": `alter table users add column email text;
+alter table users add column verified bool not null default 't';
+alter table users add column pending bool not null default 'f';
+create unique index users_email_idx on users(lower(email));
+
+create table tokens (
+    id bigserial not null,
+    user_id bigint not null,
+    type text not null,
+    hash text not null,
+    email text not null default '',
+    created_at timestamp with time zone not null default now(),
+    expires_at timestamp with time zone not null,
+    used_at timestamp with time zone,
+    primary key (id),
+    unique (hash),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index tokens_user_id_idx on tokens(user_id);
+`,
+	"schema_version_59_down": `drop table tokens;
+drop index users_email_idx;
+alter table users drop column pending;
+alter table users drop column verified;
+alter table users drop column email;
+`,
+	"schema_version_5_down": `drop table integrations;
 `,
 	"schema_version_6": `alter table feeds add column scraper_rules text default '';
+`,
+	"schema_version_60": `alter table users add column deletion_requested_at timestamp with time zone;
+create index users_deletion_requested_idx on users(deletion_requested_at) where deletion_requested_at is not null;
+`,
+	"schema_version_60_down": `drop index users_deletion_requested_idx;
+alter table users drop column deletion_requested_at;
+`,
+	"schema_version_61": `create table service_accounts (
+    id bigserial not null,
+    user_id bigint not null,
+    name text not null,
+    scopes text[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (user_id, name),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table api_keys (
+    id bigserial not null,
+    service_account_id bigint not null,
+    description text not null default '',
+    hash text not null,
+    created_at timestamp with time zone not null default now(),
+    last_used_at timestamp with time zone,
+    primary key (id),
+    unique (hash),
+    foreign key (service_account_id) references service_accounts(id) on delete cascade
+);
+`,
+	"schema_version_61_down": `drop table api_keys;
+drop table service_accounts;
+`,
+	"schema_version_62": `alter table feeds add column scraper_max_pages int not null default 1;
+`,
+	"schema_version_62_down": `alter table feeds drop column scraper_max_pages;
+`,
+	"schema_version_63": `create table filter_lists (
+    url text not null,
+    content text not null default '',
+    etag_header text not null default '',
+    last_modified_header text not null default '',
+    checked_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (url)
+);
+`,
+	"schema_version_63_down": `drop table filter_lists;
+`,
+	"schema_version_64": `alter table users add column image_display text not null default 'show';
+alter table categories add column image_display text not null default '';
+alter table feeds add column image_display text not null default '';
+`,
+	"schema_version_64_down": `alter table feeds drop column image_display;
+alter table categories drop column image_display;
+alter table users drop column image_display;
+`,
+	"schema_version_65": `alter table feeds add column summary_words int not null default 0;
+`,
+	"schema_version_65_down": `alter table feeds drop column summary_words;
+`,
+	"schema_version_66": `alter table entries add column summary text not null default '';
+alter table feeds add column summarize_entries bool not null default 'f';
+`,
+	"schema_version_66_down": `alter table feeds drop column summarize_entries;
+alter table entries drop column summary;
+`,
+	"schema_version_67": `create table entry_embeddings (
+    entry_id bigint not null,
+    user_id int not null,
+    model text not null,
+    vector bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_embeddings_user_model_idx on entry_embeddings(user_id, model);
+`,
+	"schema_version_67_down": `drop table entry_embeddings;
+`,
+	"schema_version_68": `alter table entries add column junk_score int not null default 0;
+alter table users add column junk_threshold int not null default 0;
+`,
+	"schema_version_68_down": `alter table users drop column junk_threshold;
+alter table entries drop column junk_score;
+`,
+	"schema_version_69": `alter table entries add column nsfw bool not null default 'f';
+alter table feeds add column nsfw bool not null default 'f';
+alter table categories add column nsfw bool not null default 'f';
+alter table users add column nsfw_display text not null default 'blur';
+`,
+	"schema_version_69_down": `alter table users drop column nsfw_display;
+alter table categories drop column nsfw;
+alter table feeds drop column nsfw;
+alter table entries drop column nsfw;
+`,
+	"schema_version_6_down": `alter table feeds drop column scraper_rules;
 `,
 	"schema_version_7": `alter table feeds add column rewrite_rules text default '';
+`,
+	"schema_version_70": `create table gemini_certificates (
+    host text not null,
+    fingerprint text not null,
+    expires_at timestamp with time zone not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (host)
+);
+`,
+	"schema_version_70_down": `drop table gemini_certificates;
+`,
+	"schema_version_71": `alter table pubsub_outbox add column user_id bigint not null default 0;
+alter table pubsub_outbox add column data jsonb;
+`,
+	"schema_version_71_down": `alter table pubsub_outbox drop column data;
+alter table pubsub_outbox drop column user_id;
+`,
+	"schema_version_72": `create table held_notifications (
+    id bigserial not null,
+    user_id bigint not null,
+    kind text not null,
+    data jsonb not null,
+    release_at timestamp with time zone not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+create index held_notifications_release_at_idx on held_notifications(release_at);
+`,
+	"schema_version_72_down": `drop table held_notifications;
+`,
+	"schema_version_7_down": `alter table feeds drop column rewrite_rules;
 `,
 	"schema_version_8": `alter table feeds add column crawler boolean default 'f';
+`,
+	"schema_version_8_down": `alter table feeds drop column crawler;
 `,
 	"schema_version_9": `alter table sessions rename to user_sessions;`,
+	"schema_version_9_down": `alter table user_sessions rename to sessions;
+`,
 }
 
 var SqlMapChecksums = map[string]string{
-	"schema_version_1":  "00b2fa9e945565625c93ef9d4242a8b6583dc3cd7edf38d2fc95c0f3f7b926ae",
-	"schema_version_10": "8faf15ddeff7c8cc305e66218face11ed92b97df2bdc2d0d7944d61441656795",
-	"schema_version_11": "dc5bbc302e01e425b49c48ddcd8e29e3ab2bb8e73a6cd1858a6ba9fbec0b5243",
-	"schema_version_12": "a95abab6cdf64811fc744abd37457e2928939d999c5ef00d2bdd9398e16f32fb",
-	"schema_version_13": "9073fae1e796936f4a43a8120ebdb4218442fe7d346ace6387556a357c2d7edf",
-	"schema_version_14": "4622e42c4a5a88b6fe1e61f3d367b295968f7260ab5b96481760775ba9f9e1fe",
-	"schema_version_15": "13ff91462bdf4cda5a94a4c7a09f757761b0f2c32b4be713ba4786a4837750e4",
-	"schema_version_16": "9d006faca62fd7ab787f64aef0e0a5933d142466ec4cab0e096bb920d2797e34",
-	"schema_version_17": "b9f15d6217275fedcf6d948dd85ebe978b869bf37f42a86fd5b50a51919fa0e1",
-	"schema_version_18": "c0ec24847612c7f2dc326cf735baffba79391a56aedd73292371a39f38724a71",
-	"schema_version_19": "a83f77b41cc213d282805a5b518f15abbf96331599119f0ef4aca4be037add7b",
-	"schema_version_2":  "e8e9ff32478df04fcddad10a34cba2e8bb1e67e7977b5bd6cdc4c31ec94282b4",
-	"schema_version_20": "5d414c0cfc0da2863c641079afa58b7ff42dccb0f0e01c822ad435c3e3aa9201",
-	"schema_version_21": "77da01ee38918ff4fe33985fbb20ed3276a717a7584c2ca9ebcf4d4ab6cb6910",
-	"schema_version_3":  "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
-	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
-	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
-	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
-	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
-	"schema_version_8":  "9922073fc4032d8922617ec6a6a07ae8d4817846c138760fb96cb5608ab83bfc",
-	"schema_version_9":  "de5ba954752fe808a993feef5bf0c6f808e0a4ced5379de8bec8342678150892",
+	"schema_version_1":       "00b2fa9e945565625c93ef9d4242a8b6583dc3cd7edf38d2fc95c0f3f7b926ae",
+	"schema_version_10":      "8faf15ddeff7c8cc305e66218face11ed92b97df2bdc2d0d7944d61441656795",
+	"schema_version_10_down": "83a01c45ebf73c0ad65e38561483540862acf0c3e068a8b078aff94c498fb700",
+	"schema_version_11":      "dc5bbc302e01e425b49c48ddcd8e29e3ab2bb8e73a6cd1858a6ba9fbec0b5243",
+	"schema_version_11_down": "c553389f91b5397e7e81a0525e580ca8a8a55e7c6a2c95f1f51af74d293bdf5e",
+	"schema_version_12":      "a95abab6cdf64811fc744abd37457e2928939d999c5ef00d2bdd9398e16f32fb",
+	"schema_version_12_down": "585e085f81b369f0e883c9230ae67d8ae2fd54594ad58a6ce8e8d077e011de61",
+	"schema_version_13":      "9073fae1e796936f4a43a8120ebdb4218442fe7d346ace6387556a357c2d7edf",
+	"schema_version_13_down": "ea146740241089a2d5e222496b3d08a6a9abf4c6315bfd154e03e6b8907db3c0",
+	"schema_version_14":      "4622e42c4a5a88b6fe1e61f3d367b295968f7260ab5b96481760775ba9f9e1fe",
+	"schema_version_14_down": "670946143aebdba3894e639544202ae660173dcb93e60f7e57dc3d8d62ad5f3c",
+	"schema_version_15":      "13ff91462bdf4cda5a94a4c7a09f757761b0f2c32b4be713ba4786a4837750e4",
+	"schema_version_15_down": "7c00829829ecd83a1f41299211dc189bc7a7cc6d11be58e64d41927ca8614754",
+	"schema_version_16":      "9d006faca62fd7ab787f64aef0e0a5933d142466ec4cab0e096bb920d2797e34",
+	"schema_version_16_down": "db2470fd1eab6ff2da45bb4a469119098e96148855c9a574e8f6d41ff1a0b207",
+	"schema_version_17":      "b9f15d6217275fedcf6d948dd85ebe978b869bf37f42a86fd5b50a51919fa0e1",
+	"schema_version_17_down": "ee67b25215342c6144ae8c3fb19aa81e7c77f7c210c08b788be4fcc36ac788e7",
+	"schema_version_18":      "c0ec24847612c7f2dc326cf735baffba79391a56aedd73292371a39f38724a71",
+	"schema_version_18_down": "253e013fc1b452a11b9dca4f49d49bb254ec623db667f23fade1c241e5f1044e",
+	"schema_version_19":      "a83f77b41cc213d282805a5b518f15abbf96331599119f0ef4aca4be037add7b",
+	"schema_version_19_down": "044117a18bf56b96f054b449b5434d17c46373d66dac898cef9580d8809f1475",
+	"schema_version_2":       "e8e9ff32478df04fcddad10a34cba2e8bb1e67e7977b5bd6cdc4c31ec94282b4",
+	"schema_version_20":      "5d414c0cfc0da2863c641079afa58b7ff42dccb0f0e01c822ad435c3e3aa9201",
+	"schema_version_20_down": "49239af2199ee019d51634e728f805777b079abb3a4b22d5679ceb2ca600bdce",
+	"schema_version_21":      "77da01ee38918ff4fe33985fbb20ed3276a717a7584c2ca9ebcf4d4ab6cb6910",
+	"schema_version_21_down": "3db386a3cf01851ffacc500e253df7ede0fcd21a5749ff800ed26ef8435a1f32",
+	"schema_version_22":      "0ea841897233cbe837a159f757d5bbfd7fa94ee236f1edd3a6e864070a37ba8e",
+	"schema_version_22_down": "28211e8f76067cdf7aae96596d086f43eb6d36fc10609b0836168e2f185e86bd",
+	"schema_version_23":      "91cd8fe8962861e8fed0de98a7776a1212eb44e75b325d6083b060d1a8e8d5eb",
+	"schema_version_23_down": "5f2952503cea8a0ae300363a216a26cb9c3666b5c75674447a62524c58477cf2",
+	"schema_version_24":      "d2829e9cb6d397883f2f6ba87f6f0741594924bd0a7c7bf04260dc2e1963c911",
+	"schema_version_24_down": "0170655e612f8d0a58197d58bd47788db363df0dc792330fa2ea3190916117da",
+	"schema_version_25":      "205c3cf75540a6c175fbd2e8e15a273eea8895ccebff06b2ded88b15ac809ea0",
+	"schema_version_25_down": "f475cc739d213ca55fb7479a5bbad267aa79342de95822b7a96805f3b635baaa",
+	"schema_version_26":      "f1809e82d0370a1a6107601c57fc1993a10d02c97da809579a8457b97e68531c",
+	"schema_version_26_down": "0c0ae3f0ceb065bb241514ce53e3bef09ae6b2f9e265408c1c60bd46fda68a15",
+	"schema_version_27":      "446bf1de06ac194992f681e3ba5130afd60e6056613379220e1ca17d0ab8b046",
+	"schema_version_27_down": "46b15c60027318102413fa976a06a3e7536e26edc669ff371e1a88a8560d7e7b",
+	"schema_version_28":      "169b79ba52ded3b699fd59c0f9cdc2f44086f796afe929a5d3825e26cc7a0b33",
+	"schema_version_28_down": "3b677c0b9cd4355388fec4a7e9f582520ae65b2bf5c62bd2a9daee5d5c3aea32",
+	"schema_version_29":      "fb7de0cb4dd00d2a2bba365615c14c116dbfaf7a9e86df19cb4fbd36569d3dd8",
+	"schema_version_29_down": "db4c6687b277b8bf7a724682ec82623c2dc2bd89088a74759dfc526762732d41",
+	"schema_version_2_down":  "32f051db47be867cf0998ffb7283815b815bb870bf88c1a5f51527eb6ea2847e",
+	"schema_version_3":       "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
+	"schema_version_30":      "d7f6f072b24e3b204d3ea8c0248b2ac159b9da4b28bd713d2e868d7ce455e84b",
+	"schema_version_30_down": "7968439f8eb3d4af18e8eabebec0056e059b749ae5ae9a4dbc56ae79ba81cf52",
+	"schema_version_31":      "6aee404ec434aade5b212fbf7b778fb5f736b3ec8409253bc9191c8b5ee94813",
+	"schema_version_31_down": "4c3fa788bdd366f8ae57bd263c347f699a06a2ff4807bfbdb07693e71c708831",
+	"schema_version_32":      "a147a683a9ffbfbc8250d46546475bbc5e48b4170d138b3440676ddd236ebb9c",
+	"schema_version_32_down": "daa48a21b422ad5aaeb7d61dbe047f363a204f8f8660d06b9e6c0f315ed501b8",
+	"schema_version_33":      "7fb7566b3ccca4c21b8113e3a710fc8986e59a7ebaebc20e986a017478daa611",
+	"schema_version_33_down": "0d47994e4fc4c59ab602e9770faa0f0a4eae3387329edbb4dbe5b519a1b54459",
+	"schema_version_34":      "17c18d0ccd6c42a3ea7db67b02df90bc372cb94c0d576512ef1de10b93d6acb0",
+	"schema_version_34_down": "4ad7b627ce6528358137d320ce9560a142200eda2cea5308773b62994dd57e22",
+	"schema_version_35":      "ca68005e9c4e69df26a9ee0fbb13e8a1998687101bad1917d7ac4c3763c4c480",
+	"schema_version_35_down": "b2d8435eec8f12ee18ceac7c1e17791cacc7749bad2540cf6e5a83dffca1a737",
+	"schema_version_36":      "3844ce6feb14a94be373792d3101cce42405e7a80edec69f3e8d1898bfdf225b",
+	"schema_version_36_down": "6ea4464c203ab50b2f222c410546451c4e447e4d0ba4395e821e4f5aa2eed106",
+	"schema_version_37":      "16a6046f7748bdc164728af16cf670b55b4c71b0c3ad2f755c33ba2a8108a082",
+	"schema_version_37_down": "7c7beffb1e7e06d407c467946300e6037ca95878dd3b70da97045d6c513b8728",
+	"schema_version_38":      "27e2683dd427835031cdb2d9112ec7d138531f8aaf240e452af78f7efcf1b918",
+	"schema_version_38_down": "dcfeb06638c5e174d52632d0ac458bebe086202911572cb7e467b1fd8371bc3d",
+	"schema_version_39":      "38250551f728c581de1f16b9720588d28437158875a06f7dd5a52532899f1511",
+	"schema_version_39_down": "a0de4751b2c3ab073ce85c791cb220dcb551aaeb54d84a8f1c312620d8c5851f",
+	"schema_version_3_down":  "dbc2c18ac55ca29b003e7c17a8802be0d825b2a5678b72d15607bebd06f89102",
+	"schema_version_4":       "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40":      "889e91ddd1269b96a65d062d10297f0507049e23c4583a595b7236ffa4f26e2b",
+	"schema_version_40_down": "c3ca164abbe806278dc8ad5347fec9c2cbb6f995972fc5a156dfb2f8c57a1ae1",
+	"schema_version_41":      "b8c68e0917dcbc85df41155c945513fd1218ef075c97e0cc2a1acbb7a09c8f6c",
+	"schema_version_41_down": "22b523853bb396d12cc4ca20603cf1738de74fa55beed4a920c931fe9b43228a",
+	"schema_version_42":      "8a7079861126818f535e40e59305135f2fd234a8ad289c8ffe9e0ab84f93dbf8",
+	"schema_version_42_down": "b9f030eadf0af886582558f47ab29e5393d515d08e2c9d7858f591f99ee8f9c9",
+	"schema_version_43":      "60e6d59066117b79c3d2fa465b18226a3fd41131dc040fa697d18e467792531a",
+	"schema_version_43_down": "fec2c0ad7ab30a3021a58b1962c756d913cd8f69cc74f449b721abccf6f0fd49",
+	"schema_version_44":      "abc70f90595b9ded7bb2b9cb6754634f6bc44202cd2a2a02cfc5a73ce0d474af",
+	"schema_version_44_down": "be3144fc77bf6cf9066547182a1c2125068630994b255f9723ea398e2bec97cf",
+	"schema_version_45":      "4bc931bac6d632f1aac2b7a4ee26580f14f0ddaff48b31aab7adf7c7769f5313",
+	"schema_version_45_down": "3ed9f803ead3a81ccd758fa5514b28aa826c17dc4c0fd7c99b608fe60e3bb408",
+	"schema_version_46":      "93ca2b9e7c2dc6f89c5c4c0f326bf1f75d3df8877348fcebee274f4c2c972b09",
+	"schema_version_46_down": "9a27e192daa94c972615c3706e5dfbe0316ba05f3201e1ea94a11f2663a0ad94",
+	"schema_version_47":      "3fc484fad354d152c4780dccaf84491ee5828a5d5eb21a6818533ce7a8425aa4",
+	"schema_version_47_down": "3098de7be00190584f758d798981271906be12106784ab9d6827d59e1e13118e",
+	"schema_version_48":      "9d376b04576e3a4d2f22f02c8b98fe242f588590c2dcfb9f8dd34c775bd05c38",
+	"schema_version_48_down": "249e0a31872965588893f153afeb59522a846b2d09fd89d934ccde8ad4cbcfb2",
+	"schema_version_49":      "42fba7873edc3e1d3a39e2025aec06befe5541eeda3d03afbdadbc545db25185",
+	"schema_version_49_down": "dc6e602f6dab6f96ac530c0241ec0cb939dcb60584c60d91e6f26c7a6f0e8ea3",
+	"schema_version_4_down":  "b7b38c527b10fb1aaf11a2ee8f8ec8160392c7597a3029e32318ca8e54bf7ee3",
+	"schema_version_5":       "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
+	"schema_version_50":      "5e94af8f0474be08e5fdf27f545e0b73dacb89e792bd96bbc0b4bcf693324412",
+	"schema_version_50_down": "16e4b0ec37da3aa2aff3ab8bd6d8a286088e94601b496f39bb7a47b197cf4f5a",
+	"schema_version_51":      "19fa4c52854ace573fb0884a1f7ba985365c3387b9e021ec5de43c44562ca03a",
+	"schema_version_51_down": "36aa098b22996b60d7c14a9e31edbb04a72cbf5ba2234fcd75547cb89ebda00b",
+	"schema_version_52":      "e9ad304358428c45bcf5568933e84df1dec99142af472a01c042013c4f8ce079",
+	"schema_version_52_down": "28237d35a9307d1ae29a0345ec3b77455c6899dcfa566d836b3d82959dbab8d0",
+	"schema_version_53":      "5994367bed4c77e2d223df004a937840800c49a197f4b4ec322270177621d74a",
+	"schema_version_53_down": "5022e5730f61861e690d15b616335f62bb09e02b82582a598f932188bff9062b",
+	"schema_version_54":      "c77cba7a92f0d8ba13860c0c27871ebeb3574c8843f98eb2bace29630976058e",
+	"schema_version_54_down": "98160d97a699c665be22ad0c2dcee8cf16a2b0087fbad70669fb13b7eb4827e7",
+	"schema_version_55":      "7f96d9931472141ed59c6a9a160e1a5dae61c57cc481f07539b3b989a9427d81",
+	"schema_version_55_down": "f37873c91139fb544f90d9dcf4119657b60b998bebc36a896ee41090d01a257d",
+	"schema_version_56":      "5c2f89e595e48e1ff16e357283e1d56ca4da4096cb12375f31cc243ec5037e47",
+	"schema_version_56_down": "5e1fc5aa61f114fb0cd6f7dd4268d24d7a1a8b3d87f77a73d9a3da5a7bdf2ba7",
+	"schema_version_57":      "6b10e755fc54812879e11d7adaea665cc0ea975a5624c20d27d611782c392bce",
+	"schema_version_57_down": "7480e6ad2d0e3ebb7a9d712c6c48cfa71cbf2e1bbfb916d591a113f1c7b24894",
+	"schema_version_58":      "c4240c79ad58974225e1ebb73c2c1d764326b3db6eb0f0516b264db71cd48866",
+	"schema_version_58_down": "37380aaf2d65428f867adaf9fd4c5ad2ec85f814b4413d36057c0b2332d35aa4",
+	"schema_version_59":      "04d250ba0ab4baf47b9f6efdc50459e3ce7db068af039a8e2f725ad66096a0a6",
+	"schema_version_59_down": "bb7a0a44055ffecee5f407d7afd873ee41e8af6a1b9e2c914938b690683d988a",
+	"schema_version_5_down":  "642396fa3a1d393beca92cc49fbe814774acb7321c9010da55ffc5aef3073b43",
+	"schema_version_6":       "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
+	"schema_version_60":      "eec5f7ebf4fad02019d263300acc49b66749dca26bc9709666f4364c557c6c96",
+	"schema_version_60_down": "1354574250a0e5bea16a83e5d71191024ff6eee5cbac50a14f9487df6014b563",
+	"schema_version_61":      "93cc68617a7cae5354ddffac78264a58b1ff47e5dd07d579e250b40b261cbe19",
+	"schema_version_61_down": "89b407865ecd54730207490061bf31935907779e503231d9d79980064cf00c17",
+	"schema_version_62":      "941a433de67204100873e5ba1e0681060accfa2396c9272345df085784267d78",
+	"schema_version_62_down": "a6a41a69cb4c8d99b776b293e04b92a781c0d3e41a6edb4bd7ce998180bc2fa4",
+	"schema_version_63":      "333aaaf776301501d9af1caef946176befb6895f16f26c6a53f0919097d9c11d",
+	"schema_version_63_down": "f41bbdacb596b31191c5ce3bd13ea18725fad1ed69ed95f7b879041b5cdcd3f3",
+	"schema_version_64":      "91a26570acc91a6f2180c474bc16bf6f09c1b0af48383a414c4e206008817840",
+	"schema_version_64_down": "116cb432903dade270560fbcd0ffe58185183aad53625ec8112382d5bd6a7063",
+	"schema_version_65":      "34836119db20c276e7f793a2ee22d74e79d3fbf00f688efc2c7d564d4c7f7028",
+	"schema_version_65_down": "006e9dbe2a7de6a4002f096c924fa642dc33f5541da6032e13746c7d2ec3c0ef",
+	"schema_version_66":      "1331a28086f9c3f7d16fd8f0f3edf9a3e1d5b8b640cf7b20d797ac3d27428894",
+	"schema_version_66_down": "398087655292132e32fe974399e981af1e4e0fff53958033ded68205ae05cfd3",
+	"schema_version_67":      "9475b3ff9adb70be304eedebf44cfbdb102fa4678c4ee6a8fa4d02c6dfdd1bc2",
+	"schema_version_67_down": "686f937173d4e2bc1e2483f1ee5b3bf77e4244a975b5b2694e2dacbd6c20b16e",
+	"schema_version_68":      "1c501434c0ff3893c27d9d7d28b5d353b8690a8ad2a9565058e58d60b1319785",
+	"schema_version_68_down": "62bfc3359daac740b80c3e500e9c0c0724754a245c2ca751516e7ff6655d8576",
+	"schema_version_69":      "5f7aea84fff755b5c07663559d3777999deba4d4735514f420e664adb8df4539",
+	"schema_version_69_down": "34dad6f403dd97e6116a0642a174e2895845e8719a8d72a6b71fee2ec6d4dca8",
+	"schema_version_6_down":  "5166f227dccfb13ab067d0aaad630e159b10c39fa2c3d74493e43b3067f023cc",
+	"schema_version_7":       "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
+	"schema_version_70":      "328ab75c6e5ddbb15ce4b17ad78d57bed8f16184b4da429c79b7e960deb4c541",
+	"schema_version_70_down": "9d2ab7291947bf8cf91abfcf977cab8893b027dfa125b594c8ea4b94443b222b",
+	"schema_version_71":      "97326e12464360ef5c7400dcd96337bd48e6f5f7557dd222ccb0ca7c36c52cb3",
+	"schema_version_71_down": "a5e602f1fedfb505f1c9a8da9b9a0579e139ae65b4d2049b0340d3f82ee50760",
+	"schema_version_72":      "d901dc51893286a684507b83463ca1f9141f85fcea85baf379b807586e02dc89",
+	"schema_version_72_down": "16f33840e664886b7859e860fbfe2af76807afd2c354d559f247e56c508b6095",
+	"schema_version_7_down":  "ad850832f12ef7429339fd4934812be6e5399215c71a61d3f8eb5c74c5fae65c",
+	"schema_version_8":       "9922073fc4032d8922617ec6a6a07ae8d4817846c138760fb96cb5608ab83bfc",
+	"schema_version_8_down":  "319b2f86c06782ed8244f66c7afa65f094fa1937322c09b87bb0fccf0c03aaef",
+	"schema_version_9":       "de5ba954752fe808a993feef5bf0c6f808e0a4ced5379de8bec8342678150892",
+	"schema_version_9_down":  "38d68eb1f0c2e96f95efab02e021c4510ae51d128afbd7e3dd97fa705c4849a7",
 }
@@ -0,0 +1,6 @@
+alter table integrations drop column wallabag_enabled;
+alter table integrations drop column wallabag_url;
+alter table integrations drop column wallabag_client_id;
+alter table integrations drop column wallabag_client_secret;
+alter table integrations drop column wallabag_username;
+alter table integrations drop column wallabag_password;
@@ -0,0 +1 @@
+alter table entries drop column starred;
@@ -0,0 +1,2 @@
+drop index entries_user_status_idx;
+drop index feeds_user_category_idx;
@@ -0,0 +1,3 @@
+alter table integrations drop column nunux_keeper_enabled;
+alter table integrations drop column nunux_keeper_url;
+alter table integrations drop column nunux_keeper_api_key;
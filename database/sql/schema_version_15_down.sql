@@ -0,0 +1 @@
+alter table enclosures alter column size set data type int;
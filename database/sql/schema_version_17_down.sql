@@ -0,0 +1,3 @@
+alter table integrations drop column pocket_enabled;
+alter table integrations drop column pocket_access_token;
+alter table integrations drop column pocket_consumer_key;
@@ -0,0 +1 @@
+alter table user_sessions alter column ip set data type text using host(ip);
@@ -0,0 +1,2 @@
+alter table feeds drop column username;
+alter table feeds drop column password;
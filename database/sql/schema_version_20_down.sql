@@ -0,0 +1,2 @@
+drop index document_vectors_idx;
+alter table entries drop column document_vectors;
@@ -0,0 +1,18 @@
+create table refresh_jobs (
+    id serial not null,
+    user_id int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table refresh_job_feeds (
+    job_id int not null,
+    feed_id int not null,
+    status text not null default 'pending',
+    error_msg text not null default '',
+    updated_at timestamp with time zone not null default now(),
+    primary key (job_id, feed_id),
+    foreign key (job_id) references refresh_jobs(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
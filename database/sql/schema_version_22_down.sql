@@ -0,0 +1,2 @@
+drop table refresh_job_feeds;
+drop table refresh_jobs;
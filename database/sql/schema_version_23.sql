@@ -0,0 +1 @@
+alter table entries add column score double precision not null default 0;
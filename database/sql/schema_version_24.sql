@@ -0,0 +1,2 @@
+alter table entries add column changed_at timestamp with time zone not null default now();
+create index entries_changed_at_idx on entries(user_id, changed_at);
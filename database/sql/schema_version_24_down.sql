@@ -0,0 +1,2 @@
+drop index entries_changed_at_idx;
+alter table entries drop column changed_at;
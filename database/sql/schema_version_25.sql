@@ -0,0 +1 @@
+alter table feeds add column script text not null default '';
@@ -0,0 +1,16 @@
+create table feed_responses (
+    id bigserial not null,
+    feed_id bigint not null,
+    url text not null default '',
+    status_code int not null,
+    content_type text not null default '',
+    etag text not null default '',
+    last_modified text not null default '',
+    size int not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_responses_feed_idx on feed_responses(feed_id, created_at);
@@ -0,0 +1,10 @@
+alter table feeds add column deleted_at timestamp with time zone;
+alter table categories add column deleted_at timestamp with time zone;
+
+alter table feeds drop constraint feeds_user_id_feed_url_key;
+create unique index feeds_user_id_feed_url_idx on feeds(user_id, feed_url) where deleted_at is null;
+
+alter table categories drop constraint categories_user_id_title_key;
+create unique index categories_user_id_title_idx on categories(user_id, title) where deleted_at is null;
+
+create index feeds_deleted_at_idx on feeds(deleted_at) where deleted_at is not null;
@@ -0,0 +1,13 @@
+delete from feeds where deleted_at is not null;
+delete from categories where deleted_at is not null;
+
+drop index feeds_deleted_at_idx;
+
+drop index categories_user_id_title_idx;
+alter table categories add constraint categories_user_id_title_key unique (user_id, title);
+
+drop index feeds_user_id_feed_url_idx;
+alter table feeds add constraint feeds_user_id_feed_url_key unique (user_id, feed_url);
+
+alter table categories drop column deleted_at;
+alter table feeds drop column deleted_at;
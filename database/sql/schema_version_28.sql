@@ -0,0 +1,7 @@
+create table entry_read_snapshots (
+    user_id int not null,
+    entry_ids bigint[] not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
@@ -0,0 +1 @@
+drop table entry_read_snapshots;
@@ -0,0 +1 @@
+alter table users add column keyboard_shortcuts jsonb not null default '{}';
@@ -0,0 +1,2 @@
+drop index users_extra_idx;
+alter table users drop column extra;
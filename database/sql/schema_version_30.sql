@@ -0,0 +1,2 @@
+alter table users add column entries_per_page int not null default 100;
+alter table users add column show_read_entries bool not null default 'f';
@@ -0,0 +1,2 @@
+alter table users drop column show_read_entries;
+alter table users drop column entries_per_page;
@@ -0,0 +1 @@
+alter table feeds add column entry_open_mode text not null default 'content';
@@ -0,0 +1 @@
+alter table feeds drop column entry_open_mode;
@@ -0,0 +1,9 @@
+create table entry_snapshots (
+    entry_id bigint not null,
+    url text not null,
+    size int not null default 0,
+    error text not null default '',
+    content bytea not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id)
+);
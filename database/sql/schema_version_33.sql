@@ -0,0 +1,2 @@
+alter table feeds add column watch_selector text not null default '';
+alter table feeds add column watch_content text not null default '';
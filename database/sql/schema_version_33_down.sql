@@ -0,0 +1,2 @@
+alter table feeds drop column watch_content;
+alter table feeds drop column watch_selector;
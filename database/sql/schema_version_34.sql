@@ -0,0 +1,2 @@
+alter table users add column quiet_hours_start text not null default '';
+alter table users add column quiet_hours_end text not null default '';
@@ -0,0 +1,2 @@
+alter table users drop column quiet_hours_end;
+alter table users drop column quiet_hours_start;
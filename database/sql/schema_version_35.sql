@@ -0,0 +1,10 @@
+alter table integrations add column ntfy_enabled bool default 'f';
+alter table integrations add column ntfy_url text default '';
+alter table integrations add column ntfy_topic text default '';
+alter table integrations add column ntfy_token text default '';
+alter table integrations add column pushover_enabled bool default 'f';
+alter table integrations add column pushover_token text default '';
+alter table integrations add column pushover_user text default '';
+alter table integrations add column apprise_enabled bool default 'f';
+alter table integrations add column apprise_url text default '';
+alter table integrations add column apprise_service_urls text default '';
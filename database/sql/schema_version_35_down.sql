@@ -0,0 +1,10 @@
+alter table integrations drop column ntfy_enabled;
+alter table integrations drop column ntfy_url;
+alter table integrations drop column ntfy_topic;
+alter table integrations drop column ntfy_token;
+alter table integrations drop column pushover_enabled;
+alter table integrations drop column pushover_token;
+alter table integrations drop column pushover_user;
+alter table integrations drop column apprise_enabled;
+alter table integrations drop column apprise_url;
+alter table integrations drop column apprise_service_urls;
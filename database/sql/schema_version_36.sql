@@ -0,0 +1 @@
+alter table integrations add column filters jsonb not null default '{}';
@@ -0,0 +1 @@
+alter table integrations drop column filters;
@@ -0,0 +1 @@
+alter table users add column show_absolute_time bool not null default 'f';
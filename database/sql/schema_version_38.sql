@@ -0,0 +1,3 @@
+alter table entries add column read_at timestamp with time zone;
+update entries set read_at=changed_at where status='read';
+create index entries_user_read_at_idx on entries (user_id, read_at) where read_at is not null;
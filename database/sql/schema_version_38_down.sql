@@ -0,0 +1,2 @@
+drop index entries_user_read_at_idx;
+alter table entries drop column read_at;
@@ -0,0 +1,2 @@
+alter table integrations add column kindle_enabled bool default 'f';
+alter table integrations add column kindle_email text default '';
@@ -0,0 +1,2 @@
+alter table integrations drop column kindle_enabled;
+alter table integrations drop column kindle_email;
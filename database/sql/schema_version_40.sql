@@ -0,0 +1,7 @@
+alter table integrations add column readeck_enabled bool default 'f';
+alter table integrations add column readeck_url text default '';
+alter table integrations add column readeck_api_key text default '';
+alter table integrations add column readeck_labels text default '';
+alter table integrations add column omnivore_enabled bool default 'f';
+alter table integrations add column omnivore_url text default '';
+alter table integrations add column omnivore_api_key text default '';
@@ -0,0 +1,7 @@
+alter table integrations drop column readeck_enabled;
+alter table integrations drop column readeck_url;
+alter table integrations drop column readeck_api_key;
+alter table integrations drop column readeck_labels;
+alter table integrations drop column omnivore_enabled;
+alter table integrations drop column omnivore_url;
+alter table integrations drop column omnivore_api_key;
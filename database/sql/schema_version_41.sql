@@ -0,0 +1 @@
+alter table feeds add column priority text not null default 'normal';
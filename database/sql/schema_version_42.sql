@@ -0,0 +1 @@
+alter table feeds add column muted_until timestamp with time zone;
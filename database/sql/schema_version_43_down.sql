@@ -0,0 +1,2 @@
+alter table categories drop column mark_read_after_days;
+alter table feeds drop column mark_read_after_days;
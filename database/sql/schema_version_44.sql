@@ -0,0 +1,2 @@
+alter table feeds add column max_entries int not null default 0;
+alter table feeds add column overflow_policy text not null default 'archive';
@@ -0,0 +1,2 @@
+alter table feeds drop column overflow_policy;
+alter table feeds drop column max_entries;
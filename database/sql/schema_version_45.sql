@@ -0,0 +1 @@
+alter table feeds add column entry_matching text not null default 'guid';
@@ -0,0 +1,10 @@
+create table feed_fetches (
+    id bigserial not null,
+    feed_id bigint not null,
+    success bool not null,
+    fetched_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+create index feed_fetches_feed_idx on feed_fetches(feed_id, fetched_at);
@@ -0,0 +1 @@
+drop table feed_fetches;
@@ -0,0 +1,2 @@
+alter table feeds add column created_at timestamp with time zone not null default now();
+update feeds set created_at=coalesce((select min(changed_at) from entries where entries.feed_id=feeds.id), now());
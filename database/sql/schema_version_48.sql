@@ -0,0 +1,2 @@
+create extension if not exists pg_trgm;
+create index entries_title_trgm_idx on entries using gin (title gin_trgm_ops);
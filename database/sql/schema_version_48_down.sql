@@ -0,0 +1 @@
+drop index entries_title_trgm_idx;
@@ -0,0 +1,4 @@
+alter table entries add column simhash bigint;
+alter table entries add column cluster_id bigint;
+create index entries_user_cluster_idx on entries(user_id, cluster_id);
+create index entries_unclustered_idx on entries(id) where simhash is null;
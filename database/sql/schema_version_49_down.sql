@@ -0,0 +1,2 @@
+alter table entries drop column cluster_id;
+alter table entries drop column simhash;
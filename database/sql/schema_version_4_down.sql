@@ -0,0 +1,2 @@
+alter table users drop column entry_direction;
+drop type entry_sorting_direction;
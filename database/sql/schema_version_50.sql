@@ -0,0 +1,14 @@
+create table pubsub_outbox (
+    id bigserial not null,
+    entity_type text not null,
+    entity_id bigint not null,
+    entity_op text not null,
+    status text not null default 'failed',
+    error_msg text not null default '',
+    attempts int not null default 1,
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id)
+);
+
+create index pubsub_outbox_status_idx on pubsub_outbox(status, created_at);
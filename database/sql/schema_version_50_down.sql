@@ -0,0 +1 @@
+drop table pubsub_outbox;
@@ -0,0 +1,6 @@
+create table secrets (
+    name text not null,
+    value bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (name)
+);
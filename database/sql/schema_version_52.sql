@@ -0,0 +1,6 @@
+create table scheduler_leases (
+    name text not null,
+    holder text not null,
+    expires_at timestamp with time zone not null,
+    primary key (name)
+);
@@ -0,0 +1 @@
+drop table scheduler_leases;
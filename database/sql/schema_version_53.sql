@@ -0,0 +1,13 @@
+create table api_idempotency_keys (
+    user_id bigint not null,
+    key text not null,
+    fingerprint text not null,
+    status_code int not null default 0,
+    content_type text not null default '',
+    body bytea,
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, key),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index api_idempotency_keys_created_at_idx on api_idempotency_keys(created_at);
@@ -0,0 +1,2 @@
+alter table feeds add column version int not null default 1;
+alter table categories add column version int not null default 1;
@@ -0,0 +1,2 @@
+alter table feeds drop column version;
+alter table categories drop column version;
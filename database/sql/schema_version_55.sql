@@ -0,0 +1,8 @@
+create table entry_tombstones (
+    user_id int not null,
+    entry_id bigint not null,
+    deleted_at timestamp with time zone not null default now(),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_tombstones_user_id_deleted_at_idx on entry_tombstones(user_id, deleted_at);
@@ -0,0 +1,2 @@
+alter table users add column frozen_until timestamp with time zone;
+alter table users add column freeze_message text not null default '';
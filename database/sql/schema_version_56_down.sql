@@ -0,0 +1,2 @@
+alter table users drop column frozen_until;
+alter table users drop column freeze_message;
@@ -0,0 +1,2 @@
+alter table feeds add column pending bool not null default 'f';
+create index feeds_pending_idx on feeds(id) where pending;
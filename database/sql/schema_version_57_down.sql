@@ -0,0 +1,2 @@
+drop index feeds_pending_idx;
+alter table feeds drop column pending;
@@ -0,0 +1,10 @@
+create table category_shares (
+    category_id bigint not null,
+    user_id bigint not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (category_id, user_id),
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index category_shares_user_id_idx on category_shares(user_id);
@@ -0,0 +1,20 @@
+alter table users add column email text;
+alter table users add column verified bool not null default 't';
+alter table users add column pending bool not null default 'f';
+create unique index users_email_idx on users(lower(email));
+
+create table tokens (
+    id bigserial not null,
+    user_id bigint not null,
+    type text not null,
+    hash text not null,
+    email text not null default '',
+    created_at timestamp with time zone not null default now(),
+    expires_at timestamp with time zone not null,
+    used_at timestamp with time zone,
+    primary key (id),
+    unique (hash),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index tokens_user_id_idx on tokens(user_id);
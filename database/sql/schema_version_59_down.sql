@@ -0,0 +1,5 @@
+drop table tokens;
+drop index users_email_idx;
+alter table users drop column pending;
+alter table users drop column verified;
+alter table users drop column email;
@@ -0,0 +1,2 @@
+alter table users add column deletion_requested_at timestamp with time zone;
+create index users_deletion_requested_idx on users(deletion_requested_at) where deletion_requested_at is not null;
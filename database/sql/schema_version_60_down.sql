@@ -0,0 +1,2 @@
+drop index users_deletion_requested_idx;
+alter table users drop column deletion_requested_at;
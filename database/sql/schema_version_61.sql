@@ -0,0 +1,23 @@
+create table service_accounts (
+    id bigserial not null,
+    user_id bigint not null,
+    name text not null,
+    scopes text[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (user_id, name),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table api_keys (
+    id bigserial not null,
+    service_account_id bigint not null,
+    description text not null default '',
+    hash text not null,
+    created_at timestamp with time zone not null default now(),
+    last_used_at timestamp with time zone,
+    primary key (id),
+    unique (hash),
+    foreign key (service_account_id) references service_accounts(id) on delete cascade
+);
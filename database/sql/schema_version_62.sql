@@ -0,0 +1 @@
+alter table feeds add column scraper_max_pages int not null default 1;
@@ -0,0 +1,9 @@
+create table filter_lists (
+    url text not null,
+    content text not null default '',
+    etag_header text not null default '',
+    last_modified_header text not null default '',
+    checked_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (url)
+);
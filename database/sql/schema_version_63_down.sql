@@ -0,0 +1 @@
+drop table filter_lists;
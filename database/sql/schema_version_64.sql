@@ -0,0 +1,3 @@
+alter table users add column image_display text not null default 'show';
+alter table categories add column image_display text not null default '';
+alter table feeds add column image_display text not null default '';
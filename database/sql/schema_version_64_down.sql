@@ -0,0 +1,3 @@
+alter table feeds drop column image_display;
+alter table categories drop column image_display;
+alter table users drop column image_display;
@@ -0,0 +1 @@
+alter table feeds add column summary_words int not null default 0;
@@ -0,0 +1 @@
+alter table feeds drop column summary_words;
@@ -0,0 +1,2 @@
+alter table entries add column summary text not null default '';
+alter table feeds add column summarize_entries bool not null default 'f';
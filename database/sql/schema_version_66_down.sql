@@ -0,0 +1,2 @@
+alter table feeds drop column summarize_entries;
+alter table entries drop column summary;
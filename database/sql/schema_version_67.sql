@@ -0,0 +1,12 @@
+create table entry_embeddings (
+    entry_id bigint not null,
+    user_id int not null,
+    model text not null,
+    vector bytea not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (entry_id),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_embeddings_user_model_idx on entry_embeddings(user_id, model);
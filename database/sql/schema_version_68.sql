@@ -0,0 +1,2 @@
+alter table entries add column junk_score int not null default 0;
+alter table users add column junk_threshold int not null default 0;
@@ -0,0 +1,2 @@
+alter table users drop column junk_threshold;
+alter table entries drop column junk_score;
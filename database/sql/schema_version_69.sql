@@ -0,0 +1,4 @@
+alter table entries add column nsfw bool not null default 'f';
+alter table feeds add column nsfw bool not null default 'f';
+alter table categories add column nsfw bool not null default 'f';
+alter table users add column nsfw_display text not null default 'blur';
@@ -0,0 +1,4 @@
+alter table users drop column nsfw_display;
+alter table categories drop column nsfw;
+alter table feeds drop column nsfw;
+alter table entries drop column nsfw;
@@ -0,0 +1 @@
+alter table feeds drop column scraper_rules;
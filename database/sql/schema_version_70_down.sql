@@ -0,0 +1 @@
+drop table gemini_certificates;
@@ -0,0 +1,2 @@
+alter table pubsub_outbox add column user_id bigint not null default 0;
+alter table pubsub_outbox add column data jsonb;
@@ -0,0 +1,2 @@
+alter table pubsub_outbox drop column data;
+alter table pubsub_outbox drop column user_id;
@@ -0,0 +1,11 @@
+create table held_notifications (
+    id bigserial not null,
+    user_id bigint not null,
+    kind text not null,
+    data jsonb not null,
+    release_at timestamp with time zone not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+create index held_notifications_release_at_idx on held_notifications(release_at);
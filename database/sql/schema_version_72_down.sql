@@ -0,0 +1 @@
+drop table held_notifications;
@@ -0,0 +1 @@
+alter table user_sessions rename to sessions;
@@ -0,0 +1,206 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ebook // import "miniflux.app/ebook"
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ImageLoader downloads an image referenced by an entry.
+type ImageLoader func(imageURL string) (contentType string, content []byte, err error)
+
+// Book is a collection of entries exported for offline reading.
+type Book struct {
+	Title    string
+	Language string
+	Date     time.Time
+
+	loader   ImageLoader
+	chapters []*chapter
+	images   []*image
+	byURL    map[string]*image
+}
+
+type chapter struct {
+	ID      string
+	Title   string
+	Author  string
+	URL     string
+	Date    time.Time
+	content *html.Node
+}
+
+type image struct {
+	ID          string
+	Filename    string
+	ContentType string
+	content     []byte
+}
+
+// Image formats supported by e-readers.
+var imageExtensions = map[string]string{
+	"image/jpeg":    ".jpg",
+	"image/png":     ".png",
+	"image/gif":     ".gif",
+	"image/svg+xml": ".svg",
+	"image/webp":    ".webp",
+}
+
+// New returns an empty book. The loader is optional, images are removed from the entries without loader.
+func New(title, language string, loader ImageLoader) *Book {
+	return &Book{
+		Title:    title,
+		Language: strings.Replace(language, "_", "-", -1),
+		Date:     time.Now().UTC(),
+		loader:   loader,
+		byURL:    make(map[string]*image),
+	}
+}
+
+// AddEntry appends an entry to the book and downloads its images.
+func (b *Book) AddEntry(entry *model.Entry) error {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(entry.Content), context)
+	if err != nil {
+		return fmt.Errorf("unable to parse the content of the entry #%d: %v", entry.ID, err)
+	}
+
+	content := &html.Node{Type: html.ElementNode, Data: "div"}
+	for _, node := range nodes {
+		content.AppendChild(node)
+	}
+	b.rewriteNode(content)
+
+	title := entry.Title
+	if title == "" {
+		title = entry.URL
+	}
+
+	b.chapters = append(b.chapters, &chapter{
+		ID:      fmt.Sprintf("entry-%d", entry.ID),
+		Title:   title,
+		Author:  entry.Author,
+		URL:     entry.URL,
+		Date:    entry.Date,
+		content: content,
+	})
+
+	return nil
+}
+
+// Len returns the number of entries of the book.
+func (b *Book) Len() int {
+	return len(b.chapters)
+}
+
+// render serializes the content of the chapter, the markup is valid XHTML.
+func (c *chapter) render() (string, error) {
+	var buffer strings.Builder
+	for node := c.content.FirstChild; node != nil; node = node.NextSibling {
+		if err := html.Render(&buffer, node); err != nil {
+			return "", err
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+// rewriteNode replaces remote images with their local copy and removes the elements
+// that cannot be displayed offline.
+func (b *Book) rewriteNode(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+
+		if child.Type == html.ElementNode {
+			switch child.Data {
+			case "iframe", "video", "audio", "object", "embed", "script", "source", "picture":
+				node.RemoveChild(child)
+			case "img":
+				b.rewriteImage(node, child)
+			default:
+				b.rewriteNode(child)
+			}
+		}
+
+		child = next
+	}
+}
+
+func (b *Book) rewriteImage(parent, node *html.Node) {
+	var attributes []html.Attribute
+	var img *image
+	var alt string
+
+	for _, attribute := range node.Attr {
+		switch attribute.Key {
+		case "src":
+			img = b.loadImage(attribute.Val)
+		case "alt":
+			alt = attribute.Val
+			attributes = append(attributes, attribute)
+		case "title", "width", "height":
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	if img == nil {
+		if alt != "" {
+			parent.InsertBefore(&html.Node{Type: html.TextNode, Data: alt}, node)
+		}
+		parent.RemoveChild(node)
+		return
+	}
+
+	node.Attr = append(attributes, html.Attribute{Key: "src", Val: img.Filename})
+}
+
+func (b *Book) loadImage(imageURL string) *image {
+	if img, found := b.byURL[imageURL]; found {
+		return img
+	}
+
+	// Failures are remembered to avoid downloading the same image twice.
+	b.byURL[imageURL] = nil
+	if b.loader == nil || !strings.HasPrefix(imageURL, "http") {
+		return nil
+	}
+
+	contentType, content, err := b.loader(imageURL)
+	if err != nil {
+		logger.Debug("[Ebook] Unable to download %s: %v", imageURL, err)
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil
+	}
+
+	extension, supported := imageExtensions[mediaType]
+	if !supported {
+		return nil
+	}
+
+	id := fmt.Sprintf("image-%d", len(b.images)+1)
+	img := &image{
+		ID:          id,
+		Filename:    "images/" + id + extension,
+		ContentType: mediaType,
+		content:     content,
+	}
+
+	b.images = append(b.images, img)
+	b.byURL[imageURL] = img
+	return img
+}
@@ -0,0 +1,181 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ebook // import "miniflux.app/ebook"
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func fakeLoader(imageURL string) (string, []byte, error) {
+	switch imageURL {
+	case "https://example.org/image.png":
+		return "image/png", []byte("png"), nil
+	case "https://example.org/page.html":
+		return "text/html", []byte("<html></html>"), nil
+	default:
+		return "", nil, errors.New("not found")
+	}
+}
+
+func newTestBook(t *testing.T, content string) *Book {
+	book := New("Starred entries", "fr_FR", fakeLoader)
+	entry := &model.Entry{ID: 1, Title: "Title & more", URL: "https://example.org/", Content: content}
+	if err := book.AddEntry(entry); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	return book
+}
+
+func TestAddEntryWithImages(t *testing.T) {
+	book := newTestBook(t, `<p><img src="https://example.org/image.png" alt="A"><img src="https://example.org/image.png" onerror="x"></p>`)
+
+	if len(book.images) != 1 {
+		t.Fatalf(`The same image should be downloaded only once, got %d images`, len(book.images))
+	}
+
+	content, err := book.chapters[0].render()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<p><img alt="A" src="images/image-1.png"/><img src="images/image-1.png"/></p>`
+	if content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, content, expected)
+	}
+}
+
+func TestAddEntryWithUnavailableImages(t *testing.T) {
+	book := newTestBook(t, `<img src="https://example.org/missing.png" alt="Missing"><img src="https://example.org/page.html"><img src="data:image/png;base64,AA==">`)
+
+	if len(book.images) != 0 {
+		t.Fatalf(`No image should be stored, got %d images`, len(book.images))
+	}
+
+	content, err := book.chapters[0].render()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content != "Missing" {
+		t.Errorf(`Images should be replaced by their alternative text, got %q`, content)
+	}
+}
+
+func TestAddEntryRemovesEmbeddedMedia(t *testing.T) {
+	book := newTestBook(t, `<p>Text</p><iframe src="https://example.org/"></iframe><video src="https://example.org/video.mp4"></video>`)
+
+	content, err := book.chapters[0].render()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if content != "<p>Text</p>" {
+		t.Errorf(`Unexpected content: %q`, content)
+	}
+}
+
+func TestWriteEPUB(t *testing.T) {
+	book := newTestBook(t, `<p>Hello<br><img src="https://example.org/image.png"></p>`)
+
+	var buffer bytes.Buffer
+	if err := book.WriteEPUB(&buffer); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := archive.File[0]
+	if first.Name != "mimetype" || first.Method != zip.Store {
+		t.Fatalf(`The first file must be the uncompressed mimetype, got %q`, first.Name)
+	}
+
+	files := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files[file.Name] = string(content)
+	}
+
+	if files["mimetype"] != "application/epub+zip" {
+		t.Errorf(`Invalid mimetype: %q`, files["mimetype"])
+	}
+
+	for _, name := range []string{"META-INF/container.xml", "OEBPS/content.opf", "OEBPS/nav.xhtml", "OEBPS/toc.ncx", "OEBPS/style.css"} {
+		if _, found := files[name]; !found {
+			t.Errorf(`The file %q is missing`, name)
+		}
+	}
+
+	if files["OEBPS/images/image-1.png"] != "png" {
+		t.Errorf(`The image is missing`)
+	}
+
+	if !strings.Contains(files["OEBPS/content.opf"], `<item id="image-1" href="images/image-1.png" media-type="image/png"/>`) {
+		t.Errorf(`The image is not declared in the package`)
+	}
+
+	if !strings.Contains(files["OEBPS/content.opf"], `<dc:language>fr-FR</dc:language>`) {
+		t.Errorf(`Invalid language`)
+	}
+
+	chapter := files["OEBPS/entry-1.xhtml"]
+	if !strings.Contains(chapter, `<title>Title &amp; more</title>`) {
+		t.Errorf(`The title is not escaped: %s`, chapter)
+	}
+
+	if !strings.Contains(chapter, `<p>Hello<br/><img src="images/image-1.png"/></p>`) {
+		t.Errorf(`The content is not valid XHTML: %s`, chapter)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	book := newTestBook(t, `<p><img src="https://example.org/image.png"></p>`)
+
+	var buffer bytes.Buffer
+	if err := book.WriteHTML(&buffer); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	output := buffer.String()
+	if !strings.Contains(output, `<img src="data:image/png;base64,cG5n"/>`) {
+		t.Errorf(`The image is not embedded: %s`, output)
+	}
+
+	if !strings.Contains(output, `<h1>Title &amp; more</h1>`) {
+		t.Errorf(`The title is not escaped: %s`, output)
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, format := range []string{FormatEPUB, FormatHTML} {
+		if err := ValidateFormat(format); err != nil {
+			t.Errorf(`The format %q should be valid`, format)
+		}
+	}
+
+	if err := ValidateFormat("pdf"); err == nil {
+		t.Error(`An unsupported format should be rejected`)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package ebook bundles entries into an EPUB book or a print-ready HTML document for offline reading.
+
+*/
+package ebook // import "miniflux.app/ebook"
@@ -0,0 +1,219 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ebook // import "miniflux.app/ebook"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	"miniflux.app/crypto"
+)
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+const epubStylesheet = `body { font-family: serif; line-height: 1.4; }
+h1 { font-size: 1.4em; }
+.meta { font-size: 0.8em; color: #555; }
+img { max-width: 100%; height: auto; }
+pre { white-space: pre-wrap; }
+`
+
+var epubTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"xml": func(value string) string {
+		var buffer bytes.Buffer
+		xml.EscapeText(&buffer, []byte(value))
+		return buffer.String()
+	},
+	"inc": func(i int) int {
+		return i + 1
+	},
+}).Parse(`
+{{ define "content.opf" }}<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="{{ xml .Book.Language }}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="book-id">{{ xml .Identifier }}</dc:identifier>
+    <dc:title>{{ xml .Book.Title }}</dc:title>
+    <dc:language>{{ xml .Book.Language }}</dc:language>
+    <dc:creator>Miniflux</dc:creator>
+    <meta property="dcterms:modified">{{ .Book.Date.Format "2006-01-02T15:04:05Z" }}</meta>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+    {{- range .Chapters }}
+    <item id="{{ .ID }}" href="{{ .ID }}.xhtml" media-type="application/xhtml+xml"/>
+    {{- end }}
+    {{- range .Images }}
+    <item id="{{ .ID }}" href="{{ .Filename }}" media-type="{{ .ContentType }}"/>
+    {{- end }}
+  </manifest>
+  <spine toc="ncx">
+    {{- range .Chapters }}
+    <itemref idref="{{ .ID }}"/>
+    {{- end }}
+  </spine>
+</package>
+{{ end }}
+
+{{ define "toc.ncx" }}<?xml version="1.0" encoding="UTF-8"?>
+<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">
+  <head>
+    <meta name="dtb:uid" content="{{ xml .Identifier }}"/>
+  </head>
+  <docTitle><text>{{ xml .Book.Title }}</text></docTitle>
+  <navMap>
+    {{- range $index, $chapter := .Chapters }}
+    <navPoint id="nav-{{ $chapter.ID }}" playOrder="{{ inc $index }}">
+      <navLabel><text>{{ xml $chapter.Title }}</text></navLabel>
+      <content src="{{ $chapter.ID }}.xhtml"/>
+    </navPoint>
+    {{- end }}
+  </navMap>
+</ncx>
+{{ end }}
+
+{{ define "nav.xhtml" }}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ xml .Book.Language }}" lang="{{ xml .Book.Language }}">
+<head>
+  <meta charset="UTF-8"/>
+  <title>{{ xml .Book.Title }}</title>
+</head>
+<body>
+  <nav epub:type="toc" id="toc">
+    <h1>{{ xml .Book.Title }}</h1>
+    <ol>
+      {{- range .Chapters }}
+      <li><a href="{{ .ID }}.xhtml">{{ xml .Title }}</a></li>
+      {{- end }}
+    </ol>
+  </nav>
+</body>
+</html>
+{{ end }}
+
+{{ define "chapter.xhtml" }}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{ xml .Language }}" lang="{{ xml .Language }}">
+<head>
+  <meta charset="UTF-8"/>
+  <title>{{ xml .Chapter.Title }}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <h1>{{ xml .Chapter.Title }}</h1>
+  <p class="meta">{{ if .Chapter.Author }}{{ xml .Chapter.Author }} - {{ end }}{{ .Chapter.Date.Format "2006-01-02 15:04" }} - <a href="{{ xml .Chapter.URL }}">{{ xml .Chapter.URL }}</a></p>
+  {{ .Content }}
+</body>
+</html>
+{{ end }}
+`))
+
+// WriteEPUB writes the book in the EPUB 3 format.
+func (b *Book) WriteEPUB(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	// The mimetype file must be the first file of the archive, without compression.
+	header := &zip.FileHeader{Name: "mimetype", Method: zip.Store}
+	file, err := archive.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(file, "application/epub+zip"); err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"Book":       b,
+		"Chapters":   b.chapters,
+		"Images":     b.images,
+		"Identifier": b.identifier(),
+	}
+
+	files := map[string]string{
+		"META-INF/container.xml": epubContainer,
+		"OEBPS/style.css":        epubStylesheet,
+	}
+
+	for _, name := range []string{"content.opf", "toc.ncx", "nav.xhtml"} {
+		var buffer bytes.Buffer
+		if err := epubTemplates.ExecuteTemplate(&buffer, name, data); err != nil {
+			return fmt.Errorf("unable to generate %s: %v", name, err)
+		}
+		files["OEBPS/"+name] = buffer.String()
+	}
+
+	for _, chapter := range b.chapters {
+		content, err := chapter.render()
+		if err != nil {
+			return fmt.Errorf("unable to render the entry %s: %v", chapter.ID, err)
+		}
+
+		var buffer bytes.Buffer
+		err = epubTemplates.ExecuteTemplate(&buffer, "chapter.xhtml", map[string]interface{}{
+			"Language": b.Language,
+			"Chapter":  chapter,
+			"Content":  content,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to generate the entry %s: %v", chapter.ID, err)
+		}
+		files["OEBPS/"+chapter.ID+".xhtml"] = buffer.String()
+	}
+
+	// Sorted to get a reproducible archive.
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		file, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(file, files[name]); err != nil {
+			return err
+		}
+	}
+
+	for _, image := range b.images {
+		file, err := archive.Create("OEBPS/" + image.Filename)
+		if err != nil {
+			return err
+		}
+
+		if _, err := file.Write(image.content); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func (b *Book) identifier() string {
+	var ids []string
+	for _, chapter := range b.chapters {
+		ids = append(ids, chapter.ID)
+	}
+
+	return "urn:miniflux:" + crypto.Hash(b.Title+b.Date.String()+strings.Join(ids, ","))
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ebook // import "miniflux.app/ebook"
+
+import (
+	"fmt"
+	"io"
+)
+
+// Export formats.
+const (
+	FormatEPUB = "epub"
+	FormatHTML = "html"
+)
+
+// ValidateFormat returns an error if the export format is not supported.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatEPUB, FormatHTML:
+		return nil
+	default:
+		return fmt.Errorf(`Invalid export format, valid values are: "%s" or "%s"`, FormatEPUB, FormatHTML)
+	}
+}
+
+// ContentType returns the MIME type of an export format.
+func ContentType(format string) string {
+	if format == FormatHTML {
+		return "text/html; charset=utf-8"
+	}
+
+	return "application/epub+zip"
+}
+
+// Filename returns the name of the exported file.
+func (b *Book) Filename(format string) string {
+	return fmt.Sprintf("miniflux-%s.%s", b.Date.Format("2006-01-02"), format)
+}
+
+// Write writes the book in the given format.
+func (b *Book) Write(w io.Writer, format string) error {
+	if format == FormatHTML {
+		return b.WriteHTML(w)
+	}
+
+	return b.WriteEPUB(w)
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ebook // import "miniflux.app/ebook"
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+var printTemplate = template.Must(template.New("print").Parse(`<!DOCTYPE html>
+<html lang="{{ .Language }}">
+<head>
+  <meta charset="utf-8">
+  <title>{{ .Title }}</title>
+  <style>
+    body { font-family: serif; line-height: 1.4; max-width: 45em; margin: 0 auto; padding: 1em; }
+    article + article { page-break-before: always; break-before: page; }
+    h1 { font-size: 1.4em; }
+    .meta { font-size: 0.8em; color: #555; }
+    img { max-width: 100%; height: auto; page-break-inside: avoid; }
+    pre { white-space: pre-wrap; }
+    @page { margin: 2cm; }
+  </style>
+</head>
+<body>
+{{- range .Chapters }}
+  <article id="{{ .ID }}">
+    <h1>{{ .Title }}</h1>
+    <p class="meta">{{ if .Author }}{{ .Author }} - {{ end }}{{ .Date.Format "2006-01-02 15:04" }} - <a href="{{ .URL }}">{{ .URL }}</a></p>
+    {{ .Content }}
+  </article>
+{{- end }}
+</body>
+</html>
+`))
+
+type printChapter struct {
+	*chapter
+	Content template.HTML
+}
+
+// WriteHTML writes the book as a single print-ready HTML document, images are embedded as data URLs.
+func (b *Book) WriteHTML(w io.Writer) error {
+	var replacements []string
+	for _, image := range b.images {
+		replacements = append(
+			replacements,
+			fmt.Sprintf(`src="%s"`, image.Filename),
+			fmt.Sprintf(`src="data:%s;base64,%s"`, image.ContentType, base64.StdEncoding.EncodeToString(image.content)),
+		)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	var chapters []*printChapter
+	for _, chapter := range b.chapters {
+		content, err := chapter.render()
+		if err != nil {
+			return fmt.Errorf("unable to render the entry %s: %v", chapter.ID, err)
+		}
+
+		chapters = append(chapters, &printChapter{chapter: chapter, Content: template.HTML(replacer.Replace(content))})
+	}
+
+	return printTemplate.Execute(w, map[string]interface{}{
+		"Title":    b.Title,
+		"Language": b.Language,
+		"Chapters": chapters,
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package embedding // import "miniflux.app/embedding"
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+const (
+	// The beginning of the article is enough to describe its subject and keeps the requests cheap.
+	maxInputLength = 4000
+
+	// Number of texts sent in a single request.
+	maxBatchSize = 16
+)
+
+// Embedder computes a vector for each text, close meanings give close vectors.
+// The vectors of different models cannot be compared.
+type Embedder interface {
+	Model() string
+	Embed(texts []string) ([][]float32, error)
+}
+
+// New returns the embedder configured with EMBEDDING_URL, or nil when the semantic search is disabled.
+func New(cfg *config.Config) Embedder {
+	if !cfg.HasEmbeddings() {
+		return nil
+	}
+
+	return NewOpenAI(cfg.EmbeddingURL(), cfg.EmbeddingAPIKey(), cfg.EmbeddingModel())
+}
+
+// EmbedEntries returns the vectors of the entries in the same order, the texts are sent in small batches.
+func EmbedEntries(e Embedder, entries model.Entries) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(entries))
+	for start := 0; start < len(entries); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		texts := make([]string, 0, end-start)
+		for _, entry := range entries[start:end] {
+			texts = append(texts, entryText(entry))
+		}
+
+		batch, err := e.Embed(texts)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(batch) != len(texts) {
+			return nil, errors.New("embedding: the number of vectors does not match the number of texts")
+		}
+
+		vectors = append(vectors, batch...)
+	}
+
+	return vectors, nil
+}
+
+// entryText returns the title and the beginning of the text of an entry.
+func entryText(entry *model.Entry) string {
+	text := entry.Title + "\n\n" + strings.Join(strings.Fields(sanitizer.StripTags(entry.Content)), " ")
+	if strings.TrimSpace(text) == "" {
+		return entry.URL
+	}
+
+	runes := []rune(text)
+	if len(runes) > maxInputLength {
+		return string(runes[:maxInputLength])
+	}
+
+	return text
+}
+
+// Similarity returns the cosine similarity of two vectors, between -1 and 1.
+func Similarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / math.Sqrt(normA*normB)
+}
+
+// Encode returns the binary representation of a vector stored in the database.
+func Encode(vector []float32) []byte {
+	data := make([]byte, 4*len(vector))
+	for i, value := range vector {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(value))
+	}
+
+	return data
+}
+
+// Decode returns the vector of a binary representation returned by Encode.
+func Decode(data []byte) []float32 {
+	vector := make([]float32, len(data)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+
+	return vector
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package embedding // import "miniflux.app/embedding"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+type fakeEmbedder struct {
+	requests [][]string
+}
+
+func (f *fakeEmbedder) Model() string {
+	return "fake"
+}
+
+func (f *fakeEmbedder) Embed(texts []string) ([][]float32, error) {
+	f.requests = append(f.requests, texts)
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float32{float32(len(text))}
+	}
+	return vectors, nil
+}
+
+func TestEmbedEntriesInBatches(t *testing.T) {
+	var entries model.Entries
+	for i := 0; i < maxBatchSize+2; i++ {
+		entries = append(entries, &model.Entry{Title: strings.Repeat("a", i+1)})
+	}
+
+	e := &fakeEmbedder{}
+	vectors, err := EmbedEntries(e, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(e.requests) != 2 || len(e.requests[0]) != maxBatchSize || len(e.requests[1]) != 2 {
+		t.Fatalf(`Unexpected batches, got %d requests`, len(e.requests))
+	}
+
+	for i, vector := range vectors {
+		if vector[0] != float32(len(entryText(entries[i]))) {
+			t.Errorf(`The vector #%d does not match its entry`, i)
+		}
+	}
+}
+
+func TestEntryText(t *testing.T) {
+	entry := &model.Entry{Title: "Vacuum tuning", Content: "<p>Tune the\n<em>autovacuum</em>.</p>"}
+	if text := entryText(entry); text != "Vacuum tuning\n\nTune the autovacuum." {
+		t.Errorf(`Unexpected text, got %q`, text)
+	}
+
+	entry = &model.Entry{URL: "https://example.org/article"}
+	if text := entryText(entry); text != entry.URL {
+		t.Errorf(`The URL should be used without title and content, got %q`, text)
+	}
+
+	entry = &model.Entry{Content: strings.Repeat("é", maxInputLength+10)}
+	if text := entryText(entry); len([]rune(text)) != maxInputLength {
+		t.Errorf(`The text should be truncated to %d characters, got %d`, maxInputLength, len([]rune(text)))
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	scenarios := []struct {
+		a, b     []float32
+		expected float64
+	}{
+		{[]float32{1, 0}, []float32{2, 0}, 1},
+		{[]float32{1, 0}, []float32{0, 1}, 0},
+		{[]float32{1, 0}, []float32{-1, 0}, -1},
+		{[]float32{1, 0}, []float32{1, 0, 0}, 0},
+		{[]float32{0, 0}, []float32{1, 0}, 0},
+	}
+
+	for _, scenario := range scenarios {
+		if result := Similarity(scenario.a, scenario.b); math.Abs(result-scenario.expected) > 1e-9 {
+			t.Errorf(`Unexpected similarity of %v and %v, got %f`, scenario.a, scenario.b, result)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	vector := []float32{0.5, -1.25, 3e-8, 0}
+	result := Decode(Encode(vector))
+
+	if fmt.Sprint(result) != fmt.Sprint(vector) {
+		t.Errorf(`Unexpected vector, got %v instead of %v`, result, vector)
+	}
+}
+
+func TestOpenAI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" || r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		var request embeddingRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Model != "nomic-embed-text" || len(request.Input) != 2 {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"index": 1, "embedding": [0, 1]}, {"index": 0, "embedding": [1, 0]}]}`))
+	}))
+	defer server.Close()
+
+	e := NewOpenAI(server.URL+"/v1/", "secret", "nomic-embed-text")
+	vectors, err := e.Embed([]string{"first", "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vectors) != 2 || vectors[0][0] != 1 || vectors[1][1] != 1 {
+		t.Errorf(`Unexpected vectors, got %v`, vectors)
+	}
+
+	if _, err := NewOpenAI(server.URL+"/v1", "wrong", "nomic-embed-text").Embed([]string{"first", "second"}); err == nil {
+		t.Error(`An error should be returned when the request is rejected`)
+	}
+}
+
+func TestOpenAIWithMissingVector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [{"index": 0, "embedding": [1, 0]}]}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewOpenAI(server.URL, "", "nomic-embed-text").Embed([]string{"first", "second"}); err == nil {
+		t.Fatal(`A missing vector should return an error`)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package embedding // import "miniflux.app/embedding"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+type embeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// OpenAI computes the vectors with the embeddings API of OpenAI,
+// it's also implemented by local servers like Ollama or llama.cpp.
+type OpenAI struct {
+	endpoint string
+	apiKey   string
+	model    string
+}
+
+// Model returns the name of the model computing the vectors.
+func (o *OpenAI) Model() string {
+	return o.model
+}
+
+// Embed returns the vectors of the texts in the same order.
+func (o *OpenAI) Embed(texts []string) ([][]float32, error) {
+	clt := client.New(o.endpoint + "/embeddings")
+	if o.apiKey != "" {
+		clt.WithAuthorization("Bearer " + o.apiKey)
+	}
+
+	response, err := clt.PostJSON(&embeddingRequest{Model: o.model, Input: texts})
+	if err != nil {
+		return nil, fmt.Errorf("embedding: unable to compute vectors: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return nil, fmt.Errorf("embedding: unable to compute vectors, status=%d", response.StatusCode)
+	}
+
+	var result embeddingResponse
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("embedding: unable to decode response: %v", err)
+	}
+
+	// The vectors are not guaranteed to be sorted, each one refers to the position of its text.
+	vectors := make([][]float32, len(texts))
+	for _, data := range result.Data {
+		if data.Index < 0 || data.Index >= len(texts) || len(data.Embedding) == 0 {
+			return nil, fmt.Errorf("embedding: invalid vector at index %d", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+
+	for i, vector := range vectors {
+		if vector == nil {
+			return nil, fmt.Errorf("embedding: missing vector at index %d", i)
+		}
+	}
+
+	return vectors, nil
+}
+
+// NewOpenAI returns an embedder using the embeddings API available at the given base URL.
+func NewOpenAI(baseURL, apiKey, model string) *OpenAI {
+	return &OpenAI{endpoint: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, model: model}
+}
@@ -0,0 +1,8 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+Package encryption encrypts the titles and the contents of entries at rest with keys derived for each user from a master key.
+*/
+package encryption // import "miniflux.app/encryption"
@@ -0,0 +1,136 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package encryption // import "miniflux.app/encryption"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// MasterKeySize is the size of the master key in bytes.
+const MasterKeySize = 32
+
+// encryptedPrefix marks the encrypted values, the other values have been stored before the encryption was enabled.
+const encryptedPrefix = "encrypted:v1:"
+
+// Keyring encrypts values with a key derived for each user from the master key.
+//
+// The encryption is deterministic: a value encrypted twice for the same user gives the same result,
+// which lets the database compare encrypted values. The values of different users never match.
+type Keyring struct {
+	master []byte
+
+	mu      sync.Mutex
+	ciphers map[int64]*userCipher
+}
+
+type userCipher struct {
+	aead     cipher.AEAD
+	nonceKey []byte
+}
+
+// NewKeyring returns a keyring deriving the keys of users from the given master key.
+func NewKeyring(master []byte) (*Keyring, error) {
+	if len(master) != MasterKeySize {
+		return nil, fmt.Errorf("encryption: the master key must be %d bytes long, got %d bytes", MasterKeySize, len(master))
+	}
+
+	return &Keyring{master: master, ciphers: make(map[int64]*userCipher)}, nil
+}
+
+// Encrypt encrypts a value for the given user, empty values are not encrypted.
+func (k *Keyring) Encrypt(userID int64, value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	c, err := k.cipher(userID)
+	if err != nil {
+		return "", err
+	}
+
+	// The nonce is derived from the value, like a synthetic IV.
+	mac := hmac.New(sha256.New, c.nonceKey)
+	mac.Write([]byte(value))
+	nonce := mac.Sum(nil)[:c.aead.NonceSize()]
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(value), additionalData(userID))
+	return encryptedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted for the given user, the values that are not encrypted are returned as is.
+func (k *Keyring) Decrypt(userID int64, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	c, err := k.cipher(userID)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil || len(data) < c.aead.NonceSize() {
+		return "", fmt.Errorf("encryption: malformed value for user #%d", userID)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], additionalData(userID))
+	if err != nil {
+		return "", fmt.Errorf("encryption: unable to decrypt a value of user #%d: %v", userID, err)
+	}
+
+	return string(plaintext), nil
+}
+
+// IsEncrypted returns true if the value has been encrypted by a keyring.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+func (k *Keyring) cipher(userID int64) (*userCipher, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if c, found := k.ciphers[userID]; found {
+		return c, nil
+	}
+
+	// The first half of the derived key encrypts the values, the second half derives the nonces.
+	key := make([]byte, 64)
+	reader := hkdf.New(sha256.New, k.master, nil, []byte("miniflux entry key "+strconv.FormatInt(userID, 10)))
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, fmt.Errorf("encryption: unable to derive the key of user #%d: %v", userID, err)
+	}
+
+	block, err := aes.NewCipher(key[:32])
+	if err != nil {
+		return nil, fmt.Errorf("encryption: unable to create the cipher of user #%d: %v", userID, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: unable to create the cipher of user #%d: %v", userID, err)
+	}
+
+	c := &userCipher{aead: aead, nonceKey: key[32:]}
+	k.ciphers[userID] = c
+	return c, nil
+}
+
+// additionalData binds the encrypted values to their user.
+func additionalData(userID int64) []byte {
+	return []byte(strconv.FormatInt(userID, 10))
+}
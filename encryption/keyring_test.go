@@ -0,0 +1,101 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package encryption // import "miniflux.app/encryption"
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newTestKeyring(t *testing.T, b byte) *Keyring {
+	keyring, err := NewKeyring(bytes.Repeat([]byte{b}, MasterKeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keyring
+}
+
+func TestNewKeyringWithInvalidKey(t *testing.T) {
+	if _, err := NewKeyring([]byte("short")); err == nil {
+		t.Fatal(`A short master key should be rejected`)
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	keyring := newTestKeyring(t, 1)
+
+	encrypted, err := keyring.Encrypt(1, "Some content")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !IsEncrypted(encrypted) {
+		t.Fatalf(`The value should be encrypted, got %q`, encrypted)
+	}
+
+	decrypted, err := keyring.Decrypt(1, encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decrypted != "Some content" {
+		t.Fatalf(`Unexpected value, got %q`, decrypted)
+	}
+}
+
+func TestEncryptIsDeterministic(t *testing.T) {
+	keyring := newTestKeyring(t, 1)
+
+	first, _ := keyring.Encrypt(1, "Some content")
+	second, _ := keyring.Encrypt(1, "Some content")
+	if first != second {
+		t.Fatal(`The same value should be encrypted the same way for the same user`)
+	}
+
+	other, _ := keyring.Encrypt(2, "Some content")
+	if first == other {
+		t.Fatal(`The same value should be encrypted differently for another user`)
+	}
+}
+
+func TestEncryptEmptyValue(t *testing.T) {
+	keyring := newTestKeyring(t, 1)
+
+	encrypted, err := keyring.Encrypt(1, "")
+	if err != nil || encrypted != "" {
+		t.Fatalf(`Empty values should not be encrypted, got %q`, encrypted)
+	}
+}
+
+func TestDecryptPlainValue(t *testing.T) {
+	keyring := newTestKeyring(t, 1)
+
+	decrypted, err := keyring.Decrypt(1, "Legacy content")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decrypted != "Legacy content" {
+		t.Fatalf(`Values stored before the encryption should be returned as is, got %q`, decrypted)
+	}
+}
+
+func TestDecryptWithWrongKey(t *testing.T) {
+	encrypted, _ := newTestKeyring(t, 1).Encrypt(1, "Some content")
+
+	if _, err := newTestKeyring(t, 2).Decrypt(1, encrypted); err == nil {
+		t.Fatal(`A value should not be decrypted with another master key`)
+	}
+
+	if _, err := newTestKeyring(t, 1).Decrypt(2, encrypted); err == nil {
+		t.Fatal(`A value should not be decrypted for another user`)
+	}
+}
+
+func TestDecryptMalformedValue(t *testing.T) {
+	if _, err := newTestKeyring(t, 1).Decrypt(1, encryptedPrefix+"!!!"); err == nil {
+		t.Fatal(`A malformed value should be rejected`)
+	}
+}
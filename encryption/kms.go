@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package encryption // import "miniflux.app/encryption"
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+
+	"miniflux.app/config"
+)
+
+// New returns the keyring configured by ENTRY_ENCRYPTION_KEY, or nil if the encryption is disabled.
+// When ENTRY_ENCRYPTION_KMS_KEY is set, the master key is decrypted with Cloud KMS.
+func New(ctx context.Context, cfg *config.Config) (*Keyring, error) {
+	if !cfg.HasEntryEncryption() {
+		return nil, nil
+	}
+
+	master, err := base64.StdEncoding.DecodeString(cfg.EntryEncryptionKey())
+	if err != nil {
+		return nil, fmt.Errorf("encryption: invalid master key: %v", err)
+	}
+
+	if name := cfg.EntryEncryptionKMSKey(); name != "" {
+		master, err = decryptWithKMS(ctx, name, master)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewKeyring(master)
+}
+
+// decryptWithKMS decrypts the master key with Cloud KMS.
+func decryptWithKMS(ctx context.Context, name string, ciphertext []byte) ([]byte, error) {
+	client, err := kms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: unable to create KMS client: %v", err)
+	}
+	defer client.Close()
+
+	response, err := client.Decrypt(ctx, &kmspb.DecryptRequest{Name: name, Ciphertext: ciphertext})
+	if err != nil {
+		return nil, fmt.Errorf("encryption: unable to decrypt the master key with %q: %v", name, err)
+	}
+
+	return response.Plaintext, nil
+}
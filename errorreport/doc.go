@@ -0,0 +1,8 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+Package errorreport sends the panics recovered while serving requests to Sentry or Google Error Reporting.
+*/
+package errorreport // import "miniflux.app/errorreport"
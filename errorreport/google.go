@@ -0,0 +1,44 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package errorreport // import "miniflux.app/errorreport"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/errorreporting"
+
+	"miniflux.app/logger"
+	"miniflux.app/version"
+)
+
+type googleReporter struct {
+	client *errorreporting.Client
+}
+
+func newGoogleReporter(projectID string) (*googleReporter, error) {
+	client, err := errorreporting.NewClient(context.Background(), projectID, errorreporting.Config{
+		ServiceName:    "miniflux",
+		ServiceVersion: version.Version,
+		OnError: func(err error) {
+			logger.Error("[ErrorReport] Unable to send the report to Google Error Reporting: %v", err)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("errorreport: unable to create the Google Error Reporting client: %v", err)
+	}
+
+	return &googleReporter{client: client}, nil
+}
+
+// Report sends the report in the background, the panic message and the stack trace are parsed by Error Reporting.
+func (g *googleReporter) Report(report *Report) {
+	g.client.Report(errorreporting.Entry{
+		Error: errors.New(report.Message + " [correlation ID: " + report.CorrelationID + "]"),
+		Req:   report.Request,
+		Stack: report.Stack,
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package errorreport // import "miniflux.app/errorreport"
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+)
+
+// Report describes a panic recovered while serving a request.
+type Report struct {
+	CorrelationID string
+	Message       string
+	Request       *http.Request
+	Time          time.Time
+
+	// Stack is formatted like the output of runtime/debug.Stack.
+	Stack []byte
+
+	// Frames are the calls leading to the panic, innermost first.
+	Frames []Frame
+}
+
+// Frame is a function call of the stack trace.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// NewReport captures the stack trace of a panic, it must be called by the deferred function recovering the panic.
+func NewReport(r *http.Request, correlationID string, value interface{}) *Report {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+
+	var frames []Frame
+	callers := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := callers.Next()
+
+		// The calls above runtime.gopanic belong to the deferred function recovering the panic.
+		if frame.Function == "runtime.gopanic" {
+			frames = nil
+		} else if !strings.HasPrefix(frame.Function, "runtime.") {
+			frames = append(frames, Frame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return &Report{
+		CorrelationID: correlationID,
+		Message:       fmt.Sprintf("panic: %v", value),
+		Request:       r,
+		Time:          time.Now(),
+		Stack:         debug.Stack(),
+		Frames:        frames,
+	}
+}
+
+// Reporter sends reports to an error tracking service.
+type Reporter interface {
+	Report(report *Report)
+}
+
+type reporters []Reporter
+
+func (list reporters) Report(report *Report) {
+	for _, reporter := range list {
+		reporter.Report(report)
+	}
+}
+
+// New returns the reporters enabled by the configuration, reports are only logged when none is enabled.
+func New(cfg *config.Config) (Reporter, error) {
+	var list reporters
+
+	if dsn := cfg.SentryDSN(); dsn != "" {
+		reporter, err := newSentryReporter(dsn)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("[ErrorReport] Sending panics to Sentry")
+		list = append(list, reporter)
+	}
+
+	if cfg.HasGcpErrorReporting() {
+		reporter, err := newGoogleReporter(cfg.GcpProjectID())
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("[ErrorReport] Sending panics to Google Error Reporting")
+		list = append(list, reporter)
+	}
+
+	return list, nil
+}
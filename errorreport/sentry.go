@@ -0,0 +1,186 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package errorreport // import "miniflux.app/errorreport"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/version"
+)
+
+const sentryTimeout = 10 * time.Second
+
+// Headers never sent to Sentry because they contain credentials.
+var sentryFilteredHeaders = map[string]bool{
+	"Authorization":       true,
+	"Cookie":              true,
+	"X-Auth-Token":        true,
+	"X-Csrf-Token":        true,
+	"Proxy-Authorization": true,
+}
+
+type sentryReporter struct {
+	endpoint  string
+	publicKey string
+	client    *http.Client
+}
+
+func newSentryReporter(dsn string) (*sentryReporter, error) {
+	endpoint, publicKey, err := parseSentryDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sentryReporter{
+		endpoint:  endpoint,
+		publicKey: publicKey,
+		client:    &http.Client{Timeout: sentryTimeout},
+	}, nil
+}
+
+// parseSentryDSN returns the store endpoint and the public key of a DSN like "https://key@sentry.io/42".
+func parseSentryDSN(dsn string) (endpoint, publicKey string, err error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", fmt.Errorf("errorreport: invalid Sentry DSN: %v", err)
+	}
+
+	if u.User == nil || u.User.Username() == "" {
+		return "", "", fmt.Errorf("errorreport: the Sentry DSN has no public key")
+	}
+
+	path := strings.Trim(u.Path, "/")
+	if path == "" {
+		return "", "", fmt.Errorf("errorreport: the Sentry DSN has no project ID")
+	}
+
+	prefix, projectID := "", path
+	if i := strings.LastIndex(path, "/"); i != -1 {
+		prefix, projectID = "/"+path[:i], path[i+1:]
+	}
+
+	endpoint = fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, prefix, projectID)
+	return endpoint, u.User.Username(), nil
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+}
+
+type sentryException struct {
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	Stacktrace struct {
+		Frames []sentryFrame `json:"frames"`
+	} `json:"stacktrace"`
+}
+
+type sentryRequest struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+}
+
+type sentryEvent struct {
+	EventID    string            `json:"event_id"`
+	Timestamp  string            `json:"timestamp"`
+	Level      string            `json:"level"`
+	Platform   string            `json:"platform"`
+	Release    string            `json:"release"`
+	ServerName string            `json:"server_name,omitempty"`
+	Message    string            `json:"message"`
+	Exception  []sentryException `json:"exception"`
+	Request    *sentryRequest    `json:"request,omitempty"`
+	Tags       map[string]string `json:"tags"`
+}
+
+func newSentryEvent(report *Report) *sentryEvent {
+	exception := sentryException{Type: "panic", Value: report.Message}
+
+	// Sentry expects the frames ordered from the oldest call to the most recent one.
+	for i := len(report.Frames) - 1; i >= 0; i-- {
+		frame := report.Frames[i]
+		exception.Stacktrace.Frames = append(exception.Stacktrace.Frames, sentryFrame{
+			Function: frame.Function,
+			Filename: frame.File,
+			Lineno:   frame.Line,
+		})
+	}
+
+	serverName, _ := os.Hostname()
+
+	event := &sentryEvent{
+		EventID:    fmt.Sprintf("%x", crypto.GenerateRandomBytes(16)),
+		Timestamp:  report.Time.UTC().Format("2006-01-02T15:04:05"),
+		Level:      "error",
+		Platform:   "go",
+		Release:    version.Version,
+		ServerName: serverName,
+		Message:    report.Message,
+		Exception:  []sentryException{exception},
+		Tags:       map[string]string{"correlation_id": report.CorrelationID},
+	}
+
+	if r := report.Request; r != nil {
+		request := &sentryRequest{URL: r.URL.String(), Method: r.Method, Headers: make(map[string]string)}
+		for name := range r.Header {
+			if !sentryFilteredHeaders[http.CanonicalHeaderKey(name)] {
+				request.Headers[name] = r.Header.Get(name)
+			}
+		}
+		event.Request = request
+	}
+
+	return event
+}
+
+// Report sends the report in the background to avoid delaying the response.
+func (s *sentryReporter) Report(report *Report) {
+	event := newSentryEvent(report)
+	go s.send(event)
+}
+
+func (s *sentryReporter) send(event *sentryEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("[ErrorReport] Unable to encode the Sentry event: %v", err)
+		return
+	}
+
+	request, err := http.NewRequest(http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		logger.Error("[ErrorReport] Unable to create the Sentry request: %v", err)
+		return
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Sentry-Auth", fmt.Sprintf(
+		"Sentry sentry_version=7, sentry_key=%s, sentry_client=miniflux/%s",
+		s.publicKey,
+		version.Version,
+	))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		logger.Error("[ErrorReport] Unable to send the event to Sentry: %v", err)
+		return
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		logger.Error("[ErrorReport] Sentry rejected the event %s: status %d", event.EventID, response.StatusCode)
+	}
+}
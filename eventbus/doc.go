@@ -0,0 +1,13 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package eventbus publishes the sync events of categories, feeds, entries and users.
+
+The backend is selected with EVENT_BUS_BACKEND: GCP Pub/Sub, a NATS server,
+a Kafka REST proxy, or none. The payload is the same with every backend.
+
+*/
+package eventbus // import "miniflux.app/eventbus"
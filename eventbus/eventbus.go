@@ -0,0 +1,143 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package eventbus // import "miniflux.app/eventbus"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"miniflux.app/alert"
+	"miniflux.app/config"
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/logger"
+	"miniflux.app/timer"
+)
+
+// ErrDisabled is returned when an event is published without backend.
+var ErrDisabled = errors.New("eventbus: no backend configured")
+
+// Publisher sends the sync events to a message broker.
+type Publisher interface {
+	// SetAlertMonitor sets the monitor notified of publishing errors.
+	SetAlertMonitor(monitor *alert.Monitor)
+
+	// AddSink adds a destination receiving the events in addition to the broker.
+	AddSink(sink gcppubsub.Sink)
+
+	// SetOutbox sets the outbox recording the events that could not be published.
+	SetOutbox(outbox gcppubsub.Outbox)
+
+	// PublishEvent sends an event to the sinks and to the broker, failed events are recorded in the outbox.
+	PublishEvent(event gcppubsub.SyncEvent)
+
+	// Publish sends an event to the broker and waits for the result, the sinks and the outbox are not involved.
+	Publish(event gcppubsub.SyncEvent) error
+}
+
+// New returns the publisher of the backend configured with EVENT_BUS_BACKEND.
+func New(cfg *config.Config) (Publisher, error) {
+	switch backend := cfg.EventBusBackend(); backend {
+	case "gcppubsub":
+		return gcppubsub.NewPublisher(cfg), nil
+	case "nats":
+		sender, err := newNATSSender(cfg.EventBusURL(), cfg.EventBusTopic())
+		if err != nil {
+			return nil, err
+		}
+		return &publisher{name: backend, sender: sender}, nil
+	case "kafka":
+		sender, err := newKafkaSender(cfg.EventBusURL(), cfg.EventBusTopic())
+		if err != nil {
+			return nil, err
+		}
+		return &publisher{name: backend, sender: sender}, nil
+	case "none":
+		return NewNoopPublisher(), nil
+	default:
+		return nil, fmt.Errorf("eventbus: unsupported backend %q", backend)
+	}
+}
+
+// NewNoopPublisher returns a publisher dropping the events, they are only sent to the sinks.
+func NewNoopPublisher() Publisher {
+	return &publisher{name: "none"}
+}
+
+// IsEnabled returns true if the events are sent to a broker.
+func IsEnabled(p Publisher) bool {
+	if p, ok := p.(*publisher); ok {
+		return p.sender != nil
+	}
+	return p != nil
+}
+
+// sender delivers the JSON payload of an event to a broker.
+type sender interface {
+	send(event gcppubsub.SyncEvent, payload []byte) error
+}
+
+// publisher sends the events to the sinks, the outbox and the alert monitor like the Pub/Sub publisher.
+type publisher struct {
+	name    string
+	sender  sender
+	monitor *alert.Monitor
+	sinks   []gcppubsub.Sink
+	outbox  gcppubsub.Outbox
+}
+
+func (p *publisher) SetAlertMonitor(monitor *alert.Monitor) {
+	p.monitor = monitor
+}
+
+func (p *publisher) AddSink(sink gcppubsub.Sink) {
+	p.sinks = append(p.sinks, sink)
+}
+
+func (p *publisher) SetOutbox(outbox gcppubsub.Outbox) {
+	p.outbox = outbox
+}
+
+func (p *publisher) PublishEvent(event gcppubsub.SyncEvent) {
+	for _, sink := range p.sinks {
+		sink.Record(event)
+	}
+
+	if p.sender == nil {
+		return
+	}
+
+	if err := p.Publish(event); err != nil {
+		logger.Error("[EventBus] %v", err)
+		if p.outbox != nil {
+			if err := p.outbox.RecordFailedEvent(context.Background(), event, err.Error()); err != nil {
+				logger.Error("[EventBus] Unable to record the failed event %v: %v", event, err)
+			}
+		}
+	}
+}
+
+func (p *publisher) Publish(event gcppubsub.SyncEvent) error {
+	if p.sender == nil {
+		return ErrDisabled
+	}
+
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EventBus] Publishing %v to %s", event, p.name))
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("eventbus: unable to marshal %v to JSON: %v", event, err)
+	}
+
+	if err := p.sender.send(event, payload); err != nil {
+		p.monitor.Failure(alert.ComponentPublisher, err)
+		return fmt.Errorf("eventbus: unable to publish to %s: %v", p.name, err)
+	}
+
+	p.monitor.Success(alert.ComponentPublisher)
+	return nil
+}
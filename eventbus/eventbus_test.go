@@ -0,0 +1,90 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package eventbus // import "miniflux.app/eventbus"
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/integration/gcppubsub"
+)
+
+type recorder struct {
+	events []gcppubsub.SyncEvent
+	failed []string
+}
+
+func (r *recorder) Record(event gcppubsub.SyncEvent) {
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) RecordFailedEvent(ctx context.Context, event gcppubsub.SyncEvent, reason string) error {
+	r.failed = append(r.failed, reason)
+	return nil
+}
+
+type failingSender struct{}
+
+func (failingSender) send(event gcppubsub.SyncEvent, payload []byte) error {
+	return errors.New("broker unavailable")
+}
+
+func TestNewWithUnsupportedBackend(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("EVENT_BUS_BACKEND", "rabbitmq")
+
+	if _, err := New(config.NewConfig()); err == nil {
+		t.Fatal(`An unsupported backend should be rejected`)
+	}
+}
+
+func TestNewWithoutBackend(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("EVENT_BUS_BACKEND", "none")
+
+	p, err := New(config.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if IsEnabled(p) {
+		t.Fatal(`The events should not be sent to a broker`)
+	}
+}
+
+func TestNoopPublisherRecordsSinks(t *testing.T) {
+	r := &recorder{}
+	p := NewNoopPublisher()
+	p.AddSink(r)
+	p.SetOutbox(r)
+
+	p.PublishEvent(gcppubsub.NewFeedEvent(1, gcppubsub.EntityOpWrite))
+	if len(r.events) != 1 || len(r.failed) != 0 {
+		t.Fatalf(`Unexpected events: %d recorded, %d failed`, len(r.events), len(r.failed))
+	}
+
+	if err := p.Publish(gcppubsub.NewFeedEvent(1, gcppubsub.EntityOpWrite)); err != ErrDisabled {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+}
+
+func TestPublishEventRecordsFailedEvents(t *testing.T) {
+	r := &recorder{}
+	p := &publisher{name: "test", sender: failingSender{}}
+	p.AddSink(r)
+	p.SetOutbox(r)
+
+	p.PublishEvent(gcppubsub.NewEntryEvent(2, gcppubsub.EntityOpDelete))
+	if len(r.events) != 1 || len(r.failed) != 1 {
+		t.Fatalf(`Unexpected events: %d recorded, %d failed`, len(r.events), len(r.failed))
+	}
+
+	if !IsEnabled(p) {
+		t.Fatal(`The events should be sent to the broker`)
+	}
+}
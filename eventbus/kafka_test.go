@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package eventbus // import "miniflux.app/eventbus"
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/integration/gcppubsub"
+)
+
+func TestKafkaSender(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		if r.URL.Path != "/topics/SyncData" || username != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.Write([]byte(`{"offsets":[{"partition":0,"offset":42,"error_code":null,"error":null}]}`))
+	}))
+	defer server.Close()
+
+	sender, err := newKafkaSender(strings.Replace(server.URL, "http://", "http://user:secret@", 1), "SyncData")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &publisher{name: "kafka", sender: sender}
+	if err := p.Publish(gcppubsub.NewUserEvent(3, gcppubsub.EntityOpPurge)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"records":[{"key":"USER:3","value":{"entity_type":"USER","entity_id":3,"entity_op":"PURGE"}}]}`
+	if body != expected {
+		t.Fatalf(`Unexpected body: got %q instead of %q`, body, expected)
+	}
+}
+
+func TestKafkaSenderWithRecordError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":50003,"error":"Kafka error"}]}`))
+	}))
+	defer server.Close()
+
+	sender, err := newKafkaSender(server.URL, "SyncData")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sender.send(gcppubsub.NewFeedEvent(1, gcppubsub.EntityOpWrite), []byte("{}")); err == nil {
+		t.Fatal(`The error of the record should be returned`)
+	}
+}
+
+func TestNewKafkaSenderWithInvalidURL(t *testing.T) {
+	if _, err := newKafkaSender("kafka://127.0.0.1:9092", "SyncData"); err == nil {
+		t.Fatal(`Only the URL of a REST proxy should be accepted`)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package eventbus // import "miniflux.app/eventbus"
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+
+	"miniflux.app/integration/gcppubsub"
+)
+
+// startNATSServer accepts one connection and sends the published messages to the channel.
+func startNATSServer(t *testing.T, token string) (net.Listener, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		conn.Write([]byte("INFO {\"server_id\":\"test\"}\r\n"))
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				if !strings.Contains(line, `"auth_token":"`+token+`"`) {
+					conn.Write([]byte("-ERR 'Authorization Violation'\r\n"))
+					return
+				}
+			case strings.HasPrefix(line, "PUB "):
+				payload, _ := reader.ReadString('\n')
+				messages <- strings.TrimSpace(line) + " " + strings.TrimSpace(payload)
+			case strings.HasPrefix(line, "PING"):
+				conn.Write([]byte("PONG\r\n"))
+			}
+		}
+	}()
+
+	return listener, messages
+}
+
+func TestNATSSender(t *testing.T) {
+	listener, messages := startNATSServer(t, "secret")
+	defer listener.Close()
+
+	sender, err := newNATSSender("nats://secret@"+listener.Addr().String(), "miniflux.sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &publisher{name: "nats", sender: sender}
+	if err := p.Publish(gcppubsub.NewFeedEvent(12, gcppubsub.EntityOpWrite)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `PUB miniflux.sync 57 {"entity_type":"FEED","entity_id":12,"entity_op":"WRITE"}`
+	if message := <-messages; message != expected {
+		t.Fatalf(`Unexpected message: got %q instead of %q`, message, expected)
+	}
+}
+
+func TestNATSSenderWithWrongCredentials(t *testing.T) {
+	listener, _ := startNATSServer(t, "secret")
+	defer listener.Close()
+
+	sender, err := newNATSSender("nats://wrong@"+listener.Addr().String(), "miniflux.sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sender.send(gcppubsub.NewFeedEvent(12, gcppubsub.EntityOpWrite), []byte("{}"))
+	if err == nil || !strings.Contains(err.Error(), "Authorization Violation") {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if sender.conn != nil {
+		t.Fatal(`The connection should be closed after an error`)
+	}
+}
+
+func TestNewNATSSenderWithInvalidURL(t *testing.T) {
+	for _, rawURL := range []string{"", "http://127.0.0.1:4222", "nats://"} {
+		if _, err := newNATSSender(rawURL, "miniflux.sync"); err == nil {
+			t.Errorf(`The URL %q should be rejected`, rawURL)
+		}
+	}
+
+	if _, err := newNATSSender("nats://127.0.0.1", "two words"); err == nil {
+		t.Error(`A subject with spaces should be rejected`)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extension // import "miniflux.app/extension"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/reader/feed"
+	"miniflux.app/reader/subscription"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the endpoints of the browser extension, the subscriptions and saved pages are rejected on read-only instances.
+func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, feedHandler *feed.Handler) {
+	handler := &handler{store, feedHandler}
+	middleware := newMiddleware(store)
+
+	subscribe, savePage := handler.subscribe, handler.savePage
+	if cfg.IsReadOnly() {
+		subscribe, savePage = readOnly, readOnly
+	}
+
+	sr := router.PathPrefix("/extension").Subrouter()
+	sr.Use(middleware.cors)
+	sr.Use(middleware.serve)
+	sr.Use(middleware.writeFreeze)
+	sr.HandleFunc("/", handler.currentUser).Name("extensionEndpoint").Methods("GET")
+	sr.HandleFunc("/subscription", handler.subscriptionStatus).Name("extensionSubscription").Methods("GET")
+	sr.HandleFunc("/subscription", subscribe).Methods("POST")
+	sr.HandleFunc("/page", savePage).Name("extensionPage").Methods("POST")
+
+	// Preflight requests are answered by the CORS middleware before authentication.
+	sr.PathPrefix("/").Methods("OPTIONS").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+}
+
+func readOnly(w http.ResponseWriter, r *http.Request) {
+	json.ForbiddenError(w, r, config.ErrReadOnly)
+}
+
+type handler struct {
+	store       *storage.Storage
+	feedHandler *feed.Handler
+}
+
+// currentUser lets the extension verify its token.
+func (h *handler) currentUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, &currentUser{ID: user.ID, Username: user.Username})
+}
+
+// subscriptionStatus tells if the user is subscribed to the given feed or website URL.
+func (h *handler) subscriptionStatus(w http.ResponseWriter, r *http.Request) {
+	websiteURL := request.QueryStringParam(r, "url", "")
+	if websiteURL == "" {
+		json.BadRequest(w, r, errors.New("The URL is required"))
+		return
+	}
+
+	feedID := h.store.FeedIDByURL(r.Context(), request.UserID(r), websiteURL)
+	json.OK(w, r, &subscriptionStatus{Subscribed: feedID > 0, FeedID: feedID})
+}
+
+// subscribe discovers the feed of a website and subscribes to the first one found.
+func (h *handler) subscribe(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	payload, err := decodeSubscriptionRequest(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if feedID := h.store.FeedIDByURL(r.Context(), userID, payload.URL); feedID > 0 {
+		json.OK(w, r, &subscriptionStatus{Subscribed: true, FeedID: feedID})
+		return
+	}
+
+	categoryID := payload.CategoryID
+	if categoryID == 0 {
+		category, err := h.store.FirstCategory(r.Context(), userID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if category == nil {
+			json.BadRequest(w, r, errors.New("This user has no category"))
+			return
+		}
+
+		categoryID = category.ID
+	} else if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		json.BadRequest(w, r, errors.New("This category_id doesn't exists or doesn't belongs to this user"))
+		return
+	}
+
+	subscriptions, finderErr := subscription.FindSubscriptions(payload.URL, "", "", "")
+	if finderErr != nil {
+		json.BadRequest(w, r, finderErr)
+		return
+	}
+
+	if len(subscriptions) == 0 {
+		json.NotFound(w, r)
+		return
+	}
+
+	if feedID := h.store.FeedIDByURL(r.Context(), userID, subscriptions[0].URL); feedID > 0 {
+		json.OK(w, r, &subscriptionStatus{Subscribed: true, FeedID: feedID})
+		return
+	}
+
+	feed, err := h.feedHandler.CreateFeed(r.Context(), userID, categoryID, subscriptions[0].URL, false, "", "", "")
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &subscriptionStatus{Subscribed: true, FeedID: feed.ID, Pending: feed.Pending})
+}
+
+// savePage stores a web page in the saved pages feed of the user.
+func (h *handler) savePage(w http.ResponseWriter, r *http.Request) {
+	payload, err := decodePageRequest(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	entry, err := h.feedHandler.SaveURL(r.Context(), request.UserID(r), payload.URL)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &savedPage{EntryID: entry.ID, FeedID: entry.FeedID, Title: entry.Title})
+}
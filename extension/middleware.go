@@ -0,0 +1,107 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extension // import "miniflux.app/extension"
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+)
+
+// TokenExtraField is the user extra field that holds the token of the browser extension.
+const TokenExtraField = "extension_token"
+
+type middleware struct {
+	store *storage.Storage
+}
+
+func newMiddleware(s *storage.Storage) *middleware {
+	return &middleware{s}
+}
+
+// cors allows the extension to call the endpoints from any page, credentials are never sent by browsers.
+func (m *middleware) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-Extension-Token")
+		w.Header().Set("Access-Control-Max-Age", "3600")
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *middleware) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP := request.ClientIP(r)
+		token := r.Header.Get("X-Extension-Token")
+		if token == "" {
+			token = request.QueryStringParam(r, "token", "")
+		}
+
+		if token == "" {
+			logger.Debug("[Extension] No token provided")
+			json.Unauthorized(w, r)
+			return
+		}
+
+		user, err := m.store.UserByExtraField(r.Context(), TokenExtraField, token)
+		if err != nil {
+			logger.Error("[Extension] %v", err)
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if user == nil {
+			logger.Error("[Extension] [ClientIP=%s] Invalid token", clientIP)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		logger.Info("[Extension] User #%d is authenticated", user.ID)
+
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
+		ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
+		ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
+		ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// writeFreeze rejects the subscriptions and saved pages of the users frozen by an administrator.
+func (m *middleware) writeFreeze(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := m.store.UserByID(r.Context(), request.UserID(r))
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		now := time.Now()
+		if user != nil && user.IsFrozen(now) {
+			json.ServiceUnavailable(w, r, user.FreezeError(), user.FreezeRetryAfter(now))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
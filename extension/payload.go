@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package extension // import "miniflux.app/extension"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type currentUser struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+type subscriptionStatus struct {
+	Subscribed bool  `json:"subscribed"`
+	FeedID     int64 `json:"feed_id,omitempty"`
+	Pending    bool  `json:"pending,omitempty"`
+}
+
+type subscriptionRequest struct {
+	URL        string `json:"url"`
+	CategoryID int64  `json:"category_id"`
+}
+
+type pageRequest struct {
+	URL string `json:"url"`
+}
+
+type savedPage struct {
+	EntryID int64  `json:"entry_id"`
+	FeedID  int64  `json:"feed_id"`
+	Title   string `json:"title"`
+}
+
+func decodeSubscriptionRequest(r io.ReadCloser) (*subscriptionRequest, error) {
+	defer r.Close()
+
+	var payload subscriptionRequest
+	if err := json.NewDecoder(r).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("Unable to decode subscription JSON object: %v", err)
+	}
+
+	if payload.URL == "" {
+		return nil, errors.New("The URL is required")
+	}
+
+	return &payload, nil
+}
+
+func decodePageRequest(r io.ReadCloser) (*pageRequest, error) {
+	defer r.Close()
+
+	var payload pageRequest
+	if err := json.NewDecoder(r).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("Unable to decode page JSON object: %v", err)
+	}
+
+	if payload.URL == "" {
+		return nil, errors.New("The URL is required")
+	}
+
+	return &payload, nil
+}
@@ -5,6 +5,7 @@
 package fever // import "miniflux.app/fever"
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"strings"
@@ -26,7 +27,9 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage) {
 	handler := &handler{cfg, store}
 
 	sr := router.PathPrefix("/fever").Subrouter()
-	sr.Use(newMiddleware(store).serve)
+	middleware := newMiddleware(store)
+	sr.Use(middleware.serve)
+	sr.Use(middleware.writeFreeze)
 	sr.HandleFunc("/", handler.serve).Name("feverEndpoint")
 }
 
@@ -49,6 +52,8 @@ func (h *handler) serve(w http.ResponseWriter, r *http.Request) {
 		h.handleSavedItems(w, r)
 	case request.HasQueryParam(r, "items"):
 		h.handleItems(w, r)
+	case r.FormValue("mark") != "" && h.cfg.IsReadOnly():
+		json.ForbiddenError(w, r, config.ErrReadOnly)
 	case r.FormValue("mark") == "item":
 		h.handleWriteItems(w, r)
 	case r.FormValue("mark") == "feed":
@@ -84,13 +89,13 @@ func (h *handler) handleGroups(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	logger.Debug("[Fever] Fetching groups for userID=%d", userID)
 
-	categories, err := h.store.Categories(userID)
+	categories, err := h.store.Categories(r.Context(), userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	feeds, err := h.store.Feeds(userID)
+	feeds, err := h.store.Feeds(r.Context(), userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -134,7 +139,7 @@ func (h *handler) handleFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	logger.Debug("[Fever] Fetching feeds for userID=%d", userID)
 
-	feeds, err := h.store.Feeds(userID)
+	feeds, err := h.store.Feeds(r.Context(), userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -187,7 +192,7 @@ func (h *handler) handleFavicons(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	logger.Debug("[Fever] Fetching favicons for userID=%d", userID)
 
-	icons, err := h.store.Icons(userID)
+	icons, err := h.store.Icons(r.Context(), userID)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -271,7 +276,7 @@ func (h *handler) handleItems(w http.ResponseWriter, r *http.Request) {
 		builder.WithEntryIDs(itemIDs)
 	}
 
-	entries, err := builder.GetEntries()
+	entries, err := builder.GetEntries(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -279,7 +284,7 @@ func (h *handler) handleItems(w http.ResponseWriter, r *http.Request) {
 
 	builder = h.store.NewEntryQueryBuilder(userID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
-	result.Total, err = builder.CountEntries()
+	result.Total, err = builder.CountEntries(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -327,7 +332,8 @@ func (h *handler) handleUnreadItems(w http.ResponseWriter, r *http.Request) {
 
 	builder := h.store.NewEntryQueryBuilder(userID)
 	builder.WithStatus(model.EntryStatusUnread)
-	entries, err := builder.GetEntries()
+	builder.WithoutMutedFeeds()
+	entries, err := builder.GetEntries(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -359,7 +365,7 @@ func (h *handler) handleSavedItems(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(userID)
 	builder.WithStarred()
 
-	entryIDs, err := builder.GetEntryIDs()
+	entryIDs, err := builder.GetEntryIDs(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -393,7 +399,7 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 	builder.WithEntryID(entryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
 
-	entry, err := builder.GetEntry()
+	entry, err := builder.GetEntry(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -406,18 +412,18 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 	switch r.FormValue("as") {
 	case "read":
 		logger.Debug("[Fever] Mark entry #%d as read", entryID)
-		h.store.SetEntriesStatus(userID, []int64{entryID}, model.EntryStatusRead)
+		h.store.SetEntriesStatus(r.Context(), userID, []int64{entryID}, model.EntryStatusRead)
 	case "unread":
 		logger.Debug("[Fever] Mark entry #%d as unread", entryID)
-		h.store.SetEntriesStatus(userID, []int64{entryID}, model.EntryStatusUnread)
+		h.store.SetEntriesStatus(r.Context(), userID, []int64{entryID}, model.EntryStatusUnread)
 	case "saved", "unsaved":
 		logger.Debug("[Fever] Mark entry #%d as saved/unsaved", entryID)
-		if err := h.store.ToggleBookmark(userID, entryID); err != nil {
+		if err := h.store.ToggleBookmark(r.Context(), userID, entryID); err != nil {
 			json.ServerError(w, r, err)
 			return
 		}
 
-		settings, err := h.store.Integration(userID)
+		settings, err := h.store.Integration(r.Context(), userID)
 		if err != nil {
 			json.ServerError(w, r, err)
 			return
@@ -448,8 +454,9 @@ func (h *handler) handleWriteFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The request context is canceled when the response is sent.
 	go func() {
-		if err := h.store.MarkFeedAsRead(userID, feedID, before); err != nil {
+		if err := h.store.MarkFeedAsRead(context.Background(), userID, feedID, before); err != nil {
 			logger.Error("[Fever] MarkFeedAsRead failed: %v", err)
 		}
 	}()
@@ -474,13 +481,14 @@ func (h *handler) handleWriteGroups(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The request context is canceled when the response is sent.
 	go func() {
 		var err error
 
 		if groupID == 0 {
-			err = h.store.MarkAllAsRead(userID)
+			err = h.store.MarkAllAsRead(context.Background(), userID)
 		} else {
-			err = h.store.MarkCategoryAsRead(userID, groupID, before)
+			err = h.store.MarkCategoryAsRead(context.Background(), userID, groupID, before)
 		}
 
 		if err != nil {
@@ -7,6 +7,7 @@ package fever // import "miniflux.app/fever"
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
@@ -31,7 +32,7 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 			return
 		}
 
-		user, err := m.store.UserByFeverToken(apiKey)
+		user, err := m.store.UserByFeverToken(r.Context(), apiKey)
 		if err != nil {
 			logger.Error("[Fever] %v", err)
 			json.OK(w, r, newAuthFailureResponse())
@@ -45,7 +46,7 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		}
 
 		logger.Info("[Fever] User #%d is authenticated", user.ID)
-		m.store.SetLastLogin(user.ID)
+		m.store.SetLastLogin(r.Context(), user.ID)
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
@@ -56,3 +57,27 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// writeFreeze rejects the changes of the users frozen by an administrator, they can still read their items.
+func (m *middleware) writeFreeze(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("mark") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := m.store.UserByID(r.Context(), request.UserID(r))
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		now := time.Now()
+		if user != nil && user.IsFrozen(now) {
+			json.ServiceUnavailable(w, r, user.FreezeError(), user.FreezeRetryAfter(now))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,14 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package graphql implements a GraphQL endpoint on top of the storage layer.
+
+Only the executable part of the specification is supported: queries, mutations,
+variables, fragments and the @include/@skip directives. Schema introspection is not available.
+The queries nested too deeply or resolving too many objects are rejected before running.
+
+*/
+package graphql // import "miniflux.app/graphql"
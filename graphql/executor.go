@@ -0,0 +1,533 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Schema contains the root types of the GraphQL schema.
+// The operations nested deeper than MaxDepth or resolving more than MaxComplexity objects are rejected
+// before running any resolver, a zero value disables the limit.
+type Schema struct {
+	Query         *Object
+	Mutation      *Object
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Object represents a GraphQL object type.
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+}
+
+// Field represents a field of an object type.
+// A nil Type means the field is a scalar (or a list of scalars).
+// A nil Resolve function reads the struct field having the same JSON name from the source.
+// Complexity returns the number of items of a list, the field and its subfields are counted for each item.
+// A nil Complexity function counts a single item.
+type Field struct {
+	Type       *Object
+	Resolve    ResolveFunc
+	Complexity ComplexityFunc
+}
+
+// ResolveFunc returns the value of a field.
+type ResolveFunc func(p ResolveParams) (interface{}, error)
+
+// ComplexityFunc returns the number of items expected from a field, only the arguments are given.
+type ComplexityFunc func(p ResolveParams) int
+
+// ResolveParams contains the information available to a resolver.
+type ResolveParams struct {
+	Context context.Context
+	Source  interface{}
+	Args    map[string]interface{}
+}
+
+// HasArg returns true if the argument is given.
+func (p ResolveParams) HasArg(name string) bool {
+	value, found := p.Args[name]
+	return found && value != nil
+}
+
+// StringArg returns an argument as string.
+func (p ResolveParams) StringArg(name, defaultValue string) string {
+	if value, ok := p.Args[name].(string); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Int64Arg returns an argument as int64.
+func (p ResolveParams) Int64Arg(name string, defaultValue int64) int64 {
+	if value, ok := toInt64(p.Args[name]); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// IntArg returns an argument as integer.
+func (p ResolveParams) IntArg(name string, defaultValue int) int {
+	return int(p.Int64Arg(name, int64(defaultValue)))
+}
+
+// BoolArg returns an argument as boolean.
+func (p ResolveParams) BoolArg(name string, defaultValue bool) bool {
+	if value, ok := p.Args[name].(bool); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// StringListArg returns an argument as a list of strings, a single value is converted to a list.
+func (p ResolveParams) StringListArg(name string) []string {
+	var results []string
+	switch value := p.Args[name].(type) {
+	case string:
+		results = append(results, value)
+	case []interface{}:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				results = append(results, s)
+			}
+		}
+	}
+	return results
+}
+
+// Int64ListArg returns an argument as a list of int64, a single value is converted to a list.
+func (p ResolveParams) Int64ListArg(name string) []int64 {
+	var results []int64
+	switch value := p.Args[name].(type) {
+	case []interface{}:
+		for _, item := range value {
+			if n, ok := toInt64(item); ok {
+				results = append(results, n)
+			}
+		}
+	default:
+		if n, ok := toInt64(value); ok {
+			results = append(results, n)
+		}
+	}
+	return results
+}
+
+// Error represents a GraphQL error.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Result represents the response of a GraphQL request.
+type Result struct {
+	Data   interface{} `json:"data"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+// Execute parses and runs a GraphQL document against the schema.
+func (s *Schema) Execute(ctx context.Context, query string, variables map[string]interface{}, operationName string) *Result {
+	doc, err := parse(query)
+	if err != nil {
+		return &Result{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	op, err := selectOperation(doc, operationName)
+	if err != nil {
+		return &Result{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	root := s.Query
+	if op.kind == OperationMutation {
+		root = s.Mutation
+	}
+
+	if root == nil {
+		return &Result{Errors: []*Error{{Message: fmt.Sprintf("the schema does not support %s operations", op.kind)}}}
+	}
+
+	e := &executor{ctx: ctx, doc: doc, variables: make(map[string]interface{})}
+	for _, definition := range op.variables {
+		if value, found := variables[definition.name]; found {
+			e.variables[definition.name] = value
+		} else if definition.defaultValue != nil {
+			e.variables[definition.name] = e.resolveValue(definition.defaultValue)
+		}
+	}
+
+	if err := e.checkLimits(s, root, op.selections); err != nil {
+		return &Result{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	data := e.executeSelections(root, nil, op.selections, nil)
+	return &Result{Data: data, Errors: e.errors}
+}
+
+func selectOperation(doc *document, operationName string) (*operation, error) {
+	if operationName == "" {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("an operation name is required when the document contains multiple operations")
+		}
+		return doc.operations[0], nil
+	}
+
+	for _, op := range doc.operations {
+		if op.name == operationName {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", operationName)
+}
+
+type executor struct {
+	ctx       context.Context
+	doc       *document
+	variables map[string]interface{}
+	errors    []*Error
+}
+
+func (e *executor) addError(path []interface{}, format string, args ...interface{}) {
+	e.errors = append(e.errors, &Error{Message: fmt.Sprintf(format, args...), Path: path})
+}
+
+// checkLimits measures the operation before running it.
+func (e *executor) checkLimits(s *Schema, root *Object, selections []selection) error {
+	if s.MaxDepth <= 0 && s.MaxComplexity <= 0 {
+		return nil
+	}
+
+	_, err := e.complexity(s, root, selections, 1)
+
+	// The errors of the fields are reported when the operation runs.
+	e.errors = nil
+	return err
+}
+
+// complexity returns the number of objects resolved by a selection set.
+func (e *executor) complexity(s *Schema, object *Object, selections []selection, depth int) (int, error) {
+	if s.MaxDepth > 0 && depth > s.MaxDepth {
+		return 0, fmt.Errorf("the query is nested more than %d levels deep", s.MaxDepth)
+	}
+
+	total := 0
+	for _, f := range e.collectFields(object, selections) {
+		definition, found := object.Fields[f.name]
+		if !found || definition.Type == nil {
+			continue
+		}
+
+		children, err := e.complexity(s, definition.Type, f.selections, depth+1)
+		if err != nil {
+			return 0, err
+		}
+
+		items := 1
+		if definition.Complexity != nil {
+			items = definition.Complexity(ResolveParams{Context: e.ctx, Args: e.resolveArguments(f.arguments)})
+		}
+
+		if s.MaxComplexity > 0 && items > s.MaxComplexity {
+			return 0, fmt.Errorf("the query resolves more than %d objects", s.MaxComplexity)
+		}
+
+		total += items * (1 + children)
+		if s.MaxComplexity > 0 && total > s.MaxComplexity {
+			return 0, fmt.Errorf("the query resolves more than %d objects", s.MaxComplexity)
+		}
+	}
+
+	return total, nil
+}
+
+func (e *executor) executeSelections(object *Object, source interface{}, selections []selection, path []interface{}) *orderedMap {
+	result := newOrderedMap()
+
+	for _, f := range e.collectFields(object, selections) {
+		key := f.responseKey()
+		fieldPath := appendPath(path, key)
+
+		if f.name == "__typename" {
+			result.set(key, object.Name)
+			continue
+		}
+
+		definition, found := object.Fields[f.name]
+		if !found {
+			e.addError(fieldPath, "cannot query field %q on type %q", f.name, object.Name)
+			result.set(key, nil)
+			continue
+		}
+
+		params := ResolveParams{Context: e.ctx, Source: source, Args: e.resolveArguments(f.arguments)}
+
+		var value interface{}
+		var err error
+		if definition.Resolve != nil {
+			value, err = definition.Resolve(params)
+		} else {
+			value = defaultResolve(source, f.name)
+		}
+
+		if err != nil {
+			e.addError(fieldPath, "%v", err)
+			result.set(key, nil)
+			continue
+		}
+
+		result.set(key, e.completeValue(definition, f, value, fieldPath))
+	}
+
+	return result
+}
+
+func (e *executor) completeValue(definition *Field, f *field, value interface{}, path []interface{}) interface{} {
+	if definition.Type == nil {
+		if len(f.selections) > 0 {
+			e.addError(path, "field %q must not have a selection since it is a scalar", f.name)
+			return nil
+		}
+		return value
+	}
+
+	if len(f.selections) == 0 {
+		e.addError(path, "field %q of type %q must have a selection of subfields", f.name, definition.Type.Name)
+		return nil
+	}
+
+	if isNil(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Slice {
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i).Interface()
+			if isNil(item) {
+				continue
+			}
+			list[i] = e.executeSelections(definition.Type, item, f.selections, appendPath(path, i))
+		}
+		return list
+	}
+
+	return e.executeSelections(definition.Type, value, f.selections, path)
+}
+
+// collectFields flattens fragments and applies the @skip and @include directives.
+// A fragment is spread once, the fragments including themselves don't loop forever.
+func (e *executor) collectFields(object *Object, selections []selection) []*field {
+	var fields []*field
+	seen := make(map[string]*field)
+	spread := make(map[string]bool)
+
+	var collect func(selections []selection)
+	collect = func(selections []selection) {
+		for _, s := range selections {
+			switch s := s.(type) {
+			case *field:
+				if !e.shouldInclude(s.directives) {
+					continue
+				}
+
+				if existing, found := seen[s.responseKey()]; found {
+					existing.selections = append(existing.selections, s.selections...)
+					continue
+				}
+
+				copied := *s
+				seen[s.responseKey()] = &copied
+				fields = append(fields, &copied)
+			case *inlineFragment:
+				if e.shouldInclude(s.directives) && (s.typeCondition == "" || s.typeCondition == object.Name) {
+					collect(s.selections)
+				}
+			case *fragmentSpread:
+				if !e.shouldInclude(s.directives) || spread[s.name] {
+					continue
+				}
+				spread[s.name] = true
+
+				fragment, found := e.doc.fragments[s.name]
+				if !found {
+					e.addError(nil, "unknown fragment %q", s.name)
+					continue
+				}
+
+				if fragment.typeCondition == object.Name {
+					collect(fragment.selections)
+				}
+			}
+		}
+	}
+
+	collect(selections)
+	return fields
+}
+
+func (e *executor) shouldInclude(directives []*directive) bool {
+	for _, d := range directives {
+		condition, _ := e.resolveValue(d.arguments["if"]).(bool)
+		switch d.name {
+		case "skip":
+			if condition {
+				return false
+			}
+		case "include":
+			if !condition {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (e *executor) resolveArguments(arguments map[string]value) map[string]interface{} {
+	args := make(map[string]interface{}, len(arguments))
+	for name, v := range arguments {
+		args[name] = e.resolveValue(v)
+	}
+	return args
+}
+
+func (e *executor) resolveValue(v value) interface{} {
+	switch v := v.(type) {
+	case variable:
+		return e.variables[string(v)]
+	case enumValue:
+		return string(v)
+	case []value:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = e.resolveValue(item)
+		}
+		return list
+	case map[string]value:
+		object := make(map[string]interface{}, len(v))
+		for name, item := range v {
+			object[name] = e.resolveValue(item)
+		}
+		return object
+	}
+	return v
+}
+
+// defaultResolve returns the struct field tagged with the given JSON name.
+func defaultResolve(source interface{}, name string) interface{} {
+	if m, ok := source.(map[string]interface{}); ok {
+		return m[name]
+	}
+
+	v := reflect.ValueOf(source)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag == name {
+			return v.Field(i).Interface()
+		}
+	}
+
+	return nil
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	switch n := value.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func appendPath(path []interface{}, key interface{}) []interface{} {
+	newPath := make([]interface{}, len(path), len(path)+1)
+	copy(newPath, path)
+	return append(newPath, key)
+}
+
+// orderedMap keeps the fields in the order of the selection set when encoded to JSON.
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{values: make(map[string]interface{})}
+}
+
+func (m *orderedMap) set(key string, value interface{}) {
+	if _, found := m.values[key]; !found {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *orderedMap) get(key string) interface{} {
+	return m.values[key]
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedValue, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(encodedKey)
+		buffer.WriteByte(':')
+		buffer.Write(encodedValue)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testAuthor struct {
+	Name string `json:"name"`
+}
+
+type testBook struct {
+	ID     int64       `json:"id"`
+	Title  string      `json:"title,omitempty"`
+	Author *testAuthor `json:"author"`
+	Secret string      `json:"secret"`
+}
+
+func newTestSchema(books []*testBook) *Schema {
+	authorType := &Object{Name: "Author", Fields: map[string]*Field{"name": {}}}
+	bookType := &Object{Name: "Book", Fields: map[string]*Field{
+		"id":     {},
+		"title":  {},
+		"author": {Type: authorType},
+		"failure": {Resolve: func(p ResolveParams) (interface{}, error) {
+			return nil, errors.New("resolver failure")
+		}},
+	}}
+
+	return &Schema{
+		Query: &Object{Name: "Query", Fields: map[string]*Field{
+			"books": {Type: bookType, Resolve: func(p ResolveParams) (interface{}, error) {
+				limit := p.IntArg("limit", len(books))
+				return books[:limit], nil
+			}},
+			"book": {Type: bookType, Resolve: func(p ResolveParams) (interface{}, error) {
+				for _, book := range books {
+					if book.ID == p.Int64Arg("id", 0) {
+						return book, nil
+					}
+				}
+				return (*testBook)(nil), nil
+			}},
+		}},
+		Mutation: &Object{Name: "Mutation", Fields: map[string]*Field{
+			"rename": {Type: bookType, Resolve: func(p ResolveParams) (interface{}, error) {
+				book := books[p.Int64Arg("id", 0)-1]
+				book.Title = p.StringArg("title", "")
+				return book, nil
+			}},
+		}},
+	}
+}
+
+func executeTestQuery(t *testing.T, query string, variables map[string]interface{}) (string, []*Error) {
+	books := []*testBook{
+		{ID: 1, Title: "First", Author: &testAuthor{Name: "Alice"}, Secret: "s1"},
+		{ID: 2, Title: "Second", Secret: "s2"},
+	}
+
+	result := newTestSchema(books).Execute(context.Background(), query, variables, "")
+	data, err := json.Marshal(result.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data), result.Errors
+}
+
+func TestExecuteNestedQuery(t *testing.T) {
+	data, errs := executeTestQuery(t, `{ books { title id author { name } } }`, nil)
+	if len(errs) != 0 {
+		t.Fatalf(`Unexpected errors: %v`, errs[0].Message)
+	}
+
+	expected := `{"books":[{"title":"First","id":1,"author":{"name":"Alice"}},{"title":"Second","id":2,"author":null}]}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+}
+
+func TestExecuteWithAliasesAndVariables(t *testing.T) {
+	query := `query Books($id: Int, $limit: Int = 1) {
+		first: book(id: $id) { title __typename }
+		missing: book(id: 42) { title }
+		books(limit: $limit) { id }
+	}`
+
+	data, errs := executeTestQuery(t, query, map[string]interface{}{"id": float64(1)})
+	if len(errs) != 0 {
+		t.Fatalf(`Unexpected errors: %v`, errs[0].Message)
+	}
+
+	expected := `{"first":{"title":"First","__typename":"Book"},"missing":null,"books":[{"id":1}]}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+}
+
+func TestExecuteWithFragmentsAndDirectives(t *testing.T) {
+	query := `query ($withAuthor: Boolean!) {
+		book(id: 1) {
+			...BookFields
+			... on Book @include(if: $withAuthor) { author { name } }
+			title @skip(if: true)
+		}
+	}
+	fragment BookFields on Book { id }`
+
+	data, errs := executeTestQuery(t, query, map[string]interface{}{"withAuthor": false})
+	if len(errs) != 0 {
+		t.Fatalf(`Unexpected errors: %v`, errs[0].Message)
+	}
+
+	expected := `{"book":{"id":1}}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+}
+
+func TestExecuteMutation(t *testing.T) {
+	data, errs := executeTestQuery(t, `mutation { rename(id: 2, title: "Renamed") { id title } }`, nil)
+	if len(errs) != 0 {
+		t.Fatalf(`Unexpected errors: %v`, errs[0].Message)
+	}
+
+	expected := `{"rename":{"id":2,"title":"Renamed"}}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+}
+
+func TestExecuteFieldErrors(t *testing.T) {
+	data, errs := executeTestQuery(t, `{ book(id: 1) { id secret failure author } }`, nil)
+
+	expected := `{"book":{"id":1,"secret":null,"failure":null,"author":null}}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+
+	if len(errs) != 3 {
+		t.Fatalf(`Unexpected number of errors, got %d instead of 3`, len(errs))
+	}
+
+	if len(errs[1].Path) != 2 || errs[1].Path[0] != "book" || errs[1].Path[1] != "failure" {
+		t.Errorf(`Unexpected error path: %v`, errs[1].Path)
+	}
+}
+
+func TestExecuteInvalidDocument(t *testing.T) {
+	result := newTestSchema(nil).Execute(context.Background(), `{ books `, nil, "")
+	if result.Data != nil || len(result.Errors) != 1 {
+		t.Errorf(`A syntax error should return only one error without data`)
+	}
+
+	result = newTestSchema(nil).Execute(context.Background(), `query A { books { id } } query B { books { id } }`, nil, "C")
+	if len(result.Errors) != 1 {
+		t.Errorf(`An unknown operation name should return an error`)
+	}
+}
+
+// newNestedSchema returns a cyclic schema, each node has a list of 10 children and counts the resolved lists.
+func newNestedSchema(calls *int) *Schema {
+	nodeType := &Object{Name: "Node", Fields: map[string]*Field{"name": {}}}
+	children := &Field{
+		Type: nodeType,
+		Resolve: func(p ResolveParams) (interface{}, error) {
+			*calls++
+			nodes := make([]map[string]interface{}, 10)
+			for i := range nodes {
+				nodes[i] = map[string]interface{}{"name": "node"}
+			}
+			return nodes, nil
+		},
+		Complexity: func(p ResolveParams) int { return 10 },
+	}
+	nodeType.Fields["children"] = children
+
+	return &Schema{
+		Query:         &Object{Name: "Query", Fields: map[string]*Field{"nodes": children}},
+		MaxDepth:      5,
+		MaxComplexity: 1000,
+	}
+}
+
+func TestExecuteRejectsDeeplyNestedQuery(t *testing.T) {
+	var calls int
+	query := `{ nodes { children { children { children { children { children { name } } } } } } }`
+
+	result := newNestedSchema(&calls).Execute(context.Background(), query, nil, "")
+	if result.Data != nil || len(result.Errors) != 1 {
+		t.Fatal(`A query nested too deeply should return only one error without data`)
+	}
+
+	if result.Errors[0].Message != "the query is nested more than 5 levels deep" {
+		t.Errorf(`Unexpected error: %s`, result.Errors[0].Message)
+	}
+
+	if calls != 0 {
+		t.Errorf(`The resolvers should not be called, got %d calls`, calls)
+	}
+}
+
+func TestExecuteRejectsComplexQuery(t *testing.T) {
+	var calls int
+	query := `{ nodes { children { children { name } } } }`
+
+	result := newNestedSchema(&calls).Execute(context.Background(), query, nil, "")
+	if result.Data != nil || len(result.Errors) != 1 {
+		t.Fatal(`A query resolving too many objects should return only one error without data`)
+	}
+
+	if calls != 0 {
+		t.Errorf(`The resolvers should not be called, got %d calls`, calls)
+	}
+
+	result = newNestedSchema(&calls).Execute(context.Background(), `{ nodes { children { name } } }`, nil, "")
+	if len(result.Errors) != 0 {
+		t.Errorf(`Unexpected errors: %v`, result.Errors[0].Message)
+	}
+}
+
+func TestExecuteFragmentSpreadingItself(t *testing.T) {
+	data, errs := executeTestQuery(t, `{ book(id: 1) { ...BookFields } } fragment BookFields on Book { id ...BookFields }`, nil)
+	if len(errs) != 0 {
+		t.Fatalf(`Unexpected errors: %v`, errs[0].Message)
+	}
+
+	expected := `{"book":{"id":1}}`
+	if data != expected {
+		t.Errorf(`Unexpected result, got %s instead of %s`, data, expected)
+	}
+}
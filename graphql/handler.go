@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the GraphQL endpoint.
+// Mutations are rejected on read-only instances and for the users frozen by an administrator.
+func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage) {
+	handler := &handler{store, NewSchema(store), cfg.IsReadOnly()}
+	router.Handle("/graphql", newMiddleware(store).serve(http.HandlerFunc(handler.serve))).Methods("GET", "POST").Name("graphqlEndpoint")
+}
+
+type handler struct {
+	store    *storage.Storage
+	schema   *Schema
+	readOnly bool
+}
+
+func (h *handler) serve(w http.ResponseWriter, r *http.Request) {
+	p, err := decodePayload(r)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if p.Query == "" {
+		json.BadRequest(w, r, errors.New("The query is required"))
+		return
+	}
+
+	if r.Method == http.MethodGet && isMutation(p) {
+		json.BadRequest(w, r, errors.New("Mutations are not allowed with GET requests"))
+		return
+	}
+
+	if h.readOnly && isMutation(p) {
+		json.ForbiddenError(w, r, config.ErrReadOnly)
+		return
+	}
+
+	if isMutation(p) {
+		user, err := h.store.UserByID(r.Context(), request.UserID(r))
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		now := time.Now()
+		if user != nil && user.IsFrozen(now) {
+			json.ServiceUnavailable(w, r, user.FreezeError(), user.FreezeRetryAfter(now))
+			return
+		}
+	}
+
+	json.OK(w, r, h.schema.Execute(withFeedCache(r.Context()), p.Query, p.Variables, p.OperationName))
+}
+
+func isMutation(p *payload) bool {
+	doc, err := parse(p.Query)
+	if err != nil {
+		return false
+	}
+
+	op, err := selectOperation(doc, p.OperationName)
+	return err == nil && op.kind == OperationMutation
+}
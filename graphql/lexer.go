@@ -0,0 +1,175 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "<EOF>"
+	}
+	return fmt.Sprintf("%q", t.value)
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	c := l.input[l.pos]
+
+	switch {
+	case strings.HasPrefix(l.input[l.pos:], "..."):
+		l.pos += 3
+		return token{kind: tokenPunctuator, value: "...", pos: start}, nil
+	case strings.IndexByte("!$()=@[]{}|:", c) >= 0:
+		l.pos++
+		return token{kind: tokenPunctuator, value: string(c), pos: start}, nil
+	case c == '_' || isLetter(c):
+		for l.pos < len(l.input) && (l.input[l.pos] == '_' || isLetter(l.input[l.pos]) || isDigit(l.input[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokenName, value: l.input[start:l.pos], pos: start}, nil
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case c == '"':
+		return l.readString()
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos:])
+	return token{}, fmt.Errorf("unexpected character %q at position %d", r, start)
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.input) {
+		switch c := l.input[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.pos++
+		case c == '#':
+			for l.pos < len(l.input) && l.input[l.pos] != '\n' && l.input[l.pos] != '\r' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.input[l.pos:], "\ufeff"):
+			l.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) readNumber() (token, error) {
+	start := l.pos
+	kind := tokenInt
+
+	if l.input[l.pos] == '-' {
+		l.pos++
+	}
+
+	if !l.readDigits() {
+		return token{}, fmt.Errorf("invalid number at position %d", start)
+	}
+
+	if l.pos < len(l.input) && l.input[l.pos] == '.' {
+		kind = tokenFloat
+		l.pos++
+		if !l.readDigits() {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+
+	if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+		kind = tokenFloat
+		l.pos++
+		if l.pos < len(l.input) && (l.input[l.pos] == '+' || l.input[l.pos] == '-') {
+			l.pos++
+		}
+		if !l.readDigits() {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+
+	return token{kind: kind, value: l.input[start:l.pos], pos: start}, nil
+}
+
+func (l *lexer) readDigits() bool {
+	start := l.pos
+	for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+		l.pos++
+	}
+	return l.pos > start
+}
+
+func (l *lexer) readString() (token, error) {
+	start := l.pos
+
+	if strings.HasPrefix(l.input[l.pos:], `"""`) {
+		end := strings.Index(l.input[l.pos+3:], `"""`)
+		if end < 0 {
+			return token{}, fmt.Errorf("unterminated string at position %d", start)
+		}
+		value := l.input[l.pos+3 : l.pos+3+end]
+		l.pos += end + 6
+		return token{kind: tokenString, value: strings.TrimSpace(value), pos: start}, nil
+	}
+
+	l.pos++
+	for l.pos < len(l.input) {
+		switch l.input[l.pos] {
+		case '\\':
+			l.pos += 2
+		case '"':
+			l.pos++
+			value, err := strconv.Unquote(l.input[start:l.pos])
+			if err != nil {
+				return token{}, fmt.Errorf("invalid string at position %d", start)
+			}
+			return token{kind: tokenString, value: value, pos: start}, nil
+		case '\n', '\r':
+			return token{}, fmt.Errorf("unterminated string at position %d", start)
+		default:
+			l.pos++
+		}
+	}
+
+	return token{}, fmt.Errorf("unterminated string at position %d", start)
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"context"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+)
+
+type middleware struct {
+	store *storage.Storage
+}
+
+func newMiddleware(s *storage.Storage) *middleware {
+	return &middleware{s}
+}
+
+// BasicAuth handles HTTP basic authentication.
+func (m *middleware) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
+
+		clientIP := request.ClientIP(r)
+		username, password, authOK := r.BasicAuth()
+		if !authOK {
+			logger.Debug("[GraphQL] No authentication headers sent")
+			json.Unauthorized(w, r)
+			return
+		}
+
+		if err := m.store.CheckPassword(r.Context(), username, password); err != nil {
+			logger.Error("[GraphQL] [ClientIP=%s] Invalid username or password: %s", clientIP, username)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		user, err := m.store.UserByUsername(r.Context(), username)
+		if err != nil {
+			logger.Error("[GraphQL] %v", err)
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if user == nil {
+			logger.Error("[GraphQL] [ClientIP=%s] User not found: %s", clientIP, username)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		logger.Info("[GraphQL] User authenticated: %s", username)
+		m.store.SetLastLogin(r.Context(), user.ID)
+
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
+		ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
+		ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
+		ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,532 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Operation types.
+const (
+	OperationQuery    = "query"
+	OperationMutation = "mutation"
+)
+
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind       string
+	name       string
+	variables  []*variableDefinition
+	selections []selection
+}
+
+type variableDefinition struct {
+	name         string
+	defaultValue value
+}
+
+type fragment struct {
+	name          string
+	typeCondition string
+	selections    []selection
+}
+
+type selection interface{}
+
+type field struct {
+	alias      string
+	name       string
+	arguments  map[string]value
+	directives []*directive
+	selections []selection
+}
+
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+type fragmentSpread struct {
+	name       string
+	directives []*directive
+}
+
+type inlineFragment struct {
+	typeCondition string
+	directives    []*directive
+	selections    []selection
+}
+
+type directive struct {
+	name      string
+	arguments map[string]value
+}
+
+type value interface{}
+
+type variable string
+
+type enumValue string
+
+type parser struct {
+	lexer *lexer
+	token token
+}
+
+func parse(query string) (*document, error) {
+	p := &parser{lexer: &lexer{input: query}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &document{fragments: make(map[string]*fragment)}
+	for p.token.kind != tokenEOF {
+		switch {
+		case p.peek("{"):
+			selections, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{kind: OperationQuery, selections: selections})
+		case p.peekName(OperationQuery), p.peekName(OperationMutation):
+			op, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case p.peekName("fragment"):
+			f, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			doc.fragments[f.name] = f
+		default:
+			return nil, p.unexpected()
+		}
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("the document does not contain any operation")
+	}
+
+	return doc, nil
+}
+
+func (p *parser) advance() (err error) {
+	p.token, err = p.lexer.next()
+	return err
+}
+
+func (p *parser) peek(punctuator string) bool {
+	return p.token.kind == tokenPunctuator && p.token.value == punctuator
+}
+
+func (p *parser) peekName(name string) bool {
+	return p.token.kind == tokenName && p.token.value == name
+}
+
+func (p *parser) unexpected() error {
+	return fmt.Errorf("syntax error: unexpected %s at position %d", p.token, p.token.pos)
+}
+
+func (p *parser) expect(punctuator string) error {
+	if !p.peek(punctuator) {
+		return fmt.Errorf("syntax error: expected %q, got %s at position %d", punctuator, p.token, p.token.pos)
+	}
+	return p.advance()
+}
+
+func (p *parser) skip(punctuator string) (bool, error) {
+	if p.peek(punctuator) {
+		return true, p.advance()
+	}
+	return false, nil
+}
+
+func (p *parser) parseName() (string, error) {
+	if p.token.kind != tokenName {
+		return "", fmt.Errorf("syntax error: expected a name, got %s at position %d", p.token, p.token.pos)
+	}
+	name := p.token.value
+	return name, p.advance()
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := &operation{kind: p.token.value}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName {
+		op.name = p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek("(") {
+		variables, err := p.parseVariableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		op.variables = variables
+	}
+
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+
+	return op, nil
+}
+
+func (p *parser) parseVariableDefinitions() ([]*variableDefinition, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var definitions []*variableDefinition
+	for !p.peek(")") {
+		if err := p.expect("$"); err != nil {
+			return nil, err
+		}
+
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		if err := p.parseType(); err != nil {
+			return nil, err
+		}
+
+		definition := &variableDefinition{name: name}
+		if found, err := p.skip("="); err != nil {
+			return nil, err
+		} else if found {
+			definition.defaultValue, err = p.parseValue(true)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, p.advance()
+}
+
+// parseType consumes a type reference, types are not enforced by the executor.
+func (p *parser) parseType() error {
+	if found, err := p.skip("["); err != nil {
+		return err
+	} else if found {
+		if err := p.parseType(); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	} else if _, err := p.parseName(); err != nil {
+		return err
+	}
+
+	_, err := p.skip("!")
+	return err
+}
+
+func (p *parser) parseFragment() (*fragment, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.peekName("on") {
+		return nil, p.unexpected()
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	typeCondition, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fragment{name: name, typeCondition: typeCondition, selections: selections}, nil
+}
+
+func (p *parser) parseSelectionSet() ([]selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var selections []selection
+	for !p.peek("}") {
+		var s selection
+		var err error
+
+		if p.peek("...") {
+			s, err = p.parseFragmentSelection()
+		} else {
+			s, err = p.parseField()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		selections = append(selections, s)
+	}
+
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("syntax error: empty selection set at position %d", p.token.pos)
+	}
+
+	return selections, p.advance()
+}
+
+func (p *parser) parseFragmentSelection() (selection, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName && !p.peekName("on") {
+		name := p.token.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		directives, err := p.parseDirectives()
+		if err != nil {
+			return nil, err
+		}
+
+		return &fragmentSpread{name: name, directives: directives}, nil
+	}
+
+	inline := &inlineFragment{}
+	if p.peekName("on") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		typeCondition, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		inline.typeCondition = typeCondition
+	}
+
+	directives, err := p.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+	inline.directives = directives
+
+	inline.selections, err = p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return inline, nil
+}
+
+func (p *parser) parseField() (*field, error) {
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &field{name: name}
+	if found, err := p.skip(":"); err != nil {
+		return nil, err
+	} else if found {
+		f.alias = name
+		f.name, err = p.parseName()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek("(") {
+		f.arguments, err = p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.directives, err = p.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek("{") {
+		f.selections, err = p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+func (p *parser) parseArguments() (map[string]value, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	arguments := make(map[string]value)
+	for !p.peek(")") {
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		arguments[name], err = p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return arguments, p.advance()
+}
+
+func (p *parser) parseDirectives() ([]*directive, error) {
+	var directives []*directive
+	for p.peek("@") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+
+		d := &directive{name: name}
+		if p.peek("(") {
+			d.arguments, err = p.parseArguments()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		directives = append(directives, d)
+	}
+
+	return directives, nil
+}
+
+func (p *parser) parseValue(constant bool) (value, error) {
+	t := p.token
+
+	switch {
+	case p.peek("$") && !constant:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		return variable(name), nil
+	case p.peek("["):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		list := make([]value, 0)
+		for !p.peek("]") {
+			item, err := p.parseValue(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		return list, p.advance()
+	case p.peek("{"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		object := make(map[string]value)
+		for !p.peek("}") {
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			object[name], err = p.parseValue(constant)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return object, p.advance()
+	case t.kind == tokenInt:
+		number, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q at position %d", t.value, t.pos)
+		}
+		return number, p.advance()
+	case t.kind == tokenFloat:
+		number, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %q at position %d", t.value, t.pos)
+		}
+		return number, p.advance()
+	case t.kind == tokenString:
+		return t.value, p.advance()
+	case t.kind == tokenName:
+		var v value
+		switch t.value {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		default:
+			v = enumValue(t.value)
+		}
+		return v, p.advance()
+	}
+
+	return nil, p.unexpected()
+}
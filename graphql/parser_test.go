@@ -0,0 +1,147 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import "testing"
+
+func TestParseShorthandQuery(t *testing.T) {
+	doc, err := parse(`{ feeds { id title } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.operations) != 1 {
+		t.Fatalf(`Unexpected number of operations, got %d instead of 1`, len(doc.operations))
+	}
+
+	op := doc.operations[0]
+	if op.kind != OperationQuery {
+		t.Errorf(`Unexpected operation type, got %q instead of %q`, op.kind, OperationQuery)
+	}
+
+	feeds := op.selections[0].(*field)
+	if feeds.name != "feeds" || len(feeds.selections) != 2 {
+		t.Errorf(`Unexpected field: %+v`, feeds)
+	}
+}
+
+func TestParseOperationWithVariablesAndArguments(t *testing.T) {
+	query := `
+		# Fetch unread entries
+		query Unread($limit: Int = 10, $status: [String!]!) {
+			latest: entries(limit: $limit, status: $status, starred: false, order: published_at) {
+				total
+			}
+		}
+	`
+
+	doc, err := parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op := doc.operations[0]
+	if op.name != "Unread" {
+		t.Errorf(`Unexpected operation name, got %q`, op.name)
+	}
+
+	if len(op.variables) != 2 || op.variables[0].defaultValue != int64(10) {
+		t.Errorf(`Unexpected variable definitions: %+v`, op.variables)
+	}
+
+	entries := op.selections[0].(*field)
+	if entries.alias != "latest" || entries.name != "entries" {
+		t.Errorf(`Unexpected alias or name: %q, %q`, entries.alias, entries.name)
+	}
+
+	if entries.arguments["limit"] != variable("limit") {
+		t.Errorf(`Unexpected limit argument: %v`, entries.arguments["limit"])
+	}
+
+	if entries.arguments["starred"] != false {
+		t.Errorf(`Unexpected starred argument: %v`, entries.arguments["starred"])
+	}
+
+	if entries.arguments["order"] != enumValue("published_at") {
+		t.Errorf(`Unexpected order argument: %v`, entries.arguments["order"])
+	}
+}
+
+func TestParseFragments(t *testing.T) {
+	query := `
+		query { feeds { ...FeedFields ... on Feed @include(if: true) { site_url } } }
+		fragment FeedFields on Feed { id title }
+	`
+
+	doc, err := parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := doc.fragments["FeedFields"]; !found {
+		t.Fatal(`The fragment should be defined`)
+	}
+
+	feeds := doc.operations[0].selections[0].(*field)
+	if _, ok := feeds.selections[0].(*fragmentSpread); !ok {
+		t.Errorf(`The first selection should be a fragment spread`)
+	}
+
+	inline, ok := feeds.selections[1].(*inlineFragment)
+	if !ok {
+		t.Fatal(`The second selection should be an inline fragment`)
+	}
+
+	if inline.typeCondition != "Feed" || len(inline.directives) != 1 {
+		t.Errorf(`Unexpected inline fragment: %+v`, inline)
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	doc, err := parse(`mutation { update(ids: [1, 2], ratio: -1.5e2, text: "a\"b", nothing: null, object: {key: "value"}) }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := doc.operations[0].selections[0].(*field).arguments
+	if list := args["ids"].([]value); len(list) != 2 || list[1] != int64(2) {
+		t.Errorf(`Unexpected list value: %v`, args["ids"])
+	}
+
+	if args["ratio"] != float64(-150) {
+		t.Errorf(`Unexpected float value: %v`, args["ratio"])
+	}
+
+	if args["text"] != `a"b` {
+		t.Errorf(`Unexpected string value: %v`, args["text"])
+	}
+
+	if value, found := args["nothing"]; !found || value != nil {
+		t.Errorf(`Unexpected null value: %v`, value)
+	}
+
+	if args["object"].(map[string]value)["key"] != "value" {
+		t.Errorf(`Unexpected object value: %v`, args["object"])
+	}
+}
+
+func TestParseInvalidDocuments(t *testing.T) {
+	queries := []string{
+		``,
+		`{`,
+		`{ }`,
+		`{ feeds { id }`,
+		`query ($id: ) { feed(id: $id) { id } }`,
+		`{ feed(id: "unterminated) { id } }`,
+		`subscription { feeds { id } }`,
+		`{ feed(id: 1..2) { id } }`,
+	}
+
+	for _, query := range queries {
+		if _, err := parse(query); err == nil {
+			t.Errorf(`Parsing %q should return an error`, query)
+		}
+	}
+}
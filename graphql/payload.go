@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+)
+
+type payload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func decodePayload(r *http.Request) (*payload, error) {
+	var p payload
+
+	if r.Method == http.MethodGet {
+		p.Query = request.QueryStringParam(r, "query", "")
+		p.OperationName = request.QueryStringParam(r, "operationName", "")
+		if variables := request.QueryStringParam(r, "variables", ""); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &p.Variables); err != nil {
+				return nil, fmt.Errorf("invalid variables: %v", err)
+			}
+		}
+		return &p, nil
+	}
+
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,379 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package graphql // import "miniflux.app/graphql"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+const (
+	// The schema is cyclic, a category has feeds having a category, the size of the queries is limited.
+	maxQueryDepth      = 10
+	maxQueryComplexity = 5000
+
+	// listSize is the number of items expected from the lists without limit argument, like the feeds of a category.
+	listSize = 10
+
+	defaultEntriesLimit = 100
+)
+
+type contextKey int
+
+const feedCacheContextKey contextKey = iota
+
+// feedCache keeps the feeds of the user during a request, the categories of a query don't load them again.
+type feedCache struct {
+	loaded bool
+	feeds  model.Feeds
+	err    error
+}
+
+// withFeedCache returns a context sharing the feeds of the user between the resolvers of a request.
+func withFeedCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, feedCacheContextKey, &feedCache{})
+}
+
+type entryResultSet struct {
+	Total   int           `json:"total"`
+	Entries model.Entries `json:"entries"`
+}
+
+type resolver struct {
+	store *storage.Storage
+}
+
+// NewSchema returns the Miniflux GraphQL schema.
+func NewSchema(store *storage.Storage) *Schema {
+	r := &resolver{store}
+
+	userType := &Object{Name: "User"}
+	categoryType := &Object{Name: "Category"}
+	feedType := &Object{Name: "Feed"}
+	entryType := &Object{Name: "Entry"}
+	enclosureType := &Object{Name: "Enclosure"}
+	entryResultSetType := &Object{Name: "EntryResultSet"}
+
+	userType.Fields = map[string]*Field{
+		"id":                      {},
+		"username":                {},
+		"is_admin":                {},
+		"theme":                   {},
+		"language":                {},
+		"timezone":                {},
+		"entry_sorting_direction": {},
+		"last_login_at":           {},
+	}
+
+	categoryType.Fields = map[string]*Field{
+		"id":      {},
+		"title":   {},
+		"user_id": {},
+		"feeds":   {Type: feedType, Resolve: r.categoryFeeds, Complexity: listComplexity},
+		"entries": {Type: entryResultSetType, Resolve: r.categoryEntries, Complexity: entriesComplexity},
+	}
+
+	feedType.Fields = map[string]*Field{
+		"id":                    {},
+		"user_id":               {},
+		"feed_url":              {},
+		"site_url":              {},
+		"title":                 {},
+		"checked_at":            {},
+		"parsing_error_message": {},
+		"parsing_error_count":   {},
+		"scraper_rules":         {},
+		"rewrite_rules":         {},
+		"script":                {},
+		"crawler":               {},
+		"user_agent":            {},
+		"username":              {},
+		"category":              {Type: categoryType},
+		"entries":               {Type: entryResultSetType, Resolve: r.feedEntries, Complexity: entriesComplexity},
+	}
+
+	entryType.Fields = map[string]*Field{
+		"id":           {},
+		"user_id":      {},
+		"feed_id":      {},
+		"status":       {},
+		"hash":         {},
+		"title":        {},
+		"url":          {},
+		"comments_url": {},
+		"published_at": {},
+		"changed_at":   {},
+		"content":      {},
+		"author":       {},
+		"starred":      {},
+		"score":        {},
+		"feed":         {Type: feedType},
+		"enclosures":   {Type: enclosureType, Resolve: r.entryEnclosures},
+	}
+
+	enclosureType.Fields = map[string]*Field{
+		"id":        {},
+		"user_id":   {},
+		"entry_id":  {},
+		"url":       {},
+		"mime_type": {},
+		"size":      {},
+	}
+
+	entryResultSetType.Fields = map[string]*Field{
+		"total":   {},
+		"entries": {Type: entryType},
+	}
+
+	return &Schema{
+		Query: &Object{
+			Name: "Query",
+			Fields: map[string]*Field{
+				"me":         {Type: userType, Resolve: r.me},
+				"users":      {Type: userType, Resolve: r.users, Complexity: listComplexity},
+				"categories": {Type: categoryType, Resolve: r.categories, Complexity: listComplexity},
+				"category":   {Type: categoryType, Resolve: r.category},
+				"feeds":      {Type: feedType, Resolve: r.feeds, Complexity: listComplexity},
+				"feed":       {Type: feedType, Resolve: r.feed},
+				"entries":    {Type: entryResultSetType, Resolve: r.entries, Complexity: entriesComplexity},
+				"entry":      {Type: entryType, Resolve: r.entry},
+			},
+		},
+		Mutation: &Object{
+			Name: "Mutation",
+			Fields: map[string]*Field{
+				"update_entries":    {Resolve: r.updateEntries},
+				"toggle_bookmark":   {Type: entryType, Resolve: r.toggleBookmark},
+				"mark_all_as_read":  {Resolve: r.markAllAsRead},
+				"undo_mark_as_read": {Resolve: r.undoMarkAsRead},
+			},
+		},
+		MaxDepth:      maxQueryDepth,
+		MaxComplexity: maxQueryComplexity,
+	}
+}
+
+func listComplexity(p ResolveParams) int {
+	return listSize
+}
+
+// entriesComplexity counts the entries of a page, all the entries are returned without limit.
+func entriesComplexity(p ResolveParams) int {
+	if limit := p.IntArg("limit", defaultEntriesLimit); limit > 0 {
+		return limit
+	}
+	return maxQueryComplexity + 1
+}
+
+func userID(ctx context.Context) int64 {
+	value, _ := ctx.Value(request.UserIDContextKey).(int64)
+	return value
+}
+
+func isAdmin(ctx context.Context) bool {
+	value, _ := ctx.Value(request.IsAdminUserContextKey).(bool)
+	return value
+}
+
+func (r *resolver) me(p ResolveParams) (interface{}, error) {
+	return r.store.UserByID(p.Context, userID(p.Context))
+}
+
+func (r *resolver) users(p ResolveParams) (interface{}, error) {
+	if !isAdmin(p.Context) {
+		return nil, errors.New("access forbidden")
+	}
+	return r.store.Users(p.Context)
+}
+
+func (r *resolver) categories(p ResolveParams) (interface{}, error) {
+	return r.store.Categories(p.Context, userID(p.Context))
+}
+
+func (r *resolver) category(p ResolveParams) (interface{}, error) {
+	return r.store.Category(p.Context, userID(p.Context), p.Int64Arg("id", 0))
+}
+
+func (r *resolver) categoryFeeds(p ResolveParams) (interface{}, error) {
+	category := p.Source.(*model.Category)
+	feeds, err := r.userFeeds(p.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	var results model.Feeds
+	for _, feed := range feeds {
+		if feed.Category != nil && feed.Category.ID == category.ID {
+			results = append(results, feed)
+		}
+	}
+	return results, nil
+}
+
+func (r *resolver) categoryEntries(p ResolveParams) (interface{}, error) {
+	category := p.Source.(*model.Category)
+	builder := r.store.NewEntryQueryBuilder(category.UserID)
+	builder.WithCategoryID(category.ID)
+	return fetchEntries(builder, p)
+}
+
+func (r *resolver) feeds(p ResolveParams) (interface{}, error) {
+	return r.userFeeds(p.Context)
+}
+
+// userFeeds loads the feeds of the user once per request.
+func (r *resolver) userFeeds(ctx context.Context) (model.Feeds, error) {
+	cache, found := ctx.Value(feedCacheContextKey).(*feedCache)
+	if !found {
+		return r.store.Feeds(ctx, userID(ctx))
+	}
+
+	if !cache.loaded {
+		cache.feeds, cache.err = r.store.Feeds(ctx, userID(ctx))
+		cache.loaded = true
+	}
+
+	return cache.feeds, cache.err
+}
+
+func (r *resolver) feed(p ResolveParams) (interface{}, error) {
+	return r.store.FeedByID(p.Context, userID(p.Context), p.Int64Arg("id", 0))
+}
+
+func (r *resolver) feedEntries(p ResolveParams) (interface{}, error) {
+	feed := p.Source.(*model.Feed)
+	builder := r.store.NewEntryQueryBuilder(feed.UserID)
+	builder.WithFeedID(feed.ID)
+	return fetchEntries(builder, p)
+}
+
+func (r *resolver) entries(p ResolveParams) (interface{}, error) {
+	builder := r.store.NewEntryQueryBuilder(userID(p.Context))
+	builder.WithFeedID(p.Int64Arg("feed_id", 0))
+	builder.WithCategoryID(p.Int64Arg("category_id", 0))
+	return fetchEntries(builder, p)
+}
+
+func (r *resolver) entry(p ResolveParams) (interface{}, error) {
+	builder := r.store.NewEntryQueryBuilder(userID(p.Context))
+	builder.WithEntryID(p.Int64Arg("id", 0))
+	return builder.GetEntry(p.Context)
+}
+
+func (r *resolver) entryEnclosures(p ResolveParams) (interface{}, error) {
+	entry := p.Source.(*model.Entry)
+	if entry.Enclosures != nil {
+		return entry.Enclosures, nil
+	}
+	return r.store.GetEnclosures(p.Context, entry.ID)
+}
+
+func (r *resolver) updateEntries(p ResolveParams) (interface{}, error) {
+	status := p.StringArg("status", "")
+	if err := model.ValidateEntryStatus(status); err != nil {
+		return nil, err
+	}
+
+	if err := r.store.SetEntriesStatus(p.Context, userID(p.Context), p.Int64ListArg("entry_ids"), status); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+func (r *resolver) toggleBookmark(p ResolveParams) (interface{}, error) {
+	entryID := p.Int64Arg("entry_id", 0)
+	if err := r.store.ToggleBookmark(p.Context, userID(p.Context), entryID); err != nil {
+		return nil, err
+	}
+
+	builder := r.store.NewEntryQueryBuilder(userID(p.Context))
+	builder.WithEntryID(entryID)
+	return builder.GetEntry(p.Context)
+}
+
+func (r *resolver) markAllAsRead(p ResolveParams) (interface{}, error) {
+	if err := r.store.MarkAllAsRead(p.Context, userID(p.Context)); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+func (r *resolver) undoMarkAsRead(p ResolveParams) (interface{}, error) {
+	return r.store.UndoMarkAsRead(p.Context, userID(p.Context))
+}
+
+// fetchEntries applies the same filters as the REST API entries endpoints.
+func fetchEntries(builder *storage.EntryQueryBuilder, p ResolveParams) (*entryResultSet, error) {
+	statuses := p.StringListArg("status")
+	for _, status := range statuses {
+		if err := model.ValidateEntryStatus(status); err != nil {
+			return nil, err
+		}
+	}
+
+	order := p.StringArg("order", model.DefaultSortingOrder)
+	if err := model.ValidateEntryOrder(order); err != nil {
+		return nil, err
+	}
+
+	direction := p.StringArg("direction", model.DefaultSortingDirection)
+	if err := model.ValidateDirection(direction); err != nil {
+		return nil, err
+	}
+
+	limit := p.IntArg("limit", defaultEntriesLimit)
+	offset := p.IntArg("offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		return nil, err
+	}
+
+	builder.WithStatuses(statuses)
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithSeed(p.Int64Arg("seed", 0))
+	builder.WithSearchQuery(p.StringArg("search", ""))
+
+	if p.HasArg("starred") {
+		if p.BoolArg("starred", false) {
+			builder.WithStarred()
+		} else {
+			builder.WithoutStarred()
+		}
+	}
+
+	if timestamp := p.Int64Arg("published_before", 0); timestamp != 0 {
+		builder.BeforeDate(time.Unix(timestamp, 0))
+	}
+
+	if timestamp := p.Int64Arg("published_after", 0); timestamp != 0 {
+		builder.AfterDate(time.Unix(timestamp, 0))
+	}
+
+	if timestamp := p.Int64Arg("changed_after", 0); timestamp != 0 {
+		builder.ChangedAfter(time.Unix(timestamp, 0))
+	}
+
+	count, err := builder.CountEntries(p.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.WithOffset(offset)
+	builder.WithLimit(limit)
+
+	entries, err := builder.GetEntries(p.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entryResultSet{Total: count, Entries: entries}, nil
+}
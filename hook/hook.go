@@ -0,0 +1,152 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package hook // import "miniflux.app/hook"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// Entry actions.
+const (
+	ActionStarred = "starred"
+	ActionSaved   = "saved"
+)
+
+// Payload is sent to commands on the standard input and to callbacks as request body.
+type Payload struct {
+	Action string       `json:"action"`
+	Entry  *model.Entry `json:"entry"`
+}
+
+// Runner executes the configured hooks in the background.
+// A nil Runner does nothing.
+type Runner struct {
+	command string
+	urls    []string
+	timeout time.Duration
+	client  *http.Client
+}
+
+// New returns a Runner configured from the environment, or nil when no hook is defined.
+func New(cfg *config.Config) *Runner {
+	return NewRunner(cfg.EntryHookCommand(), cfg.EntryHookURLs(), time.Duration(cfg.EntryHookTimeout())*time.Second)
+}
+
+// NewRunner returns a Runner, or nil when there is no command and no URL.
+func NewRunner(command string, urls []string, timeout time.Duration) *Runner {
+	if command == "" && len(urls) == 0 {
+		return nil
+	}
+
+	return &Runner{command: command, urls: urls, timeout: timeout, client: &http.Client{Timeout: timeout}}
+}
+
+// EntryStarred runs the hooks for a starred entry.
+func (r *Runner) EntryStarred(entry *model.Entry) {
+	r.run(ActionStarred, entry)
+}
+
+// EntrySaved runs the hooks for an entry saved to third-party services.
+func (r *Runner) EntrySaved(entry *model.Entry) {
+	r.run(ActionSaved, entry)
+}
+
+func (r *Runner) run(action string, entry *model.Entry) {
+	if r == nil {
+		return
+	}
+
+	go r.dispatch(action, entry)
+}
+
+func (r *Runner) dispatch(action string, entry *model.Entry) {
+	payload, err := json.Marshal(&Payload{Action: action, Entry: entry})
+	if err != nil {
+		logger.Error("[Hook] Unable to encode entry #%d: %v", entry.ID, err)
+		return
+	}
+
+	if r.command != "" {
+		if err := r.execute(action, entry, payload); err != nil {
+			logger.Error("[Hook] Command failed for entry #%d (%s): %v", entry.ID, action, err)
+		}
+	}
+
+	for _, url := range r.urls {
+		if err := r.call(url, action, payload); err != nil {
+			logger.Error("[Hook] Callback %s failed for entry #%d (%s): %v", url, entry.ID, action, err)
+		}
+	}
+}
+
+func (r *Runner) execute(action string, entry *model.Entry, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", r.command)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(),
+		"MINIFLUX_HOOK_ACTION="+action,
+		fmt.Sprintf("MINIFLUX_ENTRY_ID=%d", entry.ID),
+		fmt.Sprintf("MINIFLUX_USER_ID=%d", entry.UserID),
+	)
+
+	// The output goes to a file rather than a pipe, otherwise Wait blocks
+	// until the children started by the shell exit even after the timeout.
+	output, err := ioutil.TempFile("", "miniflux-hook")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(output.Name())
+	defer output.Close()
+
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	if err := cmd.Run(); err != nil {
+		output.Seek(0, io.SeekStart)
+		message, _ := ioutil.ReadAll(io.LimitReader(output, 4096))
+		return fmt.Errorf("%v: %s", err, bytes.TrimSpace(message))
+	}
+
+	logger.Debug("[Hook] Command executed for entry #%d (%s)", entry.ID, action)
+	return nil
+}
+
+func (r *Runner) call(url, action string, payload []byte) error {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Miniflux Hook")
+	request.Header.Set("X-Miniflux-Hook-Action", action)
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("status code %d", response.StatusCode)
+	}
+
+	return nil
+}
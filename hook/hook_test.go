@@ -0,0 +1,109 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package hook // import "miniflux.app/hook"
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestNewRunnerWithoutHooks(t *testing.T) {
+	if r := NewRunner("", nil, time.Second); r != nil {
+		t.Fatal(`The runner should be nil when no hook is configured`)
+	}
+
+	var r *Runner
+	r.EntryStarred(&model.Entry{ID: 1})
+	r.EntrySaved(&model.Entry{ID: 1})
+}
+
+func TestCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "miniflux-hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "output")
+	r := NewRunner(`{ cat; echo; echo "$MINIFLUX_HOOK_ACTION $MINIFLUX_ENTRY_ID"; } > `+output, nil, 5*time.Second)
+	r.dispatch(ActionStarred, &model.Entry{ID: 42, Title: "Test"})
+
+	data, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || lines[1] != "starred 42" {
+		t.Fatalf(`Unexpected command output: %q`, data)
+	}
+
+	var payload Payload
+	if err := json.Unmarshal([]byte(lines[0]), &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	if payload.Action != ActionStarred || payload.Entry.ID != 42 || payload.Entry.Title != "Test" {
+		t.Errorf(`Unexpected payload: %+v`, payload)
+	}
+}
+
+func TestCommandFailure(t *testing.T) {
+	r := NewRunner("echo failure >&2; exit 1", nil, 5*time.Second)
+	err := r.execute(ActionSaved, &model.Entry{ID: 1}, []byte(`{}`))
+	if err == nil || !strings.Contains(err.Error(), "failure") {
+		t.Fatalf(`The error should contain the command output, got %v`, err)
+	}
+}
+
+func TestCommandTimeout(t *testing.T) {
+	r := NewRunner("sleep 5", nil, 100*time.Millisecond)
+	start := time.Now()
+	if err := r.execute(ActionSaved, &model.Entry{ID: 1}, []byte(`{}`)); err == nil {
+		t.Fatal(`The command should be killed after the timeout`)
+	}
+
+	if time.Since(start) > 4*time.Second {
+		t.Fatal(`The timeout has not been applied`)
+	}
+}
+
+func TestCallback(t *testing.T) {
+	var payload Payload
+	var action string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action = r.Header.Get("X-Miniflux-Hook-Action")
+		json.NewDecoder(r.Body).Decode(&payload)
+	}))
+	defer server.Close()
+
+	r := NewRunner("", []string{server.URL}, 5*time.Second)
+	r.dispatch(ActionSaved, &model.Entry{ID: 7, URL: "https://example.org/"})
+
+	if action != ActionSaved || payload.Action != ActionSaved || payload.Entry.ID != 7 || payload.Entry.URL != "https://example.org/" {
+		t.Errorf(`Unexpected callback request: %q %+v`, action, payload)
+	}
+}
+
+func TestCallbackError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	r := NewRunner("", []string{server.URL}, 5*time.Second)
+	if err := r.call(server.URL, ActionSaved, []byte(`{}`)); err == nil {
+		t.Fatal(`A server error should be reported`)
+	}
+}
@@ -84,8 +84,18 @@ func (c *Client) WithUserAgent(userAgent string) *Client {
 	return c
 }
 
-// Get execute a GET HTTP request.
+// Get execute a GET HTTP request, gemini:// and gopher:// URLs are downloaded with their own protocol
+// and file:// URLs are read from the allowed local directories.
 func (c *Client) Get() (*Response, error) {
+	switch {
+	case strings.HasPrefix(c.url, "gemini://"):
+		return c.executeGeminiRequest()
+	case strings.HasPrefix(c.url, "gopher://"):
+		return c.executeGopherRequest()
+	case strings.HasPrefix(c.url, "file://"):
+		return c.executeFileRequest()
+	}
+
 	request, err := c.buildRequest(http.MethodGet, nil)
 	if err != nil {
 		return nil, err
@@ -124,6 +134,10 @@ func (c *Client) PostJSON(data interface{}) (*Response, error) {
 func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[HttpClient] url=%s", c.url))
 
+	if isOnionHost(request.URL.Hostname()) && torProxy() == "" {
+		return nil, errors.NewLocalizedError(errTorNotConfigured)
+	}
+
 	client := c.buildClient()
 	resp, err := client.Do(request)
 	if resp != nil {
@@ -196,6 +210,19 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	return response, err
 }
 
+// networkError returns the localized error of a failed connection.
+func networkError(err error) error {
+	if lerr, ok := err.(*errors.LocalizedError); ok {
+		return lerr
+	}
+
+	if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+		return errors.NewLocalizedError(errRequestTimeout, requestTimeout)
+	}
+
+	return errors.NewLocalizedError(errTemporaryNetworkOperation, err)
+}
+
 func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, error) {
 	request, err := http.NewRequest(method, c.url, body)
 	if err != nil {
@@ -213,15 +240,27 @@ func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, err
 
 func (c *Client) buildClient() http.Client {
 	client := http.Client{Timeout: time.Duration(requestTimeout * time.Second)}
-	if c.Insecure {
-		client.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	if c.Insecure || isOnionHost(c.hostname()) {
+		transport := &http.Transport{Dial: dial}
+		if c.Insecure {
+			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 		}
+
+		client.Transport = transport
 	}
 
 	return client
 }
 
+func (c *Client) hostname() string {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
 func (c *Client) buildHeaders() http.Header {
 	headers := make(http.Header)
 	headers.Add("User-Agent", c.userAgent)
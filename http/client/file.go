@@ -0,0 +1,165 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/errors"
+	"miniflux.app/timer"
+)
+
+var errLocalPathNotAllowed = "The local path %q is not in the allowed directories"
+
+// Extensions of the files considered as feeds in a watched directory.
+var localFeedExtensions = []string{".xml", ".rss", ".atom", ".rdf", ".json"}
+
+var (
+	localMutex       sync.RWMutex
+	localDirectories []string
+)
+
+// SetLocalDirectories defines the directories where file:// feeds can be read, file:// URLs are refused when the list is empty.
+func SetLocalDirectories(directories []string) {
+	var allowed []string
+	for _, directory := range directories {
+		if resolved, err := filepath.EvalSymlinks(directory); err == nil {
+			directory = resolved
+		}
+
+		allowed = append(allowed, filepath.Clean(directory))
+	}
+
+	localMutex.Lock()
+	localDirectories = allowed
+	localMutex.Unlock()
+}
+
+func isAllowedLocalPath(path string) bool {
+	localMutex.RLock()
+	defer localMutex.RUnlock()
+
+	for _, directory := range localDirectories {
+		rel, err := filepath.Rel(directory, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeFileRequest reads a file:// URL. When the URL is a directory, the most recent feed file of the directory is read,
+// generated feeds can be dropped there without changing the subscription.
+// The modification time is returned as Last-Modified header, unchanged files are not parsed again.
+func (c *Client) executeFileRequest() (*Response, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[FileClient] url=%s", c.url))
+
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Host != "" && u.Host != "localhost" {
+		return nil, fmt.Errorf("client: remote file URLs are not supported (%s)", c.url)
+	}
+
+	// The path is checked before and after resolving the symbolic links,
+	// the existence of files outside of the allowed directories is not revealed.
+	path := filepath.Clean(u.Path)
+	if !isAllowedLocalPath(path) {
+		return nil, errors.NewLocalizedError(errLocalPathNotAllowed, path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if os.IsNotExist(err) {
+		return &Response{Body: bytes.NewReader(nil), StatusCode: 404, EffectiveURL: c.url}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("client: unable to open %q: %v", path, err)
+	}
+
+	if !isAllowedLocalPath(resolved) {
+		return nil, errors.NewLocalizedError(errLocalPathNotAllowed, path)
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("client: unable to open %q: %v", path, err)
+	}
+
+	if info.IsDir() {
+		if info, err = latestFeedFile(resolved); err != nil {
+			return nil, fmt.Errorf("client: unable to read directory %q: %v", path, err)
+		}
+
+		if info == nil {
+			return &Response{Body: bytes.NewReader(nil), StatusCode: 404, EffectiveURL: c.url}, nil
+		}
+
+		resolved = filepath.Join(resolved, info.Name())
+	}
+
+	if info.Size() > maxBodySize {
+		return nil, fmt.Errorf("client: file too large (%d bytes)", info.Size())
+	}
+
+	data, err := ioutil.ReadFile(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("client: unable to read %q: %v", path, err)
+	}
+
+	return &Response{
+		Body:          bytes.NewReader(data),
+		StatusCode:    200,
+		EffectiveURL:  c.url,
+		LastModified:  info.ModTime().UTC().Format(http.TimeFormat),
+		ContentType:   mime.TypeByExtension(filepath.Ext(resolved)),
+		ContentLength: int64(len(data)),
+	}, nil
+}
+
+// latestFeedFile returns the most recently modified feed file of the directory, hidden files are ignored.
+func latestFeedFile(directory string) (os.FileInfo, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest os.FileInfo
+	for _, file := range files {
+		if !file.Mode().IsRegular() || strings.HasPrefix(file.Name(), ".") || !isLocalFeedFile(file.Name()) {
+			continue
+		}
+
+		if latest == nil || !file.ModTime().Before(latest.ModTime()) {
+			latest = file
+		}
+	}
+
+	return latest, nil
+}
+
+func isLocalFeedFile(name string) bool {
+	extension := strings.ToLower(filepath.Ext(name))
+	for _, feedExtension := range localFeedExtensions {
+		if extension == feedExtension {
+			return true
+		}
+	}
+
+	return false
+}
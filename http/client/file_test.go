@@ -0,0 +1,140 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"miniflux.app/errors"
+)
+
+func newLocalDirectory(t *testing.T, files map[string]string) string {
+	directory, err := ioutil.TempDir("", "miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, content := range files {
+		filename := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return directory
+}
+
+func TestFileRequest(t *testing.T) {
+	directory := newLocalDirectory(t, map[string]string{"feeds/feed.xml": "<rss></rss>"})
+	defer os.RemoveAll(directory)
+
+	SetLocalDirectories([]string{filepath.Join(directory, "feeds")})
+	defer SetLocalDirectories(nil)
+
+	response, err := New("file://" + filepath.Join(directory, "feeds", "feed.xml")).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body, _ := ioutil.ReadAll(response.Body); string(body) != "<rss></rss>" {
+		t.Errorf(`Unexpected body %q`, body)
+	}
+
+	if response.StatusCode != 200 || response.LastModified == "" {
+		t.Errorf(`Unexpected response: %d %q`, response.StatusCode, response.LastModified)
+	}
+
+	if response.IsModified("", response.LastModified) {
+		t.Error(`An unchanged file should not be considered as modified`)
+	}
+
+	response, err = New("file://" + filepath.Join(directory, "feeds", "missing.xml")).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !response.IsNotFound() {
+		t.Errorf(`A missing file should be reported as not found, got %d`, response.StatusCode)
+	}
+}
+
+func TestFileRequestOutsideOfAllowedDirectories(t *testing.T) {
+	directory := newLocalDirectory(t, map[string]string{"feeds/feed.xml": "<rss></rss>", "secret.xml": "<rss></rss>"})
+	defer os.RemoveAll(directory)
+
+	if err := os.Symlink(filepath.Join(directory, "secret.xml"), filepath.Join(directory, "feeds", "link.xml")); err != nil {
+		t.Fatal(err)
+	}
+
+	SetLocalDirectories([]string{filepath.Join(directory, "feeds")})
+	defer SetLocalDirectories(nil)
+
+	for _, path := range []string{"secret.xml", "feeds/../secret.xml", "feeds/link.xml", "missing.xml"} {
+		_, err := New("file://" + filepath.Join(directory, path)).Get()
+		if _, ok := err.(*errors.LocalizedError); !ok {
+			t.Errorf(`The path %q should not be allowed, got %v`, path, err)
+		}
+	}
+
+	SetLocalDirectories(nil)
+	if _, err := New("file://" + filepath.Join(directory, "feeds", "feed.xml")).Get(); err == nil {
+		t.Error(`Local files should not be allowed without allowed directories`)
+	}
+}
+
+func TestFileRequestWithDirectory(t *testing.T) {
+	directory := newLocalDirectory(t, map[string]string{
+		"old.xml":    "<rss>old</rss>",
+		"new.json":   `{"version": "https://jsonfeed.org/version/1"}`,
+		".draft.xml": "<rss>draft</rss>",
+		"notes.txt":  "notes",
+	})
+	defer os.RemoveAll(directory)
+
+	now := time.Now()
+	os.Chtimes(filepath.Join(directory, "old.xml"), now.Add(-time.Hour), now.Add(-time.Hour))
+	os.Chtimes(filepath.Join(directory, "new.json"), now.Add(-time.Minute), now.Add(-time.Minute))
+
+	SetLocalDirectories([]string{directory})
+	defer SetLocalDirectories(nil)
+
+	response, err := New("file://" + directory).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body, _ := ioutil.ReadAll(response.Body); string(body) != `{"version": "https://jsonfeed.org/version/1"}` {
+		t.Errorf(`The most recent feed file should be read, got %q`, body)
+	}
+
+	if response.ContentType != "application/json" || response.EffectiveURL != "file://"+directory {
+		t.Errorf(`Unexpected response: %q %q`, response.ContentType, response.EffectiveURL)
+	}
+}
+
+func TestFileRequestWithEmptyDirectory(t *testing.T) {
+	directory := newLocalDirectory(t, map[string]string{"notes.txt": "notes"})
+	defer os.RemoveAll(directory)
+
+	SetLocalDirectories([]string{directory})
+	defer SetLocalDirectories(nil)
+
+	response, err := New("file://" + directory).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !response.IsNotFound() {
+		t.Errorf(`A directory without feed should be reported as not found, got %d`, response.StatusCode)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/errors"
+	"miniflux.app/timer"
+)
+
+const (
+	geminiDefaultPort   = "1965"
+	geminiMaxRedirects  = 5
+	geminiDefaultType   = "text/gemini; charset=utf-8"
+	geminiMaxHeaderSize = 1029 // Status, space, 1024 bytes of meta and CRLF.
+)
+
+var errCertificateChanged = "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)"
+
+// CertificateStore keeps the fingerprints of the certificates trusted on first use for Gemini capsules.
+// An empty fingerprint is returned for unknown hosts.
+type CertificateStore interface {
+	GeminiCertificate(ctx context.Context, host string) (fingerprint string, expiresAt time.Time, err error)
+	TrustGeminiCertificate(ctx context.Context, host, fingerprint string, expiresAt time.Time) error
+}
+
+var (
+	certificateMutex sync.RWMutex
+	certificateStore CertificateStore = newMemoryCertificateStore()
+)
+
+// SetCertificateStore defines where the certificates of Gemini capsules are kept, they are kept in memory by default.
+func SetCertificateStore(store CertificateStore) {
+	certificateMutex.Lock()
+	certificateStore = store
+	certificateMutex.Unlock()
+}
+
+// executeGeminiRequest downloads a gemini:// URL and follows the redirects.
+// The Gemini status codes are translated to their HTTP equivalent for the callers.
+func (c *Client) executeGeminiRequest() (*Response, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[GeminiClient] url=%s", c.url))
+
+	location := c.url
+	for redirects := 0; ; redirects++ {
+		u, err := url.Parse(location)
+		if err != nil {
+			return nil, err
+		}
+
+		if u.Scheme != "gemini" {
+			return nil, fmt.Errorf("client: redirection to a non-Gemini URL %q", location)
+		}
+
+		u.Fragment = ""
+		status, meta, body, err := geminiRequest(u)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status / 10 {
+		case 2:
+			if meta == "" {
+				meta = geminiDefaultType
+			}
+
+			return &Response{
+				Body:          bytes.NewReader(body),
+				StatusCode:    200,
+				EffectiveURL:  u.String(),
+				ContentType:   meta,
+				ContentLength: int64(len(body)),
+			}, nil
+		case 3:
+			if redirects == geminiMaxRedirects {
+				return nil, fmt.Errorf("client: too many redirects for %q", c.url)
+			}
+
+			next, err := u.Parse(meta)
+			if err != nil {
+				return nil, fmt.Errorf("client: invalid redirection %q: %v", meta, err)
+			}
+
+			location = next.String()
+		default:
+			return &Response{
+				Body:         bytes.NewReader(nil),
+				StatusCode:   geminiStatusCode(status),
+				EffectiveURL: u.String(),
+			}, nil
+		}
+	}
+}
+
+func geminiRequest(u *url.URL) (int, string, []byte, error) {
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), geminiDefaultPort)
+	}
+
+	rawConn, err := dial("tcp", host)
+	if err != nil {
+		return 0, "", nil, networkError(err)
+	}
+
+	// Capsules use self-signed certificates, they are verified with trust on first use instead of a CA.
+	conn := tls.Client(rawConn, &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+	})
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(requestTimeout * time.Second))
+	if err := conn.Handshake(); err != nil {
+		return 0, "", nil, networkError(err)
+	}
+
+	if err := verifyGeminiCertificate(host, conn.ConnectionState()); err != nil {
+		return 0, "", nil, err
+	}
+
+	if _, err := conn.Write([]byte(u.String() + "\r\n")); err != nil {
+		return 0, "", nil, networkError(err)
+	}
+
+	reader := bufio.NewReaderSize(conn, geminiMaxHeaderSize)
+	line, err := reader.ReadSlice('\n')
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("client: invalid Gemini response header: %v", err)
+	}
+
+	header := strings.TrimRight(string(line), "\r\n")
+	if len(header) < 2 {
+		return 0, "", nil, fmt.Errorf("client: invalid Gemini response header %q", header)
+	}
+
+	status, err := strconv.Atoi(header[:2])
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("client: invalid Gemini status %q", header[:2])
+	}
+
+	meta := strings.TrimSpace(header[2:])
+	if status/10 != 2 {
+		return status, meta, nil, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(reader, maxBodySize+1))
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("client: error while reading body %v", err)
+	}
+
+	if len(body) > maxBodySize {
+		return 0, "", nil, fmt.Errorf("client: response too large (more than %d bytes)", maxBodySize)
+	}
+
+	return status, meta, body, nil
+}
+
+// verifyGeminiCertificate trusts the certificate seen the first time a host is contacted,
+// a different certificate is only accepted once the trusted one has expired.
+func verifyGeminiCertificate(host string, state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.NewLocalizedError(errInvalidCertificate, "no certificate")
+	}
+
+	certificate := state.PeerCertificates[0]
+	if time.Now().After(certificate.NotAfter) {
+		return errors.NewLocalizedError(errInvalidCertificate, "certificate expired")
+	}
+
+	checksum := sha256.Sum256(certificate.Raw)
+	fingerprint := hex.EncodeToString(checksum[:])
+
+	certificateMutex.RLock()
+	store := certificateStore
+	certificateMutex.RUnlock()
+
+	ctx := context.Background()
+	known, expiresAt, err := store.GeminiCertificate(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	if known == fingerprint {
+		return nil
+	}
+
+	if known != "" && time.Now().Before(expiresAt) {
+		return errors.NewLocalizedError(errCertificateChanged, fingerprint)
+	}
+
+	return store.TrustGeminiCertificate(ctx, host, fingerprint, certificate.NotAfter)
+}
+
+func geminiStatusCode(status int) int {
+	switch status {
+	case 44:
+		return 429
+	case 51:
+		return 404
+	case 52:
+		return 410
+	case 59:
+		return 400
+	}
+
+	switch status / 10 {
+	case 1:
+		// Feeds can't ask for an input.
+		return 400
+	case 4:
+		return 503
+	case 6:
+		return 401
+	default:
+		return 500
+	}
+}
+
+type memoryCertificate struct {
+	fingerprint string
+	expiresAt   time.Time
+}
+
+type memoryCertificateStore struct {
+	mutex        sync.Mutex
+	certificates map[string]memoryCertificate
+}
+
+func newMemoryCertificateStore() *memoryCertificateStore {
+	return &memoryCertificateStore{certificates: make(map[string]memoryCertificate)}
+}
+
+func (m *memoryCertificateStore) GeminiCertificate(ctx context.Context, host string) (string, time.Time, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	certificate := m.certificates[host]
+	return certificate.fingerprint, certificate.expiresAt, nil
+}
+
+func (m *memoryCertificateStore) TrustGeminiCertificate(ctx context.Context, host, fingerprint string, expiresAt time.Time) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.certificates[host] = memoryCertificate{fingerprint, expiresAt}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newSelfSignedCertificate(t *testing.T, notAfter time.Time) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"localhost"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// newGeminiServer answers the requests with the response of the requested path.
+func newGeminiServer(t *testing.T, responses map[string]string) (net.Listener, string) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{newSelfSignedCertificate(t, time.Now().Add(time.Hour))},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			line, _ := bufio.NewReader(conn).ReadString('\n')
+			path := strings.TrimPrefix(strings.TrimSpace(line), "gemini://"+listener.Addr().String())
+			if response, found := responses[path]; found {
+				conn.Write([]byte(response))
+			} else {
+				conn.Write([]byte("51 Not found\r\n"))
+			}
+			conn.Close()
+		}
+	}()
+
+	return listener, "gemini://" + listener.Addr().String()
+}
+
+func TestGeminiRequest(t *testing.T) {
+	SetCertificateStore(newMemoryCertificateStore())
+
+	listener, baseURL := newGeminiServer(t, map[string]string{
+		"/old.gmi":  "31 /feed.gmi\r\n",
+		"/feed.gmi": "20 text/gemini\r\n# My Capsule\n",
+	})
+	defer listener.Close()
+
+	response, err := New(baseURL + "/old.gmi").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.StatusCode != 200 || response.EffectiveURL != baseURL+"/feed.gmi" || response.ContentType != "text/gemini" {
+		t.Fatalf(`Unexpected response: %d %q %q`, response.StatusCode, response.EffectiveURL, response.ContentType)
+	}
+
+	if body, _ := ioutil.ReadAll(response.Body); string(body) != "# My Capsule\n" {
+		t.Errorf(`Unexpected body %q`, body)
+	}
+
+	response, err = New(baseURL + "/missing.gmi").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !response.IsNotFound() {
+		t.Errorf(`A missing page should be reported as not found, got %d`, response.StatusCode)
+	}
+}
+
+func TestVerifyGeminiCertificate(t *testing.T) {
+	SetCertificateStore(newMemoryCertificateStore())
+
+	first, _ := x509.ParseCertificate(newSelfSignedCertificate(t, time.Now().Add(time.Hour)).Certificate[0])
+	second, _ := x509.ParseCertificate(newSelfSignedCertificate(t, time.Now().Add(time.Hour)).Certificate[0])
+
+	if err := verifyGeminiCertificate("example.org:1965", tls.ConnectionState{PeerCertificates: []*x509.Certificate{first}}); err != nil {
+		t.Fatalf(`The certificate should be trusted on first use: %v`, err)
+	}
+
+	if err := verifyGeminiCertificate("example.org:1965", tls.ConnectionState{PeerCertificates: []*x509.Certificate{first}}); err != nil {
+		t.Fatalf(`The trusted certificate should be accepted: %v`, err)
+	}
+
+	if err := verifyGeminiCertificate("example.org:1965", tls.ConnectionState{PeerCertificates: []*x509.Certificate{second}}); err == nil {
+		t.Fatal(`A different certificate should be rejected while the trusted one is valid`)
+	}
+
+	if err := verifyGeminiCertificate("example.com:1965", tls.ConnectionState{PeerCertificates: []*x509.Certificate{second}}); err != nil {
+		t.Fatalf(`The certificate of another host should be trusted: %v`, err)
+	}
+}
+
+func TestVerifyGeminiCertificateAfterExpiration(t *testing.T) {
+	store := newMemoryCertificateStore()
+	store.TrustGeminiCertificate(context.Background(), "example.org:1965", "expired", time.Now().Add(-time.Minute))
+	SetCertificateStore(store)
+
+	certificate, _ := x509.ParseCertificate(newSelfSignedCertificate(t, time.Now().Add(time.Hour)).Certificate[0])
+	if err := verifyGeminiCertificate("example.org:1965", tls.ConnectionState{PeerCertificates: []*x509.Certificate{certificate}}); err != nil {
+		t.Fatalf(`A new certificate should replace an expired one: %v`, err)
+	}
+}
+
+func TestGeminiStatusCode(t *testing.T) {
+	scenarios := map[int]int{10: 400, 41: 503, 44: 429, 51: 404, 52: 410, 50: 500, 60: 401}
+
+	for status, expected := range scenarios {
+		if result := geminiStatusCode(status); result != expected {
+			t.Errorf(`Unexpected HTTP status code for %d, got %d instead of %d`, status, result, expected)
+		}
+	}
+}
+
+func TestGopherRequest(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		if line == "/feed.xml\r\n" {
+			conn.Write([]byte("<rss></rss>\r\n.\r\n"))
+		}
+	}()
+
+	response, err := New("gopher://" + listener.Addr().String() + "/0/feed.xml").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body, _ := ioutil.ReadAll(response.Body); string(body) != "<rss></rss>\r\n" {
+		t.Errorf(`Unexpected body %q`, body)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"time"
+
+	"miniflux.app/timer"
+)
+
+const gopherDefaultPort = "70"
+
+// executeGopherRequest downloads a gopher:// URL, the path starts with the item type followed by the selector.
+func (c *Client) executeGopherRequest() (*Response, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[GopherClient] url=%s", c.url))
+
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return nil, err
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), gopherDefaultPort)
+	}
+
+	// The first character of the path is the item type, it's not sent to the server.
+	selector := u.Path
+	if len(selector) > 1 {
+		selector = selector[2:]
+	} else {
+		selector = ""
+	}
+
+	conn, err := dial("tcp", host)
+	if err != nil {
+		return nil, networkError(err)
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(requestTimeout * time.Second))
+
+	if _, err := conn.Write([]byte(selector + "\r\n")); err != nil {
+		return nil, networkError(err)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(conn, maxBodySize+1))
+	if err != nil {
+		return nil, networkError(err)
+	}
+
+	if len(body) > maxBodySize {
+		return nil, fmt.Errorf("client: response too large (more than %d bytes)", maxBodySize)
+	}
+
+	// Text items end with a line containing a single dot.
+	if bytes.HasSuffix(body, []byte("\n.\r\n")) {
+		body = body[:len(body)-3]
+	}
+
+	return &Response{
+		Body:          bytes.NewReader(body),
+		StatusCode:    200,
+		EffectiveURL:  c.url,
+		ContentLength: int64(len(body)),
+	}, nil
+}
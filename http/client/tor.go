@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/errors"
+
+	"golang.org/x/net/proxy"
+)
+
+var errTorNotConfigured = "A Tor proxy is required to fetch the .onion websites"
+
+var (
+	torMutex        sync.RWMutex
+	torProxyAddress string
+)
+
+// SetTorProxy defines the address of the Tor SOCKS proxy used to fetch the .onion websites, for example 127.0.0.1:9050.
+// The .onion websites can't be fetched when the address is empty.
+func SetTorProxy(address string) {
+	torMutex.Lock()
+	torProxyAddress = address
+	torMutex.Unlock()
+}
+
+func torProxy() string {
+	torMutex.RLock()
+	defer torMutex.RUnlock()
+	return torProxyAddress
+}
+
+func isOnionHost(host string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(strings.ToLower(host), "."), ".onion")
+}
+
+// dial opens a connection to the address, the .onion addresses are reached through the Tor proxy.
+// The hostname is resolved by the proxy, it's never sent to the local resolver.
+func dial(network, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: requestTimeout * time.Second}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	if !isOnionHost(host) {
+		return dialer.Dial(network, address)
+	}
+
+	proxyAddress := torProxy()
+	if proxyAddress == "" {
+		return nil, errors.NewLocalizedError(errTorNotConfigured)
+	}
+
+	socks, err := proxy.SOCKS5("tcp", proxyAddress, nil, dialer)
+	if err != nil {
+		return nil, err
+	}
+
+	return socks.Dial(network, address)
+}
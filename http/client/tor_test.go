@@ -0,0 +1,99 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+
+	"miniflux.app/errors"
+)
+
+// newSOCKSServer answers a single SOCKS5 request with the HTTP response, the requested hostname is sent to the channel.
+func newSOCKSServer(t *testing.T, body string, hosts chan<- string) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Greeting: version, number of methods and methods, no authentication is selected.
+		greeting := make([]byte, 3)
+		io.ReadFull(conn, greeting)
+		conn.Write([]byte{5, 0})
+
+		// Request: version, command, reserved, address type, hostname length, hostname and port.
+		request := make([]byte, 5)
+		io.ReadFull(conn, request)
+		host := make([]byte, int(request[4])+2)
+		io.ReadFull(conn, host)
+		hosts <- string(host[:len(host)-2])
+		conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+
+		http.ReadRequest(bufio.NewReader(conn))
+		conn.Write([]byte("HTTP/1.1 200 OK\r\nContent-Type: application/rss+xml\r\nConnection: close\r\n\r\n" + body))
+	}()
+
+	return listener
+}
+
+func TestIsOnionHost(t *testing.T) {
+	scenarios := map[string]bool{
+		"example.onion":      true,
+		"www.Example.ONION.": true,
+		"onion":              false,
+		"example.org":        false,
+		"onion.example.org":  false,
+	}
+
+	for host, expected := range scenarios {
+		if result := isOnionHost(host); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, host, result, expected)
+		}
+	}
+}
+
+func TestOnionRequestThroughTorProxy(t *testing.T) {
+	hosts := make(chan string, 1)
+	listener := newSOCKSServer(t, "<rss></rss>", hosts)
+	defer listener.Close()
+
+	SetTorProxy(listener.Addr().String())
+	defer SetTorProxy("")
+
+	response, err := New("http://example.onion/feed.xml").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if host := <-hosts; host != "example.onion" {
+		t.Errorf(`The hostname should be resolved by the proxy, got %q`, host)
+	}
+
+	if body, _ := ioutil.ReadAll(response.Body); string(body) != "<rss></rss>" {
+		t.Errorf(`Unexpected body %q`, body)
+	}
+}
+
+func TestOnionRequestWithoutTorProxy(t *testing.T) {
+	SetTorProxy("")
+
+	for _, feedURL := range []string{"http://example.onion/feed.xml", "gopher://example.onion/0/feed.xml", "gemini://example.onion/"} {
+		_, err := New(feedURL).Get()
+		if _, ok := err.(*errors.LocalizedError); !ok || err.Error() != errTorNotConfigured {
+			t.Errorf(`Unexpected error for %q: %v`, feedURL, err)
+		}
+	}
+}
@@ -25,6 +25,9 @@ const (
 	FlashErrorMessageContextKey
 	PocketRequestTokenContextKey
 	ClientIPContextKey
+	CorrelationIDContextKey
+	ServiceAccountScopesContextKey
+	ServiceAccountCategoryIDsContextKey
 )
 
 // IsAdminUser checks if the logged user is administrator.
@@ -109,6 +112,34 @@ func ClientIP(r *http.Request) string {
 	return getContextStringValue(r, ClientIPContextKey)
 }
 
+// CorrelationID returns the identifier of the request sent in error reports.
+func CorrelationID(r *http.Request) string {
+	return getContextStringValue(r, CorrelationIDContextKey)
+}
+
+// IsServiceAccount returns true if the request is authenticated with the API key of a service account.
+func IsServiceAccount(r *http.Request) bool {
+	return r.Context().Value(ServiceAccountScopesContextKey) != nil
+}
+
+// ServiceAccountScopes returns the scopes granted to the service account.
+func ServiceAccountScopes(r *http.Request) []string {
+	if v, valid := r.Context().Value(ServiceAccountScopesContextKey).([]string); valid {
+		return v
+	}
+
+	return nil
+}
+
+// ServiceAccountCategoryIDs returns the categories the service account is restricted to, all categories are allowed if empty.
+func ServiceAccountCategoryIDs(r *http.Request) []int64 {
+	if v, valid := r.Context().Value(ServiceAccountCategoryIDsContextKey).([]int64); valid {
+		return v
+	}
+
+	return nil
+}
+
 func getContextStringValue(r *http.Request, key ContextKey) string {
 	if v := r.Context().Value(key); v != nil {
 		value, valid := v.(string)
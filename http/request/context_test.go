@@ -434,3 +434,50 @@ func TestClientIP(t *testing.T) {
 		t.Errorf(`Unexpected context value, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestCorrelationID(t *testing.T) {
+	r, _ := http.NewRequest("GET", "http://example.org", nil)
+
+	result := CorrelationID(r)
+	expected := ""
+
+	if result != expected {
+		t.Errorf(`Unexpected context value, got %q instead of %q`, result, expected)
+	}
+
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, CorrelationIDContextKey, "abc")
+	r = r.WithContext(ctx)
+
+	result = CorrelationID(r)
+	expected = "abc"
+
+	if result != expected {
+		t.Errorf(`Unexpected context value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestServiceAccount(t *testing.T) {
+	r, _ := http.NewRequest("GET", "http://example.org", nil)
+
+	if IsServiceAccount(r) {
+		t.Error(`A request without service account should not be authenticated as a service account`)
+	}
+
+	ctx := r.Context()
+	ctx = context.WithValue(ctx, ServiceAccountScopesContextKey, []string{"entries:read"})
+	ctx = context.WithValue(ctx, ServiceAccountCategoryIDsContextKey, []int64{1})
+	r = r.WithContext(ctx)
+
+	if !IsServiceAccount(r) {
+		t.Error(`The request should be authenticated as a service account`)
+	}
+
+	if scopes := ServiceAccountScopes(r); len(scopes) != 1 || scopes[0] != "entries:read" {
+		t.Errorf(`Unexpected scopes, got %v`, scopes)
+	}
+
+	if categoryIDs := ServiceAccountCategoryIDs(r); len(categoryIDs) != 1 || categoryIDs[0] != 1 {
+		t.Errorf(`Unexpected categories, got %v`, categoryIDs)
+	}
+}
@@ -7,6 +7,7 @@ package request // import "miniflux.app/http/request"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 )
@@ -22,6 +23,21 @@ func FormInt64Value(r *http.Request, param string) int64 {
 	return integer
 }
 
+// FormInt64Values returns all positive integer values of a form parameter, invalid values are ignored.
+func FormInt64Values(r *http.Request, param string) []int64 {
+	r.ParseForm()
+
+	var results []int64
+	for _, value := range r.Form[param] {
+		integer, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && integer > 0 {
+			results = append(results, integer)
+		}
+	}
+
+	return results
+}
+
 // RouteInt64Param returns an URL route parameter as int64.
 func RouteInt64Param(r *http.Request, param string) int64 {
 	vars := mux.Vars(r)
@@ -52,6 +68,21 @@ func QueryStringParam(r *http.Request, param, defaultValue string) string {
 	return value
 }
 
+// QueryStringParamList returns all values of a query string parameter,
+// the parameter can be repeated or contains comma-separated values.
+func QueryStringParamList(r *http.Request, param string) []string {
+	var results []string
+	for _, value := range r.URL.Query()[param] {
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				results = append(results, item)
+			}
+		}
+	}
+	return results
+}
+
 // QueryIntParam returns a query string parameter as integer.
 func QueryIntParam(r *http.Request, param string, defaultValue int) int {
 	return int(QueryInt64Param(r, param, int64(defaultValue)))
@@ -76,6 +107,21 @@ func QueryInt64Param(r *http.Request, param string, defaultValue int64) int64 {
 	return val
 }
 
+// QueryFloat64Param returns a query string parameter as float64.
+func QueryFloat64Param(r *http.Request, param string, defaultValue float64) float64 {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return defaultValue
+	}
+
+	val, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return val
+}
+
 // HasQueryParam checks if the query string contains the given parameter.
 func HasQueryParam(r *http.Request, param string) bool {
 	values := r.URL.Query()
@@ -42,6 +42,26 @@ func TestFormInt64Value(t *testing.T) {
 	}
 }
 
+func TestFormInt64Values(t *testing.T) {
+	f := url.Values{}
+	f.Add("entry_id", "1")
+	f.Add("entry_id", "invalid")
+	f.Add("entry_id", "-3")
+	f.Add("entry_id", "42")
+
+	r := &http.Request{Form: f}
+
+	result := FormInt64Values(r, "entry_id")
+	if len(result) != 2 || result[0] != 1 || result[1] != 42 {
+		t.Errorf(`Unexpected result, got %v`, result)
+	}
+
+	result = FormInt64Values(r, "missing value")
+	if len(result) != 0 {
+		t.Errorf(`Unexpected result, got %v`, result)
+	}
+}
+
 func TestRouteStringParam(t *testing.T) {
 	router := mux.NewRouter()
 	router.HandleFunc("/route/{variable}/index", func(w http.ResponseWriter, r *http.Request) {
@@ -129,6 +149,32 @@ func TestQueryStringParam(t *testing.T) {
 	}
 }
 
+func TestQueryStringParamList(t *testing.T) {
+	u, _ := url.Parse("http://example.org/?key=a,b&key=c&empty=")
+	r := &http.Request{URL: u}
+
+	result := QueryStringParamList(r, "key")
+	expected := []string{"a", "b", "c"}
+
+	if len(result) != len(expected) {
+		t.Fatalf(`Unexpected result, got %v instead of %v`, result, expected)
+	}
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf(`Unexpected result, got %v instead of %v`, result, expected)
+		}
+	}
+
+	if result := QueryStringParamList(r, "empty"); len(result) != 0 {
+		t.Errorf(`Unexpected result, got %v instead of an empty list`, result)
+	}
+
+	if result := QueryStringParamList(r, "missing key"); len(result) != 0 {
+		t.Errorf(`Unexpected result, got %v instead of an empty list`, result)
+	}
+}
+
 func TestQueryIntParam(t *testing.T) {
 	u, _ := url.Parse("http://example.org/?key=42&invalid=value&negative=-5")
 	r := &http.Request{URL: u}
@@ -195,6 +241,27 @@ func TestQueryInt64Param(t *testing.T) {
 	}
 }
 
+func TestQueryFloat64Param(t *testing.T) {
+	u, _ := url.Parse("http://example.org/?key=0.5&invalid=value&negative=-2.5")
+	r := &http.Request{URL: u}
+
+	if result := QueryFloat64Param(r, "key", 1); result != 0.5 {
+		t.Errorf(`Unexpected result, got %v instead of 0.5`, result)
+	}
+
+	if result := QueryFloat64Param(r, "missing key", 1); result != 1 {
+		t.Errorf(`Unexpected result, got %v instead of 1`, result)
+	}
+
+	if result := QueryFloat64Param(r, "invalid", 1); result != 1 {
+		t.Errorf(`Unexpected result, got %v instead of 1`, result)
+	}
+
+	if result := QueryFloat64Param(r, "negative", 1); result != -2.5 {
+		t.Errorf(`Unexpected result, got %v instead of -2.5`, result)
+	}
+}
+
 func TestHasQueryParam(t *testing.T) {
 	u, _ := url.Parse("http://example.org/?key=42")
 	r := &http.Request{URL: u}
@@ -57,8 +57,18 @@ func (b *Builder) WithoutCompression() *Builder {
 
 // WithCaching adds caching headers to the response.
 func (b *Builder) WithCaching(etag string, duration time.Duration, callback func(*Builder)) {
+	b.withCacheControl("public", etag, duration, callback)
+}
+
+// WithImmutableCaching adds caching headers for a content that never changes under the same URL.
+// Clients and proxies are allowed to keep the response for one year without revalidation.
+func (b *Builder) WithImmutableCaching(etag string, callback func(*Builder)) {
+	b.withCacheControl("public, max-age=31536000, immutable", etag, 365*24*time.Hour, callback)
+}
+
+func (b *Builder) withCacheControl(cacheControl, etag string, duration time.Duration, callback func(*Builder)) {
 	b.headers["ETag"] = etag
-	b.headers["Cache-Control"] = "public"
+	b.headers["Cache-Control"] = cacheControl
 	b.headers["Expires"] = time.Now().Add(duration).Format(time.RFC1123)
 
 	if etag == b.r.Header.Get("If-None-Match") {
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 
 	"miniflux.app/http/response"
 	"miniflux.app/logger"
@@ -32,6 +33,15 @@ func Created(w http.ResponseWriter, r *http.Request, body interface{}) {
 	builder.Write()
 }
 
+// Accepted sends an accepted response to the client.
+func Accepted(w http.ResponseWriter, r *http.Request, body interface{}) {
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusAccepted)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSON(body))
+	builder.Write()
+}
+
 // NoContent sends a no content response to the client.
 func NoContent(w http.ResponseWriter, r *http.Request) {
 	builder := response.New(w, r)
@@ -62,6 +72,17 @@ func BadRequest(w http.ResponseWriter, r *http.Request, err error) {
 	builder.Write()
 }
 
+// Conflict sends a conflict error to the client.
+func Conflict(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Error("[HTTP:Conflict] %s => %v", r.URL, err)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusConflict)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSONError(err))
+	builder.Write()
+}
+
 // Unauthorized sends a not authorized error to the client.
 func Unauthorized(w http.ResponseWriter, r *http.Request) {
 	logger.Error("[HTTP:Unauthorized] %s", r.URL)
@@ -84,6 +105,29 @@ func Forbidden(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// ForbiddenError sends a forbidden error with its reason to the client.
+func ForbiddenError(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Error("[HTTP:Forbidden] %s => %v", r.URL, err)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusForbidden)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSONError(err))
+	builder.Write()
+}
+
+// ServiceUnavailable sends a temporary error to the client, the request can be sent again after retryAfter seconds.
+func ServiceUnavailable(w http.ResponseWriter, r *http.Request, err error, retryAfter int) {
+	logger.Error("[HTTP:Service Unavailable] %s => %v", r.URL, err)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusServiceUnavailable)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithHeader("Retry-After", strconv.Itoa(retryAfter))
+	builder.WithBody(toJSONError(err))
+	builder.Write()
+}
+
 // NotFound sends a page not found error to the client.
 func NotFound(w http.ResponseWriter, r *http.Request) {
 	logger.Error("[HTTP:Not Found] %s", r.URL)
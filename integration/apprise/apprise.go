@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package apprise // import "miniflux.app/integration/apprise"
+
+import (
+	"fmt"
+
+	"miniflux.app/http/client"
+)
+
+// Notification is the payload of the notify endpoint of the Apprise API.
+type Notification struct {
+	URLs  string `json:"urls,omitempty"`
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body"`
+}
+
+// Client represents an Apprise API client.
+type Client struct {
+	endpoint    string
+	serviceURLs string
+}
+
+// SendNotification sends a message through Apprise.
+// The services are those of the endpoint configuration when no service URLs are given.
+func (c *Client) SendNotification(title, message, link string) error {
+	if c.endpoint == "" {
+		return fmt.Errorf("apprise: missing endpoint")
+	}
+
+	body := message
+	if link != "" {
+		body += "\n" + link
+	}
+
+	clt := client.New(c.endpoint)
+	response, err := clt.PostJSON(&Notification{URLs: c.serviceURLs, Title: title, Body: body})
+	if err != nil {
+		return fmt.Errorf("apprise: unable to send notification: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("apprise: unable to send notification, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Apprise API client, the endpoint is the URL of the notify route.
+func NewClient(endpoint, serviceURLs string) *Client {
+	return &Client{endpoint: endpoint, serviceURLs: serviceURLs}
+}
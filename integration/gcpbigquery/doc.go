@@ -0,0 +1,10 @@
+// Copyright 2019 Eka Putra. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package gcpbigquery exports the sync events to BigQuery tables for analytics.
+
+*/
+package gcpbigquery // import "miniflux.app/integration/gcpbigquery"
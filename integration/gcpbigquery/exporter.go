@@ -0,0 +1,143 @@
+// Copyright 2019 Eka Putra. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gcpbigquery // import "miniflux.app/integration/gcpbigquery"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/option"
+
+	"miniflux.app/config"
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/logger"
+)
+
+// flushInterval is the maximum time an event waits in the buffer before being inserted.
+const flushInterval = 10 * time.Second
+
+// tables maps the entity types of the events to the tables of the dataset.
+var tables = map[string]string{
+	gcppubsub.EntityTypeEntry:    "entry_events",
+	gcppubsub.EntityTypeFeed:     "feed_events",
+	gcppubsub.EntityTypeCategory: "category_events",
+}
+
+// eventRow is the schema of the event tables.
+type eventRow struct {
+	EntityID  int64     `bigquery:"entity_id"`
+	Operation string    `bigquery:"operation"`
+	CreatedAt time.Time `bigquery:"created_at"`
+}
+
+type pendingRow struct {
+	table string
+	row   *eventRow
+}
+
+// Exporter buffers the sync events and writes them to BigQuery with batched streaming inserts.
+type Exporter struct {
+	rows      chan pendingRow
+	batchSize int
+	insert    func(ctx context.Context, table string, rows []*eventRow) error
+}
+
+// NewExporter creates the event tables of the dataset when they are missing and returns an exporter writing to them.
+func NewExporter(cfg *config.Config) (*Exporter, error) {
+	ctx := context.Background()
+
+	var options []option.ClientOption
+	if file := cfg.BigQueryCredentialsFile(); file != "" {
+		options = append(options, option.WithCredentialsFile(file))
+	}
+
+	client, err := bigquery.NewClient(ctx, cfg.GcpProjectID(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("bigquery: unable to create client: %v", err)
+	}
+
+	schema, err := bigquery.InferSchema(eventRow{})
+	if err != nil {
+		return nil, fmt.Errorf("bigquery: unable to infer the event schema: %v", err)
+	}
+
+	dataset := client.Dataset(cfg.BigQueryDataset())
+	for _, name := range tables {
+		table := dataset.Table(name)
+		if _, err := table.Metadata(ctx); err == nil {
+			continue
+		}
+
+		if err := table.Create(ctx, &bigquery.TableMetadata{Schema: schema}); err != nil {
+			return nil, fmt.Errorf("bigquery: unable to create the table %q: %v", name, err)
+		}
+	}
+
+	exporter := newExporter(cfg.BigQueryBatchSize(), func(ctx context.Context, table string, rows []*eventRow) error {
+		return dataset.Table(table).Inserter().Put(ctx, rows)
+	})
+	go exporter.run()
+
+	return exporter, nil
+}
+
+func newExporter(batchSize int, insert func(ctx context.Context, table string, rows []*eventRow) error) *Exporter {
+	return &Exporter{
+		rows:      make(chan pendingRow, batchSize*2),
+		batchSize: batchSize,
+		insert:    insert,
+	}
+}
+
+// Record adds an event to the buffer, the event is dropped when the buffer is full
+// to never slow down the requests while BigQuery is unavailable.
+func (e *Exporter) Record(event gcppubsub.SyncEvent) {
+	table, found := tables[event.EntityType]
+	if !found {
+		return
+	}
+
+	select {
+	case e.rows <- pendingRow{table, &eventRow{EntityID: event.EntityID, Operation: event.EntityOp, CreatedAt: time.Now()}}:
+	default:
+		logger.Error("[BigQuery:Record] The buffer is full, dropping %v", event)
+	}
+}
+
+func (e *Exporter) run() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make(map[string][]*eventRow)
+	size := 0
+	for {
+		select {
+		case pending := <-e.rows:
+			batch[pending.table] = append(batch[pending.table], pending.row)
+			size++
+			if size < e.batchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		e.flush(batch)
+		batch = make(map[string][]*eventRow)
+		size = 0
+	}
+}
+
+// flush inserts the buffered rows, a failed batch is logged and dropped.
+func (e *Exporter) flush(batch map[string][]*eventRow) {
+	for table, rows := range batch {
+		if err := e.insert(context.Background(), table, rows); err != nil {
+			logger.Error("[BigQuery:Flush] Unable to insert %d rows in %q: %v", len(rows), table, err)
+		} else {
+			logger.Debug("[BigQuery:Flush] Inserted %d rows in %q", len(rows), table)
+		}
+	}
+}
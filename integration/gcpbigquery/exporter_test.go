@@ -0,0 +1,80 @@
+// Copyright 2019 Eka Putra. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gcpbigquery // import "miniflux.app/integration/gcpbigquery"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"miniflux.app/integration/gcppubsub"
+)
+
+type insertedRows struct {
+	table string
+	rows  []*eventRow
+}
+
+func TestExporterInsertsFullBatches(t *testing.T) {
+	inserted := make(chan insertedRows, 10)
+	exporter := newExporter(2, func(ctx context.Context, table string, rows []*eventRow) error {
+		inserted <- insertedRows{table, rows}
+		return nil
+	})
+	go exporter.run()
+
+	exporter.Record(gcppubsub.NewEntryEvent(1, gcppubsub.EntityOpWrite))
+	exporter.Record(gcppubsub.NewEntryEvent(2, gcppubsub.EntityOpDelete))
+
+	select {
+	case result := <-inserted:
+		if result.table != "entry_events" || len(result.rows) != 2 {
+			t.Fatalf(`Unexpected insert of %d rows in %q`, len(result.rows), result.table)
+		}
+
+		if result.rows[1].EntityID != 2 || result.rows[1].Operation != gcppubsub.EntityOpDelete {
+			t.Errorf(`Unexpected row: %+v`, result.rows[1])
+		}
+	case <-time.After(time.Second):
+		t.Fatal(`A full batch should be inserted without waiting for the flush interval`)
+	}
+}
+
+func TestExporterGroupsRowsByTable(t *testing.T) {
+	inserted := make(map[string]int)
+	exporter := newExporter(10, func(ctx context.Context, table string, rows []*eventRow) error {
+		inserted[table] += len(rows)
+		return nil
+	})
+
+	exporter.flush(map[string][]*eventRow{
+		"feed_events":     {{EntityID: 1}},
+		"category_events": {{EntityID: 2}, {EntityID: 3}},
+	})
+
+	if inserted["feed_events"] != 1 || inserted["category_events"] != 2 {
+		t.Errorf(`Unexpected inserted rows: %v`, inserted)
+	}
+}
+
+func TestExporterDropsEventsWhenBufferIsFull(t *testing.T) {
+	exporter := newExporter(1, nil)
+	for i := int64(1); i <= 3; i++ {
+		exporter.Record(gcppubsub.NewFeedEvent(i, gcppubsub.EntityOpWrite))
+	}
+
+	if len(exporter.rows) != 2 {
+		t.Fatalf(`The buffer should hold 2 events, got %d`, len(exporter.rows))
+	}
+}
+
+func TestExporterIgnoresUnknownEntities(t *testing.T) {
+	exporter := newExporter(1, nil)
+	exporter.Record(gcppubsub.SyncEvent{EntityType: "USER", EntityID: 1, EntityOp: gcppubsub.EntityOpWrite})
+
+	if len(exporter.rows) != 0 {
+		t.Fatal(`Events of unknown entities should be ignored`)
+	}
+}
@@ -6,5 +6,9 @@
 
 Package gcppubsub provides an integration with the GCP Pubsub.
 
+The JSON schemas of the event payloads are published in the schemas directory,
+one directory per schema version. The version is also sent in the
+"schema_version" attribute of each message.
+
 */
 package gcppubsub // import "miniflux.app/integration/gcppubsub"
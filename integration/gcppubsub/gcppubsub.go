@@ -1,13 +1,29 @@
 package gcppubsub // import "miniflux.app/integration/gcppubsub"
 
+import (
+	"encoding/json"
+	"strconv"
+)
+
 // Constants related to SyncEvent
 const (
 	EntityTypeCategory string = "CATEGORY"
 	EntityTypeFeed string = "FEED"
 	EntityTypeEntry string = "ENTRY"
+	EntityTypeUser string = "USER"
 
 	EntityOpWrite string = "WRITE"
 	EntityOpDelete string = "DELETE"
+
+	// EntityOpPurge is the tombstone of a deleted account, it is the last
+	// event of the user once all its feeds, entries and integrations are removed.
+	EntityOpPurge string = "PURGE"
+)
+
+// Payload modes of the events, the full payloads embed the model of the written entities.
+const (
+	PayloadModeIDOnly string = "id_only"
+	PayloadModeFull string = "full"
 )
 
 // SyncEvent model
@@ -15,19 +31,45 @@ type SyncEvent struct {
 	EntityType string `json:"entity_type"`
 	EntityID int64 `json:"entity_id"`
 	EntityOp string `json:"entity_op"`
+
+	// UserID is the owner of the entity, zero when it's unknown.
+	UserID int64 `json:"user_id,omitempty"`
+
+	// Data is the JSON representation of the written entity, it's only set with the full payloads.
+	Data json.RawMessage `json:"data,omitempty"`
 }
 
 // NewCategoryEvent returns `SyncEvent` with type `EntityTypeCategory`
 func NewCategoryEvent(categoryID int64, op string) SyncEvent {
-	return SyncEvent{EntityTypeCategory, categoryID, op}
+	return SyncEvent{EntityType: EntityTypeCategory, EntityID: categoryID, EntityOp: op}
 }
 
 // NewFeedEvent returns `SyncEvent` with type `EntityTypeFeed`
 func NewFeedEvent(feedID int64, op string) SyncEvent {
-	return SyncEvent{EntityTypeFeed, feedID, op}
+	return SyncEvent{EntityType: EntityTypeFeed, EntityID: feedID, EntityOp: op}
 }
 
 // NewEntryEvent returns `SyncEvent` with type `EntityTypeEntry`
 func NewEntryEvent(entryID int64, op string) SyncEvent {
-	return SyncEvent{EntityTypeEntry, entryID, op}
+	return SyncEvent{EntityType: EntityTypeEntry, EntityID: entryID, EntityOp: op}
+}
+
+// NewUserEvent returns `SyncEvent` with type `EntityTypeUser`
+func NewUserEvent(userID int64, op string) SyncEvent {
+	return SyncEvent{EntityType: EntityTypeUser, EntityID: userID, EntityOp: op}
+}
+
+// Attributes returns the message attributes of the event, consumers can filter on them without decoding the payload.
+func (e SyncEvent) Attributes() map[string]string {
+	attributes := map[string]string{
+		AttributeSchemaVersion: strconv.Itoa(SchemaVersion),
+		AttributeEntityType:    e.EntityType,
+		AttributeEntityOp:      e.EntityOp,
+	}
+
+	if e.UserID > 0 {
+		attributes[AttributeUserID] = strconv.FormatInt(e.UserID, 10)
+	}
+
+	return attributes
 }
\ No newline at end of file
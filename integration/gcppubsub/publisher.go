@@ -8,15 +8,30 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"miniflux.app/alert"
 	"miniflux.app/config"
 	"miniflux.app/timer"
 )
 
+// Sink receives a copy of every published event.
+type Sink interface {
+	Record(event SyncEvent)
+}
+
+// Outbox keeps the events that could not be published so they can be replayed.
+type Outbox interface {
+	RecordFailedEvent(ctx context.Context, event SyncEvent, reason string) error
+}
+
 // Publisher just a wrapper of pubsub Client
 type Publisher struct {
 	ctx    context.Context
 	client *pubsub.Client
 	topic  *pubsub.Topic
+
+	monitor *alert.Monitor
+	sinks   []Sink
+	outbox  Outbox
 }
 
 // NewPublisher creates new Publisher instance
@@ -28,23 +43,55 @@ func NewPublisher(config *config.Config) (publisher *Publisher) {
 	}
 
 	topic := client.Topic(config.GcpPubsubTopic())
-	return &Publisher{ctx, client, topic}
+	return &Publisher{ctx: ctx, client: client, topic: topic}
+}
+
+// SetAlertMonitor sets the monitor notified of publishing errors.
+func (p *Publisher) SetAlertMonitor(monitor *alert.Monitor) {
+	p.monitor = monitor
+}
+
+// AddSink adds a destination receiving the events in addition to the topic.
+func (p *Publisher) AddSink(sink Sink) {
+	p.sinks = append(p.sinks, sink)
+}
+
+// SetOutbox sets the outbox recording the events that could not be published.
+func (p *Publisher) SetOutbox(outbox Outbox) {
+	p.outbox = outbox
 }
 
 // PublishEvent publish an event to PubSub
 func (p *Publisher) PublishEvent(event SyncEvent) {
+	for _, sink := range p.sinks {
+		sink.Record(event)
+	}
+
+	if err := p.Publish(event); err != nil {
+		log.Printf("[Publisher:PublishEvent] %v", err)
+		if p.outbox != nil {
+			if err := p.outbox.RecordFailedEvent(p.ctx, event, err.Error()); err != nil {
+				log.Printf("[Publisher:PublishEvent] Unable to record the failed event %v, %v", event, err)
+			}
+		}
+	}
+}
+
+// Publish sends an event to the topic and waits for the result, the sinks and the outbox are not involved.
+func (p *Publisher) Publish(event SyncEvent) error {
 	jsonEvent, err := json.Marshal(event)
 	if err != nil {
-		log.Printf("[Publisher:PublishEvent] Unable to marshal %v to JSON, %v\n", event, err)
-		return
+		return fmt.Errorf("Unable to marshal %v to JSON, %v", event, err)
 	}
-	msg := &pubsub.Message{Data: []byte(jsonEvent)}
+	msg := &pubsub.Message{Data: []byte(jsonEvent), Attributes: event.Attributes()}
 
 	// TODO: Context should not inside a Struct
 	_, err = p.topic.Publish(p.ctx, msg).Get(p.ctx)
 	if err != nil {
-		log.Printf("[Publisher:PublishEvent] Publishing to topic failed, %v", err)
-		return
+		p.monitor.Failure(alert.ComponentPublisher, err)
+		return fmt.Errorf("Publishing to topic failed, %v", err)
 	}
-	timer.ExecutionTime(time.Now(), fmt.Sprintf("[Publisher:PublishEvent] Publishing %v", event))
+	p.monitor.Success(alert.ComponentPublisher)
+	timer.ExecutionTime(time.Now(), fmt.Sprintf("[Publisher:Publish] Publishing %v", event))
+	return nil
 }
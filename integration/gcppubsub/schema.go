@@ -0,0 +1,89 @@
+// Copyright 2019 Eka Putra. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gcppubsub // import "miniflux.app/integration/gcppubsub"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaVersion is the version of the event payloads, it is incremented
+// when a change of the payload could break the consumers.
+// The second version adds the owner of the entity and the full payloads.
+const SchemaVersion = 2
+
+// Attributes set on every published message.
+const (
+	AttributeSchemaVersion = "schema_version"
+	AttributeEntityType    = "entity_type"
+	AttributeEntityOp      = "entity_op"
+
+	// AttributeUserID is only set when the owner of the entity is known.
+	AttributeUserID = "user_id"
+)
+
+// EntityTypes lists the types of entity described by the events.
+var EntityTypes = []string{EntityTypeCategory, EntityTypeFeed, EntityTypeEntry, EntityTypeUser}
+
+// EntityOps returns the operations of the events of an entity type, only users are purged.
+func EntityOps(entityType string) []string {
+	if entityType == EntityTypeUser {
+		return []string{EntityOpWrite, EntityOpDelete, EntityOpPurge}
+	}
+	return []string{EntityOpWrite, EntityOpDelete}
+}
+
+// Schema returns the JSON schema of the payload of the events of an entity type.
+// The schemas of the current version are published in the schemas directory.
+func Schema(entityType string) (map[string]interface{}, error) {
+	found := false
+	for _, t := range EntityTypes {
+		if t == entityType {
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	var ops []interface{}
+	for _, op := range EntityOps(entityType) {
+		ops = append(ops, op)
+	}
+
+	name := strings.ToLower(entityType)
+	return map[string]interface{}{
+		"$schema":     "http://json-schema.org/draft-07/schema#",
+		"$id":         fmt.Sprintf("https://miniflux.app/schemas/pubsub/v%d/%s.json", SchemaVersion, name),
+		"title":       fmt.Sprintf("%s event", name),
+		"description": fmt.Sprintf("Published when the %s with the given ID is written or deleted, the message attributes contain the schema version, the entity type, the operation and the owner.", name),
+		"type":        "object",
+		"required":    []interface{}{"entity_type", "entity_id", "entity_op"},
+		"properties": map[string]interface{}{
+			"entity_type": map[string]interface{}{"type": "string", "enum": []interface{}{entityType}},
+			"entity_id":   map[string]interface{}{"type": "integer", "minimum": float64(1)},
+			"entity_op":   map[string]interface{}{"type": "string", "enum": ops},
+			"user_id":     map[string]interface{}{"type": "integer", "minimum": float64(1)},
+			"data": map[string]interface{}{
+				"type":        "object",
+				"description": fmt.Sprintf("The %s as returned by the API, only sent with the full payloads when the %s is written.", name, name),
+			},
+		},
+	}, nil
+}
+
+// IsSupportedSchemaVersion returns true if the message attributes describe a payload this version can decode.
+// Messages without version were published before the attribute existed and use the first version.
+func IsSupportedSchemaVersion(attributes map[string]string) bool {
+	value, found := attributes[AttributeSchemaVersion]
+	if !found {
+		return true
+	}
+
+	version, err := strconv.Atoi(value)
+	return err == nil && version <= SchemaVersion
+}
@@ -0,0 +1,219 @@
+// Copyright 2019 Eka Putra. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gcppubsub // import "miniflux.app/integration/gcppubsub"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The payloads are consumed by other services, changing them requires a new schema version.
+func TestEventPayloads(t *testing.T) {
+	scenarios := map[string]SyncEvent{
+		`{"entity_type":"CATEGORY","entity_id":1,"entity_op":"WRITE"}`: NewCategoryEvent(1, EntityOpWrite),
+		`{"entity_type":"FEED","entity_id":2,"entity_op":"DELETE"}`:    NewFeedEvent(2, EntityOpDelete),
+		`{"entity_type":"ENTRY","entity_id":3,"entity_op":"WRITE"}`:    NewEntryEvent(3, EntityOpWrite),
+		`{"entity_type":"USER","entity_id":4,"entity_op":"DELETE"}`:    NewUserEvent(4, EntityOpDelete),
+		`{"entity_type":"USER","entity_id":5,"entity_op":"PURGE"}`:     NewUserEvent(5, EntityOpPurge),
+	}
+
+	for expected, event := range scenarios {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(payload) != expected {
+			t.Errorf(`Unexpected payload, got %s instead of %s`, payload, expected)
+		}
+	}
+}
+
+func TestEventsMatchSchemas(t *testing.T) {
+	for _, entityType := range EntityTypes {
+		schema, err := Schema(entityType)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, op := range EntityOps(entityType) {
+			data, _ := json.Marshal(SyncEvent{EntityType: entityType, EntityID: 42, EntityOp: op, UserID: 7, Data: json.RawMessage(`{"id":42}`)})
+
+			var payload map[string]interface{}
+			if err := json.Unmarshal(data, &payload); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := validatePayload(schema, payload); err != nil {
+				t.Errorf(`The %s event does not match its schema: %v`, entityType, err)
+			}
+		}
+	}
+}
+
+func TestPublishedSchemas(t *testing.T) {
+	for _, entityType := range EntityTypes {
+		schema, err := Schema(entityType)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filename := filepath.Join("schemas", fmt.Sprintf("v%d", SchemaVersion), strings.ToLower(entityType)+".json")
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatalf(`The schema of %s events is not published: %v`, entityType, err)
+		}
+
+		var published interface{}
+		if err := json.Unmarshal(data, &published); err != nil {
+			t.Fatalf(`Invalid schema file %s: %v`, filename, err)
+		}
+
+		var expected interface{}
+		data, _ = json.Marshal(schema)
+		json.Unmarshal(data, &expected)
+
+		if !reflect.DeepEqual(published, expected) {
+			t.Errorf(`The published schema %s is outdated, expected %s`, filename, data)
+		}
+	}
+}
+
+func TestOnlyUsersArePurged(t *testing.T) {
+	schema, err := Schema(EntityTypeFeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := json.Marshal(NewFeedEvent(1, EntityOpPurge))
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validatePayload(schema, payload); err == nil {
+		t.Error(`A purge event of a feed should not match its schema`)
+	}
+}
+
+func TestSchemaWithUnknownEntityType(t *testing.T) {
+	if _, err := Schema("UNKNOWN"); err == nil {
+		t.Error(`An unknown entity type should generate an error`)
+	}
+}
+
+func TestEventAttributes(t *testing.T) {
+	attributes := NewFeedEvent(1, EntityOpDelete).Attributes()
+	expected := map[string]string{
+		"schema_version": "2",
+		"entity_type":    "FEED",
+		"entity_op":      "DELETE",
+	}
+
+	if !reflect.DeepEqual(attributes, expected) {
+		t.Errorf(`Unexpected attributes, got %v instead of %v`, attributes, expected)
+	}
+}
+
+func TestEventAttributesWithUser(t *testing.T) {
+	event := NewEntryEvent(3, EntityOpWrite)
+	event.UserID = 12
+
+	if value := event.Attributes()[AttributeUserID]; value != "12" {
+		t.Errorf(`Unexpected user_id attribute, got %q`, value)
+	}
+}
+
+func TestFullEventPayload(t *testing.T) {
+	event := NewFeedEvent(2, EntityOpWrite)
+	event.UserID = 1
+	event.Data = json.RawMessage(`{"id":2,"title":"Example"}`)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"entity_type":"FEED","entity_id":2,"entity_op":"WRITE","user_id":1,"data":{"id":2,"title":"Example"}}`
+	if string(payload) != expected {
+		t.Errorf(`Unexpected payload, got %s instead of %s`, payload, expected)
+	}
+}
+
+func TestIsSupportedSchemaVersion(t *testing.T) {
+	scenarios := []struct {
+		attributes map[string]string
+		expected   bool
+	}{
+		{nil, true},
+		{map[string]string{"schema_version": "1"}, true},
+		{map[string]string{"schema_version": "2"}, true},
+		{map[string]string{"schema_version": fmt.Sprint(SchemaVersion + 1)}, false},
+		{map[string]string{"schema_version": "invalid"}, false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := IsSupportedSchemaVersion(scenario.attributes); result != scenario.expected {
+			t.Errorf(`Unexpected result for %v, got %v instead of %v`, scenario.attributes, result, scenario.expected)
+		}
+	}
+}
+
+// validatePayload checks the subset of JSON schema used by the event schemas.
+func validatePayload(schema map[string]interface{}, payload map[string]interface{}) error {
+	properties := schema["properties"].(map[string]interface{})
+
+	for _, name := range schema["required"].([]interface{}) {
+		if _, found := payload[name.(string)]; !found {
+			return fmt.Errorf("missing property %q", name)
+		}
+	}
+
+	for name, value := range payload {
+		property, found := properties[name]
+		if !found {
+			return fmt.Errorf("property %q is not described", name)
+		}
+
+		definition := property.(map[string]interface{})
+		switch definition["type"] {
+		case "string":
+			str, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("property %q must be a string", name)
+			}
+
+			if enum, found := definition["enum"]; found {
+				allowed := false
+				for _, item := range enum.([]interface{}) {
+					if item == str {
+						allowed = true
+					}
+				}
+
+				if !allowed {
+					return fmt.Errorf("property %q has an invalid value %q", name, str)
+				}
+			}
+		case "integer":
+			number, ok := value.(float64)
+			if !ok || number != float64(int64(number)) {
+				return fmt.Errorf("property %q must be an integer", name)
+			}
+
+			if minimum, found := definition["minimum"]; found && number < minimum.(float64) {
+				return fmt.Errorf("property %q must be greater than or equal to %v", name, minimum)
+			}
+		}
+	}
+
+	return nil
+}
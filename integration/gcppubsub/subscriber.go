@@ -0,0 +1,42 @@
+package gcppubsub // import "miniflux.app/integration/gcppubsub"
+
+import (
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/pubsub"
+	"miniflux.app/config"
+	"miniflux.app/logger"
+)
+
+// Subscriber receives the events published by all instances.
+type Subscriber struct {
+	client       *pubsub.Client
+	subscription *pubsub.Subscription
+}
+
+// NewSubscriber creates a Subscriber, each instance must use its own subscription to receive all events.
+func NewSubscriber(config *config.Config) (*Subscriber, error) {
+	client, err := pubsub.NewClient(context.Background(), config.GcpProjectID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Subscriber{client, client.Subscription(config.GcpPubsubCacheSubscription())}, nil
+}
+
+// Receive calls the handler for each event until the context is done.
+func (s *Subscriber) Receive(ctx context.Context, handler func(SyncEvent)) error {
+	return s.subscription.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		var event SyncEvent
+		if !IsSupportedSchemaVersion(msg.Attributes) {
+			logger.Error("[Subscriber:Receive] Unsupported schema version %q for message %s", msg.Attributes[AttributeSchemaVersion], msg.ID)
+		} else if err := json.Unmarshal(msg.Data, &event); err != nil {
+			logger.Error("[Subscriber:Receive] Unable to decode message %s: %v", msg.ID, err)
+		} else {
+			handler(event)
+		}
+
+		msg.Ack()
+	})
+}
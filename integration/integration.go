@@ -5,37 +5,51 @@
 package integration // import "miniflux.app/integration"
 
 import (
+	"errors"
+
 	"miniflux.app/config"
+	"miniflux.app/ebook"
+	"miniflux.app/hook"
+	"miniflux.app/integration/apprise"
 	"miniflux.app/integration/instapaper"
+	"miniflux.app/integration/kindle"
+	"miniflux.app/integration/ntfy"
 	"miniflux.app/integration/nunuxkeeper"
+	"miniflux.app/integration/omnivore"
 	"miniflux.app/integration/pinboard"
 	"miniflux.app/integration/pocket"
+	"miniflux.app/integration/pushover"
+	"miniflux.app/integration/readeck"
 	"miniflux.app/integration/wallabag"
 	"miniflux.app/logger"
+	"miniflux.app/mailer"
 	"miniflux.app/model"
 )
 
-// SendEntry send the entry to the activated providers.
-func SendEntry(cfg *config.Config, entry *model.Entry, integration *model.Integration) {
+// service is a third-party service activated by the user.
+// Bookmark services save entries and notification services send messages.
+type service struct {
+	name   string
+	save   func(entry *model.Entry) error
+	notify func(title, message, link string) error
+}
+
+// activatedServices returns the services enabled in the user integration settings.
+func activatedServices(cfg *config.Config, integration *model.Integration) []*service {
+	var services []*service
+
 	if integration.PinboardEnabled {
 		client := pinboard.NewClient(integration.PinboardToken)
-		err := client.AddBookmark(
-			entry.URL,
-			entry.Title,
-			integration.PinboardTags,
-			integration.PinboardMarkAsUnread,
-		)
-
-		if err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
-		}
+		services = append(services, &service{name: "pinboard", save: func(entry *model.Entry) error {
+			return client.AddBookmark(entry.URL, entry.Title, integration.PinboardTags, integration.PinboardMarkAsUnread)
+		}})
 	}
 
 	if integration.InstapaperEnabled {
 		client := instapaper.NewClient(integration.InstapaperUsername, integration.InstapaperPassword)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
-		}
+		services = append(services, &service{name: "instapaper", save: func(entry *model.Entry) error {
+			return client.AddURL(entry.URL, entry.Title)
+		}})
 	}
 
 	if integration.WallabagEnabled {
@@ -46,10 +60,9 @@ func SendEntry(cfg *config.Config, entry *model.Entry, integration *model.Integr
 			integration.WallabagUsername,
 			integration.WallabagPassword,
 		)
-
-		if err := client.AddEntry(entry.URL, entry.Title); err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
-		}
+		services = append(services, &service{name: "wallabag", save: func(entry *model.Entry) error {
+			return client.AddEntry(entry.URL, entry.Title)
+		}})
 	}
 
 	if integration.NunuxKeeperEnabled {
@@ -57,16 +70,71 @@ func SendEntry(cfg *config.Config, entry *model.Entry, integration *model.Integr
 			integration.NunuxKeeperURL,
 			integration.NunuxKeeperAPIKey,
 		)
-
-		if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
-		}
+		services = append(services, &service{name: "nunux_keeper", save: func(entry *model.Entry) error {
+			return client.AddEntry(entry.URL, entry.Title, entry.Content)
+		}})
 	}
 
 	if integration.PocketEnabled {
 		client := pocket.NewClient(cfg.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
+		services = append(services, &service{name: "pocket", save: func(entry *model.Entry) error {
+			return client.AddURL(entry.URL, entry.Title)
+		}})
+	}
+
+	if integration.ReadeckEnabled {
+		client := readeck.NewClient(integration.ReadeckURL, integration.ReadeckAPIKey, integration.ReadeckLabels)
+		services = append(services, &service{name: "readeck", save: func(entry *model.Entry) error {
+			return client.AddEntry(entry.URL, entry.Title)
+		}})
+	}
+
+	if integration.OmnivoreEnabled {
+		client := omnivore.NewClient(integration.OmnivoreURL, integration.OmnivoreAPIKey)
+		services = append(services, &service{name: "omnivore", save: func(entry *model.Entry) error {
+			return client.AddURL(entry.URL)
+		}})
+	}
+
+	if integration.NtfyEnabled {
+		client := ntfy.NewClient(integration.NtfyURL, integration.NtfyTopic, integration.NtfyToken)
+		services = append(services, &service{name: "ntfy", notify: client.SendNotification})
+	}
+
+	if integration.PushoverEnabled {
+		client := pushover.NewClient(integration.PushoverToken, integration.PushoverUser)
+		services = append(services, &service{name: "pushover", notify: client.SendNotification})
+	}
+
+	if integration.AppriseEnabled {
+		client := apprise.NewClient(integration.AppriseURL, integration.AppriseServiceURLs)
+		services = append(services, &service{name: "apprise", notify: client.SendNotification})
+	}
+
+	return services
+}
+
+// SendEntry send the entry to the activated providers.
+func SendEntry(cfg *config.Config, entry *model.Entry, integration *model.Integration) {
+	hook.New(cfg).EntrySaved(entry)
+
+	for _, service := range activatedServices(cfg, integration) {
+		if service.save == nil {
+			continue
+		}
+
+		if err := service.save(entry); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 }
+
+// SendToKindle emails the entry as an EPUB document to the Kindle address of the user.
+func SendToKindle(cfg *config.Config, entry *model.Entry, integration *model.Integration, language string, loader ebook.ImageLoader) error {
+	if !cfg.HasMailer() || !integration.KindleEnabled {
+		return errors.New("integration: Send to Kindle is not enabled")
+	}
+
+	client := kindle.NewClient(mailer.New(cfg), integration.KindleEmail)
+	return client.SendEntry(entry, language, loader)
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package kindle // import "miniflux.app/integration/kindle"
+
+import (
+	"bytes"
+	"fmt"
+
+	"miniflux.app/ebook"
+	"miniflux.app/mailer"
+	"miniflux.app/model"
+)
+
+// Client represents a Send to Kindle client.
+type Client struct {
+	mailer  *mailer.Mailer
+	address string
+}
+
+// NewClient returns a new Send to Kindle client.
+func NewClient(m *mailer.Mailer, address string) *Client {
+	return &Client{mailer: m, address: address}
+}
+
+// SendEntry converts the entry to an EPUB document and emails it to the Kindle address.
+func (c *Client) SendEntry(entry *model.Entry, language string, loader ebook.ImageLoader) error {
+	book := ebook.New(entry.Title, language, loader)
+	if err := book.AddEntry(entry); err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if err := book.WriteEPUB(&buffer); err != nil {
+		return fmt.Errorf("kindle: unable to generate the document: %v", err)
+	}
+
+	return c.mailer.Send(&mailer.Message{
+		To:      c.address,
+		Subject: entry.Title,
+		Body:    entry.URL,
+		Attachments: []*mailer.Attachment{{
+			Filename:    fmt.Sprintf("entry-%d.epub", entry.ID),
+			ContentType: ebook.ContentType(ebook.FormatEPUB),
+			Content:     buffer.Bytes(),
+		}},
+	})
+}
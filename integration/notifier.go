@@ -0,0 +1,290 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	notificationQueueSize = 100
+
+	// maxNotificationLines is the number of entry titles listed in a notification.
+	maxNotificationLines = 10
+)
+
+// Recipient returns the user and the integration settings used to notify a user.
+type Recipient func(userID int64) (*model.User, *model.Integration, error)
+
+// Holder keeps a notification until the end of the quiet hours of the user.
+type Holder func(userID int64, held *HeldNotification, until time.Time) error
+
+// Notification is a message sent to the notification services of a user.
+type Notification struct {
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+// HeldNotification remembers the event of a notification held during quiet hours.
+type HeldNotification struct {
+	Event        string        `json:"event"`
+	CategoryID   int64         `json:"category_id"`
+	Notification *Notification `json:"notification"`
+}
+
+// event is routed to the services whose filter accepts it.
+type event struct {
+	name       string
+	userID     int64
+	categoryID int64
+	entries    model.Entries
+	feed       *model.Feed
+	held       []*HeldNotification
+}
+
+// Notifier routes starred entries, new entries and feed errors to the services selected by each user in the background.
+// Notifications are given to the holder during the quiet hours of the user and sent together by Flush afterwards.
+// A nil Notifier discards all events.
+type Notifier struct {
+	recipient Recipient
+	holder    Holder
+	queue     chan *event
+	services  func(integration *model.Integration) []*service
+}
+
+// NewNotifier returns a Notifier and starts the delivery worker.
+func NewNotifier(cfg *config.Config) *Notifier {
+	n := &Notifier{
+		queue: make(chan *event, notificationQueueSize),
+		services: func(integration *model.Integration) []*service {
+			return activatedServices(cfg, integration)
+		},
+	}
+
+	go n.run()
+	return n
+}
+
+// SetRecipient sets the function returning the settings of the user to notify.
+func (n *Notifier) SetRecipient(recipient Recipient) {
+	if n == nil {
+		return
+	}
+
+	n.recipient = recipient
+}
+
+// SetHolder sets the function keeping the notifications of users in quiet hours,
+// without holder the notifications are sent right away.
+func (n *Notifier) SetHolder(holder Holder) {
+	if n == nil {
+		return
+	}
+
+	n.holder = holder
+}
+
+// EntryStarred routes an entry starred by the user.
+func (n *Notifier) EntryStarred(entry *model.Entry) {
+	var categoryID int64
+	if entry.Feed != nil && entry.Feed.Category != nil {
+		categoryID = entry.Feed.Category.ID
+	}
+
+	n.enqueue(&event{name: model.IntegrationEventStarred, userID: entry.UserID, categoryID: categoryID, entries: model.Entries{entry}})
+}
+
+// NewEntries routes the entries added by a feed refresh.
+func (n *Notifier) NewEntries(userID, categoryID int64, entries model.Entries) {
+	if len(entries) > 0 {
+		n.enqueue(&event{name: model.IntegrationEventNewEntries, userID: userID, categoryID: categoryID, entries: entries})
+	}
+}
+
+// FeedError notifies the user that a feed cannot be refreshed anymore.
+func (n *Notifier) FeedError(feed *model.Feed) {
+	n.enqueue(&event{name: model.IntegrationEventFeedError, userID: feed.UserID, feed: feed})
+}
+
+// Flush sends the notifications held during the quiet hours of a user.
+func (n *Notifier) Flush(userID int64, held []*HeldNotification) {
+	if len(held) > 0 {
+		n.enqueue(&event{userID: userID, held: held})
+	}
+}
+
+func (n *Notifier) enqueue(e *event) {
+	if n == nil || n.recipient == nil {
+		return
+	}
+
+	select {
+	case n.queue <- e:
+	default:
+		logger.Error("[Integration] Event queue is full, dropping %s event of user #%d", e.name, e.userID)
+	}
+}
+
+func (n *Notifier) run() {
+	for e := range n.queue {
+		n.process(e)
+	}
+}
+
+func (n *Notifier) process(e *event) {
+	if e.held != nil {
+		n.flush(e.userID, e.held)
+		return
+	}
+
+	user, integration, ok := n.lookup(e.userID)
+	if !ok {
+		return
+	}
+
+	routes := n.routes(integration, e.name, e.categoryID)
+	var notified bool
+	for _, service := range routes {
+		if service.save != nil {
+			for _, entry := range e.entries {
+				if err := service.save(entry); err != nil {
+					logger.Error("[Integration] UserID #%d: %v", e.userID, err)
+				}
+			}
+		}
+
+		notified = notified || service.notify != nil
+	}
+
+	if !notified {
+		return
+	}
+
+	notification := buildNotification(locale.NewPrinter(user.Language), e)
+	if until := user.QuietHoursEndAt(time.Now()); !until.IsZero() && n.holder != nil {
+		held := &HeldNotification{Event: e.name, CategoryID: e.categoryID, Notification: notification}
+		if err := n.holder(user.ID, held, until); err != nil {
+			logger.Error("[Integration] Unable to hold the %s notification of user #%d: %v", e.name, user.ID, err)
+		}
+		return
+	}
+
+	for _, service := range routes {
+		if service.notify != nil {
+			n.notify(integration, service, notification)
+		}
+	}
+}
+
+// routes returns the activated services receiving the event.
+func (n *Notifier) routes(integration *model.Integration, eventName string, categoryID int64) []*service {
+	var services []*service
+	for _, service := range n.services(integration) {
+		if integration.Filters.Filter(service.name).Accepts(eventName, categoryID) {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+func (n *Notifier) notify(integration *model.Integration, service *service, notification *Notification) {
+	if err := service.notify(notification.Title, notification.Message, notification.URL); err != nil {
+		logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	}
+}
+
+func (n *Notifier) lookup(userID int64) (*model.User, *model.Integration, bool) {
+	user, integration, err := n.recipient(userID)
+	if err != nil {
+		logger.Error("[Integration] Unable to route event of user #%d: %v", userID, err)
+		return nil, nil, false
+	}
+
+	if user == nil || integration == nil {
+		return nil, nil, false
+	}
+
+	return user, integration, true
+}
+
+// flush sends the notifications held during the quiet hours of a user,
+// each service receives the notifications it accepts as a single notification.
+func (n *Notifier) flush(userID int64, held []*HeldNotification) {
+	user, integration, ok := n.lookup(userID)
+	if !ok || len(held) == 0 {
+		return
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	for _, service := range n.services(integration) {
+		if service.notify == nil {
+			continue
+		}
+
+		filter := integration.Filters.Filter(service.name)
+		var notifications []*Notification
+		for _, h := range held {
+			if filter.Accepts(h.Event, h.CategoryID) {
+				notifications = append(notifications, h.Notification)
+			}
+		}
+
+		switch len(notifications) {
+		case 0:
+		case 1:
+			n.notify(integration, service, notifications[0])
+		default:
+			titles := make([]string, 0, len(notifications))
+			for _, notification := range notifications {
+				titles = append(titles, notification.Title)
+			}
+
+			n.notify(integration, service, &Notification{
+				Title:   printer.Plural("notification.quiet_hours", len(notifications), len(notifications)),
+				Message: joinLines(titles),
+			})
+		}
+	}
+}
+
+func buildNotification(printer *locale.Printer, e *event) *Notification {
+	if e.feed != nil {
+		return &Notification{
+			Title:   printer.Printf("notification.feed_error", e.feed.Title),
+			Message: e.feed.ParsingErrorMsg,
+			URL:     e.feed.SiteURL,
+		}
+	}
+
+	if len(e.entries) == 1 {
+		return &Notification{Title: e.entries[0].Title, Message: e.entries[0].URL, URL: e.entries[0].URL}
+	}
+
+	titles := make([]string, 0, len(e.entries))
+	for _, entry := range e.entries {
+		titles = append(titles, entry.Title)
+	}
+
+	return &Notification{
+		Title:   printer.Plural("notification.new_entries", len(e.entries), len(e.entries)),
+		Message: joinLines(titles),
+	}
+}
+
+// joinLines lists the first lines, one per line.
+func joinLines(lines []string) string {
+	if len(lines) > maxNotificationLines {
+		lines = append(lines[:maxNotificationLines:maxNotificationLines], "…")
+	}
+
+	return strings.Join(lines, "\n")
+}
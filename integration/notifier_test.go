@@ -0,0 +1,212 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// recorder stands for an activated service and keeps what it receives.
+type recorder struct {
+	name          string
+	saved         model.Entries
+	notifications []*Notification
+}
+
+func (r *recorder) service() *service {
+	s := &service{name: r.name}
+	switch r.name {
+	case "ntfy", "pushover", "apprise":
+		s.notify = func(title, message, link string) error {
+			r.notifications = append(r.notifications, &Notification{Title: title, Message: message, URL: link})
+			return nil
+		}
+	default:
+		s.save = func(entry *model.Entry) error {
+			r.saved = append(r.saved, entry)
+			return nil
+		}
+	}
+	return s
+}
+
+func newTestNotifier(user *model.User, integration *model.Integration, recorders ...*recorder) *Notifier {
+	return &Notifier{
+		recipient: func(userID int64) (*model.User, *model.Integration, error) {
+			return user, integration, nil
+		},
+		services: func(integration *model.Integration) []*service {
+			var services []*service
+			for _, r := range recorders {
+				services = append(services, r.service())
+			}
+			return services
+		},
+	}
+}
+
+func TestNilNotifier(t *testing.T) {
+	var n *Notifier
+	n.SetRecipient(nil)
+	n.EntryStarred(&model.Entry{Title: "Title"})
+	n.NewEntries(1, 1, model.Entries{&model.Entry{Title: "Title"}})
+	n.FeedError(&model.Feed{UserID: 1})
+}
+
+func TestNotifyNewEntry(t *testing.T) {
+	ntfy := &recorder{name: "ntfy"}
+	n := newTestNotifier(&model.User{ID: 1, Language: "en_US"}, &model.Integration{}, ntfy)
+	n.process(&event{name: model.IntegrationEventNewEntries, userID: 1, entries: model.Entries{&model.Entry{Title: "Title", URL: "http://example.org/a"}}})
+
+	if len(ntfy.notifications) != 1 {
+		t.Fatalf(`Unexpected number of notifications: %d`, len(ntfy.notifications))
+	}
+
+	if ntfy.notifications[0].Title != "Title" || ntfy.notifications[0].URL != "http://example.org/a" {
+		t.Errorf(`Unexpected notification: %+v`, ntfy.notifications[0])
+	}
+}
+
+func TestNotifyNewEntries(t *testing.T) {
+	pushover := &recorder{name: "pushover"}
+	n := newTestNotifier(&model.User{ID: 1, Language: "en_US"}, &model.Integration{}, pushover)
+	n.process(&event{name: model.IntegrationEventNewEntries, userID: 1, entries: model.Entries{&model.Entry{Title: "A"}, &model.Entry{Title: "B"}}})
+
+	if len(pushover.notifications) != 1 {
+		t.Fatalf(`Unexpected number of notifications: %d`, len(pushover.notifications))
+	}
+
+	if pushover.notifications[0].Title != "2 new entries" || pushover.notifications[0].Message != "A\nB" {
+		t.Errorf(`Unexpected notification: %+v`, pushover.notifications[0])
+	}
+}
+
+func TestNotifyFeedError(t *testing.T) {
+	apprise := &recorder{name: "apprise"}
+	n := newTestNotifier(&model.User{ID: 1, Language: "en_US"}, &model.Integration{}, apprise)
+	n.process(&event{name: model.IntegrationEventFeedError, userID: 1, feed: &model.Feed{Title: "Example", ParsingErrorMsg: "Timeout"}})
+
+	if len(apprise.notifications) != 1 || apprise.notifications[0].Title != "Unable to refresh the feed Example" || apprise.notifications[0].Message != "Timeout" {
+		t.Errorf(`Unexpected notifications: %+v`, apprise.notifications)
+	}
+}
+
+func TestRouteWithFilters(t *testing.T) {
+	pinboard := &recorder{name: "pinboard"}
+	wallabag := &recorder{name: "wallabag"}
+	ntfy := &recorder{name: "ntfy"}
+	pushover := &recorder{name: "pushover"}
+	integration := &model.Integration{Filters: model.IntegrationFilters{
+		"pinboard": {Events: []string{model.IntegrationEventStarred}},
+		"ntfy":     {Events: []string{model.IntegrationEventNewEntries}, CategoryIDs: []int64{2}},
+		"pushover": {Events: []string{model.IntegrationEventFeedError}},
+	}}
+	n := newTestNotifier(&model.User{ID: 1, Language: "en_US"}, integration, pinboard, wallabag, ntfy, pushover)
+
+	n.process(&event{name: model.IntegrationEventStarred, userID: 1, categoryID: 1, entries: model.Entries{&model.Entry{Title: "Starred"}}})
+	n.process(&event{name: model.IntegrationEventNewEntries, userID: 1, categoryID: 1, entries: model.Entries{&model.Entry{Title: "Other category"}}})
+	n.process(&event{name: model.IntegrationEventNewEntries, userID: 1, categoryID: 2, entries: model.Entries{&model.Entry{Title: "Selected category"}}})
+	n.process(&event{name: model.IntegrationEventFeedError, userID: 1, feed: &model.Feed{Title: "Example"}})
+
+	if len(pinboard.saved) != 1 || pinboard.saved[0].Title != "Starred" {
+		t.Errorf(`Only starred entries should be saved to Pinboard, got %v`, pinboard.saved)
+	}
+
+	if len(wallabag.saved) != 0 {
+		t.Errorf(`Services without filter should not receive events, got %v`, wallabag.saved)
+	}
+
+	if len(ntfy.notifications) != 1 || ntfy.notifications[0].Title != "Selected category" {
+		t.Errorf(`Only new entries of the selected category should be sent to ntfy, got %+v`, ntfy.notifications)
+	}
+
+	if len(pushover.notifications) != 1 || pushover.notifications[0].Title != "Unable to refresh the feed Example" {
+		t.Errorf(`Only feed errors should be sent to Pushover, got %+v`, pushover.notifications)
+	}
+}
+
+func TestNotifyDuringQuietHours(t *testing.T) {
+	now := time.Now().UTC()
+	user := &model.User{
+		ID:              1,
+		Language:        "en_US",
+		Timezone:        "UTC",
+		QuietHoursStart: now.Add(-time.Hour).Format("15:04"),
+		QuietHoursEnd:   now.Add(time.Hour).Format("15:04"),
+	}
+
+	ntfy := &recorder{name: "ntfy"}
+	apprise := &recorder{name: "apprise"}
+	integration := &model.Integration{Filters: model.IntegrationFilters{
+		"apprise": {Events: []string{model.IntegrationEventFeedError}},
+	}}
+	n := newTestNotifier(user, integration, ntfy, apprise)
+
+	var held []*HeldNotification
+	n.SetHolder(func(userID int64, notification *HeldNotification, until time.Time) error {
+		if userID != 1 || !until.After(now) {
+			t.Errorf(`Unexpected held notification of user #%d until %v`, userID, until)
+		}
+		held = append(held, notification)
+		return nil
+	})
+
+	n.process(&event{name: model.IntegrationEventNewEntries, userID: 1, entries: model.Entries{&model.Entry{Title: "A"}}})
+	n.process(&event{name: model.IntegrationEventFeedError, userID: 1, feed: &model.Feed{Title: "Example"}})
+
+	if len(ntfy.notifications) != 0 || len(apprise.notifications) != 0 {
+		t.Fatalf(`Notifications should be held during quiet hours`)
+	}
+
+	if len(held) != 2 {
+		t.Fatalf(`Notifications should be given to the holder, got %d`, len(held))
+	}
+
+	// The held notifications are stored as JSON until the end of the quiet hours.
+	data, err := json.Marshal(held)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	held = nil
+	if err := json.Unmarshal(data, &held); err != nil {
+		t.Fatal(err)
+	}
+
+	n.process(&event{userID: 1, held: held})
+
+	if len(ntfy.notifications) != 1 {
+		t.Fatalf(`Held notifications should be sent as a single notification, got %d`, len(ntfy.notifications))
+	}
+
+	if ntfy.notifications[0].Title != "2 notifications during quiet hours" || ntfy.notifications[0].Message != "A\nUnable to refresh the feed Example" {
+		t.Errorf(`Unexpected notification: %+v`, ntfy.notifications[0])
+	}
+
+	if len(apprise.notifications) != 1 || apprise.notifications[0].Title != "Unable to refresh the feed Example" {
+		t.Errorf(`Only the accepted held notifications should be sent, got %+v`, apprise.notifications)
+	}
+}
+
+func TestJoinLines(t *testing.T) {
+	lines := make([]string, 12)
+	for i := range lines {
+		lines[i] = "line"
+	}
+
+	result := joinLines(lines)
+	expected := "line\nline\nline\nline\nline\nline\nline\nline\nline\nline\n…"
+	if result != expected {
+		t.Errorf(`Unexpected result: %q`, result)
+	}
+
+	if len(lines) != 12 || lines[10] != "line" {
+		t.Error(`The original lines should not be modified`)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package ntfy provides an integration with ntfy.
+
+*/
+package ntfy // import "miniflux.app/integration/ntfy"
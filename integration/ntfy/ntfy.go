@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ntfy // import "miniflux.app/integration/ntfy"
+
+import (
+	"fmt"
+
+	"miniflux.app/http/client"
+)
+
+// DefaultServerURL is the public ntfy server.
+const DefaultServerURL = "https://ntfy.sh"
+
+// Message is a notification published to a ntfy topic.
+type Message struct {
+	Topic   string `json:"topic"`
+	Title   string `json:"title,omitempty"`
+	Message string `json:"message"`
+	Click   string `json:"click,omitempty"`
+}
+
+// Client represents a ntfy client.
+type Client struct {
+	serverURL string
+	topic     string
+	token     string
+}
+
+// SendNotification publishes a message to the topic.
+func (c *Client) SendNotification(title, message, link string) error {
+	if c.topic == "" {
+		return fmt.Errorf("ntfy: missing topic")
+	}
+
+	clt := client.New(c.serverURL)
+	if c.token != "" {
+		clt.WithAuthorization("Bearer " + c.token)
+	}
+
+	response, err := clt.PostJSON(&Message{Topic: c.topic, Title: title, Message: message, Click: link})
+	if err != nil {
+		return fmt.Errorf("ntfy: unable to send notification: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("ntfy: unable to send notification, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new ntfy client, the public server is used when the server URL is empty.
+func NewClient(serverURL, topic, token string) *Client {
+	if serverURL == "" {
+		serverURL = DefaultServerURL
+	}
+
+	return &Client{serverURL: serverURL, topic: topic, token: token}
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package omnivore // import "miniflux.app/integration/omnivore"
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"miniflux.app/http/client"
+)
+
+// DefaultAPIEndpoint is the GraphQL endpoint of the hosted Omnivore service.
+const DefaultAPIEndpoint = "https://api-prod.omnivore.app/api/graphql"
+
+const saveURLMutation = `
+mutation SaveUrl($input: SaveUrlInput!) {
+  saveUrl(input: $input) {
+    ... on SaveSuccess {
+      url
+    }
+    ... on SaveError {
+      errorCodes
+      message
+    }
+  }
+}`
+
+type request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type saveURLInput struct {
+	ClientRequestID string `json:"clientRequestId"`
+	Source          string `json:"source"`
+	URL             string `json:"url"`
+}
+
+type response struct {
+	Data struct {
+		SaveURL struct {
+			URL        string   `json:"url"`
+			ErrorCodes []string `json:"errorCodes"`
+			Message    string   `json:"message"`
+		} `json:"saveUrl"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Client represents an Omnivore client.
+type Client struct {
+	apiURL string
+	apiKey string
+}
+
+// AddURL saves a web page to Omnivore.
+func (c *Client) AddURL(link string) error {
+	if c.apiKey == "" {
+		return fmt.Errorf("omnivore: missing API key")
+	}
+
+	requestID, err := newRequestID()
+	if err != nil {
+		return err
+	}
+
+	payload := &request{
+		Query: saveURLMutation,
+		Variables: map[string]interface{}{
+			"input": &saveURLInput{ClientRequestID: requestID, Source: "api", URL: link},
+		},
+	}
+
+	clt := client.New(c.apiURL)
+	clt.WithAuthorization(c.apiKey)
+	resp, err := clt.PostJSON(payload)
+	if err != nil {
+		return fmt.Errorf("omnivore: unable to send entry: %v", err)
+	}
+
+	if resp.HasServerFailure() {
+		return fmt.Errorf("omnivore: unable to send entry, status=%d", resp.StatusCode)
+	}
+
+	// GraphQL errors are returned with a successful status code.
+	var result response
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("omnivore: unable to decode response: %v", err)
+	}
+
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("omnivore: unable to send entry: %s", result.Errors[0].Message)
+	}
+
+	if len(result.Data.SaveURL.ErrorCodes) > 0 {
+		return fmt.Errorf("omnivore: unable to send entry: %v %s", result.Data.SaveURL.ErrorCodes, result.Data.SaveURL.Message)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Omnivore client, the hosted service is used when the API endpoint is empty.
+func NewClient(apiURL, apiKey string) *Client {
+	if apiURL == "" {
+		apiURL = DefaultAPIEndpoint
+	}
+
+	return &Client{apiURL: apiURL, apiKey: apiKey}
+}
+
+// newRequestID returns a random UUID identifying the request.
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("omnivore: unable to generate request ID: %v", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
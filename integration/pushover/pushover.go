@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package pushover // import "miniflux.app/integration/pushover"
+
+import (
+	"fmt"
+	"net/url"
+
+	"miniflux.app/http/client"
+)
+
+const apiURL = "https://api.pushover.net/1/messages.json"
+
+// Client represents a Pushover client.
+type Client struct {
+	token   string
+	userKey string
+}
+
+// SendNotification sends a message to the devices of the user.
+func (c *Client) SendNotification(title, message, link string) error {
+	if c.token == "" || c.userKey == "" {
+		return fmt.Errorf("pushover: missing credentials")
+	}
+
+	values := url.Values{}
+	values.Add("token", c.token)
+	values.Add("user", c.userKey)
+	values.Add("title", title)
+	values.Add("message", message)
+	if link != "" {
+		values.Add("url", link)
+	}
+
+	clt := client.New(apiURL)
+	response, err := clt.PostForm(values)
+	if err != nil {
+		return fmt.Errorf("pushover: unable to send notification: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("pushover: unable to send notification, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Pushover client.
+func NewClient(token, userKey string) *Client {
+	return &Client{token: token, userKey: userKey}
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readeck // import "miniflux.app/integration/readeck"
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+// Bookmark is a web page saved in Readeck.
+type Bookmark struct {
+	URL    string   `json:"url"`
+	Title  string   `json:"title,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// Client represents a Readeck client.
+type Client struct {
+	baseURL string
+	apiKey  string
+	labels  string
+}
+
+// AddEntry saves an entry to Readeck, the page is downloaded by the Readeck server.
+func (c *Client) AddEntry(link, title string) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return fmt.Errorf("readeck: missing credentials")
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/bookmarks")
+	if err != nil {
+		return err
+	}
+
+	bookmark := &Bookmark{URL: link, Title: title, Labels: splitLabels(c.labels)}
+
+	clt := client.New(apiURL)
+	clt.WithAuthorization("Bearer " + c.apiKey)
+	response, err := clt.PostJSON(bookmark)
+	if err != nil {
+		return fmt.Errorf("readeck: unable to send entry: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("readeck: unable to send entry, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Readeck client, labels are separated by commas.
+func NewClient(baseURL, apiKey, labels string) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey, labels: labels}
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("readeck: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
+
+func splitLabels(labels string) []string {
+	var result []string
+	for _, label := range strings.Split(labels, ",") {
+		label = strings.TrimSpace(label)
+		if label != "" {
+			result = append(result, label)
+		}
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import (
+	"math"
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Layout used when the language doesn't define its own date format.
+const defaultAbsoluteTimeLayout = "2006-01-02 15:04"
+
+// ElapsedTime returns the time elapsed since t in the given timezone, for example "3 hours ago".
+func (p *Printer) ElapsedTime(tz string, t time.Time) string {
+	if t.IsZero() {
+		return p.Printf("time_elapsed.not_yet")
+	}
+
+	now := timezone.Now(tz)
+	t = timezone.Convert(tz, t)
+	if now.Before(t) {
+		return p.Printf("time_elapsed.not_yet")
+	}
+
+	diff := now.Sub(t)
+	// Duration in seconds
+	s := diff.Seconds()
+	// Duration in days
+	d := int(s / 86400)
+	switch {
+	case s < 60:
+		return p.Printf("time_elapsed.now")
+	case s < 3600:
+		minutes := int(diff.Minutes())
+		return p.Plural("time_elapsed.minutes", minutes, minutes)
+	case s < 86400:
+		hours := int(diff.Hours())
+		return p.Plural("time_elapsed.hours", hours, hours)
+	case d == 1:
+		return p.Printf("time_elapsed.yesterday")
+	case d < 21:
+		return p.Plural("time_elapsed.days", d, d)
+	case d < 31:
+		weeks := int(math.Round(float64(d) / 7))
+		return p.Plural("time_elapsed.weeks", weeks, weeks)
+	case d < 365:
+		months := int(math.Round(float64(d) / 30))
+		return p.Plural("time_elapsed.months", months, months)
+	default:
+		years := int(math.Round(float64(d) / 365))
+		return p.Plural("time_elapsed.years", years, years)
+	}
+}
+
+// AbsoluteTime returns t in the given timezone with the date format of the language.
+func (p *Printer) AbsoluteTime(tz string, t time.Time) string {
+	if t.IsZero() {
+		return p.Printf("time_elapsed.not_yet")
+	}
+
+	layout := p.Printf("time_format.absolute")
+	if layout == "time_format.absolute" {
+		layout = defaultAbsoluteTimeLayout
+	}
+
+	return timezone.Convert(tz, t).Format(layout)
+}
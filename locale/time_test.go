@@ -0,0 +1,69 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestElapsedTime(t *testing.T) {
+	printer := NewPrinter("en_US")
+	var dt = []struct {
+		in  time.Time
+		out string
+	}{
+		{time.Time{}, printer.Printf("time_elapsed.not_yet")},
+		{time.Now().Add(time.Hour), printer.Printf("time_elapsed.not_yet")},
+		{time.Now(), printer.Printf("time_elapsed.now")},
+		{time.Now().Add(-time.Minute), printer.Plural("time_elapsed.minutes", 1, 1)},
+		{time.Now().Add(-time.Minute * 40), printer.Plural("time_elapsed.minutes", 40, 40)},
+		{time.Now().Add(-time.Hour), printer.Plural("time_elapsed.hours", 1, 1)},
+		{time.Now().Add(-time.Hour * 3), printer.Plural("time_elapsed.hours", 3, 3)},
+		{time.Now().Add(-time.Hour * 32), printer.Printf("time_elapsed.yesterday")},
+		{time.Now().Add(-time.Hour * 24 * 3), printer.Plural("time_elapsed.days", 3, 3)},
+		{time.Now().Add(-time.Hour * 24 * 14), printer.Plural("time_elapsed.days", 14, 14)},
+		{time.Now().Add(-time.Hour * 24 * 15), printer.Plural("time_elapsed.days", 15, 15)},
+		{time.Now().Add(-time.Hour * 24 * 21), printer.Plural("time_elapsed.weeks", 3, 3)},
+		{time.Now().Add(-time.Hour * 24 * 32), printer.Plural("time_elapsed.months", 1, 1)},
+		{time.Now().Add(-time.Hour * 24 * 60), printer.Plural("time_elapsed.months", 2, 2)},
+		{time.Now().Add(-time.Hour * 24 * 366), printer.Plural("time_elapsed.years", 1, 1)},
+		{time.Now().Add(-time.Hour * 24 * 365 * 3), printer.Plural("time_elapsed.years", 3, 3)},
+	}
+	for i, tt := range dt {
+		if out := printer.ElapsedTime("Local", tt.in); out != tt.out {
+			t.Errorf(`%d. content mismatch for "%v": expected=%q got=%q`, i, tt.in, tt.out, out)
+		}
+	}
+}
+
+func TestAbsoluteTime(t *testing.T) {
+	defaultCatalog = catalog{
+		"fr_FR": translationDict{
+			"time_format.absolute": "02/01/2006 15:04",
+		},
+	}
+
+	date := time.Date(2019, time.March, 4, 17, 30, 0, 0, time.UTC)
+
+	result := NewPrinter("fr_FR").AbsoluteTime("Europe/Paris", date)
+	expected := "04/03/2019 18:30"
+	if result != expected {
+		t.Errorf(`Unexpected absolute time, got %q instead of %q`, result, expected)
+	}
+
+	result = NewPrinter("en_US").AbsoluteTime("UTC", date)
+	expected = "2019-03-04 17:30"
+	if result != expected {
+		t.Errorf(`Unexpected absolute time with the default layout, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestAbsoluteTimeWithZeroTime(t *testing.T) {
+	printer := NewPrinter("en_US")
+	if result := printer.AbsoluteTime("UTC", time.Time{}); result != printer.Printf("time_elapsed.not_yet") {
+		t.Errorf(`Unexpected absolute time for a zero time, got %q`, result)
+	}
+}
@@ -19,6 +19,16 @@ var translations = map[string]string{
     "action.download": "Herunterladen",
     "action.import": "Importieren",
     "action.login": "Anmelden",
+    "action.signup": "Registrieren",
+    "action.send_reset_link": "Link senden",
+    "action.delete_account": "Mein Konto löschen",
+    "action.invite": "Einladen",
+    "action.show": "Anzeigen",
+    "action.mute_for_a_month": "Einen Monat stummschalten",
+    "action.unsubscribe": "Abbestellen",
+    "action.share": "Teilen",
+    "action.approve": "Genehmigen",
+    "action.reject": "Ablehnen",
     "tooltip.keyboard_shortcuts": "Tastenkürzel: %s",
     "tooltip.logged_user": "Angemeldet als %s",
     "menu.unread": "Ungelesen",
@@ -31,7 +41,10 @@ var translations = map[string]string{
     "menu.preferences": "Einstellungen",
     "menu.integrations": "Dienste",
     "menu.sessions": "Sitzungen",
+    "menu.delete_account": "Konto löschen",
     "menu.users": "Benutzer",
+    "menu.invitations": "Einladungen",
+    "menu.statistics": "Statistiken",
     "menu.about": "Über",
     "menu.export": "Exportieren",
     "menu.import": "Importieren",
@@ -39,15 +52,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Diese Seite als gelesen markieren",
     "menu.mark_all_as_read": "Alle als gelesen markieren",
     "menu.mark_all_as_read_wip": "In Arbeit...",
+    "menu.undo_mark_all_as_read": "Alle als gelesen markieren rückgängig machen",
     "menu.show_all_entries": "Zeige alle Artikel",
     "menu.show_only_unread_entries": "Nur ungelesene Artikel anzeigen",
     "menu.refresh_feed": "Aktualisieren",
     "menu.refresh_all_feeds": "Alle Abonnements im Hintergrund aktualisieren",
     "menu.edit_feed": "Bearbeiten",
     "menu.edit_category": "Bearbeiten",
+    "menu.share_category": "Teilen",
     "menu.add_feed": "Abonnement hinzufügen",
     "menu.add_user": "Benutzer anlegen",
     "menu.flush_history": "Verlauf leeren",
+    "menu.recently_read": "Kürzlich gelesen",
+    "menu.junk": "Junk",
+    "menu.export_epub": "Als EPUB herunterladen",
+    "menu.export_printable": "Druckversion",
+    "menu.least_read_feeds": "Am wenigsten gelesene Abonnements",
+    "menu.pending_feeds": "Ausstehende Abonnements",
     "search.label": "Suche",
     "search.placeholder": "Suche...",
     "pagination.next": "Nächste",
@@ -58,16 +79,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Lesezeichen hinzufügen",
     "entry.bookmark.toggle.off": "Lesezeichen entfernen",
     "entry.state.saving": "Speichern...",
+    "entry.state.sending": "Wird gesendet...",
     "entry.state.loading": "Lade...",
     "entry.save.label": "Speichern",
     "entry.save.title": "Diesen Artikel speichern",
     "entry.save.completed": "Erledigt!",
+    "entry.kindle.label": "An Kindle senden",
+    "entry.kindle.title": "Diesen Artikel an meinen Kindle senden",
+    "entry.kindle.completed": "Gesendet!",
     "entry.scraper.label": "Inhalt herunterladen",
     "entry.scraper.title": "Inhalt herunterladen",
     "entry.scraper.completed": "Erledigt!",
+    "entry.summarize.label": "Zusammenfassen",
+    "entry.summarize.title": "Eine Zusammenfassung dieses Artikels erstellen",
+    "entry.summarize.completed": "Erledigt!",
     "entry.original.label": "Original-Artikel",
     "entry.comments.label": "Kommentare",
+    "entry.snapshot.title": "Die gespeicherte Kopie der Webseite lesen",
+    "entry.snapshot.label": "Kopie",
     "entry.comments.title": "Kommentare anzeigen",
+    "entry.summary.read_more": "Weiterlesen",
+    "entry.watch.title": "Änderungen auf %s (%s)",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
     "page.categories.title": "Kategorien",
@@ -76,17 +108,37 @@ var translations = map[string]string{
         "Es gibt %d Abonnement.",
         "Es gibt %d Abonnements."
     ],
+    "page.categories.shared_with_me": "Mit mir geteilt",
     "page.new_category.title": "Neue Kategorie",
     "page.new_user.title": "Neuer Benutzer",
     "page.edit_category.title": "Kategorie bearbeiten: %s",
+    "page.category_shares.title": "Kategorie teilen: %s",
+    "page.category_shares.since": "Geteilt seit",
+    "page.shared_category_entries.shared_by": "Geteilt von %s",
     "page.edit_user.title": "Benutzer bearbeiten: %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Letzte Aktualisierung:",
+    "page.feeds.muted_until": "Stummgeschaltet bis:",
+    "page.feeds.pending": "Wartet auf Genehmigung",
     "page.feeds.error_count": [
         "%d Fehler",
         "%d Fehler"
     ],
     "page.history.title": "Verlauf",
+    "page.recently_read.title": "Kürzlich gelesen",
+    "page.junk.title": "Junk",
+    "page.junk.help": "Artikel mit einer Junk-Bewertung von mindestens %d.",
+    "page.junk.score": "Junk-Bewertung: %d",
+    "page.recently_read.read_at": "Gelesen",
+    "page.least_read_feeds.title": "Am wenigsten gelesene Abonnements",
+    "page.least_read_feeds.last_read": "Zuletzt gelesen:",
+    "page.least_read_feeds.never_read": "Nie gelesen",
+    "page.least_read_feeds.unread_count": [
+        "%d ungelesener Artikel",
+        "%d ungelesene Artikel"
+    ],
+    "page.pending_feeds.title": "Ausstehende Abonnements",
+    "page.pending_feeds.requested_by": "Angefragt von %s",
     "page.import.title": "Importieren",
     "page.search.title": "Suchergebnisse",
     "page.about.title": "Über",
@@ -100,6 +152,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Abonnement suchen",
     "page.add_feed.legend.advanced_options": "Erweiterte Optionen",
+    "page.add_feed.watch_selector_help": "Füllen Sie dieses Feld aus, um eine Webseite ohne Feed zu überwachen: Ein Artikel wird erstellt, wenn sich der Text der passenden Elemente ändert.",
     "page.add_feed.choose_feed": "Abonnement auswählen",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
@@ -142,11 +195,39 @@ var translations = map[string]string{
     "page.users.actions": "Aktionen",
     "page.users.last_login": "Letzte Anmeldung",
     "page.users.is_admin": "Administrator",
+    "page.users.not_verified": "E-Mail nicht bestätigt",
+    "page.users.pending": "Wartet auf Freigabe",
+    "page.invitations.title": "Einladungen",
+    "page.invitations.expires": "Läuft ab",
+    "page.invitations.status": "Status",
+    "page.invitations.used": "Verwendet",
+    "page.invitations.unused": "Nicht verwendet",
+    "page.statistics.title": "Statistiken",
+    "page.statistics.users": "Benutzer",
+    "page.statistics.feeds": "Abonnements",
+    "page.statistics.entries": "Artikel (geschätzt)",
+    "page.statistics.database_size": "Datenbankgröße",
+    "page.statistics.fetches_last_hour": "Aktualisierungen in der letzten Stunde",
+    "page.statistics.fetches_last_day": "Aktualisierungen in den letzten 24 Stunden",
+    "page.statistics.fetch_success_rate": "Erfolgreiche Aktualisierungen",
+    "page.statistics.shared_feeds": "Von mehreren Benutzern abonnierte Feeds",
+    "page.statistics.feed_url": "Feed-URL",
+    "page.statistics.subscribers": "Abonnenten",
+    "page.statistics.error_domains": "Domains mit den meisten Fehlern",
+    "page.statistics.domain": "Domain",
+    "page.statistics.error_feeds": "Fehlerhafte Feeds",
     "page.settings.title": "Einstellungen",
     "page.settings.link_google_account": "Google Konto verknüpfen",
     "page.settings.unlink_google_account": "Diese Kategorie existiert nicht für diesen Benutzer",
     "page.login.title": "Anmeldung",
     "page.login.google_signin": "Anmeldung mit Google",
+    "page.login.no_account": "Noch kein Konto?",
+    "page.signup.title": "Registrierung",
+    "page.login.forgot_password": "Passwort vergessen?",
+    "page.forgot_password.title": "Passwort vergessen",
+    "page.reset_password.title": "Passwort zurücksetzen",
+    "page.delete_account.title": "Konto löschen",
+    "page.delete_account.description": "Ihre Abonnements, Artikel, Kategorien und Integrationen werden endgültig gelöscht. Sie werden sofort abgemeldet und diese Aktion kann nicht rückgängig gemacht werden.",
     "page.integrations.title": "Dienste",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpunkt",
@@ -154,9 +235,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Passwort",
     "page.integration.miniflux_api_password_value": "Ihr Konto Passwort",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Browser-Erweiterung",
+    "page.integration.browser_extension_endpoint": "Endpunkt der Erweiterung",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "Kein Token erstellt",
+    "page.integration.browser_extension_generate_token": "Neues Token erstellen",
     "page.integration.bookmarklet.name": "Mit Miniflux abonnieren",
     "page.integration.bookmarklet.instructions": "Ziehen Sie diesen Link in Ihre Lesezeichen.",
     "page.integration.bookmarklet.help": "Dieser spezielle Link ermöglicht es, eine Webseite direkt über ein Lesezeichen im Browser zu abonnieren.",
+    "page.entry_snapshot.taken": "Gespeichert",
+    "page.entry_snapshot.refresh": "Neue Kopie speichern",
+    "page.entry_snapshot.last_error": "Der letzte Kopierversuch ist fehlgeschlagen:",
+    "page.entry_snapshot.empty": "Es gibt noch keine gespeicherte Kopie dieser Webseite.",
     "page.sessions.title": "Sitzungen",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP Addresse",
@@ -166,29 +256,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Es existiert derzeit kein Lesezeichen.",
     "alert.no_category": "Es ist keine Kategorie vorhanden.",
     "alert.no_category_entry": "Es befindet sich kein Artikel in dieser Kategorie.",
+    "alert.no_category_share": "Diese Kategorie wird mit niemandem geteilt.",
     "alert.no_feed_entry": "Es existiert kein Artikel für dieses Abonnement.",
     "alert.no_feed": "Es sind keine Abonnements vorhanden.",
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
+    "alert.no_junk": "Es gibt keine Junk-Artikel.",
+    "alert.no_least_read_feed": "Sie haben in diesem Zeitraum Artikel aller Ihrer Abonnements gelesen.",
+    "alert.no_pending_feed": "Es gibt keine Abonnements, die auf eine Genehmigung warten.",
+    "alert.feed_pending": "Das Abonnement wurde gespeichert, die Artikel werden heruntergeladen, sobald ein Administrator es genehmigt.",
+    "alert.no_invitation": "Es gibt keine Einladung.",
+    "alert.no_shared_feed": "Kein Feed wird von mehreren Benutzern abonniert.",
+    "alert.no_error_domain": "Keine Domain hat fehlerhafte Feeds.",
+    "alert.signup_verification_sent": "Ein Bestätigungslink wurde an %s gesendet.",
+    "alert.account_verified": "Ihre E-Mail-Adresse ist bestätigt, Sie können sich jetzt anmelden.",
+    "alert.account_verified_pending": "Ihre E-Mail-Adresse ist bestätigt, Ihr Konto wird aktiviert, sobald ein Administrator es freigibt.",
+    "alert.invitation_created": "Die Einladung wurde erstellt, teilen Sie diesen Link: %s",
+    "alert.password_reset_sent": "Falls ein Konto %s verwendet, wurde ein Link zum Festlegen eines neuen Passworts gesendet.",
+    "alert.password_reset": "Ihr Passwort wurde geändert, Sie können sich jetzt anmelden.",
+    "alert.account_deletion_requested": "Ihr Konto wurde geschlossen, Ihre Daten werden in Kürze gelöscht.",
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
+    "alert.read_only": "Diese Instanz ist schreibgeschützt, Sie können sie durchsuchen, aber Ihre Änderungen werden nicht gespeichert.",
+    "alert.write_freeze": "Änderungen an Ihrem Konto sind wegen Wartungsarbeiten bis %s deaktiviert.",
     "alert.no_user": "Sie sind der einzige Benutzer.",
     "alert.account_unlinked": "Ihr externer Account ist jetzt getrennt!",
     "alert.account_linked": "Ihr externes Konto wurde verknüpft!",
     "alert.pocket_linked": "Ihr Pocket Konto ist jetzt verknüpft!",
     "alert.prefs_saved": "Einstellungen gespeichert!",
+    "alert.extension_token_generated": "Ein neues Token wurde erstellt, das vorherige funktioniert nicht mehr.",
+    "alert.entry_snapshot_saved": "Eine neue Kopie der Webseite wurde gespeichert.",
     "error.unlink_account_without_password": "Sie müssen ein Passwort festlegen, sonst können Sie sich nicht erneut anmelden.",
     "error.duplicate_linked_account": "Es ist bereits jemand mit diesem Anbieter assoziiert!",
     "error.duplicate_fever_username": "Es existiert bereits jemand mit diesem Fever Benutzernamen!",
     "error.pocket_request_token": "Anfrage-Token konnte nicht von Pocket abgerufen werden!",
     "error.pocket_access_token": "Zugriffstoken konnte nicht von Pocket abgerufen werden!",
+    "error.entry_snapshot_failed": "Die Webseite konnte nicht heruntergeladen werden, die vorherige Kopie wurde beibehalten.",
     "error.category_already_exists": "Diese Kategorie existiert bereits.",
     "error.unable_to_create_category": "Diese Kategorie konnte nicht angelegt werden.",
     "error.unable_to_update_category": "Diese Kategorie konnte nicht aktualisiert werden.",
+    "error.category_changed": "Diese Kategorie wurde zwischenzeitlich geändert, senden Sie das Formular erneut, um die Änderungen zu überschreiben.",
+    "error.read_only": "Diese Instanz ist schreibgeschützt, Änderungen sind nicht erlaubt.",
+    "error.write_freeze": "Änderungen an Ihrem Konto sind vorübergehend deaktiviert.",
+    "error.category_share_user_not_found": "Dieser Benutzer existiert nicht.",
+    "error.invalid_email": "Diese E-Mail-Adresse ist ungültig.",
+    "error.email_domain_not_allowed": "Diese E-Mail-Domain ist nicht erlaubt.",
+    "error.email_already_exists": "Diese E-Mail-Adresse wird bereits verwendet.",
+    "error.invalid_invitation": "Dieser Einladungscode ist ungültig oder abgelaufen.",
+    "error.unable_to_send_email": "Die E-Mail konnte nicht gesendet werden, bitte versuchen Sie es später erneut.",
+    "error.invalid_verification_link": "Dieser Bestätigungslink ist ungültig oder abgelaufen.",
+    "error.user_not_verified": "Ihre E-Mail-Adresse ist noch nicht bestätigt, öffnen Sie den per E-Mail gesendeten Link.",
+    "error.user_pending": "Ihr Konto wartet auf die Freigabe durch einen Administrator.",
+    "error.invalid_password_reset_link": "Dieser Link zum Zurücksetzen des Passworts ist ungültig oder abgelaufen.",
+    "error.account_deletion_admin": "Ein Administratorkonto muss von einem anderen Administrator gelöscht werden.",
+    "error.invalid_password": "Das Passwort ist falsch.",
     "error.user_already_exists": "Dieser Benutzer existiert bereits.",
     "error.unable_to_create_user": "Dieser Benutzer kann nicht erstellt werden.",
     "error.unable_to_update_user": "Dieser Benutzer konnte nicht aktualisiert werden.",
     "error.unable_to_update_feed": "Dieser Feed konnte nicht aktualisiert werden.",
+    "error.feed_changed": "Dieser Feed wurde zwischenzeitlich geändert, senden Sie das Formular erneut, um die Änderungen zu überschreiben.",
     "error.subscription_not_found": "Es wurden keine Abonnements gefunden.",
     "error.empty_file": "Diese Datei ist leer.",
     "error.bad_credentials": "Benutzername oder Passwort ungültig.",
@@ -197,27 +323,107 @@ var translations = map[string]string{
     "error.different_passwords": "Passwörter stimmen nicht überein.",
     "error.password_min_length": "Wenigstens 6 Zeichen müssen genutzt werden.",
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
+    "error.entries_per_page_invalid": "Die Anzahl der Artikel pro Seite muss zwischen 1 und %d liegen.",
+    "error.quiet_hours_invalid": "Beginn und Ende der Ruhezeit müssen beide im Format HH:MM angegeben oder beide leer sein.",
+    "error.integration_filters_invalid": "Die für die Integrationen ausgewählten Ereignisse sind ungültig.",
+    "error.kindle_email_invalid": "Die Kindle-E-Mail-Adresse ist ungültig.",
+    "error.bulk_empty_selection": "Es wurde kein Element ausgewählt.",
+    "error.category_not_found": "Diese Kategorie existiert nicht oder gehört nicht zu diesem Benutzer.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.feed_invalid_script": "Ungültiges Skript: %v.",
+    "error.feed_script_not_allowed": "Nur Administratoren können das Skript eines Abonnements ändern.",
+    "error.feed_invalid_entry_open_mode": "Ungültige Art, Artikel zu öffnen.",
+    "error.feed_invalid_priority": "Ungültige Priorität.",
+    "error.feed_invalid_entry_limit": "Das Artikellimit ist ungültig.",
+    "error.feed_invalid_scraper_max_pages": "Die Anzahl der Seiten muss zwischen 1 und %d liegen.",
+    "error.feed_invalid_summary_words": "Die Anzahl der Wörter muss zwischen 0 und %d liegen.",
+    "error.feed_invalid_entry_matching": "Ungültige Artikelerkennung.",
+    "error.feed_invalid_muted_until": "Das Stummschaltungsdatum muss im Format JJJJ-MM-TT angegeben werden.",
+    "error.mark_read_after_days_invalid": "Die Anzahl der Tage, bevor Artikel als gelesen markiert werden, darf nicht negativ sein.",
+    "error.image_display_invalid": "Ungültige Bildanzeige.",
+    "error.nsfw_display_invalid": "Ungültiger Anzeigemodus für NSFW-Inhalte.",
+    "error.junk_threshold_invalid": "Der Junk-Schwellenwert muss zwischen 0 und %d liegen.",
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
     "form.feed.label.title": "Titel",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
     "form.feed.label.category": "Kategorie",
     "form.feed.label.crawler": "Inhalt herunterladen",
+    "form.feed.label.nsfw": "Nicht jugendfreie Artikel (NSFW)",
+    "form.feed.label.summarize_entries": "Eine Zusammenfassung der neuen Artikel erstellen",
+    "form.feed.label.entry_open_mode": "Artikel öffnen mit",
+    "form.feed.label.priority": "Priorität in der Liste der ungelesenen Artikel",
+    "form.feed.label.muted_until": "Stummschalten bis",
+    "form.feed.help.muted_until": "Neue Artikel werden weiterhin abgerufen, aber bis zu diesem Tag als gelesen markiert und nicht als ungelesen gezählt. Leer lassen, um die Stummschaltung aufzuheben.",
+    "form.feed.label.mark_read_after_days": "Ungelesene Artikel als gelesen markieren nach (Tage)",
+    "form.feed.help.mark_read_after_days": "0 übernimmt die Regel der Kategorie.",
+    "form.feed.label.max_entries": "Maximale Anzahl gespeicherter Artikel",
+    "form.feed.help.max_entries": "Markierte Artikel werden immer behalten. 0 behält alle Artikel.",
+    "form.feed.label.overflow_policy": "Artikel über dem Limit",
+    "form.feed.select.overflow_archive": "Älteste Artikel archivieren",
+    "form.feed.select.overflow_delete": "Älteste Artikel löschen",
+    "form.feed.label.entry_matching": "Erneut veröffentlichte Artikel erkennen",
+    "form.feed.help.entry_matching": "Verwenden Sie diese Option, wenn der Feed die Kennung seiner Artikel ändert und Duplikate erzeugt.",
+    "form.feed.help.image_display": "Standardmäßig wird die Einstellung der Kategorie verwendet, danach die Ihrer Einstellungen.",
+    "form.feed.label.summary_words": "Nur die ersten Wörter der Artikel behalten",
+    "form.feed.help.summary_words": "Neue Artikel werden auf diese Anzahl von Wörtern gekürzt, gefolgt von einem Link zur Website. Verwenden Sie 0, um den vollständigen Inhalt zu behalten.",
+    "form.feed.select.entry_matching_guid": "Nur anhand der Kennung",
+    "form.feed.select.entry_matching_url": "Anhand der Kennung oder URL",
+    "form.feed.select.entry_matching_title": "Anhand der Kennung oder Titel und Datum",
+    "form.feed.label.watch_selector": "Überwachter Bereich (CSS-Selektor)",
+    "form.feed.select.open_content": "Inhalt des Abonnements",
+    "form.feed.select.open_full_content": "Vollständiger Inhalt der Webseite",
+    "form.feed.select.open_original": "Ursprüngliche Webseite",
+    "form.feed.select.priority_low": "Niedrig",
+    "form.feed.select.priority_normal": "Normal",
+    "form.feed.select.priority_high": "Hoch",
     "form.feed.label.feed_username": "Benutzername des Abonnements",
     "form.feed.label.feed_password": "Passwort des Abonnements",
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
+    "form.feed.label.scraper_max_pages": "Maximale Anzahl der Seiten pro Artikel",
+    "form.feed.help.scraper_max_pages": "Beim Abrufen des Originalinhalts werden die folgenden Seiten von mehrseitigen Artikeln angehängt. Verwenden Sie 1, um nur die erste Seite abzurufen.",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.script": "Skript",
     "form.category.label.title": "Titel",
+    "form.category.label.mark_read_after_days": "Ungelesene Artikel als gelesen markieren nach (Tage)",
+    "form.category.help.mark_read_after_days": "Gilt für die Abonnements dieser Kategorie ohne eigene Regel. 0 lässt die Artikel ungelesen.",
+    "form.category.help.image_display": "Gilt für die Feeds dieser Kategorie ohne eigene Einstellung. Standardmäßig wird die Einstellung Ihrer Einstellungen verwendet.",
+    "form.category.label.nsfw": "Die Abonnements dieser Kategorie sind nicht jugendfrei (NSFW)",
+    "form.category_share.label.username": "Benutzername",
+    "form.category_share.help.username": "Dieser Benutzer kann die Artikel der Kategorie lesen, aber nicht ändern.",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
     "form.user.label.admin": "Administrator",
+    "form.user.label.email": "E-Mail",
+    "form.user.label.invitation": "Einladungscode",
+    "form.invitation.help.email": "Optional, der Einladungslink wird an diese Adresse gesendet und kann nur mit ihr verwendet werden.",
+    "form.password_reset.help.email": "Die in den Einstellungen Ihres Kontos angegebene E-Mail-Adresse.",
+    "form.delete_account.help.password": "Geben Sie zur Bestätigung Ihr Passwort ein.",
     "form.prefs.label.language": "Sprache",
     "form.prefs.label.timezone": "Zeitzone",
     "form.prefs.label.theme": "Thema",
     "form.prefs.label.entry_sorting": "Sortierung der Artikel",
+    "form.prefs.label.entries_per_page": "Artikel pro Seite",
+    "form.prefs.label.show_read_entries": "Gelesene Artikel auf Abonnement- und Kategorieseiten anzeigen",
+    "form.prefs.label.show_absolute_time": "Datum statt der vergangenen Zeit anzeigen",
+    "form.prefs.label.image_display": "Bilder in Artikeln",
+    "form.prefs.label.nsfw_display": "Nicht jugendfreie Artikel (NSFW)",
+    "form.prefs.help.nsfw_display": "Artikel werden über ihr Abonnement, ihre Kategorie, ihre Altersfreigabe oder eine NSFW-Markierung im Titel erkannt.",
+    "form.prefs.label.junk_threshold": "Artikel mit einer Junk-Bewertung von mindestens diesem Wert als gelesen markieren",
+    "form.prefs.help.junk_threshold": "Die Junk-Bewertung von 0 bis 100 bewertet Clickbait-Titel, fast leere Artikel und Linklisten. 0 deaktiviert die Funktion.",
+    "form.prefs.select.image_display_show": "Anzeigen",
+    "form.prefs.select.image_display_blur": "Bis zum Anklicken unscharf",
+    "form.prefs.select.image_display_hide": "Ausblenden und stattdessen Links anzeigen",
+    "form.prefs.select.nsfw_display_show": "Anzeigen",
+    "form.prefs.select.nsfw_display_blur": "Bis zum Anklicken unscharf",
+    "form.prefs.select.nsfw_display_hide": "Ausblenden",
+    "form.prefs.select.image_display_inherit": "Standard",
+    "form.prefs.label.quiet_hours_start": "Beginn der Ruhezeit",
+    "form.prefs.label.quiet_hours_end": "Ende der Ruhezeit",
+    "form.prefs.help.quiet_hours": "Benachrichtigungen werden während der Ruhezeit zurückgehalten und danach gesammelt gesendet.",
+    "form.prefs.help.email": "Wird verwendet, um Ihnen einen Link zu senden, wenn Sie Ihr Passwort vergessen.",
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
     "form.import.label.file": "OPML Datei",
@@ -245,8 +451,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Artikel in Nunux Keeper speichern",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API-Endpunkt",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-Schlüssel",
+    "form.integration.readeck_activate": "Artikel in Readeck speichern",
+    "form.integration.readeck_endpoint": "Readeck-URL",
+    "form.integration.readeck_api_key": "Readeck-API-Token",
+    "form.integration.readeck_labels": "Readeck-Labels (optional, durch Kommas getrennt)",
+    "form.integration.omnivore_activate": "Artikel in Omnivore speichern",
+    "form.integration.omnivore_endpoint": "Omnivore-API-Endpunkt (optional)",
+    "form.integration.omnivore_api_key": "Omnivore-API-Schlüssel",
+    "form.integration.ntfy_activate": "Benachrichtigungen an ntfy senden",
+    "form.integration.ntfy_url": "ntfy Server-URL",
+    "form.integration.ntfy_topic": "ntfy Thema",
+    "form.integration.ntfy_token": "ntfy Zugriffstoken",
+    "form.integration.pushover_activate": "Benachrichtigungen an Pushover senden",
+    "form.integration.pushover_token": "Pushover Anwendungstoken",
+    "form.integration.pushover_user": "Pushover Benutzerschlüssel",
+    "form.integration.apprise_activate": "Benachrichtigungen an Apprise senden",
+    "form.integration.apprise_url": "Apprise API Benachrichtigungs-Endpunkt",
+    "form.integration.apprise_service_urls": "Apprise Dienst-URLs (optional, durch Kommas getrennt)",
+    "form.integration.kindle_activate": "Artikel an meinen Kindle senden",
+    "form.integration.kindle_email": "Send-to-Kindle-E-Mail-Adresse",
+    "form.integration.kindle_help": "Fügen Sie %s zu den genehmigten E-Mail-Adressen Ihres Amazon-Kontos hinzu.",
+    "form.integration.events": "An diesen Dienst gesendete Ereignisse:",
+    "form.integration.event_starred": "Artikel mit Lesezeichen",
+    "form.integration.event_new_entries": "Neue Artikel",
+    "form.integration.event_feed_error": "Fehler der Abonnements",
+    "form.integration.event_categories": "Nur neue Artikel dieser Kategorien (alle Kategorien, wenn keine ausgewählt ist)",
+    "notification.feed_error": "Das Abonnement %s konnte nicht aktualisiert werden",
+    "notification.new_entries": [
+        "%d neuer Artikel",
+        "%d neue Artikel"
+    ],
+    "notification.quiet_hours": [
+        "%d Benachrichtigung während der Ruhezeit",
+        "%d Benachrichtigungen während der Ruhezeit"
+    ],
     "form.submit.loading": "Lade...",
     "form.submit.saving": "Speichern...",
+    "form.bulk.selected_entries": "Ausgewählte Artikel:",
+    "form.bulk.select_entry": "Diesen Artikel auswählen",
+    "form.bulk.select_feed": "Dieses Abonnement auswählen",
+    "form.bulk.move_feeds": "Ausgewählte Abonnements verschieben nach:",
+    "form.bulk.action.read": "Als gelesen markieren",
+    "form.bulk.action.unread": "Als ungelesen markieren",
+    "form.bulk.action.star": "Lesezeichen setzen",
+    "form.bulk.action.unstar": "Lesezeichen entfernen",
+    "form.bulk.action.move": "Verschieben",
+    "form.least_read_feeds.label.months": "Nichts gelesen seit",
+    "form.least_read_feeds.months": [
+        "%d Monat",
+        "%d Monaten"
+    ],
     "time_elapsed.not_yet": "noch nicht",
     "time_elapsed.yesterday": "gestern",
     "time_elapsed.now": "gerade",
@@ -274,6 +528,13 @@ var translations = map[string]string{
         "vor %d Jahr",
         "vor %d Jahren"
     ],
+    "time_format.absolute": "02.01.2006 15:04",
+    "email.verification.subject": "Bestätigen Sie Ihre E-Mail-Adresse",
+    "email.verification.body": "Hallo %s,\n\nÖffnen Sie diesen Link, um Ihre E-Mail-Adresse zu bestätigen und Ihr Miniflux-Konto zu aktivieren:\n%s\n\nDer Link läuft in 24 Stunden ab.",
+    "email.invitation.subject": "Einladung zu Miniflux",
+    "email.invitation.body": "%s lädt Sie ein, ein Konto bei Miniflux zu erstellen:\n%s\n\nDie Einladung läuft in 7 Tagen ab.",
+    "email.password_reset.subject": "Passwort zurücksetzen",
+    "email.password_reset.body": "Hallo %s,\n\nÖffnen Sie diesen Link, um ein neues Passwort für Ihr Miniflux-Konto festzulegen:\n%s\n\nDer Link läuft in einer Stunde ab. Sie können diese E-Mail ignorieren, wenn Sie sie nicht angefordert haben.",
     "This feed already exists (%s)": "Diese Abonnement existiert bereits (%s)",
     "Unable to fetch feed (Status Code = %d)": "Abonnement konnte nicht abgerufen werden (code=%d)",
     "Unable to open this link: %v": "Dieser Link konnte nicht geöffnet werden: %v",
@@ -284,6 +545,9 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Atom Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse JSON feed: %q": "JSON Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse RDF feed: %q": "RDF Abonnement konnte nicht gelesen werden: %q",
+    "Unable to parse feed: %q": "Abonnement konnte nicht gelesen werden: %q",
+    "Unable to parse web page: %q": "Webseite konnte nicht gelesen werden: %q",
+    "The selector %q does not match any element of this web page": "Der Selektor %q passt zu keinem Element dieser Webseite",
     "Unable to normalize encoding: %q": "Zeichenkodierung konnte nicht normalisiert werden: %q",
     "This feed is empty": "Dieses Abonnement ist leer",
     "This web page is empty": "Diese Webseite ist leer",
@@ -291,6 +555,9 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Diese Webseite ist vorübergehend nicht erreichbar (ursprünglicher Fehler: %q)",
     "This website is permanently unreachable (original error: %q)": "Diese Webseite ist dauerhaft nicht erreichbar (ursprünglicher Fehler: %q)",
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
+    "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)": "Das Zertifikat dieser Gemini-Kapsel hat sich seit dem ersten Besuch geändert (Fingerabdruck %s)",
+    "A Tor proxy is required to fetch the .onion websites": "Ein Tor-Proxy ist erforderlich, um .onion-Webseiten abzurufen",
+    "The local path %q is not in the allowed directories": "Der lokale Pfad %q befindet sich nicht in den erlaubten Verzeichnissen",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
     "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL"
@@ -312,6 +579,16 @@ var translations = map[string]string{
     "action.download": "Download",
     "action.import": "Import",
     "action.login": "Login",
+    "action.signup": "Sign up",
+    "action.send_reset_link": "Send the link",
+    "action.delete_account": "Delete my account",
+    "action.invite": "Invite",
+    "action.show": "Show",
+    "action.mute_for_a_month": "Mute for a month",
+    "action.unsubscribe": "Unsubscribe",
+    "action.share": "Share",
+    "action.approve": "Approve",
+    "action.reject": "Reject",
     "tooltip.keyboard_shortcuts": "Keyboard Shortcut: %s",
     "tooltip.logged_user": "Logged as %s",
     "menu.unread": "Unread",
@@ -324,7 +601,10 @@ var translations = map[string]string{
     "menu.preferences": "Preferences",
     "menu.integrations": "Integrations",
     "menu.sessions": "Sessions",
+    "menu.delete_account": "Delete Account",
     "menu.users": "Users",
+    "menu.invitations": "Invitations",
+    "menu.statistics": "Statistics",
     "menu.about": "About",
     "menu.export": "Export",
     "menu.import": "Import",
@@ -332,15 +612,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Mark this page as read",
     "menu.mark_all_as_read": "Mark all as read",
     "menu.mark_all_as_read_wip": "Operation in progress...",
+    "menu.undo_mark_all_as_read": "Undo mark all as read",
     "menu.show_all_entries": "Show all entries",
     "menu.show_only_unread_entries": "Show only unread entries",
     "menu.refresh_feed": "Refresh",
     "menu.refresh_all_feeds": "Refresh all feeds in the background",
     "menu.edit_feed": "Edit",
     "menu.edit_category": "Edit",
+    "menu.share_category": "Share",
     "menu.add_feed": "Add subscription",
     "menu.add_user": "Add user",
     "menu.flush_history": "Flush history",
+    "menu.recently_read": "Recently read",
+    "menu.junk": "Junk",
+    "menu.export_epub": "Download as EPUB",
+    "menu.export_printable": "Printable version",
+    "menu.least_read_feeds": "Least read feeds",
+    "menu.pending_feeds": "Pending subscriptions",
     "search.label": "Search",
     "search.placeholder": "Search...",
     "pagination.next": "Next",
@@ -351,16 +639,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Star",
     "entry.bookmark.toggle.off": "Unstar",
     "entry.state.saving": "Saving...",
+    "entry.state.sending": "Sending...",
     "entry.state.loading": "Loading...",
     "entry.save.label": "Save",
     "entry.save.title": "Save this article",
     "entry.save.completed": "Done!",
+    "entry.kindle.label": "Send to Kindle",
+    "entry.kindle.title": "Send this article to my Kindle",
+    "entry.kindle.completed": "Sent!",
     "entry.scraper.label": "Fetch original content",
     "entry.scraper.title": "Fetch original content",
     "entry.scraper.completed": "Done!",
+    "entry.summarize.label": "Summarize",
+    "entry.summarize.title": "Generate a summary of this article",
+    "entry.summarize.completed": "Done!",
     "entry.original.label": "Original",
     "entry.comments.label": "Comments",
+    "entry.snapshot.title": "Read the saved copy of the web page",
+    "entry.snapshot.label": "Snapshot",
     "entry.comments.title": "View Comments",
+    "entry.summary.read_more": "Read more",
+    "entry.watch.title": "Changes on %s (%s)",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
     "page.categories.title": "Categories",
@@ -369,17 +668,37 @@ var translations = map[string]string{
         "There is %d feed.",
         "There are %d feeds."
     ],
+    "page.categories.shared_with_me": "Shared with me",
     "page.new_category.title": "New Category",
     "page.new_user.title": "New User",
     "page.edit_category.title": "Edit Category: %s",
+    "page.category_shares.title": "Share Category: %s",
+    "page.category_shares.since": "Shared since",
+    "page.shared_category_entries.shared_by": "Shared by %s",
     "page.edit_user.title": "Edit User: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Last check:",
+    "page.feeds.muted_until": "Muted until:",
+    "page.feeds.pending": "Waiting for approval",
     "page.feeds.error_count": [
         "%d error",
         "%d errors"
     ],
     "page.history.title": "History",
+    "page.recently_read.title": "Recently read",
+    "page.junk.title": "Junk",
+    "page.junk.help": "Articles with a junk score of at least %d.",
+    "page.junk.score": "Junk score: %d",
+    "page.recently_read.read_at": "Read",
+    "page.least_read_feeds.title": "Least read feeds",
+    "page.least_read_feeds.last_read": "Last read:",
+    "page.least_read_feeds.never_read": "Never read",
+    "page.least_read_feeds.unread_count": [
+        "%d unread entry",
+        "%d unread entries"
+    ],
+    "page.pending_feeds.title": "Pending subscriptions",
+    "page.pending_feeds.requested_by": "Requested by %s",
     "page.import.title": "Import",
     "page.search.title": "Search Results",
     "page.about.title": "About",
@@ -393,6 +712,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Find a subscription",
     "page.add_feed.legend.advanced_options": "Advanced Options",
+    "page.add_feed.watch_selector_help": "Fill this field to watch a web page without feed: an entry is created when the text of the matching elements changes.",
     "page.add_feed.choose_feed": "Choose a Subscription",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
@@ -435,11 +755,39 @@ var translations = map[string]string{
     "page.users.actions": "Actions",
     "page.users.last_login": "Last Login",
     "page.users.is_admin": "Administrator",
+    "page.users.not_verified": "Email not verified",
+    "page.users.pending": "Waiting for approval",
+    "page.invitations.title": "Invitations",
+    "page.invitations.expires": "Expires",
+    "page.invitations.status": "Status",
+    "page.invitations.used": "Used",
+    "page.invitations.unused": "Not used",
+    "page.statistics.title": "Statistics",
+    "page.statistics.users": "Users",
+    "page.statistics.feeds": "Feeds",
+    "page.statistics.entries": "Entries (estimated)",
+    "page.statistics.database_size": "Database size",
+    "page.statistics.fetches_last_hour": "Refreshes in the last hour",
+    "page.statistics.fetches_last_day": "Refreshes in the last 24 hours",
+    "page.statistics.fetch_success_rate": "Successful refreshes",
+    "page.statistics.shared_feeds": "Feeds subscribed by several users",
+    "page.statistics.feed_url": "Feed URL",
+    "page.statistics.subscribers": "Subscribers",
+    "page.statistics.error_domains": "Domains with the most errors",
+    "page.statistics.domain": "Domain",
+    "page.statistics.error_feeds": "Feeds in error",
     "page.settings.title": "Settings",
     "page.settings.link_google_account": "Link my Google account",
     "page.settings.unlink_google_account": "Unlink my Google account",
     "page.login.title": "Sign In",
     "page.login.google_signin": "Sign in with Google",
+    "page.login.no_account": "Don't have an account?",
+    "page.signup.title": "Sign Up",
+    "page.login.forgot_password": "Forgot your password?",
+    "page.forgot_password.title": "Forgot Password",
+    "page.reset_password.title": "Reset Password",
+    "page.delete_account.title": "Delete Account",
+    "page.delete_account.description": "Your feeds, entries, categories and integrations will be permanently removed. You will be logged out right away and this action cannot be undone.",
     "page.integrations.title": "Integrations",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
@@ -447,9 +795,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Password",
     "page.integration.miniflux_api_password_value": "Your account password",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Browser Extension",
+    "page.integration.browser_extension_endpoint": "Extension Endpoint",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "No token generated",
+    "page.integration.browser_extension_generate_token": "Generate a new token",
     "page.integration.bookmarklet.name": "Add to Miniflux",
     "page.integration.bookmarklet.instructions": "Drag and drop this link to your bookmarks.",
     "page.integration.bookmarklet.help": "This special link allows you to subscribe to a website directly by using a bookmark in your web browser.",
+    "page.entry_snapshot.taken": "Saved",
+    "page.entry_snapshot.refresh": "Save a new copy",
+    "page.entry_snapshot.last_error": "The last copy attempt failed:",
+    "page.entry_snapshot.empty": "There is no saved copy of this web page yet.",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "IP Address",
@@ -459,29 +816,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "There is no bookmark at the moment.",
     "alert.no_category": "There is no category.",
     "alert.no_category_entry": "There are no articles in this category.",
+    "alert.no_category_share": "This category is not shared with anyone.",
     "alert.no_feed_entry": "There are no articles for this feed.",
     "alert.no_feed": "You don't have any subscriptions.",
     "alert.no_history": "There is no history at the moment.",
+    "alert.no_junk": "There are no junk articles.",
+    "alert.no_least_read_feed": "You have read entries of all your subscriptions during this period.",
+    "alert.no_pending_feed": "There is no subscription waiting for an approval.",
+    "alert.feed_pending": "The subscription has been saved, its entries will be downloaded once an administrator approves it.",
+    "alert.no_invitation": "There is no invitation.",
+    "alert.no_shared_feed": "No feed is subscribed by several users.",
+    "alert.no_error_domain": "No domain has feeds in error.",
+    "alert.signup_verification_sent": "A verification link has been sent to %s.",
+    "alert.account_verified": "Your email address is verified, you can now sign in.",
+    "alert.account_verified_pending": "Your email address is verified, your account will be enabled once an administrator approves it.",
+    "alert.invitation_created": "The invitation has been created, share this link: %s",
+    "alert.password_reset_sent": "If an account uses %s, a link to choose a new password has been sent.",
+    "alert.password_reset": "Your password has been changed, you can now sign in.",
+    "alert.account_deletion_requested": "Your account has been closed, your data will be removed shortly.",
     "alert.feed_error": "There is a problem with this feed",
     "alert.no_search_result": "There are no results for this search.",
     "alert.no_unread_entry": "There are no unread articles.",
+    "alert.read_only": "This instance is read-only, you can browse it but your changes are not saved.",
+    "alert.write_freeze": "Changes to your account are disabled until %s for maintenance.",
     "alert.no_user": "You are the only user.",
     "alert.account_unlinked": "Your external account is now dissociated!",
     "alert.account_linked": "Your external account is now linked!",
     "alert.pocket_linked": "Your Pocket account is now linked!",
     "alert.prefs_saved": "Preferences saved!",
+    "alert.extension_token_generated": "A new token has been generated, the previous one does not work anymore.",
+    "alert.entry_snapshot_saved": "A new copy of the web page has been saved.",
     "error.unlink_account_without_password": "You must define a password otherwise you won't be able to login again.",
     "error.duplicate_linked_account": "There is already someone associated with this provider!",
     "error.duplicate_fever_username": "There is already someone else with the same Fever username!",
     "error.pocket_request_token": "Unable to fetch request token from Pocket!",
     "error.pocket_access_token": "Unable to fetch access token from Pocket!",
+    "error.entry_snapshot_failed": "Unable to download the web page, the previous copy has been kept.",
     "error.category_already_exists": "This category already exists.",
     "error.unable_to_create_category": "Unable to create this category.",
     "error.unable_to_update_category": "Unable to update this category.",
+    "error.category_changed": "This category has been modified in the meantime, submit the form again to overwrite the changes.",
+    "error.read_only": "This instance is read-only, changes are not allowed.",
+    "error.write_freeze": "Changes to your account are temporarily disabled.",
+    "error.category_share_user_not_found": "This user doesn't exist.",
+    "error.invalid_email": "This email address is invalid.",
+    "error.email_domain_not_allowed": "This email domain is not allowed.",
+    "error.email_already_exists": "This email address is already used.",
+    "error.invalid_invitation": "This invitation code is invalid or has expired.",
+    "error.unable_to_send_email": "Unable to send the email, please try again later.",
+    "error.invalid_verification_link": "This verification link is invalid or has expired.",
+    "error.user_not_verified": "Your email address is not verified yet, open the link sent by email.",
+    "error.user_pending": "Your account is waiting for the approval of an administrator.",
+    "error.invalid_password_reset_link": "This password reset link is invalid or has expired.",
+    "error.account_deletion_admin": "An administrator account must be removed by another administrator.",
+    "error.invalid_password": "The password is incorrect.",
     "error.user_already_exists": "This user already exists.",
     "error.unable_to_create_user": "Unable to create this user.",
     "error.unable_to_update_user": "Unable to update this user.",
     "error.unable_to_update_feed": "Unable to update this feed.",
+    "error.feed_changed": "This feed has been modified in the meantime, submit the form again to overwrite the changes.",
     "error.subscription_not_found": "Unable to find any subscription.",
     "error.empty_file": "This file is empty.",
     "error.bad_credentials": "Invalid username or password.",
@@ -490,27 +883,107 @@ var translations = map[string]string{
     "error.different_passwords": "Passwords are not the same.",
     "error.password_min_length": "The password must have at least 6 characters.",
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
+    "error.entries_per_page_invalid": "The number of entries per page must be between 1 and %d.",
+    "error.quiet_hours_invalid": "The start and end of quiet hours must both be set as HH:MM, or both be empty.",
+    "error.integration_filters_invalid": "The events selected for the integrations are invalid.",
+    "error.kindle_email_invalid": "The Kindle email address is invalid.",
+    "error.bulk_empty_selection": "No item has been selected.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.feed_invalid_script": "Invalid script: %v.",
+    "error.feed_script_not_allowed": "Only the administrators can change the script of a feed.",
+    "error.feed_invalid_entry_open_mode": "Invalid entry open mode.",
+    "error.feed_invalid_priority": "Invalid feed priority.",
+    "error.feed_invalid_entry_limit": "The entry limit is not valid.",
+    "error.feed_invalid_scraper_max_pages": "The number of pages must be between 1 and %d.",
+    "error.feed_invalid_summary_words": "The number of words must be between 0 and %d.",
+    "error.feed_invalid_entry_matching": "Invalid entry matching.",
+    "error.feed_invalid_muted_until": "The mute date must be formatted as YYYY-MM-DD.",
+    "error.mark_read_after_days_invalid": "The number of days before marking entries as read cannot be negative.",
+    "error.image_display_invalid": "Invalid image display mode.",
+    "error.nsfw_display_invalid": "Invalid NSFW display mode.",
+    "error.junk_threshold_invalid": "The junk threshold must be between 0 and %d.",
     "error.user_mandatory_fields": "The username is mandatory.",
     "form.feed.label.title": "Title",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Category",
     "form.feed.label.crawler": "Fetch original content",
+    "form.feed.label.nsfw": "Articles not safe for work (NSFW)",
+    "form.feed.label.summarize_entries": "Generate a summary of new articles",
+    "form.feed.label.entry_open_mode": "Open entries with",
+    "form.feed.label.priority": "Priority in the unread list",
+    "form.feed.label.muted_until": "Mute until",
+    "form.feed.help.muted_until": "New entries are still fetched but marked as read and left out of the unread counters until this day. Leave empty to unmute the feed.",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after (days)",
+    "form.feed.help.mark_read_after_days": "Use 0 to follow the rule of the category.",
+    "form.feed.label.max_entries": "Maximum number of stored entries",
+    "form.feed.help.max_entries": "Starred entries are always kept. Use 0 to keep every entry.",
+    "form.feed.label.overflow_policy": "Entries over the limit",
+    "form.feed.select.overflow_archive": "Archive the oldest entries",
+    "form.feed.select.overflow_delete": "Delete the oldest entries",
+    "form.feed.label.entry_matching": "Recognize republished entries",
+    "form.feed.help.entry_matching": "Use this option when the feed changes the identifier of its entries and creates duplicates.",
+    "form.feed.help.image_display": "By default, the setting of the category is used, then the one of your preferences.",
+    "form.feed.label.summary_words": "Keep only the first words of articles",
+    "form.feed.help.summary_words": "New articles are truncated to this number of words, followed by a link to the website. Use 0 to keep the full content.",
+    "form.feed.select.entry_matching_guid": "By identifier only",
+    "form.feed.select.entry_matching_url": "By identifier or URL",
+    "form.feed.select.entry_matching_title": "By identifier or title and date",
+    "form.feed.label.watch_selector": "Watched region (CSS selector)",
+    "form.feed.select.open_content": "Feed content",
+    "form.feed.select.open_full_content": "Full content from the website",
+    "form.feed.select.open_original": "Original website",
+    "form.feed.select.priority_low": "Low",
+    "form.feed.select.priority_normal": "Normal",
+    "form.feed.select.priority_high": "High",
     "form.feed.label.feed_username": "Feed Username",
     "form.feed.label.feed_password": "Feed Password",
     "form.feed.label.user_agent": "Override Default User Agent",
     "form.feed.label.scraper_rules": "Scraper Rules",
+    "form.feed.label.scraper_max_pages": "Maximum number of pages per article",
+    "form.feed.help.scraper_max_pages": "When the original content is fetched, the next pages of articles split across several pages are appended. Use 1 to fetch only the first page.",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
+    "form.feed.label.script": "Script",
     "form.category.label.title": "Title",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after (days)",
+    "form.category.help.mark_read_after_days": "Applies to the feeds of this category without their own rule. Use 0 to keep entries unread.",
+    "form.category.help.image_display": "Applies to the feeds of this category without their own setting. By default, the setting of your preferences is used.",
+    "form.category.label.nsfw": "The feeds of this category are not safe for work (NSFW)",
+    "form.category_share.label.username": "Username",
+    "form.category_share.help.username": "This user can read the articles of the category but cannot change them.",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
     "form.user.label.admin": "Administrator",
+    "form.user.label.email": "Email",
+    "form.user.label.invitation": "Invitation Code",
+    "form.invitation.help.email": "Optional, the invitation link is sent to this address and only this address can use it.",
+    "form.password_reset.help.email": "The email address defined in the settings of your account.",
+    "form.delete_account.help.password": "Enter your password to confirm.",
     "form.prefs.label.language": "Language",
     "form.prefs.label.timezone": "Timezone",
     "form.prefs.label.theme": "Theme",
     "form.prefs.label.entry_sorting": "Entry Sorting",
+    "form.prefs.label.entries_per_page": "Entries per Page",
+    "form.prefs.label.show_read_entries": "Show read entries on feed and category pages",
+    "form.prefs.label.show_absolute_time": "Show dates instead of the elapsed time",
+    "form.prefs.label.image_display": "Images in articles",
+    "form.prefs.label.nsfw_display": "Articles not safe for work (NSFW)",
+    "form.prefs.help.nsfw_display": "Articles are flagged by their feed, their category, their rating or a NSFW marker in their title.",
+    "form.prefs.label.junk_threshold": "Mark as read the articles with a junk score of at least",
+    "form.prefs.help.junk_threshold": "The junk score, from 0 to 100, rates clickbait titles, nearly empty articles and lists of links. Use 0 to disable.",
+    "form.prefs.select.image_display_show": "Show",
+    "form.prefs.select.image_display_blur": "Blur until clicked",
+    "form.prefs.select.image_display_hide": "Hide and show links instead",
+    "form.prefs.select.nsfw_display_show": "Show",
+    "form.prefs.select.nsfw_display_blur": "Blur until clicked",
+    "form.prefs.select.nsfw_display_hide": "Hide",
+    "form.prefs.select.image_display_inherit": "Default",
+    "form.prefs.label.quiet_hours_start": "Start of quiet hours",
+    "form.prefs.label.quiet_hours_end": "End of quiet hours",
+    "form.prefs.help.quiet_hours": "Notifications are held during quiet hours and sent together once they are over.",
+    "form.prefs.help.email": "Used to send you a link when you forget your password.",
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
     "form.import.label.file": "OPML file",
@@ -538,8 +1011,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Save articles to Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.readeck_activate": "Save articles to Readeck",
+    "form.integration.readeck_endpoint": "Readeck URL",
+    "form.integration.readeck_api_key": "Readeck API token",
+    "form.integration.readeck_labels": "Readeck labels (optional, comma-separated)",
+    "form.integration.omnivore_activate": "Save articles to Omnivore",
+    "form.integration.omnivore_endpoint": "Omnivore API Endpoint (optional)",
+    "form.integration.omnivore_api_key": "Omnivore API key",
+    "form.integration.ntfy_activate": "Send notifications to ntfy",
+    "form.integration.ntfy_url": "ntfy Server URL",
+    "form.integration.ntfy_topic": "ntfy Topic",
+    "form.integration.ntfy_token": "ntfy Access Token",
+    "form.integration.pushover_activate": "Send notifications to Pushover",
+    "form.integration.pushover_token": "Pushover Application Token",
+    "form.integration.pushover_user": "Pushover User Key",
+    "form.integration.apprise_activate": "Send notifications to Apprise",
+    "form.integration.apprise_url": "Apprise API Notify Endpoint",
+    "form.integration.apprise_service_urls": "Apprise Service URLs (optional, comma-separated)",
+    "form.integration.kindle_activate": "Send entries to my Kindle",
+    "form.integration.kindle_email": "Send to Kindle email address",
+    "form.integration.kindle_help": "Add %s to the approved email addresses of your Amazon account.",
+    "form.integration.events": "Events sent to this service:",
+    "form.integration.event_starred": "Starred entries",
+    "form.integration.event_new_entries": "New entries",
+    "form.integration.event_feed_error": "Feed errors",
+    "form.integration.event_categories": "Only new entries of these categories (all categories when none is selected)",
+    "notification.feed_error": "Unable to refresh the feed %s",
+    "notification.new_entries": [
+        "%d new entry",
+        "%d new entries"
+    ],
+    "notification.quiet_hours": [
+        "%d notification during quiet hours",
+        "%d notifications during quiet hours"
+    ],
     "form.submit.loading": "Loading...",
     "form.submit.saving": "Saving...",
+    "form.bulk.selected_entries": "Selected entries:",
+    "form.bulk.select_entry": "Select this entry",
+    "form.bulk.select_feed": "Select this feed",
+    "form.bulk.move_feeds": "Move selected feeds to:",
+    "form.bulk.action.read": "Mark as read",
+    "form.bulk.action.unread": "Mark as unread",
+    "form.bulk.action.star": "Star",
+    "form.bulk.action.unstar": "Unstar",
+    "form.bulk.action.move": "Move",
+    "form.least_read_feeds.label.months": "Nothing read for",
+    "form.least_read_feeds.months": [
+        "%d month",
+        "%d months"
+    ],
     "time_elapsed.not_yet": "not yet",
     "time_elapsed.yesterday": "yesterday",
     "time_elapsed.now": "just now",
@@ -566,7 +1087,14 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d year ago",
         "%d years ago"
-    ]
+    ],
+    "time_format.absolute": "01/02/2006 3:04 PM",
+    "email.verification.subject": "Verify your email address",
+    "email.verification.body": "Hello %s,\n\nOpen this link to verify your email address and activate your Miniflux account:\n%s\n\nThe link expires in 24 hours.",
+    "email.invitation.subject": "Invitation to Miniflux",
+    "email.invitation.body": "%s invites you to create an account on Miniflux:\n%s\n\nThe invitation expires in 7 days.",
+    "email.password_reset.subject": "Reset your password",
+    "email.password_reset.body": "Hello %s,\n\nOpen this link to choose a new password for your Miniflux account:\n%s\n\nThe link expires in one hour. You can ignore this email if you did not request it."
 }
 `,
 	"es_ES": `{
@@ -585,6 +1113,16 @@ var translations = map[string]string{
     "action.download": "Descargar",
     "action.import": "Importar",
     "action.login": "Iniciar sesión",
+    "action.signup": "Registrarse",
+    "action.send_reset_link": "Enviar el enlace",
+    "action.delete_account": "Eliminar mi cuenta",
+    "action.invite": "Invitar",
+    "action.show": "Mostrar",
+    "action.mute_for_a_month": "Silenciar durante un mes",
+    "action.unsubscribe": "Cancelar la suscripción",
+    "action.share": "Compartir",
+    "action.approve": "Aprobar",
+    "action.reject": "Rechazar",
     "tooltip.keyboard_shortcuts": "Atajo de teclado: %s",
     "tooltip.logged_user": "Registrado como %s",
     "menu.unread": "No leídos",
@@ -597,7 +1135,10 @@ var translations = map[string]string{
     "menu.preferences": "Preferencias",
     "menu.integrations": "Integraciones",
     "menu.sessions": "Sesiones",
+    "menu.delete_account": "Eliminar la cuenta",
     "menu.users": "Usuarios",
+    "menu.invitations": "Invitaciones",
+    "menu.statistics": "Estadísticas",
     "menu.about": "Acerca de",
     "menu.export": "Exportar",
     "menu.import": "Importar",
@@ -605,15 +1146,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Marcar esta pagína como leída",
     "menu.mark_all_as_read": "Marcar todos como leídos",
     "menu.mark_all_as_read_wip": "Operación en progreso...",
+    "menu.undo_mark_all_as_read": "Deshacer marcar todos como leídos",
     "menu.show_all_entries": "Mostrar todas las entradas",
     "menu.show_only_unread_entries": "Mostrar solo las entradas no leídas",
     "menu.refresh_feed": "Refrescar",
     "menu.refresh_all_feeds": "Refrescar todas las fuentes en el fondo",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
+    "menu.share_category": "Compartir",
     "menu.add_feed": "Agregar suscripción",
     "menu.add_user": "Agregar usuario",
     "menu.flush_history": "Borrar historial",
+    "menu.recently_read": "Leídos recientemente",
+    "menu.junk": "Basura",
+    "menu.export_epub": "Descargar como EPUB",
+    "menu.export_printable": "Versión para imprimir",
+    "menu.least_read_feeds": "Fuentes menos leídas",
+    "menu.pending_feeds": "Suscripciones pendientes",
     "search.label": "Buscar",
     "search.placeholder": "Búsqueda...",
     "pagination.next": "Siguiente",
@@ -624,16 +1173,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Marcar",
     "entry.bookmark.toggle.off": "Desmarcar",
     "entry.state.saving": "Guardando...",
+    "entry.state.sending": "Enviando...",
     "entry.state.loading": "Cargando...",
     "entry.save.label": "Guardar",
     "entry.save.title": "Guardar este articulo",
     "entry.save.completed": "¡Hecho!",
+    "entry.kindle.label": "Enviar a Kindle",
+    "entry.kindle.title": "Enviar este artículo a mi Kindle",
+    "entry.kindle.completed": "¡Enviado!",
     "entry.scraper.label": "Obtener contenido original",
     "entry.scraper.title": "Obtener contenido original",
     "entry.scraper.completed": "¡Hecho!",
+    "entry.summarize.label": "Resumir",
+    "entry.summarize.title": "Generar un resumen de este artículo",
+    "entry.summarize.completed": "¡Hecho!",
     "entry.original.label": "Original",
     "entry.comments.label": "Comentarios",
+    "entry.snapshot.title": "Leer la copia guardada de la página web",
+    "entry.snapshot.label": "Copia",
     "entry.comments.title": "Ver comentarios",
+    "entry.summary.read_more": "Leer más",
+    "entry.watch.title": "Cambios en %s (%s)",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
     "page.categories.title": "Categorias",
@@ -642,17 +1202,37 @@ var translations = map[string]string{
         "Hay %d fuente.",
         "Hay %d fuentes."
     ],
+    "page.categories.shared_with_me": "Compartidas conmigo",
     "page.new_category.title": "Nueva categoría",
     "page.new_user.title": "Nuevo usario",
     "page.edit_category.title": "Editar categoría: %s",
+    "page.category_shares.title": "Compartir categoría: %s",
+    "page.category_shares.since": "Compartida desde",
+    "page.shared_category_entries.shared_by": "Compartida por %s",
     "page.edit_user.title": "Editar usuario: %s",
     "page.feeds.title": "Fuentes",
     "page.feeds.last_check": "Última verificación:",
+    "page.feeds.muted_until": "Silenciada hasta:",
+    "page.feeds.pending": "Pendiente de aprobación",
     "page.feeds.error_count": [
         "%d error",
         "%d errores"
     ],
     "page.history.title": "Historial",
+    "page.recently_read.title": "Leídos recientemente",
+    "page.junk.title": "Basura",
+    "page.junk.help": "Artículos con una puntuación de basura de al menos %d.",
+    "page.junk.score": "Puntuación de basura: %d",
+    "page.recently_read.read_at": "Leído",
+    "page.least_read_feeds.title": "Fuentes menos leídas",
+    "page.least_read_feeds.last_read": "Última lectura:",
+    "page.least_read_feeds.never_read": "Nunca leída",
+    "page.least_read_feeds.unread_count": [
+        "%d artículo no leído",
+        "%d artículos no leídos"
+    ],
+    "page.pending_feeds.title": "Suscripciones pendientes",
+    "page.pending_feeds.requested_by": "Solicitada por %s",
     "page.import.title": "Importar",
     "page.search.title": "Resultados de la búsqueda",
     "page.about.title": "Acerca de",
@@ -666,6 +1246,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Encontrar una suscripción",
     "page.add_feed.legend.advanced_options": "Opciones avanzadas",
+    "page.add_feed.watch_selector_help": "Rellene este campo para vigilar una página web sin fuente: se crea un artículo cuando cambia el texto de los elementos correspondientes.",
     "page.add_feed.choose_feed": "Elegir una suscripción",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
@@ -708,11 +1289,39 @@ var translations = map[string]string{
     "page.users.actions": "Acciones",
     "page.users.last_login": "Último ingreso",
     "page.users.is_admin": "Administrador",
+    "page.users.not_verified": "Correo electrónico no verificado",
+    "page.users.pending": "Pendiente de aprobación",
+    "page.invitations.title": "Invitaciones",
+    "page.invitations.expires": "Caduca",
+    "page.invitations.status": "Estado",
+    "page.invitations.used": "Usada",
+    "page.invitations.unused": "Sin usar",
+    "page.statistics.title": "Estadísticas",
+    "page.statistics.users": "Usuarios",
+    "page.statistics.feeds": "Fuentes",
+    "page.statistics.entries": "Artículos (estimado)",
+    "page.statistics.database_size": "Tamaño de la base de datos",
+    "page.statistics.fetches_last_hour": "Actualizaciones en la última hora",
+    "page.statistics.fetches_last_day": "Actualizaciones en las últimas 24 horas",
+    "page.statistics.fetch_success_rate": "Actualizaciones exitosas",
+    "page.statistics.shared_feeds": "Fuentes suscritas por varios usuarios",
+    "page.statistics.feed_url": "URL de la fuente",
+    "page.statistics.subscribers": "Suscriptores",
+    "page.statistics.error_domains": "Dominios con más errores",
+    "page.statistics.domain": "Dominio",
+    "page.statistics.error_feeds": "Fuentes con errores",
     "page.settings.title": "Ajustes",
     "page.settings.link_google_account": "Vincular mi cuenta de Google",
     "page.settings.unlink_google_account": "Desvincular mi cuenta de Google",
     "page.login.title": "Iniciar sesión",
     "page.login.google_signin": "Iniciar sesión con tu cuenta de Google",
+    "page.login.no_account": "¿No tiene una cuenta?",
+    "page.signup.title": "Registro",
+    "page.login.forgot_password": "¿Olvidó su contraseña?",
+    "page.forgot_password.title": "Contraseña olvidada",
+    "page.reset_password.title": "Restablecer la contraseña",
+    "page.delete_account.title": "Eliminar la cuenta",
+    "page.delete_account.description": "Sus fuentes, artículos, categorías e integraciones se eliminarán de forma permanente. Se cerrará su sesión inmediatamente y esta acción no se puede deshacer.",
     "page.integrations.title": "Integraciones",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Extremo de API",
@@ -720,9 +1329,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Contraseña",
     "page.integration.miniflux_api_password_value": "Contraseña de tu cuenta",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Extensión del navegador",
+    "page.integration.browser_extension_endpoint": "Endpoint de la extensión",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "Ningún token generado",
+    "page.integration.browser_extension_generate_token": "Generar un nuevo token",
     "page.integration.bookmarklet.name": "Agregar a Miniflux",
     "page.integration.bookmarklet.instructions": "Arrastrar y soltar este enlace a tus marcadores del navegador.",
     "page.integration.bookmarklet.help": "Este enlace especial te permite suscribirte a un sitio de web directamente usando un marcador del navegador.",
+    "page.entry_snapshot.taken": "Guardada",
+    "page.entry_snapshot.refresh": "Guardar una nueva copia",
+    "page.entry_snapshot.last_error": "El último intento de copia falló:",
+    "page.entry_snapshot.empty": "Todavía no hay ninguna copia guardada de esta página web.",
     "page.sessions.title": "Sesiones",
     "page.sessions.table.date": "Fecha",
     "page.sessions.table.ip": "Dirección de IP",
@@ -732,29 +1350,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "No hay marcador en este momento.",
     "alert.no_category": "No hay categoría.",
     "alert.no_category_entry": "No hay artículos en esta categoria.",
+    "alert.no_category_share": "Esta categoría no se comparte con nadie.",
     "alert.no_feed_entry": "No hay artículos para esta fuente.",
     "alert.no_feed": "No tienes suscripciones.",
     "alert.no_history": "No hay historial en este momento.",
+    "alert.no_junk": "No hay artículos basura.",
+    "alert.no_least_read_feed": "Ha leído artículos de todas sus suscripciones durante este período.",
+    "alert.no_pending_feed": "No hay ninguna suscripción pendiente de aprobación.",
+    "alert.feed_pending": "La suscripción se ha guardado, sus artículos se descargarán cuando un administrador la apruebe.",
+    "alert.no_invitation": "No hay ninguna invitación.",
+    "alert.no_shared_feed": "Ninguna fuente está suscrita por varios usuarios.",
+    "alert.no_error_domain": "Ningún dominio tiene fuentes con errores.",
+    "alert.signup_verification_sent": "Se ha enviado un enlace de verificación a %s.",
+    "alert.account_verified": "Su correo electrónico está verificado, ya puede iniciar sesión.",
+    "alert.account_verified_pending": "Su correo electrónico está verificado, su cuenta se activará cuando un administrador la apruebe.",
+    "alert.invitation_created": "La invitación ha sido creada, comparta este enlace: %s",
+    "alert.password_reset_sent": "Si una cuenta usa %s, se ha enviado un enlace para elegir una nueva contraseña.",
+    "alert.password_reset": "Su contraseña ha sido cambiada, ya puede iniciar sesión.",
+    "alert.account_deletion_requested": "Su cuenta ha sido cerrada, sus datos se eliminarán en breve.",
     "alert.feed_error": "Hay un problema con esta fuente.",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
+    "alert.read_only": "Esta instancia es de solo lectura, puede navegar por ella pero sus cambios no se guardan.",
+    "alert.write_freeze": "Los cambios en su cuenta están desactivados hasta %s por mantenimiento.",
     "alert.no_user": "Eres el unico usuario.",
     "alert.account_unlinked": "¡Tu cuenta externa ya está desvinculada!",
     "alert.account_linked": "¡Tu cuenta externa ya está vinculada!",
     "alert.pocket_linked": "¡Tu cuenta de Pocket ya está vinculada!",
     "alert.prefs_saved": "¡Las preferencias se han guardado!",
+    "alert.extension_token_generated": "Se ha generado un nuevo token, el anterior ya no funciona.",
+    "alert.entry_snapshot_saved": "Se ha guardado una nueva copia de la página web.",
     "error.unlink_account_without_password": "Debe definir una contraseña, de lo contrario no podrá volver a iniciar sesión.",
     "error.duplicate_linked_account": "¡Ya hay alguien asociado a este servicio!",
     "error.duplicate_fever_username": "¡Ya hay alguien con el mismo nombre de usuario de Fever!",
     "error.pocket_request_token": "Incapaz de obtener un token de solicitud de Pocket!",
     "error.pocket_access_token": "Incapaz de obtener un token de acceso de Pocket!",
+    "error.entry_snapshot_failed": "No se puede descargar la página web, se ha conservado la copia anterior.",
     "error.category_already_exists": "Esta categoría ya existe.",
     "error.unable_to_create_category": "Incapaz de crear esta categoría.",
     "error.unable_to_update_category": "Incapaz de actualizar esta categoría.",
+    "error.category_changed": "Esta categoría ha sido modificada mientras tanto, envíe el formulario de nuevo para sobrescribir los cambios.",
+    "error.read_only": "Esta instancia es de solo lectura, no se permiten cambios.",
+    "error.write_freeze": "Los cambios en su cuenta están desactivados temporalmente.",
+    "error.category_share_user_not_found": "Este usuario no existe.",
+    "error.invalid_email": "Esta dirección de correo electrónico no es válida.",
+    "error.email_domain_not_allowed": "Este dominio de correo electrónico no está permitido.",
+    "error.email_already_exists": "Esta dirección de correo electrónico ya está en uso.",
+    "error.invalid_invitation": "Este código de invitación no es válido o ha caducado.",
+    "error.unable_to_send_email": "No se puede enviar el correo electrónico, inténtelo más tarde.",
+    "error.invalid_verification_link": "Este enlace de verificación no es válido o ha caducado.",
+    "error.user_not_verified": "Su correo electrónico aún no está verificado, abra el enlace enviado por correo.",
+    "error.user_pending": "Su cuenta está pendiente de la aprobación de un administrador.",
+    "error.invalid_password_reset_link": "Este enlace para restablecer la contraseña no es válido o ha caducado.",
+    "error.account_deletion_admin": "Una cuenta de administrador debe ser eliminada por otro administrador.",
+    "error.invalid_password": "La contraseña es incorrecta.",
     "error.user_already_exists": "Este usuario ya existe.",
     "error.unable_to_create_user": "Incapaz de crear este usuario.",
     "error.unable_to_update_user": "Incapaz de actualizar este usuario.",
     "error.unable_to_update_feed": "Incapaz de actualizar esta fuente.",
+    "error.feed_changed": "Esta fuente ha sido modificada mientras tanto, envíe el formulario de nuevo para sobrescribir los cambios.",
     "error.subscription_not_found": "Incapaz de encontrar ninguna suscripción.",
     "error.empty_file": "Este archivo está vacío.",
     "error.bad_credentials": "Usuario o contraseña no válido.",
@@ -763,27 +1417,107 @@ var translations = map[string]string{
     "error.different_passwords": "Las contraseñas no son las mismas.",
     "error.password_min_length": "La contraseña debería tener al menos 6 caracteres.",
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
+    "error.entries_per_page_invalid": "El número de entradas por página debe estar entre 1 y %d.",
+    "error.quiet_hours_invalid": "El inicio y el fin de las horas de silencio deben indicarse ambos como HH:MM, o estar ambos vacíos.",
+    "error.integration_filters_invalid": "Los eventos seleccionados para las integraciones no son válidos.",
+    "error.kindle_email_invalid": "La dirección de correo de Kindle no es válida.",
+    "error.bulk_empty_selection": "No se ha seleccionado ningún elemento.",
+    "error.category_not_found": "Esta categoría no existe o no pertenece a este usuario.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.feed_invalid_script": "Script no válido: %v.",
+    "error.feed_script_not_allowed": "Solo los administradores pueden cambiar el script de una fuente.",
+    "error.feed_invalid_entry_open_mode": "Modo de apertura de entradas no válido.",
+    "error.feed_invalid_priority": "Prioridad no válida.",
+    "error.feed_invalid_entry_limit": "El límite de artículos no es válido.",
+    "error.feed_invalid_scraper_max_pages": "El número de páginas debe estar entre 1 y %d.",
+    "error.feed_invalid_summary_words": "El número de palabras debe estar entre 0 y %d.",
+    "error.feed_invalid_entry_matching": "Reconocimiento de artículos no válido.",
+    "error.feed_invalid_muted_until": "La fecha de silencio debe tener el formato AAAA-MM-DD.",
+    "error.mark_read_after_days_invalid": "El número de días antes de marcar los artículos como leídos no puede ser negativo.",
+    "error.image_display_invalid": "Modo de visualización de imágenes no válido.",
+    "error.nsfw_display_invalid": "Modo de visualización NSFW no válido.",
+    "error.junk_threshold_invalid": "El umbral de basura debe estar entre 0 y %d.",
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
     "form.feed.label.title": "Título",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
     "form.feed.label.category": "Categoría",
     "form.feed.label.crawler": "Obtener contento original",
+    "form.feed.label.nsfw": "Artículos no aptos para el trabajo (NSFW)",
+    "form.feed.label.summarize_entries": "Generar un resumen de los artículos nuevos",
+    "form.feed.label.entry_open_mode": "Abrir entradas con",
+    "form.feed.label.priority": "Prioridad en la lista de no leídos",
+    "form.feed.label.muted_until": "Silenciar hasta",
+    "form.feed.help.muted_until": "Los nuevos artículos se siguen descargando, pero se marcan como leídos y no se cuentan como no leídos hasta ese día. Deje el campo vacío para reactivar la fuente.",
+    "form.feed.label.mark_read_after_days": "Marcar los artículos no leídos como leídos después de (días)",
+    "form.feed.help.mark_read_after_days": "Use 0 para seguir la regla de la categoría.",
+    "form.feed.label.max_entries": "Número máximo de artículos almacenados",
+    "form.feed.help.max_entries": "Los artículos marcados siempre se conservan. Use 0 para conservar todos los artículos.",
+    "form.feed.label.overflow_policy": "Artículos por encima del límite",
+    "form.feed.select.overflow_archive": "Archivar los artículos más antiguos",
+    "form.feed.select.overflow_delete": "Eliminar los artículos más antiguos",
+    "form.feed.label.entry_matching": "Reconocer artículos republicados",
+    "form.feed.help.entry_matching": "Use esta opción cuando la fuente cambia el identificador de sus artículos y crea duplicados.",
+    "form.feed.help.image_display": "Por defecto, se usa el ajuste de la categoría y, si no, el de sus preferencias.",
+    "form.feed.label.summary_words": "Conservar solo las primeras palabras de los artículos",
+    "form.feed.help.summary_words": "Los artículos nuevos se recortan a este número de palabras, seguidos de un enlace al sitio web. Use 0 para conservar el contenido completo.",
+    "form.feed.select.entry_matching_guid": "Solo por identificador",
+    "form.feed.select.entry_matching_url": "Por identificador o URL",
+    "form.feed.select.entry_matching_title": "Por identificador o título y fecha",
+    "form.feed.label.watch_selector": "Región vigilada (selector CSS)",
+    "form.feed.select.open_content": "Contenido de la fuente",
+    "form.feed.select.open_full_content": "Contenido completo del sitio web",
+    "form.feed.select.open_original": "Sitio web original",
+    "form.feed.select.priority_low": "Baja",
+    "form.feed.select.priority_normal": "Normal",
+    "form.feed.select.priority_high": "Alta",
     "form.feed.label.feed_username": "Nombre de usuario de fuente",
     "form.feed.label.feed_password": "Contraseña de fuente",
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
     "form.feed.label.scraper_rules": "Reglas de raspador",
+    "form.feed.label.scraper_max_pages": "Número máximo de páginas por artículo",
+    "form.feed.help.scraper_max_pages": "Al obtener el contenido original, se añaden las páginas siguientes de los artículos divididos en varias páginas. Use 1 para obtener solo la primera página.",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
+    "form.feed.label.script": "Script",
     "form.category.label.title": "Título",
+    "form.category.label.mark_read_after_days": "Marcar los artículos no leídos como leídos después de (días)",
+    "form.category.help.mark_read_after_days": "Se aplica a las fuentes de esta categoría sin regla propia. Use 0 para mantener los artículos sin leer.",
+    "form.category.help.image_display": "Se aplica a las fuentes de esta categoría sin ajuste propio. Por defecto, se usa el ajuste de sus preferencias.",
+    "form.category.label.nsfw": "Las fuentes de esta categoría no son aptas para el trabajo (NSFW)",
+    "form.category_share.label.username": "Nombre de usuario",
+    "form.category_share.help.username": "Este usuario puede leer los artículos de la categoría pero no modificarlos.",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
     "form.user.label.admin": "Administrador",
+    "form.user.label.email": "Correo electrónico",
+    "form.user.label.invitation": "Código de invitación",
+    "form.invitation.help.email": "Opcional, el enlace de invitación se envía a esta dirección y solo ella puede usarlo.",
+    "form.password_reset.help.email": "El correo electrónico definido en los ajustes de su cuenta.",
+    "form.delete_account.help.password": "Introduzca su contraseña para confirmar.",
     "form.prefs.label.language": "Idioma",
     "form.prefs.label.timezone": "Zona horaria",
     "form.prefs.label.theme": "Tema",
     "form.prefs.label.entry_sorting": "Clasificación de entradas",
+    "form.prefs.label.entries_per_page": "Entradas por página",
+    "form.prefs.label.show_read_entries": "Mostrar entradas leídas en las páginas de fuentes y categorías",
+    "form.prefs.label.show_absolute_time": "Mostrar fechas en lugar del tiempo transcurrido",
+    "form.prefs.label.image_display": "Imágenes en los artículos",
+    "form.prefs.label.nsfw_display": "Artículos no aptos para el trabajo (NSFW)",
+    "form.prefs.help.nsfw_display": "Los artículos se marcan por su fuente, su categoría, su clasificación o una marca NSFW en el título.",
+    "form.prefs.label.junk_threshold": "Marcar como leídos los artículos con una puntuación de basura de al menos",
+    "form.prefs.help.junk_threshold": "La puntuación de basura, de 0 a 100, evalúa los títulos clickbait, los artículos casi vacíos y las listas de enlaces. Use 0 para desactivar.",
+    "form.prefs.select.image_display_show": "Mostrar",
+    "form.prefs.select.image_display_blur": "Difuminar hasta hacer clic",
+    "form.prefs.select.image_display_hide": "Ocultar y mostrar enlaces en su lugar",
+    "form.prefs.select.nsfw_display_show": "Mostrar",
+    "form.prefs.select.nsfw_display_blur": "Difuminar hasta hacer clic",
+    "form.prefs.select.nsfw_display_hide": "Ocultar",
+    "form.prefs.select.image_display_inherit": "Por defecto",
+    "form.prefs.label.quiet_hours_start": "Inicio de las horas de silencio",
+    "form.prefs.label.quiet_hours_end": "Fin de las horas de silencio",
+    "form.prefs.help.quiet_hours": "Las notificaciones se retienen durante las horas de silencio y se envían juntas cuando terminan.",
+    "form.prefs.help.email": "Se usa para enviarle un enlace cuando olvide su contraseña.",
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
     "form.import.label.file": "Archivo OPML",
@@ -811,8 +1545,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Guardar artículos a Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Extremo de API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clave de API de Nunux Keeper",
+    "form.integration.readeck_activate": "Guardar artículos en Readeck",
+    "form.integration.readeck_endpoint": "URL de Readeck",
+    "form.integration.readeck_api_key": "Token de API de Readeck",
+    "form.integration.readeck_labels": "Etiquetas de Readeck (opcional, separadas por comas)",
+    "form.integration.omnivore_activate": "Guardar artículos en Omnivore",
+    "form.integration.omnivore_endpoint": "Acceso API de Omnivore (opcional)",
+    "form.integration.omnivore_api_key": "Clave de API de Omnivore",
+    "form.integration.ntfy_activate": "Enviar notificaciones a ntfy",
+    "form.integration.ntfy_url": "URL del servidor ntfy",
+    "form.integration.ntfy_topic": "Tema de ntfy",
+    "form.integration.ntfy_token": "Token de acceso de ntfy",
+    "form.integration.pushover_activate": "Enviar notificaciones a Pushover",
+    "form.integration.pushover_token": "Token de aplicación de Pushover",
+    "form.integration.pushover_user": "Clave de usuario de Pushover",
+    "form.integration.apprise_activate": "Enviar notificaciones a Apprise",
+    "form.integration.apprise_url": "Endpoint de notificación de la API de Apprise",
+    "form.integration.apprise_service_urls": "URLs de servicios de Apprise (opcional, separadas por comas)",
+    "form.integration.kindle_activate": "Enviar artículos a mi Kindle",
+    "form.integration.kindle_email": "Dirección de correo de Send to Kindle",
+    "form.integration.kindle_help": "Añada %s a las direcciones de correo aprobadas de su cuenta de Amazon.",
+    "form.integration.events": "Eventos enviados a este servicio:",
+    "form.integration.event_starred": "Artículos marcados",
+    "form.integration.event_new_entries": "Nuevos artículos",
+    "form.integration.event_feed_error": "Errores de las fuentes",
+    "form.integration.event_categories": "Solo nuevos artículos de estas categorías (todas si no se selecciona ninguna)",
+    "notification.feed_error": "No se puede actualizar la fuente %s",
+    "notification.new_entries": [
+        "%d nuevo artículo",
+        "%d nuevos artículos"
+    ],
+    "notification.quiet_hours": [
+        "%d notificación durante las horas de silencio",
+        "%d notificaciones durante las horas de silencio"
+    ],
     "form.submit.loading": "Cargando...",
     "form.submit.saving": "Guardando...",
+    "form.bulk.selected_entries": "Artículos seleccionados:",
+    "form.bulk.select_entry": "Seleccionar este artículo",
+    "form.bulk.select_feed": "Seleccionar esta fuente",
+    "form.bulk.move_feeds": "Mover las fuentes seleccionadas a:",
+    "form.bulk.action.read": "Marcar como leído",
+    "form.bulk.action.unread": "Marcar como no leído",
+    "form.bulk.action.star": "Marcar",
+    "form.bulk.action.unstar": "Desmarcar",
+    "form.bulk.action.move": "Mover",
+    "form.least_read_feeds.label.months": "Nada leído desde hace",
+    "form.least_read_feeds.months": [
+        "%d mes",
+        "%d meses"
+    ],
     "time_elapsed.not_yet": "todavía no",
     "time_elapsed.yesterday": "ayer",
     "time_elapsed.now": "ahora mismo",
@@ -839,7 +1621,14 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "hace %d año",
         "hace %d años"
-    ]
+    ],
+    "time_format.absolute": "02/01/2006 15:04",
+    "email.verification.subject": "Verifique su correo electrónico",
+    "email.verification.body": "Hola %s,\n\nAbra este enlace para verificar su correo electrónico y activar su cuenta de Miniflux:\n%s\n\nEl enlace caduca en 24 horas.",
+    "email.invitation.subject": "Invitación a Miniflux",
+    "email.invitation.body": "%s le invita a crear una cuenta en Miniflux:\n%s\n\nLa invitación caduca en 7 días.",
+    "email.password_reset.subject": "Restablezca su contraseña",
+    "email.password_reset.body": "Hola %s,\n\nAbra este enlace para elegir una nueva contraseña para su cuenta de Miniflux:\n%s\n\nEl enlace caduca en una hora. Puede ignorar este correo si no lo ha solicitado."
 }
 `,
 	"fr_FR": `{
@@ -858,6 +1647,16 @@ var translations = map[string]string{
     "action.download": "Télécharger",
     "action.import": "Importer",
     "action.login": "Se connecter",
+    "action.signup": "S'inscrire",
+    "action.send_reset_link": "Envoyer le lien",
+    "action.delete_account": "Supprimer mon compte",
+    "action.invite": "Inviter",
+    "action.show": "Afficher",
+    "action.mute_for_a_month": "Mettre en sourdine pendant un mois",
+    "action.unsubscribe": "Se désabonner",
+    "action.share": "Partager",
+    "action.approve": "Approuver",
+    "action.reject": "Refuser",
     "tooltip.keyboard_shortcuts": "Raccourci clavier : %s",
     "tooltip.logged_user": "Connecté en tant que %s",
     "menu.unread": "Non lus",
@@ -870,7 +1669,10 @@ var translations = map[string]string{
     "menu.preferences": "Préférences",
     "menu.integrations": "Intégrations",
     "menu.sessions": "Sessions",
+    "menu.delete_account": "Supprimer le compte",
     "menu.users": "Utilisateurs",
+    "menu.invitations": "Invitations",
+    "menu.statistics": "Statistiques",
     "menu.about": "A propos",
     "menu.export": "Export",
     "menu.import": "Import",
@@ -878,15 +1680,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Marquer cette page comme lu",
     "menu.mark_all_as_read": "Tout marquer comme lu",
     "menu.mark_all_as_read_wip": "Opération en cours...",
+    "menu.undo_mark_all_as_read": "Annuler « Tout marquer comme lu »",
     "menu.show_all_entries": "Afficher tous les articles",
     "menu.show_only_unread_entries": "Afficher uniquement les articles non lus",
     "menu.refresh_feed": "Actualiser",
     "menu.refresh_all_feeds": "Actualiser les abonnements en arrière-plan",
     "menu.edit_feed": "Modifier",
     "menu.edit_category": "Modifier",
+    "menu.share_category": "Partager",
     "menu.add_feed": "Ajouter un abonnement",
     "menu.add_user": "Ajouter un utilisateur",
     "menu.flush_history": "Supprimer l'historique",
+    "menu.recently_read": "Lus récemment",
+    "menu.junk": "Indésirables",
+    "menu.export_epub": "Télécharger en EPUB",
+    "menu.export_printable": "Version imprimable",
+    "menu.least_read_feeds": "Abonnements les moins lus",
+    "menu.pending_feeds": "Abonnements en attente",
     "search.label": "Recherche",
     "search.placeholder": "Recherche...",
     "pagination.next": "Suivant",
@@ -897,16 +1707,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Favoris",
     "entry.bookmark.toggle.off": "Enlever favoris",
     "entry.state.saving": "Sauvegarde en cours...",
+    "entry.state.sending": "Envoi...",
     "entry.state.loading": "Chargement...",
     "entry.save.label": "Sauvegarder",
     "entry.save.title": "Sauvegarder cet article",
     "entry.save.completed": "Terminé !",
+    "entry.kindle.label": "Envoyer vers Kindle",
+    "entry.kindle.title": "Envoyer cet article vers ma liseuse Kindle",
+    "entry.kindle.completed": "Envoyé !",
     "entry.scraper.label": "Contenu original",
     "entry.scraper.title": "Récupérer le contenu original",
     "entry.scraper.completed": "Terminé !",
+    "entry.summarize.label": "Résumer",
+    "entry.summarize.title": "Générer un résumé de cet article",
+    "entry.summarize.completed": "Terminé !",
     "entry.original.label": "Original",
     "entry.comments.label": "Commentaires",
+    "entry.snapshot.title": "Lire la copie enregistrée de la page web",
+    "entry.snapshot.label": "Copie",
     "entry.comments.title": "Voir les commentaires",
+    "entry.summary.read_more": "Lire la suite",
+    "entry.watch.title": "Modifications sur %s (%s)",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
     "page.categories.title": "Catégories",
@@ -915,17 +1736,37 @@ var translations = map[string]string{
         "Il y a %d abonnement.",
         "Il y a %d abonnements."
     ],
+    "page.categories.shared_with_me": "Partagées avec moi",
     "page.new_category.title": "Nouvelle catégorie",
     "page.new_user.title": "Nouvel Utilisateur",
     "page.edit_category.title": "Modification de la catégorie : %s",
+    "page.category_shares.title": "Partager la catégorie : %s",
+    "page.category_shares.since": "Partagée depuis",
+    "page.shared_category_entries.shared_by": "Partagée par %s",
     "page.edit_user.title": "Modification de l'utilisateur : %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Dernière vérification :",
+    "page.feeds.muted_until": "En sourdine jusqu'au :",
+    "page.feeds.pending": "En attente d'approbation",
     "page.feeds.error_count": [
         "%d erreur",
         "%d erreurs"
     ],
     "page.history.title": "Historique",
+    "page.recently_read.title": "Lus récemment",
+    "page.junk.title": "Indésirables",
+    "page.junk.help": "Articles avec un score indésirable d'au moins %d.",
+    "page.junk.score": "Score indésirable : %d",
+    "page.recently_read.read_at": "Lu",
+    "page.least_read_feeds.title": "Abonnements les moins lus",
+    "page.least_read_feeds.last_read": "Dernière lecture :",
+    "page.least_read_feeds.never_read": "Jamais lu",
+    "page.least_read_feeds.unread_count": [
+        "%d article non lu",
+        "%d articles non lus"
+    ],
+    "page.pending_feeds.title": "Abonnements en attente",
+    "page.pending_feeds.requested_by": "Demandé par %s",
     "page.import.title": "Importation",
     "page.search.title": "Résultats de la recherche",
     "page.about.title": "A propos",
@@ -939,6 +1780,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "Lien",
     "page.add_feed.submit": "Trouver un abonnement",
     "page.add_feed.legend.advanced_options": "Options avancées",
+    "page.add_feed.watch_selector_help": "Remplissez ce champ pour surveiller une page web sans flux : un article est créé lorsque le texte des éléments correspondants change.",
     "page.add_feed.choose_feed": "Choisissez un abonnement",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
@@ -981,11 +1823,39 @@ var translations = map[string]string{
     "page.users.actions": "Actions",
     "page.users.last_login": "Dernière connexion",
     "page.users.is_admin": "Administrateur",
+    "page.users.not_verified": "Adresse e-mail non vérifiée",
+    "page.users.pending": "En attente d'approbation",
+    "page.invitations.title": "Invitations",
+    "page.invitations.expires": "Expire",
+    "page.invitations.status": "Statut",
+    "page.invitations.used": "Utilisée",
+    "page.invitations.unused": "Non utilisée",
+    "page.statistics.title": "Statistiques",
+    "page.statistics.users": "Utilisateurs",
+    "page.statistics.feeds": "Abonnements",
+    "page.statistics.entries": "Articles (estimation)",
+    "page.statistics.database_size": "Taille de la base de données",
+    "page.statistics.fetches_last_hour": "Actualisations de la dernière heure",
+    "page.statistics.fetches_last_day": "Actualisations des dernières 24 heures",
+    "page.statistics.fetch_success_rate": "Actualisations réussies",
+    "page.statistics.shared_feeds": "Flux suivis par plusieurs utilisateurs",
+    "page.statistics.feed_url": "URL du flux",
+    "page.statistics.subscribers": "Abonnés",
+    "page.statistics.error_domains": "Domaines avec le plus d'erreurs",
+    "page.statistics.domain": "Domaine",
+    "page.statistics.error_feeds": "Flux en erreur",
     "page.settings.title": "Réglages",
     "page.settings.link_google_account": "Associer mon compte Google",
     "page.settings.unlink_google_account": "Dissocier mon compte Google",
     "page.login.title": "Connexion",
     "page.login.google_signin": "Se connecter avec Google",
+    "page.login.no_account": "Vous n'avez pas de compte ?",
+    "page.signup.title": "Inscription",
+    "page.login.forgot_password": "Mot de passe oublié ?",
+    "page.forgot_password.title": "Mot de passe oublié",
+    "page.reset_password.title": "Réinitialiser le mot de passe",
+    "page.delete_account.title": "Supprimer le compte",
+    "page.delete_account.description": "Vos abonnements, articles, catégories et intégrations seront supprimés définitivement. Vous serez déconnecté immédiatement et cette action est irréversible.",
     "page.integrations.title": "Intégrations",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Point de terminaison de l'API",
@@ -993,9 +1863,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Mot de passe",
     "page.integration.miniflux_api_password_value": "Le mot de passe de votre compte",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Extension du navigateur",
+    "page.integration.browser_extension_endpoint": "Point de terminaison de l'extension",
+    "page.integration.browser_extension_token": "Jeton",
+    "page.integration.browser_extension_no_token": "Aucun jeton généré",
+    "page.integration.browser_extension_generate_token": "Générer un nouveau jeton",
     "page.integration.bookmarklet.name": "Ajouter à Miniflux",
     "page.integration.bookmarklet.instructions": "Glisser-déposer ce lien dans vos favoris.",
     "page.integration.bookmarklet.help": "Ce lien spécial vous permet de vous abonner à un site web directement en utilisant un marque page dans votre navigateur web.",
+    "page.entry_snapshot.taken": "Enregistrée",
+    "page.entry_snapshot.refresh": "Enregistrer une nouvelle copie",
+    "page.entry_snapshot.last_error": "La dernière tentative de copie a échoué :",
+    "page.entry_snapshot.empty": "Il n'y a pas encore de copie enregistrée de cette page web.",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "Adresse IP",
@@ -1005,29 +1884,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Il n'y a aucun favoris pour le moment.",
     "alert.no_category": "Il n'y a aucune catégorie.",
     "alert.no_category_entry": "Il n'y a aucun article dans cette catégorie.",
+    "alert.no_category_share": "Cette catégorie n'est partagée avec personne.",
     "alert.no_feed_entry": "Il n'y a aucun article pour cet abonnement.",
     "alert.no_feed": "Vous n'avez aucun abonnement.",
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
+    "alert.no_junk": "Il n'y a aucun article indésirable.",
+    "alert.no_least_read_feed": "Vous avez lu des articles de tous vos abonnements pendant cette période.",
+    "alert.no_pending_feed": "Aucun abonnement n'est en attente d'approbation.",
+    "alert.feed_pending": "L'abonnement a été enregistré, ses articles seront téléchargés dès qu'un administrateur l'aura approuvé.",
+    "alert.no_invitation": "Il n'y a aucune invitation.",
+    "alert.no_shared_feed": "Aucun flux n'est suivi par plusieurs utilisateurs.",
+    "alert.no_error_domain": "Aucun domaine n'a de flux en erreur.",
+    "alert.signup_verification_sent": "Un lien de vérification a été envoyé à %s.",
+    "alert.account_verified": "Votre adresse e-mail est vérifiée, vous pouvez maintenant vous connecter.",
+    "alert.account_verified_pending": "Votre adresse e-mail est vérifiée, votre compte sera activé dès qu'un administrateur l'aura approuvé.",
+    "alert.invitation_created": "L'invitation a été créée, partagez ce lien : %s",
+    "alert.password_reset_sent": "Si un compte utilise %s, un lien pour choisir un nouveau mot de passe a été envoyé.",
+    "alert.password_reset": "Votre mot de passe a été modifié, vous pouvez maintenant vous connecter.",
+    "alert.account_deletion_requested": "Votre compte a été fermé, vos données seront supprimées prochainement.",
     "alert.feed_error": "Il y a un problème avec cet abonnement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
+    "alert.read_only": "Cette instance est en lecture seule, vous pouvez la parcourir mais vos modifications ne sont pas enregistrées.",
+    "alert.write_freeze": "Les modifications de votre compte sont désactivées jusqu'au %s pour maintenance.",
     "alert.no_user": "Vous êtes le seul utilisateur.",
     "alert.account_unlinked": "Votre compte externe est maintenant dissocié !",
     "alert.account_linked": "Votre compte externe est maintenant associé !",
     "alert.pocket_linked": "Votre compte Pocket est maintenant connecté !",
     "alert.prefs_saved": "Préférences sauvegardées !",
+    "alert.extension_token_generated": "Un nouveau jeton a été généré, le précédent ne fonctionne plus.",
+    "alert.entry_snapshot_saved": "Une nouvelle copie de la page web a été enregistrée.",
     "error.unlink_account_without_password": "Vous devez définir un mot de passe sinon vous ne pourrez plus vous connecter par la suite.",
     "error.duplicate_linked_account": "Il y a déjà quelqu'un d'associé avec ce provider !",
     "error.duplicate_fever_username": "Il y a déjà quelqu'un d'autre avec le même nom d'utilisateur Fever !",
     "error.pocket_request_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
     "error.pocket_access_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
+    "error.entry_snapshot_failed": "Impossible de télécharger la page web, la copie précédente a été conservée.",
     "error.category_already_exists": "Cette catégorie existe déjà.",
     "error.unable_to_create_category": "Impossible de créer cette catégorie.",
     "error.unable_to_update_category": "Impossible de mettre à jour cette catégorie.",
+    "error.category_changed": "Cette catégorie a été modifiée entre-temps, envoyez le formulaire à nouveau pour écraser les modifications.",
+    "error.read_only": "Cette instance est en lecture seule, les modifications ne sont pas autorisées.",
+    "error.write_freeze": "Les modifications de votre compte sont temporairement désactivées.",
+    "error.category_share_user_not_found": "Cet utilisateur n'existe pas.",
+    "error.invalid_email": "Cette adresse e-mail n'est pas valide.",
+    "error.email_domain_not_allowed": "Ce domaine de messagerie n'est pas autorisé.",
+    "error.email_already_exists": "Cette adresse e-mail est déjà utilisée.",
+    "error.invalid_invitation": "Ce code d'invitation n'est pas valide ou a expiré.",
+    "error.unable_to_send_email": "Impossible d'envoyer l'e-mail, veuillez réessayer plus tard.",
+    "error.invalid_verification_link": "Ce lien de vérification n'est pas valide ou a expiré.",
+    "error.user_not_verified": "Votre adresse e-mail n'est pas encore vérifiée, ouvrez le lien envoyé par e-mail.",
+    "error.user_pending": "Votre compte est en attente de l'approbation d'un administrateur.",
+    "error.invalid_password_reset_link": "Ce lien de réinitialisation du mot de passe n'est pas valide ou a expiré.",
+    "error.account_deletion_admin": "Un compte administrateur doit être supprimé par un autre administrateur.",
+    "error.invalid_password": "Le mot de passe est incorrect.",
     "error.user_already_exists": "Cet utilisateur existe déjà.",
     "error.unable_to_create_user": "Impossible de créer cet utilisateur.",
     "error.unable_to_update_user": "Impossible de mettre à jour cet utilisateur.",
     "error.unable_to_update_feed": "Impossible de mettre à jour cet abonnement.",
+    "error.feed_changed": "Cet abonnement a été modifié entre-temps, envoyez le formulaire à nouveau pour écraser les modifications.",
     "error.subscription_not_found": "Impossible de trouver un abonnement.",
     "error.empty_file": "Ce fichier est vide.",
     "error.bad_credentials": "Mauvais identifiant ou mot de passe.",
@@ -1036,27 +1951,107 @@ var translations = map[string]string{
     "error.different_passwords": "Les mots de passe ne sont pas les mêmes.",
     "error.password_min_length": "Vous devez utiliser au moins 6 caractères pour le mot de passe.",
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
+    "error.entries_per_page_invalid": "Le nombre d'éléments par page doit être compris entre 1 et %d.",
+    "error.quiet_hours_invalid": "Le début et la fin des heures de silence doivent tous deux être au format HH:MM, ou être vides.",
+    "error.integration_filters_invalid": "Les événements sélectionnés pour les intégrations ne sont pas valides.",
+    "error.kindle_email_invalid": "L'adresse email Kindle n'est pas valide.",
+    "error.bulk_empty_selection": "Aucun élément n'a été sélectionné.",
+    "error.category_not_found": "Cette catégorie n'existe pas ou n'appartient pas à cet utilisateur.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.feed_invalid_script": "Script invalide : %v.",
+    "error.feed_script_not_allowed": "Seuls les administrateurs peuvent modifier le script d'un abonnement.",
+    "error.feed_invalid_entry_open_mode": "Mode d'ouverture des éléments invalide.",
+    "error.feed_invalid_priority": "Priorité invalide.",
+    "error.feed_invalid_entry_limit": "La limite d'articles n'est pas valide.",
+    "error.feed_invalid_scraper_max_pages": "Le nombre de pages doit être compris entre 1 et %d.",
+    "error.feed_invalid_summary_words": "Le nombre de mots doit être compris entre 0 et %d.",
+    "error.feed_invalid_entry_matching": "Reconnaissance des articles non valide.",
+    "error.feed_invalid_muted_until": "La date de mise en sourdine doit être au format AAAA-MM-JJ.",
+    "error.mark_read_after_days_invalid": "Le nombre de jours avant de marquer les articles comme lus ne peut pas être négatif.",
+    "error.image_display_invalid": "Mode d'affichage des images invalide.",
+    "error.nsfw_display_invalid": "Mode d'affichage NSFW invalide.",
+    "error.junk_threshold_invalid": "Le seuil des articles indésirables doit être compris entre 0 et %d.",
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
     "form.feed.label.title": "Titre",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
     "form.feed.label.category": "Catégorie",
     "form.feed.label.crawler": "Récupérer le contenu original",
+    "form.feed.label.nsfw": "Articles inappropriés au travail (NSFW)",
+    "form.feed.label.summarize_entries": "Générer un résumé des nouveaux articles",
+    "form.feed.label.entry_open_mode": "Ouvrir les éléments avec",
+    "form.feed.label.priority": "Priorité dans la liste des non lus",
+    "form.feed.label.muted_until": "Mettre en sourdine jusqu'au",
+    "form.feed.help.muted_until": "Les nouveaux articles sont toujours récupérés mais sont marqués comme lus et exclus des compteurs de non lus jusqu'à ce jour. Laissez vide pour réactiver l'abonnement.",
+    "form.feed.label.mark_read_after_days": "Marquer les articles non lus comme lus après (jours)",
+    "form.feed.help.mark_read_after_days": "Utilisez 0 pour suivre la règle de la catégorie.",
+    "form.feed.label.max_entries": "Nombre maximum d'articles conservés",
+    "form.feed.help.max_entries": "Les favoris sont toujours conservés. Utilisez 0 pour conserver tous les articles.",
+    "form.feed.label.overflow_policy": "Articles au-delà de la limite",
+    "form.feed.select.overflow_archive": "Archiver les articles les plus anciens",
+    "form.feed.select.overflow_delete": "Supprimer les articles les plus anciens",
+    "form.feed.label.entry_matching": "Reconnaître les articles republiés",
+    "form.feed.help.entry_matching": "Utilisez cette option quand le flux change l'identifiant de ses articles et crée des doublons.",
+    "form.feed.help.image_display": "Par défaut, le réglage de la catégorie est utilisé, puis celui de vos préférences.",
+    "form.feed.label.summary_words": "Ne garder que les premiers mots des articles",
+    "form.feed.help.summary_words": "Les nouveaux articles sont tronqués à ce nombre de mots, suivis d'un lien vers le site web. Utilisez 0 pour garder le contenu complet.",
+    "form.feed.select.entry_matching_guid": "Par identifiant uniquement",
+    "form.feed.select.entry_matching_url": "Par identifiant ou URL",
+    "form.feed.select.entry_matching_title": "Par identifiant ou titre et date",
+    "form.feed.label.watch_selector": "Zone surveillée (sélecteur CSS)",
+    "form.feed.select.open_content": "Contenu de l'abonnement",
+    "form.feed.select.open_full_content": "Contenu complet du site web",
+    "form.feed.select.open_original": "Site web original",
+    "form.feed.select.priority_low": "Basse",
+    "form.feed.select.priority_normal": "Normale",
+    "form.feed.select.priority_high": "Haute",
     "form.feed.label.feed_username": "Nom d'utilisateur du flux",
     "form.feed.label.feed_password": "Mot de passe du flux",
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
+    "form.feed.label.scraper_max_pages": "Nombre maximum de pages par article",
+    "form.feed.help.scraper_max_pages": "Lors de la récupération du contenu original, les pages suivantes des articles découpés en plusieurs pages sont ajoutées. Utilisez 1 pour ne récupérer que la première page.",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
+    "form.feed.label.script": "Script",
     "form.category.label.title": "Titre",
+    "form.category.label.mark_read_after_days": "Marquer les articles non lus comme lus après (jours)",
+    "form.category.help.mark_read_after_days": "S'applique aux abonnements de cette catégorie sans règle propre. Utilisez 0 pour garder les articles non lus.",
+    "form.category.help.image_display": "S'applique aux flux de cette catégorie sans réglage propre. Par défaut, le réglage de vos préférences est utilisé.",
+    "form.category.label.nsfw": "Les abonnements de cette catégorie sont inappropriés au travail (NSFW)",
+    "form.category_share.label.username": "Nom d'utilisateur",
+    "form.category_share.help.username": "Cet utilisateur peut lire les articles de la catégorie mais ne peut pas les modifier.",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
     "form.user.label.admin": "Administrateur",
+    "form.user.label.email": "Adresse e-mail",
+    "form.user.label.invitation": "Code d'invitation",
+    "form.invitation.help.email": "Facultatif, le lien d'invitation est envoyé à cette adresse et seule cette adresse peut l'utiliser.",
+    "form.password_reset.help.email": "L'adresse e-mail définie dans les réglages de votre compte.",
+    "form.delete_account.help.password": "Saisissez votre mot de passe pour confirmer.",
     "form.prefs.label.language": "Langue",
     "form.prefs.label.timezone": "Fuseau horaire",
     "form.prefs.label.theme": "Thème",
     "form.prefs.label.entry_sorting": "Ordre des éléments",
+    "form.prefs.label.entries_per_page": "Éléments par page",
+    "form.prefs.label.show_read_entries": "Afficher les éléments lus sur les pages des abonnements et catégories",
+    "form.prefs.label.show_absolute_time": "Afficher les dates au lieu du temps écoulé",
+    "form.prefs.label.image_display": "Images dans les articles",
+    "form.prefs.label.nsfw_display": "Articles inappropriés au travail (NSFW)",
+    "form.prefs.help.nsfw_display": "Les articles sont signalés par leur abonnement, leur catégorie, leur classification ou une mention NSFW dans leur titre.",
+    "form.prefs.label.junk_threshold": "Marquer comme lus les articles avec un score indésirable d'au moins",
+    "form.prefs.help.junk_threshold": "Le score indésirable, de 0 à 100, évalue les titres racoleurs, les articles presque vides et les listes de liens. Utilisez 0 pour désactiver.",
+    "form.prefs.select.image_display_show": "Afficher",
+    "form.prefs.select.image_display_blur": "Flouter jusqu'au clic",
+    "form.prefs.select.image_display_hide": "Masquer et afficher des liens à la place",
+    "form.prefs.select.nsfw_display_show": "Afficher",
+    "form.prefs.select.nsfw_display_blur": "Flouter jusqu'au clic",
+    "form.prefs.select.nsfw_display_hide": "Masquer",
+    "form.prefs.select.image_display_inherit": "Par défaut",
+    "form.prefs.label.quiet_hours_start": "Début des heures de silence",
+    "form.prefs.label.quiet_hours_end": "Fin des heures de silence",
+    "form.prefs.help.quiet_hours": "Les notifications sont retenues pendant les heures de silence et envoyées ensemble à la fin de celles-ci.",
+    "form.prefs.help.email": "Utilisée pour vous envoyer un lien lorsque vous oubliez votre mot de passe.",
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
     "form.import.label.file": "Fichier OPML",
@@ -1084,8 +2079,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Sauvegarder les articles vers Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "URL de l'API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clé d'API de Nunux Keeper",
+    "form.integration.readeck_activate": "Sauvegarder les articles vers Readeck",
+    "form.integration.readeck_endpoint": "URL de Readeck",
+    "form.integration.readeck_api_key": "Jeton d'API Readeck",
+    "form.integration.readeck_labels": "Libellés Readeck (facultatif, séparés par des virgules)",
+    "form.integration.omnivore_activate": "Sauvegarder les articles vers Omnivore",
+    "form.integration.omnivore_endpoint": "URL de l'API d'Omnivore (facultatif)",
+    "form.integration.omnivore_api_key": "Clé d'API Omnivore",
+    "form.integration.ntfy_activate": "Envoyer les notifications à ntfy",
+    "form.integration.ntfy_url": "URL du serveur ntfy",
+    "form.integration.ntfy_topic": "Sujet ntfy",
+    "form.integration.ntfy_token": "Jeton d'accès ntfy",
+    "form.integration.pushover_activate": "Envoyer les notifications à Pushover",
+    "form.integration.pushover_token": "Jeton d'application Pushover",
+    "form.integration.pushover_user": "Clé utilisateur Pushover",
+    "form.integration.apprise_activate": "Envoyer les notifications à Apprise",
+    "form.integration.apprise_url": "Point de terminaison de notification de l'API Apprise",
+    "form.integration.apprise_service_urls": "URLs des services Apprise (facultatif, séparées par des virgules)",
+    "form.integration.kindle_activate": "Envoyer les articles vers ma liseuse Kindle",
+    "form.integration.kindle_email": "Adresse email Send to Kindle",
+    "form.integration.kindle_help": "Ajoutez %s aux adresses email approuvées de votre compte Amazon.",
+    "form.integration.events": "Événements envoyés à ce service :",
+    "form.integration.event_starred": "Articles favoris",
+    "form.integration.event_new_entries": "Nouveaux articles",
+    "form.integration.event_feed_error": "Erreurs des flux",
+    "form.integration.event_categories": "Uniquement les nouveaux articles de ces catégories (toutes si aucune n'est sélectionnée)",
+    "notification.feed_error": "Impossible d'actualiser le flux %s",
+    "notification.new_entries": [
+        "%d nouvel article",
+        "%d nouveaux articles"
+    ],
+    "notification.quiet_hours": [
+        "%d notification pendant les heures de silence",
+        "%d notifications pendant les heures de silence"
+    ],
     "form.submit.loading": "Chargement...",
     "form.submit.saving": "Sauvegarde en cours...",
+    "form.bulk.selected_entries": "Articles sélectionnés :",
+    "form.bulk.select_entry": "Sélectionner cet article",
+    "form.bulk.select_feed": "Sélectionner cet abonnement",
+    "form.bulk.move_feeds": "Déplacer les abonnements sélectionnés vers :",
+    "form.bulk.action.read": "Marquer comme lu",
+    "form.bulk.action.unread": "Marquer comme non lu",
+    "form.bulk.action.star": "Ajouter aux favoris",
+    "form.bulk.action.unstar": "Retirer des favoris",
+    "form.bulk.action.move": "Déplacer",
+    "form.least_read_feeds.label.months": "Rien lu depuis",
+    "form.least_read_feeds.months": [
+        "%d mois",
+        "%d mois"
+    ],
     "time_elapsed.not_yet": "pas encore",
     "time_elapsed.yesterday": "hier",
     "time_elapsed.now": "à l'instant",
@@ -1113,6 +2156,13 @@ var translations = map[string]string{
         "il y a %d an",
         "il y a %d ans"
     ],
+    "time_format.absolute": "02/01/2006 15:04",
+    "email.verification.subject": "Vérifiez votre adresse e-mail",
+    "email.verification.body": "Bonjour %s,\n\nOuvrez ce lien pour vérifier votre adresse e-mail et activer votre compte Miniflux :\n%s\n\nLe lien expire dans 24 heures.",
+    "email.invitation.subject": "Invitation à Miniflux",
+    "email.invitation.body": "%s vous invite à créer un compte sur Miniflux :\n%s\n\nL'invitation expire dans 7 jours.",
+    "email.password_reset.subject": "Réinitialisez votre mot de passe",
+    "email.password_reset.body": "Bonjour %s,\n\nOuvrez ce lien pour choisir un nouveau mot de passe pour votre compte Miniflux :\n%s\n\nLe lien expire dans une heure. Vous pouvez ignorer cet e-mail si vous ne l'avez pas demandé.",
     "This feed already exists (%s)": "Cet abonnement existe déjà (%s)",
     "Unable to fetch feed (Status Code = %d)": "Impossible de récupérer cet abonnement (code=%d)",
     "Unable to open this link: %v": "Impossible d'ouvrir ce lien : %v",
@@ -1123,6 +2173,9 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Impossible de lire ce flux Atom : %q",
     "Unable to parse JSON feed: %q": "Impossible de lire ce flux JSON : %q",
     "Unable to parse RDF feed: %q": "Impossible de lire ce flux RDF : %q",
+    "Unable to parse feed: %q": "Impossible de lire ce flux : %q",
+    "Unable to parse web page: %q": "Impossible de lire cette page web : %q",
+    "The selector %q does not match any element of this web page": "Le sélecteur %q ne correspond à aucun élément de cette page web",
     "Unable to normalize encoding: %q": "Impossible de normaliser l'encodage : %q",
     "This feed is empty": "Cet abonnement est vide",
     "This web page is empty": "Cette page web est vide",
@@ -1130,6 +2183,9 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Ce site web est temporairement injoignable (erreur originale : %q)",
     "This website is permanently unreachable (original error: %q)": "Ce site web n'est pas joignable de façon permanente (erreur originale : %q)",
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
+    "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)": "Le certificat de cette capsule Gemini a changé depuis la première visite (empreinte %s)",
+    "A Tor proxy is required to fetch the .onion websites": "Un proxy Tor est nécessaire pour récupérer les sites web .onion",
+    "The local path %q is not in the allowed directories": "Le chemin local %q n'est pas dans les répertoires autorisés",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
     "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
@@ -1151,6 +2207,16 @@ var translations = map[string]string{
     "action.download": "Scarica",
     "action.import": "Importa",
     "action.login": "Accedi",
+    "action.signup": "Registrati",
+    "action.send_reset_link": "Invia il link",
+    "action.delete_account": "Elimina il mio account",
+    "action.invite": "Invita",
+    "action.show": "Mostra",
+    "action.mute_for_a_month": "Silenzia per un mese",
+    "action.unsubscribe": "Annulla l'abbonamento",
+    "action.share": "Condividi",
+    "action.approve": "Approva",
+    "action.reject": "Rifiuta",
     "tooltip.keyboard_shortcuts": "Scorciatoia da tastiera: %s",
     "tooltip.logged_user": "Autenticato come %s",
     "menu.unread": "Da leggere",
@@ -1163,7 +2229,10 @@ var translations = map[string]string{
     "menu.preferences": "Preferenze",
     "menu.integrations": "Integrazioni",
     "menu.sessions": "Sessioni",
+    "menu.delete_account": "Elimina l'account",
     "menu.users": "Utenti",
+    "menu.invitations": "Inviti",
+    "menu.statistics": "Statistiche",
     "menu.about": "Informazioni",
     "menu.export": "Esporta",
     "menu.import": "Importa",
@@ -1171,15 +2240,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Segna questa pagina come letta",
     "menu.mark_all_as_read": "Segna tutti gli articoli come letti",
     "menu.mark_all_as_read_wip": "Operazione in corso...",
+    "menu.undo_mark_all_as_read": "Annulla segna tutti come letti",
     "menu.show_all_entries": "Mostra tutte le voci",
     "menu.show_only_unread_entries": "Mostra solo voci non lette",
     "menu.refresh_feed": "Aggiorna",
     "menu.refresh_all_feeds": "Aggiorna tutti i feed in background",
     "menu.edit_feed": "Modifica",
     "menu.edit_category": "Modifica",
+    "menu.share_category": "Condividi",
     "menu.add_feed": "Aggiungi feed",
     "menu.add_user": "Aggiungi utente",
     "menu.flush_history": "Svuota la cronologia",
+    "menu.recently_read": "Letti di recente",
+    "menu.junk": "Spazzatura",
+    "menu.export_epub": "Scarica in formato EPUB",
+    "menu.export_printable": "Versione stampabile",
+    "menu.least_read_feeds": "Feed meno letti",
+    "menu.pending_feeds": "Abbonamenti in attesa",
     "search.label": "Cerca",
     "search.placeholder": "Cerca...",
     "pagination.next": "Successivo",
@@ -1190,16 +2267,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Aggiungi ai preferiti",
     "entry.bookmark.toggle.off": "Rimuovi dai preferiti",
     "entry.state.saving": "Salvataggio in corso...",
+    "entry.state.sending": "Invio in corso...",
     "entry.state.loading": "Caricamento in corso...",
     "entry.save.label": "Salva",
     "entry.save.title": "Salva questo articolo",
     "entry.save.completed": "Fatto!",
+    "entry.kindle.label": "Invia a Kindle",
+    "entry.kindle.title": "Invia questo articolo al mio Kindle",
+    "entry.kindle.completed": "Inviato!",
     "entry.scraper.label": "Scarica il contenuto integrale",
     "entry.scraper.title": "Scarica il contenuto integrale",
     "entry.scraper.completed": "Fatto!",
+    "entry.summarize.label": "Riassumi",
+    "entry.summarize.title": "Genera un riassunto di questo articolo",
+    "entry.summarize.completed": "Fatto!",
     "entry.original.label": "Contenuto originale",
     "entry.comments.label": "Commenti",
+    "entry.snapshot.title": "Leggi la copia salvata della pagina web",
+    "entry.snapshot.label": "Copia",
     "entry.comments.title": "Mostra i commenti",
+    "entry.summary.read_more": "Continua a leggere",
+    "entry.watch.title": "Modifiche su %s (%s)",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
     "page.categories.title": "Categorie",
@@ -1208,17 +2296,37 @@ var translations = map[string]string{
         "C'è %d feed.",
         "Ci sono %d feed."
     ],
+    "page.categories.shared_with_me": "Condivise con me",
     "page.new_category.title": "Nuova categoria",
     "page.new_user.title": "Nuovo utente",
     "page.edit_category.title": "Modifica categoria: %s",
+    "page.category_shares.title": "Condividi categoria: %s",
+    "page.category_shares.since": "Condivisa da",
+    "page.shared_category_entries.shared_by": "Condivisa da %s",
     "page.edit_user.title": "Modifica utente: %s",
     "page.feeds.title": "Feed",
     "page.feeds.last_check": "Ultimo controllo:",
+    "page.feeds.muted_until": "Silenziato fino al:",
+    "page.feeds.pending": "In attesa di approvazione",
     "page.feeds.error_count": [
         "%d errore",
         "%d errori"
     ],
     "page.history.title": "Cronologia",
+    "page.recently_read.title": "Letti di recente",
+    "page.junk.title": "Spazzatura",
+    "page.junk.help": "Articoli con un punteggio di spazzatura di almeno %d.",
+    "page.junk.score": "Punteggio di spazzatura: %d",
+    "page.recently_read.read_at": "Letto",
+    "page.least_read_feeds.title": "Feed meno letti",
+    "page.least_read_feeds.last_read": "Ultima lettura:",
+    "page.least_read_feeds.never_read": "Mai letto",
+    "page.least_read_feeds.unread_count": [
+        "%d articolo non letto",
+        "%d articoli non letti"
+    ],
+    "page.pending_feeds.title": "Abbonamenti in attesa",
+    "page.pending_feeds.requested_by": "Richiesto da %s",
     "page.import.title": "Importa",
     "page.search.title": "Risultati della ricerca",
     "page.about.title": "Informazioni",
@@ -1232,6 +2340,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Abbonati al feed",
     "page.add_feed.legend.advanced_options": "Opzioni avanzate",
+    "page.add_feed.watch_selector_help": "Compila questo campo per monitorare una pagina web senza feed: viene creato un articolo quando il testo degli elementi corrispondenti cambia.",
     "page.add_feed.choose_feed": "Scegli un feed",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
@@ -1274,11 +2383,39 @@ var translations = map[string]string{
     "page.users.actions": "Azioni",
     "page.users.last_login": "Ultimo accesso",
     "page.users.is_admin": "Amministratore",
+    "page.users.not_verified": "Email non verificata",
+    "page.users.pending": "In attesa di approvazione",
+    "page.invitations.title": "Inviti",
+    "page.invitations.expires": "Scade",
+    "page.invitations.status": "Stato",
+    "page.invitations.used": "Usato",
+    "page.invitations.unused": "Non usato",
+    "page.statistics.title": "Statistiche",
+    "page.statistics.users": "Utenti",
+    "page.statistics.feeds": "Feed",
+    "page.statistics.entries": "Articoli (stima)",
+    "page.statistics.database_size": "Dimensione del database",
+    "page.statistics.fetches_last_hour": "Aggiornamenti nell'ultima ora",
+    "page.statistics.fetches_last_day": "Aggiornamenti nelle ultime 24 ore",
+    "page.statistics.fetch_success_rate": "Aggiornamenti riusciti",
+    "page.statistics.shared_feeds": "Feed seguiti da più utenti",
+    "page.statistics.feed_url": "URL del feed",
+    "page.statistics.subscribers": "Iscritti",
+    "page.statistics.error_domains": "Domini con più errori",
+    "page.statistics.domain": "Dominio",
+    "page.statistics.error_feeds": "Feed in errore",
     "page.settings.title": "Impostazioni",
     "page.settings.link_google_account": "Collega il mio account Google",
     "page.settings.unlink_google_account": "Scollega il mio account Google",
     "page.login.title": "Accedi",
     "page.login.google_signin": "Accedi tramite Google",
+    "page.login.no_account": "Non hai un account?",
+    "page.signup.title": "Registrazione",
+    "page.login.forgot_password": "Hai dimenticato la password?",
+    "page.forgot_password.title": "Password dimenticata",
+    "page.reset_password.title": "Reimposta la password",
+    "page.delete_account.title": "Elimina l'account",
+    "page.delete_account.description": "I tuoi feed, articoli, categorie e integrazioni saranno rimossi definitivamente. Verrai disconnesso subito e questa azione non può essere annullata.",
     "page.integrations.title": "Integrazioni",
     "page.integration.miniflux_api": "API di Miniflux",
     "page.integration.miniflux_api_endpoint": "Endpoint dell'API di Miniflux",
@@ -1286,9 +2423,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Password",
     "page.integration.miniflux_api_password_value": "La password del tuo account",
     "page.integration.bookmarklet": "Segnalibro",
+    "page.integration.browser_extension": "Estensione del browser",
+    "page.integration.browser_extension_endpoint": "Endpoint dell'estensione",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "Nessun token generato",
+    "page.integration.browser_extension_generate_token": "Genera un nuovo token",
     "page.integration.bookmarklet.name": "Aggiungi a Miniflux",
     "page.integration.bookmarklet.instructions": "Trascina questo collegamento sui tuoi segnalibri.",
     "page.integration.bookmarklet.help": "Questo collegamento speciale ti consente di abbonarti ad un sito web semplicemente usando un segnalibro del tuo browser.",
+    "page.entry_snapshot.taken": "Salvata",
+    "page.entry_snapshot.refresh": "Salva una nuova copia",
+    "page.entry_snapshot.last_error": "L'ultimo tentativo di copia non è riuscito:",
+    "page.entry_snapshot.empty": "Non esiste ancora una copia salvata di questa pagina web.",
     "page.sessions.title": "Sessioni",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Indirizzo IP",
@@ -1298,29 +2444,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Nessun preferito disponibile.",
     "alert.no_category": "Nessuna categoria disponibile.",
     "alert.no_category_entry": "Questa categoria non contiene alcun articolo.",
+    "alert.no_category_share": "Questa categoria non è condivisa con nessuno.",
     "alert.no_feed_entry": "Questo feed non contiene alcun articolo.",
     "alert.no_feed": "Nessun feed disponibile.",
     "alert.no_history": "La tua cronologia al momento è vuota.",
+    "alert.no_junk": "Non ci sono articoli spazzatura.",
+    "alert.no_least_read_feed": "Hai letto articoli di tutti i tuoi abbonamenti in questo periodo.",
+    "alert.no_pending_feed": "Nessun abbonamento è in attesa di approvazione.",
+    "alert.feed_pending": "L'abbonamento è stato salvato, i suoi articoli saranno scaricati quando un amministratore lo approverà.",
+    "alert.no_invitation": "Non ci sono inviti.",
+    "alert.no_shared_feed": "Nessun feed è seguito da più utenti.",
+    "alert.no_error_domain": "Nessun dominio ha feed in errore.",
+    "alert.signup_verification_sent": "Un link di verifica è stato inviato a %s.",
+    "alert.account_verified": "Il tuo indirizzo email è verificato, ora puoi accedere.",
+    "alert.account_verified_pending": "Il tuo indirizzo email è verificato, il tuo account sarà attivato quando un amministratore lo approverà.",
+    "alert.invitation_created": "L'invito è stato creato, condividi questo link: %s",
+    "alert.password_reset_sent": "Se un account usa %s, è stato inviato un link per scegliere una nuova password.",
+    "alert.password_reset": "La tua password è stata modificata, ora puoi accedere.",
+    "alert.account_deletion_requested": "Il tuo account è stato chiuso, i tuoi dati saranno rimossi a breve.",
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
+    "alert.read_only": "Questa istanza è di sola lettura, puoi navigarla ma le tue modifiche non vengono salvate.",
+    "alert.write_freeze": "Le modifiche al tuo account sono disabilitate fino al %s per manutenzione.",
     "alert.no_user": "Tu sei l'unico utente.",
     "alert.account_unlinked": "Il tuo account esterno ora è scollegato!",
     "alert.account_linked": "Il tuo account esterno ora è collegato!",
     "alert.pocket_linked": "Il tuo account Pocket ora è collegato!",
     "alert.prefs_saved": "Preferenze salvate!",
+    "alert.extension_token_generated": "È stato generato un nuovo token, quello precedente non funziona più.",
+    "alert.entry_snapshot_saved": "È stata salvata una nuova copia della pagina web.",
     "error.unlink_account_without_password": "Devi scegliere una password altrimenti la prossima volta non riuscirai ad accedere.",
     "error.duplicate_linked_account": "Esiste già un account configurato per questo servizio!",
     "error.duplicate_fever_username": "Esiste già un account Fever con lo stesso nome utente!",
     "error.pocket_request_token": "Non sono riuscito ad ottenere il request token da Pocket!",
     "error.pocket_access_token": "Non sono riuscito ad ottenere l'access token da Pocket!",
+    "error.entry_snapshot_failed": "Impossibile scaricare la pagina web, la copia precedente è stata mantenuta.",
     "error.category_already_exists": "Questa categoria esiste già.",
     "error.unable_to_create_category": "Non sono riuscito ad aggiungere questa categoria.",
     "error.unable_to_update_category": "Non sono riuscito ad aggiornare questa categoria.",
+    "error.category_changed": "Questa categoria è stata modificata nel frattempo, invia di nuovo il modulo per sovrascrivere le modifiche.",
+    "error.read_only": "Questa istanza è di sola lettura, le modifiche non sono consentite.",
+    "error.write_freeze": "Le modifiche al tuo account sono temporaneamente disabilitate.",
+    "error.category_share_user_not_found": "Questo utente non esiste.",
+    "error.invalid_email": "Questo indirizzo email non è valido.",
+    "error.email_domain_not_allowed": "Questo dominio email non è consentito.",
+    "error.email_already_exists": "Questo indirizzo email è già in uso.",
+    "error.invalid_invitation": "Questo codice di invito non è valido o è scaduto.",
+    "error.unable_to_send_email": "Impossibile inviare l'email, riprova più tardi.",
+    "error.invalid_verification_link": "Questo link di verifica non è valido o è scaduto.",
+    "error.user_not_verified": "Il tuo indirizzo email non è ancora verificato, apri il link inviato per email.",
+    "error.user_pending": "Il tuo account è in attesa dell'approvazione di un amministratore.",
+    "error.invalid_password_reset_link": "Questo link per reimpostare la password non è valido o è scaduto.",
+    "error.account_deletion_admin": "Un account amministratore deve essere rimosso da un altro amministratore.",
+    "error.invalid_password": "La password non è corretta.",
     "error.user_already_exists": "Questo utente esiste già.",
     "error.unable_to_create_user": "Non sono riuscito ad aggiungere questo user.",
     "error.unable_to_update_user": "Non sono riuscito ad aggiornare questo utente.",
     "error.unable_to_update_feed": "Non sono riuscito ad aggiornare questo feed.",
+    "error.feed_changed": "Questo feed è stato modificato nel frattempo, invia di nuovo il modulo per sovrascrivere le modifiche.",
     "error.subscription_not_found": "Non ho trovato nessun feed.",
     "error.empty_file": "Questo file è vuoto.",
     "error.bad_credentials": "Nome utente o password non validi.",
@@ -1329,27 +2511,107 @@ var translations = map[string]string{
     "error.different_passwords": "Le password non coincidono.",
     "error.password_min_length": "La password deve contenere almeno 6 caratteri.",
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
+    "error.entries_per_page_invalid": "Il numero di articoli per pagina deve essere compreso tra 1 e %d.",
+    "error.quiet_hours_invalid": "L'inizio e la fine delle ore di silenzio devono essere entrambi nel formato HH:MM, oppure entrambi vuoti.",
+    "error.integration_filters_invalid": "Gli eventi selezionati per le integrazioni non sono validi.",
+    "error.kindle_email_invalid": "L'indirizzo email Kindle non è valido.",
+    "error.bulk_empty_selection": "Nessun elemento è stato selezionato.",
+    "error.category_not_found": "Questa categoria non esiste o non appartiene a questo utente.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.feed_invalid_script": "Script non valido: %v.",
+    "error.feed_script_not_allowed": "Solo gli amministratori possono modificare lo script di un feed.",
+    "error.feed_invalid_entry_open_mode": "Modalità di apertura degli articoli non valida.",
+    "error.feed_invalid_priority": "Priorità non valida.",
+    "error.feed_invalid_entry_limit": "Il limite di articoli non è valido.",
+    "error.feed_invalid_scraper_max_pages": "Il numero di pagine deve essere compreso tra 1 e %d.",
+    "error.feed_invalid_summary_words": "Il numero di parole deve essere compreso tra 0 e %d.",
+    "error.feed_invalid_entry_matching": "Riconoscimento degli articoli non valido.",
+    "error.feed_invalid_muted_until": "La data di silenziamento deve essere nel formato AAAA-MM-GG.",
+    "error.mark_read_after_days_invalid": "Il numero di giorni prima di segnare gli articoli come letti non può essere negativo.",
+    "error.image_display_invalid": "Modalità di visualizzazione delle immagini non valida.",
+    "error.nsfw_display_invalid": "Modalità di visualizzazione NSFW non valida.",
+    "error.junk_threshold_invalid": "La soglia di spazzatura deve essere compresa tra 0 e %d.",
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
     "form.feed.label.title": "Titolo",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Scarica il contenuto integrale",
+    "form.feed.label.nsfw": "Articoli non adatti al lavoro (NSFW)",
+    "form.feed.label.summarize_entries": "Genera un riassunto dei nuovi articoli",
+    "form.feed.label.entry_open_mode": "Apri gli articoli con",
+    "form.feed.label.priority": "Priorità nella lista dei non letti",
+    "form.feed.label.muted_until": "Silenzia fino al",
+    "form.feed.help.muted_until": "I nuovi articoli vengono comunque scaricati ma sono segnati come letti ed esclusi dai contatori dei non letti fino a quel giorno. Lascia vuoto per riattivare il feed.",
+    "form.feed.label.mark_read_after_days": "Segna gli articoli non letti come letti dopo (giorni)",
+    "form.feed.help.mark_read_after_days": "Usa 0 per seguire la regola della categoria.",
+    "form.feed.label.max_entries": "Numero massimo di articoli memorizzati",
+    "form.feed.help.max_entries": "Gli articoli preferiti sono sempre conservati. Usa 0 per conservare tutti gli articoli.",
+    "form.feed.label.overflow_policy": "Articoli oltre il limite",
+    "form.feed.select.overflow_archive": "Archivia gli articoli più vecchi",
+    "form.feed.select.overflow_delete": "Elimina gli articoli più vecchi",
+    "form.feed.label.entry_matching": "Riconosci gli articoli ripubblicati",
+    "form.feed.help.entry_matching": "Usa questa opzione quando il feed cambia l'identificativo dei suoi articoli e crea duplicati.",
+    "form.feed.help.image_display": "Per impostazione predefinita viene usata l'impostazione della categoria, poi quella delle tue preferenze.",
+    "form.feed.label.summary_words": "Mantieni solo le prime parole degli articoli",
+    "form.feed.help.summary_words": "I nuovi articoli vengono troncati a questo numero di parole, seguiti da un link al sito web. Usa 0 per mantenere il contenuto completo.",
+    "form.feed.select.entry_matching_guid": "Solo per identificativo",
+    "form.feed.select.entry_matching_url": "Per identificativo o URL",
+    "form.feed.select.entry_matching_title": "Per identificativo o titolo e data",
+    "form.feed.label.watch_selector": "Area monitorata (selettore CSS)",
+    "form.feed.select.open_content": "Contenuto del feed",
+    "form.feed.select.open_full_content": "Contenuto completo del sito web",
+    "form.feed.select.open_original": "Sito web originale",
+    "form.feed.select.priority_low": "Bassa",
+    "form.feed.select.priority_normal": "Normale",
+    "form.feed.select.priority_high": "Alta",
     "form.feed.label.feed_username": "Nome utente del feed",
     "form.feed.label.feed_password": "Password del feed",
     "form.feed.label.user_agent": "Usa user agent personalizzato",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
+    "form.feed.label.scraper_max_pages": "Numero massimo di pagine per articolo",
+    "form.feed.help.scraper_max_pages": "Quando viene scaricato il contenuto originale, le pagine successive degli articoli divisi in più pagine vengono aggiunte. Usa 1 per scaricare solo la prima pagina.",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.script": "Script",
     "form.category.label.title": "Titolo",
+    "form.category.label.mark_read_after_days": "Segna gli articoli non letti come letti dopo (giorni)",
+    "form.category.help.mark_read_after_days": "Si applica ai feed di questa categoria senza una regola propria. Usa 0 per lasciare gli articoli non letti.",
+    "form.category.help.image_display": "Si applica ai feed di questa categoria senza un'impostazione propria. Per impostazione predefinita viene usata quella delle tue preferenze.",
+    "form.category.label.nsfw": "I feed di questa categoria non sono adatti al lavoro (NSFW)",
+    "form.category_share.label.username": "Nome utente",
+    "form.category_share.help.username": "Questo utente può leggere gli articoli della categoria ma non può modificarli.",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
     "form.user.label.admin": "Amministratore",
+    "form.user.label.email": "Email",
+    "form.user.label.invitation": "Codice di invito",
+    "form.invitation.help.email": "Facoltativo, il link di invito viene inviato a questo indirizzo e solo questo indirizzo può usarlo.",
+    "form.password_reset.help.email": "L'indirizzo email definito nelle impostazioni del tuo account.",
+    "form.delete_account.help.password": "Inserisci la password per confermare.",
     "form.prefs.label.language": "Lingua",
     "form.prefs.label.timezone": "Fuso orario",
     "form.prefs.label.theme": "Tema",
     "form.prefs.label.entry_sorting": "Ordinamento articoli",
+    "form.prefs.label.entries_per_page": "Articoli per pagina",
+    "form.prefs.label.show_read_entries": "Mostra gli articoli letti nelle pagine dei feed e delle categorie",
+    "form.prefs.label.show_absolute_time": "Mostra le date invece del tempo trascorso",
+    "form.prefs.label.image_display": "Immagini negli articoli",
+    "form.prefs.label.nsfw_display": "Articoli non adatti al lavoro (NSFW)",
+    "form.prefs.help.nsfw_display": "Gli articoli sono segnalati dal loro feed, dalla loro categoria, dalla loro classificazione o da un contrassegno NSFW nel titolo.",
+    "form.prefs.label.junk_threshold": "Segna come letti gli articoli con un punteggio di spazzatura di almeno",
+    "form.prefs.help.junk_threshold": "Il punteggio di spazzatura, da 0 a 100, valuta i titoli clickbait, gli articoli quasi vuoti e gli elenchi di link. Usa 0 per disattivare.",
+    "form.prefs.select.image_display_show": "Mostra",
+    "form.prefs.select.image_display_blur": "Sfoca fino al clic",
+    "form.prefs.select.image_display_hide": "Nascondi e mostra invece dei link",
+    "form.prefs.select.nsfw_display_show": "Mostra",
+    "form.prefs.select.nsfw_display_blur": "Sfoca fino al clic",
+    "form.prefs.select.nsfw_display_hide": "Nascondi",
+    "form.prefs.select.image_display_inherit": "Predefinito",
+    "form.prefs.label.quiet_hours_start": "Inizio delle ore di silenzio",
+    "form.prefs.label.quiet_hours_end": "Fine delle ore di silenzio",
+    "form.prefs.help.quiet_hours": "Le notifiche vengono trattenute durante le ore di silenzio e inviate insieme al loro termine.",
+    "form.prefs.help.email": "Usato per inviarti un link quando dimentichi la password.",
     "form.prefs.select.older_first": "Prima i più recenti",
     "form.prefs.select.recent_first": "Prima i più vecchi",
     "form.import.label.file": "File OPML",
@@ -1377,8 +2639,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Salva gli articoli su Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint dell'API di Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "API key dell'account Nunux Keeper",
+    "form.integration.readeck_activate": "Salva gli articoli su Readeck",
+    "form.integration.readeck_endpoint": "URL di Readeck",
+    "form.integration.readeck_api_key": "Token API di Readeck",
+    "form.integration.readeck_labels": "Etichette di Readeck (facoltative, separate da virgole)",
+    "form.integration.omnivore_activate": "Salva gli articoli su Omnivore",
+    "form.integration.omnivore_endpoint": "Endpoint dell'API di Omnivore (facoltativo)",
+    "form.integration.omnivore_api_key": "Chiave API di Omnivore",
+    "form.integration.ntfy_activate": "Invia le notifiche a ntfy",
+    "form.integration.ntfy_url": "URL del server ntfy",
+    "form.integration.ntfy_topic": "Argomento ntfy",
+    "form.integration.ntfy_token": "Token di accesso ntfy",
+    "form.integration.pushover_activate": "Invia le notifiche a Pushover",
+    "form.integration.pushover_token": "Token dell'applicazione Pushover",
+    "form.integration.pushover_user": "Chiave utente Pushover",
+    "form.integration.apprise_activate": "Invia le notifiche a Apprise",
+    "form.integration.apprise_url": "Endpoint di notifica dell'API Apprise",
+    "form.integration.apprise_service_urls": "URL dei servizi Apprise (facoltativo, separati da virgole)",
+    "form.integration.kindle_activate": "Invia articoli al mio Kindle",
+    "form.integration.kindle_email": "Indirizzo email Send to Kindle",
+    "form.integration.kindle_help": "Aggiungi %s agli indirizzi email approvati del tuo account Amazon.",
+    "form.integration.events": "Eventi inviati a questo servizio:",
+    "form.integration.event_starred": "Articoli preferiti",
+    "form.integration.event_new_entries": "Nuovi articoli",
+    "form.integration.event_feed_error": "Errori dei feed",
+    "form.integration.event_categories": "Solo i nuovi articoli di queste categorie (tutte se nessuna è selezionata)",
+    "notification.feed_error": "Impossibile aggiornare il feed %s",
+    "notification.new_entries": [
+        "%d nuovo articolo",
+        "%d nuovi articoli"
+    ],
+    "notification.quiet_hours": [
+        "%d notifica durante le ore di silenzio",
+        "%d notifiche durante le ore di silenzio"
+    ],
     "form.submit.loading": "Caricamento in corso...",
     "form.submit.saving": "Salvataggio in corso...",
+    "form.bulk.selected_entries": "Articoli selezionati:",
+    "form.bulk.select_entry": "Seleziona questo articolo",
+    "form.bulk.select_feed": "Seleziona questo feed",
+    "form.bulk.move_feeds": "Sposta i feed selezionati in:",
+    "form.bulk.action.read": "Segna come letto",
+    "form.bulk.action.unread": "Segna come non letto",
+    "form.bulk.action.star": "Aggiungi ai preferiti",
+    "form.bulk.action.unstar": "Rimuovi dai preferiti",
+    "form.bulk.action.move": "Sposta",
+    "form.least_read_feeds.label.months": "Niente letto da",
+    "form.least_read_feeds.months": [
+        "%d mese",
+        "%d mesi"
+    ],
     "time_elapsed.not_yet": "non ancora",
     "time_elapsed.yesterday": "ieri",
     "time_elapsed.now": "adesso",
@@ -1405,7 +2715,14 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d anno fa",
         "%d anni fa"
-    ]
+    ],
+    "time_format.absolute": "02/01/2006 15:04",
+    "email.verification.subject": "Verifica il tuo indirizzo email",
+    "email.verification.body": "Ciao %s,\n\nApri questo link per verificare il tuo indirizzo email e attivare il tuo account Miniflux:\n%s\n\nIl link scade tra 24 ore.",
+    "email.invitation.subject": "Invito a Miniflux",
+    "email.invitation.body": "%s ti invita a creare un account su Miniflux:\n%s\n\nL'invito scade tra 7 giorni.",
+    "email.password_reset.subject": "Reimposta la tua password",
+    "email.password_reset.body": "Ciao %s,\n\nApri questo link per scegliere una nuova password per il tuo account Miniflux:\n%s\n\nIl link scade tra un'ora. Puoi ignorare questa email se non l'hai richiesta."
 }
 `,
 	"nl_NL": `{
@@ -1424,6 +2741,16 @@ var translations = map[string]string{
     "action.download": "Download",
     "action.import": "Importeren",
     "action.login": "Inloggen",
+    "action.signup": "Registreren",
+    "action.send_reset_link": "Link versturen",
+    "action.delete_account": "Mijn account verwijderen",
+    "action.invite": "Uitnodigen",
+    "action.show": "Tonen",
+    "action.mute_for_a_month": "Een maand dempen",
+    "action.unsubscribe": "Uitschrijven",
+    "action.share": "Delen",
+    "action.approve": "Goedkeuren",
+    "action.reject": "Afwijzen",
     "tooltip.keyboard_shortcuts": "Sneltoets: %s",
     "tooltip.logged_user": "Ingelogd als %s",
     "menu.unread": "Ongelezen",
@@ -1436,7 +2763,10 @@ var translations = map[string]string{
     "menu.preferences": "Voorkeuren",
     "menu.integrations": "Integraties",
     "menu.sessions": "Sessies",
+    "menu.delete_account": "Account verwijderen",
     "menu.users": "Users",
+    "menu.invitations": "Uitnodigingen",
+    "menu.statistics": "Statistieken",
     "menu.about": "Over",
     "menu.export": "Exporteren",
     "menu.import": "Importeren",
@@ -1444,15 +2774,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Markeer deze pagina als gelezen",
     "menu.mark_all_as_read": "Markeer alle items als gelezen",
     "menu.mark_all_as_read_wip": "Bezig...",
+    "menu.undo_mark_all_as_read": "Alles als gelezen markeren ongedaan maken",
     "menu.show_all_entries": "Toon alle artikelen",
     "menu.show_only_unread_entries": "Toon alleen ongelezen artikelen",
     "menu.refresh_feed": "Vernieuwen",
     "menu.refresh_all_feeds": "Vernieuw alle feeds in de achtergrond",
     "menu.edit_feed": "Bewerken",
     "menu.edit_category": "Bewerken",
+    "menu.share_category": "Delen",
     "menu.add_feed": "Feed toevoegen",
     "menu.add_user": "Gebruiker toevoegen",
     "menu.flush_history": "Verwijder geschiedenis",
+    "menu.recently_read": "Onlangs gelezen",
+    "menu.junk": "Rommel",
+    "menu.export_epub": "Downloaden als EPUB",
+    "menu.export_printable": "Afdrukversie",
+    "menu.least_read_feeds": "Minst gelezen feeds",
+    "menu.pending_feeds": "Openstaande abonnementen",
     "search.label": "Zoeken",
     "search.placeholder": "Zoeken...",
     "pagination.next": "Volgende",
@@ -1463,16 +2801,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Ster toevoegen",
     "entry.bookmark.toggle.off": "Ster weghalen",
     "entry.state.saving": "Opslaag...",
+    "entry.state.sending": "Verzenden...",
     "entry.state.loading": "Laden...",
     "entry.save.label": "Opslaan",
     "entry.save.title": "Artikel opslaan",
     "entry.save.completed": "Done!",
+    "entry.kindle.label": "Naar Kindle sturen",
+    "entry.kindle.title": "Dit artikel naar mijn Kindle sturen",
+    "entry.kindle.completed": "Verzonden!",
     "entry.scraper.label": "Fetch original content",
     "entry.scraper.title": "Fetch original content",
     "entry.scraper.completed": "Klaar!",
+    "entry.summarize.label": "Samenvatten",
+    "entry.summarize.title": "Een samenvatting van dit artikel genereren",
+    "entry.summarize.completed": "Klaar!",
     "entry.original.label": "Origineel",
     "entry.comments.label": "Comments",
+    "entry.snapshot.title": "De opgeslagen kopie van de webpagina lezen",
+    "entry.snapshot.label": "Kopie",
     "entry.comments.title": "Bekijk de reacties",
+    "entry.summary.read_more": "Lees verder",
+    "entry.watch.title": "Wijzigingen op %s (%s)",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
     "page.categories.title": "Categorieën",
@@ -1481,17 +2830,37 @@ var translations = map[string]string{
         "Er is %d feed.",
         "Er zijn %d feeds."
     ],
+    "page.categories.shared_with_me": "Met mij gedeeld",
     "page.new_category.title": "Nieuwe categorie",
     "page.new_user.title": "Nieuwe gebruiker",
     "page.edit_category.title": "Bewerken van categorie: %s",
+    "page.category_shares.title": "Categorie delen: %s",
+    "page.category_shares.since": "Gedeeld sinds",
+    "page.shared_category_entries.shared_by": "Gedeeld door %s",
     "page.edit_user.title": "Bewerk gebruiker: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Laatste update:",
+    "page.feeds.muted_until": "Gedempt tot:",
+    "page.feeds.pending": "Wacht op goedkeuring",
     "page.feeds.error_count": [
         "%d error",
         "%d errors"
     ],
     "page.history.title": "Geschiedenis",
+    "page.recently_read.title": "Onlangs gelezen",
+    "page.junk.title": "Rommel",
+    "page.junk.help": "Artikelen met een rommelscore van minstens %d.",
+    "page.junk.score": "Rommelscore: %d",
+    "page.recently_read.read_at": "Gelezen",
+    "page.least_read_feeds.title": "Minst gelezen feeds",
+    "page.least_read_feeds.last_read": "Laatst gelezen:",
+    "page.least_read_feeds.never_read": "Nooit gelezen",
+    "page.least_read_feeds.unread_count": [
+        "%d ongelezen artikel",
+        "%d ongelezen artikelen"
+    ],
+    "page.pending_feeds.title": "Openstaande abonnementen",
+    "page.pending_feeds.requested_by": "Aangevraagd door %s",
     "page.import.title": "Importeren",
     "page.login.title": "Inloggen",
     "page.search.title": "Zoekresultaten",
@@ -1506,6 +2875,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Feed zoeken",
     "page.add_feed.legend.advanced_options": "Geavanceerde mogelijkheden",
+    "page.add_feed.watch_selector_help": "Vul dit veld in om een webpagina zonder feed te volgen: er wordt een artikel aangemaakt wanneer de tekst van de overeenkomende elementen verandert.",
     "page.add_feed.choose_feed": "Feed kiezen",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
@@ -1548,10 +2918,38 @@ var translations = map[string]string{
     "page.users.actions": "Acties",
     "page.users.last_login": "Laatste login",
     "page.users.is_admin": "Administrator",
+    "page.users.not_verified": "E-mail niet geverifieerd",
+    "page.users.pending": "Wacht op goedkeuring",
+    "page.invitations.title": "Uitnodigingen",
+    "page.invitations.expires": "Verloopt",
+    "page.invitations.status": "Status",
+    "page.invitations.used": "Gebruikt",
+    "page.invitations.unused": "Niet gebruikt",
+    "page.statistics.title": "Statistieken",
+    "page.statistics.users": "Gebruikers",
+    "page.statistics.feeds": "Feeds",
+    "page.statistics.entries": "Artikelen (geschat)",
+    "page.statistics.database_size": "Databasegrootte",
+    "page.statistics.fetches_last_hour": "Vernieuwingen in het afgelopen uur",
+    "page.statistics.fetches_last_day": "Vernieuwingen in de afgelopen 24 uur",
+    "page.statistics.fetch_success_rate": "Geslaagde vernieuwingen",
+    "page.statistics.shared_feeds": "Feeds gevolgd door meerdere gebruikers",
+    "page.statistics.feed_url": "Feed-URL",
+    "page.statistics.subscribers": "Abonnees",
+    "page.statistics.error_domains": "Domeinen met de meeste fouten",
+    "page.statistics.domain": "Domein",
+    "page.statistics.error_feeds": "Feeds met fouten",
     "page.settings.title": "Instellingen",
     "page.settings.link_google_account": "Koppel mijn Google-account",
     "page.settings.unlink_google_account": "Ontkoppel mijn Google-account",
     "page.login.google_signin": "Inloggen via Google",
+    "page.login.no_account": "Nog geen account?",
+    "page.signup.title": "Registreren",
+    "page.login.forgot_password": "Wachtwoord vergeten?",
+    "page.forgot_password.title": "Wachtwoord vergeten",
+    "page.reset_password.title": "Wachtwoord opnieuw instellen",
+    "page.delete_account.title": "Account verwijderen",
+    "page.delete_account.description": "Uw feeds, artikelen, categorieën en integraties worden definitief verwijderd. U wordt meteen uitgelogd en deze actie kan niet ongedaan worden gemaakt.",
     "page.integrations.title": "Integraties",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API-URL",
@@ -1559,9 +2957,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Wachtwoord",
     "page.integration.miniflux_api_password_value": "Wachtwoord van jouw account",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Browserextensie",
+    "page.integration.browser_extension_endpoint": "Eindpunt van de extensie",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "Geen token aangemaakt",
+    "page.integration.browser_extension_generate_token": "Nieuw token aanmaken",
     "page.integration.bookmarklet.name": "Toevoegen aan Miniflux",
     "page.integration.bookmarklet.instructions": "Sleep deze link naar je bookmarks.",
     "page.integration.bookmarklet.help": "Gebruik deze link als bookmark in je browser om je direct te abboneren op een website.",
+    "page.entry_snapshot.taken": "Opgeslagen",
+    "page.entry_snapshot.refresh": "Nieuwe kopie opslaan",
+    "page.entry_snapshot.last_error": "De laatste kopieerpoging is mislukt:",
+    "page.entry_snapshot.empty": "Er is nog geen opgeslagen kopie van deze webpagina.",
     "page.sessions.title": "Sessies",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP-adres",
@@ -1571,29 +2978,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Er zijn op dit moment geen favorieten.",
     "alert.no_category": "Er zijn geen categorieën.",
     "alert.no_category_entry": "Deze categorie bevat geen feeds.",
+    "alert.no_category_share": "Deze categorie wordt met niemand gedeeld.",
     "alert.no_feed_entry": "Er zijn geen artikelen in deze feed.",
     "alert.no_feed": "Je hebt nog geen feeds geabboneerd staan.",
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
+    "alert.no_junk": "Er zijn geen rommelartikelen.",
+    "alert.no_least_read_feed": "U heeft in deze periode artikelen van al uw abonnementen gelezen.",
+    "alert.no_pending_feed": "Er zijn geen abonnementen die op goedkeuring wachten.",
+    "alert.feed_pending": "Het abonnement is opgeslagen, de artikelen worden gedownload zodra een beheerder het goedkeurt.",
+    "alert.no_invitation": "Er zijn geen uitnodigingen.",
+    "alert.no_shared_feed": "Geen enkele feed wordt door meerdere gebruikers gevolgd.",
+    "alert.no_error_domain": "Geen enkel domein heeft feeds met fouten.",
+    "alert.signup_verification_sent": "Er is een verificatielink naar %s gestuurd.",
+    "alert.account_verified": "Uw e-mailadres is geverifieerd, u kunt nu inloggen.",
+    "alert.account_verified_pending": "Uw e-mailadres is geverifieerd, uw account wordt geactiveerd zodra een beheerder het goedkeurt.",
+    "alert.invitation_created": "De uitnodiging is aangemaakt, deel deze link: %s",
+    "alert.password_reset_sent": "Als een account %s gebruikt, is er een link verstuurd om een nieuw wachtwoord te kiezen.",
+    "alert.password_reset": "Uw wachtwoord is gewijzigd, u kunt nu inloggen.",
+    "alert.account_deletion_requested": "Uw account is gesloten, uw gegevens worden binnenkort verwijderd.",
     "alert.feed_error": "Er is een probleem met deze feed",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
+    "alert.read_only": "Deze instantie is alleen-lezen, u kunt erin bladeren maar uw wijzigingen worden niet opgeslagen.",
+    "alert.write_freeze": "Wijzigingen aan uw account zijn uitgeschakeld tot %s wegens onderhoud.",
     "alert.no_user": "Je bent de enige gebruiker.",
     "alert.account_unlinked": "Uw externe account is nu gedissocieerd!",
     "alert.account_linked": "Uw externe account is nu gekoppeld!",
     "alert.pocket_linked": "Uw Pocket-account is nu gekoppeld!",
     "alert.prefs_saved": "Instellingen opgeslagen!",
+    "alert.extension_token_generated": "Er is een nieuw token aangemaakt, het vorige werkt niet meer.",
+    "alert.entry_snapshot_saved": "Er is een nieuwe kopie van de webpagina opgeslagen.",
     "error.unlink_account_without_password": "U moet een wachtwoord definiëren anders kunt u zich niet opnieuw aanmelden.",
     "error.duplicate_linked_account": "Er is al iemand geregistreerd met deze provider!",
     "error.duplicate_fever_username": "Er is al iemand met dezelfde Fever gebruikersnaam!",
     "error.pocket_request_token": "Kon geen aanvraagtoken ophalen van Pocket!",
     "error.pocket_access_token": "Kon geen toegangstoken ophalen van Pocket!",
+    "error.entry_snapshot_failed": "Kan de webpagina niet downloaden, de vorige kopie is behouden.",
     "error.category_already_exists": "Deze categorie bestaat al.",
     "error.unable_to_create_category": "Kan deze categorie niet maken.",
     "error.unable_to_update_category": "Kon categorie niet updaten.",
+    "error.category_changed": "Deze categorie is ondertussen gewijzigd, verstuur het formulier opnieuw om de wijzigingen te overschrijven.",
+    "error.read_only": "Deze instantie is alleen-lezen, wijzigingen zijn niet toegestaan.",
+    "error.write_freeze": "Wijzigingen aan uw account zijn tijdelijk uitgeschakeld.",
+    "error.category_share_user_not_found": "Deze gebruiker bestaat niet.",
+    "error.invalid_email": "Dit e-mailadres is ongeldig.",
+    "error.email_domain_not_allowed": "Dit e-maildomein is niet toegestaan.",
+    "error.email_already_exists": "Dit e-mailadres is al in gebruik.",
+    "error.invalid_invitation": "Deze uitnodigingscode is ongeldig of verlopen.",
+    "error.unable_to_send_email": "Kan de e-mail niet verzenden, probeer het later opnieuw.",
+    "error.invalid_verification_link": "Deze verificatielink is ongeldig of verlopen.",
+    "error.user_not_verified": "Uw e-mailadres is nog niet geverifieerd, open de link die per e-mail is verstuurd.",
+    "error.user_pending": "Uw account wacht op goedkeuring van een beheerder.",
+    "error.invalid_password_reset_link": "Deze link om het wachtwoord opnieuw in te stellen is ongeldig of verlopen.",
+    "error.account_deletion_admin": "Een beheerdersaccount moet door een andere beheerder worden verwijderd.",
+    "error.invalid_password": "Het wachtwoord is onjuist.",
     "error.user_already_exists": "Deze gebruiker bestaat al.",
     "error.unable_to_create_user": "Kan deze gebruiker niet maken.",
     "error.unable_to_update_user": "Kan deze gebruiker niet updaten.",
     "error.unable_to_update_feed": "Kan deze feed niet bijwerken.",
+    "error.feed_changed": "Deze feed is ondertussen gewijzigd, verstuur het formulier opnieuw om de wijzigingen te overschrijven.",
     "error.subscription_not_found": "Kon geen feeds vinden.",
     "error.empty_file": "Dit bestand is leeg.",
     "error.bad_credentials": "Onjuiste gebruikersnaam of wachtwoord.",
@@ -1602,27 +3045,107 @@ var translations = map[string]string{
     "error.different_passwords": "Wachtwoorden zijn niet hetzelfde.",
     "error.password_min_length": "Je moet minstens 6 tekens gebruiken.",
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
+    "error.entries_per_page_invalid": "Het aantal items per pagina moet tussen 1 en %d liggen.",
+    "error.quiet_hours_invalid": "Begin en einde van de stille uren moeten beide als UU:MM worden ingevuld, of beide leeg zijn.",
+    "error.integration_filters_invalid": "De geselecteerde gebeurtenissen voor de integraties zijn ongeldig.",
+    "error.kindle_email_invalid": "Het Kindle-e-mailadres is ongeldig.",
+    "error.bulk_empty_selection": "Er is geen item geselecteerd.",
+    "error.category_not_found": "Deze categorie bestaat niet of behoort niet tot deze gebruiker.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.feed_invalid_script": "Ongeldig script: %v.",
+    "error.feed_script_not_allowed": "Alleen beheerders kunnen het script van een feed wijzigen.",
+    "error.feed_invalid_entry_open_mode": "Ongeldige manier om items te openen.",
+    "error.feed_invalid_priority": "Ongeldige prioriteit.",
+    "error.feed_invalid_entry_limit": "De artikellimiet is ongeldig.",
+    "error.feed_invalid_scraper_max_pages": "Het aantal pagina's moet tussen 1 en %d liggen.",
+    "error.feed_invalid_summary_words": "Het aantal woorden moet tussen 0 en %d liggen.",
+    "error.feed_invalid_entry_matching": "Ongeldige artikelherkenning.",
+    "error.feed_invalid_muted_until": "De dempdatum moet de notatie JJJJ-MM-DD hebben.",
+    "error.mark_read_after_days_invalid": "Het aantal dagen voordat artikelen als gelezen worden gemarkeerd mag niet negatief zijn.",
+    "error.image_display_invalid": "Ongeldige weergave van afbeeldingen.",
+    "error.nsfw_display_invalid": "Ongeldige NSFW-weergavemodus.",
+    "error.junk_threshold_invalid": "De rommeldrempel moet tussen 0 en %d liggen.",
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
     "form.feed.label.title": "Naam",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Categorie",
     "form.feed.label.crawler": "Download originele content",
+    "form.feed.label.nsfw": "Artikelen niet geschikt voor op het werk (NSFW)",
+    "form.feed.label.summarize_entries": "Een samenvatting van nieuwe artikelen genereren",
+    "form.feed.label.entry_open_mode": "Items openen met",
+    "form.feed.label.priority": "Prioriteit in de lijst met ongelezen artikelen",
+    "form.feed.label.muted_until": "Dempen tot",
+    "form.feed.help.muted_until": "Nieuwe artikelen worden nog steeds opgehaald, maar tot deze dag als gelezen gemarkeerd en niet als ongelezen geteld. Laat leeg om het dempen op te heffen.",
+    "form.feed.label.mark_read_after_days": "Ongelezen artikelen als gelezen markeren na (dagen)",
+    "form.feed.help.mark_read_after_days": "Gebruik 0 om de regel van de categorie te volgen.",
+    "form.feed.label.max_entries": "Maximaal aantal opgeslagen artikelen",
+    "form.feed.help.max_entries": "Favorieten worden altijd bewaard. Gebruik 0 om alle artikelen te bewaren.",
+    "form.feed.label.overflow_policy": "Artikelen boven de limiet",
+    "form.feed.select.overflow_archive": "Oudste artikelen archiveren",
+    "form.feed.select.overflow_delete": "Oudste artikelen verwijderen",
+    "form.feed.label.entry_matching": "Opnieuw gepubliceerde artikelen herkennen",
+    "form.feed.help.entry_matching": "Gebruik deze optie wanneer de feed de identificatie van zijn artikelen wijzigt en duplicaten maakt.",
+    "form.feed.help.image_display": "Standaard wordt de instelling van de categorie gebruikt, daarna die van uw voorkeuren.",
+    "form.feed.label.summary_words": "Alleen de eerste woorden van artikelen bewaren",
+    "form.feed.help.summary_words": "Nieuwe artikelen worden ingekort tot dit aantal woorden, gevolgd door een link naar de website. Gebruik 0 om de volledige inhoud te bewaren.",
+    "form.feed.select.entry_matching_guid": "Alleen op identificatie",
+    "form.feed.select.entry_matching_url": "Op identificatie of URL",
+    "form.feed.select.entry_matching_title": "Op identificatie of titel en datum",
+    "form.feed.label.watch_selector": "Gevolgd gebied (CSS-selector)",
+    "form.feed.select.open_content": "Inhoud van de feed",
+    "form.feed.select.open_full_content": "Volledige inhoud van de website",
+    "form.feed.select.open_original": "Originele website",
+    "form.feed.select.priority_low": "Laag",
+    "form.feed.select.priority_normal": "Normaal",
+    "form.feed.select.priority_high": "Hoog",
     "form.feed.label.feed_username": "Feed-gebruikersnaam",
     "form.feed.label.feed_password": "Feed wachtwoord",
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
     "form.feed.label.scraper_rules": "Scraper regels",
+    "form.feed.label.scraper_max_pages": "Maximaal aantal pagina's per artikel",
+    "form.feed.help.scraper_max_pages": "Bij het ophalen van de originele inhoud worden de volgende pagina's van artikelen die over meerdere pagina's zijn verdeeld toegevoegd. Gebruik 1 om alleen de eerste pagina op te halen.",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.script": "Script",
     "form.category.label.title": "Naam",
+    "form.category.label.mark_read_after_days": "Ongelezen artikelen als gelezen markeren na (dagen)",
+    "form.category.help.mark_read_after_days": "Geldt voor de feeds van deze categorie zonder eigen regel. Gebruik 0 om artikelen ongelezen te laten.",
+    "form.category.help.image_display": "Geldt voor de feeds van deze categorie zonder eigen instelling. Standaard wordt de instelling van uw voorkeuren gebruikt.",
+    "form.category.label.nsfw": "De feeds van deze categorie zijn niet geschikt voor op het werk (NSFW)",
+    "form.category_share.label.username": "Gebruikersnaam",
+    "form.category_share.help.username": "Deze gebruiker kan de artikelen van de categorie lezen maar niet wijzigen.",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
     "form.user.label.admin": "Administrator",
+    "form.user.label.email": "E-mail",
+    "form.user.label.invitation": "Uitnodigingscode",
+    "form.invitation.help.email": "Optioneel, de uitnodigingslink wordt naar dit adres gestuurd en alleen dit adres kan hem gebruiken.",
+    "form.password_reset.help.email": "Het e-mailadres dat in de instellingen van uw account is ingesteld.",
+    "form.delete_account.help.password": "Voer uw wachtwoord in om te bevestigen.",
     "form.prefs.label.language": "Taal",
     "form.prefs.label.timezone": "Tijdzone",
     "form.prefs.label.theme": "Skin",
     "form.prefs.label.entry_sorting": "Volgorde van items",
+    "form.prefs.label.entries_per_page": "Items per pagina",
+    "form.prefs.label.show_read_entries": "Gelezen items tonen op feed- en categoriepagina's",
+    "form.prefs.label.show_absolute_time": "Datums tonen in plaats van de verstreken tijd",
+    "form.prefs.label.image_display": "Afbeeldingen in artikelen",
+    "form.prefs.label.nsfw_display": "Artikelen niet geschikt voor op het werk (NSFW)",
+    "form.prefs.help.nsfw_display": "Artikelen worden gemarkeerd via hun feed, hun categorie, hun classificatie of een NSFW-markering in de titel.",
+    "form.prefs.label.junk_threshold": "Artikelen met een rommelscore van minstens deze waarde als gelezen markeren",
+    "form.prefs.help.junk_threshold": "De rommelscore, van 0 tot 100, beoordeelt clickbait-titels, bijna lege artikelen en lijsten met links. Gebruik 0 om uit te schakelen.",
+    "form.prefs.select.image_display_show": "Tonen",
+    "form.prefs.select.image_display_blur": "Vervagen tot erop wordt geklikt",
+    "form.prefs.select.image_display_hide": "Verbergen en in plaats daarvan links tonen",
+    "form.prefs.select.nsfw_display_show": "Tonen",
+    "form.prefs.select.nsfw_display_blur": "Vervagen tot erop geklikt wordt",
+    "form.prefs.select.nsfw_display_hide": "Verbergen",
+    "form.prefs.select.image_display_inherit": "Standaard",
+    "form.prefs.label.quiet_hours_start": "Begin van de stille uren",
+    "form.prefs.label.quiet_hours_end": "Einde van de stille uren",
+    "form.prefs.help.quiet_hours": "Meldingen worden tijdens de stille uren vastgehouden en daarna samen verstuurd.",
+    "form.prefs.help.email": "Wordt gebruikt om u een link te sturen als u uw wachtwoord vergeet.",
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
     "form.import.label.file": "OPML-bestand",
@@ -1650,8 +3173,56 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Opslaan naar Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-sleutel",
+    "form.integration.readeck_activate": "Artikelen opslaan in Readeck",
+    "form.integration.readeck_endpoint": "Readeck-URL",
+    "form.integration.readeck_api_key": "Readeck API-token",
+    "form.integration.readeck_labels": "Readeck-labels (optioneel, gescheiden door komma's)",
+    "form.integration.omnivore_activate": "Artikelen opslaan in Omnivore",
+    "form.integration.omnivore_endpoint": "Omnivore API-URL (optioneel)",
+    "form.integration.omnivore_api_key": "Omnivore API-sleutel",
+    "form.integration.ntfy_activate": "Meldingen naar ntfy sturen",
+    "form.integration.ntfy_url": "URL van de ntfy-server",
+    "form.integration.ntfy_topic": "ntfy-onderwerp",
+    "form.integration.ntfy_token": "ntfy-toegangstoken",
+    "form.integration.pushover_activate": "Meldingen naar Pushover sturen",
+    "form.integration.pushover_token": "Pushover-applicatietoken",
+    "form.integration.pushover_user": "Pushover-gebruikerssleutel",
+    "form.integration.apprise_activate": "Meldingen naar Apprise sturen",
+    "form.integration.apprise_url": "Apprise API-meldingsendpoint",
+    "form.integration.apprise_service_urls": "Apprise-service-URL's (optioneel, gescheiden door komma's)",
+    "form.integration.kindle_activate": "Artikelen naar mijn Kindle sturen",
+    "form.integration.kindle_email": "Send to Kindle-e-mailadres",
+    "form.integration.kindle_help": "Voeg %s toe aan de goedgekeurde e-mailadressen van uw Amazon-account.",
+    "form.integration.events": "Gebeurtenissen die naar deze dienst worden gestuurd:",
+    "form.integration.event_starred": "Artikelen met ster",
+    "form.integration.event_new_entries": "Nieuwe artikelen",
+    "form.integration.event_feed_error": "Fouten van feeds",
+    "form.integration.event_categories": "Alleen nieuwe artikelen uit deze categorieën (alle categorieën als er geen is geselecteerd)",
+    "notification.feed_error": "Kan de feed %s niet vernieuwen",
+    "notification.new_entries": [
+        "%d nieuw artikel",
+        "%d nieuwe artikelen"
+    ],
+    "notification.quiet_hours": [
+        "%d melding tijdens de stille uren",
+        "%d meldingen tijdens de stille uren"
+    ],
     "form.submit.loading": "Laden...",
     "form.submit.saving": "Opslaag...",
+    "form.bulk.selected_entries": "Geselecteerde artikelen:",
+    "form.bulk.select_entry": "Dit artikel selecteren",
+    "form.bulk.select_feed": "Deze feed selecteren",
+    "form.bulk.move_feeds": "Geselecteerde feeds verplaatsen naar:",
+    "form.bulk.action.read": "Markeren als gelezen",
+    "form.bulk.action.unread": "Markeren als ongelezen",
+    "form.bulk.action.star": "Bladwijzer toevoegen",
+    "form.bulk.action.unstar": "Bladwijzer verwijderen",
+    "form.bulk.action.move": "Verplaatsen",
+    "form.least_read_feeds.label.months": "Niets gelezen sinds",
+    "form.least_read_feeds.months": [
+        "%d maand",
+        "%d maanden"
+    ],
     "time_elapsed.not_yet": "in de toekomst",
     "time_elapsed.yesterday": "gisteren",
     "time_elapsed.now": "minder dan een minuut geleden",
@@ -1679,6 +3250,13 @@ var translations = map[string]string{
         "%d jaar geleden",
         "%d jaar geleden"
     ],
+    "time_format.absolute": "02-01-2006 15:04",
+    "email.verification.subject": "Verifieer uw e-mailadres",
+    "email.verification.body": "Hallo %s,\n\nOpen deze link om uw e-mailadres te verifiëren en uw Miniflux-account te activeren:\n%s\n\nDe link verloopt over 24 uur.",
+    "email.invitation.subject": "Uitnodiging voor Miniflux",
+    "email.invitation.body": "%s nodigt u uit om een account aan te maken op Miniflux:\n%s\n\nDe uitnodiging verloopt over 7 dagen.",
+    "email.password_reset.subject": "Stel uw wachtwoord opnieuw in",
+    "email.password_reset.body": "Hallo %s,\n\nOpen deze link om een nieuw wachtwoord te kiezen voor uw Miniflux-account:\n%s\n\nDe link verloopt over een uur. U kunt deze e-mail negeren als u hem niet hebt aangevraagd.",
     "This feed already exists (%s)": "Deze feed bestaat al (%s)",
     "Unable to fetch feed (Status Code = %d)": "Kon feed niet updaten (statuscode = %d)",
     "Unable to open this link: %v": "Kon link niet volgen: %v",
@@ -1689,6 +3267,9 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Kon Atom-feed niet parsen: %q",
     "Unable to parse JSON feed: %q": "Kon JSON-feed niet parsen: %q",
     "Unable to parse RDF feed: %q": "Kon RDF-feed niet parsen: %q",
+    "Unable to parse feed: %q": "Kon feed niet parsen: %q",
+    "Unable to parse web page: %q": "Kan webpagina niet lezen: %q",
+    "The selector %q does not match any element of this web page": "De selector %q komt met geen enkel element van deze webpagina overeen",
     "Unable to normalize encoding: %q": "Kon encoding niet normaliseren: %q",
     "Unable to create this category.": "Kon categorie niet aanmaken.",
     "Category not found for this user": "Categorie niet gevonden voor deze gebruiker",
@@ -1696,7 +3277,10 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Ongeldig SSL-certificaat (originele error: %q)",
     "This website is temporarily unreachable (original error: %q)": "Deze website is tijdelijk onbereikbaar (originele error: %q)",
     "This website is permanently unreachable (original error: %q)": "Deze website is permanent onbereikbaar (originele error: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden"
+    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden",
+    "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)": "Het certificaat van deze Gemini-capsule is sinds het eerste bezoek gewijzigd (vingerafdruk %s)",
+    "A Tor proxy is required to fetch the .onion websites": "Een Tor-proxy is vereist om .onion-websites op te halen",
+    "The local path %q is not in the allowed directories": "Het lokale pad %q staat niet in de toegestane mappen"
 }
 `,
 	"pl_PL": `{
@@ -1715,6 +3299,16 @@ var translations = map[string]string{
     "action.download": "Pobierz",
     "action.import": "Importuj",
     "action.login": "Zaloguj się",
+    "action.signup": "Zarejestruj się",
+    "action.send_reset_link": "Wyślij link",
+    "action.delete_account": "Usuń moje konto",
+    "action.invite": "Zaproś",
+    "action.show": "Pokaż",
+    "action.mute_for_a_month": "Wycisz na miesiąc",
+    "action.unsubscribe": "Anuluj subskrypcję",
+    "action.share": "Udostępnij",
+    "action.approve": "Zatwierdź",
+    "action.reject": "Odrzuć",
     "tooltip.keyboard_shortcuts": "Skróty klawiszowe: %s",
     "tooltip.logged_user": "Zalogowany jako %s",
     "menu.unread": "Nieprzeczytane",
@@ -1727,7 +3321,10 @@ var translations = map[string]string{
     "menu.preferences": "Preferencje",
     "menu.integrations": "Usługi",
     "menu.sessions": "Sesje",
+    "menu.delete_account": "Usuń konto",
     "menu.users": "Użytkownicy",
+    "menu.invitations": "Zaproszenia",
+    "menu.statistics": "Statystyki",
     "menu.about": "O stronie",
     "menu.export": "Eksportuj",
     "menu.import": "Importuj",
@@ -1735,15 +3332,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Oznacz jako przeczytane",
     "menu.mark_all_as_read": "Oznacz wszystko jako przeczytane",
     "menu.mark_all_as_read_wip": "W toku...",
+    "menu.undo_mark_all_as_read": "Cofnij oznaczenie wszystkich jako przeczytane",
     "menu.show_all_entries": "Pokaż wszystkie artykuły",
     "menu.show_only_unread_entries": "Pokaż tylko nieprzeczytane artykuły",
     "menu.refresh_feed": "Odśwież",
     "menu.refresh_all_feeds": "Odśwież wszystkie subskrypcje w tle",
     "menu.edit_feed": "Edytuj",
     "menu.edit_category": "Edytuj",
+    "menu.share_category": "Udostępnij",
     "menu.add_feed": "Dodaj subskrypcję",
     "menu.add_user": "Dodaj użytkownika",
     "menu.flush_history": "Usuń historię",
+    "menu.recently_read": "Ostatnio przeczytane",
+    "menu.junk": "Śmieci",
+    "menu.export_epub": "Pobierz jako EPUB",
+    "menu.export_printable": "Wersja do druku",
+    "menu.least_read_feeds": "Najrzadziej czytane kanały",
+    "menu.pending_feeds": "Oczekujące subskrypcje",
     "search.label": "Szukaj",
     "search.placeholder": "Szukaj...",
     "pagination.next": "Następny",
@@ -1754,16 +3359,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Oznacz gwiazdką",
     "entry.bookmark.toggle.off": "Usuń gwiazdkę",
     "entry.state.saving": "Zapisywanie...",
+    "entry.state.sending": "Wysyłanie...",
     "entry.state.loading": "Ładowanie...",
     "entry.save.label": "Zapisz",
     "entry.save.title": "Zapisz ten artykuł",
     "entry.save.completed": "Gotowe!",
+    "entry.kindle.label": "Wyślij do Kindle",
+    "entry.kindle.title": "Wyślij ten artykuł do mojego Kindle",
+    "entry.kindle.completed": "Wysłano!",
     "entry.scraper.label": "Pobierz treść",
     "entry.scraper.title": "Pobierz oryginalną treść",
     "entry.scraper.completed": "Gotowe!",
+    "entry.summarize.label": "Podsumuj",
+    "entry.summarize.title": "Wygeneruj podsumowanie tego artykułu",
+    "entry.summarize.completed": "Gotowe!",
     "entry.original.label": "Oryginalny artykuł",
     "entry.comments.label": "Komentarze",
+    "entry.snapshot.title": "Przeczytaj zapisaną kopię strony",
+    "entry.snapshot.label": "Kopia",
     "entry.comments.title": "Zobacz komentarze",
+    "entry.summary.read_more": "Czytaj dalej",
+    "entry.watch.title": "Zmiany na %s (%s)",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
     "page.categories.title": "Kategorie",
@@ -1773,18 +3389,39 @@ var translations = map[string]string{
         "Są %d kanały.",
         "Jest %d kanałów."
     ],
+    "page.categories.shared_with_me": "Udostępnione mi",
     "page.new_category.title": "Nowa kategoria",
     "page.new_user.title": "Nowy użytkownik",
     "page.edit_category.title": "Edycja Kategorii: %s",
+    "page.category_shares.title": "Udostępnij kategorię: %s",
+    "page.category_shares.since": "Udostępnione od",
+    "page.shared_category_entries.shared_by": "Udostępnione przez %s",
     "page.edit_user.title": "Edytuj użytkownika: %s",
     "page.feeds.title": "Kanały",
     "page.feeds.last_check": "Ostatnia aktualizacja:",
+    "page.feeds.muted_until": "Wyciszony do:",
+    "page.feeds.pending": "Oczekuje na zatwierdzenie",
     "page.feeds.error_count": [
         "%d błąd",
         "%d błąd",
         "%d błędów"
     ],
     "page.history.title": "Historia",
+    "page.recently_read.title": "Ostatnio przeczytane",
+    "page.junk.title": "Śmieci",
+    "page.junk.help": "Artykuły z wynikiem śmieci co najmniej %d.",
+    "page.junk.score": "Wynik śmieci: %d",
+    "page.recently_read.read_at": "Przeczytano",
+    "page.least_read_feeds.title": "Najrzadziej czytane kanały",
+    "page.least_read_feeds.last_read": "Ostatnio czytany:",
+    "page.least_read_feeds.never_read": "Nigdy nie czytany",
+    "page.least_read_feeds.unread_count": [
+        "%d nieprzeczytany artykuł",
+        "%d nieprzeczytane artykuły",
+        "%d nieprzeczytanych artykułów"
+    ],
+    "page.pending_feeds.title": "Oczekujące subskrypcje",
+    "page.pending_feeds.requested_by": "Zgłoszone przez %s",
     "page.import.title": "Importuj",
     "page.search.title": "Wyniki wyszukiwania",
     "page.about.title": "O",
@@ -1798,6 +3435,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Znajdź subskrypcję",
     "page.add_feed.legend.advanced_options": "Zaawansowane opcje",
+    "page.add_feed.watch_selector_help": "Wypełnij to pole, aby obserwować stronę bez kanału: wpis jest tworzony, gdy zmieni się tekst pasujących elementów.",
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
@@ -1840,11 +3478,39 @@ var translations = map[string]string{
     "page.users.actions": "Działania",
     "page.users.last_login": "Ostatnie logowanie",
     "page.users.is_admin": "Administrator",
+    "page.users.not_verified": "E-mail niezweryfikowany",
+    "page.users.pending": "Oczekuje na zatwierdzenie",
+    "page.invitations.title": "Zaproszenia",
+    "page.invitations.expires": "Wygasa",
+    "page.invitations.status": "Status",
+    "page.invitations.used": "Użyte",
+    "page.invitations.unused": "Nieużyte",
+    "page.statistics.title": "Statystyki",
+    "page.statistics.users": "Użytkownicy",
+    "page.statistics.feeds": "Kanały",
+    "page.statistics.entries": "Artykuły (szacunkowo)",
+    "page.statistics.database_size": "Rozmiar bazy danych",
+    "page.statistics.fetches_last_hour": "Odświeżenia w ostatniej godzinie",
+    "page.statistics.fetches_last_day": "Odświeżenia w ostatnich 24 godzinach",
+    "page.statistics.fetch_success_rate": "Udane odświeżenia",
+    "page.statistics.shared_feeds": "Kanały subskrybowane przez kilku użytkowników",
+    "page.statistics.feed_url": "URL kanału",
+    "page.statistics.subscribers": "Subskrybenci",
+    "page.statistics.error_domains": "Domeny z największą liczbą błędów",
+    "page.statistics.domain": "Domena",
+    "page.statistics.error_feeds": "Kanały z błędami",
     "page.settings.title": "Ustawienia",
     "page.settings.link_google_account": "Połącz z moim kontem Google",
     "page.settings.unlink_google_account": "Odłącz moje konto Google",
     "page.login.title": "Zaloguj się",
     "page.login.google_signin": "Zaloguj przez Google",
+    "page.login.no_account": "Nie masz konta?",
+    "page.signup.title": "Rejestracja",
+    "page.login.forgot_password": "Nie pamiętasz hasła?",
+    "page.forgot_password.title": "Zapomniane hasło",
+    "page.reset_password.title": "Resetuj hasło",
+    "page.delete_account.title": "Usuń konto",
+    "page.delete_account.description": "Twoje kanały, artykuły, kategorie i integracje zostaną trwale usunięte. Zostaniesz natychmiast wylogowany, a tej operacji nie można cofnąć.",
     "page.integrations.title": "Usługi",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Punkt końcowy API",
@@ -1852,9 +3518,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Hasło",
     "page.integration.miniflux_api_password_value": "Hasło konta",
     "page.integration.bookmarklet": "Bookmarklet",
+    "page.integration.browser_extension": "Rozszerzenie przeglądarki",
+    "page.integration.browser_extension_endpoint": "Punkt końcowy rozszerzenia",
+    "page.integration.browser_extension_token": "Token",
+    "page.integration.browser_extension_no_token": "Nie wygenerowano tokenu",
+    "page.integration.browser_extension_generate_token": "Wygeneruj nowy token",
     "page.integration.bookmarklet.name": "Dodaj do Miniflux",
     "page.integration.bookmarklet.instructions": "Przeciągnij i upuść to łącze do zakładek.",
     "page.integration.bookmarklet.help": "Ten link umożliwia subskrypcję strony internetowej bezpośrednio za pomocą zakładki w przeglądarce internetowej.",
+    "page.entry_snapshot.taken": "Zapisano",
+    "page.entry_snapshot.refresh": "Zapisz nową kopię",
+    "page.entry_snapshot.last_error": "Ostatnia próba zapisania kopii nie powiodła się:",
+    "page.entry_snapshot.empty": "Nie ma jeszcze zapisanej kopii tej strony.",
     "page.sessions.title": "Sesje",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Adres IP",
@@ -1864,29 +3539,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Obecnie nie ma żadnych zakładek.",
     "alert.no_category": "Nie ma żadnej kategorii!",
     "alert.no_category_entry": "W tej kategorii nie ma żadnych artykułów",
+    "alert.no_category_share": "Ta kategoria nie jest nikomu udostępniona.",
     "alert.no_feed_entry": "Nie ma artykułu dla tego kanału.",
     "alert.no_feed": "Nie masz żadnej subskrypcji.",
     "alert.no_history": "Obecnie nie ma żadnej historii.",
+    "alert.no_junk": "Brak artykułów uznanych za śmieci.",
+    "alert.no_least_read_feed": "W tym okresie przeczytałeś artykuły ze wszystkich swoich subskrypcji.",
+    "alert.no_pending_feed": "Brak subskrypcji oczekujących na zatwierdzenie.",
+    "alert.feed_pending": "Subskrypcja została zapisana, jej artykuły zostaną pobrane po zatwierdzeniu przez administratora.",
+    "alert.no_invitation": "Brak zaproszeń.",
+    "alert.no_shared_feed": "Żaden kanał nie jest subskrybowany przez kilku użytkowników.",
+    "alert.no_error_domain": "Żadna domena nie ma kanałów z błędami.",
+    "alert.signup_verification_sent": "Link weryfikacyjny został wysłany na adres %s.",
+    "alert.account_verified": "Twój adres e-mail został zweryfikowany, możesz się teraz zalogować.",
+    "alert.account_verified_pending": "Twój adres e-mail został zweryfikowany, konto zostanie aktywowane po zatwierdzeniu przez administratora.",
+    "alert.invitation_created": "Zaproszenie zostało utworzone, udostępnij ten link: %s",
+    "alert.password_reset_sent": "Jeśli konto używa adresu %s, wysłano link do ustawienia nowego hasła.",
+    "alert.password_reset": "Twoje hasło zostało zmienione, możesz się teraz zalogować.",
+    "alert.account_deletion_requested": "Twoje konto zostało zamknięte, Twoje dane zostaną wkrótce usunięte.",
     "alert.feed_error": "Z tym kanałem jest problem",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
+    "alert.read_only": "Ta instancja jest tylko do odczytu, możesz ją przeglądać, ale twoje zmiany nie są zapisywane.",
+    "alert.write_freeze": "Zmiany na twoim koncie są wyłączone do %s z powodu konserwacji.",
     "alert.no_user": "Jesteś jedynym użytkownikiem.",
     "alert.account_unlinked": "Twoje konto zewnętrzne jest teraz zdysocjowane!",
     "alert.account_linked": "Twoje konto zewnętrzne jest teraz połączone!",
     "alert.pocket_linked": "Twoje konto Pocket jest teraz połączone!",
     "alert.prefs_saved": "Ustawienia zapisane!",
+    "alert.extension_token_generated": "Wygenerowano nowy token, poprzedni przestał działać.",
+    "alert.entry_snapshot_saved": "Zapisano nową kopię strony.",
     "error.unlink_account_without_password": "Musisz zdefiniować hasło, inaczej nie będziesz mógł się ponownie zalogować.",
     "error.duplicate_linked_account": "Już ktoś jest powiązany z tym dostawcą!",
     "error.duplicate_fever_username": "Już ktoś inny używa tej nazwy użytkownika Fever!",
     "error.pocket_request_token": "Nie można pobrać tokena żądania z Pocket!",
     "error.pocket_access_token": "Nie można pobrać tokena dostępu z Pocket!",
+    "error.entry_snapshot_failed": "Nie można pobrać strony, poprzednia kopia została zachowana.",
     "error.category_already_exists": "Ta kategoria już istnieje.",
     "error.unable_to_create_category": "Ta kategoria nie mogła zostać utworzona.",
     "error.unable_to_update_category": "Ta kategoria nie mogła zostać zaktualizowana.",
+    "error.category_changed": "Ta kategoria została w międzyczasie zmieniona, wyślij formularz ponownie, aby nadpisać zmiany.",
+    "error.read_only": "Ta instancja jest tylko do odczytu, zmiany nie są dozwolone.",
+    "error.write_freeze": "Zmiany na twoim koncie są tymczasowo wyłączone.",
+    "error.category_share_user_not_found": "Ten użytkownik nie istnieje.",
+    "error.invalid_email": "Ten adres e-mail jest nieprawidłowy.",
+    "error.email_domain_not_allowed": "Ta domena e-mail nie jest dozwolona.",
+    "error.email_already_exists": "Ten adres e-mail jest już używany.",
+    "error.invalid_invitation": "Ten kod zaproszenia jest nieprawidłowy lub wygasł.",
+    "error.unable_to_send_email": "Nie można wysłać wiadomości e-mail, spróbuj ponownie później.",
+    "error.invalid_verification_link": "Ten link weryfikacyjny jest nieprawidłowy lub wygasł.",
+    "error.user_not_verified": "Twój adres e-mail nie został jeszcze zweryfikowany, otwórz link wysłany e-mailem.",
+    "error.user_pending": "Twoje konto oczekuje na zatwierdzenie przez administratora.",
+    "error.invalid_password_reset_link": "Ten link do resetowania hasła jest nieprawidłowy lub wygasł.",
+    "error.account_deletion_admin": "Konto administratora musi zostać usunięte przez innego administratora.",
+    "error.invalid_password": "Hasło jest nieprawidłowe.",
     "error.user_already_exists": "Ten użytkownik już istnieje.",
     "error.unable_to_create_user": "Nie można utworzyć tego użytkownika.",
     "error.unable_to_update_user": "Nie można zaktualizować tego użytkownika.",
     "error.unable_to_update_feed": "Nie można zaktualizować tego kanału.",
+    "error.feed_changed": "Ten kanał został w międzyczasie zmieniony, wyślij formularz ponownie, aby nadpisać zmiany.",
     "error.subscription_not_found": "Nie znaleziono żadnych subskrypcji.",
     "error.empty_file": "Ten plik jest pusty.",
     "error.bad_credentials": "Nieprawidłowa nazwa użytkownika lub hasło.",
@@ -1895,27 +3606,107 @@ var translations = map[string]string{
     "error.different_passwords": "Hasła nie są identyczne.",
     "error.password_min_length": "Musisz użyć co najmniej 6 znaków.",
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
+    "error.entries_per_page_invalid": "Liczba artykułów na stronę musi wynosić od 1 do %d.",
+    "error.quiet_hours_invalid": "Początek i koniec godzin ciszy muszą być podane w formacie GG:MM lub oba puste.",
+    "error.integration_filters_invalid": "Zdarzenia wybrane dla integracji są nieprawidłowe.",
+    "error.kindle_email_invalid": "Adres e-mail Kindle jest nieprawidłowy.",
+    "error.bulk_empty_selection": "Nie wybrano żadnego elementu.",
+    "error.category_not_found": "Ta kategoria nie istnieje lub nie należy do tego użytkownika.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.feed_invalid_script": "Nieprawidłowy skrypt: %v.",
+    "error.feed_script_not_allowed": "Tylko administratorzy mogą zmienić skrypt kanału.",
+    "error.feed_invalid_entry_open_mode": "Nieprawidłowy sposób otwierania artykułów.",
+    "error.feed_invalid_priority": "Nieprawidłowy priorytet.",
+    "error.feed_invalid_entry_limit": "Limit artykułów jest nieprawidłowy.",
+    "error.feed_invalid_scraper_max_pages": "Liczba stron musi wynosić od 1 do %d.",
+    "error.feed_invalid_summary_words": "Liczba słów musi mieścić się w przedziale od 0 do %d.",
+    "error.feed_invalid_entry_matching": "Nieprawidłowe rozpoznawanie artykułów.",
+    "error.feed_invalid_muted_until": "Data wyciszenia musi mieć format RRRR-MM-DD.",
+    "error.mark_read_after_days_invalid": "Liczba dni przed oznaczeniem artykułów jako przeczytane nie może być ujemna.",
+    "error.image_display_invalid": "Nieprawidłowy sposób wyświetlania obrazów.",
+    "error.nsfw_display_invalid": "Nieprawidłowy tryb wyświetlania treści NSFW.",
+    "error.junk_threshold_invalid": "Próg śmieci musi wynosić od 0 do %d.",
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
     "form.feed.label.title": "Tytuł",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
     "form.feed.label.category": "Kategoria",
     "form.feed.label.crawler": "Pobierz oryginalną treść",
+    "form.feed.label.nsfw": "Artykuły nieodpowiednie w pracy (NSFW)",
+    "form.feed.label.summarize_entries": "Generuj podsumowanie nowych artykułów",
+    "form.feed.label.entry_open_mode": "Otwieraj artykuły z",
+    "form.feed.label.priority": "Priorytet na liście nieprzeczytanych",
+    "form.feed.label.muted_until": "Wycisz do",
+    "form.feed.help.muted_until": "Nowe artykuły są nadal pobierane, ale do tego dnia są oznaczane jako przeczytane i pomijane w licznikach nieprzeczytanych. Pozostaw puste, aby wyłączyć wyciszenie.",
+    "form.feed.label.mark_read_after_days": "Oznacz nieprzeczytane artykuły jako przeczytane po (dni)",
+    "form.feed.help.mark_read_after_days": "Użyj 0, aby stosować regułę kategorii.",
+    "form.feed.label.max_entries": "Maksymalna liczba przechowywanych artykułów",
+    "form.feed.help.max_entries": "Ulubione artykuły są zawsze zachowywane. Użyj 0, aby zachować wszystkie artykuły.",
+    "form.feed.label.overflow_policy": "Artykuły ponad limit",
+    "form.feed.select.overflow_archive": "Archiwizuj najstarsze artykuły",
+    "form.feed.select.overflow_delete": "Usuń najstarsze artykuły",
+    "form.feed.label.entry_matching": "Rozpoznawaj ponownie opublikowane artykuły",
+    "form.feed.help.entry_matching": "Użyj tej opcji, gdy kanał zmienia identyfikator artykułów i tworzy duplikaty.",
+    "form.feed.help.image_display": "Domyślnie używane jest ustawienie kategorii, a następnie ustawienie z preferencji.",
+    "form.feed.label.summary_words": "Zachowaj tylko pierwsze słowa artykułów",
+    "form.feed.help.summary_words": "Nowe artykuły są skracane do tej liczby słów i uzupełniane odnośnikiem do strony. Użyj 0, aby zachować pełną treść.",
+    "form.feed.select.entry_matching_guid": "Tylko po identyfikatorze",
+    "form.feed.select.entry_matching_url": "Po identyfikatorze lub adresie URL",
+    "form.feed.select.entry_matching_title": "Po identyfikatorze lub tytule i dacie",
+    "form.feed.label.watch_selector": "Obserwowany obszar (selektor CSS)",
+    "form.feed.select.open_content": "Treść kanału",
+    "form.feed.select.open_full_content": "Pełna treść ze strony internetowej",
+    "form.feed.select.open_original": "Oryginalna strona internetowa",
+    "form.feed.select.priority_low": "Niski",
+    "form.feed.select.priority_normal": "Normalny",
+    "form.feed.select.priority_high": "Wysoki",
     "form.feed.label.feed_username": "Subskrypcję nazwa użytkownika",
     "form.feed.label.feed_password": "Subskrypcję Hasło",
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
+    "form.feed.label.scraper_max_pages": "Maksymalna liczba stron artykułu",
+    "form.feed.help.scraper_max_pages": "Podczas pobierania oryginalnej treści dołączane są kolejne strony artykułów podzielonych na kilka stron. Użyj 1, aby pobrać tylko pierwszą stronę.",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.script": "Skrypt",
     "form.category.label.title": "Tytuł",
+    "form.category.label.mark_read_after_days": "Oznacz nieprzeczytane artykuły jako przeczytane po (dni)",
+    "form.category.help.mark_read_after_days": "Dotyczy kanałów tej kategorii bez własnej reguły. Użyj 0, aby pozostawić artykuły nieprzeczytane.",
+    "form.category.help.image_display": "Dotyczy kanałów tej kategorii bez własnego ustawienia. Domyślnie używane jest ustawienie z preferencji.",
+    "form.category.label.nsfw": "Kanały tej kategorii są nieodpowiednie w pracy (NSFW)",
+    "form.category_share.label.username": "Nazwa użytkownika",
+    "form.category_share.help.username": "Ten użytkownik może czytać artykuły kategorii, ale nie może ich zmieniać.",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
     "form.user.label.admin": "Administrator",
+    "form.user.label.email": "E-mail",
+    "form.user.label.invitation": "Kod zaproszenia",
+    "form.invitation.help.email": "Opcjonalnie, link z zaproszeniem zostanie wysłany na ten adres i tylko ten adres może go użyć.",
+    "form.password_reset.help.email": "Adres e-mail podany w ustawieniach Twojego konta.",
+    "form.delete_account.help.password": "Wpisz hasło, aby potwierdzić.",
     "form.prefs.label.language": "Język",
     "form.prefs.label.timezone": "Strefa czasowa",
     "form.prefs.label.theme": "Wygląd",
     "form.prefs.label.entry_sorting": "Sortowanie artykułów",
+    "form.prefs.label.entries_per_page": "Artykuły na stronę",
+    "form.prefs.label.show_read_entries": "Pokazuj przeczytane artykuły na stronach kanałów i kategorii",
+    "form.prefs.label.show_absolute_time": "Pokaż daty zamiast upływu czasu",
+    "form.prefs.label.image_display": "Obrazy w artykułach",
+    "form.prefs.label.nsfw_display": "Artykuły nieodpowiednie w pracy (NSFW)",
+    "form.prefs.help.nsfw_display": "Artykuły są oznaczane przez ich kanał, kategorię, klasyfikację wiekową lub oznaczenie NSFW w tytule.",
+    "form.prefs.label.junk_threshold": "Oznacz jako przeczytane artykuły z wynikiem śmieci co najmniej",
+    "form.prefs.help.junk_threshold": "Wynik śmieci, od 0 do 100, ocenia tytuły typu clickbait, prawie puste artykuły i listy linków. Użyj 0, aby wyłączyć.",
+    "form.prefs.select.image_display_show": "Pokaż",
+    "form.prefs.select.image_display_blur": "Rozmyj do kliknięcia",
+    "form.prefs.select.image_display_hide": "Ukryj i pokaż zamiast nich odnośniki",
+    "form.prefs.select.nsfw_display_show": "Pokaż",
+    "form.prefs.select.nsfw_display_blur": "Rozmyj do kliknięcia",
+    "form.prefs.select.nsfw_display_hide": "Ukryj",
+    "form.prefs.select.image_display_inherit": "Domyślnie",
+    "form.prefs.label.quiet_hours_start": "Początek godzin ciszy",
+    "form.prefs.label.quiet_hours_end": "Koniec godzin ciszy",
+    "form.prefs.help.quiet_hours": "Powiadomienia są wstrzymywane w godzinach ciszy i wysyłane razem po ich zakończeniu.",
+    "form.prefs.help.email": "Służy do wysłania linku, gdy zapomnisz hasła.",
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
     "form.import.label.file": "Plik OPML",
@@ -1943,8 +3734,59 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Zapisz artykuly do Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.readeck_activate": "Zapisz artykuły do Readeck",
+    "form.integration.readeck_endpoint": "URL Readeck",
+    "form.integration.readeck_api_key": "Token API Readeck",
+    "form.integration.readeck_labels": "Etykiety Readeck (opcjonalne, oddzielone przecinkami)",
+    "form.integration.omnivore_activate": "Zapisz artykuły do Omnivore",
+    "form.integration.omnivore_endpoint": "Punkt końcowy API Omnivore (opcjonalny)",
+    "form.integration.omnivore_api_key": "Klucz API Omnivore",
+    "form.integration.ntfy_activate": "Wysyłaj powiadomienia do ntfy",
+    "form.integration.ntfy_url": "URL serwera ntfy",
+    "form.integration.ntfy_topic": "Temat ntfy",
+    "form.integration.ntfy_token": "Token dostępu ntfy",
+    "form.integration.pushover_activate": "Wysyłaj powiadomienia do Pushover",
+    "form.integration.pushover_token": "Token aplikacji Pushover",
+    "form.integration.pushover_user": "Klucz użytkownika Pushover",
+    "form.integration.apprise_activate": "Wysyłaj powiadomienia do Apprise",
+    "form.integration.apprise_url": "Punkt końcowy powiadomień API Apprise",
+    "form.integration.apprise_service_urls": "Adresy URL usług Apprise (opcjonalne, oddzielone przecinkami)",
+    "form.integration.kindle_activate": "Wysyłaj artykuły do mojego Kindle",
+    "form.integration.kindle_email": "Adres e-mail Send to Kindle",
+    "form.integration.kindle_help": "Dodaj %s do zatwierdzonych adresów e-mail swojego konta Amazon.",
+    "form.integration.events": "Zdarzenia wysyłane do tej usługi:",
+    "form.integration.event_starred": "Artykuły oznaczone gwiazdką",
+    "form.integration.event_new_entries": "Nowe artykuły",
+    "form.integration.event_feed_error": "Błędy kanałów",
+    "form.integration.event_categories": "Tylko nowe artykuły z tych kategorii (wszystkie, jeśli żadna nie jest wybrana)",
+    "notification.feed_error": "Nie można odświeżyć kanału %s",
+    "notification.new_entries": [
+        "%d nowy artykuł",
+        "%d nowe artykuły",
+        "%d nowych artykułów"
+    ],
+    "notification.quiet_hours": [
+        "%d powiadomienie w godzinach ciszy",
+        "%d powiadomienia w godzinach ciszy",
+        "%d powiadomień w godzinach ciszy"
+    ],
     "form.submit.loading": "Ładowanie...",
     "form.submit.saving": "Zapisywanie...",
+    "form.bulk.selected_entries": "Wybrane artykuły:",
+    "form.bulk.select_entry": "Wybierz ten artykuł",
+    "form.bulk.select_feed": "Wybierz ten kanał",
+    "form.bulk.move_feeds": "Przenieś wybrane kanały do:",
+    "form.bulk.action.read": "Oznacz jako przeczytane",
+    "form.bulk.action.unread": "Oznacz jako nieprzeczytane",
+    "form.bulk.action.star": "Dodaj do ulubionych",
+    "form.bulk.action.unstar": "Usuń z ulubionych",
+    "form.bulk.action.move": "Przenieś",
+    "form.least_read_feeds.label.months": "Nic nie przeczytano od",
+    "form.least_read_feeds.months": [
+        "%d miesiąca",
+        "%d miesięcy",
+        "%d miesięcy"
+    ],
     "time_elapsed.not_yet": "jeszcze nie",
     "time_elapsed.yesterday": "wczoraj",
     "time_elapsed.now": "przed chwilą",
@@ -1978,6 +3820,13 @@ var translations = map[string]string{
         "%d lat temu",
         "%d lat temu"
     ],
+    "time_format.absolute": "02.01.2006 15:04",
+    "email.verification.subject": "Zweryfikuj swój adres e-mail",
+    "email.verification.body": "Witaj %s,\n\nOtwórz ten link, aby zweryfikować adres e-mail i aktywować konto Miniflux:\n%s\n\nLink wygaśnie za 24 godziny.",
+    "email.invitation.subject": "Zaproszenie do Miniflux",
+    "email.invitation.body": "%s zaprasza Cię do utworzenia konta w Miniflux:\n%s\n\nZaproszenie wygaśnie za 7 dni.",
+    "email.password_reset.subject": "Zresetuj swoje hasło",
+    "email.password_reset.body": "Witaj %s,\n\nOtwórz ten link, aby ustawić nowe hasło do konta Miniflux:\n%s\n\nLink wygaśnie za godzinę. Możesz zignorować tę wiadomość, jeśli o nią nie prosiłeś.",
     "This feed already exists (%s)": "Ten kanał już istnieje (%s)",
     "Unable to fetch feed (Status Code = %d)": "Kanał nie mógł zostać pobrany (kod=%d)",
     "Unable to open this link: %v": "Nie można było otworzyć tego linku: %v",
@@ -1988,6 +3837,9 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Nie można było odczytać kanału Atom: %q",
     "Unable to parse JSON feed: %q": "Nie można było odczytać kanału JSON: %q",
     "Unable to parse RDF feed: %q": "Nie można było odczytać kanału RDF: %q",
+    "Unable to parse feed: %q": "Nie można było odczytać kanału: %q",
+    "Unable to parse web page: %q": "Nie można przetworzyć strony: %q",
+    "The selector %q does not match any element of this web page": "Selektor %q nie pasuje do żadnego elementu tej strony",
     "Unable to normalize encoding: %q": "Kodowanie znaków nie mogło zostać znormalizowane: %q",
     "Category not found for this user": "Kategoria nie znaleziona dla tego użytkownika",
     "This feed is empty": "Ten kanał jest pusty",
@@ -1995,7 +3847,10 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Certyfikat SSL jest nieprawidłowy (błąd: %q)",
     "This website is temporarily unreachable (original error: %q)": "Ta strona jest tymczasowo niedostępna (błąd: %q)",
     "This website is permanently unreachable (original error: %q)": "Ta strona jest niedostępna (błąd: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach"
+    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach",
+    "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)": "Certyfikat tej kapsuły Gemini zmienił się od pierwszej wizyty (odcisk %s)",
+    "A Tor proxy is required to fetch the .onion websites": "Do pobierania stron .onion wymagany jest serwer proxy Tor",
+    "The local path %q is not in the allowed directories": "Lokalna ścieżka %q nie znajduje się w dozwolonych katalogach"
 }
 `,
 	"ru_RU": `{
@@ -2014,6 +3869,16 @@ var translations = map[string]string{
     "action.download": "Загрузить",
     "action.import": "Импорт",
     "action.login": "Войти",
+    "action.signup": "Зарегистрироваться",
+    "action.send_reset_link": "Отправить ссылку",
+    "action.delete_account": "Удалить мою учётную запись",
+    "action.invite": "Пригласить",
+    "action.show": "Показать",
+    "action.mute_for_a_month": "Заглушить на месяц",
+    "action.unsubscribe": "Отписаться",
+    "action.share": "Поделиться",
+    "action.approve": "Одобрить",
+    "action.reject": "Отклонить",
     "tooltip.keyboard_shortcuts": "Сочетания клавиш: %s",
     "tooltip.logged_user": "Авторизован как %s",
     "menu.unread": "Непрочитанное",
@@ -2026,7 +3891,10 @@ var translations = map[string]string{
     "menu.preferences": "Предпочтения",
     "menu.integrations": "Интеграции",
     "menu.sessions": "Сессии",
+    "menu.delete_account": "Удалить учётную запись",
     "menu.users": "Пользователи",
+    "menu.invitations": "Приглашения",
+    "menu.statistics": "Статистика",
     "menu.about": "О приложении",
     "menu.export": "Экспорт",
     "menu.import": "Импорт",
@@ -2034,15 +3902,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "Отметить эту страницу прочитанной",
     "menu.mark_all_as_read": "Отметить всё как прочитанное",
     "menu.mark_all_as_read_wip": "В процессе…",
+    "menu.undo_mark_all_as_read": "Отменить «Отметить всё как прочитанное»",
     "menu.show_all_entries": "Показать все статьи",
     "menu.show_only_unread_entries": "Показывать только непрочитанные статьи",
     "menu.refresh_feed": "Обновить",
     "menu.refresh_all_feeds": "Обновить все подписки в фоне",
     "menu.edit_feed": "Изменить",
     "menu.edit_category": "Изменить",
+    "menu.share_category": "Поделиться",
     "menu.add_feed": "Добавить подписку",
     "menu.add_user": "Добавить пользователя",
     "menu.flush_history": "Отчистить историю",
+    "menu.recently_read": "Недавно прочитанные",
+    "menu.junk": "Мусор",
+    "menu.export_epub": "Скачать в формате EPUB",
+    "menu.export_printable": "Версия для печати",
+    "menu.least_read_feeds": "Редко читаемые подписки",
+    "menu.pending_feeds": "Ожидающие подписки",
     "search.label": "Поиск",
     "search.placeholder": "Поиск…",
     "pagination.next": "Следующая",
@@ -2053,16 +3929,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "Добавить в Избранное",
     "entry.bookmark.toggle.off": "Удалить из Избранного",
     "entry.state.saving": "Сохранение…",
+    "entry.state.sending": "Отправка...",
     "entry.state.loading": "Загрузка…",
     "entry.save.label": "Сохранить",
     "entry.save.title": "Сохранить эту статью",
     "entry.save.completed": "Готово!",
+    "entry.kindle.label": "Отправить на Kindle",
+    "entry.kindle.title": "Отправить эту статью на мой Kindle",
+    "entry.kindle.completed": "Отправлено!",
     "entry.scraper.label": "Извлечь оригинальное содержимое",
     "entry.scraper.title": "Извлечь оригинальное содержимое",
     "entry.scraper.completed": "Готово!",
+    "entry.summarize.label": "Кратко",
+    "entry.summarize.title": "Создать краткое содержание этой статьи",
+    "entry.summarize.completed": "Готово!",
     "entry.original.label": "Оригинал",
     "entry.comments.label": "Комментарии",
+    "entry.snapshot.title": "Прочитать сохранённую копию страницы",
+    "entry.snapshot.label": "Копия",
     "entry.comments.title": "Показать комментарии",
+    "entry.summary.read_more": "Читать далее",
+    "entry.watch.title": "Изменения на %s (%s)",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
     "page.categories.title": "Категории",
@@ -2072,18 +3959,39 @@ var translations = map[string]string{
         "Есть %d подписки.",
         "Есть %d подписок."
     ],
+    "page.categories.shared_with_me": "Доступные мне",
     "page.new_category.title": "Новая категория",
     "page.new_user.title": "Новый пользователь",
     "page.edit_category.title": "Изменить категорию: %s",
+    "page.category_shares.title": "Поделиться категорией: %s",
+    "page.category_shares.since": "Доступ с",
+    "page.shared_category_entries.shared_by": "Поделился %s",
     "page.edit_user.title": "Изменить пользователя: %s",
     "page.feeds.title": "Подписки",
     "page.feeds.last_check": "Последняя проверка:",
+    "page.feeds.muted_until": "Отключено до:",
+    "page.feeds.pending": "Ожидает одобрения",
     "page.feeds.error_count": [
         "%d ошибка",
         "%d ошибки",
         "%d ошибок"
     ],
     "page.history.title": "История",
+    "page.recently_read.title": "Недавно прочитанные",
+    "page.junk.title": "Мусор",
+    "page.junk.help": "Статьи с оценкой мусора не менее %d.",
+    "page.junk.score": "Оценка мусора: %d",
+    "page.recently_read.read_at": "Прочитано",
+    "page.least_read_feeds.title": "Редко читаемые подписки",
+    "page.least_read_feeds.last_read": "Последнее прочтение:",
+    "page.least_read_feeds.never_read": "Никогда не читалась",
+    "page.least_read_feeds.unread_count": [
+        "%d непрочитанная статья",
+        "%d непрочитанные статьи",
+        "%d непрочитанных статей"
+    ],
+    "page.pending_feeds.title": "Ожидающие подписки",
+    "page.pending_feeds.requested_by": "Запрошено пользователем %s",
     "page.import.title": "Импорт",
     "page.search.title": "Результаты поиска",
     "page.about.title": "О приложении",
@@ -2097,6 +4005,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Найти подписку",
     "page.add_feed.legend.advanced_options": "Расширенные настройки",
+    "page.add_feed.watch_selector_help": "Заполните это поле, чтобы отслеживать страницу без ленты: статья создаётся, когда меняется текст подходящих элементов.",
     "page.add_feed.choose_feed": "Выбрать подписку",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
@@ -2139,11 +4048,39 @@ var translations = map[string]string{
     "page.users.actions": "Действия",
     "page.users.last_login": "Последний вход",
     "page.users.is_admin": "Администратор",
+    "page.users.not_verified": "Адрес не подтверждён",
+    "page.users.pending": "Ожидает одобрения",
+    "page.invitations.title": "Приглашения",
+    "page.invitations.expires": "Истекает",
+    "page.invitations.status": "Статус",
+    "page.invitations.used": "Использовано",
+    "page.invitations.unused": "Не использовано",
+    "page.statistics.title": "Статистика",
+    "page.statistics.users": "Пользователи",
+    "page.statistics.feeds": "Подписки",
+    "page.statistics.entries": "Статьи (оценка)",
+    "page.statistics.database_size": "Размер базы данных",
+    "page.statistics.fetches_last_hour": "Обновления за последний час",
+    "page.statistics.fetches_last_day": "Обновления за последние 24 часа",
+    "page.statistics.fetch_success_rate": "Успешные обновления",
+    "page.statistics.shared_feeds": "Подписки нескольких пользователей",
+    "page.statistics.feed_url": "URL подписки",
+    "page.statistics.subscribers": "Подписчики",
+    "page.statistics.error_domains": "Домены с наибольшим числом ошибок",
+    "page.statistics.domain": "Домен",
+    "page.statistics.error_feeds": "Подписки с ошибками",
     "page.settings.title": "Настройки",
     "page.settings.link_google_account": "Привязать мой Google аккаунт",
     "page.settings.unlink_google_account": "Отвязать мой Google аккаунт",
     "page.login.title": "Войти",
     "page.login.google_signin": "Войти с помощью Google",
+    "page.login.no_account": "Нет учётной записи?",
+    "page.signup.title": "Регистрация",
+    "page.login.forgot_password": "Забыли пароль?",
+    "page.forgot_password.title": "Восстановление пароля",
+    "page.reset_password.title": "Сброс пароля",
+    "page.delete_account.title": "Удаление учётной записи",
+    "page.delete_account.description": "Ваши подписки, статьи, категории и интеграции будут удалены безвозвратно. Вы сразу выйдете из системы, и это действие нельзя отменить.",
     "page.integrations.title": "Интеграции",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Конечная точка API",
@@ -2151,9 +4088,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "Пароль",
     "page.integration.miniflux_api_password_value": "Пароль вашего аккаунта",
     "page.integration.bookmarklet": "Букмарклет",
+    "page.integration.browser_extension": "Расширение браузера",
+    "page.integration.browser_extension_endpoint": "Адрес для расширения",
+    "page.integration.browser_extension_token": "Токен",
+    "page.integration.browser_extension_no_token": "Токен не создан",
+    "page.integration.browser_extension_generate_token": "Создать новый токен",
     "page.integration.bookmarklet.name": "Добавить в Miniflux",
     "page.integration.bookmarklet.instructions": "Перетащите эту ссылку в ваши закладки.",
     "page.integration.bookmarklet.help": "Эта специальная ссылка позволит вам подписаться на сайт, используя обыкновенную закладку в вашем браузере.",
+    "page.entry_snapshot.taken": "Сохранено",
+    "page.entry_snapshot.refresh": "Сохранить новую копию",
+    "page.entry_snapshot.last_error": "Последняя попытка сохранения не удалась:",
+    "page.entry_snapshot.empty": "Сохранённой копии этой страницы пока нет.",
     "page.sessions.title": "Сессии",
     "page.sessions.table.date": "Время",
     "page.sessions.table.ip": "IP адрес",
@@ -2163,29 +4109,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "Нет закладок на данный момент.",
     "alert.no_category": "Категории отсутствуют.",
     "alert.no_category_entry": "В этой категории нет статей.",
+    "alert.no_category_share": "Эта категория никому не доступна.",
     "alert.no_feed_entry": "В этой подписке отсутствуют статьи.",
     "alert.no_feed": "У вас нет ни одной подписки.",
     "alert.no_history": "Истории пока нет.",
+    "alert.no_junk": "Нет мусорных статей.",
+    "alert.no_least_read_feed": "За этот период вы читали статьи из всех ваших подписок.",
+    "alert.no_pending_feed": "Нет подписок, ожидающих одобрения.",
+    "alert.feed_pending": "Подписка сохранена, её статьи будут загружены после одобрения администратором.",
+    "alert.no_invitation": "Приглашений нет.",
+    "alert.no_shared_feed": "Ни на одну подписку не подписано несколько пользователей.",
+    "alert.no_error_domain": "Ни у одного домена нет подписок с ошибками.",
+    "alert.signup_verification_sent": "Ссылка для подтверждения отправлена на %s.",
+    "alert.account_verified": "Ваш адрес подтверждён, теперь вы можете войти.",
+    "alert.account_verified_pending": "Ваш адрес подтверждён, учётная запись будет активирована после одобрения администратором.",
+    "alert.invitation_created": "Приглашение создано, поделитесь этой ссылкой: %s",
+    "alert.password_reset_sent": "Если учётная запись использует %s, на него отправлена ссылка для выбора нового пароля.",
+    "alert.password_reset": "Ваш пароль изменён, теперь вы можете войти.",
+    "alert.account_deletion_requested": "Ваша учётная запись закрыта, ваши данные будут удалены в ближайшее время.",
     "alert.feed_error": "С этой подпиской есть проблема",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
+    "alert.read_only": "Этот экземпляр доступен только для чтения, вы можете просматривать его, но ваши изменения не сохраняются.",
+    "alert.write_freeze": "Изменения вашей учётной записи отключены до %s на время обслуживания.",
     "alert.no_user": "Вы единственный пользователь.",
     "alert.account_unlinked": "Ваш внешний аккаунт теперь отвязан!",
     "alert.account_linked": "Ваш внешний аккаунт теперь привязан!",
     "alert.pocket_linked": "Ваш Pocket аккаунт теперь привязан!",
     "alert.prefs_saved": "Предпочтения сохранены!",
+    "alert.extension_token_generated": "Создан новый токен, предыдущий больше не действует.",
+    "alert.entry_snapshot_saved": "Новая копия страницы сохранена.",
     "error.unlink_account_without_password": "Вы должны установить пароль, иначе вы не сможете войти снова.",
     "error.duplicate_linked_account": "Уже есть кто-то, кто ассоциирован с этим аккаунтом!",
     "error.duplicate_fever_username": "Уже есть кто-то с таким же именем пользователя Fever!",
     "error.pocket_request_token": "Не удается извлечь request token из Pocket!",
     "error.pocket_access_token": "Не удается извлечь access token из Pocket!",
+    "error.entry_snapshot_failed": "Не удалось загрузить страницу, предыдущая копия сохранена.",
     "error.category_already_exists": "Эта категория уже существует.",
     "error.unable_to_create_category": "Не удается создать эту категорию.",
     "error.unable_to_update_category": "Не удается обновить эту категорию.",
+    "error.category_changed": "Эта категория была изменена, отправьте форму ещё раз, чтобы перезаписать изменения.",
+    "error.read_only": "Этот экземпляр доступен только для чтения, изменения не допускаются.",
+    "error.write_freeze": "Изменения вашей учётной записи временно отключены.",
+    "error.category_share_user_not_found": "Этот пользователь не существует.",
+    "error.invalid_email": "Этот адрес электронной почты недействителен.",
+    "error.email_domain_not_allowed": "Этот почтовый домен не разрешён.",
+    "error.email_already_exists": "Этот адрес электронной почты уже используется.",
+    "error.invalid_invitation": "Этот код приглашения недействителен или истёк.",
+    "error.unable_to_send_email": "Не удалось отправить письмо, попробуйте позже.",
+    "error.invalid_verification_link": "Эта ссылка для подтверждения недействительна или истекла.",
+    "error.user_not_verified": "Ваш адрес ещё не подтверждён, откройте ссылку из письма.",
+    "error.user_pending": "Ваша учётная запись ожидает одобрения администратора.",
+    "error.invalid_password_reset_link": "Эта ссылка для сброса пароля недействительна или истекла.",
+    "error.account_deletion_admin": "Учётная запись администратора должна быть удалена другим администратором.",
+    "error.invalid_password": "Неверный пароль.",
     "error.user_already_exists": "Этот пользователь уже существует.",
     "error.unable_to_create_user": "Не удается создать этого пользователя.",
     "error.unable_to_update_user": "Не удается обновить этого пользователя.",
     "error.unable_to_update_feed": "Не удается обновить эту подписку.",
+    "error.feed_changed": "Эта подписка была изменена, отправьте форму ещё раз, чтобы перезаписать изменения.",
     "error.subscription_not_found": "Не удается найти подписки.",
     "error.empty_file": "Этот файл пуст.",
     "error.bad_credentials": "Неверное имя пользователя или пароль.",
@@ -2194,27 +4176,107 @@ var translations = map[string]string{
     "error.different_passwords": "Пароли не совпадают.",
     "error.password_min_length": "Вы должны использовать минимум 6 символов.",
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
+    "error.entries_per_page_invalid": "Количество статей на странице должно быть от 1 до %d.",
+    "error.quiet_hours_invalid": "Начало и конец тихих часов должны быть указаны в формате ЧЧ:ММ или оба оставлены пустыми.",
+    "error.integration_filters_invalid": "Выбранные для интеграций события недопустимы.",
+    "error.kindle_email_invalid": "Неверный адрес электронной почты Kindle.",
+    "error.bulk_empty_selection": "Ничего не выбрано.",
+    "error.category_not_found": "Эта категория не существует или не принадлежит этому пользователю.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.feed_invalid_script": "Неверный скрипт: %v.",
+    "error.feed_script_not_allowed": "Только администраторы могут изменять скрипт подписки.",
+    "error.feed_invalid_entry_open_mode": "Неверный способ открытия статей.",
+    "error.feed_invalid_priority": "Неверный приоритет.",
+    "error.feed_invalid_entry_limit": "Неверный лимит статей.",
+    "error.feed_invalid_scraper_max_pages": "Количество страниц должно быть от 1 до %d.",
+    "error.feed_invalid_summary_words": "Количество слов должно быть от 0 до %d.",
+    "error.feed_invalid_entry_matching": "Неверный способ распознавания статей.",
+    "error.feed_invalid_muted_until": "Дата отключения должна быть в формате ГГГГ-ММ-ДД.",
+    "error.mark_read_after_days_invalid": "Количество дней до отметки статей как прочитанных не может быть отрицательным.",
+    "error.image_display_invalid": "Неверный режим отображения изображений.",
+    "error.nsfw_display_invalid": "Неверный режим отображения NSFW.",
+    "error.junk_threshold_invalid": "Порог мусора должен быть от 0 до %d.",
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
     "form.feed.label.title": "Название",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
     "form.feed.label.category": "Категория",
     "form.feed.label.crawler": "Извлечь оригинальное содержимое",
+    "form.feed.label.nsfw": "Статьи не для работы (NSFW)",
+    "form.feed.label.summarize_entries": "Создавать краткое содержание новых статей",
+    "form.feed.label.entry_open_mode": "Открывать статьи",
+    "form.feed.label.priority": "Приоритет в списке непрочитанных",
+    "form.feed.label.muted_until": "Отключить до",
+    "form.feed.help.muted_until": "Новые статьи по-прежнему загружаются, но до этого дня отмечаются как прочитанные и не учитываются в счётчиках непрочитанных. Оставьте пустым, чтобы включить подписку.",
+    "form.feed.label.mark_read_after_days": "Отмечать непрочитанные статьи как прочитанные через (дней)",
+    "form.feed.help.mark_read_after_days": "Укажите 0, чтобы использовать правило категории.",
+    "form.feed.label.max_entries": "Максимальное количество хранимых статей",
+    "form.feed.help.max_entries": "Избранные статьи всегда сохраняются. Укажите 0, чтобы хранить все статьи.",
+    "form.feed.label.overflow_policy": "Статьи сверх лимита",
+    "form.feed.select.overflow_archive": "Архивировать самые старые статьи",
+    "form.feed.select.overflow_delete": "Удалять самые старые статьи",
+    "form.feed.label.entry_matching": "Распознавать повторно опубликованные статьи",
+    "form.feed.help.entry_matching": "Используйте этот параметр, если лента меняет идентификаторы статей и создаёт дубликаты.",
+    "form.feed.help.image_display": "По умолчанию используется настройка категории, затем настройка из ваших предпочтений.",
+    "form.feed.label.summary_words": "Сохранять только первые слова статей",
+    "form.feed.help.summary_words": "Новые статьи обрезаются до этого количества слов, после чего добавляется ссылка на сайт. Используйте 0, чтобы сохранять полное содержимое.",
+    "form.feed.select.entry_matching_guid": "Только по идентификатору",
+    "form.feed.select.entry_matching_url": "По идентификатору или URL",
+    "form.feed.select.entry_matching_title": "По идентификатору или заголовку и дате",
+    "form.feed.label.watch_selector": "Отслеживаемая область (CSS-селектор)",
+    "form.feed.select.open_content": "Содержимое подписки",
+    "form.feed.select.open_full_content": "Полное содержимое с сайта",
+    "form.feed.select.open_original": "Оригинальный сайт",
+    "form.feed.select.priority_low": "Низкий",
+    "form.feed.select.priority_normal": "Обычный",
+    "form.feed.select.priority_high": "Высокий",
     "form.feed.label.feed_username": "Имя пользователя подписки",
     "form.feed.label.feed_password": "Пароль подписки",
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
     "form.feed.label.scraper_rules": "Правила Scraper",
+    "form.feed.label.scraper_max_pages": "Максимальное количество страниц статьи",
+    "form.feed.help.scraper_max_pages": "При загрузке оригинального содержимого добавляются следующие страницы статей, разбитых на несколько страниц. Укажите 1, чтобы загружать только первую страницу.",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.script": "Скрипт",
     "form.category.label.title": "Название",
+    "form.category.label.mark_read_after_days": "Отмечать непрочитанные статьи как прочитанные через (дней)",
+    "form.category.help.mark_read_after_days": "Применяется к подпискам этой категории без собственного правила. Укажите 0, чтобы оставлять статьи непрочитанными.",
+    "form.category.help.image_display": "Применяется к лентам этой категории без собственной настройки. По умолчанию используется настройка из ваших предпочтений.",
+    "form.category.label.nsfw": "Подписки этой категории не для работы (NSFW)",
+    "form.category_share.label.username": "Имя пользователя",
+    "form.category_share.help.username": "Этот пользователь может читать статьи категории, но не может их изменять.",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
     "form.user.label.admin": "Администратор",
+    "form.user.label.email": "Электронная почта",
+    "form.user.label.invitation": "Код приглашения",
+    "form.invitation.help.email": "Необязательно, ссылка приглашения отправляется на этот адрес, и только он может её использовать.",
+    "form.password_reset.help.email": "Адрес электронной почты, указанный в настройках учётной записи.",
+    "form.delete_account.help.password": "Введите пароль для подтверждения.",
     "form.prefs.label.language": "Язык",
     "form.prefs.label.timezone": "Часовой пояс",
     "form.prefs.label.theme": "Тема",
     "form.prefs.label.entry_sorting": "Сортировка записей",
+    "form.prefs.label.entries_per_page": "Статей на странице",
+    "form.prefs.label.show_read_entries": "Показывать прочитанные статьи на страницах подписок и категорий",
+    "form.prefs.label.show_absolute_time": "Показывать даты вместо прошедшего времени",
+    "form.prefs.label.image_display": "Изображения в статьях",
+    "form.prefs.label.nsfw_display": "Статьи не для работы (NSFW)",
+    "form.prefs.help.nsfw_display": "Статьи отмечаются по подписке, категории, возрастному рейтингу или пометке NSFW в заголовке.",
+    "form.prefs.label.junk_threshold": "Отмечать как прочитанные статьи с оценкой мусора не менее",
+    "form.prefs.help.junk_threshold": "Оценка мусора от 0 до 100 учитывает кликбейтные заголовки, почти пустые статьи и списки ссылок. Используйте 0 для отключения.",
+    "form.prefs.select.image_display_show": "Показывать",
+    "form.prefs.select.image_display_blur": "Размывать до щелчка",
+    "form.prefs.select.image_display_hide": "Скрывать и показывать вместо них ссылки",
+    "form.prefs.select.nsfw_display_show": "Показывать",
+    "form.prefs.select.nsfw_display_blur": "Размывать до щелчка",
+    "form.prefs.select.nsfw_display_hide": "Скрывать",
+    "form.prefs.select.image_display_inherit": "По умолчанию",
+    "form.prefs.label.quiet_hours_start": "Начало тихих часов",
+    "form.prefs.label.quiet_hours_end": "Конец тихих часов",
+    "form.prefs.help.quiet_hours": "Уведомления задерживаются в тихие часы и отправляются вместе после их окончания.",
+    "form.prefs.help.email": "Используется для отправки ссылки, если вы забудете пароль.",
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
     "form.import.label.file": "OPML файл",
@@ -2242,8 +4304,59 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Сохранять статьи в Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Конечная точка Nunux Keeper API",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.readeck_activate": "Сохранять статьи в Readeck",
+    "form.integration.readeck_endpoint": "URL Readeck",
+    "form.integration.readeck_api_key": "API-токен Readeck",
+    "form.integration.readeck_labels": "Метки Readeck (необязательно, через запятую)",
+    "form.integration.omnivore_activate": "Сохранять статьи в Omnivore",
+    "form.integration.omnivore_endpoint": "Конечная точка API Omnivore (необязательно)",
+    "form.integration.omnivore_api_key": "Ключ API Omnivore",
+    "form.integration.ntfy_activate": "Отправлять уведомления в ntfy",
+    "form.integration.ntfy_url": "URL сервера ntfy",
+    "form.integration.ntfy_topic": "Тема ntfy",
+    "form.integration.ntfy_token": "Токен доступа ntfy",
+    "form.integration.pushover_activate": "Отправлять уведомления в Pushover",
+    "form.integration.pushover_token": "Токен приложения Pushover",
+    "form.integration.pushover_user": "Ключ пользователя Pushover",
+    "form.integration.apprise_activate": "Отправлять уведомления в Apprise",
+    "form.integration.apprise_url": "Адрес уведомлений API Apprise",
+    "form.integration.apprise_service_urls": "URL сервисов Apprise (необязательно, через запятую)",
+    "form.integration.kindle_activate": "Отправлять статьи на мой Kindle",
+    "form.integration.kindle_email": "Адрес электронной почты Send to Kindle",
+    "form.integration.kindle_help": "Добавьте %s в список одобренных адресов электронной почты вашей учётной записи Amazon.",
+    "form.integration.events": "События, отправляемые в этот сервис:",
+    "form.integration.event_starred": "Избранные статьи",
+    "form.integration.event_new_entries": "Новые статьи",
+    "form.integration.event_feed_error": "Ошибки подписок",
+    "form.integration.event_categories": "Только новые статьи из этих категорий (все, если ни одна не выбрана)",
+    "notification.feed_error": "Не удалось обновить подписку %s",
+    "notification.new_entries": [
+        "%d новая статья",
+        "%d новые статьи",
+        "%d новых статей"
+    ],
+    "notification.quiet_hours": [
+        "%d уведомление в тихие часы",
+        "%d уведомления в тихие часы",
+        "%d уведомлений в тихие часы"
+    ],
     "form.submit.loading": "Загрузка…",
     "form.submit.saving": "Сохранение…",
+    "form.bulk.selected_entries": "Выбранные статьи:",
+    "form.bulk.select_entry": "Выбрать эту статью",
+    "form.bulk.select_feed": "Выбрать эту подписку",
+    "form.bulk.move_feeds": "Переместить выбранные подписки в:",
+    "form.bulk.action.read": "Отметить как прочитанное",
+    "form.bulk.action.unread": "Отметить как непрочитанное",
+    "form.bulk.action.star": "В избранное",
+    "form.bulk.action.unstar": "Убрать из избранного",
+    "form.bulk.action.move": "Переместить",
+    "form.least_read_feeds.label.months": "Ничего не прочитано за",
+    "form.least_read_feeds.months": [
+        "%d месяц",
+        "%d месяца",
+        "%d месяцев"
+    ],
     "time_elapsed.not_yet": "ещё нет",
     "time_elapsed.yesterday": "вчера",
     "time_elapsed.now": "только что",
@@ -2276,7 +4389,14 @@ var translations = map[string]string{
         "%d год назад",
         "%d года назад",
         "%d лет назад"
-    ]
+    ],
+    "time_format.absolute": "02.01.2006 15:04",
+    "email.verification.subject": "Подтвердите адрес электронной почты",
+    "email.verification.body": "Здравствуйте, %s!\n\nОткройте эту ссылку, чтобы подтвердить адрес и активировать учётную запись Miniflux:\n%s\n\nСсылка действительна 24 часа.",
+    "email.invitation.subject": "Приглашение в Miniflux",
+    "email.invitation.body": "%s приглашает вас создать учётную запись в Miniflux:\n%s\n\nПриглашение действительно 7 дней.",
+    "email.password_reset.subject": "Сброс пароля",
+    "email.password_reset.body": "Здравствуйте, %s!\n\nОткройте эту ссылку, чтобы выбрать новый пароль для учётной записи Miniflux:\n%s\n\nСсылка действительна один час. Если вы не запрашивали сброс, просто проигнорируйте это письмо."
 }
 `,
 	"zh_CN": `{
@@ -2295,6 +4415,16 @@ var translations = map[string]string{
     "action.download": "下载",
     "action.import": "导入",
     "action.login": "登陆",
+    "action.signup": "注册",
+    "action.send_reset_link": "发送链接",
+    "action.delete_account": "删除我的账户",
+    "action.invite": "邀请",
+    "action.show": "显示",
+    "action.mute_for_a_month": "静音一个月",
+    "action.unsubscribe": "取消订阅",
+    "action.share": "共享",
+    "action.approve": "批准",
+    "action.reject": "拒绝",
     "tooltip.keyboard_shortcuts": "快捷键: %s",
     "tooltip.logged_user": "当前登录 %s",
     "menu.unread": "未读",
@@ -2307,7 +4437,10 @@ var translations = map[string]string{
     "menu.preferences": "设置",
     "menu.integrations": "集成",
     "menu.sessions": "会话",
+    "menu.delete_account": "删除账户",
     "menu.users": "用户",
+    "menu.invitations": "邀请",
+    "menu.statistics": "统计",
     "menu.about": "关于",
     "menu.export": "导出",
     "menu.import": "导入",
@@ -2315,15 +4448,23 @@ var translations = map[string]string{
     "menu.mark_page_as_read": "标记为已读",
     "menu.mark_all_as_read": "全部标为已读",
     "menu.mark_all_as_read_wip": "执行中…",
+    "menu.undo_mark_all_as_read": "撤销全部标记为已读",
     "menu.show_all_entries": "显示所有条目",
     "menu.show_only_unread_entries": "仅显示未读文章",
     "menu.refresh_feed": "更新",
     "menu.refresh_all_feeds": "在后台更新全部源",
     "menu.edit_feed": "编辑",
     "menu.edit_category": "编辑",
+    "menu.share_category": "共享",
     "menu.add_feed": "新增订阅",
     "menu.add_user": "新建用户",
     "menu.flush_history": "清理历史",
+    "menu.recently_read": "最近阅读",
+    "menu.junk": "垃圾",
+    "menu.export_epub": "下载为 EPUB",
+    "menu.export_printable": "打印版本",
+    "menu.least_read_feeds": "最少阅读的订阅源",
+    "menu.pending_feeds": "待审批的订阅",
     "search.label": "搜索",
     "search.placeholder": "搜索…",
     "pagination.next": "下一页",
@@ -2334,16 +4475,27 @@ var translations = map[string]string{
     "entry.bookmark.toggle.on": "标记星标",
     "entry.bookmark.toggle.off": "去掉星标",
     "entry.state.saving": "保存中…",
+    "entry.state.sending": "发送中...",
     "entry.state.loading": "载入中…",
     "entry.save.label": "保存",
     "entry.save.title": "保存这篇文章",
     "entry.save.completed": "完成",
+    "entry.kindle.label": "发送到 Kindle",
+    "entry.kindle.title": "将这篇文章发送到我的 Kindle",
+    "entry.kindle.completed": "已发送！",
     "entry.scraper.label": "抓取原内容",
     "entry.scraper.title": "抓取原内容",
     "entry.scraper.completed": "完成",
+    "entry.summarize.label": "摘要",
+    "entry.summarize.title": "生成这篇文章的摘要",
+    "entry.summarize.completed": "完成！",
     "entry.original.label": "原始内容",
     "entry.comments.label": "评论",
+    "entry.snapshot.title": "阅读已保存的网页副本",
+    "entry.snapshot.label": "快照",
     "entry.comments.title": "查看评论",
+    "entry.summary.read_more": "阅读全文",
+    "entry.watch.title": "%s 的变化（%s）",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
     "page.categories.title": "分类",
@@ -2351,16 +4503,35 @@ var translations = map[string]string{
     "page.categories.feed_count": [
         "有 %d 个源"
     ],
+    "page.categories.shared_with_me": "与我共享",
     "page.new_category.title": "新分类",
     "page.new_user.title": "新用户",
     "page.edit_category.title": "编辑分类 : %s",
+    "page.category_shares.title": "共享分类：%s",
+    "page.category_shares.since": "共享时间",
+    "page.shared_category_entries.shared_by": "共享者：%s",
     "page.edit_user.title": "编辑用户 : %s",
     "page.feeds.title": "源",
     "page.feeds.last_check": "最后检查时间：",
+    "page.feeds.muted_until": "静音至：",
+    "page.feeds.pending": "等待审批",
     "page.feeds.error_count": [
         "%d 错误"
     ],
     "page.history.title": "历史",
+    "page.recently_read.title": "最近阅读",
+    "page.junk.title": "垃圾",
+    "page.junk.help": "垃圾评分至少为 %d 的文章。",
+    "page.junk.score": "垃圾评分：%d",
+    "page.recently_read.read_at": "阅读于",
+    "page.least_read_feeds.title": "最少阅读的订阅源",
+    "page.least_read_feeds.last_read": "最后阅读:",
+    "page.least_read_feeds.never_read": "从未阅读",
+    "page.least_read_feeds.unread_count": [
+        "%d 篇未读文章"
+    ],
+    "page.pending_feeds.title": "待审批的订阅",
+    "page.pending_feeds.requested_by": "申请人：%s",
     "page.import.title": "导入",
     "page.search.title": "搜索结果",
     "page.about.title": "关于",
@@ -2374,6 +4545,7 @@ var translations = map[string]string{
     "page.add_feed.label.url": "网址",
     "page.add_feed.submit": "查找订阅",
     "page.add_feed.legend.advanced_options": "高级选项",
+    "page.add_feed.watch_selector_help": "填写此字段以监视没有订阅源的网页：当匹配元素的文本发生变化时会创建一篇文章。",
     "page.add_feed.choose_feed": "选择一个订阅",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
@@ -2416,11 +4588,39 @@ var translations = map[string]string{
     "page.users.actions": "操作",
     "page.users.last_login": "最后登录时间",
     "page.users.is_admin": "管理员",
+    "page.users.not_verified": "邮箱未验证",
+    "page.users.pending": "等待批准",
+    "page.invitations.title": "邀请",
+    "page.invitations.expires": "过期时间",
+    "page.invitations.status": "状态",
+    "page.invitations.used": "已使用",
+    "page.invitations.unused": "未使用",
+    "page.statistics.title": "统计",
+    "page.statistics.users": "用户",
+    "page.statistics.feeds": "源",
+    "page.statistics.entries": "文章（估计）",
+    "page.statistics.database_size": "数据库大小",
+    "page.statistics.fetches_last_hour": "最近一小时的更新",
+    "page.statistics.fetches_last_day": "最近 24 小时的更新",
+    "page.statistics.fetch_success_rate": "成功的更新",
+    "page.statistics.shared_feeds": "多个用户订阅的源",
+    "page.statistics.feed_url": "源 URL",
+    "page.statistics.subscribers": "订阅者",
+    "page.statistics.error_domains": "错误最多的域名",
+    "page.statistics.domain": "域名",
+    "page.statistics.error_feeds": "出错的源",
     "page.settings.title": "设置",
     "page.settings.link_google_account": "关联我的 Google 账户",
     "page.settings.unlink_google_account": "解除 Google 账号关联",
     "page.login.title": "登陆",
     "page.login.google_signin": "使用 Google 登陆",
+    "page.login.no_account": "还没有账户？",
+    "page.signup.title": "注册",
+    "page.login.forgot_password": "忘记密码？",
+    "page.forgot_password.title": "忘记密码",
+    "page.reset_password.title": "重置密码",
+    "page.delete_account.title": "删除账户",
+    "page.delete_account.description": "您的订阅源、文章、分类和集成将被永久删除。您将立即退出登录，此操作无法撤销。",
     "page.integrations.title": "集成",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
@@ -2428,9 +4628,18 @@ var translations = map[string]string{
     "page.integration.miniflux_api_password": "密码",
     "page.integration.miniflux_api_password_value": "您账户的密码",
     "page.integration.bookmarklet": "书签小应用",
+    "page.integration.browser_extension": "浏览器扩展",
+    "page.integration.browser_extension_endpoint": "扩展端点",
+    "page.integration.browser_extension_token": "令牌",
+    "page.integration.browser_extension_no_token": "尚未生成令牌",
+    "page.integration.browser_extension_generate_token": "生成新令牌",
     "page.integration.bookmarklet.name": "新增到Miniflux",
     "page.integration.bookmarklet.instructions": "拖动这个链接到书签",
     "page.integration.bookmarklet.help": "你可以打开这个特殊的书签来直接订阅网站",
+    "page.entry_snapshot.taken": "保存于",
+    "page.entry_snapshot.refresh": "保存新副本",
+    "page.entry_snapshot.last_error": "上次保存副本失败：",
+    "page.entry_snapshot.empty": "此网页尚无已保存的副本。",
     "page.sessions.title": "会话",
     "page.sessions.table.date": "日期",
     "page.sessions.table.ip": "IP 地址",
@@ -2440,29 +4649,65 @@ var translations = map[string]string{
     "alert.no_bookmark": "目前没有书签",
     "alert.no_category": "目前没有分类",
     "alert.no_category_entry": "该分类下没有文章",
+    "alert.no_category_share": "此分类未与任何人共享",
     "alert.no_feed_entry": "该源中没有文章",
     "alert.no_feed": "目前没有订阅",
     "alert.no_history": "目前没有历史",
+    "alert.no_junk": "没有垃圾文章。",
+    "alert.no_least_read_feed": "在此期间您阅读了所有订阅源的文章。",
+    "alert.no_pending_feed": "没有等待审批的订阅",
+    "alert.feed_pending": "订阅已保存，管理员批准后将下载其文章",
+    "alert.no_invitation": "没有邀请",
+    "alert.no_shared_feed": "没有被多个用户订阅的源",
+    "alert.no_error_domain": "没有域名的源出错",
+    "alert.signup_verification_sent": "验证链接已发送至 %s",
+    "alert.account_verified": "您的邮箱已验证，现在可以登录",
+    "alert.account_verified_pending": "您的邮箱已验证，管理员批准后您的账户将被启用",
+    "alert.invitation_created": "邀请已创建，请分享此链接：%s",
+    "alert.password_reset_sent": "如果有账户使用 %s，设置新密码的链接已发送",
+    "alert.password_reset": "您的密码已更改，现在可以登录",
+    "alert.account_deletion_requested": "您的账户已关闭，您的数据将很快被删除",
     "alert.feed_error": "该源存在问题",
     "alert.no_search_result": "该搜索没有结果",
     "alert.no_unread_entry": "目前没有未读文章",
+    "alert.read_only": "此实例为只读，您可以浏览，但您的更改不会被保存",
+    "alert.write_freeze": "由于维护，您的帐户在 %s 之前禁止更改",
     "alert.no_user": "您是目前仅有的用户",
     "alert.account_unlinked": "您的外部帐户现已解除关联！",
     "alert.account_linked": "您的外部账号已关联！",
     "alert.pocket_linked": "您的Pocket帐户现已关联",
     "alert.prefs_saved": "设置已存储！",
+    "alert.extension_token_generated": "已生成新令牌，之前的令牌已失效。",
+    "alert.entry_snapshot_saved": "已保存网页的新副本。",
     "error.unlink_account_without_password": "您必须定义密码，否则您将无法再次登录。",
     "error.duplicate_linked_account": "该 Provider 已被关联！",
     "error.duplicate_fever_username": "Fever 用户名已被占用！",
     "error.pocket_request_token": "无法从 Pocket 获取请求令牌！",
     "error.pocket_access_token": "无法从 Pocket 获取访问令牌！",
+    "error.entry_snapshot_failed": "无法下载网页，已保留之前的副本。",
     "error.category_already_exists": "分类已存在",
     "error.unable_to_create_category": "无法建立这个分类",
     "error.unable_to_update_category": "无法更新该分类",
+    "error.category_changed": "此分类已被修改，再次提交表单以覆盖这些更改",
+    "error.read_only": "此实例为只读，不允许更改",
+    "error.write_freeze": "您的帐户暂时禁止更改",
+    "error.category_share_user_not_found": "此用户不存在",
+    "error.invalid_email": "此邮箱地址无效",
+    "error.email_domain_not_allowed": "不允许使用此邮箱域名",
+    "error.email_already_exists": "此邮箱地址已被使用",
+    "error.invalid_invitation": "此邀请码无效或已过期",
+    "error.unable_to_send_email": "无法发送邮件，请稍后再试",
+    "error.invalid_verification_link": "此验证链接无效或已过期",
+    "error.user_not_verified": "您的邮箱尚未验证，请打开邮件中的链接",
+    "error.user_pending": "您的账户正在等待管理员批准",
+    "error.invalid_password_reset_link": "此密码重置链接无效或已过期",
+    "error.account_deletion_admin": "管理员账户必须由其他管理员删除",
+    "error.invalid_password": "密码不正确",
     "error.user_already_exists": "用户已存在",
     "error.unable_to_create_user": "无法创建此用户",
     "error.unable_to_update_user": "无法更新此用户",
     "error.unable_to_update_feed": "无法更新此源",
+    "error.feed_changed": "此源已被修改，再次提交表单以覆盖这些更改",
     "error.subscription_not_found": "找不到任何订阅",
     "error.empty_file": "该文件为空",
     "error.bad_credentials": "用户名或密码无效",
@@ -2471,27 +4716,107 @@ var translations = map[string]string{
     "error.different_passwords": "两次输入的密码不同",
     "error.password_min_length": "请至少使用6个字符",
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
+    "error.entries_per_page_invalid": "每页文章数必须在 1 到 %d 之间。",
+    "error.quiet_hours_invalid": "免打扰的开始和结束时间必须都以 HH:MM 格式填写，或都留空。",
+    "error.integration_filters_invalid": "为集成选择的事件无效。",
+    "error.kindle_email_invalid": "Kindle 电子邮件地址无效。",
+    "error.bulk_empty_selection": "未选择任何项目。",
+    "error.category_not_found": "此分类不存在或不属于该用户。",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.feed_invalid_script": "无效的脚本：%v。",
+    "error.feed_script_not_allowed": "只有管理员可以修改源的脚本。",
+    "error.feed_invalid_entry_open_mode": "无效的文章打开方式。",
+    "error.feed_invalid_priority": "无效的优先级。",
+    "error.feed_invalid_entry_limit": "文章数量限制无效。",
+    "error.feed_invalid_scraper_max_pages": "页数必须介于 1 和 %d 之间",
+    "error.feed_invalid_summary_words": "字数必须介于 0 和 %d 之间。",
+    "error.feed_invalid_entry_matching": "无效的文章识别方式。",
+    "error.feed_invalid_muted_until": "静音日期的格式必须为 YYYY-MM-DD。",
+    "error.mark_read_after_days_invalid": "标记文章为已读前的天数不能为负数。",
+    "error.image_display_invalid": "无效的图片显示方式",
+    "error.nsfw_display_invalid": "无效的 NSFW 显示模式。",
+    "error.junk_threshold_invalid": "垃圾阈值必须介于 0 和 %d 之间。",
     "error.user_mandatory_fields": "必须填写用户名",
     "form.feed.label.title": "标题",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
     "form.feed.label.category": "类别",
     "form.feed.label.crawler": "获取原始内容",
+    "form.feed.label.nsfw": "不适合工作场所的文章 (NSFW)",
+    "form.feed.label.summarize_entries": "为新文章生成摘要",
+    "form.feed.label.entry_open_mode": "打开文章时显示",
+    "form.feed.label.priority": "未读列表中的优先级",
+    "form.feed.label.muted_until": "静音至",
+    "form.feed.help.muted_until": "在该日期之前，新文章仍会被抓取，但会被标记为已读且不计入未读数。留空以取消静音。",
+    "form.feed.label.mark_read_after_days": "未读文章在多少天后标记为已读",
+    "form.feed.help.mark_read_after_days": "设为 0 则沿用分类的规则。",
+    "form.feed.label.max_entries": "最大保存文章数",
+    "form.feed.help.max_entries": "已收藏的文章总会保留。使用 0 保留所有文章。",
+    "form.feed.label.overflow_policy": "超出限制的文章",
+    "form.feed.select.overflow_archive": "归档最旧的文章",
+    "form.feed.select.overflow_delete": "删除最旧的文章",
+    "form.feed.label.entry_matching": "识别重新发布的文章",
+    "form.feed.help.entry_matching": "当订阅源更改文章标识符并产生重复文章时使用此选项。",
+    "form.feed.help.image_display": "默认使用分类的设置，其次使用您的偏好设置。",
+    "form.feed.label.summary_words": "仅保留文章的前若干字",
+    "form.feed.help.summary_words": "新文章会被截断为此字数，并附上指向网站的链接。使用 0 保留完整内容。",
+    "form.feed.select.entry_matching_guid": "仅按标识符",
+    "form.feed.select.entry_matching_url": "按标识符或网址",
+    "form.feed.select.entry_matching_title": "按标识符或标题和日期",
+    "form.feed.label.watch_selector": "监视区域（CSS 选择器）",
+    "form.feed.select.open_content": "源内容",
+    "form.feed.select.open_full_content": "网站的完整内容",
+    "form.feed.select.open_original": "原始网站",
+    "form.feed.select.priority_low": "低",
+    "form.feed.select.priority_normal": "普通",
+    "form.feed.select.priority_high": "高",
     "form.feed.label.feed_username": "源用户名",
     "form.feed.label.feed_password": "源密码",
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
     "form.feed.label.scraper_rules": "Scraper 规则",
+    "form.feed.label.scraper_max_pages": "每篇文章的最大页数",
+    "form.feed.help.scraper_max_pages": "获取原始内容时，会追加分成多页的文章的后续页面。使用 1 则只获取第一页。",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.script": "脚本",
     "form.category.label.title": "标题",
+    "form.category.label.mark_read_after_days": "未读文章在多少天后标记为已读",
+    "form.category.help.mark_read_after_days": "适用于此分类中没有自己规则的订阅。设为 0 则保持文章未读。",
+    "form.category.help.image_display": "适用于此分类中没有单独设置的订阅源。默认使用您的偏好设置。",
+    "form.category.label.nsfw": "此分类的订阅源不适合工作场所 (NSFW)",
+    "form.category_share.label.username": "用户名",
+    "form.category_share.help.username": "此用户可以阅读该分类的文章，但不能修改",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
     "form.user.label.admin": "管理员",
+    "form.user.label.email": "邮箱",
+    "form.user.label.invitation": "邀请码",
+    "form.invitation.help.email": "可选，邀请链接将发送到此地址，且仅此地址可以使用",
+    "form.password_reset.help.email": "您在账户设置中填写的邮箱地址",
+    "form.delete_account.help.password": "请输入密码以确认",
     "form.prefs.label.language": "语言",
     "form.prefs.label.timezone": "时区",
     "form.prefs.label.theme": "主题",
     "form.prefs.label.entry_sorting": "内容排序",
+    "form.prefs.label.entries_per_page": "每页文章数",
+    "form.prefs.label.show_read_entries": "在源和分类页面中显示已读文章",
+    "form.prefs.label.show_absolute_time": "显示日期而不是经过的时间",
+    "form.prefs.label.image_display": "文章中的图片",
+    "form.prefs.label.nsfw_display": "不适合工作场所的文章 (NSFW)",
+    "form.prefs.help.nsfw_display": "文章通过其订阅源、分类、分级或标题中的 NSFW 标记进行标识。",
+    "form.prefs.label.junk_threshold": "将垃圾评分至少为此值的文章标记为已读",
+    "form.prefs.help.junk_threshold": "垃圾评分（0 到 100）评估标题党、几乎为空的文章和链接列表。使用 0 禁用。",
+    "form.prefs.select.image_display_show": "显示",
+    "form.prefs.select.image_display_blur": "模糊显示，点击后清晰",
+    "form.prefs.select.image_display_hide": "隐藏并改为显示链接",
+    "form.prefs.select.nsfw_display_show": "显示",
+    "form.prefs.select.nsfw_display_blur": "模糊直到点击",
+    "form.prefs.select.nsfw_display_hide": "隐藏",
+    "form.prefs.select.image_display_inherit": "默认",
+    "form.prefs.label.quiet_hours_start": "免打扰开始时间",
+    "form.prefs.label.quiet_hours_end": "免打扰结束时间",
+    "form.prefs.help.quiet_hours": "免打扰时段内的通知将被暂存，并在结束后一起发送。",
+    "form.prefs.help.email": "忘记密码时用于向您发送链接",
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
     "form.import.label.file": "OPML 文件",
@@ -2519,8 +4844,53 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "保存文章到 Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API 密钥",
+    "form.integration.readeck_activate": "保存文章到 Readeck",
+    "form.integration.readeck_endpoint": "Readeck URL",
+    "form.integration.readeck_api_key": "Readeck API 令牌",
+    "form.integration.readeck_labels": "Readeck 标签（可选，用逗号分隔）",
+    "form.integration.omnivore_activate": "保存文章到 Omnivore",
+    "form.integration.omnivore_endpoint": "Omnivore API 端点（可选）",
+    "form.integration.omnivore_api_key": "Omnivore API 密钥",
+    "form.integration.ntfy_activate": "发送通知到 ntfy",
+    "form.integration.ntfy_url": "ntfy 服务器地址",
+    "form.integration.ntfy_topic": "ntfy 主题",
+    "form.integration.ntfy_token": "ntfy 访问令牌",
+    "form.integration.pushover_activate": "发送通知到 Pushover",
+    "form.integration.pushover_token": "Pushover 应用令牌",
+    "form.integration.pushover_user": "Pushover 用户密钥",
+    "form.integration.apprise_activate": "发送通知到 Apprise",
+    "form.integration.apprise_url": "Apprise API 通知端点",
+    "form.integration.apprise_service_urls": "Apprise 服务地址（可选，以逗号分隔）",
+    "form.integration.kindle_activate": "将文章发送到我的 Kindle",
+    "form.integration.kindle_email": "Send to Kindle 电子邮件地址",
+    "form.integration.kindle_help": "将 %s 添加到您的 Amazon 帐户的已认可电子邮箱列表中。",
+    "form.integration.events": "发送到此服务的事件：",
+    "form.integration.event_starred": "收藏的文章",
+    "form.integration.event_new_entries": "新文章",
+    "form.integration.event_feed_error": "源错误",
+    "form.integration.event_categories": "仅限这些分类的新文章（未选择时为所有分类）",
+    "notification.feed_error": "无法刷新源 %s",
+    "notification.new_entries": [
+        "%d 篇新文章"
+    ],
+    "notification.quiet_hours": [
+        "免打扰期间的 %d 条通知"
+    ],
     "form.submit.loading": "载入中…",
     "form.submit.saving": "保存中…",
+    "form.bulk.selected_entries": "选中的文章：",
+    "form.bulk.select_entry": "选择此文章",
+    "form.bulk.select_feed": "选择此订阅",
+    "form.bulk.move_feeds": "将选中的订阅移动到：",
+    "form.bulk.action.read": "标记为已读",
+    "form.bulk.action.unread": "标记为未读",
+    "form.bulk.action.star": "收藏",
+    "form.bulk.action.unstar": "取消收藏",
+    "form.bulk.action.move": "移动",
+    "form.least_read_feeds.label.months": "未阅读时长",
+    "form.least_read_feeds.months": [
+        "%d 个月"
+    ],
     "time_elapsed.not_yet": "尚未",
     "time_elapsed.yesterday": "昨天",
     "time_elapsed.now": "刚刚",
@@ -2542,6 +4912,13 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d 年前"
     ],
+    "time_format.absolute": "2006-01-02 15:04",
+    "email.verification.subject": "验证您的邮箱地址",
+    "email.verification.body": "%s，您好：\n\n请打开此链接验证您的邮箱并激活您的 Miniflux 账户：\n%s\n\n该链接将在 24 小时后过期。",
+    "email.invitation.subject": "Miniflux 邀请",
+    "email.invitation.body": "%s 邀请您在 Miniflux 上创建账户：\n%s\n\n该邀请将在 7 天后过期。",
+    "email.password_reset.subject": "重置您的密码",
+    "email.password_reset.body": "%s，您好：\n\n请打开此链接为您的 Miniflux 账户设置新密码：\n%s\n\n该链接将在一小时后过期。如果您没有请求重置密码，请忽略此邮件。",
     "This feed already exists (%s)": "源已存在 (%s)",
     "Unable to fetch feed (Status Code = %d)": "无法获取源 (错误代码=%d)",
     "Unable to open this link: %v": "无法打开这一链接: %v",
@@ -2552,6 +4929,9 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "无法解析Atom源: %q",
     "Unable to parse JSON feed: %q": "无法解析JSON源: %q",
     "Unable to parse RDF feed: %q": "无法解析RDF源: %q",
+    "Unable to parse feed: %q": "无法解析源: %q",
+    "Unable to parse web page: %q": "无法解析网页：%q",
+    "The selector %q does not match any element of this web page": "选择器 %q 与此网页的任何元素都不匹配",
     "Unable to normalize encoding: %q": "无法正则化编码: %q",
     "Category not found for this user": "未找到该用户的这一分类",
     "This feed is empty": "该源是空的",
@@ -2559,19 +4939,22 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "无效的SSL证书 (原始错误: %q)",
     "This website is temporarily unreachable (original error: %q)": "该网站暂时不可达 (原始错误: %q)",
     "This website is permanently unreachable (original error: %q)": "该网站永久不可达 (原始错误: %q)",
-    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时"
+    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时",
+    "The certificate of this Gemini capsule has changed since the first visit (fingerprint %s)": "此 Gemini 站点的证书自首次访问以来已更改 (指纹 %s)",
+    "A Tor proxy is required to fetch the .onion websites": "获取 .onion 网站需要 Tor 代理",
+    "The local path %q is not in the allowed directories": "本地路径 %q 不在允许的目录中"
 }
 `,
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "8c8e9f2689d2f548f72ddc7baaff11f8654fbe34d8f8f2e74adada6b9e8197ef",
-	"en_US": "28b26945e8b0a5231e0e1faf475775a6e61e8d551de13a81eb33441e8d0dc78c",
-	"es_ES": "c3afc105ebd3c472e080cc52c3a5a74db257e7a743b0d30399abaf4735e26c89",
-	"fr_FR": "f492529a9f2860dbf85275fe80355546e735c2ef067de93b697b14be5a70d96a",
-	"it_IT": "d87f4625e99c6bbdfe7fca741a3d403918026b619b9c5e00a00aac82cb091947",
-	"nl_NL": "7e711f9100f3935ace0dcbb76d740248da9ab6e6e81b0a985db042947160f915",
-	"pl_PL": "ab6acff9d61748cd1f2d815d5580fa4c02aa52fb9c937220d1b569715cf09f51",
-	"ru_RU": "2d07221418c6188656730eb41af7944f2b347622381835ba08d04ca074283674",
-	"zh_CN": "f7a036f9d51aaaf108921e6ca636fa19d16adf1347dadc40044ff3e58ea0c308",
+	"de_DE": "1290be1c681b072cdd45cb65e573414913c9bac8a540134623237247e2e26491",
+	"en_US": "dc651552bfd93e3f272ba99f7e68d9958d056e1dce3c3e01cefd6a1584b8fcf6",
+	"es_ES": "bb12315d1666b9f8242dd8eea01dd85c58fd11951a6046daf22567a75a2593fa",
+	"fr_FR": "3de74f8c1d3d33c5fc6ab83b3d1bfcb98df3702629bff2098b5d6902c97e01b4",
+	"it_IT": "39bed568c982ac3dce38fe3490a0fd1dd626677e7ad55f53f148e2c3eac7b05d",
+	"nl_NL": "354a9a86fb97c4999e1b62b0b6bf5b500cc6de8f599bf13a191ba381fc7486c4",
+	"pl_PL": "941855dbf960b3bd007a5d692f253ef26fa02c75d2555693ff522b5dd1f83232",
+	"ru_RU": "e374fd75687128ba94e0fd58c02546343bf6afa30f5e69da8b5204367d02fc94",
+	"zh_CN": "b28dd3400af3493aaebf2ed7c6fc048294ce0295e3b0964d2fe387ef13a34cda",
 }
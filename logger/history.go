@@ -0,0 +1,101 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package logger // import "miniflux.app/logger"
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	historySize     = 1000
+	subscriberQueue = 100
+)
+
+// Entry is a log message kept in memory for the live tail of the logs.
+type Entry struct {
+	ID      int64     `json:"id"`
+	Time    time.Time `json:"time"`
+	Level   LogLevel  `json:"level"`
+	Message string    `json:"message"`
+}
+
+// history is a ring buffer of the last messages, the subscribers receive the new ones.
+type history struct {
+	mutex       sync.Mutex
+	entries     []*Entry
+	lastID      int64
+	subscribers map[chan *Entry]bool
+}
+
+var defaultHistory = newHistory(historySize)
+
+func newHistory(size int) *history {
+	return &history{entries: make([]*Entry, size), subscribers: make(map[chan *Entry]bool)}
+}
+
+// add keeps the message and sends it to the subscribers, a slow subscriber misses messages
+// instead of blocking the caller.
+func (h *history) add(level LogLevel, message string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.lastID++
+	entry := &Entry{ID: h.lastID, Time: time.Now(), Level: level, Message: message}
+	h.entries[h.lastID%int64(len(h.entries))] = entry
+
+	for subscriber := range h.subscribers {
+		select {
+		case subscriber <- entry:
+		default:
+		}
+	}
+}
+
+func (h *history) recent(level LogLevel, afterID int64) []*Entry {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var result []*Entry
+	size := int64(len(h.entries))
+	first := afterID + 1
+	if first <= h.lastID-size {
+		first = h.lastID - size + 1
+	}
+
+	for id := first; id <= h.lastID; id++ {
+		if entry := h.entries[id%size]; entry.Level <= level {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+func (h *history) subscribe() (<-chan *Entry, func()) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	subscriber := make(chan *Entry, subscriberQueue)
+	h.subscribers[subscriber] = true
+
+	return subscriber, func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		delete(h.subscribers, subscriber)
+	}
+}
+
+// Recent returns the messages kept in memory with the given level or a more severe one,
+// only the messages logged after the given ID are returned.
+func Recent(level LogLevel, afterID int64) []*Entry {
+	return defaultHistory.recent(level, afterID)
+}
+
+// Subscribe returns a channel receiving the new messages of all levels, the returned function must be
+// called to stop receiving them.
+func Subscribe() (<-chan *Entry, func()) {
+	return defaultHistory.subscribe()
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package logger // import "miniflux.app/logger"
+
+import "testing"
+
+func TestHistoryKeepsLastMessages(t *testing.T) {
+	h := newHistory(3)
+	for _, message := range []string{"a", "b", "c", "d"} {
+		h.add(InfoLevel, message)
+	}
+
+	entries := h.recent(DebugLevel, 0)
+	if len(entries) != 3 {
+		t.Fatalf(`Unexpected number of messages, got %d instead of 3`, len(entries))
+	}
+
+	if entries[0].Message != "b" || entries[2].Message != "d" || entries[2].ID != 4 {
+		t.Errorf(`Unexpected messages, got %q to %q`, entries[0].Message, entries[2].Message)
+	}
+
+	if entries := h.recent(DebugLevel, 3); len(entries) != 1 || entries[0].Message != "d" {
+		t.Errorf(`Only the messages after the given ID should be returned`)
+	}
+}
+
+func TestHistoryFiltersLevel(t *testing.T) {
+	h := newHistory(10)
+	h.add(DebugLevel, "debug")
+	h.add(InfoLevel, "info")
+	h.add(ErrorLevel, "error")
+
+	entries := h.recent(InfoLevel, 0)
+	if len(entries) != 2 || entries[0].Message != "info" || entries[1].Message != "error" {
+		t.Errorf(`The debug messages should be excluded, got %d messages`, len(entries))
+	}
+}
+
+func TestHistorySubscribe(t *testing.T) {
+	h := newHistory(10)
+	entries, cancel := h.subscribe()
+	h.add(ErrorLevel, "error")
+	cancel()
+	h.add(ErrorLevel, "ignored")
+
+	if entry := <-entries; entry.Message != "error" {
+		t.Errorf(`Unexpected message, got %q`, entry.Message)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf(`The messages logged after the cancellation should not be received`)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if level, err := ParseLevel("error"); err != nil || level != ErrorLevel {
+		t.Errorf(`Unexpected level, got %v`, level)
+	}
+
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error(`An invalid level should be rejected`)
+	}
+}
@@ -7,6 +7,7 @@ package logger // import "miniflux.app/logger"
 import (
 	"fmt"
 	"os"
+	"strings"
 )
 
 var requestedLevel = InfoLevel
@@ -43,6 +44,22 @@ func (level LogLevel) String() string {
 	}
 }
 
+// MarshalText returns the name of the level, it is used in the JSON representation of the messages.
+func (level LogLevel) MarshalText() ([]byte, error) {
+	return []byte(level.String()), nil
+}
+
+// ParseLevel returns the level with the given name, the name is not case sensitive.
+func ParseLevel(name string) (LogLevel, error) {
+	for _, level := range []LogLevel{FatalLevel, ErrorLevel, InfoLevel, DebugLevel} {
+		if strings.EqualFold(name, level.String()) {
+			return level, nil
+		}
+	}
+
+	return InfoLevel, fmt.Errorf("Invalid log level %q", name)
+}
+
 // EnableDebug increases logging, more verbose (debug)
 func EnableDebug() {
 	requestedLevel = DebugLevel
@@ -79,6 +96,7 @@ func Fatal(format string, v ...interface{}) {
 }
 
 func formatMessage(level LogLevel, format string, v ...interface{}) {
-	prefix := fmt.Sprintf("[%s] ", level.String())
-	fmt.Fprintf(os.Stderr, prefix+format+"\n", v...)
+	message := fmt.Sprintf(format, v...)
+	defaultHistory.add(level, message)
+	fmt.Fprintf(os.Stderr, "[%s] %s\n", level.String(), message)
 }
@@ -0,0 +1,63 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mailer // import "miniflux.app/mailer"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"miniflux.app/config"
+)
+
+// ErrNotConfigured is returned when no SMTP server is defined.
+var ErrNotConfigured = errors.New("mailer: the SMTP server is not configured")
+
+// Mailer sends emails with an SMTP server.
+type Mailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	send     func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// New returns a Mailer configured with the SMTP settings.
+func New(cfg *config.Config) *Mailer {
+	return &Mailer{
+		addr:     net.JoinHostPort(cfg.SMTPHost(), strconv.Itoa(cfg.SMTPPort())),
+		host:     cfg.SMTPHost(),
+		username: cfg.SMTPUsername(),
+		password: cfg.SMTPPassword(),
+		from:     cfg.MailFrom(),
+		send:     smtp.SendMail,
+	}
+}
+
+// Send delivers the message, the connection is upgraded to TLS when the server supports it.
+func (m *Mailer) Send(message *Message) error {
+	if m.host == "" || m.from == "" {
+		return ErrNotConfigured
+	}
+
+	content, err := message.bytes(m.from)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	if err := m.send(m.addr, auth, m.from, []string{message.To}, content); err != nil {
+		return fmt.Errorf("mailer: unable to send the email to %s: %v", message.To, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mailer // import "miniflux.app/mailer"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+type sentMessage struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   []string
+	msg  []byte
+}
+
+func newTestMailer(sent *sentMessage) *Mailer {
+	return &Mailer{
+		addr:     "smtp.example.org:587",
+		host:     "smtp.example.org",
+		username: "miniflux",
+		password: "secret",
+		from:     "miniflux@example.org",
+		send: func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+			*sent = sentMessage{addr: addr, auth: auth, from: from, to: to, msg: msg}
+			return nil
+		},
+	}
+}
+
+func TestSend(t *testing.T) {
+	var sent sentMessage
+	m := newTestMailer(&sent)
+
+	err := m.Send(&Message{
+		To:          "reader@kindle.com",
+		Subject:     "Café",
+		Body:        "Hello",
+		Attachments: []*Attachment{{Filename: "entry.epub", ContentType: "application/epub+zip", Content: []byte("epub")}},
+	})
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if sent.addr != "smtp.example.org:587" || sent.from != "miniflux@example.org" || len(sent.to) != 1 || sent.to[0] != "reader@kindle.com" {
+		t.Fatalf(`Unexpected envelope: %+v`, sent)
+	}
+
+	if sent.auth == nil {
+		t.Fatal(`The authentication should be enabled`)
+	}
+
+	message, err := mail.ReadMessage(bytes.NewReader(sent.msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if err != nil || subject != "Café" {
+		t.Errorf(`Unexpected subject: %q`, subject)
+	}
+
+	_, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+
+		encoded, _ := ioutil.ReadAll(part)
+		content, err := base64.StdEncoding.DecodeString(strings.Replace(string(encoded), "\r\n", "", -1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, part.FileName()+":"+string(content))
+	}
+
+	if strings.Join(parts, ",") != ":Hello,entry.epub:epub" {
+		t.Errorf(`Unexpected parts: %v`, parts)
+	}
+}
+
+func TestSendWithoutAuthentication(t *testing.T) {
+	var sent sentMessage
+	m := newTestMailer(&sent)
+	m.username = ""
+
+	if err := m.Send(&Message{To: "reader@kindle.com", Subject: "Test"}); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if sent.auth != nil {
+		t.Error(`The authentication should be disabled`)
+	}
+}
+
+func TestSendWithInvalidRecipient(t *testing.T) {
+	var sent sentMessage
+	m := newTestMailer(&sent)
+
+	if err := m.Send(&Message{To: "invalid", Subject: "Test"}); err == nil {
+		t.Fatal(`An invalid recipient should be rejected`)
+	}
+
+	if sent.msg != nil {
+		t.Error(`No email should be sent`)
+	}
+}
+
+func TestSendWhenNotConfigured(t *testing.T) {
+	var sent sentMessage
+	m := newTestMailer(&sent)
+	m.host = ""
+
+	if err := m.Send(&Message{To: "reader@kindle.com"}); err != ErrNotConfigured {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+}
+
+func TestWriteBase64WrapsLines(t *testing.T) {
+	var buffer bytes.Buffer
+	writeBase64(&buffer, bytes.Repeat([]byte("a"), 100))
+
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\r\n") {
+		if len(line) > lineLength {
+			t.Fatalf(`The line is too long: %d characters`, len(line))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mailer // import "miniflux.app/mailer"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Maximum length of the base64 encoded lines.
+const lineLength = 76
+
+// Attachment is a file attached to a message.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Message is a plain text email.
+type Message struct {
+	To          string
+	Subject     string
+	Body        string
+	Attachments []*Attachment
+}
+
+// bytes returns the message in the MIME format.
+func (m *Message) bytes(from string) ([]byte, error) {
+	if _, err := mail.ParseAddress(m.To); err != nil {
+		return nil, fmt.Errorf("mailer: invalid recipient %q: %v", m.To, err)
+	}
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	fmt.Fprintf(&buffer, "From: %s\r\n", from)
+	fmt.Fprintf(&buffer, "To: %s\r\n", m.To)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buffer, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buffer, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buffer, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", writer.Boundary())
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	writeBase64(part, []byte(m.Body))
+
+	for _, attachment := range m.Attachments {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+		writeBase64(part, attachment.Content)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeBase64(w io.Writer, content []byte) {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	var lines []string
+	for len(encoded) > lineLength {
+		lines = append(lines, encoded[:lineLength])
+		encoded = encoded[lineLength:]
+	}
+	lines = append(lines, encoded)
+
+	w.Write([]byte(strings.Join(lines, "\r\n") + "\r\n"))
+}
@@ -0,0 +1,276 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mediaproxy // import "miniflux.app/mediaproxy"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"miniflux.app/blob"
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+)
+
+const (
+	// Larger images are served untouched to protect the server against decompression bombs.
+	maxCompressedPixels = 20 * 1000 * 1000
+
+	encoderTimeout = 30 * time.Second
+)
+
+var errImageTooLarge = errors.New("mediaproxy: image too large to be recompressed")
+
+// Compressor scales down and recompresses proxied images.
+// A nil Compressor serves the original images.
+type Compressor struct {
+	maxWidth int
+	quality  int
+	encoders []*encoder
+}
+
+// encoder converts images with an external command, the Go standard library doesn't provide WebP or AVIF encoders.
+type encoder struct {
+	format      string
+	contentType string
+	command     string
+}
+
+// NewCompressor returns a Compressor, or nil when there is no maximum width and no encoder command.
+// The AVIF command is preferred over the WebP command when the browser accepts both formats.
+func NewCompressor(maxWidth, quality int, avifCommand, webpCommand string) *Compressor {
+	if quality < 1 || quality > 100 {
+		quality = jpeg.DefaultQuality
+	}
+
+	c := &Compressor{maxWidth: maxWidth, quality: quality}
+	if avifCommand != "" {
+		c.encoders = append(c.encoders, &encoder{format: "avif", contentType: "image/avif", command: avifCommand})
+	}
+
+	if webpCommand != "" {
+		c.encoders = append(c.encoders, &encoder{format: "webp", contentType: "image/webp", command: webpCommand})
+	}
+
+	if maxWidth <= 0 && len(c.encoders) == 0 {
+		return nil
+	}
+
+	return c
+}
+
+// Compress returns the recompressed image for a browser sending the given Accept header.
+// The result is kept in the blob store, and the original image is returned when the conversion fails or doesn't save anything.
+func (c *Compressor) Compress(blobs blob.Store, imageURL string, original *Image, accept string) *Image {
+	if c == nil || !isCompressible(original.ContentType) {
+		return original
+	}
+
+	enc := c.encoderFor(accept)
+	if enc == nil && c.maxWidth <= 0 {
+		return original
+	}
+
+	key := fmt.Sprintf("proxy/%s/%s", crypto.Hash(imageURL), c.variant(enc))
+	if blobs != nil {
+		if object, err := blobs.Get(key); err == nil {
+			body, err := ioutil.ReadAll(object)
+			object.Close()
+			if err == nil {
+				return &Image{ContentType: object.ContentType, Content: body}
+			}
+		} else if err != blob.ErrNotFound {
+			logger.Error("[MediaProxy] %v", err)
+		}
+	}
+
+	compressed, err := c.compress(original.Content, enc)
+	if err != nil {
+		logger.Debug("[MediaProxy] Unable to recompress %s: %v", imageURL, err)
+		return original
+	}
+
+	// Small or already optimized images may grow, the original is kept under the variant key to avoid converting them again.
+	if len(compressed.Content) >= len(original.Content) {
+		compressed = original
+	}
+
+	if blobs != nil {
+		if err := blob.Put(blobs, key, compressed.Content, compressed.ContentType); err != nil {
+			logger.Error("[MediaProxy] %v", err)
+		}
+	}
+
+	return compressed
+}
+
+// Vary returns true when the result depends on the Accept header of the browser.
+func (c *Compressor) Vary() bool {
+	return c != nil && len(c.encoders) > 0
+}
+
+func (c *Compressor) encoderFor(accept string) *encoder {
+	for _, enc := range c.encoders {
+		if strings.Contains(accept, enc.contentType) {
+			return enc
+		}
+	}
+
+	return nil
+}
+
+func (c *Compressor) variant(enc *encoder) string {
+	format := "auto"
+	if enc != nil {
+		format = enc.format
+	}
+
+	return fmt.Sprintf("w%d-q%d-%s", c.maxWidth, c.quality, format)
+}
+
+func (c *Compressor) compress(data []byte, enc *encoder) (*Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Width*cfg.Height > maxCompressedPixels {
+		return nil, errImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.maxWidth > 0 && cfg.Width > c.maxWidth {
+		img = resize(img, c.maxWidth)
+	}
+
+	if enc != nil {
+		content, err := enc.encode(img, c.quality)
+		if err == nil {
+			return &Image{ContentType: enc.contentType, Content: content}, nil
+		}
+
+		logger.Error("[MediaProxy] Unable to convert image to %s: %v", enc.format, err)
+	}
+
+	var buffer bytes.Buffer
+	if isOpaque(img) {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: c.quality})
+		return &Image{ContentType: "image/jpeg", Content: buffer.Bytes()}, err
+	}
+
+	err = png.Encode(&buffer, img)
+	return &Image{ContentType: "image/png", Content: buffer.Bytes()}, err
+}
+
+func (e *encoder) encode(img image.Image, quality int) ([]byte, error) {
+	var input bytes.Buffer
+	if err := png.Encode(&input, img); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), encoderTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", e.command)
+	cmd.Stdin = &input
+	cmd.Env = append(os.Environ(), fmt.Sprintf("MINIFLUX_IMAGE_QUALITY=%d", quality))
+
+	// The output goes to a file rather than a pipe, otherwise Wait blocks
+	// until the children started by the shell exit even after the timeout.
+	output, err := ioutil.TempFile("", "miniflux-image")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(output.Name())
+	defer output.Close()
+
+	cmd.Stdout = output
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	content, err := ioutil.ReadFile(output.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return nil, errors.New("mediaproxy: the encoder command returned an empty image")
+	}
+
+	return content, nil
+}
+
+// GIF images are left untouched because the standard library only encodes their first frame.
+func isCompressible(contentType string) bool {
+	switch strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0])) {
+	case "image/jpeg", "image/jpg", "image/png":
+		return true
+	}
+
+	return false
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+
+	return false
+}
+
+// resize scales the image down to the given width with a box filter, keeping the aspect ratio.
+func resize(src image.Image, width int) *image.RGBA {
+	bounds := src.Bounds()
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*bounds.Dy()/height, (y+1)*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			x0, x1 := x*bounds.Dx()/width, (x+1)*bounds.Dx()/width
+
+			var sum [4]uint64
+			for sy := y0; sy < y1; sy++ {
+				i := rgba.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					sum[0] += uint64(rgba.Pix[i])
+					sum[1] += uint64(rgba.Pix[i+1])
+					sum[2] += uint64(rgba.Pix[i+2])
+					sum[3] += uint64(rgba.Pix[i+3])
+					i += 4
+				}
+			}
+
+			count := uint64((y1 - y0) * (x1 - x0))
+			j := dst.PixOffset(x, y)
+			for k := range sum {
+				dst.Pix[j+k] = uint8(sum[k] / count)
+			}
+		}
+	}
+
+	return dst
+}
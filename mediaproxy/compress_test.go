@@ -0,0 +1,141 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mediaproxy // import "miniflux.app/mediaproxy"
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func newTestImage(width, height int, alpha uint8) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: alpha})
+		}
+	}
+
+	return img
+}
+
+func encodeTestImage(t *testing.T, img image.Image, contentType string) *Image {
+	var buffer bytes.Buffer
+	var err error
+	if contentType == "image/png" {
+		err = png.Encode(&buffer, img)
+	} else {
+		err = jpeg.Encode(&buffer, img, &jpeg.Options{Quality: 100})
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Image{ContentType: contentType, Content: buffer.Bytes()}
+}
+
+func TestNewCompressorWithoutOptions(t *testing.T) {
+	if c := NewCompressor(0, 80, "", ""); c != nil {
+		t.Fatal(`A compressor without max width and encoder should be nil`)
+	}
+}
+
+func TestNilCompressorReturnsOriginal(t *testing.T) {
+	var c *Compressor
+	original := &Image{ContentType: "image/png", Content: []byte("data")}
+
+	if result := c.Compress(nil, "https://example.org/image.png", original, "image/webp"); result != original {
+		t.Fatal(`The original image should be returned`)
+	}
+
+	if c.Vary() {
+		t.Fatal(`A nil compressor doesn't depend on the Accept header`)
+	}
+}
+
+func TestResize(t *testing.T) {
+	img := resize(newTestImage(400, 200, 255), 100)
+	if img.Bounds().Dx() != 100 || img.Bounds().Dy() != 50 {
+		t.Fatalf(`Unexpected size, got %v`, img.Bounds())
+	}
+}
+
+func TestCompressScalesDownJPEG(t *testing.T) {
+	original := encodeTestImage(t, newTestImage(400, 300, 255), "image/jpeg")
+	result := NewCompressor(200, 60, "", "").Compress(nil, "https://example.org/image.jpg", original, "image/*")
+
+	if result.ContentType != "image/jpeg" {
+		t.Fatalf(`Unexpected content type, got %q`, result.ContentType)
+	}
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(result.Content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Width != 200 || cfg.Height != 150 {
+		t.Fatalf(`Unexpected size, got %dx%d`, cfg.Width, cfg.Height)
+	}
+}
+
+func TestCompressKeepsTransparency(t *testing.T) {
+	original := encodeTestImage(t, newTestImage(400, 300, 100), "image/png")
+	result := NewCompressor(200, 80, "", "").Compress(nil, "https://example.org/image.png", original, "image/*")
+
+	if result.ContentType != "image/png" {
+		t.Fatalf(`Unexpected content type, got %q`, result.ContentType)
+	}
+}
+
+func TestCompressNeverGrowsImages(t *testing.T) {
+	original := encodeTestImage(t, newTestImage(100, 100, 255), "image/png")
+	result := NewCompressor(200, 80, "", "").Compress(nil, "https://example.org/image.png", original, "image/*")
+
+	if len(result.Content) > len(original.Content) {
+		t.Fatal(`The recompressed image should not be larger than the original`)
+	}
+}
+
+func TestCompressIgnoresGIF(t *testing.T) {
+	original := &Image{ContentType: "image/gif", Content: []byte("GIF89a")}
+	result := NewCompressor(200, 80, "", "").Compress(nil, "https://example.org/image.gif", original, "image/*")
+
+	if result != original {
+		t.Fatal(`GIF images should be served untouched`)
+	}
+}
+
+func TestCompressWithEncoderCommand(t *testing.T) {
+	c := NewCompressor(0, 80, "", `printf 'webp-%s' "$MINIFLUX_IMAGE_QUALITY"`)
+	if !c.Vary() {
+		t.Fatal(`The result should depend on the Accept header`)
+	}
+
+	original := encodeTestImage(t, newTestImage(100, 100, 255), "image/jpeg")
+	result := c.Compress(nil, "https://example.org/image.jpg", original, "image/avif,image/webp,*/*")
+
+	if result.ContentType != "image/webp" || string(result.Content) != "webp-80" {
+		t.Fatalf(`Unexpected result, got %q: %q`, result.ContentType, result.Content)
+	}
+
+	result = c.Compress(nil, "https://example.org/image.jpg", original, "image/png,*/*")
+	if result.ContentType != "image/jpeg" {
+		t.Fatalf(`Browsers without WebP support should receive a JPEG image, got %q`, result.ContentType)
+	}
+}
+
+func TestCompressFallsBackWhenEncoderFails(t *testing.T) {
+	c := NewCompressor(50, 80, "exit 1", "")
+	original := encodeTestImage(t, newTestImage(100, 100, 255), "image/jpeg")
+	result := c.Compress(nil, "https://example.org/image.jpg", original, "image/avif")
+
+	if result.ContentType != "image/jpeg" {
+		t.Fatalf(`Unexpected content type, got %q`, result.ContentType)
+	}
+}
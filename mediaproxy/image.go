@@ -0,0 +1,76 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mediaproxy // import "miniflux.app/mediaproxy"
+
+import (
+	"errors"
+	"io/ioutil"
+
+	"miniflux.app/blob"
+	"miniflux.app/crypto"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+)
+
+// ErrNotFound is returned when the remote server doesn't return the image.
+var ErrNotFound = errors.New("mediaproxy: image not found")
+
+// Image is a downloaded image.
+type Image struct {
+	ContentType string
+	Content     []byte
+}
+
+// FetchImage returns the image from the blob store, or downloads it and keeps a copy when a blob store is configured.
+// The blob store is optional.
+func FetchImage(blobs blob.Store, imageURL string) (*Image, error) {
+	key := "proxy/" + crypto.Hash(imageURL)
+	if blobs != nil {
+		if object, err := blobs.Get(key); err == nil {
+			body, err := ioutil.ReadAll(object)
+			object.Close()
+			if err == nil {
+				return &Image{ContentType: object.ContentType, Content: body}, nil
+			}
+		} else if err != blob.ErrNotFound {
+			logger.Error("[MediaProxy] %v", err)
+		}
+	}
+
+	clt := client.New(imageURL)
+	resp, err := clt.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.HasServerFailure() {
+		return nil, ErrNotFound
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if blobs != nil {
+		if err := blob.Put(blobs, key, body, resp.ContentType); err != nil {
+			logger.Error("[MediaProxy] %v", err)
+		}
+	}
+
+	return &Image{ContentType: resp.ContentType, Content: body}, nil
+}
+
+// Loader returns a function downloading images through the cache, for example to embed them in exported documents.
+func Loader(blobs blob.Store) func(imageURL string) (contentType string, content []byte, err error) {
+	return func(imageURL string) (string, []byte, error) {
+		image, err := FetchImage(blobs, imageURL)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return image.ContentType, image.Content, nil
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package metric // import "miniflux.app/metric"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// Collector serves the metrics endpoint.
+type Collector struct {
+	store *storage.Storage
+}
+
+// NewCollector returns a new metrics collector.
+func NewCollector(store *storage.Storage) *Collector {
+	return &Collector{store: store}
+}
+
+// ServeHTTP writes the metrics to the response.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stats, err := c.store.TableStats(r.Context(), 0)
+	if err != nil {
+		logger.Error("[Metric] %v", err)
+		http.Error(w, "Unable to collect metrics", http.StatusInternalServerError)
+		return
+	}
+
+	backlog, err := c.store.OutboxBacklog(r.Context())
+	if err != nil {
+		logger.Error("[Metric] %v", err)
+		http.Error(w, "Unable to collect metrics", http.StatusInternalServerError)
+		return
+	}
+
+	var b bytes.Buffer
+	WriteTableStats(&b, stats)
+	WriteOutboxBacklog(&b, backlog)
+	WriteQueryStats(&b, c.store.QueryStats())
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.Write(b.Bytes())
+}
+
+// WriteTableStats writes the database table statistics in the Prometheus text format.
+func WriteTableStats(w io.Writer, stats model.TableStatsList) {
+	writeGauge(w, "miniflux_db_table_live_tuples", "Estimated number of live rows.", stats, func(t *model.TableStats) (float64, bool) {
+		return float64(t.LiveTuples), true
+	})
+
+	writeGauge(w, "miniflux_db_table_dead_tuples", "Estimated number of dead rows.", stats, func(t *model.TableStats) (float64, bool) {
+		return float64(t.DeadTuples), true
+	})
+
+	writeGauge(w, "miniflux_db_table_bloat_ratio", "Proportion of dead rows in the table.", stats, func(t *model.TableStats) (float64, bool) {
+		return t.BloatRatio(), true
+	})
+
+	writeGauge(w, "miniflux_db_table_size_bytes", "Total size of the table including indexes and TOAST data.", stats, func(t *model.TableStats) (float64, bool) {
+		return float64(t.TotalSize), true
+	})
+
+	writeGauge(w, "miniflux_db_table_last_vacuum_timestamp_seconds", "Time of the last vacuum of the table.", stats, func(t *model.TableStats) (float64, bool) {
+		if t.LastVacuum == nil {
+			return 0, false
+		}
+		return float64(t.LastVacuum.Unix()), true
+	})
+
+	writeGauge(w, "miniflux_db_table_last_analyze_timestamp_seconds", "Time of the last analyze of the table.", stats, func(t *model.TableStats) (float64, bool) {
+		if t.LastAnalyze == nil {
+			return 0, false
+		}
+		return float64(t.LastAnalyze.Unix()), true
+	})
+}
+
+// WriteOutboxBacklog writes the number of Pub/Sub events waiting in the outbox in the Prometheus text format.
+func WriteOutboxBacklog(w io.Writer, backlog *model.OutboxBacklog) {
+	fmt.Fprintf(w, "# HELP miniflux_pubsub_outbox_events Number of events that could not be published.\n")
+	fmt.Fprintf(w, "# TYPE miniflux_pubsub_outbox_events gauge\n")
+	fmt.Fprintf(w, "miniflux_pubsub_outbox_events{status=%q} %d\n", model.OutboxStatusFailed, backlog.Failed)
+	fmt.Fprintf(w, "miniflux_pubsub_outbox_events{status=%q} %d\n", model.OutboxStatusDiscarded, backlog.Discarded)
+
+	if backlog.OldestEvent != nil {
+		fmt.Fprintf(w, "# HELP miniflux_pubsub_outbox_oldest_event_timestamp_seconds Time of the oldest failed event.\n")
+		fmt.Fprintf(w, "# TYPE miniflux_pubsub_outbox_oldest_event_timestamp_seconds gauge\n")
+		fmt.Fprintf(w, "miniflux_pubsub_outbox_oldest_event_timestamp_seconds %d\n", backlog.OldestEvent.Unix())
+	}
+}
+
+// WriteQueryStats writes the duration of the database queries in the Prometheus text format.
+func WriteQueryStats(w io.Writer, stats *model.QueryStats) {
+	fmt.Fprintf(w, "# HELP miniflux_db_query_duration_seconds Duration of the database queries, the quantiles cover the last queries.\n")
+	fmt.Fprintf(w, "# TYPE miniflux_db_query_duration_seconds summary\n")
+	for _, quantile := range []float64{0.5, 0.9, 0.99} {
+		fmt.Fprintf(w, "miniflux_db_query_duration_seconds{quantile=\"%g\"} %g\n", quantile, stats.Quantile(quantile).Seconds())
+	}
+	fmt.Fprintf(w, "miniflux_db_query_duration_seconds_sum %g\n", stats.Total.Seconds())
+	fmt.Fprintf(w, "miniflux_db_query_duration_seconds_count %d\n", stats.Count)
+
+	fmt.Fprintf(w, "# HELP miniflux_db_slow_queries_total Number of queries above the slow query threshold.\n")
+	fmt.Fprintf(w, "# TYPE miniflux_db_slow_queries_total counter\n")
+	fmt.Fprintf(w, "miniflux_db_slow_queries_total %d\n", stats.SlowCount)
+}
+
+func writeGauge(w io.Writer, name, help string, stats model.TableStatsList, value func(*model.TableStats) (float64, bool)) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+
+	for _, table := range stats {
+		if v, ok := value(table); ok {
+			fmt.Fprintf(w, "%s{table=%q} %g\n", name, table.Name, v)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package metric // import "miniflux.app/metric"
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestWriteTableStats(t *testing.T) {
+	vacuumedAt := time.Unix(1546300800, 0)
+	stats := model.TableStatsList{
+		{Name: "entries", LiveTuples: 300, DeadTuples: 100, TotalSize: 4096, LastVacuum: &vacuumedAt},
+		{Name: "feeds", LiveTuples: 10},
+	}
+
+	var b bytes.Buffer
+	WriteTableStats(&b, stats)
+	output := b.String()
+
+	expected := []string{
+		"# TYPE miniflux_db_table_live_tuples gauge\n",
+		`miniflux_db_table_live_tuples{table="entries"} 300` + "\n",
+		`miniflux_db_table_dead_tuples{table="entries"} 100` + "\n",
+		`miniflux_db_table_bloat_ratio{table="entries"} 0.25` + "\n",
+		`miniflux_db_table_bloat_ratio{table="feeds"} 0` + "\n",
+		`miniflux_db_table_size_bytes{table="entries"} 4096` + "\n",
+		`miniflux_db_table_last_vacuum_timestamp_seconds{table="entries"} 1.5463008e+09` + "\n",
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(output, line) {
+			t.Errorf(`Missing line %q in output:\n%s`, line, output)
+		}
+	}
+
+	if strings.Contains(output, `miniflux_db_table_last_vacuum_timestamp_seconds{table="feeds"}`) {
+		t.Error(`Tables never vacuumed should not have a timestamp`)
+	}
+
+	if strings.Contains(output, `miniflux_db_table_last_analyze_timestamp_seconds{`) {
+		t.Error(`Tables never analyzed should not have a timestamp`)
+	}
+}
+
+func TestWriteOutboxBacklog(t *testing.T) {
+	oldestEvent := time.Unix(1546300800, 0)
+
+	var b bytes.Buffer
+	WriteOutboxBacklog(&b, &model.OutboxBacklog{Failed: 3, Discarded: 1, OldestEvent: &oldestEvent})
+	output := b.String()
+
+	expected := []string{
+		"# TYPE miniflux_pubsub_outbox_events gauge\n",
+		`miniflux_pubsub_outbox_events{status="failed"} 3` + "\n",
+		`miniflux_pubsub_outbox_events{status="discarded"} 1` + "\n",
+		"miniflux_pubsub_outbox_oldest_event_timestamp_seconds 1546300800\n",
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(output, line) {
+			t.Errorf(`Missing line %q in output:\n%s`, line, output)
+		}
+	}
+}
+
+func TestWriteEmptyOutboxBacklog(t *testing.T) {
+	var b bytes.Buffer
+	WriteOutboxBacklog(&b, &model.OutboxBacklog{})
+	output := b.String()
+
+	if !strings.Contains(output, `miniflux_pubsub_outbox_events{status="failed"} 0`) {
+		t.Errorf(`The failed events should be reported:\n%s`, output)
+	}
+
+	if strings.Contains(output, "miniflux_pubsub_outbox_oldest_event_timestamp_seconds") {
+		t.Error(`An empty outbox should not have a timestamp`)
+	}
+}
+
+func TestWriteQueryStats(t *testing.T) {
+	stats := &model.QueryStats{Count: 42, SlowCount: 2, Total: 3 * time.Second}
+	for i := 1; i <= 100; i++ {
+		stats.Recent = append(stats.Recent, time.Duration(i)*time.Millisecond)
+	}
+
+	var b bytes.Buffer
+	WriteQueryStats(&b, stats)
+	output := b.String()
+
+	expected := []string{
+		"# TYPE miniflux_db_query_duration_seconds summary\n",
+		`miniflux_db_query_duration_seconds{quantile="0.5"} 0.05` + "\n",
+		`miniflux_db_query_duration_seconds{quantile="0.9"} 0.09` + "\n",
+		`miniflux_db_query_duration_seconds{quantile="0.99"} 0.099` + "\n",
+		"miniflux_db_query_duration_seconds_sum 3\n",
+		"miniflux_db_query_duration_seconds_count 42\n",
+		"miniflux_db_slow_queries_total 2\n",
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(output, line) {
+			t.Errorf(`Missing line %q in output:\n%s`, line, output)
+		}
+	}
+}
@@ -7,14 +7,20 @@ package model // import "miniflux.app/model"
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Category represents a category in the system.
 type Category struct {
-	ID        int64  `json:"id,omitempty"`
-	Title     string `json:"title,omitempty"`
-	UserID    int64  `json:"user_id,omitempty"`
-	FeedCount int    `json:"nb_feeds,omitempty"`
+	ID                int64      `json:"id,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	UserID            int64      `json:"user_id,omitempty"`
+	MarkReadAfterDays int        `json:"mark_read_after_days"`
+	ImageDisplay      string     `json:"image_display"`
+	NSFW              bool       `json:"nsfw"`
+	FeedCount         int        `json:"nb_feeds,omitempty"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+	Version           int        `json:"version,omitempty"`
 }
 
 func (c *Category) String() string {
@@ -31,6 +37,14 @@ func (c Category) ValidateCategoryCreation() error {
 		return errors.New("The userID is mandatory")
 	}
 
+	if c.MarkReadAfterDays < 0 {
+		return errors.New("The number of days before marking entries as read cannot be negative")
+	}
+
+	if err := ValidateImageDisplay(c.ImageDisplay); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -44,6 +58,14 @@ func (c Category) ValidateCategoryModification() error {
 		return errors.New("The userID is mandatory")
 	}
 
+	if c.MarkReadAfterDays < 0 {
+		return errors.New("The number of days before marking entries as read cannot be negative")
+	}
+
+	if err := ValidateImageDisplay(c.ImageDisplay); err != nil {
+		return err
+	}
+
 	if c.ID <= 0 {
 		return errors.New("The ID is mandatory")
 	}
@@ -0,0 +1,27 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// CategoryShare gives another user a read-only access to a category.
+type CategoryShare struct {
+	CategoryID int64     `json:"category_id"`
+	UserID     int64     `json:"user_id"`
+	Username   string    `json:"username"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// CategoryShares is a list of category shares.
+type CategoryShares []*CategoryShare
+
+// SharedCategory is a category of another user that can be read by the current user.
+type SharedCategory struct {
+	Category      *Category `json:"category"`
+	OwnerUsername string    `json:"owner_username"`
+}
+
+// SharedCategories is a list of shared categories.
+type SharedCategories []*SharedCategory
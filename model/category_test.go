@@ -26,6 +26,16 @@ func TestValidateCategoryCreation(t *testing.T) {
 	if err := category.ValidateCategoryCreation(); err != nil {
 		t.Error(`All required fields are filled, it should not generate any error`)
 	}
+
+	category = &Category{Title: "Test", UserID: 42, MarkReadAfterDays: -1}
+	if err := category.ValidateCategoryCreation(); err == nil {
+		t.Error(`A negative number of days before marking entries as read should generate an error`)
+	}
+
+	category = &Category{Title: "Test", UserID: 42, ImageDisplay: "invalid"}
+	if err := category.ValidateCategoryCreation(); err == nil {
+		t.Error(`An invalid image display mode should generate an error`)
+	}
 }
 
 func TestValidateCategoryModification(t *testing.T) {
@@ -21,23 +21,35 @@ const (
 	DefaultSortingDirection = "asc"
 )
 
+// DefaultRelatedEntries is the number of related entries returned when no limit is given.
+const DefaultRelatedEntries = 10
+
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
-	Category    *Category     `json:"category,omitempty"`
+	ID           int64         `json:"id"`
+	UserID       int64         `json:"user_id"`
+	FeedID       int64         `json:"feed_id"`
+	Status       string        `json:"status"`
+	Hash         string        `json:"hash"`
+	Title        string        `json:"title"`
+	URL          string        `json:"url"`
+	CommentsURL  string        `json:"comments_url"`
+	Date         time.Time     `json:"published_at"`
+	DateRelative string        `json:"published_at_relative,omitempty"`
+	DateAbsolute string        `json:"published_at_absolute,omitempty"`
+	ChangedAt    time.Time     `json:"changed_at"`
+	ReadAt       *time.Time    `json:"read_at,omitempty"`
+	Content      string        `json:"content"`
+	Summary      string        `json:"summary"`
+	Author       string        `json:"author"`
+	Starred      bool          `json:"starred"`
+	Score        float64       `json:"score"`
+	JunkScore    int           `json:"junk_score"`
+	NSFW         bool          `json:"nsfw"`
+	ClusterID    int64         `json:"cluster_id"`
+	Enclosures   EnclosureList `json:"enclosures,omitempty"`
+	Feed         *Feed         `json:"feed,omitempty"`
+	Category     *Category     `json:"category,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -71,6 +83,26 @@ func (e Entry) GetEnclosuresFromContent() EnclosureList {
 	return list
 }
 
+// Links returns the distinct absolute links found in the entry content.
+func (e Entry) Links() []string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(e.Content))
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	links := make([]string, 0)
+	doc.Find("a[href]").Each(func(i int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		if (strings.HasPrefix(href, "http://") || strings.HasPrefix(href, "https://")) && !seen[href] {
+			seen[href] = true
+			links = append(links, href)
+		}
+	})
+
+	return links
+}
+
 // ValidateEntryStatus makes sure the entry status is valid.
 func ValidateEntryStatus(status string) error {
 	switch status {
@@ -84,11 +116,11 @@ func ValidateEntryStatus(status string) error {
 // ValidateEntryOrder makes sure the sorting order is valid.
 func ValidateEntryOrder(order string) error {
 	switch order {
-	case "id", "status", "published_at", "category_title", "category_id":
+	case "id", "status", "published_at", "read_at", "category_title", "category_id", "feed_title", "score", "random":
 		return nil
 	}
 
-	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "published_at", "category_title", "category_id"`)
+	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "published_at", "read_at", "category_title", "category_id", "feed_title", "score", "random"`)
 }
 
 // ValidateDirection makes sure the sorting direction is valid.
@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+)
+
+// Entry grouping modes.
+const (
+	EntryGroupingDay   = "day"
+	EntryGroupingStory = "story"
+)
+
+// EntryDay represents the number of entries published on a day in the user timezone.
+type EntryDay struct {
+	Day   string `json:"day"`
+	Count int    `json:"count"`
+}
+
+// EntryDays represents a list of days.
+type EntryDays []*EntryDay
+
+// ValidateEntryGrouping makes sure the entries can be grouped with the given mode and sorting order.
+func ValidateEntryGrouping(grouping, order string) error {
+	switch grouping {
+	case "":
+		return nil
+	case EntryGroupingDay:
+		if order != "published_at" {
+			return errors.New(`Entries grouped by day must be sorted by "published_at"`)
+		}
+		return nil
+	case EntryGroupingStory:
+		return nil
+	}
+
+	return errors.New(`Invalid entry grouping, valid values are: "day" and "story"`)
+}
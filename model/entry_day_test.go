@@ -0,0 +1,29 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateEntryGrouping(t *testing.T) {
+	if err := ValidateEntryGrouping("", "score"); err != nil {
+		t.Errorf(`Entries without grouping should accept any order: %v`, err)
+	}
+
+	if err := ValidateEntryGrouping("day", "published_at"); err != nil {
+		t.Errorf(`Grouping by day should be valid: %v`, err)
+	}
+
+	if err := ValidateEntryGrouping("day", "score"); err == nil {
+		t.Error(`Grouping by day should require the publication date order`)
+	}
+
+	if err := ValidateEntryGrouping("story", "score"); err != nil {
+		t.Errorf(`Grouping by story should accept any order: %v`, err)
+	}
+
+	if err := ValidateEntryGrouping("week", "published_at"); err == nil {
+		t.Error(`An unknown grouping should be rejected`)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"miniflux.app/errors"
+)
+
+// Entry matchings define how an entry republished with a new GUID is recognized as an existing one.
+const (
+	EntryMatchingGUID  = "guid"
+	EntryMatchingURL   = "url"
+	EntryMatchingTitle = "title"
+)
+
+// RepublishedEntryWindow is the maximum difference between the dates of two entries matched by title.
+const RepublishedEntryWindow = 48 * time.Hour
+
+// EntryMatchings returns the list of available entry matchings and their translation keys.
+func EntryMatchings() map[string]string {
+	return map[string]string{
+		EntryMatchingGUID:  "form.feed.select.entry_matching_guid",
+		EntryMatchingURL:   "form.feed.select.entry_matching_url",
+		EntryMatchingTitle: "form.feed.select.entry_matching_title",
+	}
+}
+
+// ValidateEntryMatching validates entry matching value.
+func ValidateEntryMatching(matching string) error {
+	if _, found := EntryMatchings()[matching]; !found {
+		return errors.NewLocalizedError("Invalid entry matching")
+	}
+
+	return nil
+}
+
+// IsRepublishedAs returns true if the other entry is the same article published again with a different hash.
+func (e *Entry) IsRepublishedAs(other *Entry, matching string) bool {
+	if e.Hash == other.Hash {
+		return false
+	}
+
+	switch matching {
+	case EntryMatchingURL:
+		return e.URL != "" && normalizeEntryURL(e.URL) == normalizeEntryURL(other.URL)
+	case EntryMatchingTitle:
+		title := normalizeEntryTitle(e.Title)
+		if title == "" || title != normalizeEntryTitle(other.Title) {
+			return false
+		}
+
+		delta := e.Date.Sub(other.Date)
+		if delta < 0 {
+			delta = -delta
+		}
+		return delta <= RepublishedEntryWindow
+	}
+
+	return false
+}
+
+// normalizeEntryURL ignores the fragment and the trailing slash that some feeds add or remove between updates.
+func normalizeEntryURL(url string) string {
+	if i := strings.Index(url, "#"); i >= 0 {
+		url = url[:i]
+	}
+	return strings.TrimSuffix(url, "/")
+}
+
+// normalizeEntryTitle ignores the case, the punctuation and the spacing of a title.
+func normalizeEntryTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
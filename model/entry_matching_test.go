@@ -0,0 +1,83 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateEntryMatching(t *testing.T) {
+	for _, matching := range []string{"guid", "url", "title"} {
+		if err := ValidateEntryMatching(matching); err != nil {
+			t.Error(`A valid entry matching should not generate any error`)
+		}
+	}
+
+	for _, matching := range []string{"", "content"} {
+		if err := ValidateEntryMatching(matching); err == nil {
+			t.Errorf(`An invalid entry matching should generate an error: %q`, matching)
+		}
+	}
+}
+
+func TestIsRepublishedAsWithGUIDMatching(t *testing.T) {
+	entry := &Entry{Hash: "a", URL: "http://example.org/1", Title: "Title"}
+	other := &Entry{Hash: "b", URL: "http://example.org/1", Title: "Title"}
+
+	if entry.IsRepublishedAs(other, EntryMatchingGUID) {
+		t.Error(`Entries with a different hash should not match with the guid matching`)
+	}
+}
+
+func TestIsRepublishedAsWithURLMatching(t *testing.T) {
+	entry := &Entry{Hash: "a", URL: "http://example.org/post/1/"}
+
+	scenarios := map[string]bool{
+		"http://example.org/post/1/":         true,
+		"http://example.org/post/1":          true,
+		"http://example.org/post/1#comments": true,
+		"http://example.org/post/2":          false,
+		"":                                   false,
+	}
+
+	for url, expected := range scenarios {
+		if result := entry.IsRepublishedAs(&Entry{Hash: "b", URL: url}, EntryMatchingURL); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, url, result, expected)
+		}
+	}
+
+	if entry.IsRepublishedAs(&Entry{Hash: "a", URL: entry.URL}, EntryMatchingURL) {
+		t.Error(`An entry with the same hash is not republished`)
+	}
+
+	if (&Entry{Hash: "a"}).IsRepublishedAs(&Entry{Hash: "b"}, EntryMatchingURL) {
+		t.Error(`Entries without URL should not match`)
+	}
+}
+
+func TestIsRepublishedAsWithTitleMatching(t *testing.T) {
+	date := time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+	entry := &Entry{Hash: "a", Title: "Go 1.12 is released!", Date: date}
+
+	scenarios := []struct {
+		title    string
+		date     time.Time
+		expected bool
+	}{
+		{"Go 1.12 is released!", date, true},
+		{"go 1 12 is  released", date.Add(time.Hour), true},
+		{"Go 1.12 is released", date.Add(-47 * time.Hour), true},
+		{"Go 1.12 is released", date.Add(72 * time.Hour), false},
+		{"Go 1.13 is released", date, false},
+	}
+
+	for _, scenario := range scenarios {
+		other := &Entry{Hash: "b", Title: scenario.title, Date: scenario.date}
+		if result := entry.IsRepublishedAs(other, EntryMatchingTitle); result != scenario.expected {
+			t.Errorf(`Unexpected result for %q at %v, got %v instead of %v`, scenario.title, scenario.date, result, scenario.expected)
+		}
+	}
+}
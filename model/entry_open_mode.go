@@ -0,0 +1,32 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// Entry open modes define what is displayed when an entry of a feed is opened.
+const (
+	EntryOpenModeContent     = "content"
+	EntryOpenModeFullContent = "full_content"
+	EntryOpenModeOriginal    = "original"
+)
+
+// EntryOpenModes returns the list of available entry open modes and their translation keys.
+func EntryOpenModes() map[string]string {
+	return map[string]string{
+		EntryOpenModeContent:     "form.feed.select.open_content",
+		EntryOpenModeFullContent: "form.feed.select.open_full_content",
+		EntryOpenModeOriginal:    "form.feed.select.open_original",
+	}
+}
+
+// ValidateEntryOpenMode validates entry open mode value.
+func ValidateEntryOpenMode(mode string) error {
+	if _, found := EntryOpenModes()[mode]; !found {
+		return errors.NewLocalizedError("Invalid entry open mode")
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateEntryOpenMode(t *testing.T) {
+	for _, mode := range []string{"content", "full_content", "original"} {
+		if err := ValidateEntryOpenMode(mode); err != nil {
+			t.Error(`A valid entry open mode should not generate any error`)
+		}
+	}
+
+	for _, mode := range []string{"", "invalid"} {
+		if err := ValidateEntryOpenMode(mode); err == nil {
+			t.Errorf(`An invalid entry open mode should generate an error: %q`, mode)
+		}
+	}
+}
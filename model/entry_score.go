@@ -0,0 +1,14 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// EntryScore represents the score given to an entry, usually by an external ranking model.
+type EntryScore struct {
+	EntryID int64   `json:"entry_id"`
+	Score   float64 `json:"score"`
+}
+
+// EntryScores represents a list of entry scores.
+type EntryScores []*EntryScore
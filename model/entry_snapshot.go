@@ -0,0 +1,18 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// EntrySnapshot is a sanitized copy of the web page of a starred entry,
+// kept to read the article after the original page disappears.
+type EntrySnapshot struct {
+	EntryID   int64     `json:"entry_id"`
+	URL       string    `json:"url"`
+	Size      int       `json:"size"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Content   string    `json:"content"`
+}
@@ -19,7 +19,7 @@ func TestValidateEntryStatus(t *testing.T) {
 }
 
 func TestValidateEntryOrder(t *testing.T) {
-	for _, status := range []string{"id", "status", "published_at", "category_title", "category_id"} {
+	for _, status := range []string{"id", "status", "published_at", "read_at", "category_title", "category_id", "feed_title", "score", "random"} {
 		if err := ValidateEntryOrder(status); err != nil {
 			t.Error(`A valid order should not generate any error`)
 		}
@@ -69,3 +69,16 @@ func TestGetOppositeDirection(t *testing.T) {
 		t.Errorf(`An invalid direction should return "asc"`)
 	}
 }
+
+func TestEntryLinks(t *testing.T) {
+	entry := Entry{Content: `<p><a href="https://example.org/a">A</a> <a href="/relative">B</a> <a href="https://example.org/a">A</a> <a href="http://example.org/c">C</a></p>`}
+	links := entry.Links()
+
+	if len(links) != 2 {
+		t.Fatalf(`Wrong number of links, got %d instead of 2: %v`, len(links), links)
+	}
+
+	if links[0] != "https://example.org/a" || links[1] != "http://example.org/c" {
+		t.Errorf(`Unexpected links: %v`, links)
+	}
+}
@@ -8,30 +8,51 @@ import (
 	"fmt"
 	"time"
 
+	"miniflux.app/errors"
 	"miniflux.app/http/client"
 )
 
+// SavedPagesFeedURL identifies the virtual feed that holds the web pages saved by a user.
+const SavedPagesFeedURL = "miniflux:saved-pages"
+
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
+	ID                 int64      `json:"id"`
+	UserID             int64      `json:"user_id"`
+	FeedURL            string     `json:"feed_url"`
+	SiteURL            string     `json:"site_url"`
+	Title              string     `json:"title"`
+	CheckedAt          time.Time  `json:"checked_at"`
+	EtagHeader         string     `json:"etag_header"`
+	LastModifiedHeader string     `json:"last_modified_header"`
+	ParsingErrorMsg    string     `json:"parsing_error_message"`
+	ParsingErrorCount  int        `json:"parsing_error_count"`
+	ScraperRules       string     `json:"scraper_rules"`
+	RewriteRules       string     `json:"rewrite_rules"`
+	Script             string     `json:"script"`
+	Crawler            bool       `json:"crawler"`
+	ScraperMaxPages    int        `json:"scraper_max_pages"`
+	EntryOpenMode      string     `json:"entry_open_mode"`
+	ImageDisplay       string     `json:"image_display"`
+	SummaryWords       int        `json:"summary_words"`
+	SummarizeEntries   bool       `json:"summarize_entries"`
+	NSFW               bool       `json:"nsfw"`
+	Priority           string     `json:"priority"`
+	MutedUntil         *time.Time `json:"muted_until"`
+	MarkReadAfterDays  int        `json:"mark_read_after_days"`
+	MaxEntries         int        `json:"max_entries"`
+	OverflowPolicy     string     `json:"overflow_policy"`
+	EntryMatching      string     `json:"entry_matching"`
+	WatchSelector      string     `json:"watch_selector"`
+	UserAgent          string     `json:"user_agent"`
+	Username           string     `json:"username"`
+	Password           string     `json:"password"`
+	Category           *Category  `json:"category,omitempty"`
+	Entries            Entries    `json:"entries,omitempty"`
+	Icon               *FeedIcon  `json:"icon"`
+	DeletedAt          *time.Time `json:"deleted_at,omitempty"`
+	Pending            bool       `json:"pending"`
+	Version            int        `json:"version"`
 }
 
 func (f *Feed) String() string {
@@ -45,6 +66,54 @@ func (f *Feed) String() string {
 	)
 }
 
+// IsSavedPages returns true if the feed is the virtual feed of saved web pages, it is never refreshed.
+func (f *Feed) IsSavedPages() bool {
+	return f.FeedURL == SavedPagesFeedURL
+}
+
+// IsPageWatch returns true if the feed watches a region of a web page instead of parsing a feed.
+func (f *Feed) IsPageWatch() bool {
+	return f.WatchSelector != ""
+}
+
+// ValidateMarkReadAfterDays validates the number of days after which unread entries are marked as read, zero disables the rule.
+func ValidateMarkReadAfterDays(days int) error {
+	if days < 0 {
+		return errors.NewLocalizedError("Invalid number of days before marking entries as read")
+	}
+
+	return nil
+}
+
+// MaxScraperPages is the maximum number of pages of an article followed by the scraper.
+const MaxScraperPages = 10
+
+// ValidateScraperMaxPages validates the number of pages of an article followed by the scraper, one disables the pagination.
+func ValidateScraperMaxPages(pages int) error {
+	if pages < 1 || pages > MaxScraperPages {
+		return errors.NewLocalizedError("The number of pages must be between 1 and %d", MaxScraperPages)
+	}
+
+	return nil
+}
+
+// MaxSummaryWords is the maximum number of words kept in the summary of the entries of a feed.
+const MaxSummaryWords = 1000
+
+// ValidateSummaryWords validates the number of words kept in the summary of entries, zero keeps the full content.
+func ValidateSummaryWords(words int) error {
+	if words < 0 || words > MaxSummaryWords {
+		return errors.NewLocalizedError("The number of words must be between 0 and %d", MaxSummaryWords)
+	}
+
+	return nil
+}
+
+// IsMuted returns true if new entries of the feed are marked as read until the mute expires.
+func (f *Feed) IsMuted() bool {
+	return f.MutedUntil != nil && f.MutedUntil.After(time.Now())
+}
+
 // WithClientResponse updates feed attributes from an HTTP request.
 func (f *Feed) WithClientResponse(response *client.Response) {
 	f.EtagHeader = response.ETag
@@ -0,0 +1,34 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// Overflow policies define what happens to the oldest entries of a feed above its entry limit.
+const (
+	OverflowPolicyArchive = "archive"
+	OverflowPolicyDelete  = "delete"
+)
+
+// OverflowPolicies returns the list of available overflow policies and their translation keys.
+func OverflowPolicies() map[string]string {
+	return map[string]string{
+		OverflowPolicyArchive: "form.feed.select.overflow_archive",
+		OverflowPolicyDelete:  "form.feed.select.overflow_delete",
+	}
+}
+
+// ValidateEntryLimit validates the maximum number of entries of a feed and its overflow policy, zero means no limit.
+func ValidateEntryLimit(maxEntries int, policy string) error {
+	if maxEntries < 0 {
+		return errors.NewLocalizedError("Invalid maximum number of entries")
+	}
+
+	if _, found := OverflowPolicies()[policy]; !found {
+		return errors.NewLocalizedError("Invalid overflow policy")
+	}
+
+	return nil
+}
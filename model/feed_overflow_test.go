@@ -0,0 +1,29 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateEntryLimit(t *testing.T) {
+	for _, policy := range []string{"archive", "delete"} {
+		if err := ValidateEntryLimit(200, policy); err != nil {
+			t.Errorf(`A valid overflow policy should not generate any error: %q`, policy)
+		}
+	}
+
+	if err := ValidateEntryLimit(0, "archive"); err != nil {
+		t.Error(`A feed without limit should not generate any error`)
+	}
+
+	if err := ValidateEntryLimit(-1, "archive"); err == nil {
+		t.Error(`A negative limit should generate an error`)
+	}
+
+	for _, policy := range []string{"", "truncate"} {
+		if err := ValidateEntryLimit(200, policy); err == nil {
+			t.Errorf(`An invalid overflow policy should generate an error: %q`, policy)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// Feed priorities define which entries surface first in the unread stream.
+const (
+	FeedPriorityLow    = "low"
+	FeedPriorityNormal = "normal"
+	FeedPriorityHigh   = "high"
+)
+
+// FeedPriorities returns the list of available feed priorities and their translation keys.
+func FeedPriorities() map[string]string {
+	return map[string]string{
+		FeedPriorityLow:    "form.feed.select.priority_low",
+		FeedPriorityNormal: "form.feed.select.priority_normal",
+		FeedPriorityHigh:   "form.feed.select.priority_high",
+	}
+}
+
+// ValidateFeedPriority validates feed priority value.
+func ValidateFeedPriority(priority string) error {
+	if _, found := FeedPriorities()[priority]; !found {
+		return errors.NewLocalizedError("Invalid feed priority")
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateFeedPriority(t *testing.T) {
+	for _, priority := range []string{"low", "normal", "high"} {
+		if err := ValidateFeedPriority(priority); err != nil {
+			t.Error(`A valid feed priority should not generate any error`)
+		}
+	}
+
+	for _, priority := range []string{"", "urgent"} {
+		if err := ValidateFeedPriority(priority); err == nil {
+			t.Errorf(`An invalid feed priority should generate an error: %q`, priority)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// FeedResponse represents a feed document archived to debug parsing errors.
+type FeedResponse struct {
+	ID           int64     `json:"id"`
+	FeedID       int64     `json:"feed_id"`
+	URL          string    `json:"url"`
+	StatusCode   int       `json:"status_code"`
+	ContentType  string    `json:"content_type"`
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"last_modified"`
+	Size         int       `json:"size"`
+	CreatedAt    time.Time `json:"created_at"`
+	Content      []byte    `json:"-"`
+}
+
+// FeedResponses represents a list of archived feed responses.
+type FeedResponses []*FeedResponse
@@ -0,0 +1,48 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// FeedStatsPeriodDays is the number of days covered by the publishing and fetching statistics of a feed.
+const FeedStatsPeriodDays = 30
+
+// Bounds of the period of the least read feeds report, in months.
+const (
+	DefaultLeastReadMonths = 3
+	MaxLeastReadMonths     = 24
+)
+
+// FeedStats represents the activity of a feed, it helps to find the subscriptions that are not worth keeping.
+type FeedStats struct {
+	FeedID           int64   `json:"feed_id"`
+	EntriesPerWeek   float64 `json:"entries_per_week"`
+	AverageEntrySize int     `json:"average_entry_size"`
+	ReadRatio        float64 `json:"read_ratio"`
+	FetchCount       int     `json:"fetch_count"`
+	FetchSuccessRate float64 `json:"fetch_success_rate"`
+}
+
+// LeastReadFeed represents a feed without any entry read during the report period.
+type LeastReadFeed struct {
+	Feed        *Feed      `json:"feed"`
+	LastReadAt  *time.Time `json:"last_read_at"`
+	UnreadCount int        `json:"unread_count"`
+}
+
+// LeastReadFeeds represents a list of least read feeds, the feeds never read come first.
+type LeastReadFeeds []*LeastReadFeed
+
+// ValidateLeastReadMonths makes sure the period of the least read feeds report is within the allowed range.
+func ValidateLeastReadMonths(months int) error {
+	if months < 1 || months > MaxLeastReadMonths {
+		return fmt.Errorf("The number of months must be between 1 and %d", MaxLeastReadMonths)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateLeastReadMonths(t *testing.T) {
+	for _, months := range []int{1, DefaultLeastReadMonths, MaxLeastReadMonths} {
+		if err := ValidateLeastReadMonths(months); err != nil {
+			t.Errorf(`A valid number of months should not generate any error: %d`, months)
+		}
+	}
+
+	for _, months := range []int{-1, 0, MaxLeastReadMonths + 1} {
+		if err := ValidateLeastReadMonths(months); err == nil {
+			t.Errorf(`An invalid number of months should generate an error: %d`, months)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package model // import "miniflux.app/model"
 
 import (
 	"testing"
+	"time"
 
 	"miniflux.app/http/client"
 )
@@ -99,3 +100,50 @@ func TestFeedCheckedNow(t *testing.T) {
 		t.Error(`The checked date must be set`)
 	}
 }
+
+func TestFeedIsMuted(t *testing.T) {
+	feed := &Feed{}
+	if feed.IsMuted() {
+		t.Error(`A feed without mute date should not be muted`)
+	}
+
+	past := time.Now().Add(-time.Hour)
+	feed.MutedUntil = &past
+	if feed.IsMuted() {
+		t.Error(`A feed should not be muted once the mute date has expired`)
+	}
+
+	future := time.Now().Add(time.Hour)
+	feed.MutedUntil = &future
+	if !feed.IsMuted() {
+		t.Error(`A feed should be muted until the mute date`)
+	}
+}
+
+func TestValidateScraperMaxPages(t *testing.T) {
+	for _, pages := range []int{1, MaxScraperPages} {
+		if err := ValidateScraperMaxPages(pages); err != nil {
+			t.Errorf(`%d pages should be valid`, pages)
+		}
+	}
+
+	for _, pages := range []int{0, -1, MaxScraperPages + 1} {
+		if err := ValidateScraperMaxPages(pages); err == nil {
+			t.Errorf(`%d pages should be rejected`, pages)
+		}
+	}
+}
+
+func TestValidateSummaryWords(t *testing.T) {
+	for _, words := range []int{0, 50, MaxSummaryWords} {
+		if err := ValidateSummaryWords(words); err != nil {
+			t.Errorf(`%d words should be valid`, words)
+		}
+	}
+
+	for _, words := range []int{-1, MaxSummaryWords + 1} {
+		if err := ValidateSummaryWords(words); err == nil {
+			t.Errorf(`%d words should be rejected`, words)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// FilterList is the cached copy of a filter list with element hiding rules.
+type FilterList struct {
+	URL                string
+	Content            string
+	EtagHeader         string
+	LastModifiedHeader string
+	CheckedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// IsStale returns true if the list has not been downloaded during the interval.
+func (f *FilterList) IsStale(interval time.Duration) bool {
+	return time.Since(f.CheckedAt) >= interval
+}
+
+// FilterLists is a list of filter lists.
+type FilterLists []*FilterList
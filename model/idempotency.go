@@ -0,0 +1,22 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// IdempotencyKeyMaxLength is the maximum length of the Idempotency-Key header.
+const IdempotencyKeyMaxLength = 255
+
+// IdempotentResponse is the response kept for an API request sent with an idempotency key.
+type IdempotentResponse struct {
+	// Fingerprint identifies the method, the path and the body of the request.
+	Fingerprint string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// InProgress returns true when the first request with the key has not been answered yet.
+func (i *IdempotentResponse) InProgress() bool {
+	return i.StatusCode == 0
+}
@@ -0,0 +1,17 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestIdempotentResponseInProgress(t *testing.T) {
+	if !(&IdempotentResponse{}).InProgress() {
+		t.Error(`A response without status code should be in progress`)
+	}
+
+	if (&IdempotentResponse{StatusCode: 204}).InProgress() {
+		t.Error(`A response with a status code should not be in progress`)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// Image display modes define how the images of entries are rendered.
+// Feeds and categories without a mode inherit the mode of their category or of the user.
+const (
+	ImageDisplayShow = "show"
+	ImageDisplayBlur = "blur"
+	ImageDisplayHide = "hide"
+)
+
+// ImageDisplays returns the list of available image display modes and their translation keys.
+func ImageDisplays() map[string]string {
+	return map[string]string{
+		ImageDisplayShow: "form.prefs.select.image_display_show",
+		ImageDisplayBlur: "form.prefs.select.image_display_blur",
+		ImageDisplayHide: "form.prefs.select.image_display_hide",
+	}
+}
+
+// ValidateImageDisplay validates image display mode value, an empty value inherits the parent mode.
+func ValidateImageDisplay(mode string) error {
+	if _, found := ImageDisplays()[mode]; mode != "" && !found {
+		return errors.NewLocalizedError("Invalid image display mode")
+	}
+
+	return nil
+}
+
+// ResolveImageDisplay returns the image display mode of the feed, falling back to its category and then to the user preferences.
+func ResolveImageDisplay(user *User, feed *Feed) string {
+	if feed != nil && feed.ImageDisplay != "" {
+		return feed.ImageDisplay
+	}
+
+	if feed != nil && feed.Category != nil && feed.Category.ImageDisplay != "" {
+		return feed.Category.ImageDisplay
+	}
+
+	if user != nil && user.ImageDisplay != "" {
+		return user.ImageDisplay
+	}
+
+	return ImageDisplayShow
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateImageDisplay(t *testing.T) {
+	for _, mode := range []string{"", "show", "blur", "hide"} {
+		if err := ValidateImageDisplay(mode); err != nil {
+			t.Errorf(`A valid image display mode should not generate any error: %q`, mode)
+		}
+	}
+
+	if err := ValidateImageDisplay("invalid"); err == nil {
+		t.Error(`An invalid image display mode should generate an error`)
+	}
+}
+
+func TestResolveImageDisplay(t *testing.T) {
+	user := &User{ImageDisplay: ImageDisplayBlur}
+	scenarios := []struct {
+		feed     *Feed
+		expected string
+	}{
+		{nil, ImageDisplayBlur},
+		{&Feed{}, ImageDisplayBlur},
+		{&Feed{Category: &Category{ImageDisplay: ImageDisplayHide}}, ImageDisplayHide},
+		{&Feed{ImageDisplay: ImageDisplayShow, Category: &Category{ImageDisplay: ImageDisplayHide}}, ImageDisplayShow},
+	}
+
+	for _, scenario := range scenarios {
+		if result := ResolveImageDisplay(user, scenario.feed); result != scenario.expected {
+			t.Errorf(`Unexpected image display mode, got %q instead of %q`, result, scenario.expected)
+		}
+	}
+
+	if result := ResolveImageDisplay(nil, nil); result != ImageDisplayShow {
+		t.Errorf(`Images should be shown by default, got %q`, result)
+	}
+}
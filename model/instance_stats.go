@@ -0,0 +1,39 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// InstanceStatsLimit is the number of shared feeds and of erroring domains listed in the instance statistics.
+const InstanceStatsLimit = 10
+
+// InstanceStats represents the statistics of all users, they are visible by administrators.
+type InstanceStats struct {
+	Users        int   `json:"users"`
+	Feeds        int   `json:"feeds"`
+	Entries      int64 `json:"entries"`
+	DatabaseSize int64 `json:"database_size"`
+
+	// SharedFeedURLs is the number of feed URLs subscribed by several users, they are fetched once per subscription.
+	SharedFeedURLs int           `json:"shared_feed_urls"`
+	SharedFeeds    []*SharedFeed `json:"shared_feeds"`
+
+	FetchesLastHour  int     `json:"fetches_last_hour"`
+	FetchesLastDay   int     `json:"fetches_last_day"`
+	FetchSuccessRate float64 `json:"fetch_success_rate"`
+
+	ErrorDomains []*ErrorDomain `json:"error_domains"`
+}
+
+// SharedFeed represents a feed URL subscribed by several users.
+type SharedFeed struct {
+	FeedURL     string `json:"feed_url"`
+	Subscribers int    `json:"subscribers"`
+}
+
+// ErrorDomain represents the feeds of a domain that could not be refreshed.
+type ErrorDomain struct {
+	Domain     string `json:"domain"`
+	ErrorFeeds int    `json:"error_feeds"`
+	Feeds      int    `json:"feeds"`
+}
@@ -30,4 +30,29 @@ type Integration struct {
 	PocketEnabled        bool
 	PocketAccessToken    string
 	PocketConsumerKey    string
+	NtfyEnabled          bool
+	NtfyURL              string
+	NtfyTopic            string
+	NtfyToken            string
+	PushoverEnabled      bool
+	PushoverToken        string
+	PushoverUser         string
+	AppriseEnabled       bool
+	AppriseURL           string
+	AppriseServiceURLs   string
+	KindleEnabled        bool
+	KindleEmail          string
+	ReadeckEnabled       bool
+	ReadeckURL           string
+	ReadeckAPIKey        string
+	ReadeckLabels        string
+	OmnivoreEnabled      bool
+	OmnivoreURL          string
+	OmnivoreAPIKey       string
+	Filters              IntegrationFilters
+}
+
+// HasNotificationService returns true if the user receives notifications through at least one service.
+func (i *Integration) HasNotificationService() bool {
+	return i.NtfyEnabled || i.PushoverEnabled || i.AppriseEnabled
 }
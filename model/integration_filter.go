@@ -0,0 +1,160 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Integration events.
+const (
+	IntegrationEventStarred    = "starred"
+	IntegrationEventNewEntries = "new_entries"
+	IntegrationEventFeedError  = "feed_error"
+)
+
+// Services that save entries and services that send notifications.
+var (
+	bookmarkServices     = []string{"pinboard", "instapaper", "wallabag", "nunux_keeper", "pocket", "readeck", "omnivore"}
+	notificationServices = []string{"ntfy", "pushover", "apprise"}
+)
+
+// IntegrationFilter selects the events received by an integration.
+// New entries are restricted to the given categories, all categories are selected when the list is empty.
+type IntegrationFilter struct {
+	Events      []string `json:"events"`
+	CategoryIDs []int64  `json:"category_ids,omitempty"`
+}
+
+// HasEvent returns true if the integration receives the event.
+func (f *IntegrationFilter) HasEvent(event string) bool {
+	for _, e := range f.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCategory returns true if the category is explicitly selected.
+func (f *IntegrationFilter) HasCategory(categoryID int64) bool {
+	for _, id := range f.CategoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// Accepts returns true if the integration receives the event of an entry of the given category.
+func (f *IntegrationFilter) Accepts(event string, categoryID int64) bool {
+	if !f.HasEvent(event) {
+		return false
+	}
+
+	if event == IntegrationEventNewEntries && len(f.CategoryIDs) > 0 {
+		return f.HasCategory(categoryID)
+	}
+
+	return true
+}
+
+// IntegrationFilters maps the name of an integration to its filter.
+type IntegrationFilters map[string]*IntegrationFilter
+
+// Filter returns the filter of an integration.
+// By default, notification services receive new entries and feed errors, other services only receive saved entries.
+func (f IntegrationFilters) Filter(service string) *IntegrationFilter {
+	if filter, found := f[service]; found && filter != nil {
+		return filter
+	}
+
+	if isNotificationService(service) {
+		return &IntegrationFilter{Events: []string{IntegrationEventNewEntries, IntegrationEventFeedError}}
+	}
+
+	return &IntegrationFilter{}
+}
+
+// Validate makes sure the integrations and the events exist.
+func (f IntegrationFilters) Validate() error {
+	for service, filter := range f {
+		notification := isNotificationService(service)
+		if !notification && !isBookmarkService(service) {
+			return fmt.Errorf("The integration %q doesn't exist", service)
+		}
+
+		if filter == nil {
+			continue
+		}
+
+		for _, event := range filter.Events {
+			switch event {
+			case IntegrationEventStarred, IntegrationEventNewEntries:
+			case IntegrationEventFeedError:
+				if !notification {
+					return fmt.Errorf("The integration %q cannot receive the event %q", service, event)
+				}
+			default:
+				return fmt.Errorf("The integration event %q doesn't exist", event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Value converts the integration filters to JSON.
+func (f IntegrationFilters) Value() (driver.Value, error) {
+	if f == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(f)
+}
+
+// Scan converts raw JSON data.
+func (f *IntegrationFilters) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("integration filters: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, f); err != nil {
+		return fmt.Errorf("integration filters: %v", err)
+	}
+
+	return nil
+}
+
+// BookmarkServices returns the names of the integrations saving entries.
+func BookmarkServices() []string {
+	return bookmarkServices
+}
+
+// NotificationServices returns the names of the integrations sending notifications.
+func NotificationServices() []string {
+	return notificationServices
+}
+
+func isBookmarkService(service string) bool {
+	for _, s := range bookmarkServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotificationService(service string) bool {
+	for _, s := range notificationServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
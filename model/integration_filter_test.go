@@ -0,0 +1,74 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+)
+
+func TestDefaultIntegrationFilters(t *testing.T) {
+	filters := IntegrationFilters{}
+
+	if !filters.Filter("ntfy").Accepts(IntegrationEventNewEntries, 1) || !filters.Filter("ntfy").Accepts(IntegrationEventFeedError, 0) {
+		t.Error(`Notification services should receive new entries and feed errors by default`)
+	}
+
+	if filters.Filter("ntfy").Accepts(IntegrationEventStarred, 1) {
+		t.Error(`Notification services should not receive starred entries by default`)
+	}
+
+	if filters.Filter("pinboard").Accepts(IntegrationEventStarred, 1) || filters.Filter("pinboard").Accepts(IntegrationEventNewEntries, 1) {
+		t.Error(`Bookmark services should not receive any event by default`)
+	}
+}
+
+func TestIntegrationFilterCategories(t *testing.T) {
+	filter := &IntegrationFilter{Events: []string{IntegrationEventStarred, IntegrationEventNewEntries}, CategoryIDs: []int64{2, 3}}
+
+	if !filter.Accepts(IntegrationEventNewEntries, 3) {
+		t.Error(`New entries of a selected category should be accepted`)
+	}
+
+	if filter.Accepts(IntegrationEventNewEntries, 1) {
+		t.Error(`New entries of other categories should be rejected`)
+	}
+
+	if !filter.Accepts(IntegrationEventStarred, 1) {
+		t.Error(`Categories should only restrict new entries`)
+	}
+}
+
+func TestValidateIntegrationFilters(t *testing.T) {
+	valid := IntegrationFilters{
+		"pinboard": {Events: []string{IntegrationEventStarred}},
+		"pushover": {Events: []string{IntegrationEventFeedError}},
+		"ntfy":     nil,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf(`The filters should be valid: %v`, err)
+	}
+
+	invalid := []IntegrationFilters{
+		{"unknown": {}},
+		{"ntfy": {Events: []string{"unknown"}}},
+		{"wallabag": {Events: []string{IntegrationEventFeedError}}},
+	}
+	for _, filters := range invalid {
+		if err := filters.Validate(); err == nil {
+			t.Errorf(`The filters %v should be invalid`, filters)
+		}
+	}
+}
+
+func TestIntegrationFiltersScan(t *testing.T) {
+	var filters IntegrationFilters
+	if err := filters.Scan([]byte(`{"ntfy":{"events":["starred"],"category_ids":[4]}}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !filters.Filter("ntfy").Accepts(IntegrationEventStarred, 0) || filters.Filter("ntfy").Accepts(IntegrationEventNewEntries, 1) {
+		t.Errorf(`Unexpected filters: %+v`, filters["ntfy"])
+	}
+}
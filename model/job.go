@@ -6,8 +6,9 @@ package model // import "miniflux.app/model"
 
 // Job represents a payload sent to the processing queue.
 type Job struct {
-	UserID int64
-	FeedID int64
+	UserID       int64
+	FeedID       int64
+	RefreshJobID int64
 }
 
 // JobList represents a list of jobs.
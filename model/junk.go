@@ -0,0 +1,33 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "fmt"
+
+const (
+	// MaxJunkScore is the score of the entries most likely to be spam or low-quality content.
+	MaxJunkScore = 100
+
+	// DefaultJunkThreshold is the score from which entries are listed for review when the user has no threshold.
+	DefaultJunkThreshold = 50
+)
+
+// ValidateJunkThreshold makes sure the threshold is a valid score, zero disables the threshold.
+func ValidateJunkThreshold(threshold int) error {
+	if threshold < 0 || threshold > MaxJunkScore {
+		return fmt.Errorf("The junk threshold must be between 0 and %d", MaxJunkScore)
+	}
+
+	return nil
+}
+
+// ReviewedJunkScore returns the score from which the entries of the user are listed for review.
+func (u *User) ReviewedJunkScore() int {
+	if u.JunkThreshold > 0 {
+		return u.JunkThreshold
+	}
+
+	return DefaultJunkThreshold
+}
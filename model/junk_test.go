@@ -0,0 +1,31 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateJunkThreshold(t *testing.T) {
+	for _, threshold := range []int{0, 1, 50, MaxJunkScore} {
+		if err := ValidateJunkThreshold(threshold); err != nil {
+			t.Errorf(`A valid threshold should not generate any error: %d`, threshold)
+		}
+	}
+
+	for _, threshold := range []int{-1, MaxJunkScore + 1} {
+		if err := ValidateJunkThreshold(threshold); err == nil {
+			t.Errorf(`An invalid threshold should generate an error: %d`, threshold)
+		}
+	}
+}
+
+func TestReviewedJunkScore(t *testing.T) {
+	if score := (&User{}).ReviewedJunkScore(); score != DefaultJunkThreshold {
+		t.Errorf(`The default threshold should be used, got %d`, score)
+	}
+
+	if score := (&User{JunkThreshold: 70}).ReviewedJunkScore(); score != 70 {
+		t.Errorf(`The threshold of the user should be used, got %d`, score)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KeyboardShortcuts maps a user interface action to the keys that trigger it.
+// A key sequence is written with spaces, for example "g u", and an action without keys is disabled.
+type KeyboardShortcuts map[string][]string
+
+var defaultKeyboardShortcuts = KeyboardShortcuts{
+	"go_to_unread":            {"g u"},
+	"go_to_starred":           {"g b"},
+	"go_to_history":           {"g h"},
+	"go_to_feeds":             {"g f"},
+	"go_to_categories":        {"g c"},
+	"go_to_settings":          {"g s"},
+	"show_keyboard_shortcuts": {"?"},
+	"go_to_previous_item":     {"p", "j", "ArrowLeft"},
+	"go_to_next_item":         {"n", "k", "ArrowRight"},
+	"go_to_previous_page":     {"h"},
+	"go_to_next_page":         {"l"},
+	"open_item":               {"o"},
+	"open_original":           {"v"},
+	"toggle_read_status":      {"m"},
+	"mark_page_as_read":       {"A"},
+	"download_content":        {"d"},
+	"toggle_bookmark_status":  {"f"},
+	"save_article":            {"s"},
+	"remove_feed":             {"#"},
+	"go_to_search":            {"/"},
+	"close_modal":             {"Escape"},
+}
+
+var keyLabels = map[string]string{
+	"ArrowLeft":  "◄",
+	"ArrowRight": "►",
+	"ArrowUp":    "▲",
+	"ArrowDown":  "▼",
+	"Escape":     "Esc",
+}
+
+// DefaultKeyboardShortcuts returns the keyboard shortcuts used when the user has not changed them.
+func DefaultKeyboardShortcuts() KeyboardShortcuts {
+	return KeyboardShortcuts{}.WithDefaults()
+}
+
+// WithDefaults returns the keyboard shortcuts completed with the default keys of the missing actions.
+func (k KeyboardShortcuts) WithDefaults() KeyboardShortcuts {
+	shortcuts := make(KeyboardShortcuts, len(defaultKeyboardShortcuts))
+	for action, keys := range defaultKeyboardShortcuts {
+		if customKeys, found := k[action]; found {
+			keys = customKeys
+		}
+
+		shortcuts[action] = append([]string{}, keys...)
+	}
+
+	return shortcuts
+}
+
+// Labels returns the keys of an action formatted for display.
+func (k KeyboardShortcuts) Labels(action string) []string {
+	var labels []string
+	for _, sequence := range k[action] {
+		var parts []string
+		for _, key := range strings.Fields(sequence) {
+			if label, found := keyLabels[key]; found {
+				key = label
+			}
+			parts = append(parts, key)
+		}
+
+		labels = append(labels, strings.Join(parts, " + "))
+	}
+
+	return labels
+}
+
+// Label returns the keys of an action formatted for display in a single string.
+func (k KeyboardShortcuts) Label(action string) string {
+	return strings.Join(k.Labels(action), ", ")
+}
+
+// Validate checks the actions and the keys, a key sequence must trigger only one action.
+func (k KeyboardShortcuts) Validate() error {
+	for action, keys := range k {
+		if _, found := defaultKeyboardShortcuts[action]; !found {
+			return fmt.Errorf("The keyboard shortcut action %q doesn't exist", action)
+		}
+
+		for _, sequence := range keys {
+			if n := len(strings.Fields(sequence)); n == 0 || n > 2 {
+				return fmt.Errorf("The key sequence %q of the action %q is invalid", sequence, action)
+			}
+		}
+	}
+
+	assigned := make(map[string]string)
+	for action, keys := range k.WithDefaults() {
+		for _, sequence := range keys {
+			sequence = strings.Join(strings.Fields(sequence), " ")
+			if other, found := assigned[sequence]; found {
+				return fmt.Errorf("The key sequence %q is assigned to %q and %q", sequence, action, other)
+			}
+			assigned[sequence] = action
+		}
+	}
+
+	// A single key is triggered before the sequences starting with the same key.
+	for sequence, action := range assigned {
+		fields := strings.Fields(sequence)
+		if len(fields) == 1 {
+			continue
+		}
+
+		if other, found := assigned[fields[0]]; found {
+			return fmt.Errorf("The key %q of %q hides the key sequence %q of %q", fields[0], other, sequence, action)
+		}
+	}
+
+	return nil
+}
+
+// Value converts the keyboard shortcuts to JSON.
+func (k KeyboardShortcuts) Value() (driver.Value, error) {
+	if k == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(k)
+}
+
+// Scan converts raw JSON data.
+func (k *KeyboardShortcuts) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("keyboard shortcuts: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, k); err != nil {
+		return fmt.Errorf("keyboard shortcuts: %v", err)
+	}
+
+	return nil
+}
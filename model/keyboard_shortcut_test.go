@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultKeyboardShortcutsAreValid(t *testing.T) {
+	if err := DefaultKeyboardShortcuts().Validate(); err != nil {
+		t.Errorf(`The default keyboard shortcuts should be valid: %v`, err)
+	}
+}
+
+func TestKeyboardShortcutsWithDefaults(t *testing.T) {
+	shortcuts := KeyboardShortcuts{"open_item": {"Enter"}, "remove_feed": {}}.WithDefaults()
+
+	if !reflect.DeepEqual(shortcuts["open_item"], []string{"Enter"}) {
+		t.Errorf(`Custom keys should replace the default keys, got %v`, shortcuts["open_item"])
+	}
+
+	if len(shortcuts["remove_feed"]) != 0 {
+		t.Errorf(`A disabled action should not have keys, got %v`, shortcuts["remove_feed"])
+	}
+
+	if !reflect.DeepEqual(shortcuts["go_to_unread"], []string{"g u"}) {
+		t.Errorf(`Missing actions should use the default keys, got %v`, shortcuts["go_to_unread"])
+	}
+}
+
+func TestKeyboardShortcutsLabels(t *testing.T) {
+	labels := DefaultKeyboardShortcuts().Labels("go_to_previous_item")
+	expected := []string{"p", "j", "◄"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf(`Unexpected labels, got %v instead of %v`, labels, expected)
+	}
+
+	if label := DefaultKeyboardShortcuts().Label("go_to_next_item"); label != "n, k, ►" {
+		t.Errorf(`Unexpected label, got %q`, label)
+	}
+
+	labels = DefaultKeyboardShortcuts().Labels("go_to_unread")
+	expected = []string{"g + u"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf(`Unexpected labels, got %v instead of %v`, labels, expected)
+	}
+}
+
+func TestValidateKeyboardShortcuts(t *testing.T) {
+	scenarios := []struct {
+		shortcuts KeyboardShortcuts
+		valid     bool
+	}{
+		{KeyboardShortcuts{"open_item": {"Enter"}}, true},
+		{KeyboardShortcuts{"remove_feed": {}}, true},
+		{KeyboardShortcuts{"go_to_next_item": {"j"}, "go_to_previous_item": {"k"}}, true},
+		{KeyboardShortcuts{"unknown_action": {"x"}}, false},
+		{KeyboardShortcuts{"open_item": {""}}, false},
+		{KeyboardShortcuts{"open_item": {"a b c"}}, false},
+		{KeyboardShortcuts{"open_item": {"m"}}, false},
+		{KeyboardShortcuts{"open_item": {"g"}}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := scenario.shortcuts.Validate()
+		if scenario.valid && err != nil {
+			t.Errorf(`%v should be valid: %v`, scenario.shortcuts, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`%v should not be valid`, scenario.shortcuts)
+		}
+	}
+}
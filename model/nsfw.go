@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// NSFW display modes define how the entries flagged as not safe for work are rendered.
+const (
+	NSFWDisplayShow = "show"
+	NSFWDisplayBlur = "blur"
+	NSFWDisplayHide = "hide"
+)
+
+// NSFWDisplays returns the list of available NSFW display modes and their translation keys.
+func NSFWDisplays() map[string]string {
+	return map[string]string{
+		NSFWDisplayShow: "form.prefs.select.nsfw_display_show",
+		NSFWDisplayBlur: "form.prefs.select.nsfw_display_blur",
+		NSFWDisplayHide: "form.prefs.select.nsfw_display_hide",
+	}
+}
+
+// ValidateNSFWDisplay validates NSFW display mode value, an empty value shows the entries.
+func ValidateNSFWDisplay(mode string) error {
+	if _, found := NSFWDisplays()[mode]; mode != "" && !found {
+		return errors.NewLocalizedError("Invalid NSFW display mode")
+	}
+
+	return nil
+}
+
+// IsNSFW returns true when the entry, its feed or the category of its feed is flagged as not safe for work.
+func (e *Entry) IsNSFW() bool {
+	if e.NSFW {
+		return true
+	}
+
+	if e.Feed == nil {
+		return false
+	}
+
+	return e.Feed.NSFW || (e.Feed.Category != nil && e.Feed.Category.NSFW)
+}
+
+// BlurNSFW returns true when the entry is not safe for work and the user prefers to blur such entries.
+func (u *User) BlurNSFW(entry *Entry) bool {
+	return u.NSFWDisplay == NSFWDisplayBlur && entry.IsNSFW()
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateNSFWDisplay(t *testing.T) {
+	for _, mode := range []string{"", "show", "blur", "hide"} {
+		if err := ValidateNSFWDisplay(mode); err != nil {
+			t.Errorf(`A valid NSFW display mode should not generate any error: %q`, mode)
+		}
+	}
+
+	if err := ValidateNSFWDisplay("invalid"); err == nil {
+		t.Error(`An invalid NSFW display mode should generate an error`)
+	}
+}
+
+func TestEntryIsNSFW(t *testing.T) {
+	scenarios := []struct {
+		entry    *Entry
+		expected bool
+	}{
+		{&Entry{}, false},
+		{&Entry{NSFW: true}, true},
+		{&Entry{Feed: &Feed{}}, false},
+		{&Entry{Feed: &Feed{NSFW: true}}, true},
+		{&Entry{Feed: &Feed{Category: &Category{NSFW: true}}}, true},
+	}
+
+	for i, scenario := range scenarios {
+		if result := scenario.entry.IsNSFW(); result != scenario.expected {
+			t.Errorf(`Unexpected result for scenario #%d: got %v`, i, result)
+		}
+	}
+}
+
+func TestBlurNSFW(t *testing.T) {
+	scenarios := []struct {
+		mode     string
+		nsfw     bool
+		expected bool
+	}{
+		{NSFWDisplayBlur, true, true},
+		{NSFWDisplayBlur, false, false},
+		{NSFWDisplayShow, true, false},
+		{NSFWDisplayHide, true, false},
+	}
+
+	for _, scenario := range scenarios {
+		user := &User{NSFWDisplay: scenario.mode}
+		if result := user.BlurNSFW(&Entry{NSFW: scenario.nsfw}); result != scenario.expected {
+			t.Errorf(`Unexpected result for mode %q and nsfw=%v: got %v`, scenario.mode, scenario.nsfw, result)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Outbox event statuses.
+const (
+	OutboxStatusFailed    = "failed"
+	OutboxStatusDiscarded = "discarded"
+)
+
+// DefaultOutboxLimit is the number of outbox events returned or replayed by default.
+const DefaultOutboxLimit = 100
+
+// OutboxEvent represents a Pub/Sub event that could not be published.
+type OutboxEvent struct {
+	ID         int64     `json:"id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   int64     `json:"entity_id"`
+	EntityOp   string    `json:"entity_op"`
+	UserID     int64     `json:"user_id,omitempty"`
+	Status     string    `json:"status"`
+	ErrorMsg   string    `json:"error_message"`
+	Attempts   int       `json:"attempts"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// Data is the entity embedded in the event with the full payloads, it's published again with the event.
+	Data []byte `json:"-"`
+}
+
+// OutboxEvents represents a list of outbox events.
+type OutboxEvents []*OutboxEvent
+
+// OutboxBacklog represents the number of outbox events by status.
+type OutboxBacklog struct {
+	Failed      int        `json:"failed"`
+	Discarded   int        `json:"discarded"`
+	OldestEvent *time.Time `json:"oldest_event"`
+}
+
+// OutboxReplay represents the result of the replay of outbox events.
+type OutboxReplay struct {
+	Replayed int `json:"replayed"`
+	Failed   int `json:"failed"`
+}
+
+// ValidateOutboxStatus returns an error if the status cannot be used to filter the outbox.
+func ValidateOutboxStatus(status string) error {
+	switch status {
+	case OutboxStatusFailed, OutboxStatusDiscarded:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid outbox status, valid status values are: "%s" and "%s"`, OutboxStatusFailed, OutboxStatusDiscarded)
+}
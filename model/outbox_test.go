@@ -0,0 +1,19 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateOutboxStatus(t *testing.T) {
+	for _, status := range []string{OutboxStatusFailed, OutboxStatusDiscarded} {
+		if err := ValidateOutboxStatus(status); err != nil {
+			t.Errorf(`A valid status should not generate any error: %q`, status)
+		}
+	}
+
+	if err := ValidateOutboxStatus("invalid"); err == nil {
+		t.Error(`An invalid status should generate an error`)
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// PendingFeed is a subscription waiting for the approval of an administrator.
+type PendingFeed struct {
+	Feed     *Feed  `json:"feed"`
+	Username string `json:"username"`
+}
+
+// PendingFeeds is a list of pending subscriptions.
+type PendingFeeds []*PendingFeed
@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"sort"
+	"time"
+)
+
+// QueryStats represents the duration of the database queries executed since startup.
+type QueryStats struct {
+	Count     int64
+	SlowCount int64
+	Total     time.Duration
+
+	// Recent holds the duration of the last queries, used to compute the quantiles.
+	Recent []time.Duration
+}
+
+// Quantile returns the duration under which the given proportion of the recent queries completed.
+func (q *QueryStats) Quantile(quantile float64) time.Duration {
+	if len(q.Recent) == 0 {
+		return 0
+	}
+
+	durations := make([]time.Duration, len(q.Recent))
+	copy(durations, q.Recent)
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+	index := int(quantile*float64(len(durations))+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(durations) {
+		index = len(durations) - 1
+	}
+
+	return durations[index]
+}
@@ -0,0 +1,42 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueryStatsQuantile(t *testing.T) {
+	stats := &QueryStats{}
+	for i := 100; i >= 1; i-- {
+		stats.Recent = append(stats.Recent, time.Duration(i)*time.Millisecond)
+	}
+
+	scenarios := map[float64]time.Duration{
+		0:    1 * time.Millisecond,
+		0.5:  50 * time.Millisecond,
+		0.9:  90 * time.Millisecond,
+		0.99: 99 * time.Millisecond,
+		1:    100 * time.Millisecond,
+	}
+
+	for quantile, expected := range scenarios {
+		if result := stats.Quantile(quantile); result != expected {
+			t.Errorf(`Unexpected quantile %v, got %v instead of %v`, quantile, result, expected)
+		}
+	}
+
+	if stats.Recent[0] != 100*time.Millisecond {
+		t.Error(`The recent durations should not be sorted in place`)
+	}
+}
+
+func TestQueryStatsQuantileWithoutQueries(t *testing.T) {
+	stats := &QueryStats{}
+	if result := stats.Quantile(0.5); result != 0 {
+		t.Errorf(`The quantile should be zero without queries, got %v`, result)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// quietHoursLayout is the format of the start and end of quiet hours.
+const quietHoursLayout = "15:04"
+
+// ValidateQuietHours makes sure both bounds are empty or both are valid times of day.
+func ValidateQuietHours(start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+
+	if _, err := time.Parse(quietHoursLayout, start); err != nil {
+		return errors.New("The start of quiet hours must be formatted as HH:MM")
+	}
+
+	if _, err := time.Parse(quietHoursLayout, end); err != nil {
+		return errors.New("The end of quiet hours must be formatted as HH:MM")
+	}
+
+	return nil
+}
+
+// HasQuietHours returns true if notifications are delayed during part of the day.
+func (u *User) HasQuietHours() bool {
+	return u.QuietHoursStart != "" && u.QuietHoursEnd != "" && u.QuietHoursStart != u.QuietHoursEnd
+}
+
+// QuietHoursEndAt returns the end of the quiet hours in progress at the given time,
+// or the zero time when notifications can be delivered.
+// Quiet hours are expressed in the timezone of the user and may span midnight.
+func (u *User) QuietHoursEndAt(now time.Time) time.Time {
+	if !u.HasQuietHours() {
+		return time.Time{}
+	}
+
+	start, startErr := time.Parse(quietHoursLayout, u.QuietHoursStart)
+	end, endErr := time.Parse(quietHoursLayout, u.QuietHoursEnd)
+	if startErr != nil || endErr != nil {
+		return time.Time{}
+	}
+
+	local := timezone.Convert(u.Timezone, now)
+	minutes := local.Hour()*60 + local.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	endAt := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, local.Location())
+	switch {
+	case startMinutes < endMinutes && minutes >= startMinutes && minutes < endMinutes:
+		return endAt
+	case startMinutes > endMinutes && minutes < endMinutes:
+		return endAt
+	case startMinutes > endMinutes && minutes >= startMinutes:
+		return endAt.AddDate(0, 0, 1)
+	default:
+		return time.Time{}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateQuietHours(t *testing.T) {
+	valid := [][2]string{{"", ""}, {"22:00", "07:30"}, {"00:00", "23:59"}}
+	for _, hours := range valid {
+		if err := ValidateQuietHours(hours[0], hours[1]); err != nil {
+			t.Errorf(`Quiet hours %q should be valid: %v`, hours, err)
+		}
+	}
+
+	invalid := [][2]string{{"22:00", ""}, {"", "07:00"}, {"25:00", "07:00"}, {"22:00", "7h"}}
+	for _, hours := range invalid {
+		if err := ValidateQuietHours(hours[0], hours[1]); err == nil {
+			t.Errorf(`Quiet hours %q should be invalid`, hours)
+		}
+	}
+}
+
+func TestQuietHoursEndAt(t *testing.T) {
+	user := &User{Timezone: "UTC", QuietHoursStart: "22:00", QuietHoursEnd: "07:00"}
+
+	scenarios := []struct {
+		now      time.Time
+		expected time.Time
+	}{
+		{time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC), time.Time{}},
+		{time.Date(2019, 5, 1, 21, 59, 0, 0, time.UTC), time.Time{}},
+		{time.Date(2019, 5, 1, 22, 0, 0, 0, time.UTC), time.Date(2019, 5, 2, 7, 0, 0, 0, time.UTC)},
+		{time.Date(2019, 5, 2, 3, 15, 0, 0, time.UTC), time.Date(2019, 5, 2, 7, 0, 0, 0, time.UTC)},
+		{time.Date(2019, 5, 2, 7, 0, 0, 0, time.UTC), time.Time{}},
+	}
+
+	for _, scenario := range scenarios {
+		if result := user.QuietHoursEndAt(scenario.now); !result.Equal(scenario.expected) {
+			t.Errorf(`Unexpected end of quiet hours at %v, got %v instead of %v`, scenario.now, result, scenario.expected)
+		}
+	}
+}
+
+func TestQuietHoursEndAtWithinTheSameDay(t *testing.T) {
+	user := &User{Timezone: "UTC", QuietHoursStart: "12:00", QuietHoursEnd: "14:00"}
+
+	if result := user.QuietHoursEndAt(time.Date(2019, 5, 1, 13, 0, 0, 0, time.UTC)); !result.Equal(time.Date(2019, 5, 1, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected end of quiet hours, got %v`, result)
+	}
+
+	if result := user.QuietHoursEndAt(time.Date(2019, 5, 1, 23, 0, 0, 0, time.UTC)); !result.IsZero() {
+		t.Errorf(`There should be no quiet hours in the evening, got %v`, result)
+	}
+}
+
+func TestQuietHoursEndAtUsesUserTimezone(t *testing.T) {
+	user := &User{Timezone: "Europe/Paris", QuietHoursStart: "22:00", QuietHoursEnd: "07:00"}
+
+	// 21:30 UTC is 23:30 in Paris during summer time.
+	result := user.QuietHoursEndAt(time.Date(2019, 7, 1, 21, 30, 0, 0, time.UTC))
+	if expected := time.Date(2019, 7, 2, 5, 0, 0, 0, time.UTC); !result.Equal(expected) {
+		t.Errorf(`Unexpected end of quiet hours, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestQuietHoursEndAtWhenDisabled(t *testing.T) {
+	user := &User{Timezone: "UTC", QuietHoursStart: "08:00", QuietHoursEnd: "08:00"}
+
+	if result := user.QuietHoursEndAt(time.Date(2019, 5, 1, 8, 30, 0, 0, time.UTC)); !result.IsZero() {
+		t.Errorf(`Identical bounds should disable quiet hours, got %v`, result)
+	}
+}
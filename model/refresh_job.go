@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Refresh job statuses.
+const (
+	RefreshJobStatusPending   = "pending"
+	RefreshJobStatusSucceeded = "succeeded"
+	RefreshJobStatusFailed    = "failed"
+	RefreshJobStatusRunning   = "running"
+	RefreshJobStatusFinished  = "finished"
+)
+
+// RefreshJob represents a manual refresh of all feeds requested by a user.
+type RefreshJob struct {
+	ID        int64           `json:"id"`
+	UserID    int64           `json:"user_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Status    string          `json:"status"`
+	Total     int             `json:"total"`
+	Pending   int             `json:"pending"`
+	Succeeded int             `json:"succeeded"`
+	Failed    int             `json:"failed"`
+	Feeds     RefreshJobFeeds `json:"feeds"`
+}
+
+// WithFeeds attaches the per-feed progress and updates the counters.
+func (j *RefreshJob) WithFeeds(feeds RefreshJobFeeds) {
+	j.Feeds = feeds
+	j.Total = len(feeds)
+	j.Pending = 0
+	j.Succeeded = 0
+	j.Failed = 0
+
+	for _, feed := range feeds {
+		switch feed.Status {
+		case RefreshJobStatusPending:
+			j.Pending++
+		case RefreshJobStatusSucceeded:
+			j.Succeeded++
+		case RefreshJobStatusFailed:
+			j.Failed++
+		}
+	}
+
+	if j.Pending > 0 {
+		j.Status = RefreshJobStatusRunning
+	} else {
+		j.Status = RefreshJobStatusFinished
+	}
+}
+
+// RefreshJobFeed represents the progress of a single feed in a refresh job.
+type RefreshJobFeed struct {
+	FeedID    int64     `json:"feed_id"`
+	FeedTitle string    `json:"feed_title"`
+	Status    string    `json:"status"`
+	ErrorMsg  string    `json:"error_message,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RefreshJobFeeds represents a list of feed progress.
+type RefreshJobFeeds []*RefreshJobFeed
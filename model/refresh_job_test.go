@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestRefreshJobWithPendingFeeds(t *testing.T) {
+	job := &RefreshJob{}
+	job.WithFeeds(RefreshJobFeeds{
+		&RefreshJobFeed{FeedID: 1, Status: RefreshJobStatusSucceeded},
+		&RefreshJobFeed{FeedID: 2, Status: RefreshJobStatusFailed},
+		&RefreshJobFeed{FeedID: 3, Status: RefreshJobStatusPending},
+	})
+
+	if job.Total != 3 {
+		t.Fatalf(`Unexpected total, got %d instead of %d`, job.Total, 3)
+	}
+
+	if job.Pending != 1 || job.Succeeded != 1 || job.Failed != 1 {
+		t.Fatalf(`Unexpected counters, got pending=%d succeeded=%d failed=%d`, job.Pending, job.Succeeded, job.Failed)
+	}
+
+	if job.Status != RefreshJobStatusRunning {
+		t.Fatalf(`Unexpected status, got %q instead of %q`, job.Status, RefreshJobStatusRunning)
+	}
+}
+
+func TestRefreshJobWithAllFeedsProcessed(t *testing.T) {
+	job := &RefreshJob{}
+	job.WithFeeds(RefreshJobFeeds{
+		&RefreshJobFeed{FeedID: 1, Status: RefreshJobStatusSucceeded},
+		&RefreshJobFeed{FeedID: 2, Status: RefreshJobStatusFailed},
+	})
+
+	if job.Status != RefreshJobStatusFinished {
+		t.Fatalf(`Unexpected status, got %q instead of %q`, job.Status, RefreshJobStatusFinished)
+	}
+}
+
+func TestRefreshJobWithoutFeeds(t *testing.T) {
+	job := &RefreshJob{}
+	job.WithFeeds(RefreshJobFeeds{})
+
+	if job.Total != 0 {
+		t.Fatalf(`Unexpected total, got %d instead of %d`, job.Total, 0)
+	}
+
+	if job.Status != RefreshJobStatusFinished {
+		t.Fatalf(`Unexpected status, got %q instead of %q`, job.Status, RefreshJobStatusFinished)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scopes granted to service accounts, a scope gives access to the API routes of a tag.
+// The routes changing data require the write scope of their tag.
+const (
+	ScopeCategoriesRead  = "categories:read"
+	ScopeCategoriesWrite = "categories:write"
+	ScopeEntriesRead     = "entries:read"
+	ScopeEntriesWrite    = "entries:write"
+	ScopeFeedsRead       = "feeds:read"
+	ScopeFeedsWrite      = "feeds:write"
+	ScopeOPMLRead        = "opml:read"
+	ScopeOPMLWrite       = "opml:write"
+	ScopeUsersRead       = "users:read"
+)
+
+// ServiceAccountScopes lists the scopes that can be granted to service accounts.
+// Changing the account of the owner is never allowed.
+var ServiceAccountScopes = []string{
+	ScopeCategoriesRead,
+	ScopeCategoriesWrite,
+	ScopeEntriesRead,
+	ScopeEntriesWrite,
+	ScopeFeedsRead,
+	ScopeFeedsWrite,
+	ScopeOPMLRead,
+	ScopeOPMLWrite,
+	ScopeUsersRead,
+}
+
+// ServiceAccount is a non-interactive identity used by bots, it acts on the data of its owner
+// with API keys and cannot log in the user interface.
+type ServiceAccount struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Name        string    `json:"name"`
+	Scopes      []string  `json:"scopes"`
+	CategoryIDs []int64   `json:"category_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// HasScope returns true if the service account is allowed to use the given scope.
+func (s *ServiceAccount) HasScope(scope string) bool {
+	for _, item := range s.Scopes {
+		if item == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRestricted returns true if the service account only has access to some categories.
+func (s *ServiceAccount) IsRestricted() bool {
+	return len(s.CategoryIDs) > 0
+}
+
+// HasCategory returns true if the service account has access to the given category.
+func (s *ServiceAccount) HasCategory(categoryID int64) bool {
+	if !s.IsRestricted() {
+		return true
+	}
+
+	for _, id := range s.CategoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate makes sure the name and the scopes of the service account are valid.
+func (s *ServiceAccount) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("The name is mandatory")
+	}
+
+	return ValidateScopes(s.Scopes)
+}
+
+// ServiceAccounts represents a list of service accounts.
+type ServiceAccounts []*ServiceAccount
+
+// ValidateScopes returns an error if a scope cannot be granted to service accounts.
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		valid := false
+		for _, item := range ServiceAccountScopes {
+			if item == scope {
+				valid = true
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf(`Invalid scope %q, valid scopes are: %s`, scope, strings.Join(ServiceAccountScopes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// APIKey authenticates a service account, only the hash of the key is stored.
+type APIKey struct {
+	ID               int64      `json:"id"`
+	ServiceAccountID int64      `json:"service_account_id"`
+	Description      string     `json:"description"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+}
+
+// APIKeys represents a list of API keys.
+type APIKeys []*APIKey
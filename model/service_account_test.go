@@ -0,0 +1,56 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateServiceAccount(t *testing.T) {
+	account := &ServiceAccount{Name: "bot", Scopes: []string{ScopeEntriesRead, ScopeEntriesWrite}}
+	if err := account.Validate(); err != nil {
+		t.Errorf(`A valid service account should not generate any error: %v`, err)
+	}
+
+	account = &ServiceAccount{Name: " ", Scopes: []string{ScopeEntriesRead}}
+	if err := account.Validate(); err == nil {
+		t.Error(`A service account without name should generate an error`)
+	}
+
+	account = &ServiceAccount{Name: "bot", Scopes: []string{"users:write"}}
+	if err := account.Validate(); err == nil {
+		t.Error(`A scope changing the owner account should generate an error`)
+	}
+}
+
+func TestServiceAccountScopes(t *testing.T) {
+	account := &ServiceAccount{Scopes: []string{ScopeFeedsRead}}
+
+	if !account.HasScope(ScopeFeedsRead) {
+		t.Error(`The granted scope should be allowed`)
+	}
+
+	if account.HasScope(ScopeFeedsWrite) {
+		t.Error(`A scope that is not granted should not be allowed`)
+	}
+}
+
+func TestServiceAccountCategories(t *testing.T) {
+	account := &ServiceAccount{}
+	if account.IsRestricted() || !account.HasCategory(42) {
+		t.Error(`A service account without categories should have access to all of them`)
+	}
+
+	account = &ServiceAccount{CategoryIDs: []int64{1, 2}}
+	if !account.IsRestricted() {
+		t.Error(`A service account with categories should be restricted`)
+	}
+
+	if !account.HasCategory(2) {
+		t.Error(`The allowed category should be accessible`)
+	}
+
+	if account.HasCategory(3) {
+		t.Error(`Other categories should not be accessible`)
+	}
+}
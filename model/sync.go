@@ -0,0 +1,66 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyncEntry is the state of an entry sent to the clients keeping a local copy of the entries.
+type SyncEntry struct {
+	ID        int64     `json:"id"`
+	FeedID    int64     `json:"feed_id"`
+	Status    string    `json:"status"`
+	Starred   bool      `json:"starred"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// SyncChanges contains the entries changed and deleted since a sync token.
+type SyncChanges struct {
+	// Token is sent with the next request to receive the following changes.
+	Token string `json:"sync_token"`
+
+	// Reset is true when the token has expired, the local copy must be replaced by the returned entries.
+	Reset bool `json:"reset"`
+
+	// HasMore is true when the changes do not fit in one response.
+	HasMore         bool         `json:"has_more"`
+	Entries         []*SyncEntry `json:"entries"`
+	DeletedEntryIDs []int64      `json:"deleted_entry_ids"`
+}
+
+// SyncToken is the position of a client in the list of entries sorted by change date.
+type SyncToken struct {
+	ChangedAt time.Time
+	EntryID   int64
+}
+
+// String returns the token sent to clients.
+func (s *SyncToken) String() string {
+	return fmt.Sprintf("%d-%d", s.ChangedAt.UnixNano()/int64(time.Microsecond), s.EntryID)
+}
+
+// ParseSyncToken parses a token returned by SyncToken.String.
+func ParseSyncToken(token string) (*SyncToken, error) {
+	parts := strings.Split(token, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid sync token %q", token)
+	}
+
+	micro, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || micro < 0 {
+		return nil, fmt.Errorf("Invalid sync token %q", token)
+	}
+
+	entryID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || entryID < 0 {
+		return nil, fmt.Errorf("Invalid sync token %q", token)
+	}
+
+	return &SyncToken{ChangedAt: time.Unix(0, micro*int64(time.Microsecond)), EntryID: entryID}, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncTokenRoundTrip(t *testing.T) {
+	token := &SyncToken{ChangedAt: time.Date(2019, 3, 1, 10, 30, 0, 123456000, time.UTC), EntryID: 42}
+
+	parsed, err := ParseSyncToken(token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !parsed.ChangedAt.Equal(token.ChangedAt) {
+		t.Errorf(`Unexpected date, got %v instead of %v`, parsed.ChangedAt, token.ChangedAt)
+	}
+
+	if parsed.EntryID != 42 {
+		t.Errorf(`Unexpected entry ID, got %d instead of 42`, parsed.EntryID)
+	}
+}
+
+func TestParseInvalidSyncToken(t *testing.T) {
+	for _, token := range []string{"", "abc", "1-2-3", "x-1", "1-x", "-1-2"} {
+		if _, err := ParseSyncToken(token); err == nil {
+			t.Errorf(`The token %q should be rejected`, token)
+		}
+	}
+}
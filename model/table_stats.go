@@ -0,0 +1,29 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// TableStats represents the size and bloat statistics of a database table.
+type TableStats struct {
+	Name        string
+	LiveTuples  int64
+	DeadTuples  int64
+	TotalSize   int64
+	LastVacuum  *time.Time
+	LastAnalyze *time.Time
+}
+
+// BloatRatio returns the proportion of dead tuples in the table.
+func (t *TableStats) BloatRatio() float64 {
+	if t.LiveTuples+t.DeadTuples == 0 {
+		return 0
+	}
+
+	return float64(t.DeadTuples) / float64(t.LiveTuples+t.DeadTuples)
+}
+
+// TableStatsList represents a list of table statistics.
+type TableStatsList []*TableStats
@@ -0,0 +1,36 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Types of tokens.
+const (
+	TokenTypeEmailVerification = "email_verification"
+	TokenTypeInvitation        = "invitation"
+	TokenTypePasswordReset     = "password_reset"
+)
+
+// Lifetime of tokens.
+const (
+	EmailVerificationTokenTTL = 24 * time.Hour
+	InvitationTokenTTL        = 7 * 24 * time.Hour
+	PasswordResetTokenTTL     = time.Hour
+)
+
+// Token is a single use secret sent to a user, only its hash is stored.
+// The user of an invitation is the administrator who created it.
+type Token struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	Type      string     `json:"type"`
+	Email     string     `json:"email"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+// Tokens is a list of tokens.
+type Tokens []*Token
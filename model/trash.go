@@ -0,0 +1,11 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// Trash contains the removed categories and feeds of a user that can still be restored.
+type Trash struct {
+	Categories Categories `json:"categories"`
+	Feeds      Feeds      `json:"feeds"`
+}
@@ -6,28 +6,50 @@ package model // import "miniflux.app/model"
 
 import (
 	"errors"
+	"fmt"
+	"net/mail"
 	"time"
 
 	"miniflux.app/timezone"
 )
 
+// Limits for the number of entries displayed on each page.
+const (
+	DefaultEntriesPerPage = 100
+	MaxEntriesPerPage     = 1000
+)
+
 // User represents a user in the system.
 type User struct {
-	ID             int64             `json:"id"`
-	Username       string            `json:"username"`
-	Password       string            `json:"password,omitempty"`
-	IsAdmin        bool              `json:"is_admin"`
-	Theme          string            `json:"theme"`
-	Language       string            `json:"language"`
-	Timezone       string            `json:"timezone"`
-	EntryDirection string            `json:"entry_sorting_direction"`
-	LastLoginAt    *time.Time        `json:"last_login_at,omitempty"`
-	Extra          map[string]string `json:"extra"`
+	ID                int64             `json:"id"`
+	Username          string            `json:"username"`
+	Password          string            `json:"password,omitempty"`
+	IsAdmin           bool              `json:"is_admin"`
+	Theme             string            `json:"theme"`
+	Language          string            `json:"language"`
+	Timezone          string            `json:"timezone"`
+	EntryDirection    string            `json:"entry_sorting_direction"`
+	EntriesPerPage    int               `json:"entries_per_page"`
+	ShowReadEntries   bool              `json:"show_read_entries"`
+	ShowAbsoluteTime  bool              `json:"show_absolute_time"`
+	ImageDisplay      string            `json:"image_display"`
+	NSFWDisplay       string            `json:"nsfw_display"`
+	JunkThreshold     int               `json:"junk_threshold"`
+	QuietHoursStart   string            `json:"quiet_hours_start"`
+	QuietHoursEnd     string            `json:"quiet_hours_end"`
+	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
+	Extra             map[string]string `json:"extra"`
+	KeyboardShortcuts KeyboardShortcuts `json:"keyboard_shortcuts"`
+	FrozenUntil       *time.Time        `json:"frozen_until,omitempty"`
+	FreezeMessage     string            `json:"freeze_message,omitempty"`
+	Email             string            `json:"email,omitempty"`
+	Verified          bool              `json:"verified"`
+	Pending           bool              `json:"pending"`
 }
 
 // NewUser returns a new User.
 func NewUser() *User {
-	return &User{Extra: make(map[string]string)}
+	return &User{Extra: make(map[string]string), EntriesPerPage: DefaultEntriesPerPage}
 }
 
 // ValidateUserCreation validates new user.
@@ -41,6 +63,34 @@ func (u User) ValidateUserCreation() error {
 
 // ValidateUserModification validates user modification payload.
 func (u User) ValidateUserModification() error {
+	if u.EntriesPerPage != 0 {
+		if err := ValidateEntriesPerPage(u.EntriesPerPage); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateQuietHours(u.QuietHoursStart, u.QuietHoursEnd); err != nil {
+		return err
+	}
+
+	if u.Email != "" {
+		if err := ValidateEmail(u.Email); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateImageDisplay(u.ImageDisplay); err != nil {
+		return err
+	}
+
+	if err := ValidateNSFWDisplay(u.NSFWDisplay); err != nil {
+		return err
+	}
+
+	if err := ValidateJunkThreshold(u.JunkThreshold); err != nil {
+		return err
+	}
+
 	if u.Theme != "" {
 		return ValidateTheme(u.Theme)
 	}
@@ -74,11 +124,35 @@ func (u User) ValidatePassword() error {
 	return nil
 }
 
+// ValidateEmail makes sure the email address is a bare address without display name.
+func ValidateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return errors.New("The email address is invalid")
+	}
+
+	return nil
+}
+
+// ValidateEntriesPerPage makes sure the number of entries per page is within the allowed range.
+func ValidateEntriesPerPage(entriesPerPage int) error {
+	if entriesPerPage < 1 || entriesPerPage > MaxEntriesPerPage {
+		return fmt.Errorf("The number of entries per page must be between 1 and %d", MaxEntriesPerPage)
+	}
+
+	return nil
+}
+
 // UseTimezone converts last login date to the given timezone.
 func (u *User) UseTimezone(tz string) {
 	if u.LastLoginAt != nil {
 		*u.LastLoginAt = timezone.Convert(tz, *u.LastLoginAt)
 	}
+
+	if u.FrozenUntil != nil {
+		frozenUntil := timezone.Convert(tz, *u.FrozenUntil)
+		u.FrozenUntil = &frozenUntil
+	}
 }
 
 // Users represents a list of users.
@@ -58,4 +58,29 @@ func TestValidateUserModification(t *testing.T) {
 	if err := user.ValidateUserModification(); err == nil {
 		t.Error(`An invalid password should generate an error`)
 	}
+
+	user = &User{EntriesPerPage: 50}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid number of entries per page should not generate any errors`)
+	}
+
+	user = &User{EntriesPerPage: -1}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`A negative number of entries per page should generate an error`)
+	}
+
+	user = &User{EntriesPerPage: MaxEntriesPerPage + 1}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`Too many entries per page should generate an error`)
+	}
+
+	user = &User{Email: "john@example.org"}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid email address should not generate any errors`)
+	}
+
+	user = &User{Email: "John <john@example.org>"}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`An email address with a display name should generate an error`)
+	}
 }
@@ -0,0 +1,46 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// MaxWriteFreezeDuration is the longest write freeze, in seconds.
+const MaxWriteFreezeDuration = 7 * 24 * 3600
+
+// IsFrozen returns true if the changes of the user are rejected at the given time, during a migration or an export.
+func (u *User) IsFrozen(now time.Time) bool {
+	return u.FrozenUntil != nil && now.Before(*u.FrozenUntil)
+}
+
+// FreezeRetryAfter returns the number of seconds before the end of the write freeze, at least one second.
+func (u *User) FreezeRetryAfter(now time.Time) int {
+	if !u.IsFrozen(now) {
+		return 0
+	}
+
+	return int(math.Ceil(u.FrozenUntil.Sub(now).Seconds()))
+}
+
+// FreezeError returns the error sent to the clients of a frozen user, the message of the administrator when there is one.
+func (u *User) FreezeError() error {
+	if u.FreezeMessage != "" {
+		return errors.New(u.FreezeMessage)
+	}
+
+	return errors.New("The changes of this account are temporarily disabled")
+}
+
+// ValidateWriteFreezeDuration makes sure the duration of a write freeze is between one second and a week.
+func ValidateWriteFreezeDuration(duration int) error {
+	if duration < 1 || duration > MaxWriteFreezeDuration {
+		return errors.New("The duration of the write freeze must be between 1 second and 7 days")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserIsFrozen(t *testing.T) {
+	now := time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	user := &User{}
+	if user.IsFrozen(now) || user.FreezeRetryAfter(now) != 0 {
+		t.Fatal(`A user without write freeze should not be frozen`)
+	}
+
+	until := now.Add(90*time.Second + time.Millisecond)
+	user.FrozenUntil = &until
+	if !user.IsFrozen(now) {
+		t.Fatal(`The user should be frozen`)
+	}
+
+	if retryAfter := user.FreezeRetryAfter(now); retryAfter != 91 {
+		t.Fatalf(`Unexpected retry delay, got %d instead of 91`, retryAfter)
+	}
+
+	if user.IsFrozen(until) {
+		t.Fatal(`The write freeze should end at the given time`)
+	}
+}
+
+func TestValidateWriteFreezeDuration(t *testing.T) {
+	for _, duration := range []int{1, 3600, MaxWriteFreezeDuration} {
+		if err := ValidateWriteFreezeDuration(duration); err != nil {
+			t.Errorf(`The duration %d should be accepted`, duration)
+		}
+	}
+
+	for _, duration := range []int{-1, 0, MaxWriteFreezeDuration + 1} {
+		if err := ValidateWriteFreezeDuration(duration); err == nil {
+			t.Errorf(`The duration %d should be rejected`, duration)
+		}
+	}
+}
+
+func TestUserFreezeError(t *testing.T) {
+	user := &User{}
+	if err := user.FreezeError(); err == nil || err.Error() != "The changes of this account are temporarily disabled" {
+		t.Fatalf(`Unexpected default error, got %v`, err)
+	}
+
+	user.FreezeMessage = "Migration in progress"
+	if err := user.FreezeError(); err == nil || err.Error() != "Migration in progress" {
+		t.Fatalf(`The message of the administrator should be used, got %v`, err)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package adblock removes ads and trackers from entry contents with the element hiding rules of EasyList-style filter lists.
+
+Only the cosmetic rules are supported:
+
+	##.ad-banner                                hides the elements on every website
+	example.org,~www.example.org##.sidebar-ad   hides the elements on some websites only
+	example.org#@#.ad-banner                    disables a rule on some websites, or everywhere without domain
+
+The network rules and the extended syntaxes (#?#, #$#) are ignored.
+
+*/
+package adblock // import "miniflux.app/reader/adblock"
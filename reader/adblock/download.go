@@ -0,0 +1,43 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package adblock // import "miniflux.app/reader/adblock"
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+)
+
+// Download updates the content of the filter list, it is left unchanged
+// when the list has not been modified since the previous download.
+func Download(list *model.FilterList) error {
+	clt := client.New(list.URL)
+	clt.WithCacheHeaders(list.EtagHeader, list.LastModifiedHeader)
+
+	response, err := clt.Get()
+	if err != nil {
+		return err
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("adblock: unable to download the filter list %q (status code %d)", list.URL, response.StatusCode)
+	}
+
+	if !response.IsModified(list.EtagHeader, list.LastModifiedHeader) {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	list.Content = string(body)
+	list.EtagHeader = response.ETag
+	list.LastModifiedHeader = response.LastModified
+	return nil
+}
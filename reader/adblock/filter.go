@@ -0,0 +1,238 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package adblock // import "miniflux.app/reader/adblock"
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	"miniflux.app/logger"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+)
+
+// Number of generic selectors compiled together, a document is traversed once per group.
+const selectorsPerGroup = 500
+
+var (
+	mutex   sync.RWMutex
+	current = NewFilter()
+)
+
+// Filter holds the compiled element hiding rules of a set of filter lists.
+type Filter struct {
+	generic  []cascadia.Selector
+	specific []*rule
+	size     int
+}
+
+type rule struct {
+	text     string
+	selector cascadia.Selector
+	domains  []string
+	excluded []string
+}
+
+// NewFilter parses the element hiding rules of the given filter lists, the invalid selectors are ignored.
+func NewFilter(lists ...string) *Filter {
+	var rules []*rule
+	exceptions := make(map[string][]string)
+
+	for _, list := range lists {
+		for _, line := range strings.Split(list, "\n") {
+			domains, selector, isException := parseLine(line)
+			if selector == "" {
+				continue
+			}
+
+			included, excluded := parseDomains(domains)
+			if isException {
+				if len(included) == 0 {
+					included = []string{""}
+				}
+				exceptions[selector] = append(exceptions[selector], included...)
+				continue
+			}
+
+			rules = append(rules, &rule{text: selector, domains: included, excluded: excluded})
+		}
+	}
+
+	filter := &Filter{}
+	var generic []string
+
+	for _, r := range rules {
+		if disabled, found := exceptions[r.text]; found {
+			if containsDomain(disabled, "") {
+				continue
+			}
+			r.excluded = append(r.excluded, disabled...)
+		}
+
+		selector, err := cascadia.Compile(r.text)
+		if err != nil {
+			continue
+		}
+
+		filter.size++
+		if len(r.domains) == 0 && len(r.excluded) == 0 {
+			generic = append(generic, r.text)
+			continue
+		}
+
+		r.selector = selector
+		filter.specific = append(filter.specific, r)
+	}
+
+	for start := 0; start < len(generic); start += selectorsPerGroup {
+		end := start + selectorsPerGroup
+		if end > len(generic) {
+			end = len(generic)
+		}
+
+		// The selectors are valid one by one, so is their group.
+		filter.generic = append(filter.generic, cascadia.MustCompile(strings.Join(generic[start:end], ", ")))
+	}
+
+	return filter
+}
+
+// Len returns the number of element hiding rules.
+func (f *Filter) Len() int {
+	return f.size
+}
+
+// Apply removes the elements hidden on the website of the page from the content.
+func (f *Filter) Apply(pageURL, content string) string {
+	if f.size == 0 || content == "" {
+		return content
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	host := hostname(pageURL)
+	selectors := make([]cascadia.Selector, 0, len(f.generic))
+	selectors = append(selectors, f.generic...)
+	for _, r := range f.specific {
+		if r.matches(host) {
+			selectors = append(selectors, r.selector)
+		}
+	}
+
+	removed := 0
+	for _, selector := range selectors {
+		elements := doc.FindMatcher(selector)
+		removed += elements.Length()
+		elements.Remove()
+	}
+
+	if removed == 0 {
+		return content
+	}
+
+	logger.Debug("[Adblock] Removed %d elements from %q", removed, pageURL)
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func (r *rule) matches(host string) bool {
+	for _, domain := range r.excluded {
+		if domain == "" || matchDomain(host, domain) {
+			return false
+		}
+	}
+
+	if len(r.domains) == 0 {
+		return true
+	}
+
+	for _, domain := range r.domains {
+		if matchDomain(host, domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Register replaces the filter applied to the entries.
+func Register(filter *Filter) {
+	mutex.Lock()
+	current = filter
+	mutex.Unlock()
+}
+
+// Apply removes the hidden elements from the content with the registered filter.
+func Apply(pageURL, content string) string {
+	mutex.RLock()
+	filter := current
+	mutex.RUnlock()
+
+	return filter.Apply(pageURL, content)
+}
+
+// parseLine returns the domains and the selector of an element hiding rule,
+// the selector is empty for the other lines.
+func parseLine(line string) (domains, selector string, isException bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") {
+		return "", "", false
+	}
+
+	if index := strings.Index(line, "#@#"); index >= 0 {
+		return line[:index], strings.TrimSpace(line[index+3:]), true
+	}
+
+	if index := strings.Index(line, "##"); index >= 0 {
+		return line[:index], strings.TrimSpace(line[index+2:]), false
+	}
+
+	return "", "", false
+}
+
+func parseDomains(domains string) (included, excluded []string) {
+	for _, domain := range strings.Split(domains, ",") {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		switch {
+		case domain == "" || domain == "~":
+		case strings.HasPrefix(domain, "~"):
+			excluded = append(excluded, domain[1:])
+		default:
+			included = append(included, domain)
+		}
+	}
+
+	return included, excluded
+}
+
+func hostname(pageURL string) string {
+	host := url.Domain(pageURL)
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+
+	return strings.ToLower(host)
+}
+
+// matchDomain returns true if the host is the domain or one of its subdomains.
+func matchDomain(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+func containsDomain(domains []string, domain string) bool {
+	for _, item := range domains {
+		if item == domain {
+			return true
+		}
+	}
+
+	return false
+}
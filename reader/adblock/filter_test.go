@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package adblock // import "miniflux.app/reader/adblock"
+
+import "testing"
+
+const testList = `[Adblock Plus 2.0]
+! Title: Test list
+||ads.example.com^$third-party
+##.ad-banner
+###sponsor
+example.org##.sidebar-ad
+example.org,~www.example.org##.promo
+news.example.com#@#.ad-banner
+example.org#?#div:-abp-has(> .ad)
+##div[invalid
+`
+
+func TestFilterLen(t *testing.T) {
+	if size := NewFilter(testList).Len(); size != 4 {
+		t.Errorf(`Unexpected number of rules, got %d instead of 4`, size)
+	}
+}
+
+func TestFilterGenericRules(t *testing.T) {
+	input := `<p>Article</p><div class="ad-banner">Ad</div><div id="sponsor">Sponsor</div>`
+	output := NewFilter(testList).Apply("https://blog.example.net/article", input)
+	expected := `<p>Article</p>`
+
+	if output != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, output, expected)
+	}
+}
+
+func TestFilterDomainRules(t *testing.T) {
+	filter := NewFilter(testList)
+	input := `<p>Article</p><aside class="sidebar-ad">Ad</aside><p class="promo">Promo</p>`
+
+	scenarios := map[string]string{
+		"https://example.org/article":      `<p>Article</p>`,
+		"https://blog.example.org/article": `<p>Article</p>`,
+		"https://www.example.org/article":  `<p>Article</p><p class="promo">Promo</p>`,
+		"https://example.com/article":      input,
+	}
+
+	for pageURL, expected := range scenarios {
+		if output := filter.Apply(pageURL, input); output != expected {
+			t.Errorf(`Unexpected output for %s, got %q instead of %q`, pageURL, output, expected)
+		}
+	}
+}
+
+func TestFilterExceptions(t *testing.T) {
+	filter := NewFilter(testList)
+	input := `<p>Article</p><div class="ad-banner">Ad</div>`
+
+	if output := filter.Apply("https://news.example.com/article", input); output != input {
+		t.Errorf(`The rule should be disabled by the exception, got %q`, output)
+	}
+
+	if output := NewFilter(testList, "#@#.ad-banner").Apply("https://example.net/article", input); output != input {
+		t.Errorf(`The rule should be disabled everywhere by the generic exception, got %q`, output)
+	}
+}
+
+func TestEmptyFilter(t *testing.T) {
+	input := `<div class="ad-banner">Ad</div>`
+	if output := NewFilter().Apply("https://example.org/", input); output != input {
+		t.Errorf(`An empty filter should not change the content, got %q`, output)
+	}
+}
+
+func TestRegisteredFilter(t *testing.T) {
+	defer Register(NewFilter())
+
+	Register(NewFilter("##.ad-banner"))
+	if output := Apply("https://example.org/", `<p>Text</p><div class="ad-banner">Ad</div>`); output != `<p>Text</p>` {
+		t.Errorf(`Unexpected output, got %q`, output)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/nsfw"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 )
@@ -38,6 +39,12 @@ type atomEntry struct {
 	Content    atomContent    `xml:"content"`
 	MediaGroup atomMediaGroup `xml:"http://search.yahoo.com/mrss/ group"`
 	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Ratings    []string       `xml:"http://search.yahoo.com/mrss/ rating"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
 }
 
 type atomAuthor struct {
@@ -59,7 +66,8 @@ type atomContent struct {
 }
 
 type atomMediaGroup struct {
-	Description string `xml:"http://search.yahoo.com/mrss/ description"`
+	Description string   `xml:"http://search.yahoo.com/mrss/ description"`
+	Ratings     []string `xml:"http://search.yahoo.com/mrss/ rating"`
 }
 
 func (a *atomFeed) Transform() *model.Feed {
@@ -102,6 +110,7 @@ func (a *atomEntry) Transform() *model.Entry {
 	entry.Content = getContent(a)
 	entry.Title = getTitle(a)
 	entry.Enclosures = getEnclosures(a)
+	entry.NSFW = isNSFW(a)
 	return entry
 }
 
@@ -225,3 +234,22 @@ func getAuthor(author atomAuthor) string {
 
 	return ""
 }
+
+func isNSFW(a *atomEntry) bool {
+	// Media RSS ratings are allowed on the entry and inside its media group.
+	for _, ratings := range [][]string{a.Ratings, a.MediaGroup.Ratings} {
+		for _, rating := range ratings {
+			if nsfw.IsExplicitRating(rating) {
+				return true
+			}
+		}
+	}
+
+	for _, category := range a.Categories {
+		if nsfw.IsExplicitCategory(category.Term) {
+			return true
+		}
+	}
+
+	return false
+}
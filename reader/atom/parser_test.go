@@ -551,6 +551,48 @@ func TestParseEntryWithPublishedAndUpdated(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithNSFWIndicators(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/">
+	  <title>Example Feed</title>
+	  <link href="http://example.org/"/>
+
+	  <entry>
+		<title>Entry 1</title>
+		<link href="http://example.org/1"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<category term="technology"/>
+	  </entry>
+
+	  <entry>
+		<title>Entry 2</title>
+		<link href="http://example.org/2"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6b</id>
+		<category term="nsfw"/>
+	  </entry>
+
+	  <entry>
+		<title>Entry 3</title>
+		<link href="http://example.org/3"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6c</id>
+		<media:group>
+		  <media:rating scheme="urn:simple">adult</media:rating>
+		</media:group>
+	  </entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []bool{false, true, true} {
+		if feed.Entries[i].NSFW != expected {
+			t.Errorf("Incorrect NSFW flag for entry #%d, got: %v", i+1, feed.Entries[i].NSFW)
+		}
+	}
+}
+
 func TestParseInvalidXml(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
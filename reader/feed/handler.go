@@ -5,19 +5,28 @@
 package feed // import "miniflux.app/reader/feed"
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"miniflux.app/crypto"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/adblock"
 	"miniflux.app/reader/browser"
 	"miniflux.app/reader/icon"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/watch"
 	"miniflux.app/storage"
+	"miniflux.app/summarizer"
 	"miniflux.app/timer"
 )
 
@@ -27,16 +36,27 @@ var (
 	errCategoryNotFound = "Category not found for this user"
 )
 
+const savedPagesFeedTitle = "Saved pages"
+
 // Handler contains all the logic to create and refresh feeds.
 type Handler struct {
-	store      *storage.Storage
+	store storage.Store
+
+	// archiveSize is the number of fetched documents kept for each feed, zero disables the archive.
+	archiveSize int
+
+	// approval makes the new subscriptions of the users who are not administrators pending.
+	approval bool
+
+	// summarizer generates the summary of the new entries of the feeds asking for it, nil disables the summaries.
+	summarizer summarizer.Summarizer
 }
 
 // CreateFeed fetch, parse and store a new feed.
-func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password string) (*model.Feed, error) {
+func (h *Handler) CreateFeed(ctx context.Context, userID, categoryID int64, url string, crawler bool, userAgent, username, password string) (*model.Feed, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", url))
 
-	if !h.store.CategoryExists(userID, categoryID) {
+	if !h.store.CategoryExists(ctx, userID, categoryID) {
 		return nil, errors.NewLocalizedError(errCategoryNotFound)
 	}
 
@@ -48,11 +68,11 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 		return nil, requestErr
 	}
 
-	if h.store.FeedURLExists(userID, response.EffectiveURL) {
+	if h.store.FeedURLExists(ctx, userID, response.EffectiveURL) {
 		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
 	}
 
-	subscription, parseErr := parser.ParseFeed(response.String())
+	subscription, parseErr := parser.ParseFeed(response.EffectiveURL, response.String())
 	if parseErr != nil {
 		return nil, parseErr
 	}
@@ -63,25 +83,123 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 	subscription.WithClientResponse(response)
 	subscription.CheckedNow()
 
-	processor.ProcessFeedEntries(h.store, subscription)
+	// The entries of pending feeds are downloaded after the approval.
+	if h.NeedsApproval(ctx, userID) {
+		subscription.Pending = true
+		subscription.Entries = nil
+	} else {
+		processor.ProcessFeedEntries(ctx, h.store, subscription)
+	}
 
-	if storeErr := h.store.CreateFeed(subscription); storeErr != nil {
+	if storeErr := h.store.CreateFeed(ctx, subscription); storeErr != nil {
 		return nil, storeErr
 	}
 
 	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", subscription.ID)
 
-	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL)
+	checkFeedIcon(ctx, h.store, subscription.ID, subscription.SiteURL)
+	return subscription, nil
+}
+
+// CreatePageWatchFeed subscribes to the changes of the region of a web page matching a CSS selector.
+// The current content of the region is stored as the first entry.
+func (h *Handler) CreatePageWatchFeed(ctx context.Context, userID, categoryID int64, url, selector, userAgent, username, password string) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreatePageWatchFeed] url=%s", url))
+
+	if !h.store.CategoryExists(ctx, userID, categoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	request := client.New(url)
+	request.WithCredentials(username, password)
+	request.WithUserAgent(userAgent)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	if h.store.FeedURLExists(ctx, userID, response.EffectiveURL) {
+		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
+	}
+
+	body := response.String()
+	page, extractErr := watch.Extract(body, selector)
+	if extractErr != nil {
+		return nil, extractErr
+	}
+
+	subscription := &model.Feed{
+		UserID:        userID,
+		SiteURL:       response.EffectiveURL,
+		Title:         page.Title,
+		WatchSelector: selector,
+	}
+	if subscription.Title == "" {
+		subscription.Title = response.EffectiveURL
+	}
+
+	subscription.WithCategoryID(categoryID)
+	subscription.WithBrowsingParameters(false, userAgent, username, password)
+	subscription.WithClientResponse(response)
+	subscription.CheckedNow()
+	subscription.Pending = h.NeedsApproval(ctx, userID)
+
+	if storeErr := h.store.CreateFeed(ctx, subscription); storeErr != nil {
+		return nil, storeErr
+	}
+
+	if subscription.Pending {
+		return subscription, nil
+	}
+
+	printer := locale.NewPrinter(h.store.UserLanguage(ctx, userID))
+	if watchErr := h.updateWatchedPage(ctx, subscription, body, printer); watchErr != nil {
+		return nil, watchErr
+	}
+
+	checkFeedIcon(ctx, h.store, subscription.ID, subscription.SiteURL)
 	return subscription, nil
 }
 
+// PreviewFeed fetch and parse a feed without storing anything, only the latest entries are returned.
+func (h *Handler) PreviewFeed(ctx context.Context, url string, limit int, userAgent, username, password string) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:PreviewFeed] feedUrl=%s", url))
+
+	request := client.New(url)
+	request.WithCredentials(username, password)
+	request.WithUserAgent(userAgent)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	preview, parseErr := parser.ParseFeed(response.EffectiveURL, response.String())
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	preview.WithBrowsingParameters(false, userAgent, username, password)
+	preview.WithClientResponse(response)
+
+	sort.SliceStable(preview.Entries, func(i, j int) bool {
+		return preview.Entries[i].Date.After(preview.Entries[j].Date)
+	})
+
+	if limit > 0 && len(preview.Entries) > limit {
+		preview.Entries = preview.Entries[:limit]
+	}
+
+	processor.ProcessFeedEntries(ctx, h.store, preview)
+	return preview, nil
+}
+
 // RefreshFeed fetch and update a feed if necessary.
-func (h *Handler) RefreshFeed(userID, feedID int64) error {
+func (h *Handler) RefreshFeed(ctx context.Context, userID, feedID int64) (err error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeed] feedID=%d", feedID))
-	userLanguage := h.store.UserLanguage(userID)
+	userLanguage := h.store.UserLanguage(ctx, userID)
 	printer := locale.NewPrinter(userLanguage)
 
-	originalFeed, storeErr := h.store.FeedByID(userID, feedID)
+	originalFeed, storeErr := h.store.FeedByID(ctx, userID, feedID)
 	if storeErr != nil {
 		return storeErr
 	}
@@ -90,8 +208,21 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return errors.NewLocalizedError(errNotFound, feedID)
 	}
 
+	// Saved pages are added one by one, there is nothing to download.
+	// Pending feeds are downloaded once approved by an administrator.
+	if originalFeed.IsSavedPages() || originalFeed.Pending {
+		return nil
+	}
+
 	originalFeed.CheckedNow()
 
+	// The result of every attempt is kept for the feed statistics.
+	defer func() {
+		if recordErr := h.store.RecordFeedFetch(ctx, feedID, err == nil); recordErr != nil {
+			logger.Error("[Handler:RefreshFeed] Feed #%d: %v", feedID, recordErr)
+		}
+	}()
+
 	request := client.New(originalFeed.FeedURL)
 	request.WithCredentials(originalFeed.Username, originalFeed.Password)
 	request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
@@ -99,63 +230,250 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	response, requestErr := browser.Exec(request)
 	if requestErr != nil {
 		originalFeed.WithError(requestErr.Localize(printer))
-		h.store.UpdateFeedError(originalFeed)
+		h.store.UpdateFeedError(ctx, originalFeed)
 		return requestErr
 	}
 
 	if response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.String())
-		if parseErr != nil {
-			originalFeed.WithError(parseErr.Localize(printer))
-			h.store.UpdateFeedError(originalFeed)
-			return parseErr
+		body := response.String()
+		if h.archiveSize > 0 {
+			h.archiveResponse(ctx, originalFeed, response, body)
 		}
 
-		originalFeed.Entries = updatedFeed.Entries
-		processor.ProcessFeedEntries(h.store, originalFeed)
+		if originalFeed.IsPageWatch() {
+			if watchErr := h.updateWatchedPage(ctx, originalFeed, body, printer); watchErr != nil {
+				originalFeed.WithError(watchErr.Error())
+				h.store.UpdateFeedError(ctx, originalFeed)
+				return watchErr
+			}
+		} else {
+			updatedFeed, parseErr := parser.ParseFeed(response.EffectiveURL, body)
+			if parseErr != nil {
+				originalFeed.WithError(parseErr.Localize(printer))
+				h.store.UpdateFeedError(ctx, originalFeed)
+				return parseErr
+			}
+
+			originalFeed.Entries = updatedFeed.Entries
+			processor.ProcessFeedEntries(ctx, h.store, originalFeed)
+			h.summarizeNewEntries(ctx, originalFeed)
+
+			// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
+			if storeErr := h.store.UpdateEntries(ctx, originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
+				originalFeed.WithError(storeErr.Error())
+				h.store.UpdateFeedError(ctx, originalFeed)
+				return storeErr
+			}
 
-		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
-			originalFeed.WithError(storeErr.Error())
-			h.store.UpdateFeedError(originalFeed)
-			return storeErr
+			if count, trimErr := h.store.TrimFeedEntries(ctx, originalFeed); trimErr != nil {
+				logger.Error("[Handler:RefreshFeed] Feed #%d: %v", feedID, trimErr)
+			} else if count > 0 {
+				logger.Debug("[Handler:RefreshFeed] Feed #%d: %d entries over the limit of %d", feedID, count, originalFeed.MaxEntries)
+			}
 		}
 
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
 		originalFeed.WithClientResponse(response)
-		checkFeedIcon(h.store, originalFeed.ID, originalFeed.SiteURL)
+		checkFeedIcon(ctx, h.store, originalFeed.ID, originalFeed.SiteURL)
 	} else {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d not modified", feedID)
 	}
 
 	originalFeed.ResetErrorCounter()
 
-	if storeErr := h.store.UpdateFeed(originalFeed); storeErr != nil {
+	if storeErr := h.store.UpdateFeedRefresh(ctx, originalFeed); storeErr != nil {
 		originalFeed.WithError(storeErr.Error())
-		h.store.UpdateFeedError(originalFeed)
+		h.store.UpdateFeedError(ctx, originalFeed)
 		return storeErr
 	}
 
 	return nil
 }
 
+// SaveURL downloads a web page and stores it as an entry of the "Saved pages" feed of the user.
+func (h *Handler) SaveURL(ctx context.Context, userID int64, websiteURL string) (*model.Entry, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:SaveURL] url=%s", websiteURL))
+
+	feed, err := h.savedPagesFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := scraper.FetchPage(websiteURL, "", "", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	content := rewrite.Rewriter(page.URL, page.Content, "")
+	content = adblock.Apply(page.URL, content)
+	content = sanitizer.Sanitize(page.URL, content)
+
+	title := page.Title
+	if title == "" {
+		title = page.URL
+	}
+
+	entry := &model.Entry{
+		UserID:  userID,
+		FeedID:  feed.ID,
+		Hash:    crypto.Hash(page.URL),
+		URL:     page.URL,
+		Title:   title,
+		Content: content,
+		Date:    time.Now(),
+	}
+
+	if err := h.store.SaveEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	entry.Feed = feed
+	return entry, nil
+}
+
+// updateWatchedPage creates an entry listing the lines added and removed in the watched region since the previous refresh.
+func (h *Handler) updateWatchedPage(ctx context.Context, feed *model.Feed, body string, printer *locale.Printer) error {
+	page, extractErr := watch.Extract(body, feed.WatchSelector)
+	if extractErr != nil {
+		return fmt.Errorf("%s", extractErr.Localize(printer))
+	}
+
+	previous, err := h.store.WatchedContent(ctx, feed.ID)
+	if err != nil {
+		return err
+	}
+
+	changes := watch.Diff(watch.Lines(previous), page.Lines)
+	if len(changes) == 0 {
+		logger.Debug("[Handler:UpdateWatchedPage] Feed #%d has no change", feed.ID)
+		return nil
+	}
+
+	// Entry titles must be unique, the date of the change is part of the title.
+	now := time.Now()
+	entry := &model.Entry{
+		UserID:  feed.UserID,
+		FeedID:  feed.ID,
+		Hash:    crypto.Hash(fmt.Sprintf("%s:%d", feed.FeedURL, now.UnixNano())),
+		URL:     feed.SiteURL,
+		Title:   printer.Printf("entry.watch.title", feed.Title, now.UTC().Format("2006-01-02 15:04:05.000 UTC")),
+		Content: sanitizer.Sanitize(feed.SiteURL, watch.Render(changes)),
+		Date:    now,
+	}
+
+	if err := h.store.SaveEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	return h.store.UpdateWatchedContent(ctx, feed.ID, page.Content())
+}
+
+// savedPagesFeed returns the virtual feed of saved web pages, it is created in the first category of the user if necessary.
+func (h *Handler) savedPagesFeed(ctx context.Context, userID int64) (*model.Feed, error) {
+	if feedID := h.store.FeedIDByURL(ctx, userID, model.SavedPagesFeedURL); feedID > 0 {
+		return h.store.FeedByID(ctx, userID, feedID)
+	}
+
+	category, err := h.store.FirstCategory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	feed := &model.Feed{
+		UserID:  userID,
+		FeedURL: model.SavedPagesFeedURL,
+		Title:   savedPagesFeedTitle,
+	}
+	feed.WithCategoryID(category.ID)
+	feed.CheckedNow()
+
+	if err := h.store.CreateFeed(ctx, feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// EnableResponseArchive keeps the last documents fetched for each feed to debug parsing errors.
+func (h *Handler) EnableResponseArchive(size int) {
+	h.archiveSize = size
+}
+
+// RequireSubscriptionApproval makes the new subscriptions of the users who are not administrators
+// pending until an administrator approves them.
+func (h *Handler) RequireSubscriptionApproval() {
+	h.approval = true
+}
+
+// EnableSummaries generates a summary of the new entries of the feeds asking for it.
+func (h *Handler) EnableSummaries(s summarizer.Summarizer) {
+	h.summarizer = s
+}
+
+// summarizeNewEntries generates the summary of the unread entries which are not stored yet,
+// the entries are stored without summary when the summarizer fails.
+func (h *Handler) summarizeNewEntries(ctx context.Context, feed *model.Feed) {
+	if h.summarizer == nil || !feed.SummarizeEntries {
+		return
+	}
+
+	for _, entry := range feed.Entries {
+		if entry.Status == model.EntryStatusRead || h.store.EntryURLExists(ctx, feed.UserID, entry.URL) {
+			continue
+		}
+
+		summary, err := summarizer.SummarizeEntry(h.summarizer, entry)
+		if err != nil {
+			logger.Error("[Handler:SummarizeNewEntries] Feed #%d: %v", feed.ID, err)
+			continue
+		}
+
+		entry.Summary = summary
+	}
+}
+
+// NeedsApproval returns true if the new subscriptions of the given user must be approved by an administrator.
+func (h *Handler) NeedsApproval(ctx context.Context, userID int64) bool {
+	return h.approval && !h.store.UserIsAdmin(ctx, userID)
+}
+
+func (h *Handler) archiveResponse(ctx context.Context, feed *model.Feed, response *client.Response, body string) {
+	archived := &model.FeedResponse{
+		FeedID:       feed.ID,
+		URL:          response.EffectiveURL,
+		StatusCode:   response.StatusCode,
+		ContentType:  response.ContentType,
+		ETag:         response.ETag,
+		LastModified: response.LastModified,
+		Content:      []byte(body),
+	}
+
+	if err := h.store.ArchiveFeedResponse(ctx, archived, h.archiveSize); err != nil {
+		logger.Error("[Handler:RefreshFeed] feed #%d: %v", feed.ID, err)
+	}
+}
+
 // NewFeedHandler returns a feed handler.
-func NewFeedHandler(store *storage.Storage) *Handler {
-	return &Handler{store}
+func NewFeedHandler(store storage.Store) *Handler {
+	return &Handler{store: store}
 }
 
-func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string) {
-	if !store.HasIcon(feedID) {
+func checkFeedIcon(ctx context.Context, store storage.IconStore, feedID int64, websiteURL string) {
+	if !store.HasIcon(ctx, feedID) {
 		icon, err := icon.FindIcon(websiteURL)
 		if err != nil {
 			logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
 		} else if icon == nil {
 			logger.Debug("CheckFeedIcon: No icon found (feedID=%d websiteURL=%s)", feedID, websiteURL)
 		} else {
-			if err := store.CreateFeedIcon(feedID, icon); err != nil {
+			if err := store.CreateFeedIcon(ctx, feedID, icon); err != nil {
 				logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
 			}
 		}
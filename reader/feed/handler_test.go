@@ -0,0 +1,568 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+	"miniflux.app/storage"
+	"miniflux.app/storage/memory"
+)
+
+const testFeed = `<?xml version="1.0"?>
+<rss version="2.0">
+<channel>
+	<title>Example</title>
+	<link>%s</link>
+	<item>
+		<title>First item</title>
+		<link>%s/1</link>
+		<guid>1</guid>
+	</item>
+	<item>
+		<title>Second item</title>
+		<link>%s/2</link>
+		<guid>2</guid>
+	</item>
+</channel>
+</rss>`
+
+func newTestServer(body *string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/feed.xml" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, *body, server.URL, server.URL, server.URL)
+	}))
+	return server
+}
+
+func newTestStore(t *testing.T) (*memory.Store, *model.Category) {
+	store := memory.New()
+	category := &model.Category{UserID: 1, Title: "All"}
+	if err := store.CreateCategory(context.Background(), category); err != nil {
+		t.Fatal(err)
+	}
+	return store, category
+}
+
+func TestCreateFeed(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Example" {
+		t.Errorf(`Unexpected feed title, got %q`, feed.Title)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 2 {
+		t.Errorf(`Unexpected number of entries, got %d instead of 2`, len(entries))
+	}
+
+	if count := store.CountUnreadEntries(ctx, 1); count != 2 {
+		t.Errorf(`Unexpected number of unread entries, got %d instead of 2`, count)
+	}
+
+	if _, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", ""); err == nil {
+		t.Error(`Subscribing twice to the same feed should return an error`)
+	}
+}
+
+func TestCreateFeedWithUnknownCategory(t *testing.T) {
+	store, _ := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	if _, err := handler.CreateFeed(context.Background(), 1, 42, "http://example.org/feed.xml", false, "", "", ""); err == nil {
+		t.Fatal(`An error should be returned when the category does not exist`)
+	}
+}
+
+func TestCreateFeedWithApproval(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+	handler.RequireSubscriptionApproval()
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !feed.Pending {
+		t.Fatal(`The feed of a user should be pending`)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 0 {
+		t.Errorf(`The entries of a pending feed should not be stored, got %d entries`, len(entries))
+	}
+
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetches := store.Fetches(feed.ID); len(fetches) != 0 {
+		t.Errorf(`A pending feed should not be refreshed, got %d fetches`, len(fetches))
+	}
+
+	adminCategory := &model.Category{UserID: 2, Title: "All"}
+	if err := store.CreateCategory(ctx, adminCategory); err != nil {
+		t.Fatal(err)
+	}
+	store.SetUserAdmin(2, true)
+
+	feed, err = handler.CreateFeed(ctx, 2, adminCategory.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Pending {
+		t.Error(`The feed of an administrator should not be pending`)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 2 {
+		t.Errorf(`Unexpected number of entries, got %d instead of 2`, len(entries))
+	}
+}
+
+func TestRefreshFeed(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+	handler.EnableResponseArchive(1)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body = `<rss version="2.0"><channel><title>Example</title><link>%s</link>
+		<item><title>Third item</title><link>%s/3</link><guid>3</guid></item>
+		<item><title>Fourth item</title><link>%s/4</link><guid>4</guid></item>
+		</channel></rss>`
+
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 4 {
+		t.Errorf(`Unexpected number of entries, got %d instead of 4`, len(entries))
+	}
+
+	if responses := store.ArchivedResponses(feed.ID); len(responses) != 1 {
+		t.Errorf(`Unexpected number of archived responses, got %d instead of 1`, len(responses))
+	}
+
+	if fetches := store.Fetches(feed.ID); len(fetches) != 1 || !fetches[0] {
+		t.Errorf(`The successful fetch should be recorded, got %v`, fetches)
+	}
+}
+
+func TestCreateFeedWithJunkThreshold(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	store.SetUserJunkThreshold(1, 30)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The entries of the test feed have no content.
+	for _, entry := range store.Entries(feed.ID) {
+		if entry.JunkScore < 30 || entry.Status != model.EntryStatusRead {
+			t.Errorf(`Near-empty entries should be marked as read, got score %d and status %q`, entry.JunkScore, entry.Status)
+		}
+	}
+
+	if count := store.CountUnreadEntries(ctx, 1); count != 0 {
+		t.Errorf(`Unexpected number of unread entries, got %d instead of 0`, count)
+	}
+}
+
+type testSummarizer struct {
+	titles []string
+}
+
+func (s *testSummarizer) Summarize(title, text string) (string, error) {
+	s.titles = append(s.titles, title)
+	return "Summary of " + title, nil
+}
+
+func TestRefreshFeedWithSummaries(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	summarizer := &testSummarizer{}
+	handler := NewFeedHandler(store)
+	handler.EnableSummaries(summarizer)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed.SummarizeEntries = true
+	if err := store.UpdateFeed(ctx, feed); err != nil {
+		t.Fatal(err)
+	}
+
+	body = `<rss version="2.0"><channel><title>Example</title><link>%s</link>
+		<item><title>Second item</title><link>%s/2</link><guid>2</guid></item>
+		<item><title>Third item</title><link>%s/3</link><guid>3</guid></item>
+		</channel></rss>`
+
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summarizer.titles) != 1 || summarizer.titles[0] != "Third item" {
+		t.Fatalf(`Only the new entry should be summarized, got %v`, summarizer.titles)
+	}
+
+	for _, entry := range store.Entries(feed.ID) {
+		if entry.Title == "Third item" && entry.Summary != "Summary of Third item" {
+			t.Errorf(`Unexpected summary, got %q`, entry.Summary)
+		}
+	}
+}
+
+func TestRefreshFeedWithEntryLimit(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed.MaxEntries = 3
+	feed.OverflowPolicy = model.OverflowPolicyDelete
+	if err := store.UpdateFeed(ctx, feed); err != nil {
+		t.Fatal(err)
+	}
+
+	body = `<rss version="2.0"><channel><title>Example</title><link>%s</link>
+		<item><title>Second item</title><link>%s/2</link><guid>2</guid></item>
+		<item><title>Third item</title><link>%s/3</link><guid>3</guid></item>
+		<item><title>Fourth item</title><link>%s/4</link><guid>4</guid></item>
+		</channel></rss>`
+
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := store.Entries(feed.ID)
+	if len(entries) != 3 {
+		t.Fatalf(`Unexpected number of entries, got %d instead of 3`, len(entries))
+	}
+
+	for _, entry := range entries {
+		if entry.Title == "First item" {
+			t.Error(`The oldest entry should be deleted`)
+		}
+	}
+}
+
+func TestRefreshFeedKeepsVersion(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staleFeed := *feed
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// A refresh must not reject the settings edited meanwhile.
+	feed.Title = "Edited title"
+	if err := store.UpdateFeed(ctx, feed); err != nil {
+		t.Fatal(err)
+	}
+
+	staleFeed.Title = "Stale title"
+	if err := store.UpdateFeed(ctx, &staleFeed); err != storage.ErrConflict {
+		t.Errorf(`Updating a stale feed should return a conflict, got %v`, err)
+	}
+}
+
+// Some feeds generate a new GUID for every article on each update.
+const testFeedWithRotatingGUIDs = `<?xml version="1.0"?>
+<rss version="2.0">
+<channel>
+	<title>Example</title>
+	<link>%s</link>
+	<item>
+		<title>First item</title>
+		<link>%s/1</link>
+		<guid isPermaLink="false">GUID-PLACEHOLDER-1</guid>
+		<pubDate>Fri, 01 Mar 2019 10:00:00 GMT</pubDate>
+	</item>
+	<item>
+		<title>Second item</title>
+		<link>%s/2</link>
+		<guid isPermaLink="false">GUID-PLACEHOLDER-2</guid>
+		<pubDate>Fri, 01 Mar 2019 12:00:00 GMT</pubDate>
+	</item>
+</channel>
+</rss>`
+
+// Other feeds add tracking parameters to the permalinks and change the case of the titles.
+const testFeedWithChangingLinks = `<?xml version="1.0"?>
+<rss version="2.0">
+<channel>
+	<title>Example</title>
+	<link>%s</link>
+	<item>
+		<title>BREAKING: first item!</title>
+		<link>%s/1?utm_source=rss&amp;utm_campaign=LINK-PLACEHOLDER</link>
+		<pubDate>Fri, 01 Mar 2019 10:05:00 GMT</pubDate>
+	</item>
+	<item>
+		<title>Breaking - Second item</title>
+		<link>%s/2?utm_source=rss&amp;utm_campaign=LINK-PLACEHOLDER</link>
+		<pubDate>Fri, 01 Mar 2019 12:05:00 GMT</pubDate>
+	</item>
+</channel>
+</rss>`
+
+func TestRefreshFeedWithRepublishedEntries(t *testing.T) {
+	scenarios := []struct {
+		feed     string
+		matching string
+		expected int
+	}{
+		{testFeedWithRotatingGUIDs, model.EntryMatchingGUID, 4},
+		{testFeedWithRotatingGUIDs, model.EntryMatchingURL, 2},
+		{testFeedWithChangingLinks, model.EntryMatchingURL, 4},
+		{testFeedWithChangingLinks, model.EntryMatchingTitle, 2},
+	}
+
+	for _, scenario := range scenarios {
+		body := strings.Replace(scenario.feed, "PLACEHOLDER", "a", -1)
+		server := newTestServer(&body)
+
+		ctx := context.Background()
+		store, category := newTestStore(t)
+		handler := NewFeedHandler(store)
+
+		feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		feed.EntryMatching = scenario.matching
+		if err := store.UpdateFeed(ctx, feed); err != nil {
+			t.Fatal(err)
+		}
+
+		firstEntry := store.Entries(feed.ID)[0]
+		if err := store.SetEntriesStatus(ctx, 1, []int64{firstEntry.ID}, model.EntryStatusRead); err != nil {
+			t.Fatal(err)
+		}
+
+		// The titles change as well to bypass the duplicate title check.
+		body = strings.Replace(strings.Replace(scenario.feed, "PLACEHOLDER", "b", -1), " item", " Item", -1)
+		if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		entries := store.Entries(feed.ID)
+		if len(entries) != scenario.expected {
+			t.Errorf(`Unexpected number of entries with the %s matching, got %d instead of %d`, scenario.matching, len(entries), scenario.expected)
+		}
+
+		if scenario.expected == 2 && (entries[0].ID != firstEntry.ID || entries[0].Status != model.EntryStatusRead) {
+			t.Errorf(`The republished entry should keep its status with the %s matching`, scenario.matching)
+		}
+
+		server.Close()
+	}
+}
+
+func TestRefreshFeedWithParsingError(t *testing.T) {
+	body := testFeed
+	server := newTestServer(&body)
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreateFeed(ctx, 1, category.ID, server.URL+"/feed.xml", false, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body = `not a feed %s %s %s`
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err == nil {
+		t.Fatal(`An error should be returned when the feed cannot be parsed`)
+	}
+
+	feed, _ = store.FeedByID(ctx, 1, feed.ID)
+	if feed.ParsingErrorCount != 1 || feed.ParsingErrorMsg == "" {
+		t.Errorf(`The parsing error should be stored, got count=%d msg=%q`, feed.ParsingErrorCount, feed.ParsingErrorMsg)
+	}
+
+	if fetches := store.Fetches(feed.ID); len(fetches) != 1 || fetches[0] {
+		t.Errorf(`The failed fetch should be recorded, got %v`, fetches)
+	}
+}
+
+func TestSaveURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Saved article</title></head><body><article><p>Some content worth reading later.</p></article></body></html>`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	entry, err := handler.SaveURL(ctx, 1, server.URL+"/article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.ID == 0 || entry.Title != "Saved article" || entry.URL != server.URL+"/article" {
+		t.Errorf(`Unexpected saved entry: %+v`, entry)
+	}
+
+	if !entry.Feed.IsSavedPages() || entry.Feed.Category.ID != category.ID {
+		t.Errorf(`The entry should belong to the saved pages feed of the first category, got %+v`, entry.Feed)
+	}
+
+	if _, err := handler.SaveURL(ctx, 1, server.URL+"/article"); err != nil {
+		t.Fatal(err)
+	}
+
+	if entries := store.Entries(entry.FeedID); len(entries) != 1 {
+		t.Errorf(`Saving the same page twice should not create duplicates, got %d entries`, len(entries))
+	}
+
+	if err := handler.RefreshFeed(ctx, 1, entry.FeedID); err != nil {
+		t.Errorf(`The saved pages feed should not be refreshed: %v`, err)
+	}
+}
+
+func TestPageWatchFeed(t *testing.T) {
+	body := `<html><head><title>Prices</title></head><body><div id="menu">Home</div><div id="prices"><p>Apples 1€</p>
+		<p>Pears 2€</p></div></body></html>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	feed, err := handler.CreatePageWatchFeed(ctx, 1, category.ID, server.URL+"/prices", "#prices", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Prices" || !feed.IsPageWatch() {
+		t.Errorf(`Unexpected page watch feed: %+v`, feed)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 1 {
+		t.Fatalf(`The current content should be stored as the first entry, got %d entries`, len(entries))
+	}
+
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if entries := store.Entries(feed.ID); len(entries) != 1 {
+		t.Fatalf(`An unchanged page should not create entries, got %d entries`, len(entries))
+	}
+
+	body = `<html><head><title>Prices</title></head><body><div id="menu">About</div><div id="prices"><p>Apples 1€</p>
+		<p>Pears 3€</p></div></body></html>`
+	if err := handler.RefreshFeed(ctx, 1, feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := store.Entries(feed.ID)
+	if len(entries) != 2 {
+		t.Fatalf(`A change of the watched region should create an entry, got %d entries`, len(entries))
+	}
+
+	for _, entry := range entries {
+		if strings.Contains(entry.Content, "<del>Pears 2€</del>") {
+			if !strings.Contains(entry.Content, "<ins>Pears 3€</ins>") || strings.Contains(entry.Content, "Apples") {
+				t.Errorf(`Unexpected changes: %s`, entry.Content)
+			}
+			return
+		}
+	}
+
+	t.Errorf(`The removed line is missing from the entries: %+v`, entries)
+}
+
+func TestCreatePageWatchFeedWithoutMatchingElement(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><body><p>Test</p></body></html>`)
+	}))
+	defer server.Close()
+
+	store, category := newTestStore(t)
+	handler := NewFeedHandler(store)
+
+	if _, err := handler.CreatePageWatchFeed(context.Background(), 1, category.ID, server.URL, "#missing", "", "", ""); err == nil {
+		t.Fatal(`A selector without matching element should return an error`)
+	}
+}
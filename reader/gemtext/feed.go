@@ -0,0 +1,86 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemtext // import "miniflux.app/reader/gemtext"
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+	"miniflux.app/url"
+)
+
+// The label of the links to the entries of a feed starts with the publication date,
+// the title follows, optionally after a separator.
+var entryLabelRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})(?:\s+[-–—:]?\s*(.*))?$`)
+
+// IsFeed returns true when the gemtext document links to at least one dated entry.
+func IsFeed(text string) bool {
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "=>") {
+			if _, label := parseLink(line); entryLabelRegex.MatchString(label) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Parse returns a normalized feed from a gemtext document, see https://gemini.circumlunar.space/docs/companion/subscription.gmi
+// The title of the feed is the first level 1 heading and the entries are the dated links.
+func Parse(baseURL, text string) *model.Feed {
+	feed := new(model.Feed)
+	feed.FeedURL = baseURL
+	feed.SiteURL = baseURL
+	feed.Title = Title(text)
+
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "=>") {
+			if entry := parseEntry(baseURL, line); entry != nil {
+				feed.Entries = append(feed.Entries, entry)
+			}
+		}
+	}
+
+	if feed.Title == "" {
+		feed.Title = baseURL
+	}
+
+	return feed
+}
+
+func parseEntry(baseURL, line string) *model.Entry {
+	link, label := parseLink(line)
+	matches := entryLabelRegex.FindStringSubmatch(label)
+	if matches == nil {
+		return nil
+	}
+
+	published, err := time.Parse("2006-01-02", matches[1])
+	if err != nil {
+		return nil
+	}
+
+	entryURL, err := url.AbsoluteURL(baseURL, link)
+	if err != nil {
+		return nil
+	}
+
+	entry := new(model.Entry)
+	entry.URL = entryURL
+	entry.Date = published
+	entry.Hash = crypto.Hash(entryURL)
+	entry.Title = strings.TrimSpace(matches[2])
+	entry.Enclosures = make(model.EnclosureList, 0)
+
+	if entry.Title == "" {
+		entry.Title = entryURL
+	}
+
+	return entry
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemtext // import "miniflux.app/reader/gemtext"
+
+import (
+	"testing"
+	"time"
+)
+
+const capsule = `# My Gemlog
+## Notes about everything
+
+=> /about.gmi About me
+=> 2019-03-12-second.gmi 2019-03-12 - Second post
+=> gemini://example.org/gemlog/first.gmi 2019-01-05 First post
+=> /untitled.gmi 2019-01-01
+`
+
+func TestIsFeed(t *testing.T) {
+	if !IsFeed(capsule) {
+		t.Error(`A page with dated links should be detected as a feed`)
+	}
+
+	if IsFeed("# Home\n=> /about.gmi About me\n") {
+		t.Error(`A page without dated links is not a feed`)
+	}
+
+	if IsFeed(`<html><body><a href="/2019-01-05">2019-01-05</a></body></html>`) {
+		t.Error(`A HTML page is not a feed`)
+	}
+}
+
+func TestParse(t *testing.T) {
+	feed := Parse("gemini://example.org/gemlog/", capsule)
+
+	if feed.Title != "My Gemlog" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+
+	if feed.SiteURL != "gemini://example.org/gemlog/" {
+		t.Errorf(`Incorrect site URL, got: %q`, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 3 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[0].URL != "gemini://example.org/gemlog/2019-03-12-second.gmi" {
+		t.Errorf(`Incorrect entry URL, got: %q`, feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Title != "Second post" || feed.Entries[1].Title != "First post" {
+		t.Errorf(`Incorrect entry titles, got: %q and %q`, feed.Entries[0].Title, feed.Entries[1].Title)
+	}
+
+	if !feed.Entries[1].Date.Equal(time.Date(2019, time.January, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Incorrect entry date, got: %v`, feed.Entries[1].Date)
+	}
+
+	if feed.Entries[2].Title != "gemini://example.org/untitled.gmi" {
+		t.Errorf(`An entry without title should use its URL, got: %q`, feed.Entries[2].Title)
+	}
+
+	if feed.Entries[0].Hash == "" || feed.Entries[0].Hash == feed.Entries[1].Hash {
+		t.Errorf(`Incorrect entry hashes`)
+	}
+}
+
+func TestParseWithoutTitle(t *testing.T) {
+	feed := Parse("gemini://example.org/", "=> /post.gmi 2019-01-05 Post\n")
+
+	if feed.Title != "gemini://example.org/" {
+		t.Errorf(`The URL should be used as title, got: %q`, feed.Title)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemtext // import "miniflux.app/reader/gemtext"
+
+import (
+	"html"
+	"strings"
+
+	"miniflux.app/url"
+)
+
+// ToHTML converts a gemtext document to HTML, the relative links are resolved against the URL of the document.
+// The output is not sanitized.
+func ToHTML(baseURL, text string) string {
+	var buffer strings.Builder
+	preformatted, list := false, false
+
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "```") {
+			if preformatted {
+				buffer.WriteString("</code></pre>")
+			} else {
+				list = closeList(&buffer, list)
+				buffer.WriteString("<pre><code>")
+			}
+
+			preformatted = !preformatted
+			continue
+		}
+
+		if preformatted {
+			buffer.WriteString(html.EscapeString(line) + "\n")
+			continue
+		}
+
+		if strings.HasPrefix(line, "* ") {
+			if !list {
+				buffer.WriteString("<ul>")
+				list = true
+			}
+
+			buffer.WriteString("<li>" + html.EscapeString(strings.TrimSpace(line[1:])) + "</li>")
+			continue
+		}
+
+		list = closeList(&buffer, list)
+
+		switch {
+		case strings.HasPrefix(line, "=>"):
+			link, label := parseLink(line)
+			if link == "" {
+				continue
+			}
+
+			if absoluteURL, err := url.AbsoluteURL(baseURL, link); err == nil {
+				link = absoluteURL
+			}
+
+			if label == "" {
+				label = link
+			}
+
+			buffer.WriteString(`<p><a href="` + html.EscapeString(link) + `">` + html.EscapeString(label) + `</a></p>`)
+		case strings.HasPrefix(line, "###"):
+			buffer.WriteString("<h3>" + html.EscapeString(strings.TrimSpace(line[3:])) + "</h3>")
+		case strings.HasPrefix(line, "##"):
+			buffer.WriteString("<h2>" + html.EscapeString(strings.TrimSpace(line[2:])) + "</h2>")
+		case strings.HasPrefix(line, "#"):
+			buffer.WriteString("<h1>" + html.EscapeString(strings.TrimSpace(line[1:])) + "</h1>")
+		case strings.HasPrefix(line, ">"):
+			buffer.WriteString("<blockquote>" + html.EscapeString(strings.TrimSpace(line[1:])) + "</blockquote>")
+		case strings.TrimSpace(line) != "":
+			buffer.WriteString("<p>" + html.EscapeString(line) + "</p>")
+		}
+	}
+
+	if preformatted {
+		buffer.WriteString("</code></pre>")
+	}
+
+	closeList(&buffer, list)
+	return buffer.String()
+}
+
+// Title returns the first level 1 heading of the document.
+func Title(text string) string {
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "##") {
+			return strings.TrimSpace(line[1:])
+		}
+	}
+
+	return ""
+}
+
+func closeList(buffer *strings.Builder, list bool) bool {
+	if list {
+		buffer.WriteString("</ul>")
+	}
+
+	return false
+}
+
+// parseLink returns the URL and the optional label of a link line: "=> URL label".
+func parseLink(line string) (string, string) {
+	line = strings.TrimSpace(strings.TrimPrefix(line, "=>"))
+	if i := strings.IndexAny(line, " \t"); i > 0 {
+		return line[:i], strings.TrimSpace(line[i:])
+	}
+
+	return line, ""
+}
+
+func splitLines(text string) []string {
+	return strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gemtext // import "miniflux.app/reader/gemtext"
+
+import "testing"
+
+func TestToHTML(t *testing.T) {
+	input := "# Title\r\n\r\nSome <text>.\n=> /about.gmi About me\n=> gemini://example.com/\n* one\n* two\n> quote\n```\nif a < b {\n```\n## Sub\n### Sub sub"
+	expected := `<h1>Title</h1><p>Some &lt;text&gt;.</p>` +
+		`<p><a href="gemini://example.org/about.gmi">About me</a></p>` +
+		`<p><a href="gemini://example.com/">gemini://example.com/</a></p>` +
+		`<ul><li>one</li><li>two</li></ul><blockquote>quote</blockquote>` +
+		"<pre><code>if a &lt; b {\n</code></pre>" +
+		`<h2>Sub</h2><h3>Sub sub</h3>`
+	output := ToHTML("gemini://example.org/index.gmi", input)
+
+	if output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, output, expected)
+	}
+}
+
+func TestToHTMLClosesOpenBlocks(t *testing.T) {
+	output := ToHTML("gemini://example.org/", "* item\n```\ncode")
+	expected := "<ul><li>item</li></ul><pre><code>code\n</code></pre>"
+
+	if output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, output, expected)
+	}
+}
+
+func TestParseLink(t *testing.T) {
+	scenarios := map[string][2]string{
+		"=> gemini://example.org/":              {"gemini://example.org/", ""},
+		"=>/page.gmi   A page":                  {"/page.gmi", "A page"},
+		"=>\tpage.gmi\t2019-01-02 - Some title": {"page.gmi", "2019-01-02 - Some title"},
+		"=>":                                    {"", ""},
+	}
+
+	for input, expected := range scenarios {
+		link, label := parseLink(input)
+		if link != expected[0] || label != expected[1] {
+			t.Errorf(`Unexpected link for %q: got %q and %q`, input, link, label)
+		}
+	}
+}
+
+func TestTitle(t *testing.T) {
+	if title := Title("## Subtitle\n#  My Capsule \n# Other"); title != "My Capsule" {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+
+	if title := Title("Some text"); title != "" {
+		t.Errorf(`Documents without heading should return an empty string, got %q`, title)
+	}
+}
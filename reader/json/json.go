@@ -12,6 +12,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/nsfw"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 )
@@ -41,6 +42,7 @@ type jsonItem struct {
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
 	Attachments   []jsonAttachment `json:"attachments"`
+	Tags          []string         `json:"tags"`
 }
 
 type jsonAttachment struct {
@@ -146,6 +148,16 @@ func (j *jsonItem) GetEnclosures() model.EnclosureList {
 	return enclosures
 }
 
+func (j *jsonItem) IsNSFW() bool {
+	for _, tag := range j.Tags {
+		if nsfw.IsExplicitCategory(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = j.URL
@@ -155,6 +167,7 @@ func (j *jsonItem) Transform() *model.Entry {
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
+	entry.NSFW = j.IsNSFW()
 	return entry
 }
 
@@ -369,6 +369,40 @@ func TestParseTruncateItemTitle(t *testing.T) {
 	}
 }
 
+func TestParseFeedItemWithNSFWTag(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "1",
+				"content_text": "Some text.",
+				"tags": ["technology"]
+			},
+			{
+				"id": "2",
+				"content_text": "Some text.",
+				"tags": ["news", "NSFW"]
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].NSFW {
+		t.Error("The first entry should not be flagged as NSFW")
+	}
+
+	if !feed.Entries[1].NSFW {
+		t.Error("The second entry should be flagged as NSFW")
+	}
+}
+
 func TestParseInvalidJSON(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
@@ -0,0 +1,115 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package junk // import "miniflux.app/reader/junk"
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"miniflux.app/model"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	clickbaitPenalty    = 40
+	shoutingPenalty     = 15
+	emptyContentPenalty = 30
+	linkFarmPenalty     = 40
+
+	// Entries with fewer words are considered near-empty.
+	minContentWords = 15
+
+	// Entries with at least this number of links, making up most of the text, are considered link farms.
+	minLinkFarmLinks = 5
+)
+
+var clickbaitPatterns = regexp.MustCompile(`(?i)` +
+	`you won'?t believe|` +
+	`what happen(s|ed) next|` +
+	`will (shock|amaze|surprise) you|` +
+	`blow your mind|` +
+	`(one|this) (weird|simple|little) trick|` +
+	`doctors hate|` +
+	`you need to (see|know|read)|` +
+	`(the|number|#) ?\d+ will|` +
+	`click here|` +
+	`^\d+ (reasons|things|ways|facts|photos|pictures|secrets|signs)\b`)
+
+// Score returns a junk score between 0 and 100, the higher the more likely the entry is spam or low-quality.
+func Score(entry *model.Entry) int {
+	score := 0
+
+	if clickbaitPatterns.MatchString(entry.Title) {
+		score += clickbaitPenalty
+	}
+
+	if isShouting(entry.Title) {
+		score += shoutingPenalty
+	}
+
+	words, linkWords, links := countWords(entry.Content)
+	if words < minContentWords {
+		score += emptyContentPenalty
+	}
+
+	if links >= minLinkFarmLinks && linkWords*2 >= words {
+		score += linkFarmPenalty
+	}
+
+	if score > model.MaxJunkScore {
+		return model.MaxJunkScore
+	}
+
+	return score
+}
+
+// isShouting returns true when the title is mostly written in capital letters or full of exclamation marks.
+func isShouting(title string) bool {
+	if strings.Contains(title, "!!") || strings.Contains(title, "?!") {
+		return true
+	}
+
+	var letters, upper int
+	for _, r := range title {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+
+	return letters >= 15 && upper*10 >= letters*7
+}
+
+// countWords returns the number of words of the content, the number of words inside links and the number of links.
+func countWords(content string) (words, linkWords, links int) {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	depth := 0
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return words, linkWords, links
+		case html.StartTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "a" {
+				links++
+				depth++
+			}
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "a" && depth > 0 {
+				depth--
+			}
+		case html.TextToken:
+			count := len(strings.Fields(string(tokenizer.Text())))
+			words += count
+			if depth > 0 {
+				linkWords += count
+			}
+		}
+	}
+}
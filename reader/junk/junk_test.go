@@ -0,0 +1,72 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package junk // import "miniflux.app/reader/junk"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+var article = `<p>` + strings.Repeat("The autovacuum daemon removes the dead tuples left by updates. ", 5) + `</p>`
+
+func TestScoreRegularEntry(t *testing.T) {
+	entry := &model.Entry{Title: "Tuning the autovacuum of PostgreSQL", Content: article + `<p>See <a href="https://example.org">the documentation</a>.</p>`}
+	if score := Score(entry); score != 0 {
+		t.Errorf(`A regular entry should not be junk, got %d`, score)
+	}
+}
+
+func TestScoreClickbait(t *testing.T) {
+	titles := []string{
+		"You won't believe what this database can do",
+		"Developers hate him: this one weird trick speeds up Postgres",
+		"10 reasons to use PostgreSQL",
+		"The 7th tip will shock you",
+	}
+
+	for _, title := range titles {
+		if score := Score(&model.Entry{Title: title, Content: article}); score != clickbaitPenalty {
+			t.Errorf(`The title %q should be detected as clickbait, got %d`, title, score)
+		}
+	}
+}
+
+func TestScoreShouting(t *testing.T) {
+	titles := []string{"POSTGRES IS THE BEST DATABASE", "Postgres released!!", "Is this the end?!"}
+	for _, title := range titles {
+		if score := Score(&model.Entry{Title: title, Content: article}); score != shoutingPenalty {
+			t.Errorf(`The title %q should be detected as shouting, got %d`, title, score)
+		}
+	}
+
+	if isShouting("NASA and ESA launch") {
+		t.Error(`Short titles with acronyms should not be detected as shouting`)
+	}
+}
+
+func TestScoreEmptyContent(t *testing.T) {
+	entry := &model.Entry{Title: "Postgres release", Content: `<p>Read on <a href="https://example.org">our site</a>.</p>`}
+	if score := Score(entry); score != emptyContentPenalty {
+		t.Errorf(`A near-empty entry should be detected, got %d`, score)
+	}
+}
+
+func TestScoreLinkFarm(t *testing.T) {
+	content := `<p>Best deals of the day for everyone:</p><ul>` + strings.Repeat(`<li><a href="https://example.org/deal">Cheap watches and pills online now</a></li>`, 5) + `</ul>`
+	entry := &model.Entry{Title: "Deals of the day", Content: content}
+	if score := Score(entry); score != linkFarmPenalty {
+		t.Errorf(`A link farm should be detected, got %d`, score)
+	}
+}
+
+func TestScoreIsCapped(t *testing.T) {
+	content := strings.Repeat(`<a href="https://example.org">x</a>`, 10)
+	entry := &model.Entry{Title: "YOU WON'T BELIEVE WHAT HAPPENED NEXT!!", Content: content}
+	if score := Score(entry); score != model.MaxJunkScore {
+		t.Errorf(`The score should be capped to %d, got %d`, model.MaxJunkScore, score)
+	}
+}
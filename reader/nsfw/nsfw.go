@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package nsfw // import "miniflux.app/reader/nsfw"
+
+import (
+	"regexp"
+	"strings"
+
+	"miniflux.app/model"
+)
+
+// Ratings of the Media RSS and iTunes namespaces reserved to an adult audience.
+var explicitRatings = map[string]bool{
+	"adult":    true,
+	"explicit": true,
+	"yes":      true,
+	"true":     true,
+	"x":        true,
+	"nc-17":    true,
+	"tv-ma":    true,
+}
+
+// Categories and tags used by publishers to flag their entries.
+var explicitCategories = map[string]bool{
+	"nsfw":     true,
+	"adult":    true,
+	"explicit": true,
+	"porn":     true,
+	"xxx":      true,
+	"18+":      true,
+}
+
+var titleKeywords = regexp.MustCompile(`(?i)(^|[^\pL\d])(nsfw|not safe for work|18\+|xxx)($|[^\pL\d+])`)
+
+// IsExplicitRating returns true when the media rating or the iTunes explicit flag is reserved to adults.
+func IsExplicitRating(rating string) bool {
+	return explicitRatings[strings.ToLower(strings.TrimSpace(rating))]
+}
+
+// IsExplicitCategory returns true when the category flags the entry as not safe for work.
+func IsExplicitCategory(category string) bool {
+	return explicitCategories[strings.ToLower(strings.TrimSpace(category))]
+}
+
+// HasKeywords returns true when the title of the entry contains a NSFW marker like "[NSFW]".
+func HasKeywords(entry *model.Entry) bool {
+	return titleKeywords.MatchString(entry.Title)
+}
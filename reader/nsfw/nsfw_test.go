@@ -0,0 +1,53 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package nsfw // import "miniflux.app/reader/nsfw"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestIsExplicitRating(t *testing.T) {
+	for _, rating := range []string{"adult", " Adult ", "yes", "explicit", "nc-17"} {
+		if !IsExplicitRating(rating) {
+			t.Errorf(`The rating %q should be explicit`, rating)
+		}
+	}
+
+	for _, rating := range []string{"", "nonadult", "no", "clean", "pg-13"} {
+		if IsExplicitRating(rating) {
+			t.Errorf(`The rating %q should not be explicit`, rating)
+		}
+	}
+}
+
+func TestIsExplicitCategory(t *testing.T) {
+	if !IsExplicitCategory("NSFW") {
+		t.Error(`The category "NSFW" should be explicit`)
+	}
+
+	if IsExplicitCategory("Technology") {
+		t.Error(`The category "Technology" should not be explicit`)
+	}
+}
+
+func TestHasKeywords(t *testing.T) {
+	scenarios := map[string]bool{
+		"[NSFW] Some title":              true,
+		"Some title (nsfw)":              true,
+		"Not safe for work: the gallery": true,
+		"Only 18+ allowed":               true,
+		"Welcome to the 18++ club":       false,
+		"NSFWish naming":                 false,
+		"A regular title":                false,
+	}
+
+	for title, expected := range scenarios {
+		if result := HasKeywords(&model.Entry{Title: title}); result != expected {
+			t.Errorf(`Unexpected result for %q: got %v instead of %v`, title, result, expected)
+		}
+	}
+}
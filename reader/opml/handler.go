@@ -5,6 +5,7 @@
 package opml // import "miniflux.app/reader/opml"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -16,12 +17,15 @@ import (
 
 // Handler handles the logic for OPML import/export.
 type Handler struct {
-	store *storage.Storage
+	store storage.Store
+
+	// pending makes the imported feeds pending until an administrator approves them.
+	pending bool
 }
 
 // Export exports user feeds to OPML.
-func (h *Handler) Export(userID int64) (string, error) {
-	feeds, err := h.store.Feeds(userID)
+func (h *Handler) Export(ctx context.Context, userID int64) (string, error) {
+	feeds, err := h.store.Feeds(ctx, userID)
 	if err != nil {
 		return "", err
 	}
@@ -33,6 +37,10 @@ func (h *Handler) Export(userID int64) (string, error) {
 			FeedURL:      feed.FeedURL,
 			SiteURL:      feed.SiteURL,
 			CategoryName: feed.Category.Title,
+			RewriteRules: feed.RewriteRules,
+			ScraperRules: feed.ScraperRules,
+			UserAgent:    feed.UserAgent,
+			Crawler:      feed.Crawler,
 		})
 	}
 
@@ -40,25 +48,29 @@ func (h *Handler) Export(userID int64) (string, error) {
 }
 
 // Import parses and create feeds from an OPML import.
-func (h *Handler) Import(userID int64, data io.Reader) error {
+func (h *Handler) Import(ctx context.Context, userID int64, data io.Reader) error {
 	subscriptions, err := Parse(data)
 	if err != nil {
 		return err
 	}
 
 	for _, subscription := range subscriptions {
-		if !h.store.FeedURLExists(userID, subscription.FeedURL) {
+		if !h.store.FeedURLExists(ctx, userID, subscription.FeedURL) {
 			var category *model.Category
 			var err error
 
 			if subscription.CategoryName == "" {
-				category, err = h.store.FirstCategory(userID)
+				category, err = h.store.FirstCategory(ctx, userID)
 				if err != nil {
 					logger.Error("[OPML:Import] %v", err)
 					return errors.New("unable to find first category")
 				}
+
+				if category == nil {
+					return errors.New("unable to find first category")
+				}
 			} else {
-				category, err = h.store.CategoryByTitle(userID, subscription.CategoryName)
+				category, err = h.store.CategoryByTitle(ctx, userID, subscription.CategoryName)
 				if err != nil {
 					logger.Error("[OPML:Import] %v", err)
 					return errors.New("unable to search category by title")
@@ -70,7 +82,7 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 						Title:  subscription.CategoryName,
 					}
 
-					err := h.store.CreateCategory(category)
+					err := h.store.CreateCategory(ctx, category)
 					if err != nil {
 						logger.Error("[OPML:Import] %v", err)
 						return fmt.Errorf(`unable to create this category: %q`, subscription.CategoryName)
@@ -79,21 +91,31 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 			}
 
 			feed := &model.Feed{
-				UserID:   userID,
-				Title:    subscription.Title,
-				FeedURL:  subscription.FeedURL,
-				SiteURL:  subscription.SiteURL,
-				Category: category,
+				UserID:       userID,
+				Title:        subscription.Title,
+				FeedURL:      subscription.FeedURL,
+				SiteURL:      subscription.SiteURL,
+				Category:     category,
+				Pending:      h.pending,
+				RewriteRules: subscription.RewriteRules,
+				ScraperRules: subscription.ScraperRules,
+				UserAgent:    subscription.UserAgent,
+				Crawler:      subscription.Crawler,
 			}
 
-			h.store.CreateFeed(feed)
+			h.store.CreateFeed(ctx, feed)
 		}
 	}
 
 	return nil
 }
 
+// MarkPending makes the imported feeds pending until an administrator approves them.
+func (h *Handler) MarkPending() {
+	h.pending = true
+}
+
 // NewHandler creates a new handler for OPML files.
-func NewHandler(store *storage.Storage) *Handler {
+func NewHandler(store storage.Store) *Handler {
 	return &Handler{store: store}
 }
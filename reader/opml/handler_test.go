@@ -0,0 +1,178 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+	"miniflux.app/storage/memory"
+)
+
+func TestImportAndExport(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Feed 1" title="Feed 1" type="rss" htmlUrl="http://example.org/1" xmlUrl="http://example.org/1/feed.xml"/>
+			<outline text="News">
+				<outline text="Feed 2" title="Feed 2" type="rss" htmlUrl="http://example.org/2" xmlUrl="http://example.org/2/feed.xml"/>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	ctx := context.Background()
+	store := memory.New()
+	store.CreateCategory(ctx, &model.Category{UserID: 1, Title: "All"})
+
+	handler := NewHandler(store)
+	if err := handler.Import(ctx, 1, bytes.NewBufferString(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	feeds, _ := store.Feeds(ctx, 1)
+	if len(feeds) != 2 {
+		t.Fatalf(`Unexpected number of feeds, got %d instead of 2`, len(feeds))
+	}
+
+	if feeds[0].Title != "Feed 1" || feeds[0].Category.Title != "All" {
+		t.Errorf(`The first feed should be in the first category, got %q in %q`, feeds[0].Title, feeds[0].Category.Title)
+	}
+
+	if feeds[1].Title != "Feed 2" || feeds[1].Category.Title != "News" {
+		t.Errorf(`The second feed should be in a new category, got %q in %q`, feeds[1].Title, feeds[1].Category.Title)
+	}
+
+	// Importing the same file twice does not create duplicates.
+	if err := handler.Import(ctx, 1, bytes.NewBufferString(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	if feeds, _ := store.Feeds(ctx, 1); len(feeds) != 2 {
+		t.Fatalf(`Unexpected number of feeds after the second import, got %d instead of 2`, len(feeds))
+	}
+
+	export, err := handler.Export(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(export, `xmlUrl="http://example.org/2/feed.xml"`) {
+		t.Errorf(`The export should contain the imported feeds, got %s`, export)
+	}
+}
+
+func TestImportWithoutCategory(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Feed 1" title="Feed 1" type="rss" htmlUrl="http://example.org/1" xmlUrl="http://example.org/1/feed.xml"/>
+		</body>
+	</opml>
+	`
+
+	handler := NewHandler(memory.New())
+	if err := handler.Import(context.Background(), 1, bytes.NewBufferString(data)); err == nil {
+		t.Fatal(`An error should be returned when the user has no category`)
+	}
+}
+
+func TestImportPendingFeeds(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Feed 1" title="Feed 1" type="rss" htmlUrl="http://example.org/1" xmlUrl="http://example.org/1/feed.xml"/>
+		</body>
+	</opml>
+	`
+
+	ctx := context.Background()
+	store := memory.New()
+	store.CreateCategory(ctx, &model.Category{UserID: 1, Title: "All"})
+
+	handler := NewHandler(store)
+	handler.MarkPending()
+	if err := handler.Import(ctx, 1, bytes.NewBufferString(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	feeds, _ := store.Feeds(ctx, 1)
+	if len(feeds) != 1 || !feeds[0].Pending {
+		t.Fatalf(`The imported feed should be pending, got %v`, feeds)
+	}
+}
+
+func TestImportAndExportFeedSettings(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0" xmlns:miniflux="https://miniflux.app/opml">
+		<body>
+			<outline text="News">
+				<outline text="Feed 1" type="rss" xmlUrl="http://example.org/1/feed.xml" miniflux:rewriteRules="add_image_title" miniflux:scraperRules="article &gt; p" miniflux:userAgent="Custom/1.0" miniflux:crawler="true"/>
+				<outline text="Feed 2" type="rss" xmlUrl="http://example.org/2/feed.xml" rewriteRules="ignored"/>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	ctx := context.Background()
+	store := memory.New()
+	store.CreateCategory(ctx, &model.Category{UserID: 1, Title: "All"})
+
+	handler := NewHandler(store)
+	if err := handler.Import(ctx, 1, bytes.NewBufferString(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	feeds, _ := store.Feeds(ctx, 1)
+	if len(feeds) != 2 {
+		t.Fatalf(`Unexpected number of feeds, got %d instead of 2`, len(feeds))
+	}
+
+	feed := feeds[0]
+	if feed.RewriteRules != "add_image_title" || feed.ScraperRules != "article > p" || feed.UserAgent != "Custom/1.0" || !feed.Crawler {
+		t.Errorf(`The settings of the feed should be imported, got %q, %q, %q and %v`, feed.RewriteRules, feed.ScraperRules, feed.UserAgent, feed.Crawler)
+	}
+
+	if feeds[1].RewriteRules != "" || feeds[1].Crawler {
+		t.Errorf(`Attributes outside of the namespace should be ignored, got %q and %v`, feeds[1].RewriteRules, feeds[1].Crawler)
+	}
+
+	export, err := handler.Export(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriptions, parseErr := Parse(strings.NewReader(export))
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	expected := &Subcription{
+		Title:        "Feed 1",
+		FeedURL:      "http://example.org/1/feed.xml",
+		SiteURL:      "http://example.org/1/feed.xml",
+		CategoryName: "News",
+		RewriteRules: "add_image_title",
+		ScraperRules: "article > p",
+		UserAgent:    "Custom/1.0",
+		Crawler:      true,
+	}
+
+	found := false
+	for _, subscription := range subscriptions {
+		found = found || subscription.Equals(expected)
+	}
+
+	if !found {
+		t.Errorf(`The settings of the feed should be exported, got %s`, export)
+	}
+
+	if strings.Count(export, `xmlns:miniflux="https://miniflux.app/opml"`) != 1 {
+		t.Errorf(`The namespace should be declared once, got %s`, export)
+	}
+}
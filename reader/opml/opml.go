@@ -6,18 +6,60 @@ package opml // import "miniflux.app/reader/opml"
 
 import "encoding/xml"
 
+// The settings of the feeds are stored in attributes of this namespace, they are ignored by other readers.
+const minifluxNamespace = "https://miniflux.app/opml"
+
 type opml struct {
-	XMLName  xml.Name  `xml:"opml"`
-	Version  string    `xml:"version,attr"`
-	Outlines []outline `xml:"body>outline"`
+	XMLName   xml.Name  `xml:"opml"`
+	Version   string    `xml:"version,attr"`
+	Namespace string    `xml:"xmlns:miniflux,attr,omitempty"`
+	Outlines  []outline `xml:"body>outline"`
 }
 
 type outline struct {
-	Title    string    `xml:"title,attr,omitempty"`
-	Text     string    `xml:"text,attr"`
-	FeedURL  string    `xml:"xmlUrl,attr,omitempty"`
-	SiteURL  string    `xml:"htmlUrl,attr,omitempty"`
-	Outlines []outline `xml:"outline,omitempty"`
+	Title        string    `xml:"title,attr,omitempty"`
+	Text         string    `xml:"text,attr"`
+	FeedURL      string    `xml:"xmlUrl,attr,omitempty"`
+	SiteURL      string    `xml:"htmlUrl,attr,omitempty"`
+	RewriteRules string    `xml:"https://miniflux.app/opml rewriteRules,attr"`
+	ScraperRules string    `xml:"https://miniflux.app/opml scraperRules,attr"`
+	UserAgent    string    `xml:"https://miniflux.app/opml userAgent,attr"`
+	Crawler      bool      `xml:"https://miniflux.app/opml crawler,attr"`
+	Outlines     []outline `xml:"outline,omitempty"`
+}
+
+// MarshalXML writes the settings with the prefix declared on the root element,
+// the encoder would declare the namespace again on every outline.
+func (o outline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = nil
+	addAttr := func(name, value string, always bool) {
+		if value != "" || always {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+		}
+	}
+
+	addAttr("title", o.Title, false)
+	addAttr("text", o.Text, true)
+	addAttr("xmlUrl", o.FeedURL, false)
+	addAttr("htmlUrl", o.SiteURL, false)
+	addAttr("miniflux:rewriteRules", o.RewriteRules, false)
+	addAttr("miniflux:scraperRules", o.ScraperRules, false)
+	addAttr("miniflux:userAgent", o.UserAgent, false)
+	if o.Crawler {
+		addAttr("miniflux:crawler", "true", true)
+	}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, child := range o.Outlines {
+		if err := e.EncodeElement(child, xml.StartElement{Name: xml.Name{Local: "outline"}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
 }
 
 func (o *outline) GetTitle() string {
@@ -59,6 +101,10 @@ func (o *outline) Append(subscriptions SubcriptionList, category string) Subcrip
 			FeedURL:      o.FeedURL,
 			SiteURL:      o.GetSiteURL(),
 			CategoryName: category,
+			RewriteRules: o.RewriteRules,
+			ScraperRules: o.ScraperRules,
+			UserAgent:    o.UserAgent,
+			Crawler:      o.Crawler,
 		})
 	}
 
@@ -20,15 +20,20 @@ func Serialize(subscriptions SubcriptionList) string {
 
 	feeds := new(opml)
 	feeds.Version = "2.0"
+	feeds.Namespace = minifluxNamespace
 	for categoryName, subs := range groupSubscriptionsByFeed(subscriptions) {
 		category := outline{Text: categoryName}
 
 		for _, subscription := range subs {
 			category.Outlines = append(category.Outlines, outline{
-				Title:   subscription.Title,
-				Text:    subscription.Title,
-				FeedURL: subscription.FeedURL,
-				SiteURL: subscription.SiteURL,
+				Title:        subscription.Title,
+				Text:         subscription.Title,
+				FeedURL:      subscription.FeedURL,
+				SiteURL:      subscription.SiteURL,
+				RewriteRules: subscription.RewriteRules,
+				ScraperRules: subscription.ScraperRules,
+				UserAgent:    subscription.UserAgent,
+				Crawler:      subscription.Crawler,
 			})
 		}
 
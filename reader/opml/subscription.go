@@ -10,12 +10,18 @@ type Subcription struct {
 	SiteURL      string
 	FeedURL      string
 	CategoryName string
+	RewriteRules string
+	ScraperRules string
+	UserAgent    string
+	Crawler      bool
 }
 
 // Equals compare two subscriptions.
 func (s Subcription) Equals(subscription *Subcription) bool {
 	return s.Title == subscription.Title && s.SiteURL == subscription.SiteURL &&
-		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName
+		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName &&
+		s.RewriteRules == subscription.RewriteRules && s.ScraperRules == subscription.ScraperRules &&
+		s.UserAgent == subscription.UserAgent && s.Crawler == subscription.Crawler
 }
 
 // SubcriptionList is a list of subscriptions.
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"miniflux.app/reader/encoding"
+	"miniflux.app/reader/gemtext"
 )
 
 // List of feed formats.
@@ -17,6 +18,7 @@ const (
 	FormatRSS     = "rss"
 	FormatAtom    = "atom"
 	FormatJSON    = "json"
+	FormatGemfeed = "gemfeed"
 	FormatUnknown = "unknown"
 )
 
@@ -47,5 +49,9 @@ func DetectFeedFormat(data string) string {
 		}
 	}
 
+	if gemtext.IsFeed(data) {
+		return FormatGemfeed
+	}
+
 	return FormatUnknown
 }
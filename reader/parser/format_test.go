@@ -58,6 +58,15 @@ func TestDetectJSON(t *testing.T) {
 	}
 }
 
+func TestDetectGemfeed(t *testing.T) {
+	data := "# My Gemlog\n\n=> /about.gmi About\n=> /first.gmi 2019-01-05 First post\n"
+	format := DetectFeedFormat(data)
+
+	if format != FormatGemfeed {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatGemfeed)
+	}
+}
+
 func TestDetectUnknown(t *testing.T) {
 	data := `
 	<!DOCTYPE html> <html> </html>
@@ -0,0 +1,27 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build gofuzz
+
+package parser // import "miniflux.app/reader/parser"
+
+// Fuzz is the entry point for go-fuzz, the seed corpus is stored in testdata/fuzz/corpus:
+//
+//	go-fuzz-build miniflux.app/reader/parser
+//	go-fuzz -bin=parser-fuzz.zip -workdir=reader/parser/testdata/fuzz
+//
+// The parsers are called without the panic guard so crashes are reported.
+// Crashers must be fixed and copied to the corpus to prevent regressions.
+func Fuzz(data []byte) int {
+	feed, err := parseFeed("https://example.org/", string(data))
+	if err != nil {
+		return 0
+	}
+
+	if feed == nil {
+		panic("no error and no feed returned")
+	}
+
+	return 1
+}
@@ -5,19 +5,42 @@
 package parser // import "miniflux.app/reader/parser"
 
 import (
+	"fmt"
+	"runtime/debug"
 	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/atom"
+	"miniflux.app/reader/gemtext"
 	"miniflux.app/reader/json"
 	"miniflux.app/reader/rdf"
 	"miniflux.app/reader/rss"
 )
 
 // ParseFeed analyzes the input data and returns a normalized feed object.
-func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
+// The base URL is the location of the document, it's used by the formats without a link to their website.
+// A panic in one of the parsers is returned as an error, a malformed feed must not stop the refresh workers.
+func ParseFeed(baseURL, data string) (*model.Feed, *errors.LocalizedError) {
+	return safeParse(func() (*model.Feed, *errors.LocalizedError) {
+		return parseFeed(baseURL, data)
+	})
+}
+
+func safeParse(parse func() (*model.Feed, *errors.LocalizedError)) (feed *model.Feed, err *errors.LocalizedError) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("[Parser:ParseFeed] Recovered from a panic: %v\n%s", r, debug.Stack())
+			feed = nil
+			err = errors.NewLocalizedError("Unable to parse feed: %q", fmt.Sprint(r))
+		}
+	}()
+
+	return parse()
+}
+
+func parseFeed(baseURL, data string) (*model.Feed, *errors.LocalizedError) {
 	data = stripInvalidXMLCharacters(data)
 
 	switch DetectFeedFormat(data) {
@@ -29,6 +52,8 @@ func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
 		return json.Parse(strings.NewReader(data))
 	case FormatRDF:
 		return rdf.Parse(strings.NewReader(data))
+	case FormatGemfeed:
+		return gemtext.Parse(baseURL, data), nil
 	default:
 		return nil, errors.NewLocalizedError("Unsupported feed format")
 	}
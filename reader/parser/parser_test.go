@@ -7,9 +7,12 @@ package parser // import "miniflux.app/reader/parser"
 import (
 	"bytes"
 	"io/ioutil"
+	"path/filepath"
 	"testing"
 
+	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/model"
 )
 
 func TestParseAtom(t *testing.T) {
@@ -34,7 +37,7 @@ func TestParseAtom(t *testing.T) {
 
 	</feed>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -60,7 +63,7 @@ func TestParseRSS(t *testing.T) {
 	</channel>
 	</rss>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +92,7 @@ func TestParseRDF(t *testing.T) {
 		  </item>
 		</rdf:RDF>`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -119,7 +122,7 @@ func TestParseJson(t *testing.T) {
 		]
 	}`
 
-	feed, err := ParseFeed(data)
+	feed, err := ParseFeed("https://example.org/", data)
 	if err != nil {
 		t.Error(err)
 	}
@@ -129,6 +132,23 @@ func TestParseJson(t *testing.T) {
 	}
 }
 
+func TestParseGemfeed(t *testing.T) {
+	data := "# My Gemlog\n\n=> 2019-01-05-first.gmi 2019-01-05 - First post\n"
+
+	feed, err := ParseFeed("gemini://example.org/gemlog/", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Gemlog" {
+		t.Errorf("Incorrect title, got: %s", feed.Title)
+	}
+
+	if len(feed.Entries) != 1 || feed.Entries[0].URL != "gemini://example.org/gemlog/2019-01-05-first.gmi" {
+		t.Errorf("Incorrect entries, got: %v", feed.Entries)
+	}
+}
+
 func TestParseUnknownFeed(t *testing.T) {
 	data := `
 		<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
@@ -142,14 +162,14 @@ func TestParseUnknownFeed(t *testing.T) {
 		</html>
 	`
 
-	_, err := ParseFeed(data)
+	_, err := ParseFeed("https://example.org/", data)
 	if err == nil {
 		t.Error("ParseFeed must returns an error")
 	}
 }
 
 func TestParseEmptyFeed(t *testing.T) {
-	_, err := ParseFeed("")
+	_, err := ParseFeed("https://example.org/", "")
 	if err == nil {
 		t.Error("ParseFeed must returns an error")
 	}
@@ -188,7 +208,7 @@ func TestDifferentEncodingWithResponse(t *testing.T) {
 
 		r := &client.Response{Body: bytes.NewReader(content), ContentType: tc.contentType}
 		r.EnsureUnicodeBody()
-		feed, parseErr := ParseFeed(r.String())
+		feed, parseErr := ParseFeed("https://example.org/", r.String())
 		if parseErr != nil {
 			t.Fatalf(`Parsing error for %q - %q: %v`, tc.filename, tc.contentType, parseErr)
 		}
@@ -198,3 +218,45 @@ func TestDifferentEncodingWithResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestParseFeedRecoversFromPanic(t *testing.T) {
+	feed, err := safeParse(func() (*model.Feed, *errors.LocalizedError) {
+		panic("boom")
+	})
+
+	if feed != nil {
+		t.Error(`No feed should be returned after a panic`)
+	}
+
+	if err == nil {
+		t.Fatal(`A panic should be returned as an error`)
+	}
+
+	if err.Error() != `Unable to parse feed: "boom"` {
+		t.Errorf(`Unexpected error message: %q`, err.Error())
+	}
+}
+
+func TestParseFuzzCorpus(t *testing.T) {
+	files, err := filepath.Glob("testdata/fuzz/corpus/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal(`The fuzz corpus should not be empty`)
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The unguarded parser is used here, a panic means a crasher has not been fixed.
+		feed, parseErr := parseFeed("https://example.org/", string(data))
+		if parseErr == nil && feed == nil {
+			t.Errorf(`No error and no feed returned for %s`, file)
+		}
+	}
+}
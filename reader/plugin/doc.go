@@ -0,0 +1,24 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package plugin runs WebAssembly content filters on feed entries.
+
+A plugin is a WebAssembly module that exports the following symbols:
+
+	memory                                     the linear memory
+	allocate(size: i32) -> i32                 returns a buffer for the input
+	filter(ptr: i32, size: i32) -> i64         processes the entry
+	deallocate(ptr: i32, size: i32)            optional, frees a buffer
+
+The entry is written as JSON in the buffer returned by allocate.
+The filter function returns the location of the modified entry as (ptr << 32 | size),
+or zero to drop the entry. The output has the same keys as the input,
+missing keys are left unchanged.
+
+The only available import is env.log(ptr: i32, size: i32) which writes a debug message.
+
+*/
+package plugin // import "miniflux.app/reader/plugin"
@@ -0,0 +1,289 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package plugin // import "miniflux.app/reader/plugin"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+
+	"github.com/perlin-network/life/compiler"
+	"github.com/perlin-network/life/exec"
+)
+
+const (
+	// Maximum number of instructions executed for one entry.
+	gasLimit = 500000000
+
+	defaultMemoryPages = 16
+	maxMemoryPages     = 1024
+)
+
+var (
+	mutex   sync.RWMutex
+	plugins []*Plugin
+)
+
+type payload struct {
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	CommentsURL string `json:"comments_url"`
+	Content     string `json:"content"`
+	Author      string `json:"author"`
+	PublishedAt int64  `json:"published_at"`
+	FeedURL     string `json:"feed_url"`
+	FeedTitle   string `json:"feed_title"`
+}
+
+// Plugin is a WebAssembly content filter.
+type Plugin struct {
+	name string
+	code []byte
+
+	mutex sync.Mutex
+	vm    *exec.VirtualMachine
+}
+
+// New compiles a WebAssembly module and checks its exports.
+func New(name string, code []byte) (*Plugin, error) {
+	p := &Plugin{name: name, code: code}
+	if err := p.instantiate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Name returns the plugin name.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// LoadDirectory loads all the .wasm files of a directory, they are applied in alphabetical order.
+func LoadDirectory(directory string) error {
+	filenames, err := filepath.Glob(filepath.Join(directory, "*.wasm"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(filenames)
+
+	var loaded []*Plugin
+	for _, filename := range filenames {
+		code, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("unable to read the plugin %q: %v", filename, err)
+		}
+
+		p, err := New(filepath.Base(filename), code)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("[Plugin] Loaded %s", p.name)
+		loaded = append(loaded, p)
+	}
+
+	Register(loaded...)
+	return nil
+}
+
+// Register replaces the list of plugins applied to entries.
+func Register(list ...*Plugin) {
+	mutex.Lock()
+	plugins = list
+	mutex.Unlock()
+}
+
+// Apply runs all plugins on the entry and returns false if the entry must be dropped.
+// The entry is left unchanged by a plugin that fails.
+func Apply(feed *model.Feed, entry *model.Entry) bool {
+	mutex.RLock()
+	list := plugins
+	mutex.RUnlock()
+
+	for _, p := range list {
+		keep, err := p.Filter(feed, entry)
+		if err != nil {
+			logger.Error("[Plugin] Unable to run %s on %q: %v", p.name, entry.URL, err)
+			continue
+		}
+
+		if !keep {
+			logger.Debug("[Plugin] Entry %q dropped by %s", entry.URL, p.name)
+			return false
+		}
+	}
+
+	return true
+}
+
+// Filter runs the plugin on the entry and returns false if the entry must be dropped.
+func (p *Plugin) Filter(feed *model.Feed, entry *model.Entry) (bool, error) {
+	input := &payload{
+		Title:       entry.Title,
+		URL:         entry.URL,
+		CommentsURL: entry.CommentsURL,
+		Content:     entry.Content,
+		Author:      entry.Author,
+		PublishedAt: entry.Date.Unix(),
+		FeedURL:     feed.FeedURL,
+		FeedTitle:   feed.Title,
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return true, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.vm == nil {
+		if err := p.instantiate(); err != nil {
+			return true, err
+		}
+	}
+
+	result, err := p.call(data)
+	if err != nil {
+		// The memory of the module may be corrupted, a new instance is created for the next entry.
+		p.vm = nil
+		return true, err
+	}
+
+	if result == nil {
+		return false, nil
+	}
+
+	output := *input
+	if err := json.Unmarshal(result, &output); err != nil {
+		return true, fmt.Errorf("invalid output: %v", err)
+	}
+
+	if output.URL == "" {
+		return true, errors.New("the entry URL must not be empty")
+	}
+
+	entry.Title = output.Title
+	entry.URL = output.URL
+	entry.CommentsURL = output.CommentsURL
+	entry.Content = output.Content
+	entry.Author = output.Author
+	return true, nil
+}
+
+func (p *Plugin) instantiate() (err error) {
+	defer func() {
+		// The import resolver panics on unknown imports.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to load the plugin %s: %v", p.name, r)
+		}
+	}()
+
+	config := exec.VMConfig{
+		DefaultMemoryPages:   defaultMemoryPages,
+		MaxMemoryPages:       maxMemoryPages,
+		GasLimit:             gasLimit,
+		DisableFloatingPoint: true,
+	}
+
+	vm, err := exec.NewVirtualMachine(p.code, config, &resolver{name: p.name}, &compiler.SimpleGasPolicy{GasPerInstruction: 1})
+	if err != nil {
+		return fmt.Errorf("unable to load the plugin %s: %v", p.name, err)
+	}
+
+	for _, name := range []string{"allocate", "filter"} {
+		if _, found := vm.GetFunctionExport(name); !found {
+			return fmt.Errorf("the plugin %s does not export the function %q", p.name, name)
+		}
+	}
+
+	p.vm = vm
+	return nil
+}
+
+func (p *Plugin) call(data []byte) ([]byte, error) {
+	p.vm.Gas = 0
+
+	allocate, _ := p.vm.GetFunctionExport("allocate")
+	ptr, err := p.vm.Run(allocate, int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkBounds(ptr, int64(len(data))); err != nil {
+		return nil, err
+	}
+	copy(p.vm.Memory[ptr:], data)
+
+	filter, _ := p.vm.GetFunctionExport("filter")
+	result, err := p.vm.Run(filter, ptr, int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	p.deallocate(ptr, int64(len(data)))
+
+	if result == 0 {
+		return nil, nil
+	}
+
+	outputPtr := int64(uint64(result) >> 32)
+	outputSize := int64(uint32(result))
+	if err := p.checkBounds(outputPtr, outputSize); err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, outputSize)
+	copy(output, p.vm.Memory[outputPtr:outputPtr+outputSize])
+	p.deallocate(outputPtr, outputSize)
+
+	return output, nil
+}
+
+func (p *Plugin) deallocate(ptr, size int64) {
+	if deallocate, found := p.vm.GetFunctionExport("deallocate"); found {
+		if _, err := p.vm.Run(deallocate, ptr, size); err != nil {
+			logger.Debug("[Plugin] %s: unable to free memory: %v", p.name, err)
+		}
+	}
+}
+
+func (p *Plugin) checkBounds(ptr, size int64) error {
+	if ptr < 0 || size < 0 || ptr+size > int64(len(p.vm.Memory)) {
+		return fmt.Errorf("out of bounds memory access (ptr=%d, size=%d)", ptr, size)
+	}
+	return nil
+}
+
+type resolver struct {
+	name string
+}
+
+func (r *resolver) ResolveFunc(module, field string) exec.FunctionImport {
+	if module == "env" && field == "log" {
+		return func(vm *exec.VirtualMachine) int64 {
+			frame := vm.GetCurrentFrame()
+			ptr, size := int64(uint32(frame.Locals[0])), int64(uint32(frame.Locals[1]))
+			if ptr+size <= int64(len(vm.Memory)) {
+				logger.Debug("[Plugin:%s] %s", r.name, vm.Memory[ptr:ptr+size])
+			}
+			return 0
+		}
+	}
+
+	panic(fmt.Sprintf("unknown import %s.%s", module, field))
+}
+
+func (r *resolver) ResolveGlobal(module, field string) int64 {
+	panic(fmt.Sprintf("unknown global %s.%s", module, field))
+}
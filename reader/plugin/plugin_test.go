@@ -0,0 +1,149 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package plugin // import "miniflux.app/reader/plugin"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+// Hand-assembled modules, allocate always returns 1024.
+var (
+	// filter returns its input.
+	identityPlugin = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x0c, 0x02, 0x60,
+		0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e, 0x03, 0x03,
+		0x02, 0x00, 0x01, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07, 0x1e, 0x03, 0x06,
+		0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x08, 0x61, 0x6c, 0x6c,
+		0x6f, 0x63, 0x61, 0x74, 0x65, 0x00, 0x00, 0x06, 0x66, 0x69, 0x6c, 0x74,
+		0x65, 0x72, 0x00, 0x01, 0x0a, 0x14, 0x02, 0x05, 0x00, 0x41, 0x80, 0x08,
+		0x0b, 0x0c, 0x00, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad,
+		0x84, 0x0b,
+	}
+
+	// filter returns zero.
+	dropPlugin = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x0c, 0x02, 0x60,
+		0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e, 0x03, 0x03,
+		0x02, 0x00, 0x01, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07, 0x1e, 0x03, 0x06,
+		0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x08, 0x61, 0x6c, 0x6c,
+		0x6f, 0x63, 0x61, 0x74, 0x65, 0x00, 0x00, 0x06, 0x66, 0x69, 0x6c, 0x74,
+		0x65, 0x72, 0x00, 0x01, 0x0a, 0x0c, 0x02, 0x05, 0x00, 0x41, 0x80, 0x08,
+		0x0b, 0x04, 0x00, 0x42, 0x00, 0x0b,
+	}
+
+	// filter never returns.
+	infiniteLoopPlugin = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x0c, 0x02, 0x60,
+		0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e, 0x03, 0x03,
+		0x02, 0x00, 0x01, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07, 0x1e, 0x03, 0x06,
+		0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x08, 0x61, 0x6c, 0x6c,
+		0x6f, 0x63, 0x61, 0x74, 0x65, 0x00, 0x00, 0x06, 0x66, 0x69, 0x6c, 0x74,
+		0x65, 0x72, 0x00, 0x01, 0x0a, 0x11, 0x02, 0x05, 0x00, 0x41, 0x80, 0x08,
+		0x0b, 0x09, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b,
+	}
+
+	// filter returns {"title":"Cleaned title","content":"<p>Cleaned</p>"} stored at 4096.
+	cleanupPlugin = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x0c, 0x02, 0x60,
+		0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e, 0x03, 0x03,
+		0x02, 0x00, 0x01, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07, 0x1e, 0x03, 0x06,
+		0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x08, 0x61, 0x6c, 0x6c,
+		0x6f, 0x63, 0x61, 0x74, 0x65, 0x00, 0x00, 0x06, 0x66, 0x69, 0x6c, 0x74,
+		0x65, 0x72, 0x00, 0x01, 0x0a, 0x12, 0x02, 0x05, 0x00, 0x41, 0x80, 0x08,
+		0x0b, 0x0a, 0x00, 0x42, 0xb4, 0x80, 0x80, 0x80, 0x80, 0x80, 0x04, 0x0b,
+		0x0b, 0x3b, 0x01, 0x00, 0x41, 0x80, 0x20, 0x0b, 0x34, 0x7b, 0x22, 0x74,
+		0x69, 0x74, 0x6c, 0x65, 0x22, 0x3a, 0x22, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+		0x65, 0x64, 0x20, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2c, 0x22, 0x63,
+		0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3a, 0x22, 0x3c, 0x70, 0x3e,
+		0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x64, 0x3c, 0x2f, 0x70, 0x3e, 0x22,
+		0x7d,
+	}
+)
+
+func newEntry() *model.Entry {
+	return &model.Entry{Title: "Some Title", URL: "https://example.org/article", Content: "<p>Some content</p>"}
+}
+
+func TestNewWithInvalidModule(t *testing.T) {
+	if _, err := New("invalid.wasm", []byte("invalid")); err == nil {
+		t.Error(`An invalid module should be rejected`)
+	}
+}
+
+func TestIdentityPlugin(t *testing.T) {
+	p, err := New("identity.wasm", identityPlugin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := newEntry()
+	keep, err := p.Filter(&model.Feed{}, entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !keep {
+		t.Error(`The entry should be kept`)
+	}
+
+	if entry.Title != "Some Title" || entry.Content != "<p>Some content</p>" {
+		t.Errorf(`The entry should not be modified, got %q and %q`, entry.Title, entry.Content)
+	}
+}
+
+func TestCleanupPlugin(t *testing.T) {
+	p, err := New("cleanup.wasm", cleanupPlugin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := newEntry()
+	if keep, err := p.Filter(&model.Feed{}, entry); err != nil || !keep {
+		t.Fatalf(`The entry should be kept: %v`, err)
+	}
+
+	if entry.Title != "Cleaned title" || entry.Content != "<p>Cleaned</p>" {
+		t.Errorf(`Unexpected entry, got %q and %q`, entry.Title, entry.Content)
+	}
+
+	if entry.URL != "https://example.org/article" {
+		t.Errorf(`Missing keys should be unchanged, got %q`, entry.URL)
+	}
+}
+
+func TestApply(t *testing.T) {
+	identity, _ := New("identity.wasm", identityPlugin)
+	drop, _ := New("drop.wasm", dropPlugin)
+
+	Register(identity, drop)
+	defer Register()
+
+	if Apply(&model.Feed{}, newEntry()) {
+		t.Error(`The entry should be dropped`)
+	}
+}
+
+func TestApplyWithInfiniteLoop(t *testing.T) {
+	loop, err := New("loop.wasm", infiniteLoopPlugin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cleanup, _ := New("cleanup.wasm", cleanupPlugin)
+
+	Register(loop, cleanup)
+	defer Register()
+
+	entry := newEntry()
+	if !Apply(&model.Feed{}, entry) {
+		t.Fatal(`The entry should be kept`)
+	}
+
+	if entry.Title != "Cleaned title" {
+		t.Errorf(`The next plugins should run when a plugin fails, got %q`, entry.Title)
+	}
+}
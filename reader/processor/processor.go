@@ -5,20 +5,38 @@
 package processor
 
 import (
+	"context"
+
+	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/adblock"
+	"miniflux.app/reader/junk"
+	"miniflux.app/reader/nsfw"
+	"miniflux.app/reader/plugin"
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/script"
 	"miniflux.app/storage"
 )
 
 // ProcessFeedEntries downloads original web page for entries and apply filters.
-func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
+func ProcessFeedEntries(ctx context.Context, store storage.Store, feed *model.Feed) {
+	filter := script.NewFilter(feed)
+	entries := feed.Entries[:0]
+
+	readMore := ""
+	if feed.SummaryWords > 0 {
+		readMore = locale.NewPrinter(store.UserLanguage(ctx, feed.UserID)).Printf("entry.summary.read_more")
+	}
+
+	junkThreshold := store.UserJunkThreshold(ctx, feed.UserID)
+
 	for _, entry := range feed.Entries {
 		if feed.Crawler {
-			if !store.EntryURLExists(feed.UserID, entry.URL) {
-				content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent)
+			if !store.EntryURLExists(ctx, feed.UserID, entry.URL) {
+				content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent, feed.ScraperMaxPages)
 				if err != nil {
 					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, err)
 				} else if content != "" {
@@ -29,20 +47,51 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
 		}
 
 		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, feed.RewriteRules)
+		entry.Content = adblock.Apply(entry.URL, entry.Content)
+
+		if !plugin.Apply(feed, entry) || !filter.Apply(entry) {
+			continue
+		}
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
 		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+
+		// The junk score is computed on the full content, before the summary.
+		entry.JunkScore = junk.Score(entry)
+		if junkThreshold > 0 && entry.JunkScore >= junkThreshold {
+			entry.Status = model.EntryStatusRead
+		}
+
+		// The flags of the feed and its category are applied when the entries are displayed.
+		if nsfw.HasKeywords(entry) {
+			entry.NSFW = true
+		}
+
+		// High-volume feeds can keep only the beginning of their entries.
+		if feed.SummaryWords > 0 {
+			entry.Content = summarize(entry.Content, entry.URL, feed.SummaryWords, readMore)
+		}
+
+		// Entries of a muted feed are still stored but they never show up as unread.
+		if feed.IsMuted() {
+			entry.Status = model.EntryStatusRead
+		}
+
+		entries = append(entries, entry)
 	}
+
+	feed.Entries = entries
 }
 
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
 func ProcessEntryWebPage(entry *model.Entry) error {
-	content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
+	content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent, entry.Feed.ScraperMaxPages)
 	if err != nil {
 		return err
 	}
 
 	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
+	content = adblock.Apply(entry.URL, content)
 	content = sanitizer.Sanitize(entry.URL, content)
 
 	if content != "" {
@@ -51,3 +100,22 @@ func ProcessEntryWebPage(entry *model.Entry) error {
 
 	return nil
 }
+
+// SnapshotEntryWebPage downloads the entry web page and returns a sanitized copy of the article.
+// When the page cannot be downloaded, the error is also recorded in the snapshot.
+func SnapshotEntryWebPage(entry *model.Entry) (*model.EntrySnapshot, error) {
+	snapshot := &model.EntrySnapshot{EntryID: entry.ID, URL: entry.URL}
+
+	page, err := scraper.FetchPage(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent, entry.Feed.ScraperMaxPages)
+	if err != nil {
+		snapshot.Error = err.Error()
+		return snapshot, err
+	}
+
+	content := rewrite.Rewriter(page.URL, page.Content, entry.Feed.RewriteRules)
+	content = adblock.Apply(page.URL, content)
+	snapshot.URL = page.URL
+	snapshot.Content = sanitizer.Sanitize(page.URL, content)
+	snapshot.Size = len(snapshot.Content)
+	return snapshot, nil
+}
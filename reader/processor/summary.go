@@ -0,0 +1,96 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor // import "miniflux.app/reader/processor"
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// summarize keeps the first words of the content and appends a link to the article.
+// The content is returned untouched when it's short enough.
+func summarize(content, entryURL string, maxWords int, readMore string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	var buffer bytes.Buffer
+	var openTags []string
+	words := 0
+
+	for {
+		if tokenizer.Next() == html.ErrorToken {
+			return content
+		}
+
+		// The raw token is copied before parsing it, Token() lowercases the tag names in place.
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+
+		switch token.Type {
+		case html.StartTagToken:
+			if !voidElements[token.Data] {
+				openTags = append(openTags, token.Data)
+			}
+		case html.EndTagToken:
+			for i := len(openTags) - 1; i >= 0; i-- {
+				if openTags[i] == token.Data {
+					openTags = openTags[:i]
+					break
+				}
+			}
+		case html.TextToken:
+			end, count := cutWords(raw, maxWords-words)
+			words += count
+
+			if end < len(raw) {
+				buffer.WriteString(raw[:end])
+				buffer.WriteString("…")
+
+				for i := len(openTags) - 1; i >= 0; i-- {
+					buffer.WriteString("</" + openTags[i] + ">")
+				}
+
+				buffer.WriteString(`<p><a href="` + html.EscapeString(entryURL) + `">` + html.EscapeString(readMore) + `</a></p>`)
+				return buffer.String()
+			}
+		}
+
+		buffer.WriteString(raw)
+	}
+}
+
+// cutWords returns the position following the first words of the text and the number of words before this position.
+func cutWords(text string, limit int) (int, int) {
+	count, end := 0, 0
+	inWord := false
+
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if inWord {
+				end = i
+				inWord = false
+			}
+
+			continue
+		}
+
+		if !inWord {
+			if count == limit {
+				return end, count
+			}
+
+			count++
+			inWord = true
+		}
+	}
+
+	return len(text), count
+}
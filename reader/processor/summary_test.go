@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor // import "miniflux.app/reader/processor"
+
+import "testing"
+
+func TestSummarizeShortContent(t *testing.T) {
+	input := `<p>Only <strong>four</strong> words here.</p>`
+	output := summarize(input, "https://example.org/article", 4, "Read more")
+
+	if output != input {
+		t.Errorf(`Short content should not be truncated, got "%s"`, output)
+	}
+}
+
+func TestSummarizeClosesOpenTags(t *testing.T) {
+	input := `<p>The first <strong>paragraph of the</strong> article.</p><p>The second paragraph.</p>`
+	output := summarize(input, "https://example.org/article?a=1&b=2", 4, "Read more")
+	expected := `<p>The first <strong>paragraph of…</strong></p><p><a href="https://example.org/article?a=1&amp;b=2">Read more</a></p>`
+
+	if output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, output, expected)
+	}
+}
+
+func TestSummarizeWithVoidElements(t *testing.T) {
+	input := `<p><img src="https://example.org/image.jpg"/>One<br>two three</p>`
+	output := summarize(input, "https://example.org/article", 2, "Lire la suite")
+	expected := `<p><img src="https://example.org/image.jpg"/>One<br>two…</p><p><a href="https://example.org/article">Lire la suite</a></p>`
+
+	if output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, output, expected)
+	}
+}
+
+func TestCutWords(t *testing.T) {
+	scenarios := []struct {
+		text  string
+		limit int
+		end   int
+		count int
+	}{
+		{"one two three", 2, 7, 2},
+		{"  one  ", 1, 7, 1},
+		{"one two", 0, 0, 0},
+		{"one two", 5, 7, 2},
+	}
+
+	for _, scenario := range scenarios {
+		end, count := cutWords(scenario.text, scenario.limit)
+		if end != scenario.end || count != scenario.count {
+			t.Errorf(`Unexpected result for %q: got %d and %d`, scenario.text, end, count)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"strings"
 
+	"miniflux.app/url"
+
 	"github.com/PuerkitoBio/goquery"
 )
 
@@ -129,6 +131,55 @@ func replaceLineFeeds(input string) string {
 	return strings.Replace(input, "\n", "<br>", -1)
 }
 
+// removeOverlays strips the cookie-consent banners, the newsletter modals and the "subscribe to read" overlays
+// copied from the web page with the article, they are listed in overlaySelectors.
+func removeOverlays(entryURL, entryContent string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	overlays := doc.Find(strings.Join(overlaySelectors, ", "))
+	if overlays.Length() == 0 {
+		return entryContent
+	}
+
+	overlays.Remove()
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+// addDarkModeBackground marks the transparent images drawn with dark strokes, like the diagrams of engineering blogs,
+// the dark theme displays them on a light background.
+func addDarkModeBackground(entryURL, entryContent string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	changed := false
+	doc.Find("img[src]").EachWithBreak(func(i int, img *goquery.Selection) bool {
+		if i >= maxAnalyzedImages {
+			return false
+		}
+
+		imageURL, err := url.AbsoluteURL(entryURL, img.AttrOr("src", ""))
+		if err == nil && needsLightBackground(imageURL) {
+			img.SetAttr("data-miniflux-background", "light")
+			changed = true
+		}
+
+		return true
+	})
+
+	if !changed {
+		return entryContent
+	}
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
 // -- Gatra Bali specific rewriter functions -- //
 
 // hideFirstImage replaces the first image found on body with span tag '<span data-minifux-enclosure=""/>'
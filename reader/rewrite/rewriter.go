@@ -19,7 +19,7 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 	}
 
 	rules := strings.Split(rulesList, ",")
-	rules = append(rules, "add_pdf_download_link")
+	rules = append(rules, "add_pdf_download_link", "remove_overlays")
 
 	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entryURL)
 
@@ -33,6 +33,10 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 			entryContent = addYoutubeVideo(entryURL, entryContent)
 		case "add_pdf_download_link":
 			entryContent = addPDFLink(entryURL, entryContent)
+		case "remove_overlays":
+			entryContent = removeOverlays(entryURL, entryContent)
+		case "add_dark_mode_background":
+			entryContent = addDarkModeBackground(entryURL, entryContent)
 		case "hide_first_image":
 			entryContent = hideFirstImage(entryURL, entryContent)
 		case "cleanup_balipost":
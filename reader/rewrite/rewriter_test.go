@@ -138,3 +138,23 @@ func TestRewriteWithUnknownLazyNoScriptImage(t *testing.T) {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
 	}
 }
+
+func TestRewriteRemovesOverlays(t *testing.T) {
+	description := `<div id="onetrust-consent-sdk"><p>We use cookies</p></div><p>Article</p><div class="newsletter-modal"><p>Subscribe</p></div>`
+	output := Rewriter("https://example.org/article", description, ``)
+	expected := `<p>Article</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithoutOverlays(t *testing.T) {
+	description := `<p class="cookie-recipe">Article about cookies</p>`
+	output := Rewriter("https://example.org/article", description, ``)
+	expected := description
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
@@ -33,3 +33,36 @@ var predefinedRules = map[string]string{
 	"balipuspanews.com": "hide_first_image,cleanup_balipuspanews",
 	"balebengong.id":    "hide_first_image",
 }
+
+// List of CSS selectors of the cookie-consent banners, newsletter modals and "subscribe to read" overlays,
+// they are removed from every entry by the "remove_overlays" rule.
+var overlaySelectors = []string{
+	// Cookie-consent banners
+	"#cookie-banner",
+	"#cookie-notice",
+	"#cookie-law-info-bar",
+	"#CybotCookiebotDialog",
+	"#onetrust-consent-sdk",
+	"#didomi-host",
+	"#truste-consent-track",
+	".cc-window",
+	".cookie-banner",
+	".cookie-consent",
+	".cookie-notice",
+	".gdpr-banner",
+	".qc-cmp2-container",
+	// Newsletter modals
+	"#newsletter-modal",
+	".newsletter-modal",
+	".newsletter-popup",
+	".mc-modal",
+	".signup-modal",
+	".subscribe-modal",
+	// Subscribe to read overlays
+	".paywall-overlay",
+	".regwall",
+	".subscribe-overlay",
+	".subscription-overlay",
+	".tp-backdrop",
+	".tp-modal",
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif" // Register the GIF decoder.
+	_ "image/png" // Register the PNG decoder.
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+const (
+	// Only the first images of an entry are downloaded to keep the refresh of feeds fast.
+	maxAnalyzedImages    = 10
+	maxAnalyzedImageSize = 2 * 1024 * 1024
+	maxAnalyzedPixels    = 4 * 1000 * 1000
+
+	// Large images are sampled instead of reading every pixel.
+	maxSampledPixels = 10000
+)
+
+// needsLightBackground downloads the image and returns true when it's an SVG image
+// or a transparent image drawn with dark strokes, they are unreadable on a dark background.
+func needsLightBackground(imageURL string) bool {
+	clt := client.New(imageURL)
+	response, err := clt.Get()
+	if err != nil || response.HasServerFailure() {
+		return false
+	}
+
+	// SVG diagrams are almost always transparent and can't be decoded by the standard library.
+	if strings.HasPrefix(response.ContentType, "image/svg") {
+		return true
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxAnalyzedImageSize+1))
+	if err != nil || len(data) > maxAnalyzedImageSize {
+		return false
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width*cfg.Height > maxAnalyzedPixels {
+		return false
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+
+	return hasDarkStrokes(img)
+}
+
+// hasDarkStrokes returns true when at least a tenth of the image is transparent and most of the visible pixels are dark.
+func hasDarkStrokes(img image.Image) bool {
+	bounds := img.Bounds()
+	step := 1
+	for (bounds.Dx()/step)*(bounds.Dy()/step) > maxSampledPixels {
+		step++
+	}
+
+	var transparent, visible, dark int
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a < 0x8000 {
+				transparent++
+				continue
+			}
+
+			// The color components are premultiplied by the alpha value.
+			luminance := (299*uint64(r) + 587*uint64(g) + 114*uint64(b)) / 1000 * 0xffff / uint64(a)
+			if luminance < 0x5000 {
+				dark++
+			}
+
+			visible++
+		}
+	}
+
+	return visible > 0 && transparent*10 >= transparent+visible && dark*2 >= visible
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rewrite // import "miniflux.app/reader/rewrite"
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newDiagram returns a transparent image with a stroke of the given color in the middle.
+func newDiagram(stroke color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 200, 100))
+	for y := 40; y < 60; y++ {
+		for x := 0; x < 200; x++ {
+			img.Set(x, y, stroke)
+		}
+	}
+
+	return img
+}
+
+func TestHasDarkStrokes(t *testing.T) {
+	if !hasDarkStrokes(newDiagram(color.Black)) {
+		t.Error(`A transparent image with black strokes should be detected`)
+	}
+}
+
+func TestHasDarkStrokesWithLightStrokes(t *testing.T) {
+	if hasDarkStrokes(newDiagram(color.White)) {
+		t.Error(`A transparent image with white strokes is readable on a dark background`)
+	}
+}
+
+func TestHasDarkStrokesWithOpaqueImage(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 200, 100))
+	if hasDarkStrokes(img) {
+		t.Error(`An opaque image should not be detected`)
+	}
+}
+
+func TestHasDarkStrokesWithLargeImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2000, 1000))
+	for y := 0; y < 1000; y++ {
+		img.Set(y, y, color.Black)
+		img.Set(y+1, y, color.Black)
+	}
+
+	if !hasDarkStrokes(img) {
+		t.Error(`A large transparent image with black strokes should be detected`)
+	}
+}
+
+func TestRewriteWithDarkModeBackground(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/diagram.png":
+			w.Header().Set("Content-Type", "image/png")
+			png.Encode(w, newDiagram(color.Black))
+		case "/photo.png":
+			w.Header().Set("Content-Type", "image/png")
+			png.Encode(w, newDiagram(color.White))
+		case "/logo.svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			w.Write([]byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	description := `<p><img src="/diagram.png" alt="Diagram"></p><p><img src="/photo.png"></p><p><img src="/logo.svg"></p><p><img src="/missing.png"></p>`
+	output := Rewriter(server.URL+"/article", description, "add_dark_mode_background")
+	expected := `<p><img src="/diagram.png" alt="Diagram" data-miniflux-background="light"/></p><p><img src="/photo.png"/></p><p><img src="/logo.svg" data-miniflux-background="light"/></p><p><img src="/missing.png"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithoutDarkModeBackgroundRule(t *testing.T) {
+	description := `<p><img src="https://example.org/diagram.png"></p>`
+	output := Rewriter("https://example.org/article", description, ``)
+
+	if description != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, description)
+	}
+}
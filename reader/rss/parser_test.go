@@ -607,6 +607,69 @@ func TestParseEntryWithCommentsURL(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithNSFWIndicators(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+				<category>Technology</category>
+			</item>
+			<item>
+				<title>Item 2</title>
+				<link>https://example.org/item2</link>
+				<category>NSFW</category>
+			</item>
+			<item>
+				<title>Item 3</title>
+				<link>https://example.org/item3</link>
+				<media:rating scheme="urn:simple">adult</media:rating>
+			</item>
+			<item>
+				<title>Item 4</title>
+				<link>https://example.org/item4</link>
+				<itunes:explicit>yes</itunes:explicit>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []bool{false, true, true, true} {
+		if feed.Entries[i].NSFW != expected {
+			t.Errorf("Incorrect NSFW flag for entry #%d, got: %v", i+1, feed.Entries[i].NSFW)
+		}
+	}
+}
+
+func TestParseFeedWithExplicitChannel(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<link>https://example.org/</link>
+			<itunes:explicit>true</itunes:explicit>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !feed.Entries[0].NSFW {
+		t.Error("The entries of an explicit channel should be flagged as NSFW")
+	}
+}
+
 func TestParseInvalidXml(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
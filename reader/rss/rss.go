@@ -17,20 +17,23 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/nsfw"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 )
 
 type rssFeed struct {
-	XMLName      xml.Name  `xml:"rss"`
-	Version      string    `xml:"version,attr"`
-	Title        string    `xml:"channel>title"`
-	Links        []rssLink `xml:"channel>link"`
-	Language     string    `xml:"channel>language"`
-	Description  string    `xml:"channel>description"`
-	PubDate      string    `xml:"channel>pubDate"`
-	ItunesAuthor string    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
-	Items        []rssItem `xml:"channel>item"`
+	XMLName        xml.Name  `xml:"rss"`
+	Version        string    `xml:"version,attr"`
+	Title          string    `xml:"channel>title"`
+	Links          []rssLink `xml:"channel>link"`
+	Language       string    `xml:"channel>language"`
+	Description    string    `xml:"channel>description"`
+	PubDate        string    `xml:"channel>pubDate"`
+	ItunesAuthor   string    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
+	ItunesExplicit string    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>explicit"`
+	MediaRating    string    `xml:"http://search.yahoo.com/mrss/ channel>rating"`
+	Items          []rssItem `xml:"channel>item"`
 }
 
 type rssLink struct {
@@ -74,6 +77,9 @@ type rssItem struct {
 	OrigEnclosureLink string           `xml:"http://rssnamespace.org/feedburner/ext/1.0 origEnclosureLink"`
 	Image             string           `xml:"image"`
 	Thumbnail         string           `xml:"thumbnail"`
+	Categories        []string         `xml:"category"`
+	ItunesExplicit    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit"`
+	MediaRatings      []string         `xml:"http://search.yahoo.com/mrss/ rating"`
 }
 
 func (r *rssFeed) SiteURL() string {
@@ -114,6 +120,10 @@ func (r *rssFeed) Transform() *model.Feed {
 		}
 		entry.Author = strings.TrimSpace(sanitizer.StripTags(entry.Author))
 
+		if nsfw.IsExplicitRating(r.ItunesExplicit) || nsfw.IsExplicitRating(r.MediaRating) {
+			entry.NSFW = true
+		}
+
 		if entry.URL == "" {
 			entry.URL = feed.SiteURL
 		} else {
@@ -269,6 +279,27 @@ func (r *rssItem) CommentsURL() string {
 	return ""
 }
 
+// IsNSFW returns true when the item is rated for adults or has a NSFW category.
+func (r *rssItem) IsNSFW() bool {
+	if nsfw.IsExplicitRating(r.ItunesExplicit) {
+		return true
+	}
+
+	for _, rating := range r.MediaRatings {
+		if nsfw.IsExplicitRating(rating) {
+			return true
+		}
+	}
+
+	for _, category := range r.Categories {
+		if nsfw.IsExplicitCategory(category) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *rssItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = r.URL()
@@ -279,6 +310,7 @@ func (r *rssItem) Transform() *model.Entry {
 	entry.Content = r.Content()
 	entry.Title = strings.TrimSpace(r.Title)
 	entry.Enclosures = r.Enclosures()
+	entry.NSFW = r.IsNSFW()
 	return entry
 }
 
@@ -111,7 +111,11 @@ func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([
 					continue
 				}
 
-				if !hasValidScheme(value) || isBlacklistedResource(value) {
+				if !hasValidScheme(value) && !(attribute.Key == "href" && hasAllowedScheme(value)) {
+					continue
+				}
+
+				if isBlacklistedResource(value) {
 					continue
 				}
 			}
@@ -322,7 +326,7 @@ func isValidIframeSource(src string) bool {
 func getTagWhitelist() map[string][]string {
 	whitelist := make(map[string][]string)
 	whitelist["span"] = []string{"data-miniflux-enclosure"}
-	whitelist["img"] = []string{"alt", "title", "src"}
+	whitelist["img"] = []string{"alt", "title", "src", "data-miniflux-background"}
 	whitelist["audio"] = []string{"src"}
 	whitelist["video"] = []string{"poster", "height", "width", "src"}
 	whitelist["source"] = []string{"src", "type"}
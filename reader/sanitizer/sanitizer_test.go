@@ -24,6 +24,15 @@ func TestSelfClosingTags(t *testing.T) {
 	}
 }
 
+func TestImageWithDarkModeBackground(t *testing.T) {
+	input := `<p><img src="http://example.org/diagram.png" alt="Diagram" data-miniflux-background="light"/></p>`
+	output := Sanitize("http://example.org/", input)
+
+	if input != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, input, output)
+	}
+}
+
 func TestTable(t *testing.T) {
 	input := `<table><tr><th>A</th><th colspan="2">B</th></tr><tr><td>C</td><td>D</td><td>E</td></tr></table>`
 	output := Sanitize("http://example.org/", input)
@@ -252,3 +261,61 @@ func TestReplaceStyle(t *testing.T) {
 		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
 	}
 }
+
+func TestLinkWithSchemeNotAllowed(t *testing.T) {
+	input := `<a href="magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a">Download</a>`
+	expected := `Download`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestLinkWithAllowedScheme(t *testing.T) {
+	if err := SetAllowedSchemes([]string{"magnet", "Gemini:"}); err != nil {
+		t.Fatal(err)
+	}
+	defer SetAllowedSchemes(nil)
+
+	input := `<a href="magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a">Download</a> <a href="gemini://example.org/">Capsule</a>`
+	expected := `<a href="magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">Download</a> <a href="gemini://example.org/" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">Capsule</a>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestImageWithAllowedScheme(t *testing.T) {
+	if err := SetAllowedSchemes([]string{"gemini"}); err != nil {
+		t.Fatal(err)
+	}
+	defer SetAllowedSchemes(nil)
+
+	input := `<img src="gemini://example.org/image.png"/>`
+	expected := ``
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestScriptSchemesAreNeverAllowed(t *testing.T) {
+	for _, scheme := range []string{"javascript", "JavaScript:", "data", "vbscript"} {
+		if err := SetAllowedSchemes([]string{scheme}); err == nil {
+			t.Errorf(`The scheme %q should be rejected`, scheme)
+		}
+	}
+
+	if err := SetAllowedSchemes([]string{"not a scheme"}); err == nil {
+		t.Error(`An invalid scheme should be rejected`)
+	}
+
+	for _, input := range []string{`<a href="javascript:alert(1)">Click</a>`, `<a href="data:text/html;base64,PHNjcmlwdD4=">Click</a>`} {
+		if output := Sanitize("http://example.org/", input); output != "Click" {
+			t.Errorf(`Wrong output: "%s" != "Click"`, output)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	schemeRegex = regexp.MustCompile(`^[a-z][a-z0-9+.\-]*$`)
+
+	// Schemes able to run scripts are never allowed, even when configured.
+	forbiddenSchemes = []string{"javascript", "vbscript", "data"}
+
+	schemeMutex    sync.RWMutex
+	allowedSchemes map[string]bool
+)
+
+// SetAllowedSchemes defines the schemes allowed in links in addition to the built-in list, for example magnet or gemini.
+func SetAllowedSchemes(schemes []string) error {
+	allowed := make(map[string]bool)
+	for _, scheme := range schemes {
+		scheme = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(scheme)), ":")
+		if scheme == "" {
+			continue
+		}
+
+		if !schemeRegex.MatchString(scheme) {
+			return fmt.Errorf("sanitizer: invalid URL scheme %q", scheme)
+		}
+
+		if inList(scheme, forbiddenSchemes) {
+			return fmt.Errorf("sanitizer: the URL scheme %q cannot be allowed", scheme)
+		}
+
+		allowed[scheme] = true
+	}
+
+	schemeMutex.Lock()
+	allowedSchemes = allowed
+	schemeMutex.Unlock()
+	return nil
+}
+
+// hasAllowedScheme returns true when the link uses one of the configured schemes,
+// links like magnet:?xt=... don't have the double slash of the built-in list.
+func hasAllowedScheme(src string) bool {
+	i := strings.Index(src, ":")
+	if i <= 0 {
+		return false
+	}
+
+	scheme := strings.ToLower(src[:i])
+	if inList(scheme, forbiddenSchemes) {
+		return false
+	}
+
+	schemeMutex.RLock()
+	defer schemeMutex.RUnlock()
+	return allowedSchemes[scheme]
+}
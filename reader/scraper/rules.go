@@ -46,3 +46,13 @@ var predefinedRules = map[string]string{
 	"metrobali.com":     ".post-image img.wp-post-image, .entry-content-inner",
 	"balipuspanews.com": ".td-post-content",
 }
+
+// List of CSS selectors of the link to the next page of an article, in order of preference.
+var nextPageSelectors = []string{
+	`link[rel~="next"]`,
+	`a[rel~="next"]`,
+	"a.next-page",
+	"a.pagination-next",
+	".pagination a.next",
+	".pagination .next a",
+}
@@ -5,21 +5,91 @@
 package scraper // import "miniflux.app/reader/scraper"
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"html"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"miniflux.app/http/client"
 	"miniflux.app/logger"
+	"miniflux.app/reader/gemtext"
 	"miniflux.app/reader/readability"
 	"miniflux.app/url"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+// Page is a web page downloaded by the scraper.
+type Page struct {
+	// URL is the location of the page after following redirects.
+	URL     string
+	Title   string
+	Content string
+}
+
 // Fetch downloads a web page and returns relevant contents.
-func Fetch(websiteURL, rules, userAgent string) (string, error) {
+func Fetch(websiteURL, rules, userAgent string, maxPages int) (string, error) {
+	page, err := FetchPage(websiteURL, rules, userAgent, maxPages)
+	if err != nil {
+		return "", err
+	}
+
+	return page.Content, nil
+}
+
+// FetchPage downloads a web page and returns its title and relevant contents.
+// The next pages of an article split across several pages are appended, up to maxPages pages.
+func FetchPage(websiteURL, rules, userAgent string, maxPages int) (*Page, error) {
+	body, websiteURL, err := download(websiteURL, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules == "" {
+		rules = getPredefinedScraperRules(websiteURL)
+	}
+
+	content, err := extractContent(websiteURL, body, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{URL: websiteURL, Title: scrapTitle(bytes.NewReader(body)), Content: content}
+	visited := map[string]bool{websiteURL: true}
+	pageURL := websiteURL
+
+	for pages := 1; pages < maxPages; pages++ {
+		nextURL := findNextPageURL(pageURL, bytes.NewReader(body))
+		if nextURL == "" || visited[nextURL] {
+			break
+		}
+
+		logger.Debug(`[Scraper] Following the next page %q of %q`, nextURL, websiteURL)
+		body, pageURL, err = download(nextURL, userAgent)
+		if err != nil {
+			logger.Error(`[Scraper] Unable to download the next page %q: %v`, nextURL, err)
+			break
+		}
+
+		visited[nextURL] = true
+		visited[pageURL] = true
+
+		content, err := extractContent(pageURL, body, rules)
+		if err != nil || content == "" {
+			break
+		}
+
+		page.Content += content
+	}
+
+	return page, nil
+}
+
+// download returns the body of a web page and its location after following redirects.
+func download(websiteURL, userAgent string) ([]byte, string, error) {
 	clt := client.New(websiteURL)
 	if userAgent != "" {
 		clt.WithUserAgent(userAgent)
@@ -27,42 +97,41 @@ func Fetch(websiteURL, rules, userAgent string) (string, error) {
 
 	response, err := clt.Get()
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
 	if response.HasServerFailure() {
-		return "", errors.New("scraper: unable to download web page")
+		return nil, "", errors.New("scraper: unable to download web page")
 	}
 
 	if !isWhitelistedContentType(response.ContentType) {
-		return "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", response.ContentType)
+		return nil, "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", response.ContentType)
 	}
 
 	if err = response.EnsureUnicodeBody(); err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	// The entry URL could redirect somewhere else.
-	websiteURL = response.EffectiveURL
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, "", err
+	}
 
-	if rules == "" {
-		rules = getPredefinedScraperRules(websiteURL)
+	if isGemtextContentType(response.ContentType) {
+		body = gemtextToHTML(response.EffectiveURL, string(body))
 	}
 
-	var content string
+	return body, response.EffectiveURL, nil
+}
+
+func extractContent(websiteURL string, body []byte, rules string) (string, error) {
 	if rules != "" {
 		logger.Debug(`[Scraper] Using rules %q for %q`, rules, websiteURL)
-		content, err = scrapContent(response.Body, rules)
-	} else {
-		logger.Debug(`[Scraper] Using readability for %q`, websiteURL)
-		content, err = readability.ExtractContent(response.Body)
-	}
-
-	if err != nil {
-		return "", err
+		return scrapContent(bytes.NewReader(body), rules)
 	}
 
-	return content, nil
+	logger.Debug(`[Scraper] Using readability for %q`, websiteURL)
+	return readability.ExtractContent(bytes.NewReader(body))
 }
 
 func scrapContent(page io.Reader, rules string) (string, error) {
@@ -88,6 +157,40 @@ func scrapContent(page io.Reader, rules string) (string, error) {
 	return contents, nil
 }
 
+func scrapTitle(page io.Reader) string {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(document.Find("head title").First().Text())
+}
+
+// findNextPageURL returns the link to the next page of an article on the same website,
+// an empty string is returned if the page is not paginated.
+func findNextPageURL(pageURL string, page io.Reader) string {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return ""
+	}
+
+	for _, selector := range nextPageSelectors {
+		href, found := document.Find(selector).First().Attr("href")
+		if !found || strings.TrimSpace(href) == "" || strings.HasPrefix(href, "#") {
+			continue
+		}
+
+		nextURL, err := url.AbsoluteURL(pageURL, strings.TrimSpace(href))
+		if err != nil || nextURL == pageURL || url.Domain(nextURL) != url.Domain(pageURL) {
+			continue
+		}
+
+		return nextURL
+	}
+
+	return ""
+}
+
 func getPredefinedScraperRules(websiteURL string) string {
 	urlDomain := url.Domain(websiteURL)
 
@@ -103,5 +206,15 @@ func getPredefinedScraperRules(websiteURL string) string {
 func isWhitelistedContentType(contentType string) bool {
 	contentType = strings.ToLower(contentType)
 	return strings.HasPrefix(contentType, "text/html") ||
-		strings.HasPrefix(contentType, "application/xhtml+xml")
+		strings.HasPrefix(contentType, "application/xhtml+xml") ||
+		isGemtextContentType(contentType)
+}
+
+func isGemtextContentType(contentType string) bool {
+	return strings.HasPrefix(strings.ToLower(contentType), "text/gemini")
+}
+
+// gemtextToHTML converts a Gemini page to a HTML document, the title is the first heading of the page.
+func gemtextToHTML(pageURL, text string) []byte {
+	return []byte("<html><head><title>" + html.EscapeString(gemtext.Title(text)) + "</title></head><body>" + gemtext.ToHTML(pageURL, text) + "</body></html>")
 }
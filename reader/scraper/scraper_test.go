@@ -4,7 +4,10 @@
 
 package scraper // import "miniflux.app/reader/scraper"
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestGetPredefinedRules(t *testing.T) {
 	if getPredefinedScraperRules("http://www.phoronix.com/") == "" {
@@ -27,6 +30,8 @@ func TestWhitelistedContentTypes(t *testing.T) {
 		"application/xhtml+xml":                true,
 		"text/html; charset=utf-8":             true,
 		"application/xhtml+xml; charset=utf-8": true,
+		"text/gemini":                          true,
+		"text/gemini; lang=en":                 true,
 		"text/css":                             false,
 		"application/javascript":               false,
 		"image/png":                            false,
@@ -40,3 +45,47 @@ func TestWhitelistedContentTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestScrapTitle(t *testing.T) {
+	page := `<html><head><title>
+		Some Title </title></head><body><h1>Other title</h1></body></html>`
+
+	if title := scrapTitle(strings.NewReader(page)); title != "Some Title" {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+
+	if title := scrapTitle(strings.NewReader(`<p>No title</p>`)); title != "" {
+		t.Errorf(`Pages without title should return an empty string, got %q`, title)
+	}
+}
+
+func TestGemtextToHTML(t *testing.T) {
+	output := string(gemtextToHTML("gemini://example.org/post.gmi", "# A <post>\nSome text.\n=> /next.gmi Next"))
+	expected := `<html><head><title>A &lt;post&gt;</title></head><body><h1>A &lt;post&gt;</h1><p>Some text.</p><p><a href="gemini://example.org/next.gmi">Next</a></p></body></html>`
+
+	if output != expected {
+		t.Errorf(`Wrong output: "%s" != "%s"`, output, expected)
+	}
+
+	if title := scrapTitle(strings.NewReader(output)); title != "A <post>" {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+}
+
+func TestFindNextPageURL(t *testing.T) {
+	scenarios := map[string]string{
+		`<html><head><link rel="next" href="/article?page=2"></head></html>`:          "https://example.org/article?page=2",
+		`<p><a rel="nofollow next" href="https://example.org/article/2">Next</a></p>`: "https://example.org/article/2",
+		`<div class="pagination"><a class="next" href="2">Next</a></div>`:             "https://example.org/2",
+		`<a rel="next" href="https://other.example.com/article/2">Next</a>`:           "",
+		`<a rel="next" href="#comments">Next</a>`:                                     "",
+		`<a rel="next" href="https://example.org/article">Next</a>`:                   "",
+		`<p>Single page</p>`: "",
+	}
+
+	for page, expected := range scenarios {
+		if nextURL := findNextPageURL("https://example.org/article", strings.NewReader(page)); nextURL != expected {
+			t.Errorf(`Unexpected next page for %s, got %q instead of %q`, page, nextURL, expected)
+		}
+	}
+}
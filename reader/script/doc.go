@@ -0,0 +1,20 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package script runs user-defined Starlark scripts on feed entries.
+
+A script must define a function named "process" that receives the entry as a dictionary.
+The function can modify the title, url, comments_url, content and author keys,
+and returns False to drop the entry. Only the administrators can define the script of a feed,
+a script is stopped when it runs for too long.
+
+	def process(entry):
+	    if "sponsored" in entry["title"].lower():
+	        return False
+	    entry["title"] = entry["title"].strip()
+
+*/
+package script // import "miniflux.app/reader/script"
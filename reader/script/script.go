@@ -0,0 +1,232 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package script // import "miniflux.app/reader/script"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+
+	"go.starlark.net/starlark"
+)
+
+const (
+	entryFunction     = "process"
+	scriptTimeout     = 2 * time.Second
+	maxExecutionSteps = 10000000
+)
+
+// Writable entry fields, the other keys of the dictionary are read-only.
+var writableFields = []string{"title", "url", "comments_url", "content", "author"}
+
+var (
+	mutex        sync.RWMutex
+	globalScript *program
+)
+
+type program struct {
+	name    string
+	process starlark.Value
+}
+
+// SetGlobalScript defines the script applied to the entries of all feeds, an empty source removes it.
+func SetGlobalScript(source string) error {
+	var p *program
+	if strings.TrimSpace(source) != "" {
+		var err error
+		if p, err = compile("global", source); err != nil {
+			return err
+		}
+	}
+
+	mutex.Lock()
+	globalScript = p
+	mutex.Unlock()
+	return nil
+}
+
+// Validate returns an error if the script cannot be loaded.
+func Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return nil
+	}
+
+	_, err := compile("feed", source)
+	return err
+}
+
+// Filter applies the global script and the feed script to entries.
+type Filter struct {
+	feed     *model.Feed
+	programs []*program
+}
+
+// NewFilter returns a filter for the entries of a feed, an invalid feed script is ignored.
+func NewFilter(feed *model.Feed) *Filter {
+	f := &Filter{feed: feed}
+
+	mutex.RLock()
+	if globalScript != nil {
+		f.programs = append(f.programs, globalScript)
+	}
+	mutex.RUnlock()
+
+	if strings.TrimSpace(feed.Script) != "" {
+		p, err := compile("feed", feed.Script)
+		if err != nil {
+			logger.Error("[Script] Feed #%d: %v", feed.ID, err)
+		} else {
+			f.programs = append(f.programs, p)
+		}
+	}
+
+	return f
+}
+
+// Apply runs the scripts on the entry and returns false if the entry must be dropped.
+// The entry is left unchanged by a script that fails.
+func (f *Filter) Apply(entry *model.Entry) bool {
+	for _, p := range f.programs {
+		keep, err := p.run(f.feed, entry)
+		if err != nil {
+			logger.Error("[Script] Unable to run the %s script on %q: %v", p.name, entry.URL, err)
+			continue
+		}
+
+		if !keep {
+			logger.Debug("[Script] Entry %q dropped by the %s script", entry.URL, p.name)
+			return false
+		}
+	}
+
+	return true
+}
+
+func compile(name, source string) (*program, error) {
+	var globals starlark.StringDict
+	err := execute(name, func(thread *starlark.Thread) (err error) {
+		globals, err = starlark.ExecFile(thread, name+".star", source, nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the %s script: %v", name, err)
+	}
+
+	process, found := globals[entryFunction]
+	if !found {
+		return nil, fmt.Errorf("the %s script does not define the function %q", name, entryFunction)
+	}
+
+	if _, ok := process.(starlark.Callable); !ok {
+		return nil, fmt.Errorf("%q is not a function in the %s script", entryFunction, name)
+	}
+
+	return &program{name: name, process: process}, nil
+}
+
+func newThread(name string) *starlark.Thread {
+	return &starlark.Thread{
+		Name: name,
+		Print: func(thread *starlark.Thread, msg string) {
+			logger.Debug("[Script:%s] %s", thread.Name, msg)
+		},
+	}
+}
+
+// execute runs the script on a new thread, the thread is cancelled when it runs for too long.
+func execute(name string, fn func(thread *starlark.Thread) error) error {
+	thread := newThread(name)
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	timer := time.AfterFunc(scriptTimeout, func() {
+		thread.Cancel(fmt.Sprintf("the %s script is taking more than %v", name, scriptTimeout))
+	})
+	defer timer.Stop()
+
+	return fn(thread)
+}
+
+func (p *program) run(feed *model.Feed, entry *model.Entry) (bool, error) {
+	dict, err := entryToDict(feed, entry)
+	if err != nil {
+		return true, err
+	}
+
+	var result starlark.Value
+	err = execute(p.name, func(thread *starlark.Thread) (err error) {
+		result, err = starlark.Call(thread, p.process, starlark.Tuple{dict}, nil)
+		return err
+	})
+	if err != nil {
+		return true, err
+	}
+
+	var keep bool
+	switch result {
+	case starlark.None, starlark.True:
+		keep = true
+	case starlark.False:
+		keep = false
+	default:
+		return true, fmt.Errorf("%q must return a boolean or None, got %s", entryFunction, result.Type())
+	}
+
+	values := make(map[string]string, len(writableFields))
+	for _, field := range writableFields {
+		value, found, err := dict.Get(starlark.String(field))
+		if err != nil {
+			return true, err
+		}
+
+		if !found {
+			return true, fmt.Errorf("the key %q has been removed", field)
+		}
+
+		str, ok := starlark.AsString(value)
+		if !ok {
+			return true, fmt.Errorf("the key %q must be a string, got %s", field, value.Type())
+		}
+		values[field] = str
+	}
+
+	if values["url"] == "" {
+		return true, errors.New("the entry URL must not be empty")
+	}
+
+	entry.Title = values["title"]
+	entry.URL = values["url"]
+	entry.CommentsURL = values["comments_url"]
+	entry.Content = values["content"]
+	entry.Author = values["author"]
+
+	return keep, nil
+}
+
+func entryToDict(feed *model.Feed, entry *model.Entry) (*starlark.Dict, error) {
+	fields := map[string]starlark.Value{
+		"title":        starlark.String(entry.Title),
+		"url":          starlark.String(entry.URL),
+		"comments_url": starlark.String(entry.CommentsURL),
+		"content":      starlark.String(entry.Content),
+		"author":       starlark.String(entry.Author),
+		"published_at": starlark.MakeInt64(entry.Date.Unix()),
+		"feed_url":     starlark.String(feed.FeedURL),
+		"feed_title":   starlark.String(feed.Title),
+	}
+
+	dict := starlark.NewDict(len(fields))
+	for key, value := range fields {
+		if err := dict.SetKey(starlark.String(key), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return dict, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package script // import "miniflux.app/reader/script"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func newEntry() *model.Entry {
+	return &model.Entry{Title: "Some Title", URL: "https://example.org/article", Content: "<p>Some content</p>"}
+}
+
+func TestValidate(t *testing.T) {
+	scenarios := map[string]bool{
+		"":                                       true,
+		"def process(entry):\n    return True\n": true,
+		"def transform(entry):\n    pass\n":      false,
+		"process = 1\n":                          false,
+		"def process(entry)\n":                   false,
+	}
+
+	for source, valid := range scenarios {
+		err := Validate(source)
+		if valid && err != nil {
+			t.Errorf(`The script %q should be valid: %v`, source, err)
+		}
+
+		if !valid && err == nil {
+			t.Errorf(`The script %q should not be valid`, source)
+		}
+	}
+}
+
+func TestFilterModifiesEntry(t *testing.T) {
+	feed := &model.Feed{Title: "Feed", Script: `
+def process(entry):
+    entry["title"] = entry["feed_title"] + ": " + entry["title"].upper()
+    entry["content"] = entry["content"].replace("Some", "Other")
+`}
+
+	entry := newEntry()
+	if !NewFilter(feed).Apply(entry) {
+		t.Fatal(`The entry should be kept`)
+	}
+
+	if entry.Title != "Feed: SOME TITLE" {
+		t.Errorf(`Unexpected title, got %q`, entry.Title)
+	}
+
+	if entry.Content != "<p>Other content</p>" {
+		t.Errorf(`Unexpected content, got %q`, entry.Content)
+	}
+}
+
+func TestFilterDropsEntry(t *testing.T) {
+	feed := &model.Feed{Script: `
+def process(entry):
+    return "sponsored" not in entry["title"].lower()
+`}
+
+	entry := newEntry()
+	entry.Title = "Sponsored: buy this"
+	if NewFilter(feed).Apply(entry) {
+		t.Error(`The entry should be dropped`)
+	}
+
+	if !NewFilter(feed).Apply(newEntry()) {
+		t.Error(`The entry should be kept`)
+	}
+}
+
+func TestFilterKeepsEntryOnError(t *testing.T) {
+	scenarios := []string{
+		"def process(entry):\n    entry[\"title\"] = 42\n",
+		"def process(entry):\n    entry[\"title\"] = \"changed\"\n    return 1 / 0\n",
+		"def process(entry):\n    entry[\"title\"] = \"changed\"\n    entry[\"url\"] = \"\"\n",
+		"def process(entry):\n    return \"yes\"\n",
+	}
+
+	for _, source := range scenarios {
+		entry := newEntry()
+		if !NewFilter(&model.Feed{Script: source}).Apply(entry) {
+			t.Errorf(`The entry should be kept when the script %q fails`, source)
+		}
+
+		if entry.Title != "Some Title" {
+			t.Errorf(`The entry should not be modified when the script %q fails, got %q`, source, entry.Title)
+		}
+	}
+}
+
+func TestFilterTimeout(t *testing.T) {
+	feed := &model.Feed{Script: `
+def process(entry):
+    entry["title"] = "changed"
+    for i in range(1000000000):
+        pass
+`}
+
+	entry := newEntry()
+	if !NewFilter(feed).Apply(entry) {
+		t.Error(`The entry should be kept when the script is cancelled`)
+	}
+
+	if entry.Title != "Some Title" {
+		t.Errorf(`The entry should not be modified when the script is cancelled, got %q`, entry.Title)
+	}
+}
+
+func TestValidateStopsLongScript(t *testing.T) {
+	source := `
+count = len([1 for i in range(100000) for j in range(100000) if False])
+
+def process(entry):
+    return True
+`
+
+	start := time.Now()
+	if err := Validate(source); err == nil {
+		t.Error(`The script should be stopped`)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*scriptTimeout {
+		t.Errorf(`The script should be stopped after %v, it ran for %v`, scriptTimeout, elapsed)
+	}
+}
+
+func TestFilterWithGlobalScript(t *testing.T) {
+	if err := SetGlobalScript("def process(entry):\n    entry[\"title\"] = entry[\"title\"] + \"!\"\n"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetGlobalScript("")
+
+	feed := &model.Feed{Script: "def process(entry):\n    entry[\"title\"] = entry[\"title\"] + \"?\"\n"}
+
+	entry := newEntry()
+	NewFilter(feed).Apply(entry)
+
+	if entry.Title != "Some Title!?" {
+		t.Errorf(`The global script should run before the feed script, got %q`, entry.Title)
+	}
+}
+
+func TestSetGlobalScriptWithInvalidScript(t *testing.T) {
+	if err := SetGlobalScript("def process(entry)\n"); err == nil {
+		t.Error(`An invalid global script should be rejected`)
+	}
+}
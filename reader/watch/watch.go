@@ -0,0 +1,141 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watch // import "miniflux.app/reader/watch"
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"miniflux.app/errors"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxLines limits the size of the compared regions, the diff is quadratic.
+const maxLines = 2000
+
+// Page is the watched region of a web page.
+type Page struct {
+	Title string
+	Lines []string
+}
+
+// Content returns the text of the region, one line per line of text.
+func (p *Page) Content() string {
+	return strings.Join(p.Lines, "\n")
+}
+
+// Extract returns the non-empty lines of text of the elements matching the CSS selector.
+func Extract(body, selector string) (*Page, *errors.LocalizedError) {
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse web page: %q", err)
+	}
+
+	selection := document.Find(selector)
+	if selection.Length() == 0 {
+		return nil, errors.NewLocalizedError("The selector %q does not match any element of this web page", selector)
+	}
+
+	page := &Page{Title: strings.TrimSpace(document.Find("head title").First().Text())}
+	selection.Each(func(i int, s *goquery.Selection) {
+		page.Lines = append(page.Lines, Lines(s.Text())...)
+	})
+
+	if len(page.Lines) > maxLines {
+		page.Lines = page.Lines[:maxLines]
+	}
+
+	return page, nil
+}
+
+// Lines splits a text into trimmed non-empty lines.
+func Lines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// Change operations.
+const (
+	Added   = '+'
+	Removed = '-'
+)
+
+// Change is a line added or removed between two versions of a region.
+type Change struct {
+	Op   rune
+	Text string
+}
+
+// Diff returns the lines removed from before and added in after, in the order of the documents.
+// Unchanged lines are omitted.
+func Diff(before, after []string) []Change {
+	// lcs[i][j] is the length of the longest common subsequence of before[i:] and after[j:].
+	lcs := make([][]int, len(before)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(after)+1)
+	}
+
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var changes []Change
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] == after[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			changes = append(changes, Change{Removed, before[i]})
+			i++
+		default:
+			changes = append(changes, Change{Added, after[j]})
+			j++
+		}
+	}
+
+	for ; i < len(before); i++ {
+		changes = append(changes, Change{Removed, before[i]})
+	}
+
+	for ; j < len(after); j++ {
+		changes = append(changes, Change{Added, after[j]})
+	}
+
+	return changes
+}
+
+// Render formats changes as HTML, added lines in <ins> and removed lines in <del>.
+func Render(changes []Change) string {
+	var b bytes.Buffer
+	for _, change := range changes {
+		tag := "ins"
+		if change.Op == Removed {
+			tag = "del"
+		}
+
+		fmt.Fprintf(&b, "<p><%s>%s</%s></p>", tag, html.EscapeString(change.Text), tag)
+	}
+
+	return b.String()
+}
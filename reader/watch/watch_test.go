@@ -0,0 +1,87 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watch // import "miniflux.app/reader/watch"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	body := `<html><head><title> Prices </title></head><body>
+		<div id="menu">Home</div>
+		<div id="prices">
+			<p>Apples:   1€</p>
+
+			<p>Pears: 2€</p>
+		</div>
+	</body></html>`
+
+	page, err := Extract(body, "#prices")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if page.Title != "Prices" {
+		t.Errorf(`Unexpected title, got %q`, page.Title)
+	}
+
+	expected := "Apples: 1€\nPears: 2€"
+	if page.Content() != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, page.Content(), expected)
+	}
+}
+
+func TestExtractWithoutMatchingElement(t *testing.T) {
+	if _, err := Extract(`<html><body><p>Test</p></body></html>`, "#missing"); err == nil {
+		t.Fatal(`A selector without matching element should return an error`)
+	}
+}
+
+func TestLines(t *testing.T) {
+	lines := Lines("  first \t line \n\n\nsecond\n  ")
+	expected := []string{"first line", "second"}
+
+	if !reflect.DeepEqual(lines, expected) {
+		t.Fatalf(`Unexpected lines, got %q instead of %q`, lines, expected)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := []string{"a", "b", "c", "d"}
+	after := []string{"a", "c", "e", "d", "f"}
+
+	changes := Diff(before, after)
+	expected := []Change{{Removed, "b"}, {Added, "e"}, {Added, "f"}}
+
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf(`Unexpected changes, got %v instead of %v`, changes, expected)
+	}
+}
+
+func TestDiffWithoutChange(t *testing.T) {
+	lines := []string{"a", "b"}
+	if changes := Diff(lines, lines); len(changes) != 0 {
+		t.Fatalf(`Identical regions should not have changes, got %v`, changes)
+	}
+}
+
+func TestDiffWithEmptyRegion(t *testing.T) {
+	changes := Diff(nil, []string{"a"})
+	expected := []Change{{Added, "a"}}
+
+	if !reflect.DeepEqual(changes, expected) {
+		t.Fatalf(`Unexpected changes, got %v instead of %v`, changes, expected)
+	}
+}
+
+func TestRender(t *testing.T) {
+	output := Render([]Change{{Removed, "old <price>"}, {Added, "new"}})
+	expected := `<p><del>old &lt;price&gt;</del></p><p><ins>new</ins></p>`
+
+	if output != expected {
+		t.Fatalf(`Unexpected HTML, got %q instead of %q`, output, expected)
+	}
+}
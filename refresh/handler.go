@@ -0,0 +1,176 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package refresh // import "miniflux.app/refresh"
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/alert"
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/feed"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the refresh routes.
+func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, feedHandler *feed.Handler) {
+	h := &handler{cfg, store, feedHandler}
+
+	sr := router.PathPrefix("/refresh").Subrouter()
+	sr.Handle("/feeds", h.withSecret(h.refreshBatch)).Methods("POST").Name("refreshBatch")
+	sr.Handle("/tasks", h.withSecret(h.listTasks)).Methods("GET").Name("refreshTasks")
+	sr.HandleFunc("/users/{userID}/feeds/{feedID}", h.refreshFeed).Methods("POST").Name("refreshSignedFeed")
+}
+
+type handler struct {
+	cfg         *config.Config
+	store       *storage.Storage
+	feedHandler *feed.Handler
+}
+
+type batchResponse struct {
+	Refreshed int `json:"refreshed"`
+	Failed    int `json:"failed"`
+}
+
+type task struct {
+	UserID int64  `json:"user_id"`
+	FeedID int64  `json:"feed_id"`
+	URL    string `json:"url"`
+}
+
+type tasksResponse struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Tasks     []task    `json:"tasks"`
+}
+
+func (h *handler) withSecret(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.cfg.PushRefreshSecret())) != 1 {
+			logger.Error("[Refresh] Invalid secret received from %s", request.ClientIP(r))
+			json.Unauthorized(w, r)
+			return
+		}
+
+		next(w, r)
+	})
+}
+
+// refreshBatch refreshes the next batch of feeds before answering, the instance
+// may be stopped as soon as the response is sent when it scales to zero.
+func (h *handler) refreshBatch(w http.ResponseWriter, r *http.Request) {
+	jobs, ok := h.nextBatch(w, r)
+	if !ok {
+		return
+	}
+
+	monitor := h.store.AlertMonitor()
+	monitor.EndRefreshCycle()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var response batchResponse
+	queue := make(chan model.Job)
+
+	for i := 0; i < h.cfg.WorkerPoolSize(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				err := h.feedHandler.RefreshFeed(r.Context(), job.UserID, job.FeedID)
+				monitor.FeedRefreshed(err)
+
+				mu.Lock()
+				if err != nil {
+					logger.Error("[Refresh] %v", err)
+					response.Failed++
+				} else {
+					response.Refreshed++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, job := range jobs {
+		queue <- job
+	}
+	close(queue)
+	wg.Wait()
+
+	logger.Debug("[Refresh] Refreshed %d feeds, %d failures", response.Refreshed, response.Failed)
+	json.OK(w, r, response)
+}
+
+// listTasks returns the signed refresh URLs of the next batch of feeds, each URL
+// can be sent to a Cloud Tasks queue.
+func (h *handler) listTasks(w http.ResponseWriter, r *http.Request) {
+	jobs, ok := h.nextBatch(w, r)
+	if !ok {
+		return
+	}
+
+	expires := time.Now().Add(time.Duration(h.cfg.PushRefreshTTL()) * time.Minute).Truncate(time.Second)
+	response := tasksResponse{ExpiresAt: expires, Tasks: make([]task, 0, len(jobs))}
+	for _, job := range jobs {
+		response.Tasks = append(response.Tasks, task{
+			UserID: job.UserID,
+			FeedID: job.FeedID,
+			URL:    SignedURL(h.cfg.BaseURL(), h.cfg.PushRefreshSecret(), job.UserID, job.FeedID, expires),
+		})
+	}
+
+	json.OK(w, r, response)
+}
+
+func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.RouteInt64Param(r, "userID")
+	feedID := request.RouteInt64Param(r, "feedID")
+	expires := time.Unix(request.QueryInt64Param(r, "expires", 0), 0)
+	signature := request.QueryStringParam(r, "signature", "")
+
+	if !Verify(h.cfg.PushRefreshSecret(), userID, feedID, expires, signature) {
+		logger.Error("[Refresh] Invalid or expired signature for feed #%d received from %s", feedID, request.ClientIP(r))
+		json.Forbidden(w, r)
+		return
+	}
+
+	err := h.feedHandler.RefreshFeed(r.Context(), userID, feedID)
+	h.store.AlertMonitor().FeedRefreshed(err)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) nextBatch(w http.ResponseWriter, r *http.Request) (model.JobList, bool) {
+	batchSize := h.cfg.BatchSize()
+	if value := request.QueryIntParam(r, "batch_size", 0); value > 0 {
+		batchSize = value
+	}
+
+	monitor := h.store.AlertMonitor()
+	jobs, err := h.store.NewBatch(r.Context(), batchSize)
+	if err != nil {
+		monitor.Failure(alert.ComponentDatabase, err)
+		json.ServerError(w, r, err)
+		return nil, false
+	}
+
+	monitor.Success(alert.ComponentDatabase)
+	return jobs, true
+}
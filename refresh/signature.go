@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package refresh // import "miniflux.app/refresh"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Sign returns the signature of the refresh request of a feed valid until the given time.
+func Sign(secret string, userID, feedID int64, expires time.Time) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d:%d:%d", userID, feedID, expires.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns true if the signature is valid and not expired.
+func Verify(secret string, userID, feedID int64, expires time.Time, signature string) bool {
+	if time.Now().After(expires) {
+		return false
+	}
+
+	expected := Sign(secret, userID, feedID, expires)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// SignedURL returns the URL refreshing a feed without other credentials until the given time.
+func SignedURL(baseURL, secret string, userID, feedID int64, expires time.Time) string {
+	return fmt.Sprintf("%s/refresh/users/%d/feeds/%d?expires=%d&signature=%s",
+		baseURL,
+		userID,
+		feedID,
+		expires.Unix(),
+		Sign(secret, userID, feedID, expires),
+	)
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package refresh // import "miniflux.app/refresh"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	signature := Sign("secret", 1, 2, expires)
+
+	if !Verify("secret", 1, 2, expires, signature) {
+		t.Error(`The signature should be valid`)
+	}
+
+	if Verify("other", 1, 2, expires, signature) {
+		t.Error(`The signature should be invalid with another secret`)
+	}
+
+	if Verify("secret", 1, 3, expires, signature) {
+		t.Error(`The signature should be invalid for another feed`)
+	}
+
+	if Verify("secret", 2, 2, expires, signature) {
+		t.Error(`The signature should be invalid for another user`)
+	}
+
+	if Verify("secret", 1, 2, expires.Add(time.Second), signature) {
+		t.Error(`The signature should be invalid with another expiration time`)
+	}
+}
+
+func TestVerifyExpiredSignature(t *testing.T) {
+	expires := time.Now().Add(-time.Minute)
+	signature := Sign("secret", 1, 2, expires)
+
+	if Verify("secret", 1, 2, expires, signature) {
+		t.Error(`An expired signature should be invalid`)
+	}
+}
+
+func TestSignedURL(t *testing.T) {
+	expires := time.Unix(1550000000, 0)
+	signature := Sign("secret", 1, 2, expires)
+
+	expected := "https://example.org/miniflux/refresh/users/1/feeds/2?expires=1550000000&signature=" + signature
+	result := SignedURL("https://example.org/miniflux", "secret", 1, 2, expires)
+
+	if result != expected {
+		t.Errorf(`Unexpected signed URL, got %q instead of %q`, result, expected)
+	}
+}
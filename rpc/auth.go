@@ -0,0 +1,97 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type authInterceptor struct {
+	store *storage.Storage
+}
+
+func newAuthInterceptor(store *storage.Storage) *authInterceptor {
+	return &authInterceptor{store}
+}
+
+// intercept authenticates each call with the same credentials as the REST API.
+func (a *authInterceptor) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	username, password, ok := basicAuth(ctx)
+	if !ok {
+		logger.Debug("[gRPC] No authentication metadata sent")
+		return nil, status.Error(codes.Unauthenticated, "Access Unauthorized")
+	}
+
+	if err := a.store.CheckPassword(ctx, username, password); err != nil {
+		logger.Error("[gRPC] Invalid username or password: %s", username)
+		return nil, status.Error(codes.Unauthenticated, "Access Unauthorized")
+	}
+
+	user, err := a.store.UserByUsername(ctx, username)
+	if err != nil {
+		logger.Error("[gRPC] %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if user == nil {
+		logger.Error("[gRPC] User not found: %s", username)
+		return nil, status.Error(codes.Unauthenticated, "Access Unauthorized")
+	}
+
+	if now := time.Now(); user.IsFrozen(now) && !readMethods[info.FullMethod] {
+		return nil, status.Error(codes.Unavailable, user.FreezeError().Error())
+	}
+
+	logger.Debug("[gRPC] User %s called %s", username, info.FullMethod)
+	a.store.SetLastLogin(ctx, user.ID)
+
+	ctx = context.WithValue(ctx, request.UserIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, request.UserTimezoneContextKey, user.Timezone)
+	ctx = context.WithValue(ctx, request.IsAdminUserContextKey, user.IsAdmin)
+	ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
+
+	return handler(ctx, req)
+}
+
+func basicAuth(ctx context.Context) (username, password string, ok bool) {
+	md, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return "", "", false
+	}
+
+	values := md["authorization"]
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Basic ") {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(values[0], "Basic "))
+	if err != nil {
+		return "", "", false
+	}
+
+	credentials := strings.SplitN(string(decoded), ":", 2)
+	if len(credentials) != 2 {
+		return "", "", false
+	}
+
+	return credentials[0], credentials[1], true
+}
+
+func userID(ctx context.Context) int64 {
+	value, _ := ctx.Value(request.UserIDContextKey).(int64)
+	return value
+}
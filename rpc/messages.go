@@ -0,0 +1,841 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import "fmt"
+
+// Messages implement the proto.Message, proto.Marshaler and proto.Unmarshaler
+// interfaces so the default gRPC codec can use them directly.
+
+// Empty is an empty message.
+type Empty struct{}
+
+// Reset clears the message.
+func (m *Empty) Reset() { *m = Empty{} }
+
+// String returns a text representation of the message.
+func (m *Empty) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*Empty) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *Empty) Marshal() ([]byte, error) {
+	e := &encoder{}
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *Empty) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		_, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		err = d.skip(wireType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Entry represents a feed entry.
+type Entry struct {
+	ID          int64
+	FeedID      int64
+	Status      string
+	Hash        string
+	Title       string
+	URL         string
+	CommentsURL string
+	Author      string
+	Content     string
+	PublishedAt int64
+	ChangedAt   int64
+	Starred     bool
+	Score       float64
+}
+
+// Reset clears the message.
+func (m *Entry) Reset() { *m = Entry{} }
+
+// String returns a text representation of the message.
+func (m *Entry) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*Entry) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *Entry) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.ID)
+	e.int64(2, m.FeedID)
+	e.string(3, m.Status)
+	e.string(4, m.Hash)
+	e.string(5, m.Title)
+	e.string(6, m.URL)
+	e.string(7, m.CommentsURL)
+	e.string(8, m.Author)
+	e.string(9, m.Content)
+	e.int64(10, m.PublishedAt)
+	e.int64(11, m.ChangedAt)
+	e.bool(12, m.Starred)
+	e.double(13, m.Score)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *Entry) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.ID, err = d.int64(wireType)
+		case 2:
+			m.FeedID, err = d.int64(wireType)
+		case 3:
+			m.Status, err = d.string(wireType)
+		case 4:
+			m.Hash, err = d.string(wireType)
+		case 5:
+			m.Title, err = d.string(wireType)
+		case 6:
+			m.URL, err = d.string(wireType)
+		case 7:
+			m.CommentsURL, err = d.string(wireType)
+		case 8:
+			m.Author, err = d.string(wireType)
+		case 9:
+			m.Content, err = d.string(wireType)
+		case 10:
+			m.PublishedAt, err = d.int64(wireType)
+		case 11:
+			m.ChangedAt, err = d.int64(wireType)
+		case 12:
+			m.Starred, err = d.bool(wireType)
+		case 13:
+			m.Score, err = d.double(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Feed represents a subscription.
+type Feed struct {
+	ID                int64
+	CategoryID        int64
+	CategoryTitle     string
+	FeedURL           string
+	SiteURL           string
+	Title             string
+	CheckedAt         int64
+	ParsingErrorCount int32
+	ParsingErrorMsg   string
+	Crawler           bool
+}
+
+// Reset clears the message.
+func (m *Feed) Reset() { *m = Feed{} }
+
+// String returns a text representation of the message.
+func (m *Feed) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*Feed) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *Feed) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.ID)
+	e.int64(2, m.CategoryID)
+	e.string(3, m.CategoryTitle)
+	e.string(4, m.FeedURL)
+	e.string(5, m.SiteURL)
+	e.string(6, m.Title)
+	e.int64(7, m.CheckedAt)
+	e.int32(8, m.ParsingErrorCount)
+	e.string(9, m.ParsingErrorMsg)
+	e.bool(10, m.Crawler)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *Feed) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.ID, err = d.int64(wireType)
+		case 2:
+			m.CategoryID, err = d.int64(wireType)
+		case 3:
+			m.CategoryTitle, err = d.string(wireType)
+		case 4:
+			m.FeedURL, err = d.string(wireType)
+		case 5:
+			m.SiteURL, err = d.string(wireType)
+		case 6:
+			m.Title, err = d.string(wireType)
+		case 7:
+			m.CheckedAt, err = d.int64(wireType)
+		case 8:
+			m.ParsingErrorCount, err = d.int32(wireType)
+		case 9:
+			m.ParsingErrorMsg, err = d.string(wireType)
+		case 10:
+			m.Crawler, err = d.bool(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListEntriesRequest contains the filters used to list entries.
+type ListEntriesRequest struct {
+	Statuses        []string
+	FeedID          int64
+	CategoryID      int64
+	Starred         bool
+	Search          string
+	Order           string
+	Direction       string
+	Limit           int32
+	Offset          int32
+	PublishedAfter  int64
+	PublishedBefore int64
+}
+
+// Reset clears the message.
+func (m *ListEntriesRequest) Reset() { *m = ListEntriesRequest{} }
+
+// String returns a text representation of the message.
+func (m *ListEntriesRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*ListEntriesRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *ListEntriesRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.strings(1, m.Statuses)
+	e.int64(2, m.FeedID)
+	e.int64(3, m.CategoryID)
+	e.bool(4, m.Starred)
+	e.string(5, m.Search)
+	e.string(6, m.Order)
+	e.string(7, m.Direction)
+	e.int32(8, m.Limit)
+	e.int32(9, m.Offset)
+	e.int64(10, m.PublishedAfter)
+	e.int64(11, m.PublishedBefore)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *ListEntriesRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			var item string
+			item, err = d.string(wireType)
+			m.Statuses = append(m.Statuses, item)
+		case 2:
+			m.FeedID, err = d.int64(wireType)
+		case 3:
+			m.CategoryID, err = d.int64(wireType)
+		case 4:
+			m.Starred, err = d.bool(wireType)
+		case 5:
+			m.Search, err = d.string(wireType)
+		case 6:
+			m.Order, err = d.string(wireType)
+		case 7:
+			m.Direction, err = d.string(wireType)
+		case 8:
+			m.Limit, err = d.int32(wireType)
+		case 9:
+			m.Offset, err = d.int32(wireType)
+		case 10:
+			m.PublishedAfter, err = d.int64(wireType)
+		case 11:
+			m.PublishedBefore, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListEntriesResponse contains a page of entries.
+type ListEntriesResponse struct {
+	Total   int32
+	Entries []*Entry
+}
+
+// Reset clears the message.
+func (m *ListEntriesResponse) Reset() { *m = ListEntriesResponse{} }
+
+// String returns a text representation of the message.
+func (m *ListEntriesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*ListEntriesResponse) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *ListEntriesResponse) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int32(1, m.Total)
+	for _, item := range m.Entries {
+		if err := e.message(2, item); err != nil {
+			return nil, err
+		}
+	}
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *ListEntriesResponse) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.Total, err = d.int32(wireType)
+		case 2:
+			var data []byte
+			if data, err = d.bytes(wireType); err == nil {
+				item := &Entry{}
+				err = item.Unmarshal(data)
+				m.Entries = append(m.Entries, item)
+			}
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncEntriesRequest contains the timestamp of the last synchronization.
+type SyncEntriesRequest struct {
+	ChangedAfter int64
+}
+
+// Reset clears the message.
+func (m *SyncEntriesRequest) Reset() { *m = SyncEntriesRequest{} }
+
+// String returns a text representation of the message.
+func (m *SyncEntriesRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*SyncEntriesRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *SyncEntriesRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.ChangedAfter)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *SyncEntriesRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.ChangedAfter, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncEntriesResponse contains the entries changed since the last synchronization.
+type SyncEntriesResponse struct {
+	Entries   []*Entry
+	Timestamp int64
+}
+
+// Reset clears the message.
+func (m *SyncEntriesResponse) Reset() { *m = SyncEntriesResponse{} }
+
+// String returns a text representation of the message.
+func (m *SyncEntriesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*SyncEntriesResponse) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *SyncEntriesResponse) Marshal() ([]byte, error) {
+	e := &encoder{}
+	for _, item := range m.Entries {
+		if err := e.message(1, item); err != nil {
+			return nil, err
+		}
+	}
+	e.int64(2, m.Timestamp)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *SyncEntriesResponse) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			var data []byte
+			if data, err = d.bytes(wireType); err == nil {
+				item := &Entry{}
+				err = item.Unmarshal(data)
+				m.Entries = append(m.Entries, item)
+			}
+		case 2:
+			m.Timestamp, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateEntriesStatusRequest contains the entries to update.
+type UpdateEntriesStatusRequest struct {
+	EntryIDs []int64
+	Status   string
+}
+
+// Reset clears the message.
+func (m *UpdateEntriesStatusRequest) Reset() { *m = UpdateEntriesStatusRequest{} }
+
+// String returns a text representation of the message.
+func (m *UpdateEntriesStatusRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*UpdateEntriesStatusRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *UpdateEntriesStatusRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.packedInt64s(1, m.EntryIDs)
+	e.string(2, m.Status)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *UpdateEntriesStatusRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.EntryIDs, err = d.int64s(wireType, m.EntryIDs)
+		case 2:
+			m.Status, err = d.string(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToggleBookmarkRequest contains the entry to star or unstar.
+type ToggleBookmarkRequest struct {
+	EntryID int64
+}
+
+// Reset clears the message.
+func (m *ToggleBookmarkRequest) Reset() { *m = ToggleBookmarkRequest{} }
+
+// String returns a text representation of the message.
+func (m *ToggleBookmarkRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*ToggleBookmarkRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *ToggleBookmarkRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.EntryID)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *ToggleBookmarkRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.EntryID, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFeedsRequest is the request to list feeds.
+type ListFeedsRequest struct{}
+
+// Reset clears the message.
+func (m *ListFeedsRequest) Reset() { *m = ListFeedsRequest{} }
+
+// String returns a text representation of the message.
+func (m *ListFeedsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*ListFeedsRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *ListFeedsRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *ListFeedsRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		_, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		err = d.skip(wireType)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFeedsResponse contains the list of feeds.
+type ListFeedsResponse struct {
+	Feeds []*Feed
+}
+
+// Reset clears the message.
+func (m *ListFeedsResponse) Reset() { *m = ListFeedsResponse{} }
+
+// String returns a text representation of the message.
+func (m *ListFeedsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*ListFeedsResponse) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *ListFeedsResponse) Marshal() ([]byte, error) {
+	e := &encoder{}
+	for _, item := range m.Feeds {
+		if err := e.message(1, item); err != nil {
+			return nil, err
+		}
+	}
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *ListFeedsResponse) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			var data []byte
+			if data, err = d.bytes(wireType); err == nil {
+				item := &Feed{}
+				err = item.Unmarshal(data)
+				m.Feeds = append(m.Feeds, item)
+			}
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateFeedRequest contains the feed to subscribe to.
+type CreateFeedRequest struct {
+	FeedURL    string
+	CategoryID int64
+	Crawler    bool
+	UserAgent  string
+	Username   string
+	Password   string
+}
+
+// Reset clears the message.
+func (m *CreateFeedRequest) Reset() { *m = CreateFeedRequest{} }
+
+// String returns a text representation of the message.
+func (m *CreateFeedRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*CreateFeedRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *CreateFeedRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.string(1, m.FeedURL)
+	e.int64(2, m.CategoryID)
+	e.bool(3, m.Crawler)
+	e.string(4, m.UserAgent)
+	e.string(5, m.Username)
+	e.string(6, m.Password)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *CreateFeedRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.FeedURL, err = d.string(wireType)
+		case 2:
+			m.CategoryID, err = d.int64(wireType)
+		case 3:
+			m.Crawler, err = d.bool(wireType)
+		case 4:
+			m.UserAgent, err = d.string(wireType)
+		case 5:
+			m.Username, err = d.string(wireType)
+		case 6:
+			m.Password, err = d.string(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateFeedResponse contains the ID of the new feed, pending feeds wait for the approval of an administrator.
+type CreateFeedResponse struct {
+	FeedID  int64
+	Pending bool
+}
+
+// Reset clears the message.
+func (m *CreateFeedResponse) Reset() { *m = CreateFeedResponse{} }
+
+// String returns a text representation of the message.
+func (m *CreateFeedResponse) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*CreateFeedResponse) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *CreateFeedResponse) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.FeedID)
+	e.bool(2, m.Pending)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *CreateFeedResponse) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.FeedID, err = d.int64(wireType)
+		case 2:
+			m.Pending, err = d.bool(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveFeedRequest contains the feed to remove.
+type RemoveFeedRequest struct {
+	FeedID int64
+}
+
+// Reset clears the message.
+func (m *RemoveFeedRequest) Reset() { *m = RemoveFeedRequest{} }
+
+// String returns a text representation of the message.
+func (m *RemoveFeedRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*RemoveFeedRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *RemoveFeedRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.FeedID)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *RemoveFeedRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.FeedID, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshFeedRequest contains the feed to refresh.
+type RefreshFeedRequest struct {
+	FeedID int64
+}
+
+// Reset clears the message.
+func (m *RefreshFeedRequest) Reset() { *m = RefreshFeedRequest{} }
+
+// String returns a text representation of the message.
+func (m *RefreshFeedRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage marks the type as a Protocol Buffers message.
+func (*RefreshFeedRequest) ProtoMessage() {}
+
+// Marshal encodes the message to the Protocol Buffers wire format.
+func (m *RefreshFeedRequest) Marshal() ([]byte, error) {
+	e := &encoder{}
+	e.int64(1, m.FeedID)
+	return e.buf, nil
+}
+
+// Unmarshal decodes the message from the Protocol Buffers wire format.
+func (m *RefreshFeedRequest) Unmarshal(data []byte) error {
+	d := &decoder{buf: data}
+	for !d.done() {
+		field, wireType, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch field {
+		case 1:
+			m.FeedID, err = d.int64(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalKnownEncoding(t *testing.T) {
+	data, err := (&ToggleBookmarkRequest{EntryID: 150}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{0x08, 0x96, 0x01}
+	if !bytes.Equal(data, expected) {
+		t.Errorf(`Unexpected encoding, got %x instead of %x`, data, expected)
+	}
+
+	data, err = (&UpdateEntriesStatusRequest{EntryIDs: []int64{3, 270}, Status: "read"}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []byte{0x0a, 0x03, 0x03, 0x8e, 0x02, 0x12, 0x04, 'r', 'e', 'a', 'd'}
+	if !bytes.Equal(data, expected) {
+		t.Errorf(`Unexpected encoding, got %x instead of %x`, data, expected)
+	}
+}
+
+func TestMarshalDefaultValues(t *testing.T) {
+	data, err := (&Entry{}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(data) != 0 {
+		t.Errorf(`Default values should not be encoded, got %x`, data)
+	}
+}
+
+func TestEntriesRoundTrip(t *testing.T) {
+	original := &ListEntriesResponse{
+		Total: 2,
+		Entries: []*Entry{
+			{ID: 1, FeedID: 2, Status: "unread", Title: "Entry 1", URL: "https://example.org/1", PublishedAt: 1546300800, ChangedAt: -1, Starred: true, Score: 0.75},
+			{ID: 2, Title: "Entry 2 — ünïcode"},
+		},
+	}
+
+	data, err := original.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &ListEntriesResponse{}
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(original, decoded) {
+		t.Errorf(`Unexpected decoded message, got %v instead of %v`, decoded, original)
+	}
+}
+
+func TestListEntriesRequestRoundTrip(t *testing.T) {
+	original := &ListEntriesRequest{
+		Statuses:  []string{"unread", "read"},
+		FeedID:    42,
+		Starred:   true,
+		Order:     "published_at",
+		Limit:     10,
+		Offset:    20,
+		Direction: "desc",
+	}
+
+	data, err := original.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &ListEntriesRequest{}
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(original, decoded) {
+		t.Errorf(`Unexpected decoded message, got %v instead of %v`, decoded, original)
+	}
+}
+
+func TestUnmarshalUnpackedRepeatedField(t *testing.T) {
+	data := []byte{0x08, 0x03, 0x08, 0x8e, 0x02}
+
+	decoded := &UpdateEntriesStatusRequest{}
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded.EntryIDs, []int64{3, 270}) {
+		t.Errorf(`Unexpected entry IDs: %v`, decoded.EntryIDs)
+	}
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	// Field 15 (varint), field 16 (bytes), field 17 (fixed32) and field 18 (fixed64) are unknown.
+	data := []byte{
+		0x78, 0x01,
+		0x82, 0x01, 0x02, 'o', 'k',
+		0x8d, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0x91, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x08, 0x2a,
+	}
+
+	decoded := &RemoveFeedRequest{}
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.FeedID != 42 {
+		t.Errorf(`Unexpected feed ID, got %d instead of 42`, decoded.FeedID)
+	}
+}
+
+func TestUnmarshalInvalidData(t *testing.T) {
+	inputs := [][]byte{
+		{0x08},
+		{0x0a, 0x05, 'a'},
+		{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+		{0x0a, 0x01, 0x01},
+		{0x00, 0x01},
+	}
+
+	for _, input := range inputs {
+		if err := (&RefreshFeedRequest{}).Unmarshal(input); err == nil {
+			t.Errorf(`Decoding %x should return an error`, input)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"context"
+
+	"miniflux.app/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readMethods do not change anything, they are allowed on read-only instances and for frozen users.
+var readMethods = map[string]bool{
+	"/" + serviceName + "/ListEntries": true,
+	"/" + serviceName + "/SyncEntries": true,
+	"/" + serviceName + "/ListFeeds":   true,
+}
+
+// rejectMutations wraps an interceptor to reject the calls changing data on read-only instances.
+func rejectMutations(next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !readMethods[info.FullMethod] {
+			return nil, status.Error(codes.PermissionDenied, config.ErrReadOnly.Error())
+		}
+
+		return next(ctx, req, info, handler)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"context"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/feed"
+	"miniflux.app/storage"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewServer returns a gRPC server exposing the Miniflux service, the calls changing data are rejected when readOnly is true.
+func NewServer(store *storage.Storage, feedHandler *feed.Handler, readOnly bool) *grpc.Server {
+	intercept := newAuthInterceptor(store).intercept
+	if readOnly {
+		intercept = rejectMutations(intercept)
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(intercept))
+	RegisterMinifluxServer(s, &server{store, feedHandler})
+	return s
+}
+
+type server struct {
+	store       *storage.Storage
+	feedHandler *feed.Handler
+}
+
+func (s *server) ListEntries(ctx context.Context, req *ListEntriesRequest) (*ListEntriesResponse, error) {
+	for _, entryStatus := range req.Statuses {
+		if err := model.ValidateEntryStatus(entryStatus); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	order := req.Order
+	if order == "" {
+		order = model.DefaultSortingOrder
+	}
+
+	if err := model.ValidateEntryOrder(order); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	direction := req.Direction
+	if direction == "" {
+		direction = model.DefaultSortingDirection
+	}
+
+	if err := model.ValidateDirection(direction); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	if err := model.ValidateRange(int(req.Offset), limit); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	builder := s.store.NewEntryQueryBuilder(userID(ctx))
+	builder.WithStatuses(req.Statuses)
+	builder.WithFeedID(req.FeedID)
+	builder.WithCategoryID(req.CategoryID)
+	builder.WithSearchQuery(req.Search)
+
+	if req.Starred {
+		builder.WithStarred()
+	}
+
+	if req.PublishedAfter != 0 {
+		builder.AfterDate(time.Unix(req.PublishedAfter, 0))
+	}
+
+	if req.PublishedBefore != 0 {
+		builder.BeforeDate(time.Unix(req.PublishedBefore, 0))
+	}
+
+	count, err := builder.CountEntries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithOffset(int(req.Offset))
+	builder.WithLimit(limit)
+
+	entries, err := builder.GetEntries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &ListEntriesResponse{Total: int32(count), Entries: newEntries(entries)}, nil
+}
+
+func (s *server) SyncEntries(ctx context.Context, req *SyncEntriesRequest) (*SyncEntriesResponse, error) {
+	// The timestamp is taken before the query to never miss a change made during the synchronization.
+	timestamp := time.Now().Unix()
+
+	builder := s.store.NewEntryQueryBuilder(userID(ctx))
+	if req.ChangedAfter != 0 {
+		builder.ChangedAfter(time.Unix(req.ChangedAfter, 0))
+	}
+	builder.WithOrder("id")
+	builder.WithDirection("asc")
+
+	entries, err := builder.GetEntries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SyncEntriesResponse{Entries: newEntries(entries), Timestamp: timestamp}, nil
+}
+
+func (s *server) UpdateEntriesStatus(ctx context.Context, req *UpdateEntriesStatusRequest) (*Empty, error) {
+	if err := model.ValidateEntryStatus(req.Status); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.store.SetEntriesStatus(ctx, userID(ctx), req.EntryIDs, req.Status); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *server) ToggleBookmark(ctx context.Context, req *ToggleBookmarkRequest) (*Empty, error) {
+	if err := s.store.ToggleBookmark(ctx, userID(ctx), req.EntryID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *server) ListFeeds(ctx context.Context, req *ListFeedsRequest) (*ListFeedsResponse, error) {
+	feeds, err := s.store.Feeds(ctx, userID(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &ListFeedsResponse{}
+	for _, f := range feeds {
+		response.Feeds = append(response.Feeds, newFeed(f))
+	}
+
+	return response, nil
+}
+
+func (s *server) CreateFeed(ctx context.Context, req *CreateFeedRequest) (*CreateFeedResponse, error) {
+	if req.FeedURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "The feed_url is required")
+	}
+
+	if req.CategoryID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "The category_id is required")
+	}
+
+	if s.store.FeedURLExists(ctx, userID(ctx), req.FeedURL) {
+		return nil, status.Error(codes.AlreadyExists, "This feed_url already exists")
+	}
+
+	if !s.store.CategoryExists(ctx, userID(ctx), req.CategoryID) {
+		return nil, status.Error(codes.InvalidArgument, "This category_id doesn't exists or doesn't belongs to this user")
+	}
+
+	f, err := s.feedHandler.CreateFeed(ctx, userID(ctx), req.CategoryID, req.FeedURL, req.Crawler, req.UserAgent, req.Username, req.Password)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &CreateFeedResponse{FeedID: f.ID, Pending: f.Pending}, nil
+}
+
+func (s *server) RemoveFeed(ctx context.Context, req *RemoveFeedRequest) (*Empty, error) {
+	if !s.store.FeedExists(ctx, userID(ctx), req.FeedID) {
+		return nil, status.Error(codes.NotFound, "Feed not found")
+	}
+
+	if err := s.store.RemoveFeed(ctx, userID(ctx), req.FeedID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &Empty{}, nil
+}
+
+func (s *server) RefreshFeed(ctx context.Context, req *RefreshFeedRequest) (*Empty, error) {
+	if !s.store.FeedExists(ctx, userID(ctx), req.FeedID) {
+		return nil, status.Error(codes.NotFound, "Feed not found")
+	}
+
+	if err := s.feedHandler.RefreshFeed(ctx, userID(ctx), req.FeedID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &Empty{}, nil
+}
+
+func newEntries(entries model.Entries) []*Entry {
+	results := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, &Entry{
+			ID:          entry.ID,
+			FeedID:      entry.FeedID,
+			Status:      entry.Status,
+			Hash:        entry.Hash,
+			Title:       entry.Title,
+			URL:         entry.URL,
+			CommentsURL: entry.CommentsURL,
+			Author:      entry.Author,
+			Content:     entry.Content,
+			PublishedAt: entry.Date.Unix(),
+			ChangedAt:   entry.ChangedAt.Unix(),
+			Starred:     entry.Starred,
+			Score:       entry.Score,
+		})
+	}
+	return results
+}
+
+func newFeed(f *model.Feed) *Feed {
+	result := &Feed{
+		ID:                f.ID,
+		FeedURL:           f.FeedURL,
+		SiteURL:           f.SiteURL,
+		Title:             f.Title,
+		CheckedAt:         f.CheckedAt.Unix(),
+		ParsingErrorCount: int32(f.ParsingErrorCount),
+		ParsingErrorMsg:   f.ParsingErrorMsg,
+		Crawler:           f.Crawler,
+	}
+
+	if f.Category != nil {
+		result.CategoryID = f.Category.ID
+		result.CategoryTitle = f.Category.Title
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+const serviceName = "miniflux.v1.Miniflux"
+
+// MinifluxServer is the server API for the Miniflux service defined in miniflux.proto.
+type MinifluxServer interface {
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	SyncEntries(context.Context, *SyncEntriesRequest) (*SyncEntriesResponse, error)
+	UpdateEntriesStatus(context.Context, *UpdateEntriesStatusRequest) (*Empty, error)
+	ToggleBookmark(context.Context, *ToggleBookmarkRequest) (*Empty, error)
+	ListFeeds(context.Context, *ListFeedsRequest) (*ListFeedsResponse, error)
+	CreateFeed(context.Context, *CreateFeedRequest) (*CreateFeedResponse, error)
+	RemoveFeed(context.Context, *RemoveFeedRequest) (*Empty, error)
+	RefreshFeed(context.Context, *RefreshFeedRequest) (*Empty, error)
+}
+
+// RegisterMinifluxServer registers the Miniflux service on a gRPC server.
+func RegisterMinifluxServer(s *grpc.Server, srv MinifluxServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*MinifluxServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEntries",
+			Handler: unaryHandler("ListEntries", func() interface{} { return &ListEntriesRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.ListEntries(ctx, req.(*ListEntriesRequest))
+			}),
+		},
+		{
+			MethodName: "SyncEntries",
+			Handler: unaryHandler("SyncEntries", func() interface{} { return &SyncEntriesRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.SyncEntries(ctx, req.(*SyncEntriesRequest))
+			}),
+		},
+		{
+			MethodName: "UpdateEntriesStatus",
+			Handler: unaryHandler("UpdateEntriesStatus", func() interface{} { return &UpdateEntriesStatusRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.UpdateEntriesStatus(ctx, req.(*UpdateEntriesStatusRequest))
+			}),
+		},
+		{
+			MethodName: "ToggleBookmark",
+			Handler: unaryHandler("ToggleBookmark", func() interface{} { return &ToggleBookmarkRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.ToggleBookmark(ctx, req.(*ToggleBookmarkRequest))
+			}),
+		},
+		{
+			MethodName: "ListFeeds",
+			Handler: unaryHandler("ListFeeds", func() interface{} { return &ListFeedsRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.ListFeeds(ctx, req.(*ListFeedsRequest))
+			}),
+		},
+		{
+			MethodName: "CreateFeed",
+			Handler: unaryHandler("CreateFeed", func() interface{} { return &CreateFeedRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.CreateFeed(ctx, req.(*CreateFeedRequest))
+			}),
+		},
+		{
+			MethodName: "RemoveFeed",
+			Handler: unaryHandler("RemoveFeed", func() interface{} { return &RemoveFeedRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.RemoveFeed(ctx, req.(*RemoveFeedRequest))
+			}),
+		},
+		{
+			MethodName: "RefreshFeed",
+			Handler: unaryHandler("RefreshFeed", func() interface{} { return &RefreshFeedRequest{} }, func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.RefreshFeed(ctx, req.(*RefreshFeedRequest))
+			}),
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniflux.proto",
+}
+
+type callFunc func(srv MinifluxServer, ctx context.Context, req interface{}) (interface{}, error)
+
+func unaryHandler(method string, newRequest func() interface{}, call callFunc) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := newRequest()
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+
+		if interceptor == nil {
+			return call(srv.(MinifluxServer), ctx, in)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: "/" + serviceName + "/" + method,
+		}
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv.(MinifluxServer), ctx, req)
+		}
+
+		return interceptor(ctx, in, info, handler)
+	}
+}
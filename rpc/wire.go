@@ -0,0 +1,253 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rpc // import "miniflux.app/rpc"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Protocol Buffers wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("rpc: truncated message")
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// encoder writes proto3 fields, default values are omitted like protoc does.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) tag(field, wireType int) {
+	e.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (e *encoder) varint(v uint64) {
+	for v >= 0x80 {
+		e.buf = append(e.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *encoder) int64(field int, v int64) {
+	if v != 0 {
+		e.tag(field, wireVarint)
+		e.varint(uint64(v))
+	}
+}
+
+func (e *encoder) int32(field int, v int32) {
+	e.int64(field, int64(v))
+}
+
+func (e *encoder) bool(field int, v bool) {
+	if v {
+		e.tag(field, wireVarint)
+		e.varint(1)
+	}
+}
+
+func (e *encoder) double(field int, v float64) {
+	if v != 0 {
+		e.tag(field, wireFixed64)
+		e.buf = append(e.buf, make([]byte, 8)...)
+		binary.LittleEndian.PutUint64(e.buf[len(e.buf)-8:], math.Float64bits(v))
+	}
+}
+
+func (e *encoder) string(field int, v string) {
+	if v != "" {
+		e.bytes(field, []byte(v))
+	}
+}
+
+func (e *encoder) bytes(field int, v []byte) {
+	e.tag(field, wireBytes)
+	e.varint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) strings(field int, values []string) {
+	for _, v := range values {
+		e.bytes(field, []byte(v))
+	}
+}
+
+func (e *encoder) packedInt64s(field int, values []int64) {
+	if len(values) == 0 {
+		return
+	}
+
+	packed := &encoder{}
+	for _, v := range values {
+		packed.varint(uint64(v))
+	}
+	e.bytes(field, packed.buf)
+}
+
+func (e *encoder) message(field int, m marshaler) error {
+	data, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	e.bytes(field, data)
+	return nil
+}
+
+type decoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *decoder) done() bool {
+	return d.pos >= len(d.buf)
+}
+
+func (d *decoder) next() (field, wireType int, err error) {
+	key, err := d.varint()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	field = int(key >> 3)
+	wireType = int(key & 7)
+	if field <= 0 {
+		return 0, 0, fmt.Errorf("rpc: invalid field number %d", field)
+	}
+
+	return field, wireType, nil
+}
+
+func (d *decoder) varint() (uint64, error) {
+	var v uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		if d.pos >= len(d.buf) {
+			return 0, errTruncated
+		}
+		b := d.buf[d.pos]
+		d.pos++
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+	}
+	return 0, errors.New("rpc: varint overflow")
+}
+
+func (d *decoder) expect(wireType, expected int) error {
+	if wireType != expected {
+		return fmt.Errorf("rpc: unexpected wire type %d, expected %d", wireType, expected)
+	}
+	return nil
+}
+
+func (d *decoder) int64(wireType int) (int64, error) {
+	if err := d.expect(wireType, wireVarint); err != nil {
+		return 0, err
+	}
+	v, err := d.varint()
+	return int64(v), err
+}
+
+func (d *decoder) int32(wireType int) (int32, error) {
+	v, err := d.int64(wireType)
+	return int32(v), err
+}
+
+func (d *decoder) bool(wireType int) (bool, error) {
+	v, err := d.int64(wireType)
+	return v != 0, err
+}
+
+func (d *decoder) double(wireType int) (float64, error) {
+	if err := d.expect(wireType, wireFixed64); err != nil {
+		return 0, err
+	}
+	if len(d.buf)-d.pos < 8 {
+		return 0, errTruncated
+	}
+	v := binary.LittleEndian.Uint64(d.buf[d.pos:])
+	d.pos += 8
+	return math.Float64frombits(v), nil
+}
+
+func (d *decoder) bytes(wireType int) ([]byte, error) {
+	if err := d.expect(wireType, wireBytes); err != nil {
+		return nil, err
+	}
+	length, err := d.varint()
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(len(d.buf)-d.pos) {
+		return nil, errTruncated
+	}
+	v := d.buf[d.pos : d.pos+int(length)]
+	d.pos += int(length)
+	return v, nil
+}
+
+func (d *decoder) string(wireType int) (string, error) {
+	v, err := d.bytes(wireType)
+	return string(v), err
+}
+
+// int64s reads a repeated int64 field, packed or not.
+func (d *decoder) int64s(wireType int, values []int64) ([]int64, error) {
+	if wireType == wireVarint {
+		v, err := d.int64(wireType)
+		return append(values, v), err
+	}
+
+	data, err := d.bytes(wireType)
+	if err != nil {
+		return nil, err
+	}
+
+	packed := &decoder{buf: data}
+	for !packed.done() {
+		v, err := packed.varint()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, int64(v))
+	}
+	return values, nil
+}
+
+func (d *decoder) skip(wireType int) error {
+	switch wireType {
+	case wireVarint:
+		_, err := d.varint()
+		return err
+	case wireFixed64:
+		if len(d.buf)-d.pos < 8 {
+			return errTruncated
+		}
+		d.pos += 8
+	case wireBytes:
+		_, err := d.bytes(wireType)
+		return err
+	case wireFixed32:
+		if len(d.buf)-d.pos < 4 {
+			return errTruncated
+		}
+		d.pos += 4
+	default:
+		return fmt.Errorf("rpc: unsupported wire type %d", wireType)
+	}
+	return nil
+}
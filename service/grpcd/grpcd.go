@@ -0,0 +1,37 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package grpcd // import "miniflux.app/service/grpcd"
+
+import (
+	"net"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/reader/feed"
+	"miniflux.app/rpc"
+	"miniflux.app/storage"
+
+	"google.golang.org/grpc"
+)
+
+// Serve starts a new gRPC server.
+func Serve(cfg *config.Config, store *storage.Storage, feedHandler *feed.Handler) *grpc.Server {
+	listenAddr := cfg.GRPCListenAddr()
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		logger.Fatal(`gRPC server failed to listen on %q: %v`, listenAddr, err)
+	}
+
+	server := rpc.NewServer(store, feedHandler, cfg.IsReadOnly())
+
+	go func() {
+		logger.Info(`gRPC server listening on %q`, listenAddr)
+		if err := server.Serve(listener); err != nil {
+			logger.Fatal(`gRPC server failed to start: %v`, err)
+		}
+	}()
+
+	return server
+}
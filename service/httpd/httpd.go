@@ -15,9 +15,14 @@ import (
 
 	"miniflux.app/api"
 	"miniflux.app/config"
+	"miniflux.app/errorreport"
+	"miniflux.app/extension"
 	"miniflux.app/fever"
+	"miniflux.app/graphql"
 	"miniflux.app/logger"
+	"miniflux.app/metric"
 	"miniflux.app/reader/feed"
+	"miniflux.app/refresh"
 	"miniflux.app/storage"
 	"miniflux.app/ui"
 	"miniflux.app/worker"
@@ -33,9 +38,10 @@ func Serve(cfg *config.Config, store *storage.Storage, pool *worker.Pool, feedHa
 	certDomain := cfg.CertDomain()
 	certCache := cfg.CertCache()
 	listenAddr := cfg.ListenAddr()
+	timeout := time.Duration(cfg.HTTPServerTimeout()) * time.Second
 	server := &http.Server{
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
 		IdleTimeout:  60 * time.Second,
 		Handler:      setupHandler(cfg, store, feedHandler, pool),
 	}
@@ -163,12 +169,32 @@ func setupHandler(cfg *config.Config, store *storage.Storage, feedHandler *feed.
 		router = router.PathPrefix(cfg.BasePath()).Subrouter()
 	}
 
-	router.Use(newMiddleware(cfg).Serve)
+	reporter, err := errorreport.New(cfg)
+	if err != nil {
+		logger.Fatal("%v", err)
+	}
+
+	router.Use(newMiddleware(cfg, reporter).Serve)
 
 	fever.Serve(router, cfg, store)
-	api.Serve(router, store, feedHandler)
+	api.Serve(router, cfg, store, pool, feedHandler)
+	extension.Serve(router, cfg, store, feedHandler)
+
+	if cfg.HasGraphQL() {
+		graphql.Serve(router, cfg, store)
+	}
+
 	ui.Serve(router, cfg, store, pool, feedHandler)
 
+	// The feeds are not refreshed on read-only instances.
+	if cfg.HasPushRefresh() && !cfg.IsReadOnly() {
+		refresh.Serve(router, cfg, store, feedHandler)
+	}
+
+	if cfg.HasMetricsCollector() {
+		router.Handle("/metrics", metric.NewCollector(store)).Methods("GET").Name("metrics")
+	}
+
 	router.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("OK"))
 	}).Name("healthcheck")
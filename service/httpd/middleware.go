@@ -6,19 +6,27 @@ package httpd // import "miniflux.app/service/httpd"
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/errorreport"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/response/json"
 	"miniflux.app/logger"
 )
 
 type middleware struct {
-	cfg *config.Config
+	cfg      *config.Config
+	reporter errorreport.Reporter
 }
 
-func newMiddleware(cfg *config.Config) *middleware {
-	return &middleware{cfg}
+func newMiddleware(cfg *config.Config, reporter errorreport.Reporter) *middleware {
+	return &middleware{cfg, reporter}
 }
 
 func (m *middleware) Serve(next http.Handler) http.Handler {
@@ -27,6 +35,18 @@ func (m *middleware) Serve(next http.Handler) http.Handler {
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.ClientIPContextKey, clientIP)
 
+		correlationID := r.Header.Get("X-Request-Id")
+		if correlationID == "" {
+			correlationID = crypto.GenerateRandomString(16)
+		}
+		ctx = context.WithValue(ctx, request.CorrelationIDContextKey, correlationID)
+		w.Header().Set("X-Correlation-Id", correlationID)
+
+		// The storage receives the request context, slow queries are canceled
+		// when the response can no longer be written or the client is gone.
+		ctx, cancel := context.WithTimeout(ctx, time.Duration(m.cfg.HTTPServerTimeout())*time.Second)
+		defer cancel()
+
 		if r.Header.Get("X-Forwarded-Proto") == "https" {
 			m.cfg.IsHTTPS = true
 		}
@@ -42,6 +62,35 @@ func (m *middleware) Serve(next http.Handler) http.Handler {
 			w.Header().Set("Strict-Transport-Security", "max-age=31536000")
 		}
 
-		next.ServeHTTP(w, r.WithContext(ctx))
+		r = r.WithContext(ctx)
+		defer m.recoverPanic(w, r)
+
+		next.ServeHTTP(w, r)
 	})
 }
+
+// recoverPanic turns a panic of a handler into an error report and an internal server error,
+// the other requests served by the process are not affected.
+func (m *middleware) recoverPanic(w http.ResponseWriter, r *http.Request) {
+	value := recover()
+	if value == nil {
+		return
+	}
+
+	// The standard library uses this panic to abort a response, it must not be reported.
+	if value == http.ErrAbortHandler {
+		panic(value)
+	}
+
+	correlationID := request.CorrelationID(r)
+	report := errorreport.NewReport(r, correlationID, value)
+	logger.Error("[HTTP:Panic] %s %s (correlation ID: %s) => %s\n%s", r.Method, r.URL, correlationID, report.Message, report.Stack)
+	m.reporter.Report(report)
+
+	err := fmt.Errorf("Internal server error, correlation ID: %s", correlationID)
+	if strings.HasPrefix(r.URL.Path, m.cfg.BasePath()+"/v1/") {
+		json.ServerError(w, r, err)
+	} else {
+		html.ServerError(w, r, err)
+	}
+}
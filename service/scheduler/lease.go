@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package scheduler // import "miniflux.app/service/scheduler"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+)
+
+// holderID identifies this process in the scheduler leases.
+var holderID = newHolderID()
+
+func newHolderID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), crypto.GenerateRandomString(6))
+}
+
+// leasedTick returns a channel receiving the ticks of the interval when this process
+// holds the lease of the job. Instances sharing the database run each job only once
+// per interval, another instance takes over when the holder stops renewing its lease.
+func leasedTick(store *storage.Storage, job string, interval time.Duration) <-chan time.Time {
+	c := make(chan time.Time)
+
+	go func() {
+		for tick := range time.Tick(interval) {
+			acquired, err := store.AcquireLease(context.Background(), job, holderID, interval)
+			if err != nil {
+				logger.Error("[Scheduler:Lease] %v", err)
+				continue
+			}
+
+			if !acquired {
+				logger.Debug("[Scheduler:Lease] The %s job is run by another instance", job)
+				continue
+			}
+
+			c <- tick
+		}
+	}()
+
+	return c
+}
@@ -5,43 +5,341 @@
 package scheduler // import "miniflux.app/service/scheduler"
 
 import (
+	"context"
 	"time"
 
+	"miniflux.app/alert"
 	"miniflux.app/config"
+	"miniflux.app/embedding"
 	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/adblock"
+	"miniflux.app/reader/processor"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/simhash"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
 
+// filterListCheckInterval is the interval between two checks of the cached filter lists.
+const filterListCheckInterval = time.Hour
+
+// quietHoursCheckInterval is the interval between two deliveries of the notifications held during quiet hours.
+const quietHoursCheckInterval = time.Minute
+
 // Serve starts the internal scheduler.
 func Serve(cfg *config.Config, store *storage.Storage, pool *worker.Pool) {
 	logger.Info(`Starting scheduler...`)
-	go feedScheduler(store, pool, cfg.PollingFrequency(), cfg.BatchSize())
-	go cleanupScheduler(store, cfg.CleanupFrequency(), cfg.ArchiveReadDays())
+	if cfg.HasPushRefresh() {
+		logger.Info(`Feeds are refreshed by push requests, the feed scheduler is disabled`)
+	} else {
+		go feedScheduler(store, pool, cfg.PollingFrequency(), cfg.BatchSize())
+	}
+
+	go cleanupScheduler(store, cfg.CleanupFrequency(), cfg.ArchiveReadDays(), cfg.TrashRetentionDays())
+	go quietHoursScheduler(store)
+
+	if frequency := cfg.MaintenanceFrequency(); frequency > 0 {
+		go maintenanceScheduler(store, frequency, cfg.MaintenanceTables())
+	}
+
+	if frequency := cfg.SnapshotFrequency(); frequency > 0 {
+		go snapshotScheduler(store, frequency, cfg.BatchSize())
+	}
+
+	if frequency := cfg.ClusterFrequency(); frequency > 0 {
+		go clusterScheduler(store, frequency, cfg.BatchSize())
+	}
+
+	if embedder := embedding.New(cfg); embedder != nil && cfg.EmbeddingFrequency() > 0 {
+		go embeddingScheduler(store, embedder, cfg.EmbeddingFrequency(), cfg.BatchSize())
+	}
+
+	if urls := cfg.FilterLists(); len(urls) > 0 {
+		go filterListScheduler(store, cfg.FilterListFrequency(), urls)
+	}
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSize int) {
-	c := time.Tick(time.Duration(frequency) * time.Minute)
+	ctx := context.Background()
+	monitor := store.AlertMonitor()
+	c := leasedTick(store, "feed", time.Duration(frequency)*time.Minute)
 	for range c {
-		jobs, err := store.NewBatch(batchSize)
+		// The refreshes of the previous batch are done or still running, either way the cycle is over.
+		monitor.EndRefreshCycle()
+
+		jobs, err := store.NewBatch(ctx, batchSize)
 		if err != nil {
 			logger.Error("[Scheduler:Feed] %v", err)
+			monitor.Failure(alert.ComponentDatabase, err)
 		} else {
+			monitor.Success(alert.ComponentDatabase)
 			logger.Debug("[Scheduler:Feed] Pushing %d jobs", len(jobs))
 			pool.Push(jobs)
 		}
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int) {
-	c := time.Tick(time.Duration(frequency) * time.Hour)
+func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, trashDays int) {
+	ctx := context.Background()
+	c := leasedTick(store, "cleanup", time.Duration(frequency)*time.Hour)
 	for range c {
-		nbSessions := store.CleanOldSessions()
-		nbUserSessions := store.CleanOldUserSessions()
+		nbSessions := store.CleanOldSessions(ctx)
+		nbUserSessions := store.CleanOldUserSessions(ctx)
 		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions and %d user sessions", nbSessions, nbUserSessions)
 
-		if err := store.ArchiveEntries(archiveDays); err != nil {
+		nbRefreshJobs := store.CleanOldRefreshJobs(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d refresh jobs", nbRefreshJobs)
+
+		nbUnverifiedUsers := store.CleanUnverifiedUsers(ctx)
+		nbTokens := store.CleanOldTokens(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d unverified users and %d tokens", nbUnverifiedUsers, nbTokens)
+
+		nbDeletedUsers := store.PurgeDeletedUsers(ctx)
+		logger.Info("[Scheduler:Cleanup] Purged %d deleted users", nbDeletedUsers)
+
+		nbIdempotencyKeys := store.CleanOldIdempotencyKeys(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d API idempotency keys", nbIdempotencyKeys)
+
+		nbTombstones := store.CleanOldEntryTombstones(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d entry tombstones", nbTombstones)
+
+		nbReadSnapshots := store.CleanOldReadSnapshots(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d mark as read snapshots", nbReadSnapshots)
+
+		nbEntrySnapshots := store.CleanOldEntrySnapshots(ctx)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d snapshots of unstarred entries", nbEntrySnapshots)
+
+		if nbStale, err := store.MarkStaleEntriesAsRead(ctx); err != nil {
 			logger.Error("[Scheduler:Cleanup] %v", err)
+		} else {
+			logger.Info("[Scheduler:Cleanup] Marked %d stale entries as read", nbStale)
 		}
+
+		if err := store.ArchiveEntries(ctx, archiveDays); err != nil {
+			logger.Error("[Scheduler:Cleanup] %v", err)
+		}
+
+		nbTrashed := store.PurgeTrash(ctx, trashDays)
+		logger.Info("[Scheduler:Cleanup] Purged %d feeds and categories from the trash", nbTrashed)
 	}
 }
+
+func quietHoursScheduler(store *storage.Storage) {
+	ctx := context.Background()
+	c := leasedTick(store, "quiet_hours", quietHoursCheckInterval)
+	for range c {
+		nbNotifications, err := store.FlushHeldNotifications(ctx)
+		if err != nil {
+			logger.Error("[Scheduler:QuietHours] %v", err)
+			continue
+		}
+
+		if nbNotifications > 0 {
+			logger.Debug("[Scheduler:QuietHours] Sent %d held notifications", nbNotifications)
+		}
+	}
+}
+
+func maintenanceScheduler(store *storage.Storage, frequency int, nbTables int) {
+	ctx := context.Background()
+	c := leasedTick(store, "maintenance", time.Duration(frequency)*time.Hour)
+	for range c {
+		tables, err := store.TableStats(ctx, nbTables)
+		if err != nil {
+			logger.Error("[Scheduler:Maintenance] %v", err)
+			continue
+		}
+
+		for _, table := range tables {
+			if err := store.VacuumAnalyze(ctx, table.Name); err != nil {
+				logger.Error("[Scheduler:Maintenance] %v", err)
+			}
+		}
+
+		if err := store.ReindexSearchIndex(ctx); err != nil {
+			logger.Error("[Scheduler:Maintenance] %v", err)
+		}
+
+		tables, err = store.TableStats(ctx, nbTables)
+		if err != nil {
+			logger.Error("[Scheduler:Maintenance] %v", err)
+			continue
+		}
+
+		for _, table := range tables {
+			logger.Info("[Scheduler:Maintenance] table=%s size=%d live=%d dead=%d bloat=%.2f",
+				table.Name, table.TotalSize, table.LiveTuples, table.DeadTuples, table.BloatRatio())
+		}
+	}
+}
+
+func snapshotScheduler(store *storage.Storage, frequency, batchSize int) {
+	ctx := context.Background()
+	c := leasedTick(store, "snapshot", time.Duration(frequency)*time.Minute)
+	for range c {
+		entries, err := store.StarredEntriesWithoutSnapshot(ctx, batchSize)
+		if err != nil {
+			logger.Error("[Scheduler:Snapshot] %v", err)
+			continue
+		}
+
+		for _, entry := range entries {
+			// Failures are recorded in the snapshot, the entry is not retried until a new snapshot is requested.
+			snapshot, err := processor.SnapshotEntryWebPage(entry)
+			if err != nil {
+				logger.Error("[Scheduler:Snapshot] entryID=%d: %v", entry.ID, err)
+			}
+
+			if err := store.SaveEntrySnapshot(ctx, snapshot); err != nil {
+				logger.Error("[Scheduler:Snapshot] %v", err)
+			}
+		}
+
+		logger.Debug("[Scheduler:Snapshot] Processed %d starred entries", len(entries))
+	}
+}
+
+func clusterScheduler(store *storage.Storage, frequency, batchSize int) {
+	ctx := context.Background()
+	c := leasedTick(store, "cluster", time.Duration(frequency)*time.Minute)
+	for range c {
+		entries, err := store.UnclusteredEntries(ctx, batchSize)
+		if err != nil {
+			logger.Error("[Scheduler:Cluster] %v", err)
+			continue
+		}
+
+		for _, entry := range entries {
+			fingerprint := simhash.Fingerprint(entry.Title + " " + sanitizer.StripTags(entry.Content))
+			if _, err := store.ClusterEntry(ctx, entry, fingerprint); err != nil {
+				logger.Error("[Scheduler:Cluster] %v", err)
+			}
+		}
+
+		logger.Debug("[Scheduler:Cluster] Processed %d entries", len(entries))
+	}
+}
+
+func embeddingScheduler(store *storage.Storage, embedder embedding.Embedder, frequency, batchSize int) {
+	ctx := context.Background()
+	c := leasedTick(store, "embedding", time.Duration(frequency)*time.Minute)
+	for range c {
+		entries, err := store.EntriesWithoutEmbedding(ctx, embedder.Model(), batchSize)
+		if err != nil {
+			logger.Error("[Scheduler:Embedding] %v", err)
+			continue
+		}
+
+		// The entries are retried at the next tick when the embedding API fails.
+		vectors, err := embedding.EmbedEntries(embedder, entries)
+		if err != nil {
+			logger.Error("[Scheduler:Embedding] %v", err)
+			continue
+		}
+
+		for i, entry := range entries {
+			if err := store.SaveEntryEmbedding(ctx, entry, embedder.Model(), vectors[i]); err != nil {
+				logger.Error("[Scheduler:Embedding] %v", err)
+			}
+		}
+
+		logger.Debug("[Scheduler:Embedding] Processed %d entries", len(entries))
+	}
+}
+
+// filterListScheduler downloads the stale filter lists on a single instance,
+// every instance applies the cached copies as soon as they change.
+func filterListScheduler(store *storage.Storage, frequency int, urls []string) {
+	ctx := context.Background()
+	interval := time.Duration(frequency) * time.Hour
+
+	var loadedAt time.Time
+	for {
+		refreshFilterLists(ctx, store, urls, interval)
+		loadedAt = loadFilterLists(ctx, store, urls, loadedAt)
+		time.Sleep(filterListCheckInterval)
+	}
+}
+
+func refreshFilterLists(ctx context.Context, store *storage.Storage, urls []string, interval time.Duration) {
+	lists, err := store.FilterLists(ctx, urls)
+	if err != nil {
+		logger.Error("[Scheduler:FilterList] %v", err)
+		return
+	}
+
+	cached := make(map[string]*model.FilterList, len(lists))
+	for _, list := range lists {
+		cached[list.URL] = list
+	}
+
+	var stale model.FilterLists
+	for _, url := range urls {
+		list, found := cached[url]
+		if !found {
+			list = &model.FilterList{URL: url}
+		}
+
+		if list.IsStale(interval) {
+			stale = append(stale, list)
+		}
+	}
+
+	if len(stale) == 0 {
+		return
+	}
+
+	acquired, err := store.AcquireLease(ctx, "filterlist", holderID, filterListCheckInterval)
+	if err != nil {
+		logger.Error("[Scheduler:FilterList] %v", err)
+		return
+	}
+
+	if !acquired {
+		logger.Debug("[Scheduler:FilterList] The filter lists are downloaded by another instance")
+		return
+	}
+
+	for _, list := range stale {
+		// The previous copy is kept when the download fails, it is retried at the next check.
+		if err := adblock.Download(list); err != nil {
+			logger.Error("[Scheduler:FilterList] %v", err)
+			continue
+		}
+
+		if err := store.SaveFilterList(ctx, list); err != nil {
+			logger.Error("[Scheduler:FilterList] %v", err)
+		}
+	}
+}
+
+// loadFilterLists compiles the cached filter lists when they changed since the given date and returns the date of the loaded copies.
+func loadFilterLists(ctx context.Context, store *storage.Storage, urls []string, loadedAt time.Time) time.Time {
+	updatedAt, err := store.FilterListsUpdatedAt(ctx, urls)
+	if err != nil {
+		logger.Error("[Scheduler:FilterList] %v", err)
+		return loadedAt
+	}
+
+	if !updatedAt.After(loadedAt) {
+		return loadedAt
+	}
+
+	lists, err := store.FilterLists(ctx, urls)
+	if err != nil {
+		logger.Error("[Scheduler:FilterList] %v", err)
+		return loadedAt
+	}
+
+	contents := make([]string, 0, len(lists))
+	for _, list := range lists {
+		contents = append(contents, list.Content)
+	}
+
+	filter := adblock.NewFilter(contents...)
+	adblock.Register(filter)
+	logger.Info("[Scheduler:FilterList] Loaded %d element hiding rules from %d filter lists", filter.Len(), len(lists))
+
+	return updatedAt
+}
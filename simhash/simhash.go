@@ -0,0 +1,51 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package simhash // import "miniflux.app/simhash"
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// MaxDistance is the number of different bits below which two fingerprints belong to the same story.
+const MaxDistance = 3
+
+// Fingerprint returns the simhash of the words of the text.
+func Fingerprint(text string) uint64 {
+	var weights [64]int
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	for _, word := range words {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		sum := h.Sum64()
+
+		for i := uint(0); i < 64; i++ {
+			if sum&(1<<i) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for i := uint(0); i < 64; i++ {
+		if weights[i] > 0 {
+			fingerprint |= 1 << i
+		}
+	}
+
+	return fingerprint
+}
+
+// Distance returns the number of different bits between two fingerprints.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package simhash // import "miniflux.app/simhash"
+
+import "testing"
+
+const story = `The city council approved on Tuesday the construction of a new tramway line between the central station
+and the airport, the works should start next spring and last three years according to the mayor.`
+
+func TestFingerprintOfSameText(t *testing.T) {
+	if Fingerprint(story) != Fingerprint(story) {
+		t.Error(`The fingerprint of a text should be stable`)
+	}
+}
+
+func TestFingerprintIgnoresCaseAndPunctuation(t *testing.T) {
+	if Fingerprint("Hello, World!") != Fingerprint("hello world") {
+		t.Error(`The case and the punctuation should not change the fingerprint`)
+	}
+}
+
+func TestFingerprintOfSimilarTexts(t *testing.T) {
+	similar := story + ` The mayor said.`
+	if d := Distance(Fingerprint(story), Fingerprint(similar)); d > MaxDistance {
+		t.Errorf(`Similar texts should have close fingerprints, got a distance of %d`, d)
+	}
+}
+
+func TestFingerprintOfDifferentTexts(t *testing.T) {
+	other := `A new smartphone was unveiled yesterday with a bigger screen, a faster processor and a longer battery life,
+it will be available in stores at the end of the month for a price similar to the previous model.`
+	if d := Distance(Fingerprint(story), Fingerprint(other)); d <= MaxDistance {
+		t.Errorf(`Different texts should have distant fingerprints, got a distance of %d`, d)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	if d := Distance(0, 0); d != 0 {
+		t.Errorf(`Identical fingerprints should have a distance of 0, got %d`, d)
+	}
+
+	if d := Distance(0, 7); d != 3 {
+		t.Errorf(`Wrong distance, got %d instead of 3`, d)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"miniflux.app/cache"
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	sessionCacheTTL     = 5 * time.Minute
+	unreadCountCacheTTL = time.Minute
+	iconCacheTTL        = 24 * time.Hour
+)
+
+func appSessionCacheKey(id string) string {
+	return "app_session:" + id
+}
+
+func userSessionCacheKey(token string) string {
+	return "user_session:" + token
+}
+
+func unreadCountCacheKey(userID int64) string {
+	return fmt.Sprintf("unread_count:%d", userID)
+}
+
+func iconCacheKey(iconID int64) string {
+	return fmt.Sprintf("icon:%d", iconID)
+}
+
+// cacheGet decodes a cached value, it returns false on a cache miss.
+func cacheGet(c cache.Cache, key string, value interface{}) bool {
+	data, found := c.Get(key)
+	if !found {
+		return false
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		logger.Error("[Storage:Cache] Unable to decode %q: %v", key, err)
+		c.Delete(key)
+		return false
+	}
+
+	return true
+}
+
+func cacheSet(c cache.Cache, key string, value interface{}, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Error("[Storage:Cache] Unable to encode %q: %v", key, err)
+		return
+	}
+
+	c.Set(key, data, ttl)
+}
+
+// InvalidateLocalCaches removes the values changed by another instance, the events are received from Pub/Sub.
+// Events do not contain the owner of categories, so all category lists are removed.
+func (s *Storage) InvalidateLocalCaches(event gcppubsub.SyncEvent) {
+	switch event.EntityType {
+	case gcppubsub.EntityTypeCategory:
+		s.categories.Purge()
+		s.feeds.Purge()
+	case gcppubsub.EntityTypeFeed:
+		s.feeds.Remove(event.EntityID)
+	case gcppubsub.EntityTypeUser:
+		s.users.Remove(event.EntityID)
+		s.feeds.Purge()
+	}
+}
+
+// Cached values are copied because callers modify the returned models.
+
+func (s *Storage) cachedUser(userID int64) *model.User {
+	if value, found := s.users.Get(userID); found {
+		return copyUser(value.(*model.User))
+	}
+	return nil
+}
+
+func (s *Storage) cacheUser(user *model.User) {
+	s.users.Add(user.ID, copyUser(user))
+}
+
+func copyUser(user *model.User) *model.User {
+	u := *user
+	u.Extra = make(map[string]string, len(user.Extra))
+	for key, value := range user.Extra {
+		u.Extra[key] = value
+	}
+
+	if user.LastLoginAt != nil {
+		lastLoginAt := *user.LastLoginAt
+		u.LastLoginAt = &lastLoginAt
+	}
+
+	return &u
+}
+
+func (s *Storage) cachedCategories(userID int64) model.Categories {
+	if value, found := s.categories.Get(userID); found {
+		return copyCategories(value.(model.Categories))
+	}
+	return nil
+}
+
+func (s *Storage) cacheCategories(userID int64, categories model.Categories) {
+	s.categories.Add(userID, copyCategories(categories))
+}
+
+func copyCategories(categories model.Categories) model.Categories {
+	result := make(model.Categories, len(categories))
+	for i, category := range categories {
+		c := *category
+		result[i] = &c
+	}
+	return result
+}
+
+func (s *Storage) cachedFeed(userID, feedID int64) *model.Feed {
+	if value, found := s.feeds.Get(feedID); found {
+		if feed := value.(*model.Feed); feed.UserID == userID {
+			return copyFeed(feed)
+		}
+	}
+	return nil
+}
+
+func (s *Storage) cacheFeed(feed *model.Feed) {
+	s.feeds.Add(feed.ID, copyFeed(feed))
+}
+
+func copyFeed(feed *model.Feed) *model.Feed {
+	f := *feed
+	f.Entries = nil
+
+	if feed.Category != nil {
+		category := *feed.Category
+		f.Category = &category
+	}
+
+	if feed.Icon != nil {
+		icon := *feed.Icon
+		f.Icon = &icon
+	}
+
+	return &f
+}
@@ -5,43 +5,37 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
-	"time"
 
 	"miniflux.app/model"
-	"miniflux.app/timer"
 	"miniflux.app/integration/gcppubsub"
 )
 
 // AnotherCategoryExists checks if another category exists with the same title.
-func (s *Storage) AnotherCategoryExists(userID, categoryID int64, title string) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:AnotherCategoryExists] userID=%d, categoryID=%d, title=%s", userID, categoryID, title))
-
+func (s *Storage) AnotherCategoryExists(ctx context.Context, userID, categoryID int64, title string) bool {
 	var result int
-	query := `SELECT count(*) as c FROM categories WHERE user_id=$1 AND id != $2 AND title=$3`
-	s.db.QueryRow(query, userID, categoryID, title).Scan(&result)
+	query := `SELECT count(*) as c FROM categories WHERE user_id=$1 AND id != $2 AND title=$3 AND deleted_at IS NULL`
+	s.db.QueryRowContext(ctx, query, userID, categoryID, title).Scan(&result)
 	return result >= 1
 }
 
 // CategoryExists checks if the given category exists into the database.
-func (s *Storage) CategoryExists(userID, categoryID int64) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CategoryExists] userID=%d, categoryID=%d", userID, categoryID))
-
+func (s *Storage) CategoryExists(ctx context.Context, userID, categoryID int64) bool {
 	var result int
-	query := `SELECT count(*) as c FROM categories WHERE user_id=$1 AND id=$2`
-	s.db.QueryRow(query, userID, categoryID).Scan(&result)
+	query := `SELECT count(*) as c FROM categories WHERE user_id=$1 AND id=$2 AND deleted_at IS NULL`
+	s.db.QueryRowContext(ctx, query, userID, categoryID).Scan(&result)
 	return result >= 1
 }
 
 // Category returns a category from the database.
-func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:Category] userID=%d, getCategory=%d", userID, categoryID))
+func (s *Storage) Category(ctx context.Context, userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, mark_read_after_days, image_display, nsfw, version FROM categories WHERE user_id=$1 AND id=$2 AND deleted_at IS NULL`
+	err := s.db.QueryRowContext(ctx, query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.MarkReadAfterDays, &category.ImageDisplay, &category.NSFW, &category.Version)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -52,12 +46,11 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 }
 
 // FirstCategory returns the first category for the given user.
-func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:FirstCategory] userID=%d", userID))
+func (s *Storage) FirstCategory(ctx context.Context, userID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, mark_read_after_days, image_display, nsfw, version FROM categories WHERE user_id=$1 AND deleted_at IS NULL ORDER BY title ASC LIMIT 1`
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.MarkReadAfterDays, &category.ImageDisplay, &category.NSFW, &category.Version)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -68,12 +61,11 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 }
 
 // CategoryByTitle finds a category by the title.
-func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CategoryByTitle] userID=%d, title=%s", userID, title))
+func (s *Storage) CategoryByTitle(ctx context.Context, userID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, mark_read_after_days, image_display, nsfw, version FROM categories WHERE user_id=$1 AND title=$2 AND deleted_at IS NULL`
+	err := s.db.QueryRowContext(ctx, query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.MarkReadAfterDays, &category.ImageDisplay, &category.NSFW, &category.Version)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -84,11 +76,13 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 }
 
 // Categories returns all categories that belongs to the given user.
-func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:Categories] userID=%d", userID))
+func (s *Storage) Categories(ctx context.Context, userID int64) (model.Categories, error) {
+	if categories := s.cachedCategories(userID); categories != nil {
+		return categories, nil
+	}
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC`
-	rows, err := s.db.Query(query, userID)
+	query := `SELECT id, user_id, title, mark_read_after_days, image_display, nsfw, version FROM categories WHERE user_id=$1 AND deleted_at IS NULL ORDER BY title ASC`
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to fetch categories: %v", err)
 	}
@@ -97,26 +91,26 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.MarkReadAfterDays, &category.ImageDisplay, &category.NSFW, &category.Version); err != nil {
 			return nil, fmt.Errorf("Unable to fetch categories row: %v", err)
 		}
 
 		categories = append(categories, &category)
 	}
 
+	s.cacheCategories(userID, categories)
 	return categories, nil
 }
 
 // CategoriesWithFeedCount returns all categories with the number of feeds.
-func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CategoriesWithFeedCount] userID=%d", userID))
+func (s *Storage) CategoriesWithFeedCount(ctx context.Context, userID int64) (model.Categories, error) {
 	query := `SELECT
-		c.id, c.user_id, c.title,
-		(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count
-		FROM categories c WHERE user_id=$1
+		c.id, c.user_id, c.title, c.mark_read_after_days, c.image_display, c.nsfw, c.version,
+		(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id AND feeds.deleted_at IS NULL) AS count
+		FROM categories c WHERE user_id=$1 AND deleted_at IS NULL
 		ORDER BY c.title ASC`
 
-	rows, err := s.db.Query(query, userID)
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("Unable to fetch categories: %v", err)
 	}
@@ -125,7 +119,7 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.FeedCount); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.MarkReadAfterDays, &category.ImageDisplay, &category.NSFW, &category.Version, &category.FeedCount); err != nil {
 			return nil, fmt.Errorf("Unable to fetch categories row: %v", err)
 		}
 
@@ -136,77 +130,124 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 }
 
 // CreateCategory creates a new category.
-func (s *Storage) CreateCategory(category *model.Category) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CreateCategory] title=%s", category.Title))
-
+func (s *Storage) CreateCategory(ctx context.Context, category *model.Category) error {
 	query := `
 		INSERT INTO categories
-		(user_id, title)
+		(user_id, title, mark_read_after_days, image_display, nsfw)
 		VALUES
-		($1, $2)
-		RETURNING id
+		($1, $2, $3, $4, $5)
+		RETURNING id, version
 	`
-	err := s.db.QueryRow(
+	err := s.db.QueryRowContext(
+		ctx,
 		query,
 		category.UserID,
 		category.Title,
-	).Scan(&category.ID)
+		category.MarkReadAfterDays,
+		category.ImageDisplay,
+		category.NSFW,
+	).Scan(&category.ID, &category.Version)
 
 	if err != nil {
 		return fmt.Errorf("Unable to create category: %v", err)
 	}
 
+	s.categories.Remove(category.UserID)
+
 	// Sync category
-	syncEvent := gcppubsub.NewCategoryEvent(category.ID, gcppubsub.EntityOpWrite)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewCategoryEvent(category.ID, gcppubsub.EntityOpWrite), category.UserID, category)
 
 	return nil
 }
 
-// UpdateCategory updates an existing category.
-func (s *Storage) UpdateCategory(category *model.Category) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UpdateCategory] categoryID=%d", category.ID))
-
-	query := `UPDATE categories SET title=$1 WHERE id=$2 AND user_id=$3`
-	_, err := s.db.Exec(
+// UpdateCategory updates an existing category, ErrConflict is returned when the category
+// has been changed since its version has been read.
+func (s *Storage) UpdateCategory(ctx context.Context, category *model.Category) error {
+	query := `UPDATE categories SET title=$1, mark_read_after_days=$2, image_display=$3, nsfw=$4, version=version+1 WHERE id=$5 AND user_id=$6 AND version=$7`
+	result, err := s.db.ExecContext(
+		ctx,
 		query,
 		category.Title,
+		category.MarkReadAfterDays,
+		category.ImageDisplay,
+		category.NSFW,
 		category.ID,
 		category.UserID,
+		category.Version,
 	)
 
 	if err != nil {
 		return fmt.Errorf("Unable to update category: %v", err)
 	}
 
+	if count, _ := result.RowsAffected(); count == 0 {
+		return ErrConflict
+	}
+
+	category.Version++
+
+	// Feeds contain the title of their category.
+	s.categories.Remove(category.UserID)
+	s.feeds.Purge()
+
 	// Sync category
-	syncEvent := gcppubsub.NewCategoryEvent(category.ID, gcppubsub.EntityOpWrite)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewCategoryEvent(category.ID, gcppubsub.EntityOpWrite), category.UserID, category)
 
 	return nil
 }
 
-// RemoveCategory deletes a category.
-func (s *Storage) RemoveCategory(userID, categoryID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:RemoveCategory] userID=%d, categoryID=%d", userID, categoryID))
+// RemoveCategory moves a category and its feeds to the trash.
+func (s *Storage) RemoveCategory(ctx context.Context, userID, categoryID int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Unable to start transaction: %v", err)
+	}
 
-	result, err := s.db.Exec("DELETE FROM categories WHERE id = $1 AND user_id = $2", categoryID, userID)
+	result, err := tx.ExecContext(
+		ctx,
+		"UPDATE categories SET deleted_at=now() WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL",
+		categoryID,
+		userID,
+	)
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf("Unable to remove this category: %v", err)
 	}
 
 	count, err := result.RowsAffected()
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf("Unable to remove this category: %v", err)
 	}
 
 	if count == 0 {
+		tx.Rollback()
 		return errors.New("no category has been removed")
 	}
 
+	// The feeds share the deletion time of their category, that's how they are restored together.
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE feeds SET deleted_at=(SELECT deleted_at FROM categories WHERE id=$1)
+		WHERE category_id=$1 AND user_id=$2 AND deleted_at IS NULL`,
+		categoryID,
+		userID,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("Unable to remove the feeds of this category: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Unable to remove this category: %v", err)
+	}
+
+	s.entriesChanged(userID)
+	s.categories.Remove(userID)
+	s.feeds.Purge()
+
 	// Sync category
-	syncEvent := gcppubsub.NewCategoryEvent(categoryID, gcppubsub.EntityOpDelete)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewCategoryEvent(categoryID, gcppubsub.EntityOpDelete), userID, nil)
 
 	return nil
 }
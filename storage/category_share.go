@@ -0,0 +1,143 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// ShareCategory gives another user a read-only access to a category of the owner.
+func (s *Storage) ShareCategory(ctx context.Context, ownerID, categoryID, userID int64) error {
+	if ownerID == userID {
+		return errors.New("a category cannot be shared with its owner")
+	}
+
+	result, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO category_shares (category_id, user_id)
+		SELECT id, $3 FROM categories WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL
+		ON CONFLICT DO NOTHING`,
+		categoryID,
+		ownerID,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to share category #%d: %v", categoryID, err)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 && !s.CategoryExists(ctx, ownerID, categoryID) {
+		return fmt.Errorf("unable to share category #%d: the category does not exist", categoryID)
+	}
+
+	return nil
+}
+
+// UnshareCategory removes the access of a user to a category of the owner.
+func (s *Storage) UnshareCategory(ctx context.Context, ownerID, categoryID, userID int64) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM category_shares
+		WHERE category_id=$1 AND user_id=$3 AND category_id IN (SELECT id FROM categories WHERE user_id=$2)`,
+		categoryID,
+		ownerID,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to unshare category #%d: %v", categoryID, err)
+	}
+
+	return nil
+}
+
+// CategoryShares returns the users allowed to read a category of the owner.
+func (s *Storage) CategoryShares(ctx context.Context, ownerID, categoryID int64) (model.CategoryShares, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT cs.category_id, cs.user_id, u.username, cs.created_at
+		FROM category_shares cs
+		JOIN categories c ON c.id=cs.category_id
+		JOIN users u ON u.id=cs.user_id
+		WHERE cs.category_id=$1 AND c.user_id=$2
+		ORDER BY lower(u.username) ASC`,
+		categoryID,
+		ownerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch category shares: %v", err)
+	}
+	defer rows.Close()
+
+	shares := make(model.CategoryShares, 0)
+	for rows.Next() {
+		var share model.CategoryShare
+		if err := rows.Scan(&share.CategoryID, &share.UserID, &share.Username, &share.CreatedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch category shares row: %v", err)
+		}
+
+		shares = append(shares, &share)
+	}
+
+	return shares, nil
+}
+
+// SharedCategories returns the categories of other users shared with the given user.
+func (s *Storage) SharedCategories(ctx context.Context, userID int64) (model.SharedCategories, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT c.id, c.user_id, c.title, u.username
+		FROM category_shares cs
+		JOIN categories c ON c.id=cs.category_id
+		JOIN users u ON u.id=c.user_id
+		WHERE cs.user_id=$1 AND c.deleted_at IS NULL
+		ORDER BY lower(c.title) ASC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch shared categories: %v", err)
+	}
+	defer rows.Close()
+
+	categories := make(model.SharedCategories, 0)
+	for rows.Next() {
+		shared := &model.SharedCategory{Category: &model.Category{}}
+		if err := rows.Scan(&shared.Category.ID, &shared.Category.UserID, &shared.Category.Title, &shared.OwnerUsername); err != nil {
+			return nil, fmt.Errorf("unable to fetch shared categories row: %v", err)
+		}
+
+		categories = append(categories, shared)
+	}
+
+	return categories, nil
+}
+
+// SharedCategory returns a category shared with the given user, or nil if the user has no access.
+// The entries of the category must be queried with the ID of the owner.
+func (s *Storage) SharedCategory(ctx context.Context, userID, categoryID int64) (*model.SharedCategory, error) {
+	shared := &model.SharedCategory{Category: &model.Category{}}
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT c.id, c.user_id, c.title, u.username
+		FROM category_shares cs
+		JOIN categories c ON c.id=cs.category_id
+		JOIN users u ON u.id=c.user_id
+		WHERE cs.user_id=$1 AND cs.category_id=$2 AND c.deleted_at IS NULL`,
+		userID,
+		categoryID,
+	).Scan(&shared.Category.ID, &shared.Category.UserID, &shared.Category.Title, &shared.OwnerUsername)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch shared category #%d: %v", categoryID, err)
+	}
+
+	return shared, nil
+}
@@ -6,5 +6,7 @@
 
 Package storage implements a set of functions to interact with the database.
 
+Methods querying the database receive a context, the queries are canceled with it.
+
 */
 package storage // import "miniflux.app/storage"
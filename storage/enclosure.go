@@ -5,19 +5,20 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"fmt"
 
 	"miniflux.app/model"
 )
 
 // GetEnclosures returns all attachments for the given entry.
-func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
+func (s *Storage) GetEnclosures(ctx context.Context, entryID int64) (model.EnclosureList, error) {
 	query := `SELECT
 		id, user_id, entry_id, url, size, mime_type
 		FROM enclosures
 		WHERE entry_id = $1 ORDER BY id ASC`
 
-	rows, err := s.db.Query(query, entryID)
+	rows, err := s.db.QueryContext(ctx, query, entryID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get enclosures: %v", err)
 	}
@@ -46,7 +47,7 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 }
 
 // CreateEnclosure creates a new attachment.
-func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
+func (s *Storage) CreateEnclosure(ctx context.Context, enclosure *model.Enclosure) error {
 	query := `
 		INSERT INTO enclosures
 		(url, size, mime_type, entry_id, user_id)
@@ -54,7 +55,8 @@ func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 		($1, $2, $3, $4, $5)
 		RETURNING id
 	`
-	err := s.db.QueryRow(
+	err := s.db.QueryRowContext(
+		ctx,
 		query,
 		enclosure.URL,
 		enclosure.Size,
@@ -71,18 +73,18 @@ func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 }
 
 // IsEnclosureExists checks if an attachment exists.
-func (s *Storage) IsEnclosureExists(enclosure *model.Enclosure) bool {
+func (s *Storage) IsEnclosureExists(ctx context.Context, enclosure *model.Enclosure) bool {
 	var result int
 	query := `SELECT count(*) as c FROM enclosures WHERE user_id=$1 AND entry_id=$2 AND url=$3`
-	s.db.QueryRow(query, enclosure.UserID, enclosure.EntryID, enclosure.URL).Scan(&result)
+	s.db.QueryRowContext(ctx, query, enclosure.UserID, enclosure.EntryID, enclosure.URL).Scan(&result)
 	return result >= 1
 }
 
 // UpdateEnclosures add missing attachments while updating a feed.
-func (s *Storage) UpdateEnclosures(enclosures model.EnclosureList) error {
+func (s *Storage) UpdateEnclosures(ctx context.Context, enclosures model.EnclosureList) error {
 	for _, enclosure := range enclosures {
-		if !s.IsEnclosureExists(enclosure) {
-			err := s.CreateEnclosure(enclosure)
+		if !s.IsEnclosureExists(ctx, enclosure) {
+			err := s.CreateEnclosure(ctx, enclosure)
 			if err != nil {
 				return err
 			}
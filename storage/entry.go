@@ -5,30 +5,40 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"miniflux.app/integration/gcppubsub"
 	"miniflux.app/logger"
 	"miniflux.app/model"
-	"miniflux.app/timer"
 
 	"github.com/abadojack/whatlanggo"
 	"github.com/lib/pq"
 )
 
 // CountUnreadEntries returns the number of unread entries.
-func (s *Storage) CountUnreadEntries(userID int64) int {
+func (s *Storage) CountUnreadEntries(ctx context.Context, userID int64) int {
+	var n int
+	if cacheGet(s.cache, unreadCountCacheKey(userID), &n) {
+		return n
+	}
+
 	builder := s.NewEntryQueryBuilder(userID)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithoutMutedFeeds()
+	builder.WithoutHiddenNSFW()
 
-	n, err := builder.CountEntries()
+	n, err := builder.CountEntries(ctx)
 	if err != nil {
 		logger.Error("unable to count unread entries for user #%d: %v", userID, err)
 		return 0
 	}
 
+	cacheSet(s.cache, unreadCountCacheKey(userID), n, unreadCountCacheTTL)
 	return n
 }
 
@@ -38,13 +48,18 @@ func (s *Storage) NewEntryQueryBuilder(userID int64) *EntryQueryBuilder {
 }
 
 // UpdateEntryContent updates entry content.
-func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
-	tx, err := s.db.Begin()
+func (s *Storage) UpdateEntryContent(ctx context.Context, entry *model.Entry) error {
+	sealed, err := s.sealEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.Exec(`UPDATE entries SET content=$1 WHERE id=$2 AND user_id=$3`, entry.Content, entry.ID, entry.UserID)
+	_, err = tx.ExecContext(ctx, `UPDATE entries SET content=$1, changed_at=now() WHERE id=$2 AND user_id=$3`, sealed.content, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`unable to update content of entry #%d: %v`, entry.ID, err)
@@ -52,50 +67,80 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 
 	query := `
 		UPDATE entries
-		SET document_vectors = to_tsvector(substring(title || ' ' || coalesce(content, '') for 1000000))
-		WHERE id=$1 AND user_id=$2
+		SET document_vectors = to_tsvector(substring($1 for 1000000))
+		WHERE id=$2 AND user_id=$3
 	`
-	_, err = tx.Exec(query, entry.ID, entry.UserID)
+	_, err = tx.ExecContext(ctx, query, sealed.searchText, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`unable to update content of entry #%d: %v`, entry.ID, err)
 	}
 
 	// Sync entry
-	syncEvent := gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite), entry.UserID, entry)
 
 	return tx.Commit()
 }
 
+// UpdateEntrySummary stores the summary generated for an entry.
+func (s *Storage) UpdateEntrySummary(ctx context.Context, entry *model.Entry) error {
+	sealed, err := s.sealEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `UPDATE entries SET summary=$1, changed_at=now() WHERE id=$2 AND user_id=$3`, sealed.summary, entry.ID, entry.UserID)
+	if err != nil {
+		return fmt.Errorf(`unable to update summary of entry #%d: %v`, entry.ID, err)
+	}
+
+	return nil
+}
+
 // createEntry add a new entry.
-func (s *Storage) createEntry(entry *model.Entry) error {
+func (s *Storage) createEntry(ctx context.Context, entry *model.Entry) error {
 	// Gatra Bali Project:
 	// To avoid duplicate entry, check the title before creating new entry.
 	// not the best way but it should minimize dulicated entries on DB.
 	// Its fine to do this because feeds is managed by single user only.
-	if s.titleExists(entry.Title) {
+	sealed, err := s.sealEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	if s.titleExists(ctx, sealed.title) {
 		return nil
 	}
 
+	status := entry.Status
+	if status == "" {
+		status = model.EntryStatusUnread
+	}
+
 	query := `
 		INSERT INTO entries
-		(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, document_vectors)
+		(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, status, document_vectors, summary, junk_score, nsfw)
 		VALUES
-		($1, $2, $3, $4, $5, $6, $7, $8, $9, to_tsvector(substring($1 || ' ' || coalesce($6, '') for 1000000)))
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, to_tsvector(substring($11 for 1000000)), $12, $13, $14)
 		RETURNING id, status
 	`
-	err := s.db.QueryRow(
+	err = s.db.QueryRowContext(
+		ctx,
 		query,
-		entry.Title,
+		sealed.title,
 		entry.Hash,
 		entry.URL,
 		entry.CommentsURL,
 		entry.Date,
-		entry.Content,
+		sealed.content,
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
+		status,
+		sealed.searchText,
+		sealed.summary,
+		entry.JunkScore,
+		entry.NSFW,
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
@@ -105,7 +150,7 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 	for i := 0; i < len(entry.Enclosures); i++ {
 		entry.Enclosures[i].EntryID = entry.ID
 		entry.Enclosures[i].UserID = entry.UserID
-		err := s.CreateEnclosure(entry.Enclosures[i])
+		err := s.CreateEnclosure(ctx, entry.Enclosures[i])
 		if err != nil {
 			return err
 		}
@@ -125,8 +170,7 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 		logger.Debug("[Storage:createEntry] %s language detected, won't sync. [%s]", langInfo.Lang, entry.Title)
 	} else {
 		logger.Debug("[Storage:createEntry] %s language detected, will sync.[%s]", langInfo.Lang, entry.Title)
-		syncEvent := gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite)
-		s.pub.PublishEvent(syncEvent)
+		s.publishEvent(ctx, gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite), entry.UserID, entry)
 	}
 	return nil
 }
@@ -134,25 +178,34 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
-func (s *Storage) updateEntry(entry *model.Entry) error {
+func (s *Storage) updateEntry(ctx context.Context, entry *model.Entry) error {
+	sealed, err := s.sealEntry(entry)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE entries SET
+		changed_at=(CASE WHEN title=$1 AND url=$2 AND comments_url=$3 AND content IS NOT DISTINCT FROM $4 AND author=$5 THEN changed_at ELSE now() END),
 		title=$1, url=$2, comments_url=$3, content=$4, author=$5,
-		document_vectors=to_tsvector(substring($1 || ' ' || coalesce($4, '') for 1000000))
+		document_vectors=to_tsvector(substring($9 for 1000000))
 		WHERE user_id=$6 AND feed_id=$7 AND hash=$8
-		RETURNING id
+		RETURNING id, changed_at=now()
 	`
-	err := s.db.QueryRow(
+	var changed bool
+	err = s.db.QueryRowContext(
+		ctx,
 		query,
-		entry.Title,
+		sealed.title,
 		entry.URL,
 		entry.CommentsURL,
-		entry.Content,
+		sealed.content,
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
-	).Scan(&entry.ID)
+		sealed.searchText,
+	).Scan(&entry.ID, &changed)
 
 	if err != nil {
 		return fmt.Errorf(`unable to update entry %q: %v`, entry.URL, err)
@@ -163,56 +216,159 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 		enclosure.EntryID = entry.ID
 	}
 
-	// Sync entry
-	// syncEvent := gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite)
-	// s.pub.PublishEvent(syncEvent)
+	if err := s.UpdateEnclosures(ctx, entry.Enclosures); err != nil {
+		return err
+	}
+
+	// Sync entry, the refreshed entry doesn't have the status and the bookmark of the stored one.
+	if changed {
+		s.publishEvent(ctx, gcppubsub.NewEntryEvent(entry.ID, gcppubsub.EntityOpWrite), entry.UserID, nil)
+	}
 
-	return s.UpdateEnclosures(entry.Enclosures)
+	return nil
 }
 
 // entryExists checks if an entry already exists based on its hash when refreshing a feed.
-func (s *Storage) entryExists(entry *model.Entry) bool {
+func (s *Storage) entryExists(ctx context.Context, entry *model.Entry) bool {
 	var result int
 	query := `SELECT count(*) as c FROM entries WHERE user_id=$1 AND feed_id=$2 AND hash=$3`
-	s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash).Scan(&result)
+	s.db.QueryRowContext(ctx, query, entry.UserID, entry.FeedID, entry.Hash).Scan(&result)
 	return result >= 1
 }
 
+// republishedEntry returns the existing entry that the given entry republishes with a new hash, nil if none.
+func (s *Storage) republishedEntry(ctx context.Context, entry *model.Entry, matching string) *model.Entry {
+	// The candidates are narrowed down in SQL, the comparison itself is done by the model.
+	var condition string
+	var args []interface{}
+	switch matching {
+	case model.EntryMatchingURL:
+		if entry.URL == "" {
+			return nil
+		}
+		condition = `left(url, char_length($3::text))=$3`
+		args = []interface{}{strings.TrimRight(strings.SplitN(entry.URL, "#", 2)[0], "/")}
+	case model.EntryMatchingTitle:
+		condition = `published_at BETWEEN $3 AND $4`
+		args = []interface{}{entry.Date.Add(-model.RepublishedEntryWindow), entry.Date.Add(model.RepublishedEntryWindow)}
+	default:
+		return nil
+	}
+
+	query := `
+		SELECT id, hash, title, url, published_at
+		FROM entries
+		WHERE user_id=$1 AND feed_id=$2 AND ` + condition + `
+		ORDER BY published_at DESC
+	`
+	rows, err := s.db.QueryContext(ctx, query, append([]interface{}{entry.UserID, entry.FeedID}, args...)...)
+	if err != nil {
+		logger.Error("[Storage:RepublishedEntry] %v", err)
+		return nil
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var existing model.Entry
+		existing.UserID = entry.UserID
+		if err := rows.Scan(&existing.ID, &existing.Hash, &existing.Title, &existing.URL, &existing.Date); err != nil {
+			logger.Error("[Storage:RepublishedEntry] %v", err)
+			return nil
+		}
+
+		if err := s.openEntry(&existing); err != nil {
+			logger.Error("[Storage:RepublishedEntry] %v", err)
+			return nil
+		}
+
+		if entry.IsRepublishedAs(&existing, matching) {
+			return &existing
+		}
+	}
+
+	return nil
+}
+
+// mergeRepublishedEntry gives the hash of the republished entry to the existing one,
+// the next refreshes match it directly while its status and bookmark are kept.
+func (s *Storage) mergeRepublishedEntry(ctx context.Context, existing, entry *model.Entry, updateContent bool) error {
+	logger.Debug("[Storage:MergeRepublishedEntry] Entry #%d republished as %q", existing.ID, entry.Hash)
+
+	_, err := s.db.ExecContext(ctx, `UPDATE entries SET hash=$1 WHERE id=$2`, entry.Hash, existing.ID)
+	if err != nil {
+		return fmt.Errorf("unable to merge republished entry #%d: %v", existing.ID, err)
+	}
+
+	if updateContent {
+		return s.updateEntry(ctx, entry)
+	}
+
+	entry.ID = existing.ID
+	return nil
+}
+
 // titleExists checks if title already exists.
-func (s *Storage) titleExists(title string) bool {
+func (s *Storage) titleExists(ctx context.Context, title string) bool {
 	var result int
 	query := `SELECT count(*) as c FROM entries WHERE title=$1`
-	s.db.QueryRow(query, title).Scan(&result)
+	s.db.QueryRowContext(ctx, query, title).Scan(&result)
 	return result >= 1
 }
 
 // cleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
-func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
+// The deleted entries are kept as tombstones for the sync API.
+func (s *Storage) cleanupEntries(ctx context.Context, feedID int64, entryHashes []string) error {
 	query := `
-		DELETE FROM entries
-		WHERE feed_id=$1 AND
-		id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)))
+		WITH deleted AS (
+			DELETE FROM entries
+			WHERE feed_id=$1 AND
+			id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)))
+			RETURNING user_id, id
+		)
+		INSERT INTO entry_tombstones (user_id, entry_id) SELECT user_id, id FROM deleted
+		RETURNING user_id, entry_id
 	`
-	if _, err := s.db.Exec(query, feedID, feedID, model.EntryStatusRemoved, pq.Array(entryHashes)); err != nil {
+	rows, err := s.db.QueryContext(ctx, query, feedID, feedID, model.EntryStatusRemoved, pq.Array(entryHashes))
+	if err != nil {
 		return fmt.Errorf("unable to cleanup entries: %v", err)
 	}
 
+	deleted, err := scanEntryIDs(rows)
+	if err != nil {
+		return fmt.Errorf("unable to fetch deleted entries: %v", err)
+	}
+
+	for userID, entryIDs := range deleted {
+		s.publishEntryEvents(ctx, userID, entryIDs, gcppubsub.EntityOpDelete)
+	}
+
 	return nil
 }
 
 // UpdateEntries updates a list of entries while refreshing a feed.
-func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
+func (s *Storage) UpdateEntries(ctx context.Context, userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
+	var matching string
+	s.db.QueryRowContext(ctx, `SELECT entry_matching FROM feeds WHERE id=$1`, feedID).Scan(&matching)
+
 	var entryHashes []string
+	var newEntries model.Entries
 	for _, entry := range entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
-		if s.entryExists(entry) {
+		if s.entryExists(ctx, entry) {
 			if updateExistingEntries {
-				err = s.updateEntry(entry)
+				err = s.updateEntry(ctx, entry)
 			}
+		} else if republished := s.republishedEntry(ctx, entry, matching); republished != nil {
+			err = s.mergeRepublishedEntry(ctx, republished, entry, updateExistingEntries)
 		} else {
-			err = s.createEntry(entry)
+			err = s.createEntry(ctx, entry)
+
+			// Entries with a duplicate title are skipped and keep a zero ID, entries of muted feeds are already read.
+			if err == nil && entry.ID != 0 && entry.Status == model.EntryStatusUnread {
+				newEntries = append(newEntries, entry)
+			}
 		}
 
 		if err != nil {
@@ -222,146 +378,370 @@ func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, upd
 		entryHashes = append(entryHashes, entry.Hash)
 	}
 
-	if err := s.cleanupEntries(feedID, entryHashes); err != nil {
+	s.entriesChanged(userID)
+
+	if len(newEntries) > 0 && s.notifier != nil {
+		var categoryID int64
+		s.db.QueryRowContext(ctx, `SELECT category_id FROM feeds WHERE id=$1`, feedID).Scan(&categoryID)
+		s.notifier.NewEntries(userID, categoryID, newEntries)
+	}
+
+	if err := s.cleanupEntries(ctx, feedID, entryHashes); err != nil {
 		logger.Error("[Storage:CleanupEntries] feed #%d: %v", feedID, err)
 	}
 
 	return nil
 }
 
+// TrimFeedEntries enforces the entry limit of a feed and returns the number of entries over the limit.
+// With the delete policy, the oldest entries are deleted unless they are still published in the feed,
+// those are archived like with the archive policy to avoid fetching them again. Starred entries are always kept.
+func (s *Storage) TrimFeedEntries(ctx context.Context, feed *model.Feed) (int, error) {
+	if feed.MaxEntries <= 0 {
+		return 0, nil
+	}
+
+	overflow := `
+		SELECT id FROM entries
+		WHERE user_id=$1 AND feed_id=$2 AND status <> 'removed' AND starred is false
+		ORDER BY published_at DESC, id DESC
+		OFFSET $3
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var deleted map[int64][]int64
+	if feed.OverflowPolicy == model.OverflowPolicyDelete {
+		// An empty list and not NULL, all the overflow is deleted when the feed has no entries.
+		entryHashes := make([]string, 0, len(feed.Entries))
+		for _, entry := range feed.Entries {
+			entryHashes = append(entryHashes, entry.Hash)
+		}
+
+		query := `
+			WITH deleted AS (
+				DELETE FROM entries WHERE id IN (` + overflow + `) AND NOT (hash=ANY($4))
+				RETURNING user_id, id
+			)
+			INSERT INTO entry_tombstones (user_id, entry_id) SELECT user_id, id FROM deleted
+			RETURNING user_id, entry_id
+		`
+		rows, err := tx.QueryContext(ctx, query, feed.UserID, feed.ID, feed.MaxEntries, pq.Array(entryHashes))
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("unable to delete entries of feed #%d: %v", feed.ID, err)
+		}
+
+		if deleted, err = scanEntryIDs(rows); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("unable to fetch deleted entries of feed #%d: %v", feed.ID, err)
+		}
+	}
+
+	query := `UPDATE entries SET status='removed', changed_at=now() WHERE id IN (` + overflow + `) RETURNING user_id, id`
+	rows, err := tx.QueryContext(ctx, query, feed.UserID, feed.ID, feed.MaxEntries)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("unable to archive entries of feed #%d: %v", feed.ID, err)
+	}
+
+	archived, err := scanEntryIDs(rows)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("unable to fetch archived entries of feed #%d: %v", feed.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	count := len(deleted[feed.UserID]) + len(archived[feed.UserID])
+	if count > 0 {
+		s.entriesChanged(feed.UserID)
+	}
+
+	s.publishEntryEvents(ctx, feed.UserID, deleted[feed.UserID], gcppubsub.EntityOpDelete)
+	s.publishEntryEvents(ctx, feed.UserID, archived[feed.UserID], gcppubsub.EntityOpWrite)
+
+	return count, nil
+}
+
+// SaveEntry stores an entry added outside of a feed refresh, the entry is updated if its hash already exists in the feed.
+func (s *Storage) SaveEntry(ctx context.Context, entry *model.Entry) (err error) {
+	if s.entryExists(ctx, entry) {
+		err = s.updateEntry(ctx, entry)
+	} else {
+		err = s.createEntry(ctx, entry)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if entry.ID == 0 {
+		return fmt.Errorf("unable to save entry %q: an entry with the same title already exists", entry.URL)
+	}
+
+	s.entriesChanged(entry.UserID)
+	return nil
+}
+
 // ArchiveEntries changes the status of read items to "removed" after specified days.
-func (s *Storage) ArchiveEntries(days int) error {
+func (s *Storage) ArchiveEntries(ctx context.Context, days int) error {
 	query := fmt.Sprintf(`
-			UPDATE entries SET status='removed'
+			UPDATE entries SET status='removed', changed_at=now()
 			WHERE id=ANY(SELECT id FROM entries WHERE status='read' AND starred is false AND published_at < now () - '%d days'::interval LIMIT 5000)
 		`, days)
-	if _, err := s.db.Exec(query); err != nil {
+	if _, err := s.db.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("unable to archive read entries: %v", err)
 	}
 
 	return nil
 }
 
-// SetEntriesStatus update the status of the given list of entries.
-func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:SetEntriesStatus] userID=%d, entryIDs=%v, status=%s", userID, entryIDs, status))
+// MarkStaleEntriesAsRead marks as read the unread entries older than the number of days defined on
+// their feed, or on the category of the feed when the feed has no rule, and returns the number of entries.
+// The read date is not set, these entries have not been read by the user.
+func (s *Storage) MarkStaleEntriesAsRead(ctx context.Context) (int, error) {
+	query := `
+		UPDATE entries e
+		SET status='read', changed_at=now()
+		FROM feeds f
+		LEFT JOIN categories c ON c.id=f.category_id
+		WHERE
+			e.feed_id=f.id AND e.status='unread' AND
+			coalesce(nullif(f.mark_read_after_days, 0), c.mark_read_after_days, 0) > 0 AND
+			e.published_at < now() - coalesce(nullif(f.mark_read_after_days, 0), c.mark_read_after_days) * interval '1 day'
+		RETURNING e.user_id
+	`
 
-	query := `UPDATE entries SET status=$1 WHERE user_id=$2 AND id=ANY($3)`
-	result, err := s.db.Exec(query, status, userID, pq.Array(entryIDs))
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("unable to mark stale entries as read: %v", err)
+	}
+	defer rows.Close()
+
+	count := 0
+	userIDs := make(map[int64]bool)
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return count, fmt.Errorf("unable to fetch stale entry row: %v", err)
+		}
+
+		count++
+		userIDs[userID] = true
+	}
+
+	for userID := range userIDs {
+		s.entriesChanged(userID)
+	}
+
+	return count, nil
+}
+
+// SetEntriesStatus update the status of the given list of entries.
+func (s *Storage) SetEntriesStatus(ctx context.Context, userID int64, entryIDs []int64, status string) error {
+	query := `
+		UPDATE entries
+		SET status=$1, changed_at=now(), read_at=CASE WHEN $1='read' THEN coalesce(read_at, now()) END
+		WHERE user_id=$2 AND id=ANY($3)
+		RETURNING user_id, id
+	`
+	rows, err := s.db.QueryContext(ctx, query, status, userID, pq.Array(entryIDs))
 	if err != nil {
 		return fmt.Errorf("unable to update entries statuses %v: %v", entryIDs, err)
 	}
 
-	count, err := result.RowsAffected()
+	updated, err := scanEntryIDs(rows)
 	if err != nil {
 		return fmt.Errorf("unable to update these entries %v: %v", entryIDs, err)
 	}
 
-	if count == 0 {
+	if len(updated[userID]) == 0 {
 		return errors.New("nothing has been updated")
 	}
 
+	s.entriesChanged(userID)
+	s.publishEntryEvents(ctx, userID, updated[userID], gcppubsub.EntityOpWrite)
 	return nil
 }
 
 // ToggleBookmark toggles entry bookmark value.
-func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:ToggleBookmark] userID=%d, entryID=%d", userID, entryID))
+func (s *Storage) ToggleBookmark(ctx context.Context, userID int64, entryID int64) error {
+	var starred bool
+	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2 RETURNING starred`
+	err := s.db.QueryRowContext(ctx, query, userID, entryID).Scan(&starred)
+	switch {
+	case err == sql.ErrNoRows:
+		return errors.New("nothing has been updated")
+	case err != nil:
+		return fmt.Errorf("unable to toggle bookmark flag for entry #%d: %v", entryID, err)
+	}
+
+	s.entriesChanged(userID)
+	s.publishEvent(ctx, gcppubsub.NewEntryEvent(entryID, gcppubsub.EntityOpWrite), userID, nil)
+
+	if starred && (s.hooks != nil || s.notifier != nil) {
+		builder := s.NewEntryQueryBuilder(userID)
+		builder.WithEntryID(entryID)
+		entry, err := builder.GetEntry(ctx)
+		if err != nil {
+			return err
+		}
+
+		if entry != nil {
+			s.hooks.EntryStarred(entry)
+			s.notifier.EntryStarred(entry)
+		}
+	}
 
-	query := `UPDATE entries SET starred = NOT starred WHERE user_id=$1 AND id=$2`
-	result, err := s.db.Exec(query, userID, entryID)
+	return nil
+}
+
+// SetEntriesStarred stars or unstars the given list of entries.
+func (s *Storage) SetEntriesStarred(ctx context.Context, userID int64, entryIDs []int64, starred bool) error {
+	query := `
+		UPDATE entries
+		SET starred=$1, changed_at=now()
+		WHERE user_id=$2 AND id=ANY($3) AND starred <> $1
+		RETURNING id
+	`
+	rows, err := s.db.QueryContext(ctx, query, starred, userID, pq.Array(entryIDs))
 	if err != nil {
-		return fmt.Errorf("unable to toggle bookmark flag for entry #%d: %v", entryID, err)
+		return fmt.Errorf("unable to update bookmark flag for entries %v: %v", entryIDs, err)
+	}
+	defer rows.Close()
+
+	var changedIDs []int64
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			return fmt.Errorf("unable to fetch updated entry: %v", err)
+		}
+		changedIDs = append(changedIDs, entryID)
+	}
+
+	if len(changedIDs) == 0 {
+		return nil
+	}
+
+	s.entriesChanged(userID)
+	s.publishEntryEvents(ctx, userID, changedIDs, gcppubsub.EntityOpWrite)
+
+	if starred && (s.hooks != nil || s.notifier != nil) {
+		builder := s.NewEntryQueryBuilder(userID)
+		builder.WithEntryIDs(changedIDs)
+		entries, err := builder.GetEntries(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			s.hooks.EntryStarred(entry)
+			s.notifier.EntryStarred(entry)
+		}
+	}
+
+	return nil
+}
+
+// SetEntriesScore updates the score of the given entries, the entries of other users are ignored.
+func (s *Storage) SetEntriesScore(ctx context.Context, userID int64, scores model.EntryScores) error {
+	entryIDs := make([]int64, len(scores))
+	values := make([]float64, len(scores))
+	for i, score := range scores {
+		entryIDs[i] = score.EntryID
+		values[i] = score.Score
 	}
 
-	count, err := result.RowsAffected()
+	query := `
+		UPDATE entries e
+		SET score=s.score, changed_at=now()
+		FROM unnest($2::bigint[], $3::double precision[]) AS s(id, score)
+		WHERE e.user_id=$1 AND e.id=s.id AND e.score <> s.score
+	`
+	result, err := s.db.ExecContext(ctx, query, userID, pq.Array(entryIDs), pq.Array(values))
 	if err != nil {
-		return fmt.Errorf("unable to toogle bookmark flag for entry #%d: %v", entryID, err)
+		return fmt.Errorf("unable to update the score of entries: %v", err)
 	}
 
-	if count == 0 {
-		return errors.New("nothing has been updated")
+	if count, _ := result.RowsAffected(); count > 0 {
+		s.entriesChanged(userID)
 	}
 
 	return nil
 }
 
 // FlushHistory set all entries with the status "read" to "removed".
-func (s *Storage) FlushHistory(userID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:FlushHistory] userID=%d", userID))
-
-	query := `UPDATE entries SET status=$1 WHERE user_id=$2 AND status=$3 AND starred='f'`
-	_, err := s.db.Exec(query, model.EntryStatusRemoved, userID, model.EntryStatusRead)
+func (s *Storage) FlushHistory(ctx context.Context, userID int64) error {
+	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3 AND starred='f'`
+	_, err := s.db.ExecContext(ctx, query, model.EntryStatusRemoved, userID, model.EntryStatusRead)
 	if err != nil {
 		return fmt.Errorf("unable to flush history: %v", err)
 	}
 
+	s.entriesChanged(userID)
+
 	return nil
 }
 
 // MarkAllAsRead updates all user entries to the read status.
-func (s *Storage) MarkAllAsRead(userID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:MarkAllAsRead] userID=%d", userID))
-
-	query := `UPDATE entries SET status=$1 WHERE user_id=$2 AND status=$3`
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
+func (s *Storage) MarkAllAsRead(ctx context.Context, userID int64) error {
+	query := `UPDATE entries SET status=$1, changed_at=now(), read_at=now() WHERE user_id=$2 AND status=$3 RETURNING id`
+	count, err := s.markAsRead(ctx, userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf("unable to mark all entries as read: %v", err)
 	}
 
-	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkAllAsRead] %d items marked as read", count)
-
 	return nil
 }
 
 // MarkFeedAsRead updates all feed entries to the read status.
-func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:MarkFeedAsRead] userID=%d, feedID=%d, before=%v", userID, feedID, before))
-
+func (s *Storage) MarkFeedAsRead(ctx context.Context, userID, feedID int64, before time.Time) error {
 	query := `
 		UPDATE entries
-		SET status=$1
+		SET status=$1, changed_at=now(), read_at=now()
 		WHERE user_id=$2 AND feed_id=$3 AND status=$4 AND published_at < $5
+		RETURNING id
 	`
 
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, feedID, model.EntryStatusUnread, before)
+	count, err := s.markAsRead(ctx, userID, query, model.EntryStatusRead, userID, feedID, model.EntryStatusUnread, before)
 	if err != nil {
 		return fmt.Errorf("unable to mark feed entries as read: %v", err)
 	}
 
-	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkFeedAsRead] %d items marked as read", count)
-
 	return nil
 }
 
 // MarkCategoryAsRead updates all category entries to the read status.
-func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:MarkCategoryAsRead] userID=%d, categoryID=%d, before=%v", userID, categoryID, before))
-
+func (s *Storage) MarkCategoryAsRead(ctx context.Context, userID, categoryID int64, before time.Time) error {
 	query := `
 		UPDATE entries
-		SET status=$1
+		SET status=$1, changed_at=now(), read_at=now()
 		WHERE
 		user_id=$2 AND status=$3 AND published_at < $4 AND feed_id IN (SELECT id FROM feeds WHERE user_id=$2 AND category_id=$5)
+		RETURNING id
 	`
 
-	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, categoryID)
+	count, err := s.markAsRead(ctx, userID, query, model.EntryStatusRead, userID, model.EntryStatusUnread, before, categoryID)
 	if err != nil {
 		return fmt.Errorf("unable to mark category entries as read: %v", err)
 	}
 
-	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkCategoryAsRead] %d items marked as read", count)
-
 	return nil
 }
 
 // EntryURLExists returns true if an entry with this URL already exists.
-func (s *Storage) EntryURLExists(userID int64, entryURL string) bool {
+func (s *Storage) EntryURLExists(ctx context.Context, userID int64, entryURL string) bool {
 	var result int
 	query := `SELECT count(*) as c FROM entries WHERE user_id=$1 AND url=$2`
-	s.db.QueryRow(query, userID, entryURL).Scan(&result)
+	s.db.QueryRowContext(ctx, query, userID, entryURL).Scan(&result)
 	return result >= 1
 }
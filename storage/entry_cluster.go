@@ -0,0 +1,89 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/simhash"
+)
+
+// clusterWindow is the publication interval in which the entries of a story are searched.
+const clusterWindow = 48 * time.Hour
+
+// UnclusteredEntries returns the entries without fingerprint, oldest first.
+func (s *Storage) UnclusteredEntries(ctx context.Context, limit int) (model.Entries, error) {
+	query := `
+		SELECT e.id, e.user_id, e.feed_id, e.published_at, e.title, e.content
+		FROM entries e
+		WHERE e.simhash IS NULL AND e.status <> $1
+		ORDER BY e.id ASC
+		LIMIT $2
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, model.EntryStatusRemoved, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch unclustered entries: %v", err)
+	}
+	defer rows.Close()
+
+	entries := make(model.Entries, 0)
+	for rows.Next() {
+		var entry model.Entry
+		if err := rows.Scan(&entry.ID, &entry.UserID, &entry.FeedID, &entry.Date, &entry.Title, &entry.Content); err != nil {
+			return nil, fmt.Errorf("unable to fetch unclustered entry row: %v", err)
+		}
+
+		if err := s.openEntry(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// ClusterEntry stores the fingerprint of an entry and adds the entry to the story of the closest entry
+// published around the same time, the entry starts a new story when no entry is close enough.
+func (s *Storage) ClusterEntry(ctx context.Context, entry *model.Entry, fingerprint uint64) (int64, error) {
+	query := `
+		SELECT coalesce(cluster_id, id), simhash
+		FROM entries
+		WHERE
+			user_id=$1 AND id<>$2 AND simhash IS NOT NULL AND
+			published_at BETWEEN $3 AND $4
+	`
+	rows, err := s.db.QueryContext(ctx, query, entry.UserID, entry.ID, entry.Date.Add(-clusterWindow), entry.Date.Add(clusterWindow))
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch entry fingerprints: %v", err)
+	}
+	defer rows.Close()
+
+	clusterID, bestDistance := entry.ID, simhash.MaxDistance+1
+	for rows.Next() {
+		var candidateClusterID, candidateFingerprint int64
+		if err := rows.Scan(&candidateClusterID, &candidateFingerprint); err != nil {
+			return 0, fmt.Errorf("unable to fetch entry fingerprint row: %v", err)
+		}
+
+		if distance := simhash.Distance(fingerprint, uint64(candidateFingerprint)); distance < bestDistance {
+			clusterID, bestDistance = candidateClusterID, distance
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("unable to fetch entry fingerprints: %v", err)
+	}
+
+	query = `UPDATE entries SET simhash=$1, cluster_id=$2 WHERE id=$3`
+	if _, err := s.db.ExecContext(ctx, query, int64(fingerprint), clusterID, entry.ID); err != nil {
+		return 0, fmt.Errorf("unable to update entry cluster: %v", err)
+	}
+
+	return clusterID, nil
+}
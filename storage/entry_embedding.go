@@ -0,0 +1,144 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"miniflux.app/embedding"
+	"miniflux.app/model"
+)
+
+// maxSearchedEmbeddings is the number of most recent entries compared to the query of a semantic search,
+// the vectors are compared one by one in memory.
+const maxSearchedEmbeddings = 20000
+
+// EntriesWithoutEmbedding returns the entries without vector computed by the given model.
+// The most recent entries are processed first, they are the ones most likely searched.
+func (s *Storage) EntriesWithoutEmbedding(ctx context.Context, modelName string, limit int) (model.Entries, error) {
+	query := `
+		SELECT e.id, e.user_id, e.feed_id, e.url, e.title, e.content
+		FROM entries e
+		LEFT JOIN entry_embeddings v ON v.entry_id=e.id AND v.model=$2
+		WHERE v.entry_id IS NULL AND e.status <> $1
+		ORDER BY e.id DESC
+		LIMIT $3
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, model.EntryStatusRemoved, modelName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch entries without embedding: %v", err)
+	}
+	defer rows.Close()
+
+	entries := make(model.Entries, 0)
+	for rows.Next() {
+		var entry model.Entry
+		if err := rows.Scan(&entry.ID, &entry.UserID, &entry.FeedID, &entry.URL, &entry.Title, &entry.Content); err != nil {
+			return nil, fmt.Errorf("unable to fetch entry without embedding row: %v", err)
+		}
+
+		if err := s.openEntry(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// SaveEntryEmbedding stores the vector of an entry, it replaces the vector computed by another model.
+func (s *Storage) SaveEntryEmbedding(ctx context.Context, entry *model.Entry, modelName string, vector []float32) error {
+	query := `
+		INSERT INTO entry_embeddings (entry_id, user_id, model, vector)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (entry_id) DO UPDATE SET model=EXCLUDED.model, vector=EXCLUDED.vector, created_at=now()
+	`
+	if _, err := s.db.ExecContext(ctx, query, entry.ID, entry.UserID, modelName, embedding.Encode(vector)); err != nil {
+		return fmt.Errorf("unable to save embedding of entry #%d: %v", entry.ID, err)
+	}
+
+	return nil
+}
+
+// SemanticSearch returns the entries of the user whose vectors are the closest to the vector of the query, closest first.
+func (s *Storage) SemanticSearch(ctx context.Context, userID int64, modelName string, vector []float32, limit int) (model.Entries, error) {
+	query := `
+		SELECT v.entry_id, v.vector
+		FROM entry_embeddings v
+		JOIN entries e ON e.id=v.entry_id
+		JOIN feeds f ON f.id=e.feed_id
+		WHERE v.user_id=$1 AND v.model=$2 AND e.status<>$3 AND f.deleted_at IS NULL
+		ORDER BY e.published_at DESC
+		LIMIT $4
+	`
+	rows, err := s.reader(userID).QueryContext(ctx, query, userID, modelName, model.EntryStatusRemoved, maxSearchedEmbeddings)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch entry embeddings: %v", err)
+	}
+	defer rows.Close()
+
+	type match struct {
+		entryID    int64
+		similarity float64
+	}
+
+	// Only the best matches are kept while reading the rows, sorted by decreasing similarity.
+	var matches []match
+	for rows.Next() {
+		var entryID int64
+		var data []byte
+		if err := rows.Scan(&entryID, &data); err != nil {
+			return nil, fmt.Errorf("unable to fetch entry embedding row: %v", err)
+		}
+
+		similarity := embedding.Similarity(vector, embedding.Decode(data))
+		if len(matches) == limit && similarity <= matches[limit-1].similarity {
+			continue
+		}
+
+		i := sort.Search(len(matches), func(i int) bool { return matches[i].similarity < similarity })
+		matches = append(matches, match{})
+		copy(matches[i+1:], matches[i:])
+		matches[i] = match{entryID, similarity}
+		if len(matches) > limit {
+			matches = matches[:limit]
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to fetch entry embeddings: %v", err)
+	}
+
+	if len(matches) == 0 {
+		return model.Entries{}, nil
+	}
+
+	entryIDs := make([]int64, len(matches))
+	for i, m := range matches {
+		entryIDs[i] = m.entryID
+	}
+
+	entries, err := s.NewEntryQueryBuilder(userID).WithEntryIDs(entryIDs).GetEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*model.Entry, len(entries))
+	for _, entry := range entries {
+		byID[entry.ID] = entry
+	}
+
+	results := make(model.Entries, 0, len(entries))
+	for _, entryID := range entryIDs {
+		if entry, found := byID[entryID]; found {
+			results = append(results, entry)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/encryption"
+	"miniflux.app/model"
+)
+
+// AddEntryKeyring encrypts the titles, contents and summaries of the entries stored from now on.
+// The search and the title similarity of related entries do not work with encrypted entries.
+func (s *Storage) AddEntryKeyring(keyring *encryption.Keyring) {
+	s.keyring = keyring
+}
+
+// sealedEntry contains the columns of an entry as they are stored in the database.
+type sealedEntry struct {
+	title   string
+	content string
+	summary string
+
+	// searchText is indexed in document_vectors, it is empty when the entry is encrypted.
+	searchText string
+}
+
+// sealEntry returns the title, the content and the summary of the entry encrypted for its user.
+func (s *Storage) sealEntry(entry *model.Entry) (*sealedEntry, error) {
+	if s.keyring == nil {
+		return &sealedEntry{title: entry.Title, content: entry.Content, summary: entry.Summary, searchText: entry.Title + " " + entry.Content}, nil
+	}
+
+	title, err := s.keyring.Encrypt(entry.UserID, entry.Title)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt title of entry %q: %v", entry.URL, err)
+	}
+
+	content, err := s.keyring.Encrypt(entry.UserID, entry.Content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt content of entry %q: %v", entry.URL, err)
+	}
+
+	// Entries without summary keep an empty column.
+	summary := entry.Summary
+	if summary != "" {
+		summary, err = s.keyring.Encrypt(entry.UserID, summary)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt summary of entry %q: %v", entry.URL, err)
+		}
+	}
+
+	return &sealedEntry{title: title, content: content, summary: summary}, nil
+}
+
+// openEntry decrypts the title, the content and the summary of an entry read from the database.
+// Entries stored before the encryption was enabled are not changed.
+func (s *Storage) openEntry(entry *model.Entry) error {
+	if !encryption.IsEncrypted(entry.Title) && !encryption.IsEncrypted(entry.Content) && !encryption.IsEncrypted(entry.Summary) {
+		return nil
+	}
+
+	if s.keyring == nil {
+		return fmt.Errorf("entry #%d is encrypted but no encryption key is configured", entry.ID)
+	}
+
+	title, err := s.keyring.Decrypt(entry.UserID, entry.Title)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt title of entry #%d: %v", entry.ID, err)
+	}
+
+	content, err := s.keyring.Decrypt(entry.UserID, entry.Content)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt content of entry #%d: %v", entry.ID, err)
+	}
+
+	summary, err := s.keyring.Decrypt(entry.UserID, entry.Summary)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt summary of entry #%d: %v", entry.ID, err)
+	}
+
+	entry.Title = title
+	entry.Content = content
+	entry.Summary = summary
+	return nil
+}
@@ -5,22 +5,23 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
-	"time"
 
 	"miniflux.app/model"
-	"miniflux.app/timer"
 )
 
 // EntryPaginationBuilder is a builder for entry prev/next queries.
 type EntryPaginationBuilder struct {
 	store      *Storage
+	userID     int64
 	conditions []string
 	args       []interface{}
 	entryID    int64
 	direction  string
+	priority   bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
@@ -52,6 +53,16 @@ func (e *EntryPaginationBuilder) WithCategoryID(categoryID int64) {
 	}
 }
 
+// WithoutMutedFeeds excludes entries of feeds that are currently muted.
+func (e *EntryPaginationBuilder) WithoutMutedFeeds() {
+	e.conditions = append(e.conditions, "(f.muted_until IS NULL OR f.muted_until <= now())")
+}
+
+// WithoutHiddenNSFW excludes the NSFW entries of the users hiding them.
+func (e *EntryPaginationBuilder) WithoutHiddenNSFW() {
+	e.conditions = append(e.conditions, hiddenNSFWCondition)
+}
+
 // WithStatus adds status to the condition.
 func (e *EntryPaginationBuilder) WithStatus(status string) {
 	if status != "" {
@@ -60,26 +71,31 @@ func (e *EntryPaginationBuilder) WithStatus(status string) {
 	}
 }
 
+// WithPriorityOrder follows the order of entry lists sorted by feed priority.
+func (e *EntryPaginationBuilder) WithPriorityOrder() {
+	e.priority = true
+}
+
 // Entries returns previous and next entries.
-func (e *EntryPaginationBuilder) Entries() (*model.Entry, *model.Entry, error) {
-	tx, err := e.store.db.Begin()
+func (e *EntryPaginationBuilder) Entries(ctx context.Context) (*model.Entry, *model.Entry, error) {
+	tx, err := e.store.reader(e.userID).BeginTx(ctx, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("begin transaction for entry pagination: %v", err)
 	}
 
-	prevID, nextID, err := e.getPrevNextID(tx)
+	prevID, nextID, err := e.getPrevNextID(ctx, tx)
 	if err != nil {
 		tx.Rollback()
 		return nil, nil, err
 	}
 
-	prevEntry, err := e.getEntry(tx, prevID)
+	prevEntry, err := e.getEntry(ctx, tx, prevID)
 	if err != nil {
 		tx.Rollback()
 		return nil, nil, err
 	}
 
-	nextEntry, err := e.getEntry(tx, nextID)
+	nextEntry, err := e.getEntry(ctx, tx, nextID)
 	if err != nil {
 		tx.Rollback()
 		return nil, nil, err
@@ -94,30 +110,38 @@ func (e *EntryPaginationBuilder) Entries() (*model.Entry, *model.Entry, error) {
 	return prevEntry, nextEntry, nil
 }
 
-func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID int64, err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EntryPaginationBuilder] %v, %v", e.conditions, e.args))
-
+func (e *EntryPaginationBuilder) getPrevNextID(ctx context.Context, tx *transaction) (prevID int64, nextID int64, err error) {
 	cte := `
 		WITH entry_pagination AS (
 			SELECT
 				e.id,
-				lag(e.id) over (order by e.published_at asc, e.id desc) as prev_id,
-				lead(e.id) over (order by e.published_at asc, e.id desc) as next_id
+				lag(e.id) over (order by %[1]s) as prev_id,
+				lead(e.id) over (order by %[1]s) as next_id
 			FROM entries AS e
 			LEFT JOIN feeds AS f ON f.id=e.feed_id
-			WHERE %s
-			ORDER BY e.published_at asc, e.id desc
+			WHERE %[2]s
+			ORDER BY %[1]s
 		)
-		SELECT prev_id, next_id FROM entry_pagination AS ep WHERE %s;
+		SELECT prev_id, next_id FROM entry_pagination AS ep WHERE %[3]s;
 	`
 
+	sorting := "e.published_at asc, e.id desc"
+	if e.priority {
+		// The list is reversed for the descending direction, high priority entries must still come first.
+		priorityDirection := "desc"
+		if e.direction == "desc" {
+			priorityDirection = "asc"
+		}
+		sorting = fmt.Sprintf("%s %s, %s", feedPriorityRank, priorityDirection, sorting)
+	}
+
 	subCondition := strings.Join(e.conditions, " AND ")
 	finalCondition := fmt.Sprintf("ep.id = $%d", len(e.args)+1)
-	query := fmt.Sprintf(cte, subCondition, finalCondition)
+	query := fmt.Sprintf(cte, sorting, subCondition, finalCondition)
 	e.args = append(e.args, e.entryID)
 
 	var pID, nID sql.NullInt64
-	err = tx.QueryRow(query, e.args...).Scan(&pID, &nID)
+	err = tx.QueryRowContext(ctx, query, e.args...).Scan(&pID, &nID)
 	switch {
 	case err == sql.ErrNoRows:
 		return 0, 0, nil
@@ -136,10 +160,10 @@ func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID
 	return prevID, nextID, nil
 }
 
-func (e *EntryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Entry, error) {
-	var entry model.Entry
+func (e *EntryPaginationBuilder) getEntry(ctx context.Context, tx *transaction, entryID int64) (*model.Entry, error) {
+	entry := model.Entry{UserID: e.userID}
 
-	err := tx.QueryRow(`SELECT id, title FROM entries WHERE id = $1`, entryID).Scan(
+	err := tx.QueryRowContext(ctx, `SELECT id, title FROM entries WHERE id = $1`, entryID).Scan(
 		&entry.ID,
 		&entry.Title,
 	)
@@ -151,6 +175,10 @@ func (e *EntryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Ent
 		return nil, fmt.Errorf("fetching sibling entry: %v", err)
 	}
 
+	if err := e.store.openEntry(&entry); err != nil {
+		return nil, err
+	}
+
 	return &entry, nil
 }
 
@@ -158,8 +186,9 @@ func (e *EntryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Ent
 func NewEntryPaginationBuilder(store *Storage, userID, entryID int64, direction string) *EntryPaginationBuilder {
 	return &EntryPaginationBuilder{
 		store:      store,
+		userID:     userID,
 		args:       []interface{}{userID, "removed"},
-		conditions: []string{"e.user_id = $1", "e.status <> $2"},
+		conditions: []string{"e.user_id = $1", "e.status <> $2", "f.deleted_at IS NULL"},
 		entryID:    entryID,
 		direction:  direction,
 	}
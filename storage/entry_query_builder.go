@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -12,19 +13,29 @@ import (
 	"github.com/lib/pq"
 
 	"miniflux.app/model"
-	"miniflux.app/timer"
 	"miniflux.app/timezone"
 )
 
+// feedPriorityRank ranks the priority of the feed joined as "f", higher ranks come first.
+const feedPriorityRank = `CASE f.priority WHEN 'high' THEN 2 WHEN 'low' THEN 0 ELSE 1 END`
+
+// hiddenNSFWCondition excludes the entries joined as "e" flagged as NSFW, directly or through the feed joined as "f"
+// and its category, when their user hides them.
+const hiddenNSFWCondition = `(NOT (e.nsfw OR f.nsfw OR f.category_id IN (SELECT id FROM categories WHERE nsfw IS TRUE))
+	OR NOT EXISTS (SELECT 1 FROM users hu WHERE hu.id=e.user_id AND hu.nsfw_display='hide'))`
+
 // EntryQueryBuilder builds a SQL query to fetch entries.
 type EntryQueryBuilder struct {
 	store      *Storage
+	userID     int64
 	args       []interface{}
 	conditions []string
 	order      string
 	direction  string
+	seed       int64
 	limit      int
 	offset     int
+	priority   bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
@@ -42,6 +53,45 @@ func (e *EntryQueryBuilder) WithStarred() *EntryQueryBuilder {
 	return e
 }
 
+// WithoutMutedFeeds excludes entries of feeds that are currently muted.
+func (e *EntryQueryBuilder) WithoutMutedFeeds() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "(f.muted_until IS NULL OR f.muted_until <= now())")
+	return e
+}
+
+// WithoutHiddenNSFW excludes the NSFW entries of the users hiding them.
+func (e *EntryQueryBuilder) WithoutHiddenNSFW() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, hiddenNSFWCondition)
+	return e
+}
+
+// WithoutStarred adds a filter to exclude starred entries.
+func (e *EntryQueryBuilder) WithoutStarred() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "e.starred is false")
+	return e
+}
+
+// WithMinScore adds a condition score >= minScore.
+func (e *EntryQueryBuilder) WithMinScore(minScore float64) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.score >= $%d", len(e.args)+1))
+	e.args = append(e.args, minScore)
+	return e
+}
+
+// WithMinJunkScore adds a condition junk_score >= minJunkScore.
+func (e *EntryQueryBuilder) WithMinJunkScore(minJunkScore int) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.junk_score >= $%d", len(e.args)+1))
+	e.args = append(e.args, minJunkScore)
+	return e
+}
+
+// WithMaxScore adds a condition score <= maxScore.
+func (e *EntryQueryBuilder) WithMaxScore(maxScore float64) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.score <= $%d", len(e.args)+1))
+	e.args = append(e.args, maxScore)
+	return e
+}
+
 // BeforeDate adds a condition < published_at
 func (e *EntryQueryBuilder) BeforeDate(date time.Time) *EntryQueryBuilder {
 	e.conditions = append(e.conditions, fmt.Sprintf("e.published_at < $%d", len(e.args)+1))
@@ -56,6 +106,13 @@ func (e *EntryQueryBuilder) AfterDate(date time.Time) *EntryQueryBuilder {
 	return e
 }
 
+// ChangedAfter adds a condition > changed_at
+func (e *EntryQueryBuilder) ChangedAfter(date time.Time) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.changed_at > $%d", len(e.args)+1))
+	e.args = append(e.args, date)
+	return e
+}
+
 // BeforeEntryID adds a condition < entryID.
 func (e *EntryQueryBuilder) BeforeEntryID(entryID int64) *EntryQueryBuilder {
 	if entryID != 0 {
@@ -81,6 +138,13 @@ func (e *EntryQueryBuilder) WithEntryIDs(entryIDs []int64) *EntryQueryBuilder {
 	return e
 }
 
+// WithClusterIDs adds a condition to fetch only the entries of the given stories.
+func (e *EntryQueryBuilder) WithClusterIDs(clusterIDs []int64) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("coalesce(e.cluster_id, e.id) = ANY($%d)", len(e.args)+1))
+	e.args = append(e.args, pq.Array(clusterIDs))
+	return e
+}
+
 // WithEntryID set the entryID.
 func (e *EntryQueryBuilder) WithEntryID(entryID int64) *EntryQueryBuilder {
 	if entryID != 0 {
@@ -108,6 +172,13 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	return e
 }
 
+// WithCategoryIDs adds a condition to fetch only the entries of the given categories.
+func (e *EntryQueryBuilder) WithCategoryIDs(categoryIDs []int64) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("f.category_id = ANY($%d)", len(e.args)+1))
+	e.args = append(e.args, pq.Array(categoryIDs))
+	return e
+}
+
 // WithStatus set the entry status.
 func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -117,6 +188,15 @@ func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	return e
 }
 
+// WithStatuses set the list of entry statuses.
+func (e *EntryQueryBuilder) WithStatuses(statuses []string) *EntryQueryBuilder {
+	if len(statuses) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.status = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Array(statuses))
+	}
+	return e
+}
+
 // WithoutStatus set the entry status that should not be returned.
 func (e *EntryQueryBuilder) WithoutStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -132,6 +212,18 @@ func (e *EntryQueryBuilder) WithOrder(order string) *EntryQueryBuilder {
 	return e
 }
 
+// WithPriorityOrder sorts entries of high priority feeds first, the regular order applies within a priority.
+func (e *EntryQueryBuilder) WithPriorityOrder() *EntryQueryBuilder {
+	e.priority = true
+	return e
+}
+
+// WithSeed set the seed used by the random sorting order.
+func (e *EntryQueryBuilder) WithSeed(seed int64) *EntryQueryBuilder {
+	e.seed = seed
+	return e
+}
+
 // WithDirection set the sorting direction.
 func (e *EntryQueryBuilder) WithDirection(direction string) *EntryQueryBuilder {
 	e.direction = direction
@@ -151,13 +243,11 @@ func (e *EntryQueryBuilder) WithOffset(offset int) *EntryQueryBuilder {
 }
 
 // CountEntries count the number of entries that match the condition.
-func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
+func (e *EntryQueryBuilder) CountEntries(ctx context.Context) (count int, err error) {
 	query := `SELECT count(*) FROM entries e LEFT JOIN feeds f ON f.id=e.feed_id WHERE %s`
 	condition := e.buildCondition()
 
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EntryQueryBuilder:CountEntries] %s, args=%v", condition, e.args))
-
-	err = e.store.db.QueryRow(fmt.Sprintf(query, condition), e.args...).Scan(&count)
+	err = e.store.reader(e.userID).QueryRowContext(ctx, fmt.Sprintf(query, condition), e.args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("unable to count entries: %v", err)
 	}
@@ -165,10 +255,48 @@ func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
 	return count, nil
 }
 
+// CountEntriesByDay returns the number of entries that match the condition for each publication day in the user timezone.
+func (e *EntryQueryBuilder) CountEntriesByDay(ctx context.Context) (model.EntryDays, error) {
+	query := `
+		SELECT
+		to_char(e.published_at at time zone u.timezone, 'YYYY-MM-DD') as day, count(*)
+		FROM entries e
+		LEFT JOIN feeds f ON f.id=e.feed_id
+		LEFT JOIN users u ON u.id=e.user_id
+		WHERE %s
+		GROUP BY day
+		ORDER BY day %s
+	`
+
+	direction := e.direction
+	if direction == "" {
+		direction = "desc"
+	}
+
+	condition := e.buildCondition()
+	rows, err := e.store.reader(e.userID).QueryContext(ctx, fmt.Sprintf(query, condition, direction), e.args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to count entries by day: %v", err)
+	}
+	defer rows.Close()
+
+	days := make(model.EntryDays, 0)
+	for rows.Next() {
+		var day model.EntryDay
+		if err := rows.Scan(&day.Day, &day.Count); err != nil {
+			return nil, fmt.Errorf("unable to fetch entry day row: %v", err)
+		}
+
+		days = append(days, &day)
+	}
+
+	return days, nil
+}
+
 // GetEntry returns a single entry that match the condition.
-func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
+func (e *EntryQueryBuilder) GetEntry(ctx context.Context) (*model.Entry, error) {
 	e.limit = 1
-	entries, err := e.GetEntries()
+	entries, err := e.GetEntries(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +305,7 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, nil
 	}
 
-	entries[0].Enclosures, err = e.store.GetEnclosures(entries[0].ID)
+	entries[0].Enclosures, err = e.store.GetEnclosures(ctx, entries[0].ID)
 	if err != nil {
 		return nil, err
 	}
@@ -193,13 +321,13 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 }
 
 // GetEntries returns a list of entries that match the condition.
-func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
+func (e *EntryQueryBuilder) GetEntries(ctx context.Context) (model.Entries, error) {
 	query := `
 		SELECT
-		e.id, e.user_id, e.feed_id, e.hash, e.published_at at time zone u.timezone, e.title,
-		e.url, e.comments_url, e.author, e.content, e.status, e.starred,
+		e.id, e.user_id, e.feed_id, e.hash, e.published_at at time zone u.timezone, e.changed_at, e.read_at, e.title,
+		e.url, e.comments_url, e.author, e.content, e.summary, e.status, e.starred, e.score, e.junk_score, e.nsfw, coalesce(e.cluster_id, e.id),
 		f.title as feed_title, f.feed_url, f.site_url, f.checked_at,
-		f.category_id, c.title as category_title, f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
+		f.category_id, c.title as category_title, f.scraper_rules, f.rewrite_rules, f.crawler, f.scraper_max_pages, f.entry_open_mode, f.image_display, c.image_display, f.nsfw, c.nsfw, f.priority, f.user_agent,
 		fi.icon_id,
 		u.timezone
 		FROM entries e
@@ -214,9 +342,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 	sorting := e.buildSorting()
 	query = fmt.Sprintf(query, condition, sorting)
 
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EntryQueryBuilder:GetEntries] %s, args=%v, sorting=%s", condition, e.args, sorting))
-
-	rows, err := e.store.db.Query(query, e.args...)
+	rows, err := e.store.reader(e.userID).QueryContext(ctx, query, e.args...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get entries: %v", err)
 	}
@@ -238,13 +364,20 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.FeedID,
 			&entry.Hash,
 			&entry.Date,
+			&entry.ChangedAt,
+			&entry.ReadAt,
 			&entry.Title,
 			&entry.URL,
 			&entry.CommentsURL,
 			&entry.Author,
 			&entry.Content,
+			&entry.Summary,
 			&entry.Status,
 			&entry.Starred,
+			&entry.Score,
+			&entry.JunkScore,
+			&entry.NSFW,
+			&entry.ClusterID,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -254,6 +387,13 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.ScraperRules,
 			&entry.Feed.RewriteRules,
 			&entry.Feed.Crawler,
+			&entry.Feed.ScraperMaxPages,
+			&entry.Feed.EntryOpenMode,
+			&entry.Feed.ImageDisplay,
+			&entry.Feed.Category.ImageDisplay,
+			&entry.Feed.NSFW,
+			&entry.Feed.Category.NSFW,
+			&entry.Feed.Priority,
 			&entry.Feed.UserAgent,
 			&iconID,
 			&tz,
@@ -263,6 +403,10 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			return nil, fmt.Errorf("unable to fetch entry row: %v", err)
 		}
 
+		if err := e.store.openEntry(&entry); err != nil {
+			return nil, err
+		}
+
 		if iconID == nil {
 			entry.Feed.Icon.IconID = 0
 		} else {
@@ -271,6 +415,11 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 
 		// Make sure that timestamp fields contains timezone information (API)
 		entry.Date = timezone.Convert(tz, entry.Date)
+		entry.ChangedAt = timezone.Convert(tz, entry.ChangedAt)
+		if entry.ReadAt != nil {
+			readAt := timezone.Convert(tz, *entry.ReadAt)
+			entry.ReadAt = &readAt
+		}
 		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
 
 		entry.Feed.ID = entry.FeedID
@@ -284,16 +433,14 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 }
 
 // GetEntryIDs returns a list of entry IDs that match the condition.
-func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
+func (e *EntryQueryBuilder) GetEntryIDs(ctx context.Context) ([]int64, error) {
 	query := `SELECT e.id FROM entries e LEFT JOIN feeds f ON f.id=e.feed_id WHERE %s %s`
 
 	condition := e.buildCondition()
 	query = fmt.Sprintf(query, condition, e.buildSorting())
 	// log.Println(query)
 
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EntryQueryBuilder:GetEntryIDs] condition=%s, args=%v", condition, e.args))
-
-	rows, err := e.store.db.Query(query, e.args...)
+	rows, err := e.store.reader(e.userID).QueryContext(ctx, query, e.args...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get entries: %v", err)
 	}
@@ -321,8 +468,22 @@ func (e *EntryQueryBuilder) buildCondition() string {
 func (e *EntryQueryBuilder) buildSorting() string {
 	var parts []string
 
-	if e.order != "" {
-		parts = append(parts, fmt.Sprintf(`ORDER BY "%s"`, e.order))
+	orderBy := `ORDER BY`
+	if e.priority {
+		orderBy = fmt.Sprintf(`ORDER BY %s DESC,`, feedPriorityRank)
+	}
+
+	switch e.order {
+	case "":
+	case "feed_title":
+		parts = append(parts, orderBy, `f.title`)
+	case "score":
+		parts = append(parts, orderBy, `e.score`)
+	case "random":
+		// Hashing the entry ID with a seed gives a stable random order across pages.
+		parts = append(parts, orderBy, fmt.Sprintf(`md5(e.id::text || '-%d')`, e.seed))
+	default:
+		parts = append(parts, orderBy, fmt.Sprintf(`"%s"`, e.order))
 	}
 
 	if e.direction != "" {
@@ -344,7 +505,8 @@ func (e *EntryQueryBuilder) buildSorting() string {
 func NewEntryQueryBuilder(store *Storage, userID int64) *EntryQueryBuilder {
 	return &EntryQueryBuilder{
 		store:      store,
+		userID:     userID,
 		args:       []interface{}{userID},
-		conditions: []string{"e.user_id = $1"},
+		conditions: []string{"e.user_id = $1", "f.deleted_at IS NULL"},
 	}
 }
@@ -0,0 +1,146 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// readSnapshotWindow is how long a bulk mark as read operation can be reverted.
+const readSnapshotWindow = "10 minutes"
+
+// markAsRead runs an update query returning the IDs of the entries marked as read
+// and keeps these IDs as the last operation of the user that can be reverted.
+func (s *Storage) markAsRead(ctx context.Context, userID int64, query string, args ...interface{}) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	var entryIDs []int64
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return 0, err
+		}
+
+		entryIDs = append(entryIDs, entryID)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	// An operation that does nothing, like a second tap on the button, keeps the previous snapshot.
+	if len(entryIDs) > 0 {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM entry_read_snapshots WHERE user_id=$1`, userID); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO entry_read_snapshots (user_id, entry_ids) VALUES ($1, $2)`,
+			userID,
+			pq.Array(entryIDs),
+		)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	s.entriesChanged(userID)
+	return len(entryIDs), nil
+}
+
+// CanUndoMarkAsRead returns true if the last bulk mark as read operation of the user can be reverted.
+func (s *Storage) CanUndoMarkAsRead(ctx context.Context, userID int64) bool {
+	var result int
+	query := fmt.Sprintf(
+		`SELECT count(*) FROM entry_read_snapshots WHERE user_id=$1 AND created_at > now() - interval '%s'`,
+		readSnapshotWindow,
+	)
+	s.db.QueryRowContext(ctx, query, userID).Scan(&result)
+	return result >= 1
+}
+
+// UndoMarkAsRead sets back to unread the entries of the last bulk mark as read operation.
+// Entries read or removed since then are left untouched, it returns the number of entries restored.
+func (s *Storage) UndoMarkAsRead(ctx context.Context, userID int64) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	var entryIDs []int64
+	query := fmt.Sprintf(
+		`DELETE FROM entry_read_snapshots WHERE user_id=$1 AND created_at > now() - interval '%s' RETURNING entry_ids`,
+		readSnapshotWindow,
+	)
+	err = tx.QueryRowContext(ctx, query, userID).Scan(pq.Array(&entryIDs))
+	switch {
+	case err == sql.ErrNoRows:
+		tx.Rollback()
+		return 0, nil
+	case err != nil:
+		tx.Rollback()
+		return 0, fmt.Errorf("unable to fetch the last mark as read operation: %v", err)
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE entries SET status=$1, changed_at=now(), read_at=NULL WHERE user_id=$2 AND status=$3 AND id=ANY($4)`,
+		model.EntryStatusUnread,
+		userID,
+		model.EntryStatusRead,
+		pq.Array(entryIDs),
+	)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("unable to mark entries as unread: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("unable to undo the last mark as read operation: %v", err)
+	}
+
+	count, _ := result.RowsAffected()
+	s.entriesChanged(userID)
+	return count, nil
+}
+
+// CleanOldReadSnapshots removes the mark as read operations that can no longer be reverted.
+func (s *Storage) CleanOldReadSnapshots(ctx context.Context) int64 {
+	query := fmt.Sprintf(`DELETE FROM entry_read_snapshots WHERE created_at < now() - interval '%s'`, readSnapshotWindow)
+
+	result, err := s.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/model"
+)
+
+// RelatedEntries returns the entries of other articles covering the same story as the given entry:
+// the entries sharing a link with it come first, then the entries with a similar title.
+func (s *Storage) RelatedEntries(ctx context.Context, entry *model.Entry, limit int) (model.Entries, error) {
+	links := entry.Links()
+	if entry.URL != "" {
+		links = append(links, entry.URL)
+	}
+
+	// The title similarity relies on the trigram index, the links are only compared within this user's entries.
+	query := `
+		SELECT e.id
+		FROM entries e
+		JOIN feeds f ON f.id=e.feed_id
+		WHERE
+			e.user_id=$1 AND e.id<>$2 AND e.status<>$3 AND f.deleted_at IS NULL AND
+			(e.title % $4 OR e.url = ANY($5) OR ($6 <> '' AND strpos(e.content, $6) > 0))
+		ORDER BY
+			CASE WHEN e.url = ANY($5) OR ($6 <> '' AND strpos(e.content, $6) > 0) THEN 1 ELSE similarity(e.title, $4) END DESC,
+			e.published_at DESC
+		LIMIT $7
+	`
+	rows, err := s.reader(entry.UserID).QueryContext(ctx, query, entry.UserID, entry.ID, model.EntryStatusRemoved, entry.Title, pq.Array(links), entry.URL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch related entries: %v", err)
+	}
+	defer rows.Close()
+
+	var entryIDs []int64
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, fmt.Errorf("unable to fetch related entry row: %v", err)
+		}
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	if len(entryIDs) == 0 {
+		return model.Entries{}, nil
+	}
+
+	entries, err := s.NewEntryQueryBuilder(entry.UserID).WithEntryIDs(entryIDs).GetEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entries are returned by relevance, the order of the first query.
+	byID := make(map[int64]*model.Entry, len(entries))
+	for _, related := range entries {
+		byID[related.ID] = related
+	}
+
+	related := make(model.Entries, 0, len(entries))
+	for _, entryID := range entryIDs {
+		if e, found := byID[entryID]; found {
+			related = append(related, e)
+		}
+	}
+
+	return related, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+
+	"miniflux.app/blob"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// SaveEntrySnapshot stores the snapshot of an entry web page.
+// A failed snapshot only records the error, the content of the previous snapshot is kept.
+func (s *Storage) SaveEntrySnapshot(ctx context.Context, snapshot *model.EntrySnapshot) error {
+	if snapshot.Error != "" {
+		query := `
+			INSERT INTO entry_snapshots (entry_id, url, error)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (entry_id) DO UPDATE SET error=EXCLUDED.error
+		`
+		if _, err := s.db.ExecContext(ctx, query, snapshot.EntryID, snapshot.URL, snapshot.Error); err != nil {
+			return fmt.Errorf("unable to save entry snapshot: %v", err)
+		}
+		return nil
+	}
+
+	// The content is kept in the blob store, the row only holds the metadata.
+	var content []byte
+	if s.blobs != nil {
+		if err := blob.Put(s.blobs, entrySnapshotBlobKey(snapshot.EntryID), []byte(snapshot.Content), "text/html; charset=utf-8"); err != nil {
+			return fmt.Errorf("unable to store entry snapshot content: %v", err)
+		}
+	} else {
+		var b bytes.Buffer
+		w := gzip.NewWriter(&b)
+		w.Write([]byte(snapshot.Content))
+		if err := w.Close(); err != nil {
+			return fmt.Errorf("unable to compress entry snapshot: %v", err)
+		}
+		content = b.Bytes()
+	}
+
+	query := `
+		INSERT INTO entry_snapshots (entry_id, url, size, error, content, created_at)
+		VALUES ($1, $2, $3, '', $4, now())
+		ON CONFLICT (entry_id) DO UPDATE SET
+			url=EXCLUDED.url, size=EXCLUDED.size, error='', content=EXCLUDED.content, created_at=EXCLUDED.created_at
+		RETURNING created_at
+	`
+	err := s.db.QueryRowContext(ctx, query, snapshot.EntryID, snapshot.URL, snapshot.Size, content).Scan(&snapshot.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to save entry snapshot: %v", err)
+	}
+
+	return nil
+}
+
+// EntrySnapshot returns the snapshot of an entry with its content.
+func (s *Storage) EntrySnapshot(ctx context.Context, userID, entryID int64) (*model.EntrySnapshot, error) {
+	query := `
+		SELECT
+			s.entry_id, s.url, s.size, s.error, s.created_at, s.content
+		FROM entry_snapshots s
+		JOIN entries e ON e.id=s.entry_id
+		WHERE e.user_id=$1 AND s.entry_id=$2
+	`
+
+	var snapshot model.EntrySnapshot
+	var compressed []byte
+	err := s.db.QueryRowContext(ctx, query, userID, entryID).Scan(
+		&snapshot.EntryID,
+		&snapshot.URL,
+		&snapshot.Size,
+		&snapshot.Error,
+		&snapshot.CreatedAt,
+		&compressed,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch entry snapshot: %v", err)
+	}
+
+	if snapshot.Size == 0 {
+		return &snapshot, nil
+	}
+
+	// Snapshots taken before the blob store was configured keep their content in the database.
+	if len(compressed) == 0 {
+		s.loadEntrySnapshotContent(&snapshot)
+		return &snapshot, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress entry snapshot: %v", err)
+	}
+	defer r.Close()
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress entry snapshot: %v", err)
+	}
+
+	snapshot.Content = string(content)
+	return &snapshot, nil
+}
+
+// StarredEntriesWithoutSnapshot returns the starred entries that were never snapshotted, with the scraper settings of their feed.
+func (s *Storage) StarredEntriesWithoutSnapshot(ctx context.Context, limit int) (model.Entries, error) {
+	query := `
+		SELECT
+			e.id, e.user_id, e.feed_id, e.url, f.scraper_rules, f.rewrite_rules, f.user_agent
+		FROM entries e
+		JOIN feeds f ON f.id=e.feed_id
+		WHERE e.starred='t' AND e.url <> '' AND f.feed_url <> $1
+		AND NOT EXISTS (SELECT 1 FROM entry_snapshots s WHERE s.entry_id=e.id)
+		ORDER BY e.changed_at ASC
+		LIMIT $2
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, model.SavedPagesFeedURL, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch starred entries without snapshot: %v", err)
+	}
+	defer rows.Close()
+
+	entries := make(model.Entries, 0)
+	for rows.Next() {
+		entry := &model.Entry{Feed: &model.Feed{}}
+		err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.FeedID,
+			&entry.URL,
+			&entry.Feed.ScraperRules,
+			&entry.Feed.RewriteRules,
+			&entry.Feed.UserAgent,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch starred entries without snapshot row: %v", err)
+		}
+
+		entry.Feed.ID = entry.FeedID
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// CleanOldEntrySnapshots removes the snapshots of entries that are not starred anymore or that were removed.
+func (s *Storage) CleanOldEntrySnapshots(ctx context.Context) int64 {
+	query := `
+		DELETE FROM entry_snapshots s
+		WHERE NOT EXISTS (SELECT 1 FROM entries e WHERE e.id=s.entry_id AND e.starred='t')
+		RETURNING s.entry_id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		logger.Error(`[Storage:CleanOldEntrySnapshots] %v`, err)
+		return 0
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			logger.Error(`[Storage:CleanOldEntrySnapshots] %v`, err)
+			continue
+		}
+
+		if s.blobs != nil {
+			if err := s.blobs.Delete(entrySnapshotBlobKey(entryID)); err != nil {
+				logger.Error(`[Storage:CleanOldEntrySnapshots] %v`, err)
+			}
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func entrySnapshotBlobKey(entryID int64) string {
+	return fmt.Sprintf("snapshots/%d", entryID)
+}
+
+// loadEntrySnapshotContent reads the content of snapshots stored outside of the database.
+func (s *Storage) loadEntrySnapshotContent(snapshot *model.EntrySnapshot) {
+	if s.blobs == nil {
+		return
+	}
+
+	object, err := s.blobs.Get(entrySnapshotBlobKey(snapshot.EntryID))
+	if err != nil {
+		logger.Error("[Storage:EntrySnapshot] Unable to load snapshot of entry #%d: %v", snapshot.EntryID, err)
+		return
+	}
+	defer object.Close()
+
+	content, err := ioutil.ReadAll(object)
+	if err != nil {
+		logger.Error("[Storage:EntrySnapshot] Unable to load snapshot of entry #%d: %v", snapshot.EntryID, err)
+		return
+	}
+
+	snapshot.Content = string(content)
+}
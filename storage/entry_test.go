@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+// recorder is a database connection keeping the executed queries, the queries return no rows.
+type recorder struct {
+	mu      sync.Mutex
+	queries []recordedQuery
+}
+
+type recordedQuery struct {
+	query string
+	args  []driver.Value
+}
+
+func (r *recorder) Connect(ctx context.Context) (driver.Conn, error) { return r, nil }
+func (r *recorder) Driver() driver.Driver                            { return r }
+func (r *recorder) Open(name string) (driver.Conn, error)            { return r, nil }
+func (r *recorder) Close() error                                     { return nil }
+func (r *recorder) Begin() (driver.Tx, error)                        { return r, nil }
+func (r *recorder) Commit() error                                    { return nil }
+func (r *recorder) Rollback() error                                  { return nil }
+
+func (r *recorder) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (r *recorder) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	r.record(query, args)
+	return emptyRows{}, nil
+}
+
+func (r *recorder) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	r.record(query, args)
+	return driver.RowsAffected(0), nil
+}
+
+func (r *recorder) record(query string, args []driver.NamedValue) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	r.mu.Lock()
+	r.queries = append(r.queries, recordedQuery{query, values})
+	r.mu.Unlock()
+}
+
+// find returns the first query containing the given text.
+func (r *recorder) find(text string) *recordedQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.queries {
+		if strings.Contains(r.queries[i].query, text) {
+			return &r.queries[i]
+		}
+	}
+
+	return nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string              { return nil }
+func (emptyRows) Close() error                   { return nil }
+func (emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+func TestTrimFeedEntriesWithoutFeedEntries(t *testing.T) {
+	db := &recorder{}
+	store := NewStorage(sql.OpenDB(db), nil)
+
+	feed := &model.Feed{ID: 1, UserID: 1, MaxEntries: 10, OverflowPolicy: model.OverflowPolicyDelete}
+	if _, err := store.TrimFeedEntries(context.Background(), feed); err != nil {
+		t.Fatal(err)
+	}
+
+	query := db.find("DELETE FROM entries")
+	if query == nil {
+		t.Fatal(`The overflow entries should be deleted`)
+	}
+
+	// A NULL array would match no entry and the overflow would be archived instead of deleted.
+	if hashes := query.args[3]; hashes != "{}" {
+		t.Errorf(`The hashes of the feed entries should be an empty array, got %#v`, hashes)
+	}
+}
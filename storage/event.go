@@ -0,0 +1,145 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// EnableFullEventPayloads embeds the model of the written entities in the sync events,
+// the consumers can rebuild their state without calling the API.
+func (s *Storage) EnableFullEventPayloads() {
+	s.fullEventPayloads = true
+}
+
+// publishEvent sends a sync event with the owner of the entity. With the full payloads, the entity
+// of a write event is embedded, it is loaded from the database when the caller doesn't have it.
+func (s *Storage) publishEvent(ctx context.Context, event gcppubsub.SyncEvent, userID int64, entity interface{}) {
+	event.UserID = userID
+
+	if s.fullEventPayloads && event.EntityOp == gcppubsub.EntityOpWrite {
+		if entity == nil {
+			entity = s.eventEntity(ctx, event)
+		}
+
+		if entity != nil {
+			data, err := json.Marshal(eventPayload(entity))
+			if err != nil {
+				logger.Error("[Storage:publishEvent] Unable to serialize %v: %v", event, err)
+			} else {
+				event.Data = data
+			}
+		}
+	}
+
+	s.pub.PublishEvent(event)
+}
+
+// publishEntryEvents sends a sync event for each entry of the user,
+// with the full payloads the written entries are loaded with a single query.
+func (s *Storage) publishEntryEvents(ctx context.Context, userID int64, entryIDs []int64, op string) {
+	entries := make(map[int64]*model.Entry)
+	if s.fullEventPayloads && op == gcppubsub.EntityOpWrite && len(entryIDs) > 0 {
+		result, err := s.NewEntryQueryBuilder(userID).WithEntryIDs(entryIDs).GetEntries(ctx)
+		if err != nil {
+			logger.Error("[Storage:publishEntryEvents] Unable to load the entries of user #%d: %v", userID, err)
+		}
+
+		for _, entry := range result {
+			entries[entry.ID] = entry
+		}
+	}
+
+	for _, entryID := range entryIDs {
+		var entity interface{}
+		if entry, found := entries[entryID]; found {
+			entity = entry
+		}
+
+		s.publishEvent(ctx, gcppubsub.NewEntryEvent(entryID, op), userID, entity)
+	}
+}
+
+// scanEntryIDs returns the entry identifiers of a RETURNING clause, grouped by user.
+// The query must return the user ID and the entry ID, the rows are closed.
+func scanEntryIDs(rows *sql.Rows) (map[int64][]int64, error) {
+	defer rows.Close()
+
+	entryIDs := make(map[int64][]int64)
+	for rows.Next() {
+		var userID, entryID int64
+		if err := rows.Scan(&userID, &entryID); err != nil {
+			return nil, err
+		}
+
+		entryIDs[userID] = append(entryIDs[userID], entryID)
+	}
+
+	return entryIDs, rows.Err()
+}
+
+// eventEntity returns the current state of the entity of an event, or nil when it can't be loaded.
+func (s *Storage) eventEntity(ctx context.Context, event gcppubsub.SyncEvent) interface{} {
+	var entity interface{}
+	var err error
+
+	switch event.EntityType {
+	case gcppubsub.EntityTypeCategory:
+		var category *model.Category
+		if category, err = s.Category(ctx, event.UserID, event.EntityID); category != nil {
+			entity = category
+		}
+	case gcppubsub.EntityTypeFeed:
+		var feed *model.Feed
+		if feed, err = s.FeedByID(ctx, event.UserID, event.EntityID); feed != nil {
+			entity = feed
+		}
+	case gcppubsub.EntityTypeEntry:
+		var entry *model.Entry
+		if entry, err = s.NewEntryQueryBuilder(event.UserID).WithEntryID(event.EntityID).GetEntry(ctx); entry != nil {
+			entity = entry
+		}
+	case gcppubsub.EntityTypeUser:
+		var user *model.User
+		if user, err = s.UserByID(ctx, event.EntityID); user != nil {
+			entity = user
+		}
+	}
+
+	if err != nil {
+		logger.Error("[Storage:eventEntity] Unable to load the entity of %v: %v", event, err)
+	}
+
+	return entity
+}
+
+// eventPayload returns a copy of the entity without credentials and nested lists of entries.
+func eventPayload(entity interface{}) interface{} {
+	switch value := entity.(type) {
+	case *model.Feed:
+		feed := *value
+		feed.Password = ""
+		feed.Entries = nil
+		return &feed
+	case *model.Entry:
+		entry := *value
+		if entry.Feed != nil {
+			entry.Feed = eventPayload(entry.Feed).(*model.Feed)
+		}
+		return &entry
+	case *model.User:
+		user := *value
+		user.Password = ""
+		return &user
+	}
+
+	return entity
+}
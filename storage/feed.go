@@ -5,41 +5,49 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
-	"time"
 
 	"miniflux.app/model"
-	"miniflux.app/timer"
 	"miniflux.app/timezone"
 	"miniflux.app/integration/gcppubsub"
+
+	"github.com/lib/pq"
 )
 
 // FeedExists checks if the given feed exists.
-func (s *Storage) FeedExists(userID, feedID int64) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:FeedExists] userID=%d, feedID=%d", userID, feedID))
-
+func (s *Storage) FeedExists(ctx context.Context, userID, feedID int64) bool {
 	var result int
-	query := `SELECT count(*) as c FROM feeds WHERE user_id=$1 AND id=$2`
-	s.db.QueryRow(query, userID, feedID).Scan(&result)
+	query := `SELECT count(*) as c FROM feeds WHERE user_id=$1 AND id=$2 AND deleted_at IS NULL`
+	s.db.QueryRowContext(ctx, query, userID, feedID).Scan(&result)
 	return result >= 1
 }
 
 // FeedURLExists checks if feed URL already exists.
-func (s *Storage) FeedURLExists(userID int64, feedURL string) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:FeedURLExists] userID=%d, feedURL=%s", userID, feedURL))
-
+func (s *Storage) FeedURLExists(ctx context.Context, userID int64, feedURL string) bool {
 	var result int
-	query := `SELECT count(*) as c FROM feeds WHERE user_id=$1 AND feed_url=$2`
-	s.db.QueryRow(query, userID, feedURL).Scan(&result)
+	query := `SELECT count(*) as c FROM feeds WHERE user_id=$1 AND feed_url=$2 AND deleted_at IS NULL`
+	s.db.QueryRowContext(ctx, query, userID, feedURL).Scan(&result)
 	return result >= 1
 }
 
+// FeedIDByURL returns the ID of the feed matching the given feed or website URL, zero if the user is not subscribed.
+func (s *Storage) FeedIDByURL(ctx context.Context, userID int64, websiteURL string) int64 {
+	var feedID int64
+	query := `
+		SELECT id FROM feeds
+		WHERE user_id=$1 AND deleted_at IS NULL AND (feed_url=$2 OR rtrim(site_url, '/')=rtrim($2, '/'))
+		ORDER BY id ASC LIMIT 1`
+	s.db.QueryRowContext(ctx, query, userID, websiteURL).Scan(&feedID)
+	return feedID
+}
+
 // CountFeeds returns the number of feeds that belongs to the given user.
-func (s *Storage) CountFeeds(userID int64) int {
+func (s *Storage) CountFeeds(ctx context.Context, userID int64) int {
 	var result int
-	err := s.db.QueryRow(`SELECT count(*) FROM feeds WHERE user_id=$1`, userID).Scan(&result)
+	err := s.reader(userID).QueryRowContext(ctx, `SELECT count(*) FROM feeds WHERE user_id=$1 AND deleted_at IS NULL`, userID).Scan(&result)
 	if err != nil {
 		return 0
 	}
@@ -48,9 +56,9 @@ func (s *Storage) CountFeeds(userID int64) int {
 }
 
 // CountErrorFeeds returns the number of feeds with parse errors that belong to the given user.
-func (s *Storage) CountErrorFeeds(userID int64) int {
+func (s *Storage) CountErrorFeeds(ctx context.Context, userID int64) int {
 	var result int
-	err := s.db.QueryRow(`SELECT count(*) FROM feeds WHERE user_id=$1 AND parsing_error_count>=$2`, userID, maxParsingError).Scan(&result)
+	err := s.reader(userID).QueryRowContext(ctx, `SELECT count(*) FROM feeds WHERE user_id=$1 AND parsing_error_count>=$2 AND deleted_at IS NULL`, userID, maxParsingError).Scan(&result)
 	if err != nil {
 		return 0
 	}
@@ -59,16 +67,15 @@ func (s *Storage) CountErrorFeeds(userID int64) int {
 }
 
 // Feeds returns all feeds of the given user.
-func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:Feeds] userID=%d", userID))
-
+func (s *Storage) Feeds(ctx context.Context, userID int64) (model.Feeds, error) {
 	feeds := make(model.Feeds, 0)
 	query := `SELECT
 		f.id, f.feed_url, f.site_url, f.title, f.etag_header, f.last_modified_header,
 		f.user_id, f.checked_at at time zone u.timezone,
 		f.parsing_error_count, f.parsing_error_msg,
-		f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-		f.username, f.password,
+		f.scraper_rules, f.rewrite_rules, f.script, f.crawler, f.scraper_max_pages, f.entry_open_mode, f.image_display, f.summary_words, f.summarize_entries, f.nsfw, f.priority, f.muted_until, f.mark_read_after_days,
+		f.max_entries, f.overflow_policy, f.entry_matching, f.watch_selector, f.user_agent,
+		f.username, f.password, f.version, f.pending,
 		f.category_id, c.title as category_title,
 		fi.icon_id,
 		u.timezone
@@ -76,10 +83,10 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 		LEFT JOIN categories c ON c.id=f.category_id
 		LEFT JOIN feed_icons fi ON fi.feed_id=f.id
 		LEFT JOIN users u ON u.id=f.user_id
-		WHERE f.user_id=$1
+		WHERE f.user_id=$1 AND f.deleted_at IS NULL
 		ORDER BY f.parsing_error_count DESC, lower(f.title) ASC`
 
-	rows, err := s.db.Query(query, userID)
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch feeds: %v", err)
 	}
@@ -104,10 +111,26 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			&feed.ParsingErrorMsg,
 			&feed.ScraperRules,
 			&feed.RewriteRules,
+			&feed.Script,
 			&feed.Crawler,
+			&feed.ScraperMaxPages,
+			&feed.EntryOpenMode,
+			&feed.ImageDisplay,
+			&feed.SummaryWords,
+			&feed.SummarizeEntries,
+			&feed.NSFW,
+			&feed.Priority,
+			&feed.MutedUntil,
+			&feed.MarkReadAfterDays,
+			&feed.MaxEntries,
+			&feed.OverflowPolicy,
+			&feed.EntryMatching,
+			&feed.WatchSelector,
 			&feed.UserAgent,
 			&feed.Username,
 			&feed.Password,
+			&feed.Version,
+			&feed.Pending,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -123,6 +146,10 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 		}
 
 		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+		if feed.MutedUntil != nil {
+			mutedUntil := timezone.Convert(tz, *feed.MutedUntil)
+			feed.MutedUntil = &mutedUntil
+		}
 		feeds = append(feeds, &feed)
 	}
 
@@ -130,8 +157,10 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 }
 
 // FeedByID returns a feed by the ID.
-func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:FeedByID] feedID=%d", feedID))
+func (s *Storage) FeedByID(ctx context.Context, userID, feedID int64) (*model.Feed, error) {
+	if feed := s.cachedFeed(userID, feedID); feed != nil {
+		return feed, nil
+	}
 
 	var feed model.Feed
 	var iconID interface{}
@@ -143,8 +172,9 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		f.id, f.feed_url, f.site_url, f.title, f.etag_header, f.last_modified_header,
 		f.user_id, f.checked_at at time zone u.timezone,
 		f.parsing_error_count, f.parsing_error_msg,
-		f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-		f.username, f.password,
+		f.scraper_rules, f.rewrite_rules, f.script, f.crawler, f.scraper_max_pages, f.entry_open_mode, f.image_display, f.summary_words, f.summarize_entries, f.nsfw, f.priority, f.muted_until, f.mark_read_after_days,
+		f.max_entries, f.overflow_policy, f.entry_matching, f.watch_selector, f.user_agent,
+		f.username, f.password, f.version, f.pending,
 		f.category_id, c.title as category_title,
 		fi.icon_id,
 		u.timezone
@@ -152,9 +182,9 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		LEFT JOIN categories c ON c.id=f.category_id
 		LEFT JOIN feed_icons fi ON fi.feed_id=f.id
 		LEFT JOIN users u ON u.id=f.user_id
-		WHERE f.user_id=$1 AND f.id=$2`
+		WHERE f.user_id=$1 AND f.id=$2 AND f.deleted_at IS NULL`
 
-	err := s.db.QueryRow(query, userID, feedID).Scan(
+	err := s.db.QueryRowContext(ctx, query, userID, feedID).Scan(
 		&feed.ID,
 		&feed.FeedURL,
 		&feed.SiteURL,
@@ -167,10 +197,26 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.ParsingErrorMsg,
 		&feed.ScraperRules,
 		&feed.RewriteRules,
+		&feed.Script,
 		&feed.Crawler,
+		&feed.ScraperMaxPages,
+		&feed.EntryOpenMode,
+		&feed.ImageDisplay,
+		&feed.SummaryWords,
+		&feed.SummarizeEntries,
+		&feed.NSFW,
+		&feed.Priority,
+		&feed.MutedUntil,
+		&feed.MarkReadAfterDays,
+		&feed.MaxEntries,
+		&feed.OverflowPolicy,
+		&feed.EntryMatching,
+		&feed.WatchSelector,
 		&feed.UserAgent,
 		&feed.Username,
 		&feed.Password,
+		&feed.Version,
+		&feed.Pending,
 		&feed.Category.ID,
 		&feed.Category.Title,
 		&iconID,
@@ -189,20 +235,45 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	}
 
 	feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+	if feed.MutedUntil != nil {
+		mutedUntil := timezone.Convert(tz, *feed.MutedUntil)
+		feed.MutedUntil = &mutedUntil
+	}
+	s.cacheFeed(&feed)
 	return &feed, nil
 }
 
 // CreateFeed creates a new feed.
-func (s *Storage) CreateFeed(feed *model.Feed) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CreateFeed] feedURL=%s", feed.FeedURL))
+func (s *Storage) CreateFeed(ctx context.Context, feed *model.Feed) error {
+	if feed.EntryOpenMode == "" {
+		feed.EntryOpenMode = model.EntryOpenModeContent
+	}
+
+	if feed.Priority == "" {
+		feed.Priority = model.FeedPriorityNormal
+	}
+
+	if feed.OverflowPolicy == "" {
+		feed.OverflowPolicy = model.OverflowPolicyArchive
+	}
+
+	if feed.ScraperMaxPages == 0 {
+		feed.ScraperMaxPages = 1
+	}
+
+	if feed.EntryMatching == "" {
+		feed.EntryMatching = model.EntryMatchingGUID
+	}
+
 	sql := `
 		INSERT INTO feeds
-		(feed_url, site_url, title, category_id, user_id, etag_header, last_modified_header, crawler, user_agent, username, password)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-		RETURNING id
+		(feed_url, site_url, title, category_id, user_id, etag_header, last_modified_header, crawler, entry_open_mode, priority, watch_selector, user_agent, username, password, pending, rewrite_rules, scraper_rules)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING id, version
 	`
 
-	err := s.db.QueryRow(
+	err := s.db.QueryRowContext(
+		ctx,
 		sql,
 		feed.FeedURL,
 		feed.SiteURL,
@@ -212,41 +283,49 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.EtagHeader,
 		feed.LastModifiedHeader,
 		feed.Crawler,
+		feed.EntryOpenMode,
+		feed.Priority,
+		feed.WatchSelector,
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
-	).Scan(&feed.ID)
+		feed.Pending,
+		feed.RewriteRules,
+		feed.ScraperRules,
+	).Scan(&feed.ID, &feed.Version)
 	if err != nil {
 		return fmt.Errorf("unable to create feed %q: %v", feed.FeedURL, err)
 	}
 
 	// Sync feed
-	syncEvent := gcppubsub.NewFeedEvent(feed.ID, gcppubsub.EntityOpWrite)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feed.ID, gcppubsub.EntityOpWrite), feed.UserID, feed)
+	s.webhooks.FeedCreated(feed)
 
 	for i := 0; i < len(feed.Entries); i++ {
 		feed.Entries[i].FeedID = feed.ID
 		feed.Entries[i].UserID = feed.UserID
-		err := s.createEntry(feed.Entries[i])
+		err := s.createEntry(ctx, feed.Entries[i])
 		if err != nil {
 			return err
 		}
 	}
 
+	s.entriesChanged(feed.UserID)
 	return nil
 }
 
-// UpdateFeed updates an existing feed.
-func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UpdateFeed] feedURL=%s", feed.FeedURL))
-
+// UpdateFeed updates an existing feed, ErrConflict is returned when the feed
+// has been changed since its version has been read.
+func (s *Storage) UpdateFeed(ctx context.Context, feed *model.Feed) (err error) {
 	query := `UPDATE feeds SET
 		feed_url=$1, site_url=$2, title=$3, category_id=$4, etag_header=$5, last_modified_header=$6, checked_at=$7,
-		parsing_error_msg=$8, parsing_error_count=$9, scraper_rules=$10, rewrite_rules=$11, crawler=$12, user_agent=$13,
-		username=$14, password=$15
-		WHERE id=$16 AND user_id=$17`
+		parsing_error_msg=$8, parsing_error_count=$9, scraper_rules=$10, rewrite_rules=$11, script=$12, crawler=$13,
+		entry_open_mode=$14, priority=$15, muted_until=$16, mark_read_after_days=$17, max_entries=$18, overflow_policy=$19,
+		entry_matching=$20, watch_selector=$21, user_agent=$22, username=$23, password=$24, scraper_max_pages=$25,
+		image_display=$26, summary_words=$27, summarize_entries=$28, nsfw=$29, version=version+1
+		WHERE id=$30 AND user_id=$31 AND version=$32`
 
-	_, err = s.db.Exec(query,
+	result, err := s.db.ExecContext(ctx, query,
 		feed.FeedURL,
 		feed.SiteURL,
 		feed.Title,
@@ -258,29 +337,115 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.ParsingErrorCount,
 		feed.ScraperRules,
 		feed.RewriteRules,
+		feed.Script,
 		feed.Crawler,
+		feed.EntryOpenMode,
+		feed.Priority,
+		feed.MutedUntil,
+		feed.MarkReadAfterDays,
+		feed.MaxEntries,
+		feed.OverflowPolicy,
+		feed.EntryMatching,
+		feed.WatchSelector,
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
+		feed.ScraperMaxPages,
+		feed.ImageDisplay,
+		feed.SummaryWords,
+		feed.SummarizeEntries,
+		feed.NSFW,
 		feed.ID,
 		feed.UserID,
+		feed.Version,
 	)
 
 	if err != nil {
 		return fmt.Errorf("unable to update feed #%d (%s): %v", feed.ID, feed.FeedURL, err)
 	}
 
+	if count, _ := result.RowsAffected(); count == 0 {
+		return ErrConflict
+	}
+
+	feed.Version++
+	s.feeds.Remove(feed.ID)
+
 	// Sync feed
-	syncEvent := gcppubsub.NewFeedEvent(feed.ID, gcppubsub.EntityOpWrite)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feed.ID, gcppubsub.EntityOpWrite), feed.UserID, feed)
 
+	// Muting or unmuting the feed changes the unread counter.
+	s.entriesChanged(feed.UserID)
 	return nil
 }
 
-// UpdateFeedError updates feed errors.
-func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UpdateFeedError] feedID=%d", feed.ID))
+// UpdateFeedRefresh saves the result of a feed refresh, the settings and the version of the feed are not changed.
+func (s *Storage) UpdateFeedRefresh(ctx context.Context, feed *model.Feed) (err error) {
+	query := `
+		UPDATE feeds
+		SET
+			feed_url=$1,
+			site_url=$2,
+			etag_header=$3,
+			last_modified_header=$4,
+			checked_at=$5,
+			parsing_error_msg=$6,
+			parsing_error_count=$7
+		WHERE id=$8 AND user_id=$9`
+
+	_, err = s.db.ExecContext(ctx, query,
+		feed.FeedURL,
+		feed.SiteURL,
+		feed.EtagHeader,
+		feed.LastModifiedHeader,
+		feed.CheckedAt,
+		feed.ParsingErrorMsg,
+		feed.ParsingErrorCount,
+		feed.ID,
+		feed.UserID,
+	)
 
+	if err != nil {
+		return fmt.Errorf("unable to update feed #%d (%s): %v", feed.ID, feed.FeedURL, err)
+	}
+
+	s.feeds.Remove(feed.ID)
+
+	// Sync feed
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feed.ID, gcppubsub.EntityOpWrite), feed.UserID, feed)
+	return nil
+}
+
+// SetFeedsCategory moves the given list of feeds to another category.
+func (s *Storage) SetFeedsCategory(ctx context.Context, userID int64, feedIDs []int64, categoryID int64) error {
+	query := `
+		UPDATE feeds
+		SET category_id=$1, version=version+1
+		WHERE user_id=$2 AND id=ANY($3) AND EXISTS (SELECT 1 FROM categories WHERE id=$1 AND user_id=$2)
+		RETURNING id
+	`
+	rows, err := s.db.QueryContext(ctx, query, categoryID, userID, pq.Array(feedIDs))
+	if err != nil {
+		return fmt.Errorf("unable to change the category of feeds %v: %v", feedIDs, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var feedID int64
+		if err := rows.Scan(&feedID); err != nil {
+			return fmt.Errorf("unable to fetch updated feed: %v", err)
+		}
+
+		s.feeds.Remove(feedID)
+		s.publishEvent(ctx, gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpWrite), userID, nil)
+	}
+
+	s.categories.Remove(userID)
+	return nil
+}
+
+// UpdateFeedError updates feed errors.
+func (s *Storage) UpdateFeedError(ctx context.Context, feed *model.Feed) (err error) {
 	query := `
 		UPDATE feeds
 		SET
@@ -289,7 +454,7 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 			checked_at=$3
 		WHERE id=$4 AND user_id=$5`
 
-	_, err = s.db.Exec(query,
+	_, err = s.db.ExecContext(ctx, query,
 		feed.ParsingErrorMsg,
 		feed.ParsingErrorCount,
 		feed.CheckedAt,
@@ -301,14 +466,45 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 		return fmt.Errorf("unable to update feed error #%d (%s): %v", feed.ID, feed.FeedURL, err)
 	}
 
+	s.feeds.Remove(feed.ID)
+
+	// The first error means the feed is entering the error state.
+	if feed.ParsingErrorCount == 1 {
+		s.webhooks.FeedError(feed)
+		s.notifier.FeedError(feed)
+	}
+
 	return nil
 }
 
-// RemoveFeed removes a feed.
-func (s *Storage) RemoveFeed(userID, feedID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:RemoveFeed] userID=%d, feedID=%d", userID, feedID))
+// WatchedContent returns the text of the watched region of a web page from the last refresh.
+func (s *Storage) WatchedContent(ctx context.Context, feedID int64) (string, error) {
+	var content string
+	err := s.db.QueryRowContext(ctx, `SELECT watch_content FROM feeds WHERE id=$1`, feedID).Scan(&content)
+	if err != nil {
+		return "", fmt.Errorf("unable to fetch watched content of feed #%d: %v", feedID, err)
+	}
+
+	return content, nil
+}
+
+// UpdateWatchedContent saves the text of the watched region of a web page, it is compared with the next version.
+func (s *Storage) UpdateWatchedContent(ctx context.Context, feedID int64, content string) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE feeds SET watch_content=$1 WHERE id=$2`, content, feedID); err != nil {
+		return fmt.Errorf("unable to update watched content of feed #%d: %v", feedID, err)
+	}
+
+	return nil
+}
 
-	result, err := s.db.Exec("DELETE FROM feeds WHERE id = $1 AND user_id = $2", feedID, userID)
+// RemoveFeed moves a feed to the trash, it is deleted by PurgeTrash after the retention period.
+func (s *Storage) RemoveFeed(ctx context.Context, userID, feedID int64) error {
+	result, err := s.db.ExecContext(
+		ctx,
+		"UPDATE feeds SET deleted_at=now() WHERE id=$1 AND user_id=$2 AND deleted_at IS NULL",
+		feedID,
+		userID,
+	)
 	if err != nil {
 		return fmt.Errorf("unable to remove feed #%d: %v", feedID, err)
 	}
@@ -322,15 +518,19 @@ func (s *Storage) RemoveFeed(userID, feedID int64) error {
 		return errors.New("no feed has been removed")
 	}
 
+	s.entriesChanged(userID)
+	s.feeds.Remove(feedID)
+
 	// Sync feed
-	syncEvent := gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpDelete)
-	s.pub.PublishEvent(syncEvent)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpDelete), userID, nil)
+	s.webhooks.FeedRemoved(userID, feedID)
 
 	return nil
 }
 
 // ResetFeedErrors removes all feed errors.
-func (s *Storage) ResetFeedErrors() error {
-	_, err := s.db.Exec(`UPDATE feeds SET parsing_error_count=0, parsing_error_msg=''`)
+func (s *Storage) ResetFeedErrors(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE feeds SET parsing_error_count=0, parsing_error_msg=''`)
+	s.feeds.Purge()
 	return err
 }
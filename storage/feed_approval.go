@@ -0,0 +1,107 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/model"
+)
+
+// UserIsAdmin returns true if the given user is an administrator.
+func (s *Storage) UserIsAdmin(ctx context.Context, userID int64) bool {
+	user, err := s.UserByID(ctx, userID)
+	return err == nil && user != nil && user.IsAdmin
+}
+
+// PendingFeeds returns the subscriptions of all users waiting for the approval of an administrator.
+func (s *Storage) PendingFeeds(ctx context.Context) (model.PendingFeeds, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT f.id, f.user_id, u.username, f.feed_url, f.site_url, f.title, f.category_id, c.title
+		FROM feeds f
+		LEFT JOIN categories c ON c.id=f.category_id
+		LEFT JOIN users u ON u.id=f.user_id
+		WHERE f.pending AND f.deleted_at IS NULL ORDER BY f.id ASC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending feeds: %v", err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.PendingFeeds, 0)
+	for rows.Next() {
+		pending := &model.PendingFeed{Feed: &model.Feed{Category: &model.Category{}, Pending: true}}
+		err := rows.Scan(
+			&pending.Feed.ID,
+			&pending.Feed.UserID,
+			&pending.Username,
+			&pending.Feed.FeedURL,
+			&pending.Feed.SiteURL,
+			&pending.Feed.Title,
+			&pending.Feed.Category.ID,
+			&pending.Feed.Category.Title,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch pending feeds row: %v", err)
+		}
+
+		pending.Feed.Category.UserID = pending.Feed.UserID
+		feeds = append(feeds, pending)
+	}
+
+	return feeds, nil
+}
+
+// ApproveFeed allows a pending feed to be refreshed, the owner of the feed is returned.
+// The HTTP caching headers are cleared to download the entries on the next refresh.
+// Zero is returned if the feed is not pending.
+func (s *Storage) ApproveFeed(ctx context.Context, feedID int64) (int64, error) {
+	var userID int64
+	err := s.db.QueryRowContext(
+		ctx,
+		`UPDATE feeds SET pending='f', etag_header='', last_modified_header='', version=version+1
+		WHERE id=$1 AND pending AND deleted_at IS NULL
+		RETURNING user_id`,
+		feedID,
+	).Scan(&userID)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("unable to approve feed #%d: %v", feedID, err)
+	}
+
+	s.feeds.Remove(feedID)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpWrite), userID, nil)
+	return userID, nil
+}
+
+// RejectFeed removes a pending feed, the owner of the feed is returned.
+// Zero is returned if the feed is not pending.
+func (s *Storage) RejectFeed(ctx context.Context, feedID int64) (int64, error) {
+	var userID int64
+	err := s.db.QueryRowContext(
+		ctx,
+		`DELETE FROM feeds WHERE id=$1 AND pending RETURNING user_id`,
+		feedID,
+	).Scan(&userID)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("unable to reject feed #%d: %v", feedID, err)
+	}
+
+	s.feeds.Remove(feedID)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpDelete), userID, nil)
+	s.webhooks.FeedRemoved(userID, feedID)
+	return userID, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+
+	"miniflux.app/model"
+)
+
+// ArchiveFeedResponse saves a compressed copy of a feed document and keeps only the latest ones of the feed.
+func (s *Storage) ArchiveFeedResponse(ctx context.Context, response *model.FeedResponse, keep int) error {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	w.Write(response.Content)
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("unable to compress feed response: %v", err)
+	}
+
+	query := `
+		INSERT INTO feed_responses
+		(feed_id, url, status_code, content_type, etag, last_modified, size, content)
+		VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	err := s.db.QueryRowContext(
+		ctx,
+		query,
+		response.FeedID,
+		response.URL,
+		response.StatusCode,
+		response.ContentType,
+		response.ETag,
+		response.LastModified,
+		len(response.Content),
+		b.Bytes(),
+	).Scan(&response.ID, &response.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("unable to archive feed response: %v", err)
+	}
+
+	query = `
+		DELETE FROM feed_responses
+		WHERE feed_id=$1 AND id NOT IN (
+			SELECT id FROM feed_responses WHERE feed_id=$1 ORDER BY created_at DESC, id DESC LIMIT $2
+		)
+	`
+	if _, err := s.db.ExecContext(ctx, query, response.FeedID, keep); err != nil {
+		return fmt.Errorf("unable to remove old feed responses: %v", err)
+	}
+
+	return nil
+}
+
+// FeedResponses returns the archived responses of a feed without their content, the most recent first.
+func (s *Storage) FeedResponses(ctx context.Context, feedID int64) (model.FeedResponses, error) {
+	query := `
+		SELECT
+			id, feed_id, url, status_code, content_type, etag, last_modified, size, created_at
+		FROM feed_responses
+		WHERE feed_id=$1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, feedID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch feed responses: %v", err)
+	}
+	defer rows.Close()
+
+	responses := make(model.FeedResponses, 0)
+	for rows.Next() {
+		var response model.FeedResponse
+		err := rows.Scan(
+			&response.ID,
+			&response.FeedID,
+			&response.URL,
+			&response.StatusCode,
+			&response.ContentType,
+			&response.ETag,
+			&response.LastModified,
+			&response.Size,
+			&response.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch feed responses row: %v", err)
+		}
+
+		responses = append(responses, &response)
+	}
+
+	return responses, nil
+}
+
+// FeedResponse returns an archived response with its uncompressed content.
+func (s *Storage) FeedResponse(ctx context.Context, feedID, responseID int64) (*model.FeedResponse, error) {
+	query := `
+		SELECT
+			id, feed_id, url, status_code, content_type, etag, last_modified, size, created_at, content
+		FROM feed_responses
+		WHERE feed_id=$1 AND id=$2
+	`
+
+	var response model.FeedResponse
+	var compressed []byte
+	err := s.db.QueryRowContext(ctx, query, feedID, responseID).Scan(
+		&response.ID,
+		&response.FeedID,
+		&response.URL,
+		&response.StatusCode,
+		&response.ContentType,
+		&response.ETag,
+		&response.LastModified,
+		&response.Size,
+		&response.CreatedAt,
+		&compressed,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch feed response: %v", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress feed response: %v", err)
+	}
+	defer r.Close()
+
+	if response.Content, err = ioutil.ReadAll(r); err != nil {
+		return nil, fmt.Errorf("unable to decompress feed response: %v", err)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+// RecordFeedFetch stores the result of a feed refresh, results older than the statistics period are deleted.
+func (s *Storage) RecordFeedFetch(ctx context.Context, feedID int64, success bool) error {
+	query := `INSERT INTO feed_fetches (feed_id, success) VALUES ($1, $2)`
+	if _, err := s.db.ExecContext(ctx, query, feedID, success); err != nil {
+		return fmt.Errorf("unable to record fetch of feed #%d: %v", feedID, err)
+	}
+
+	query = `DELETE FROM feed_fetches WHERE feed_id=$1 AND fetched_at < now() - $2::int * interval '1 day'`
+	if _, err := s.db.ExecContext(ctx, query, feedID, model.FeedStatsPeriodDays); err != nil {
+		return fmt.Errorf("unable to delete old fetches of feed #%d: %v", feedID, err)
+	}
+
+	return nil
+}
+
+// FeedStats returns the publishing, reading and fetching statistics of a feed.
+func (s *Storage) FeedStats(ctx context.Context, userID, feedID int64) (*model.FeedStats, error) {
+	stats := &model.FeedStats{FeedID: feedID}
+	query := `
+		SELECT
+			(SELECT count(*) FROM entries WHERE user_id=$1 AND feed_id=$2 AND published_at > now() - $3::int * interval '1 day') * 7.0 / $3::int,
+			(SELECT coalesce(round(avg(octet_length(content)))::int, 0) FROM entries WHERE user_id=$1 AND feed_id=$2),
+			(SELECT coalesce(avg(CASE WHEN status='read' THEN 1.0 ELSE 0.0 END), 0) FROM entries WHERE user_id=$1 AND feed_id=$2 AND status <> 'removed'),
+			(SELECT count(*) FROM feed_fetches WHERE feed_id=$2 AND fetched_at > now() - $3::int * interval '1 day'),
+			(SELECT coalesce(avg(CASE WHEN success THEN 1.0 ELSE 0.0 END), 0) FROM feed_fetches WHERE feed_id=$2 AND fetched_at > now() - $3::int * interval '1 day')
+	`
+	err := s.reader(userID).QueryRowContext(ctx, query, userID, feedID, model.FeedStatsPeriodDays).Scan(
+		&stats.EntriesPerWeek,
+		&stats.AverageEntrySize,
+		&stats.ReadRatio,
+		&stats.FetchCount,
+		&stats.FetchSuccessRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute statistics of feed #%d: %v", feedID, err)
+	}
+
+	return stats, nil
+}
+
+// LeastReadFeeds returns the feeds subscribed before the given date without any entry read since then.
+func (s *Storage) LeastReadFeeds(ctx context.Context, userID int64, since time.Time) (model.LeastReadFeeds, error) {
+	feeds, err := s.Feeds(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT
+			f.id, max(e.read_at), count(e.id) FILTER (WHERE e.status='unread'), u.timezone
+		FROM feeds f
+		JOIN users u ON u.id=f.user_id
+		LEFT JOIN entries e ON e.feed_id=f.id
+		WHERE f.user_id=$1 AND f.deleted_at IS NULL AND f.created_at < $2
+		GROUP BY f.id, u.timezone
+		HAVING max(e.read_at) IS NULL OR max(e.read_at) < $2
+	`
+	rows, err := s.reader(userID).QueryContext(ctx, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch least read feeds: %v", err)
+	}
+	defer rows.Close()
+
+	activities := make(map[int64]*model.LeastReadFeed)
+	for rows.Next() {
+		var feedID int64
+		var tz string
+		var leastReadFeed model.LeastReadFeed
+		if err := rows.Scan(&feedID, &leastReadFeed.LastReadAt, &leastReadFeed.UnreadCount, &tz); err != nil {
+			return nil, fmt.Errorf("unable to fetch least read feed row: %v", err)
+		}
+
+		if leastReadFeed.LastReadAt != nil {
+			lastReadAt := timezone.Convert(tz, *leastReadFeed.LastReadAt)
+			leastReadFeed.LastReadAt = &lastReadAt
+		}
+		activities[feedID] = &leastReadFeed
+	}
+
+	leastReadFeeds := make(model.LeastReadFeeds, 0)
+	for _, feed := range feeds {
+		if leastReadFeed, found := activities[feed.ID]; found && !feed.IsSavedPages() {
+			leastReadFeed.Feed = feed
+			leastReadFeeds = append(leastReadFeeds, leastReadFeed)
+		}
+	}
+
+	// The feeds keep their alphabetical order for the same last read date.
+	sort.SliceStable(leastReadFeeds, func(i, j int) bool {
+		a, b := leastReadFeeds[i].LastReadAt, leastReadFeeds[j].LastReadAt
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+
+	return leastReadFeeds, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// SaveFilterList stores the downloaded copy of a filter list,
+// its update date only changes with its content.
+func (s *Storage) SaveFilterList(ctx context.Context, list *model.FilterList) error {
+	query := `
+		INSERT INTO filter_lists (url, content, etag_header, last_modified_header)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (url) DO UPDATE SET
+			content=EXCLUDED.content,
+			etag_header=EXCLUDED.etag_header,
+			last_modified_header=EXCLUDED.last_modified_header,
+			checked_at=now(),
+			updated_at=CASE WHEN filter_lists.content=EXCLUDED.content THEN filter_lists.updated_at ELSE now() END
+	`
+	_, err := s.db.ExecContext(ctx, query, list.URL, list.Content, list.EtagHeader, list.LastModifiedHeader)
+	if err != nil {
+		return fmt.Errorf("unable to save filter list %q: %v", list.URL, err)
+	}
+
+	return nil
+}
+
+// FilterLists returns the cached copies of the given filter lists, the lists never downloaded are missing.
+func (s *Storage) FilterLists(ctx context.Context, urls []string) (model.FilterLists, error) {
+	query := `
+		SELECT url, content, etag_header, last_modified_header, checked_at, updated_at
+		FROM filter_lists
+		WHERE url=ANY($1)
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(urls))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch filter lists: %v", err)
+	}
+	defer rows.Close()
+
+	lists := make(model.FilterLists, 0)
+	for rows.Next() {
+		var list model.FilterList
+		err := rows.Scan(&list.URL, &list.Content, &list.EtagHeader, &list.LastModifiedHeader, &list.CheckedAt, &list.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch filter lists row: %v", err)
+		}
+		lists = append(lists, &list)
+	}
+
+	return lists, nil
+}
+
+// FilterListsUpdatedAt returns the last change of the given filter lists, it is zero when none has been downloaded.
+func (s *Storage) FilterListsUpdatedAt(ctx context.Context, urls []string) (time.Time, error) {
+	var updatedAt *time.Time
+	err := s.db.QueryRowContext(ctx, `SELECT max(updated_at) FROM filter_lists WHERE url=ANY($1)`, pq.Array(urls)).Scan(&updatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to fetch the update date of filter lists: %v", err)
+	}
+
+	if updatedAt == nil {
+		return time.Time{}, nil
+	}
+
+	return *updatedAt, nil
+}
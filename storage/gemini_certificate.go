@@ -0,0 +1,43 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GeminiCertificate returns the fingerprint of the certificate trusted for a Gemini host, it's empty for unknown hosts.
+func (s *Storage) GeminiCertificate(ctx context.Context, host string) (string, time.Time, error) {
+	var fingerprint string
+	var expiresAt time.Time
+
+	query := `SELECT fingerprint, expires_at FROM gemini_certificates WHERE host=$1`
+	err := s.db.QueryRowContext(ctx, query, host).Scan(&fingerprint, &expiresAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", time.Time{}, nil
+	case err != nil:
+		return "", time.Time{}, fmt.Errorf("unable to fetch Gemini certificate: %v", err)
+	}
+
+	return fingerprint, expiresAt, nil
+}
+
+// TrustGeminiCertificate stores the certificate trusted for a Gemini host, it replaces the previous one.
+func (s *Storage) TrustGeminiCertificate(ctx context.Context, host, fingerprint string, expiresAt time.Time) error {
+	query := `
+		INSERT INTO gemini_certificates (host, fingerprint, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (host) DO UPDATE SET fingerprint=EXCLUDED.fingerprint, expires_at=EXCLUDED.expires_at, created_at=now()
+	`
+	if _, err := s.db.ExecContext(ctx, query, host, fingerprint, expiresAt); err != nil {
+		return fmt.Errorf("unable to store Gemini certificate: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/webhook"
+)
+
+const (
+	heldNotificationKindIntegration = "integration"
+	heldNotificationKindWebhook     = "webhook"
+
+	// maxHeldNotifications is the number of notifications of each kind held for a user,
+	// the next notifications are dropped until the end of the quiet hours.
+	maxHeldNotifications = 100
+)
+
+var errTooManyHeldNotifications = fmt.Errorf("more than %d notifications are held", maxHeldNotifications)
+
+// holdIntegrationNotification keeps the notification until the end of the quiet hours of the user.
+func (s *Storage) holdIntegrationNotification(userID int64, held *integration.HeldNotification, until time.Time) error {
+	return s.holdNotification(context.Background(), userID, heldNotificationKindIntegration, held, until)
+}
+
+// holdWebhookEvent keeps the event until the end of the quiet hours of the user.
+func (s *Storage) holdWebhookEvent(userID int64, event *webhook.Event, until time.Time) error {
+	return s.holdNotification(context.Background(), userID, heldNotificationKindWebhook, event, until)
+}
+
+func (s *Storage) holdNotification(ctx context.Context, userID int64, kind string, value interface{}, until time.Time) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the held notification: %v", err)
+	}
+
+	query := `
+		INSERT INTO held_notifications (user_id, kind, data, release_at)
+		SELECT $1::bigint, $2::text, $3::jsonb, $4::timestamptz
+		WHERE (SELECT count(*) FROM held_notifications WHERE user_id=$1 AND kind=$2) < $5
+	`
+	result, err := s.db.ExecContext(ctx, query, userID, kind, string(data), until, maxHeldNotifications)
+	if err != nil {
+		return fmt.Errorf("unable to hold the notification: %v", err)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 {
+		return errTooManyHeldNotifications
+	}
+
+	return nil
+}
+
+// FlushHeldNotifications removes the notifications whose quiet hours are over and sends them,
+// the notifications of each user are sent together. It returns the number of sent notifications.
+func (s *Storage) FlushHeldNotifications(ctx context.Context) (int, error) {
+	query := `
+		DELETE FROM held_notifications
+		WHERE release_at <= now()
+		RETURNING id, user_id, kind, data
+	`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("unable to remove the held notifications: %v", err)
+	}
+	defer rows.Close()
+
+	type heldRow struct {
+		id     int64
+		userID int64
+		kind   string
+		data   []byte
+	}
+
+	var held []heldRow
+	for rows.Next() {
+		var row heldRow
+		if err := rows.Scan(&row.id, &row.userID, &row.kind, &row.data); err != nil {
+			return 0, fmt.Errorf("unable to fetch the held notifications: %v", err)
+		}
+		held = append(held, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("unable to fetch the held notifications: %v", err)
+	}
+
+	// The returned rows are not ordered, the notifications are sent in the order they were held.
+	sort.Slice(held, func(i, j int) bool { return held[i].id < held[j].id })
+
+	notifications := make(map[int64][]*integration.HeldNotification)
+	events := make(map[int64][]*webhook.Event)
+	for _, row := range held {
+		switch row.kind {
+		case heldNotificationKindIntegration:
+			var notification integration.HeldNotification
+			if err := json.Unmarshal(row.data, &notification); err != nil || notification.Notification == nil {
+				logger.Error("[Storage:FlushHeldNotifications] Invalid notification #%d: %v", row.id, err)
+				continue
+			}
+			notifications[row.userID] = append(notifications[row.userID], &notification)
+		case heldNotificationKindWebhook:
+			// The payload is kept as is, it is sent again without being decoded into a map.
+			event := &webhook.Event{Data: new(json.RawMessage)}
+			if err := json.Unmarshal(row.data, event); err != nil {
+				logger.Error("[Storage:FlushHeldNotifications] Invalid event #%d: %v", row.id, err)
+				continue
+			}
+			events[row.userID] = append(events[row.userID], event)
+		default:
+			logger.Error("[Storage:FlushHeldNotifications] Unknown kind %q of notification #%d", row.kind, row.id)
+		}
+	}
+
+	for userID, userNotifications := range notifications {
+		s.notifier.Flush(userID, userNotifications)
+	}
+
+	for userID, userEvents := range events {
+		s.webhooks.Flush(userID, userEvents)
+	}
+
+	return len(held), nil
+}
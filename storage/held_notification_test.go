@@ -0,0 +1,34 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"miniflux.app/integration"
+	"miniflux.app/model"
+)
+
+func TestHoldNotificationOverLimit(t *testing.T) {
+	db := &recorder{}
+	store := NewStorage(sql.OpenDB(db), nil)
+
+	// The recorder inserts no row, like a user already having the maximum of held notifications.
+	held := &integration.HeldNotification{Event: model.IntegrationEventNewEntries, Notification: &integration.Notification{Title: "Title"}}
+	if err := store.holdIntegrationNotification(1, held, time.Now().Add(time.Hour)); err != errTooManyHeldNotifications {
+		t.Fatalf(`The notification should be dropped, got %v`, err)
+	}
+
+	query := db.find("INSERT INTO held_notifications")
+	if query == nil {
+		t.Fatal(`The notification should be stored`)
+	}
+
+	if limit := query.args[4]; limit != int64(maxHeldNotifications) {
+		t.Errorf(`The number of held notifications should be limited, got %#v`, limit)
+	}
+}
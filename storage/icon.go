@@ -5,42 +5,47 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"io/ioutil"
 	"strings"
-	"time"
 
+	"miniflux.app/blob"
+	"miniflux.app/logger"
 	"miniflux.app/model"
-	"miniflux.app/timer"
 )
 
 // HasIcon checks if the given feed has an icon.
-func (s *Storage) HasIcon(feedID int64) bool {
+func (s *Storage) HasIcon(ctx context.Context, feedID int64) bool {
 	var result int
 	query := `SELECT count(*) as c FROM feed_icons WHERE feed_id=$1`
-	s.db.QueryRow(query, feedID).Scan(&result)
+	s.db.QueryRowContext(ctx, query, feedID).Scan(&result)
 	return result == 1
 }
 
 // IconByID returns an icon by the ID.
-func (s *Storage) IconByID(iconID int64) (*model.Icon, error) {
-	defer timer.ExecutionTime(time.Now(), "[Storage:IconByID]")
-
+func (s *Storage) IconByID(ctx context.Context, iconID int64) (*model.Icon, error) {
 	var icon model.Icon
+	if cacheGet(s.cache, iconCacheKey(iconID), &icon) {
+		return &icon, nil
+	}
+
 	query := `SELECT id, hash, mime_type, content FROM icons WHERE id=$1`
-	err := s.db.QueryRow(query, iconID).Scan(&icon.ID, &icon.Hash, &icon.MimeType, &icon.Content)
+	err := s.db.QueryRowContext(ctx, query, iconID).Scan(&icon.ID, &icon.Hash, &icon.MimeType, &icon.Content)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
 		return nil, fmt.Errorf("Unable to fetch icon by hash: %v", err)
 	}
 
+	s.loadIconContent(&icon)
+	cacheSet(s.cache, iconCacheKey(iconID), &icon, iconCacheTTL)
 	return &icon, nil
 }
 
 // IconByFeedID returns a feed icon.
-func (s *Storage) IconByFeedID(userID, feedID int64) (*model.Icon, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:IconByFeedID] userID=%d, feedID=%d", userID, feedID))
+func (s *Storage) IconByFeedID(ctx context.Context, userID, feedID int64) (*model.Icon, error) {
 	query := `
 		SELECT
 		icons.id, icons.hash, icons.mime_type, icons.content
@@ -52,19 +57,18 @@ func (s *Storage) IconByFeedID(userID, feedID int64) (*model.Icon, error) {
 	`
 
 	var icon model.Icon
-	err := s.db.QueryRow(query, userID, feedID).Scan(&icon.ID, &icon.Hash, &icon.MimeType, &icon.Content)
+	err := s.db.QueryRowContext(ctx, query, userID, feedID).Scan(&icon.ID, &icon.Hash, &icon.MimeType, &icon.Content)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch icon: %v", err)
 	}
 
+	s.loadIconContent(&icon)
 	return &icon, nil
 }
 
 // IconByHash returns an icon by the hash (checksum).
-func (s *Storage) IconByHash(icon *model.Icon) error {
-	defer timer.ExecutionTime(time.Now(), "[Storage:IconByHash]")
-
-	err := s.db.QueryRow(`SELECT id FROM icons WHERE hash=$1`, icon.Hash).Scan(&icon.ID)
+func (s *Storage) IconByHash(ctx context.Context, icon *model.Icon) error {
+	err := s.db.QueryRowContext(ctx, `SELECT id FROM icons WHERE hash=$1`, icon.Hash).Scan(&icon.ID)
 	if err == sql.ErrNoRows {
 		return nil
 	} else if err != nil {
@@ -75,8 +79,15 @@ func (s *Storage) IconByHash(icon *model.Icon) error {
 }
 
 // CreateIcon creates a new icon.
-func (s *Storage) CreateIcon(icon *model.Icon) error {
-	defer timer.ExecutionTime(time.Now(), "[Storage:CreateIcon]")
+func (s *Storage) CreateIcon(ctx context.Context, icon *model.Icon) error {
+	// The content is kept in the blob store, the row only holds the metadata.
+	content := icon.Content
+	if s.blobs != nil {
+		if err := blob.Put(s.blobs, iconBlobKey(icon.Hash), icon.Content, normalizeMimeType(icon.MimeType)); err != nil {
+			return fmt.Errorf("Unable to store icon content: %v", err)
+		}
+		content = []byte{}
+	}
 
 	query := `
 		INSERT INTO icons
@@ -85,11 +96,12 @@ func (s *Storage) CreateIcon(icon *model.Icon) error {
 		($1, $2, $3)
 		RETURNING id
 	`
-	err := s.db.QueryRow(
+	err := s.db.QueryRowContext(
+		ctx,
 		query,
 		icon.Hash,
 		normalizeMimeType(icon.MimeType),
-		icon.Content,
+		content,
 	).Scan(&icon.ID)
 
 	if err != nil {
@@ -100,32 +112,30 @@ func (s *Storage) CreateIcon(icon *model.Icon) error {
 }
 
 // CreateFeedIcon creates an icon and associate the icon to the given feed.
-func (s *Storage) CreateFeedIcon(feedID int64, icon *model.Icon) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CreateFeedIcon] feedID=%d", feedID))
-
-	err := s.IconByHash(icon)
+func (s *Storage) CreateFeedIcon(ctx context.Context, feedID int64, icon *model.Icon) error {
+	err := s.IconByHash(ctx, icon)
 	if err != nil {
 		return err
 	}
 
 	if icon.ID == 0 {
-		err := s.CreateIcon(icon)
+		err := s.CreateIcon(ctx, icon)
 		if err != nil {
 			return err
 		}
 	}
 
-	_, err = s.db.Exec(`INSERT INTO feed_icons (feed_id, icon_id) VALUES ($1, $2)`, feedID, icon.ID)
+	_, err = s.db.ExecContext(ctx, `INSERT INTO feed_icons (feed_id, icon_id) VALUES ($1, $2)`, feedID, icon.ID)
 	if err != nil {
 		return fmt.Errorf("unable to create feed icon: %v", err)
 	}
 
+	s.feeds.Remove(feedID)
 	return nil
 }
 
 // Icons returns all icons tht belongs to a user.
-func (s *Storage) Icons(userID int64) (model.Icons, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:Icons] userID=%d", userID))
+func (s *Storage) Icons(ctx context.Context, userID int64) (model.Icons, error) {
 	query := `
 		SELECT
 		icons.id, icons.hash, icons.mime_type, icons.content
@@ -135,7 +145,7 @@ func (s *Storage) Icons(userID int64) (model.Icons, error) {
 		WHERE feeds.user_id=$1
 	`
 
-	rows, err := s.db.Query(query, userID)
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch icons: %v", err)
 	}
@@ -148,12 +158,40 @@ func (s *Storage) Icons(userID int64) (model.Icons, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to fetch icons row: %v", err)
 		}
+		s.loadIconContent(&icon)
 		icons = append(icons, &icon)
 	}
 
 	return icons, nil
 }
 
+func iconBlobKey(hash string) string {
+	return "icons/" + hash
+}
+
+// loadIconContent reads the content of icons stored outside of the database.
+// Icons created before the blob store was configured keep their content in the database.
+func (s *Storage) loadIconContent(icon *model.Icon) {
+	if s.blobs == nil || len(icon.Content) > 0 {
+		return
+	}
+
+	object, err := s.blobs.Get(iconBlobKey(icon.Hash))
+	if err != nil {
+		logger.Error("[Storage:Icon] Unable to load icon #%d: %v", icon.ID, err)
+		return
+	}
+	defer object.Close()
+
+	content, err := ioutil.ReadAll(object)
+	if err != nil {
+		logger.Error("[Storage:Icon] Unable to load icon #%d: %v", icon.ID, err)
+		return
+	}
+
+	icon.Content = content
+}
+
 func normalizeMimeType(mimeType string) string {
 	mimeType = strings.ToLower(mimeType)
 	switch mimeType {
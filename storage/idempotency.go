@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// ClaimIdempotencyKey reserves an idempotency key for a request of the user.
+// It returns nil when the key is new, or the response kept for the first request sent with the key.
+func (s *Storage) ClaimIdempotencyKey(ctx context.Context, userID int64, key, fingerprint string) (*model.IdempotentResponse, error) {
+	query := `
+		INSERT INTO api_idempotency_keys (user_id, key, fingerprint)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, key) DO NOTHING
+	`
+	result, err := s.db.ExecContext(ctx, query, userID, key, fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to claim idempotency key: %v", err)
+	}
+
+	if n, _ := result.RowsAffected(); n == 1 {
+		return nil, nil
+	}
+
+	var response model.IdempotentResponse
+	query = `SELECT fingerprint, status_code, content_type, coalesce(body, '') FROM api_idempotency_keys WHERE user_id=$1 AND key=$2`
+	err = s.db.QueryRowContext(ctx, query, userID, key).Scan(
+		&response.Fingerprint,
+		&response.StatusCode,
+		&response.ContentType,
+		&response.Body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch idempotent response: %v", err)
+	}
+
+	return &response, nil
+}
+
+// SaveIdempotentResponse keeps the response of the request that claimed the key.
+func (s *Storage) SaveIdempotentResponse(ctx context.Context, userID int64, key string, response *model.IdempotentResponse) error {
+	query := `UPDATE api_idempotency_keys SET status_code=$1, content_type=$2, body=$3 WHERE user_id=$4 AND key=$5`
+	_, err := s.db.ExecContext(ctx, query, response.StatusCode, response.ContentType, response.Body, userID, key)
+	if err != nil {
+		return fmt.Errorf("unable to save idempotent response: %v", err)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey removes a key, the next request sent with this key is executed again.
+func (s *Storage) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM api_idempotency_keys WHERE user_id=$1 AND key=$2`, userID, key)
+	if err != nil {
+		return fmt.Errorf("unable to release idempotency key: %v", err)
+	}
+
+	return nil
+}
+
+// CleanOldIdempotencyKeys removes the idempotency keys older than one day.
+func (s *Storage) CleanOldIdempotencyKeys(ctx context.Context) int64 {
+	query := `DELETE FROM api_idempotency_keys WHERE created_at < now() - interval '1 day'`
+	result, err := s.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// InstanceStats returns the statistics of all users, the number of entries is estimated by PostgreSQL.
+// The throughput of the refreshes comes from the fetch results kept for the feed statistics.
+func (s *Storage) InstanceStats(ctx context.Context, limit int) (*model.InstanceStats, error) {
+	query := `
+		SELECT
+			(SELECT count(*) FROM users),
+			(SELECT count(*) FROM feeds WHERE deleted_at IS NULL),
+			(SELECT coalesce(sum(n_live_tup), 0) FROM pg_stat_user_tables WHERE relname='entries'),
+			pg_database_size(current_database()),
+			(SELECT count(*) FROM (
+				SELECT feed_url FROM feeds WHERE deleted_at IS NULL GROUP BY feed_url HAVING count(DISTINCT user_id) > 1
+			) AS shared),
+			(SELECT count(*) FROM feed_fetches WHERE fetched_at > now() - interval '1 hour'),
+			(SELECT count(*) FROM feed_fetches WHERE fetched_at > now() - interval '1 day'),
+			(SELECT coalesce(avg(CASE WHEN success THEN 1.0 ELSE 0.0 END), 0) FROM feed_fetches WHERE fetched_at > now() - interval '1 day')
+	`
+
+	var stats model.InstanceStats
+	err := s.db.QueryRowContext(ctx, query).Scan(
+		&stats.Users,
+		&stats.Feeds,
+		&stats.Entries,
+		&stats.DatabaseSize,
+		&stats.SharedFeedURLs,
+		&stats.FetchesLastHour,
+		&stats.FetchesLastDay,
+		&stats.FetchSuccessRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch instance statistics: %v", err)
+	}
+
+	if stats.SharedFeeds, err = s.sharedFeeds(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	if stats.ErrorDomains, err = s.errorDomains(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// sharedFeeds returns the feed URLs with the most subscribers, they could be fetched once for all users.
+func (s *Storage) sharedFeeds(ctx context.Context, limit int) ([]*model.SharedFeed, error) {
+	query := `
+		SELECT feed_url, count(DISTINCT user_id)
+		FROM feeds
+		WHERE deleted_at IS NULL
+		GROUP BY feed_url
+		HAVING count(DISTINCT user_id) > 1
+		ORDER BY 2 DESC, feed_url ASC
+		LIMIT $1
+	`
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch shared feeds: %v", err)
+	}
+	defer rows.Close()
+
+	feeds := make([]*model.SharedFeed, 0)
+	for rows.Next() {
+		var feed model.SharedFeed
+		if err := rows.Scan(&feed.FeedURL, &feed.Subscribers); err != nil {
+			return nil, fmt.Errorf("unable to fetch shared feed row: %v", err)
+		}
+		feeds = append(feeds, &feed)
+	}
+
+	return feeds, nil
+}
+
+// errorDomains returns the domains with the most feeds in error, the domain is the host of the feed URL.
+func (s *Storage) errorDomains(ctx context.Context, limit int) ([]*model.ErrorDomain, error) {
+	query := `
+		SELECT
+			lower(substring(feed_url from '^[a-zA-Z][a-zA-Z0-9+.-]*://(?:[^/@]*@)?([^/:?#]+)')) AS domain,
+			count(*) FILTER (WHERE parsing_error_count > 0),
+			count(*)
+		FROM feeds
+		WHERE deleted_at IS NULL AND NOT pending
+		GROUP BY domain
+		HAVING count(*) FILTER (WHERE parsing_error_count > 0) > 0
+		ORDER BY 2 DESC, domain ASC
+		LIMIT $1
+	`
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch error domains: %v", err)
+	}
+	defer rows.Close()
+
+	domains := make([]*model.ErrorDomain, 0)
+	for rows.Next() {
+		var domain model.ErrorDomain
+		var name *string
+		if err := rows.Scan(&name, &domain.ErrorFeeds, &domain.Feeds); err != nil {
+			return nil, fmt.Errorf("unable to fetch error domain row: %v", err)
+		}
+
+		if name != nil {
+			domain.Domain = *name
+		}
+		domains = append(domains, &domain)
+	}
+
+	return domains, nil
+}
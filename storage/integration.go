@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -12,7 +13,7 @@ import (
 )
 
 // HasDuplicateFeverUsername checks if another user have the same fever username.
-func (s *Storage) HasDuplicateFeverUsername(userID int64, feverUsername string) bool {
+func (s *Storage) HasDuplicateFeverUsername(ctx context.Context, userID int64, feverUsername string) bool {
 	query := `
 		SELECT
 			count(*) as c
@@ -21,22 +22,22 @@ func (s *Storage) HasDuplicateFeverUsername(userID int64, feverUsername string)
 	`
 
 	var result int
-	s.db.QueryRow(query, userID, feverUsername).Scan(&result)
+	s.db.QueryRowContext(ctx, query, userID, feverUsername).Scan(&result)
 	return result >= 1
 }
 
 // UserByFeverToken returns a user by using the Fever API token.
-func (s *Storage) UserByFeverToken(token string) (*model.User, error) {
+func (s *Storage) UserByFeverToken(ctx context.Context, token string) (*model.User, error) {
 	query := `
 		SELECT
 			users.id, users.is_admin, users.timezone
 		FROM users
 		LEFT JOIN integrations ON integrations.user_id=users.id
-		WHERE integrations.fever_enabled='t' AND integrations.fever_token=$1
+		WHERE integrations.fever_enabled='t' AND integrations.fever_token=$1 AND users.deletion_requested_at IS NULL
 	`
 
 	var user model.User
-	err := s.db.QueryRow(query, token).Scan(&user.ID, &user.IsAdmin, &user.Timezone)
+	err := s.db.QueryRowContext(ctx, query, token).Scan(&user.ID, &user.IsAdmin, &user.Timezone)
 	switch {
 	case err == sql.ErrNoRows:
 		return nil, nil
@@ -48,7 +49,7 @@ func (s *Storage) UserByFeverToken(token string) (*model.User, error) {
 }
 
 // Integration returns user integration settings.
-func (s *Storage) Integration(userID int64) (*model.Integration, error) {
+func (s *Storage) Integration(ctx context.Context, userID int64) (*model.Integration, error) {
 	query := `SELECT
 			user_id,
 			pinboard_enabled,
@@ -73,12 +74,32 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 			nunux_keeper_api_key,
 			pocket_enabled,
 			pocket_access_token,
-			pocket_consumer_key
+			pocket_consumer_key,
+			ntfy_enabled,
+			ntfy_url,
+			ntfy_topic,
+			ntfy_token,
+			pushover_enabled,
+			pushover_token,
+			pushover_user,
+			apprise_enabled,
+			apprise_url,
+			apprise_service_urls,
+			kindle_enabled,
+			kindle_email,
+			readeck_enabled,
+			readeck_url,
+			readeck_api_key,
+			readeck_labels,
+			omnivore_enabled,
+			omnivore_url,
+			omnivore_api_key,
+			filters
 		FROM integrations
 		WHERE user_id=$1
 	`
 	var integration model.Integration
-	err := s.db.QueryRow(query, userID).Scan(
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(
 		&integration.UserID,
 		&integration.PinboardEnabled,
 		&integration.PinboardToken,
@@ -103,6 +124,26 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		&integration.PocketEnabled,
 		&integration.PocketAccessToken,
 		&integration.PocketConsumerKey,
+		&integration.NtfyEnabled,
+		&integration.NtfyURL,
+		&integration.NtfyTopic,
+		&integration.NtfyToken,
+		&integration.PushoverEnabled,
+		&integration.PushoverToken,
+		&integration.PushoverUser,
+		&integration.AppriseEnabled,
+		&integration.AppriseURL,
+		&integration.AppriseServiceURLs,
+		&integration.KindleEnabled,
+		&integration.KindleEmail,
+		&integration.ReadeckEnabled,
+		&integration.ReadeckURL,
+		&integration.ReadeckAPIKey,
+		&integration.ReadeckLabels,
+		&integration.OmnivoreEnabled,
+		&integration.OmnivoreURL,
+		&integration.OmnivoreAPIKey,
+		&integration.Filters,
 	)
 	switch {
 	case err == sql.ErrNoRows:
@@ -115,7 +156,7 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 }
 
 // UpdateIntegration saves user integration settings.
-func (s *Storage) UpdateIntegration(integration *model.Integration) error {
+func (s *Storage) UpdateIntegration(ctx context.Context, integration *model.Integration) error {
 	query := `
 		UPDATE integrations SET
 			pinboard_enabled=$1,
@@ -140,10 +181,31 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 			nunux_keeper_api_key=$20,
 			pocket_enabled=$21,
 			pocket_access_token=$22,
-			pocket_consumer_key=$23
-		WHERE user_id=$24
+			pocket_consumer_key=$23,
+			ntfy_enabled=$24,
+			ntfy_url=$25,
+			ntfy_topic=$26,
+			ntfy_token=$27,
+			pushover_enabled=$28,
+			pushover_token=$29,
+			pushover_user=$30,
+			apprise_enabled=$31,
+			apprise_url=$32,
+			apprise_service_urls=$33,
+			kindle_enabled=$34,
+			kindle_email=$35,
+			readeck_enabled=$36,
+			readeck_url=$37,
+			readeck_api_key=$38,
+			readeck_labels=$39,
+			omnivore_enabled=$40,
+			omnivore_url=$41,
+			omnivore_api_key=$42,
+			filters=$43
+		WHERE user_id=$44
 	`
-	_, err := s.db.Exec(
+	_, err := s.db.ExecContext(
+		ctx,
 		query,
 		integration.PinboardEnabled,
 		integration.PinboardToken,
@@ -168,6 +230,26 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		integration.PocketEnabled,
 		integration.PocketAccessToken,
 		integration.PocketConsumerKey,
+		integration.NtfyEnabled,
+		integration.NtfyURL,
+		integration.NtfyTopic,
+		integration.NtfyToken,
+		integration.PushoverEnabled,
+		integration.PushoverToken,
+		integration.PushoverUser,
+		integration.AppriseEnabled,
+		integration.AppriseURL,
+		integration.AppriseServiceURLs,
+		integration.KindleEnabled,
+		integration.KindleEmail,
+		integration.ReadeckEnabled,
+		integration.ReadeckURL,
+		integration.ReadeckAPIKey,
+		integration.ReadeckLabels,
+		integration.OmnivoreEnabled,
+		integration.OmnivoreURL,
+		integration.OmnivoreAPIKey,
+		integration.Filters,
 		integration.UserID,
 	)
 
@@ -179,9 +261,9 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 }
 
 // CreateIntegration creates initial user integration settings.
-func (s *Storage) CreateIntegration(userID int64) error {
+func (s *Storage) CreateIntegration(ctx context.Context, userID int64) error {
 	query := `INSERT INTO integrations (user_id) VALUES ($1)`
-	_, err := s.db.Exec(query, userID)
+	_, err := s.db.ExecContext(ctx, query, userID)
 	if err != nil {
 		return fmt.Errorf("unable to create integration row: %v", err)
 	}
@@ -189,15 +271,26 @@ func (s *Storage) CreateIntegration(userID int64) error {
 	return nil
 }
 
+// HasKindle returns true if the given user can send entries to a Kindle device.
+func (s *Storage) HasKindle(ctx context.Context, userID int64) (result bool) {
+	query := `SELECT true FROM integrations WHERE user_id=$1 AND kindle_enabled='t' AND kindle_email <> ''`
+
+	if err := s.db.QueryRowContext(ctx, query, userID).Scan(&result); err != nil {
+		result = false
+	}
+
+	return result
+}
+
 // HasSaveEntry returns true if the given user can save articles to third-parties.
-func (s *Storage) HasSaveEntry(userID int64) (result bool) {
+func (s *Storage) HasSaveEntry(ctx context.Context, userID int64) (result bool) {
 	query := `
 		SELECT true FROM integrations
 		WHERE user_id=$1 AND
-		(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t')
+		(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t' OR readeck_enabled='t' OR omnivore_enabled='t')
 	`
 
-	if err := s.db.QueryRow(query, userID).Scan(&result); err != nil {
+	if err := s.db.QueryRowContext(ctx, query, userID).Scan(&result); err != nil {
 		result = false
 	}
 
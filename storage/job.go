@@ -5,46 +5,55 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"miniflux.app/model"
-	"miniflux.app/timer"
 )
 
 const maxParsingError = 3
 
-// NewBatch returns a serie of jobs.
-func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:GetJobs] batchSize=%d", batchSize))
+// NewBatch returns a serie of jobs, the feeds of frozen or deleted users and the pending feeds are not refreshed.
+func (s *Storage) NewBatch(ctx context.Context, batchSize int) (jobs model.JobList, err error) {
 	query := `
 		SELECT
 		id, user_id
 		FROM feeds
-		WHERE parsing_error_count < $1
+		WHERE parsing_error_count < $1 AND deleted_at IS NULL AND NOT pending AND
+		user_id NOT IN (SELECT id FROM users WHERE frozen_until > now() OR deletion_requested_at IS NOT NULL)
 		ORDER BY checked_at ASC LIMIT %d`
 
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError)
+	return s.fetchBatchRows(ctx, fmt.Sprintf(query, batchSize), maxParsingError)
 }
 
 // NewUserBatch returns a serie of jobs but only for a given user.
-func (s *Storage) NewUserBatch(userID int64, batchSize int) (jobs model.JobList, err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:GetUserJobs] batchSize=%d, userID=%d", batchSize, userID))
-
+func (s *Storage) NewUserBatch(ctx context.Context, userID int64, batchSize int) (jobs model.JobList, err error) {
 	// We do not take the error counter into consideration when the given
 	// user refresh manually all his feeds to force a refresh.
 	query := `
 		SELECT
 		id, user_id
 		FROM feeds
-		WHERE user_id=$1
+		WHERE user_id=$1 AND deleted_at IS NULL AND NOT pending
 		ORDER BY checked_at ASC LIMIT %d`
 
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), userID)
+	return s.fetchBatchRows(ctx, fmt.Sprintf(query, batchSize), userID)
+}
+
+// NewCategoryBatch returns a serie of jobs for all feeds of a category.
+func (s *Storage) NewCategoryBatch(ctx context.Context, userID, categoryID int64) (jobs model.JobList, err error) {
+	query := `
+		SELECT
+		id, user_id
+		FROM feeds
+		WHERE user_id=$1 AND category_id=$2 AND deleted_at IS NULL AND NOT pending
+		ORDER BY checked_at ASC`
+
+	return s.fetchBatchRows(ctx, query, userID, categoryID)
 }
 
-func (s *Storage) fetchBatchRows(query string, args ...interface{}) (jobs model.JobList, err error) {
-	rows, err := s.db.Query(query, args...)
+func (s *Storage) fetchBatchRows(ctx context.Context, query string, args ...interface{}) (jobs model.JobList, err error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch batch of jobs: %v", err)
 	}
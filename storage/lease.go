@@ -0,0 +1,36 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AcquireLease takes or renews the lease of a scheduled job for the given duration.
+// It returns false while another holder has an unexpired lease, so a single
+// instance runs the job when several instances share the database.
+func (s *Storage) AcquireLease(ctx context.Context, name, holder string, duration time.Duration) (bool, error) {
+	query := `
+		INSERT INTO scheduler_leases (name, holder, expires_at)
+		VALUES ($1, $2, now() + $3 * interval '1 millisecond')
+		ON CONFLICT (name) DO UPDATE SET holder=EXCLUDED.holder, expires_at=EXCLUDED.expires_at
+		WHERE scheduler_leases.holder=EXCLUDED.holder OR scheduler_leases.expires_at < now()
+		RETURNING holder
+	`
+
+	var acquiredBy string
+	err := s.db.QueryRowContext(ctx, query, name, holder, duration.Nanoseconds()/int64(time.Millisecond)).Scan(&acquiredBy)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("unable to acquire lease %q: %v", name, err)
+	}
+
+	return true, nil
+}
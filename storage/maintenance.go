@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// TableStats returns the statistics of the biggest tables, a limit of zero returns all tables.
+func (s *Storage) TableStats(ctx context.Context, limit int) (model.TableStatsList, error) {
+	query := `
+		SELECT
+			relname,
+			n_live_tup,
+			n_dead_tup,
+			pg_total_relation_size(relid),
+			greatest(last_vacuum, last_autovacuum),
+			greatest(last_analyze, last_autoanalyze)
+		FROM pg_stat_user_tables
+		ORDER BY pg_total_relation_size(relid) DESC
+	`
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch table statistics: %v", err)
+	}
+	defer rows.Close()
+
+	var stats model.TableStatsList
+	for rows.Next() {
+		var table model.TableStats
+		if err := rows.Scan(
+			&table.Name,
+			&table.LiveTuples,
+			&table.DeadTuples,
+			&table.TotalSize,
+			&table.LastVacuum,
+			&table.LastAnalyze,
+		); err != nil {
+			return nil, fmt.Errorf("unable to fetch table statistics row: %v", err)
+		}
+
+		stats = append(stats, &table)
+	}
+
+	return stats, nil
+}
+
+// VacuumAnalyze reclaims the space of dead tuples and updates the planner statistics of a table.
+func (s *Storage) VacuumAnalyze(ctx context.Context, table string) error {
+	// VACUUM cannot run inside a transaction block, the statement is sent on its own.
+	if _, err := s.db.ExecContext(ctx, `VACUUM ANALYZE `+pq.QuoteIdentifier(table)); err != nil {
+		return fmt.Errorf("unable to vacuum table %q: %v", table, err)
+	}
+
+	return nil
+}
+
+// ReindexSearchIndex rebuilds the full-text search index of entries.
+func (s *Storage) ReindexSearchIndex(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, `REINDEX INDEX document_vectors_idx`); err != nil {
+		return fmt.Errorf("unable to reindex entries search index: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package memory implements the storage interfaces in memory, for unit tests and applications embedding the feed reader.
+
+*/
+package memory // import "miniflux.app/storage/memory"
@@ -0,0 +1,800 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package memory // import "miniflux.app/storage/memory"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+var _ storage.Store = (*Store)(nil)
+
+// Store keeps categories, feeds, entries and icons in memory.
+// Like the database, it returns copies of the stored objects.
+type Store struct {
+	mu         sync.RWMutex
+	lastID     int64
+	languages  map[int64]string
+	admins     map[int64]bool
+	thresholds map[int64]int
+	categories map[int64]*model.Category
+	feeds      map[int64]*model.Feed
+	entries    map[int64]*model.Entry
+	icons      map[int64]*model.Icon
+	responses  map[int64][]*model.FeedResponse
+	fetches    map[int64][]bool
+	watched    map[int64]string
+}
+
+// New returns an empty store.
+func New() *Store {
+	return &Store{
+		languages:  make(map[int64]string),
+		admins:     make(map[int64]bool),
+		thresholds: make(map[int64]int),
+		categories: make(map[int64]*model.Category),
+		feeds:      make(map[int64]*model.Feed),
+		entries:    make(map[int64]*model.Entry),
+		icons:      make(map[int64]*model.Icon),
+		responses:  make(map[int64][]*model.FeedResponse),
+		fetches:    make(map[int64][]bool),
+		watched:    make(map[int64]string),
+	}
+}
+
+func (s *Store) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+// SetUserLanguage changes the language returned for a user, the default is en_US.
+func (s *Store) SetUserLanguage(userID int64, language string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.languages[userID] = language
+}
+
+// UserLanguage returns the language of the given user.
+func (s *Store) UserLanguage(ctx context.Context, userID int64) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if language, found := s.languages[userID]; found {
+		return language
+	}
+
+	return "en_US"
+}
+
+// SetUserAdmin changes whether a user is an administrator, the users are not administrators by default.
+func (s *Store) SetUserAdmin(userID int64, admin bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.admins[userID] = admin
+}
+
+// UserIsAdmin returns true if the given user is an administrator.
+func (s *Store) UserIsAdmin(ctx context.Context, userID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.admins[userID]
+}
+
+// SetUserJunkThreshold changes the junk threshold of a user, the threshold is disabled by default.
+func (s *Store) SetUserJunkThreshold(userID int64, threshold int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.thresholds[userID] = threshold
+}
+
+// UserJunkThreshold returns the junk threshold of the given user.
+func (s *Store) UserJunkThreshold(ctx context.Context, userID int64) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.thresholds[userID]
+}
+
+// CategoryExists checks if the given category exists.
+func (s *Store) CategoryExists(ctx context.Context, userID, categoryID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.category(userID, categoryID) != nil
+}
+
+// Category returns a category, nil if not found.
+func (s *Store) Category(ctx context.Context, userID, categoryID int64) (*model.Category, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if category := s.category(userID, categoryID); category != nil {
+		clone := *category
+		return &clone, nil
+	}
+
+	return nil, nil
+}
+
+// FirstCategory returns the first category of the user sorted by title, nil if the user has none.
+func (s *Store) FirstCategory(ctx context.Context, userID int64) (*model.Category, error) {
+	categories, _ := s.Categories(ctx, userID)
+	if len(categories) == 0 {
+		return nil, nil
+	}
+
+	return categories[0], nil
+}
+
+// CategoryByTitle finds a category by the title, nil if not found.
+func (s *Store) CategoryByTitle(ctx context.Context, userID int64, title string) (*model.Category, error) {
+	categories, _ := s.Categories(ctx, userID)
+	for _, category := range categories {
+		if category.Title == title {
+			return category, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Categories returns the categories of a user sorted by title.
+func (s *Store) Categories(ctx context.Context, userID int64) (model.Categories, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	categories := make(model.Categories, 0)
+	for _, category := range s.categories {
+		if category.UserID == userID {
+			clone := *category
+			categories = append(categories, &clone)
+		}
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Title < categories[j].Title
+	})
+
+	return categories, nil
+}
+
+// CreateCategory creates a new category, the title must be unique for the user.
+func (s *Store) CreateCategory(ctx context.Context, category *model.Category) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.categories {
+		if existing.UserID == category.UserID && existing.Title == category.Title {
+			return fmt.Errorf("unable to create category: the title %q already exists", category.Title)
+		}
+	}
+
+	category.ID = s.nextID()
+	category.Version = 1
+	clone := *category
+	s.categories[category.ID] = &clone
+	return nil
+}
+
+// UpdateCategory updates an existing category, the version of the category must not have changed.
+func (s *Store) UpdateCategory(ctx context.Context, category *model.Category) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := s.category(category.UserID, category.ID)
+	if existing == nil {
+		return fmt.Errorf("unable to update category #%d: not found", category.ID)
+	}
+
+	if existing.Version != category.Version {
+		return storage.ErrConflict
+	}
+
+	category.Version++
+	existing.Title = category.Title
+	existing.MarkReadAfterDays = category.MarkReadAfterDays
+	existing.Version = category.Version
+	return nil
+}
+
+// RemoveCategory deletes a category with its feeds and entries.
+func (s *Store) RemoveCategory(ctx context.Context, userID, categoryID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.category(userID, categoryID) == nil {
+		return errors.New("no category has been removed")
+	}
+
+	delete(s.categories, categoryID)
+	for _, feed := range s.feeds {
+		if feed.Category.ID == categoryID {
+			s.removeFeed(feed.ID)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) category(userID, categoryID int64) *model.Category {
+	if category, found := s.categories[categoryID]; found && category.UserID == userID {
+		return category
+	}
+
+	return nil
+}
+
+// FeedExists checks if the given feed exists.
+func (s *Store) FeedExists(ctx context.Context, userID, feedID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.feed(userID, feedID) != nil
+}
+
+// FeedURLExists checks if the user is already subscribed to the feed URL.
+func (s *Store) FeedURLExists(ctx context.Context, userID int64, feedURL string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, feed := range s.feeds {
+		if feed.UserID == userID && feed.FeedURL == feedURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FeedIDByURL returns the ID of the feed matching the given feed or website URL, zero if the user is not subscribed.
+func (s *Store) FeedIDByURL(ctx context.Context, userID int64, websiteURL string) int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var feedID int64
+	for _, feed := range s.feeds {
+		if feed.UserID != userID || (feedID != 0 && feed.ID > feedID) {
+			continue
+		}
+
+		if feed.FeedURL == websiteURL || strings.TrimRight(feed.SiteURL, "/") == strings.TrimRight(websiteURL, "/") {
+			feedID = feed.ID
+		}
+	}
+
+	return feedID
+}
+
+// Feeds returns the feeds of a user, feeds with errors first, then sorted by title.
+func (s *Store) Feeds(ctx context.Context, userID int64) (model.Feeds, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	feeds := make(model.Feeds, 0)
+	for _, feed := range s.feeds {
+		if feed.UserID == userID {
+			feeds = append(feeds, s.copyFeed(feed))
+		}
+	}
+
+	sort.Slice(feeds, func(i, j int) bool {
+		if feeds[i].ParsingErrorCount != feeds[j].ParsingErrorCount {
+			return feeds[i].ParsingErrorCount > feeds[j].ParsingErrorCount
+		}
+		return strings.ToLower(feeds[i].Title) < strings.ToLower(feeds[j].Title)
+	})
+
+	return feeds, nil
+}
+
+// FeedByID returns a feed, nil if not found.
+func (s *Store) FeedByID(ctx context.Context, userID, feedID int64) (*model.Feed, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if feed := s.feed(userID, feedID); feed != nil {
+		return s.copyFeed(feed), nil
+	}
+
+	return nil, nil
+}
+
+// CreateFeed creates a new feed with its entries, the feed URL must be unique for the user.
+func (s *Store) CreateFeed(ctx context.Context, feed *model.Feed) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.feeds {
+		if existing.UserID == feed.UserID && existing.FeedURL == feed.FeedURL {
+			return fmt.Errorf("unable to create feed %q: already subscribed", feed.FeedURL)
+		}
+	}
+
+	if feed.Category == nil || s.category(feed.UserID, feed.Category.ID) == nil {
+		return fmt.Errorf("unable to create feed %q: the category does not exist", feed.FeedURL)
+	}
+
+	feed.ID = s.nextID()
+	feed.Version = 1
+	s.saveFeed(feed)
+
+	for _, entry := range feed.Entries {
+		entry.FeedID = feed.ID
+		entry.UserID = feed.UserID
+		s.createEntry(entry)
+	}
+
+	return nil
+}
+
+// UpdateFeed updates an existing feed, the entries are not modified and the version of the feed must not have changed.
+func (s *Store) UpdateFeed(ctx context.Context, feed *model.Feed) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing := s.feed(feed.UserID, feed.ID)
+	if existing == nil || existing.Version != feed.Version {
+		return storage.ErrConflict
+	}
+
+	feed.Version++
+	s.saveFeed(feed)
+	return nil
+}
+
+// UpdateFeedRefresh saves the result of a refresh without changing the settings of the feed.
+func (s *Store) UpdateFeedRefresh(ctx context.Context, feed *model.Feed) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing := s.feed(feed.UserID, feed.ID); existing != nil {
+		existing.FeedURL = feed.FeedURL
+		existing.SiteURL = feed.SiteURL
+		existing.EtagHeader = feed.EtagHeader
+		existing.LastModifiedHeader = feed.LastModifiedHeader
+		existing.CheckedAt = feed.CheckedAt
+		existing.ParsingErrorMsg = feed.ParsingErrorMsg
+		existing.ParsingErrorCount = feed.ParsingErrorCount
+	}
+
+	return nil
+}
+
+// UpdateFeedError updates the error message and counter of a feed.
+func (s *Store) UpdateFeedError(ctx context.Context, feed *model.Feed) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing := s.feed(feed.UserID, feed.ID); existing != nil {
+		existing.ParsingErrorMsg = feed.ParsingErrorMsg
+		existing.ParsingErrorCount = feed.ParsingErrorCount
+		existing.CheckedAt = feed.CheckedAt
+	}
+
+	return nil
+}
+
+// RemoveFeed deletes a feed with its entries.
+func (s *Store) RemoveFeed(ctx context.Context, userID, feedID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.feed(userID, feedID) == nil {
+		return errors.New("no feed has been removed")
+	}
+
+	s.removeFeed(feedID)
+	return nil
+}
+
+// ArchiveFeedResponse keeps a document fetched for a feed, only the newest keep documents are kept.
+func (s *Store) ArchiveFeedResponse(ctx context.Context, response *model.FeedResponse, keep int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response.ID = s.nextID()
+	response.Size = len(response.Content)
+	response.CreatedAt = time.Now()
+
+	clone := *response
+	responses := append([]*model.FeedResponse{&clone}, s.responses[response.FeedID]...)
+	if len(responses) > keep {
+		responses = responses[:keep]
+	}
+
+	s.responses[response.FeedID] = responses
+	return nil
+}
+
+// RecordFeedFetch keeps the result of a feed refresh.
+func (s *Store) RecordFeedFetch(ctx context.Context, feedID int64, success bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fetches[feedID] = append(s.fetches[feedID], success)
+	return nil
+}
+
+// WatchedContent returns the text of the watched region of a web page from the last refresh.
+func (s *Store) WatchedContent(ctx context.Context, feedID int64) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.watched[feedID], nil
+}
+
+// UpdateWatchedContent saves the text of the watched region of a web page.
+func (s *Store) UpdateWatchedContent(ctx context.Context, feedID int64, content string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.watched[feedID] = content
+	return nil
+}
+
+// ArchivedResponses returns the documents archived for a feed, newest first.
+func (s *Store) ArchivedResponses(feedID int64) model.FeedResponses {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var responses model.FeedResponses
+	for _, response := range s.responses[feedID] {
+		clone := *response
+		responses = append(responses, &clone)
+	}
+
+	return responses
+}
+
+// Fetches returns the results of the refreshes of a feed, oldest first.
+func (s *Store) Fetches(feedID int64) []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]bool(nil), s.fetches[feedID]...)
+}
+
+func (s *Store) feed(userID, feedID int64) *model.Feed {
+	if feed, found := s.feeds[feedID]; found && feed.UserID == userID {
+		return feed
+	}
+
+	return nil
+}
+
+func (s *Store) saveFeed(feed *model.Feed) {
+	clone := *feed
+	clone.Entries = nil
+	clone.Icon = nil
+	clone.Category = &model.Category{ID: feed.Category.ID, UserID: feed.UserID}
+	s.feeds[feed.ID] = &clone
+}
+
+func (s *Store) copyFeed(feed *model.Feed) *model.Feed {
+	clone := *feed
+	clone.Category = &model.Category{ID: feed.Category.ID, UserID: feed.UserID}
+	if category, found := s.categories[feed.Category.ID]; found {
+		clone.Category.Title = category.Title
+	}
+
+	if icon, found := s.icons[feed.ID]; found {
+		clone.Icon = &model.FeedIcon{FeedID: feed.ID, IconID: icon.ID}
+	}
+
+	return &clone
+}
+
+func (s *Store) removeFeed(feedID int64) {
+	delete(s.feeds, feedID)
+	delete(s.icons, feedID)
+	delete(s.responses, feedID)
+
+	for id, entry := range s.entries {
+		if entry.FeedID == feedID {
+			delete(s.entries, id)
+		}
+	}
+}
+
+// HasIcon checks if the given feed has an icon.
+func (s *Store) HasIcon(ctx context.Context, feedID int64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, found := s.icons[feedID]
+	return found
+}
+
+// IconByFeedID returns the icon of a feed.
+func (s *Store) IconByFeedID(ctx context.Context, userID, feedID int64) (*model.Icon, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	icon, found := s.icons[feedID]
+	if !found || s.feed(userID, feedID) == nil {
+		return nil, fmt.Errorf("unable to fetch icon: feed #%d has no icon", feedID)
+	}
+
+	clone := *icon
+	return &clone, nil
+}
+
+// CreateFeedIcon attaches an icon to a feed.
+func (s *Store) CreateFeedIcon(ctx context.Context, feedID int64, icon *model.Icon) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if icon.ID == 0 {
+		icon.ID = s.nextID()
+	}
+
+	clone := *icon
+	s.icons[feedID] = &clone
+	return nil
+}
+
+// EntryURLExists returns true if an entry of the user has this URL.
+func (s *Store) EntryURLExists(ctx context.Context, userID int64, entryURL string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, entry := range s.entries {
+		if entry.UserID == userID && entry.URL == entryURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpdateEntries creates the new entries of a feed and updates the existing ones if asked.
+// Entries republished with a new hash are merged according to the entry matching of the feed.
+// Removed entries that are no longer in the feed are deleted.
+func (s *Store) UpdateEntries(ctx context.Context, userID, feedID int64, entries model.Entries, updateExistingEntries bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matching string
+	if feed, found := s.feeds[feedID]; found {
+		matching = feed.EntryMatching
+	}
+
+	hashes := make(map[string]bool)
+	for _, entry := range entries {
+		entry.UserID = userID
+		entry.FeedID = feedID
+		hashes[entry.Hash] = true
+
+		existing := s.entryByHash(feedID, entry.Hash)
+		if existing == nil {
+			existing = s.republishedEntry(entry, matching)
+		}
+
+		if existing != nil {
+			existing.Hash = entry.Hash
+			if updateExistingEntries {
+				entry.ID = existing.ID
+				existing.Title = entry.Title
+				existing.URL = entry.URL
+				existing.CommentsURL = entry.CommentsURL
+				existing.Content = entry.Content
+				existing.Author = entry.Author
+			}
+			continue
+		}
+
+		s.createEntry(entry)
+	}
+
+	for id, entry := range s.entries {
+		if entry.FeedID == feedID && entry.Status == model.EntryStatusRemoved && !hashes[entry.Hash] {
+			delete(s.entries, id)
+		}
+	}
+
+	return nil
+}
+
+// TrimFeedEntries enforces the entry limit of a feed and returns the number of entries over the limit.
+func (s *Store) TrimFeedEntries(ctx context.Context, feed *model.Feed) (int, error) {
+	if feed.MaxEntries <= 0 {
+		return 0, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hashes := make(map[string]bool)
+	for _, entry := range feed.Entries {
+		hashes[entry.Hash] = true
+	}
+
+	var entries model.Entries
+	for _, entry := range s.entries {
+		if entry.FeedID == feed.ID && entry.Status != model.EntryStatusRemoved && !entry.Starred {
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) <= feed.MaxEntries {
+		return 0, nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].ID > entries[j].ID
+		}
+		return entries[i].Date.After(entries[j].Date)
+	})
+
+	for _, entry := range entries[feed.MaxEntries:] {
+		if feed.OverflowPolicy == model.OverflowPolicyDelete && !hashes[entry.Hash] {
+			delete(s.entries, entry.ID)
+		} else {
+			entry.Status = model.EntryStatusRemoved
+		}
+	}
+
+	return len(entries) - feed.MaxEntries, nil
+}
+
+// SaveEntry stores an entry added outside of a feed refresh, the entry is updated if its hash already exists in the feed.
+func (s *Store) SaveEntry(ctx context.Context, entry *model.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing := s.entryByHash(entry.FeedID, entry.Hash); existing != nil {
+		entry.ID = existing.ID
+		existing.Title = entry.Title
+		existing.URL = entry.URL
+		existing.Content = entry.Content
+		return nil
+	}
+
+	s.createEntry(entry)
+	if entry.ID == 0 {
+		return fmt.Errorf("unable to save entry %q: an entry with the same title already exists", entry.URL)
+	}
+
+	return nil
+}
+
+// SetEntriesStatus updates the status of the given entries.
+func (s *Store) SetEntriesStatus(ctx context.Context, userID int64, entryIDs []int64, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, entryID := range entryIDs {
+		if entry, found := s.entries[entryID]; found && entry.UserID == userID {
+			entry.Status = status
+			count++
+		}
+	}
+
+	if count == 0 {
+		return errors.New("nothing has been updated")
+	}
+
+	return nil
+}
+
+// MarkAllAsRead updates all unread entries of a user to the read status.
+func (s *Store) MarkAllAsRead(ctx context.Context, userID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, entry := range s.entries {
+		if entry.UserID == userID && entry.Status == model.EntryStatusUnread {
+			entry.Status = model.EntryStatusRead
+		}
+	}
+
+	return nil
+}
+
+// MarkFeedAsRead updates the unread entries of a feed published before the given date to the read status.
+func (s *Store) MarkFeedAsRead(ctx context.Context, userID, feedID int64, before time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, entry := range s.entries {
+		if entry.UserID == userID && entry.FeedID == feedID && entry.Status == model.EntryStatusUnread && entry.Date.Before(before) {
+			entry.Status = model.EntryStatusRead
+		}
+	}
+
+	return nil
+}
+
+// CountUnreadEntries returns the number of unread entries of a user.
+func (s *Store) CountUnreadEntries(ctx context.Context, userID int64) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	count := 0
+	for _, entry := range s.entries {
+		if entry.UserID == userID && entry.Status == model.EntryStatusUnread {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Entries returns the entries of a feed sorted by ID.
+func (s *Store) Entries(feedID int64) model.Entries {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries model.Entries
+	for _, entry := range s.entries {
+		if entry.FeedID == feedID {
+			clone := *entry
+			entries = append(entries, &clone)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+
+	return entries
+}
+
+func (s *Store) entryByHash(feedID int64, hash string) *model.Entry {
+	for _, entry := range s.entries {
+		if entry.FeedID == feedID && entry.Hash == hash {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) republishedEntry(entry *model.Entry, matching string) *model.Entry {
+	for _, existing := range s.entries {
+		if existing.FeedID == entry.FeedID && entry.IsRepublishedAs(existing, matching) {
+			return existing
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) createEntry(entry *model.Entry) {
+	// Like the database storage, entries with an existing title are ignored.
+	for _, existing := range s.entries {
+		if existing.Title == entry.Title {
+			return
+		}
+	}
+
+	entry.ID = s.nextID()
+	if entry.Status == "" {
+		entry.Status = model.EntryStatusUnread
+	}
+	for _, enclosure := range entry.Enclosures {
+		enclosure.ID = s.nextID()
+		enclosure.EntryID = entry.ID
+		enclosure.UserID = entry.UserID
+	}
+
+	clone := *entry
+	clone.Feed = nil
+	s.entries[entry.ID] = &clone
+}
@@ -0,0 +1,162 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"miniflux.app/eventbus"
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// RecordFailedEvent saves a sync event that could not be published, it can be replayed later.
+func (s *Storage) RecordFailedEvent(ctx context.Context, event gcppubsub.SyncEvent, reason string) error {
+	query := `
+		INSERT INTO pubsub_outbox (entity_type, entity_id, entity_op, user_id, data, status, error_msg)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	var data interface{}
+	if len(event.Data) > 0 {
+		data = string(event.Data)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, event.EntityType, event.EntityID, event.EntityOp, event.UserID, data, model.OutboxStatusFailed, reason); err != nil {
+		return fmt.Errorf("unable to record failed event: %v", err)
+	}
+
+	return nil
+}
+
+// OutboxEvents returns the outbox events with the given status, oldest first.
+func (s *Storage) OutboxEvents(ctx context.Context, status string, limit int) (model.OutboxEvents, error) {
+	query := `
+		SELECT id, entity_type, entity_id, entity_op, user_id, data, status, error_msg, attempts, created_at, updated_at
+		FROM pubsub_outbox
+		WHERE status=$1
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+	`
+	return s.fetchOutboxEvents(ctx, query, status, limit)
+}
+
+// OutboxBacklog returns the number of outbox events by status.
+func (s *Storage) OutboxBacklog(ctx context.Context) (*model.OutboxBacklog, error) {
+	query := `
+		SELECT
+			count(*) FILTER (WHERE status=$1),
+			count(*) FILTER (WHERE status=$2),
+			min(created_at) FILTER (WHERE status=$1)
+		FROM pubsub_outbox
+	`
+
+	var backlog model.OutboxBacklog
+	err := s.db.QueryRowContext(ctx, query, model.OutboxStatusFailed, model.OutboxStatusDiscarded).Scan(
+		&backlog.Failed,
+		&backlog.Discarded,
+		&backlog.OldestEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch outbox backlog: %v", err)
+	}
+
+	return &backlog, nil
+}
+
+// ReplayOutboxEvents publishes again the failed events, all of them when no ID is given.
+// Published events are removed from the outbox, the others stay there with an updated error.
+func (s *Storage) ReplayOutboxEvents(ctx context.Context, eventIDs []int64, limit int) (*model.OutboxReplay, error) {
+	if !eventbus.IsEnabled(s.pub) {
+		return nil, errors.New("unable to replay events: the event bus is not configured")
+	}
+
+	query := `
+		SELECT id, entity_type, entity_id, entity_op, user_id, data, status, error_msg, attempts, created_at, updated_at
+		FROM pubsub_outbox
+		WHERE status=$1 AND (coalesce(cardinality($2::bigint[]), 0) = 0 OR id = ANY($2))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $3
+	`
+	events, err := s.fetchOutboxEvents(ctx, query, model.OutboxStatusFailed, pq.Array(eventIDs), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var result model.OutboxReplay
+	for _, event := range events {
+		publishErr := s.pub.Publish(gcppubsub.SyncEvent{
+			EntityType: event.EntityType,
+			EntityID:   event.EntityID,
+			EntityOp:   event.EntityOp,
+			UserID:     event.UserID,
+			Data:       event.Data,
+		})
+
+		if publishErr == nil {
+			result.Replayed++
+			if _, err := s.db.ExecContext(ctx, `DELETE FROM pubsub_outbox WHERE id=$1`, event.ID); err != nil {
+				return nil, fmt.Errorf("unable to remove replayed event: %v", err)
+			}
+			continue
+		}
+
+		result.Failed++
+		query := `UPDATE pubsub_outbox SET attempts=attempts+1, error_msg=$1, updated_at=now() WHERE id=$2`
+		if _, err := s.db.ExecContext(ctx, query, publishErr.Error(), event.ID); err != nil {
+			return nil, fmt.Errorf("unable to update failed event: %v", err)
+		}
+	}
+
+	return &result, nil
+}
+
+// DiscardOutboxEvents marks the given failed events as discarded, they are no longer replayed.
+func (s *Storage) DiscardOutboxEvents(ctx context.Context, eventIDs []int64) (int64, error) {
+	query := `UPDATE pubsub_outbox SET status=$1, updated_at=now() WHERE status=$2 AND id = ANY($3)`
+	result, err := s.db.ExecContext(ctx, query, model.OutboxStatusDiscarded, model.OutboxStatusFailed, pq.Array(eventIDs))
+	if err != nil {
+		return 0, fmt.Errorf("unable to discard events: %v", err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
+
+func (s *Storage) fetchOutboxEvents(ctx context.Context, query string, args ...interface{}) (model.OutboxEvents, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch outbox events: %v", err)
+	}
+	defer rows.Close()
+
+	events := make(model.OutboxEvents, 0)
+	for rows.Next() {
+		var event model.OutboxEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.EntityType,
+			&event.EntityID,
+			&event.EntityOp,
+			&event.UserID,
+			&event.Data,
+			&event.Status,
+			&event.ErrorMsg,
+			&event.Attempts,
+			&event.CreatedAt,
+			&event.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch outbox event row: %v", err)
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
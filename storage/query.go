@@ -0,0 +1,207 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	// queryStatsSampleSize is the number of recent queries used to compute the quantiles.
+	queryStatsSampleSize = 1000
+
+	explainTimeout = 30 * time.Second
+)
+
+// database runs the queries of the storage and measures their duration.
+type database struct {
+	*sql.DB
+	log *queryLog
+}
+
+// transaction runs the queries of a transaction and measures their duration.
+type transaction struct {
+	*sql.Tx
+	db *database
+}
+
+// QueryContext executes a query that returns rows.
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	start := time.Now()
+	rows, err := d.DB.QueryContext(ctx, query, args...)
+	d.log.observe(d.DB, query, args, start)
+	return rows, err
+}
+
+// QueryRowContext executes a query that returns at most one row.
+func (d *database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := d.DB.QueryRowContext(ctx, query, args...)
+	d.log.observe(d.DB, query, args, start)
+	return row
+}
+
+// ExecContext executes a query without returning any rows.
+func (d *database) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	start := time.Now()
+	result, err := d.DB.ExecContext(ctx, query, args...)
+	d.log.observe(d.DB, query, args, start)
+	return result, err
+}
+
+// BeginTx starts a transaction whose queries are measured like the other ones.
+func (d *database) BeginTx(ctx context.Context, opts *sql.TxOptions) (*transaction, error) {
+	tx, err := d.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction{Tx: tx, db: d}, nil
+}
+
+// QueryContext executes a query that returns rows.
+func (t *transaction) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	start := time.Now()
+	rows, err := t.Tx.QueryContext(ctx, query, args...)
+	t.db.log.observe(t.db.DB, query, args, start)
+	return rows, err
+}
+
+// QueryRowContext executes a query that returns at most one row.
+func (t *transaction) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := t.Tx.QueryRowContext(ctx, query, args...)
+	t.db.log.observe(t.db.DB, query, args, start)
+	return row
+}
+
+// ExecContext executes a query without returning any rows.
+func (t *transaction) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	start := time.Now()
+	result, err := t.Tx.ExecContext(ctx, query, args...)
+	t.db.log.observe(t.db.DB, query, args, start)
+	return result, err
+}
+
+// queryLog keeps the statistics of the queries and logs the slow ones.
+type queryLog struct {
+	mu        sync.Mutex
+	threshold time.Duration
+	explain   bool
+	count     int64
+	slowCount int64
+	total     time.Duration
+	recent    []time.Duration
+	next      int
+}
+
+// LogSlowQueries logs the queries taking longer than threshold, a zero threshold disables the log.
+// When explain is true, the plan of slow SELECT queries is logged with EXPLAIN ANALYZE.
+func (s *Storage) LogSlowQueries(threshold time.Duration, explain bool) {
+	s.queries.mu.Lock()
+	defer s.queries.mu.Unlock()
+
+	s.queries.threshold = threshold
+	s.queries.explain = explain
+}
+
+// QueryStats returns the duration of the queries executed since startup.
+func (s *Storage) QueryStats() *model.QueryStats {
+	return s.queries.stats()
+}
+
+func (l *queryLog) stats() *model.QueryStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	recent := make([]time.Duration, len(l.recent))
+	copy(recent, l.recent)
+
+	return &model.QueryStats{
+		Count:     l.count,
+		SlowCount: l.slowCount,
+		Total:     l.total,
+		Recent:    recent,
+	}
+}
+
+// observe records a query, it must be called directly by the method executing the query.
+func (l *queryLog) observe(db *sql.DB, query string, args []interface{}, start time.Time) {
+	elapsed := time.Since(start)
+
+	l.mu.Lock()
+	l.count++
+	l.total += elapsed
+	if len(l.recent) < queryStatsSampleSize {
+		l.recent = append(l.recent, elapsed)
+	} else {
+		l.recent[l.next] = elapsed
+		l.next = (l.next + 1) % queryStatsSampleSize
+	}
+
+	slow := l.threshold > 0 && elapsed >= l.threshold
+	if slow {
+		l.slowCount++
+	}
+	explain := l.explain
+	l.mu.Unlock()
+
+	if !slow {
+		return
+	}
+
+	// The arguments are not logged, they can contain passwords and tokens.
+	caller := queryCaller()
+	statement := strings.Join(strings.Fields(query), " ")
+	logger.Info("[Storage:SlowQuery] %s took %v: %s", caller, elapsed, statement)
+
+	// EXPLAIN ANALYZE executes the statement, the other queries could change data twice.
+	if explain && strings.HasPrefix(strings.ToUpper(statement), "SELECT") {
+		go explainQuery(db, caller, query, args)
+	}
+}
+
+// queryCaller returns the storage function that executed the query.
+func queryCaller() string {
+	// Skip queryCaller, queryLog.observe and the method of database or transaction.
+	pc, _, _, ok := runtime.Caller(3)
+	if !ok {
+		return "unknown"
+	}
+
+	return strings.TrimPrefix(runtime.FuncForPC(pc).Name(), "miniflux.app/storage.")
+}
+
+func explainQuery(db *sql.DB, caller, query string, args []interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), explainTimeout)
+	defer cancel()
+
+	rows, err := db.QueryContext(ctx, "EXPLAIN ANALYZE "+query, args...)
+	if err != nil {
+		logger.Error("[Storage:SlowQuery] Unable to explain the query of %s: %v", caller, err)
+		return
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			logger.Error("[Storage:SlowQuery] Unable to read the plan of %s: %v", caller, err)
+			return
+		}
+		plan = append(plan, line)
+	}
+
+	logger.Debug("[Storage:SlowQuery] Plan of %s:\n%s", caller, strings.Join(plan, "\n"))
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// CreateRefreshJob records a new refresh job for the given list of jobs and links them to it.
+func (s *Storage) CreateRefreshJob(ctx context.Context, userID int64, jobs model.JobList) (*model.RefreshJob, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	refreshJob := &model.RefreshJob{UserID: userID}
+	query := `INSERT INTO refresh_jobs (user_id) VALUES ($1) RETURNING id, created_at`
+	if err := tx.QueryRowContext(ctx, query, userID).Scan(&refreshJob.ID, &refreshJob.CreatedAt); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("unable to create refresh job: %v", err)
+	}
+
+	for i := range jobs {
+		query = `INSERT INTO refresh_job_feeds (job_id, feed_id, status) VALUES ($1, $2, $3)`
+		if _, err := tx.ExecContext(ctx, query, refreshJob.ID, jobs[i].FeedID, model.RefreshJobStatusPending); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("unable to add feed #%d to refresh job: %v", jobs[i].FeedID, err)
+		}
+
+		jobs[i].RefreshJobID = refreshJob.ID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("unable to commit refresh job: %v", err)
+	}
+
+	return refreshJob, nil
+}
+
+// UpdateRefreshJobFeed stores the result of a feed refresh for the given refresh job.
+func (s *Storage) UpdateRefreshJobFeed(ctx context.Context, jobID, feedID int64, errorMsg string) error {
+	status := model.RefreshJobStatusSucceeded
+	if errorMsg != "" {
+		status = model.RefreshJobStatusFailed
+	}
+
+	query := `UPDATE refresh_job_feeds SET status=$1, error_msg=$2, updated_at=now() WHERE job_id=$3 AND feed_id=$4`
+	if _, err := s.db.ExecContext(ctx, query, status, errorMsg, jobID, feedID); err != nil {
+		return fmt.Errorf("unable to update refresh job #%d: %v", jobID, err)
+	}
+
+	return nil
+}
+
+// RefreshJob returns a refresh job with the progress of each feed.
+func (s *Storage) RefreshJob(ctx context.Context, userID, jobID int64) (*model.RefreshJob, error) {
+	var refreshJob model.RefreshJob
+	query := `SELECT id, user_id, created_at FROM refresh_jobs WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRowContext(ctx, query, userID, jobID).Scan(&refreshJob.ID, &refreshJob.UserID, &refreshJob.CreatedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch refresh job #%d: %v", jobID, err)
+	}
+
+	query = `
+		SELECT
+			j.feed_id, f.title, j.status, j.error_msg, j.updated_at
+		FROM refresh_job_feeds j
+		LEFT JOIN feeds f ON f.id=j.feed_id
+		WHERE j.job_id=$1
+		ORDER BY lower(f.title) ASC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch refresh job feeds: %v", err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.RefreshJobFeeds, 0)
+	for rows.Next() {
+		var feed model.RefreshJobFeed
+		if err := rows.Scan(&feed.FeedID, &feed.FeedTitle, &feed.Status, &feed.ErrorMsg, &feed.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch refresh job feed row: %v", err)
+		}
+
+		feeds = append(feeds, &feed)
+	}
+
+	refreshJob.WithFeeds(feeds)
+	return &refreshJob, nil
+}
+
+// CleanOldRefreshJobs removes refresh jobs older than one day.
+func (s *Storage) CleanOldRefreshJobs(ctx context.Context) int64 {
+	query := `DELETE FROM refresh_jobs WHERE id IN (SELECT id FROM refresh_jobs WHERE created_at < now() - interval '1 day')`
+	result, err := s.db.ExecContext(ctx, query)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"miniflux.app/cache"
+	"miniflux.app/logger"
+)
+
+const (
+	replicaLagCheckInterval = 10 * time.Second
+	replicaWritesCacheSize  = 10000
+)
+
+// replica is a read-only database used for heavy read queries.
+// Users who changed entries recently are sent to the primary until the replica has caught up.
+type replica struct {
+	db     *database
+	maxLag time.Duration
+
+	// lag is the last replication delay measured, in nanoseconds, -1 means unknown.
+	lag int64
+
+	// writes holds the users who changed entries during the last maxLag.
+	writes *cache.LRU
+}
+
+func replicaWriteCacheKey(userID int64) string {
+	return fmt.Sprintf("replica_write:%d", userID)
+}
+
+// AddReadReplica routes entry lists, searches and counters to a read-only database.
+// The replica is not used while its replication delay is above maxLag.
+func (s *Storage) AddReadReplica(db *sql.DB, maxLag time.Duration) {
+	s.replica = &replica{
+		db:     &database{DB: db, log: s.queries},
+		maxLag: maxLag,
+		lag:    -1,
+		writes: cache.NewLRU(replicaWritesCacheSize, maxLag),
+	}
+
+	go s.replica.monitorLag()
+}
+
+// reader returns the database to use for heavy read queries of a user.
+func (s *Storage) reader(userID int64) *database {
+	if s.replica == nil || !s.replica.isAvailable() {
+		return s.db
+	}
+
+	if _, found := s.replica.writes.Get(userID); found {
+		return s.db
+	}
+
+	if _, found := s.cache.Get(replicaWriteCacheKey(userID)); found {
+		return s.db
+	}
+
+	return s.replica.db
+}
+
+// entriesChanged must be called after changing the entries of a user,
+// it removes the unread counter and sends the user to the primary until the replica has caught up.
+func (s *Storage) entriesChanged(userID int64) {
+	s.cache.Delete(unreadCountCacheKey(userID))
+
+	if s.replica != nil {
+		s.replica.writes.Add(userID, true)
+		s.cache.Set(replicaWriteCacheKey(userID), []byte("1"), s.replica.maxLag)
+	}
+}
+
+func (r *replica) isAvailable() bool {
+	lag := atomic.LoadInt64(&r.lag)
+	return lag >= 0 && time.Duration(lag) <= r.maxLag
+}
+
+func (r *replica) monitorLag() {
+	r.checkLag()
+	for range time.Tick(replicaLagCheckInterval) {
+		r.checkLag()
+	}
+}
+
+// checkLag measures the replication delay, the replica is up to date when all received WAL has been replayed.
+func (r *replica) checkLag() {
+	query := `
+		SELECT
+			CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+			END
+	`
+
+	var seconds float64
+	if err := r.db.QueryRow(query).Scan(&seconds); err != nil {
+		logger.Error("[Storage:Replica] Unable to check replication lag: %v", err)
+		atomic.StoreInt64(&r.lag, -1)
+		return
+	}
+
+	lag := time.Duration(seconds * float64(time.Second))
+	if lag > r.maxLag {
+		logger.Info("[Storage:Replica] Replication lag is %v, reading from the primary", lag)
+	}
+
+	atomic.StoreInt64(&r.lag, int64(lag))
+}
@@ -0,0 +1,27 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"fmt"
+
+	"miniflux.app/crypto"
+)
+
+// Secret returns the key with the given name shared by all instances, it is generated on first use.
+func (s *Storage) Secret(ctx context.Context, name string) ([]byte, error) {
+	query := `INSERT INTO secrets (name, value) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`
+	if _, err := s.db.ExecContext(ctx, query, name, crypto.GenerateRandomBytes(32)); err != nil {
+		return nil, fmt.Errorf("unable to create secret: %v", err)
+	}
+
+	var value []byte
+	if err := s.db.QueryRowContext(ctx, `SELECT value FROM secrets WHERE name=$1`, name).Scan(&value); err != nil {
+		return nil, fmt.Errorf("unable to fetch secret: %v", err)
+	}
+
+	return value, nil
+}
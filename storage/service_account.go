@@ -0,0 +1,225 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// ServiceAccountExists returns true if the user already has a service account with this name.
+func (s *Storage) ServiceAccountExists(ctx context.Context, userID int64, name string) bool {
+	var result bool
+	query := `SELECT true FROM service_accounts WHERE user_id=$1 AND name=$2`
+	s.db.QueryRowContext(ctx, query, userID, name).Scan(&result)
+	return result
+}
+
+// CreateServiceAccount creates a service account acting on the data of its owner.
+func (s *Storage) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) error {
+	err := s.db.QueryRowContext(
+		ctx,
+		`INSERT INTO service_accounts (user_id, name, scopes, category_ids)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`,
+		account.UserID,
+		account.Name,
+		pq.Array(account.Scopes),
+		pq.Array(account.CategoryIDs),
+	).Scan(&account.ID, &account.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf("unable to create service account: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateServiceAccount changes the name, the scopes and the categories of a service account.
+func (s *Storage) UpdateServiceAccount(ctx context.Context, account *model.ServiceAccount) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		`UPDATE service_accounts SET name=$1, scopes=$2, category_ids=$3 WHERE id=$4 AND user_id=$5`,
+		account.Name,
+		pq.Array(account.Scopes),
+		pq.Array(account.CategoryIDs),
+		account.ID,
+		account.UserID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update service account #%d: %v", account.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveServiceAccount deletes a service account and its API keys, false is returned if it does not exist.
+func (s *Storage) RemoveServiceAccount(ctx context.Context, userID, accountID int64) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM service_accounts WHERE id=$1 AND user_id=$2`, accountID, userID)
+	if err != nil {
+		return false, fmt.Errorf("unable to remove service account #%d: %v", accountID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to remove service account #%d: %v", accountID, err)
+	}
+
+	return count > 0, nil
+}
+
+// ServiceAccount returns a service account of the user, nil is returned if it does not exist.
+func (s *Storage) ServiceAccount(ctx context.Context, userID, accountID int64) (*model.ServiceAccount, error) {
+	return s.fetchServiceAccount(
+		ctx,
+		`SELECT id, user_id, name, scopes, category_ids, created_at FROM service_accounts WHERE id=$1 AND user_id=$2`,
+		accountID,
+		userID,
+	)
+}
+
+// ServiceAccountByAPIKey returns the service account authenticated by the key and records its use.
+// Nil is returned if the key is unknown or if the owner cannot log in anymore.
+func (s *Storage) ServiceAccountByAPIKey(ctx context.Context, key string) (*model.ServiceAccount, error) {
+	return s.fetchServiceAccount(
+		ctx,
+		`WITH used AS (
+			UPDATE api_keys SET last_used_at=now() WHERE hash=$1 RETURNING service_account_id
+		)
+		SELECT s.id, s.user_id, s.name, s.scopes, s.category_ids, s.created_at
+		FROM service_accounts s
+		JOIN used ON used.service_account_id=s.id
+		JOIN users u ON u.id=s.user_id
+		WHERE u.verified AND NOT u.pending AND u.deletion_requested_at IS NULL`,
+		crypto.Hash(key),
+	)
+}
+
+// ServiceAccounts returns the service accounts of the user.
+func (s *Storage) ServiceAccounts(ctx context.Context, userID int64) (model.ServiceAccounts, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, user_id, name, scopes, category_ids, created_at FROM service_accounts WHERE user_id=$1 ORDER BY name ASC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch service accounts: %v", err)
+	}
+	defer rows.Close()
+
+	accounts := make(model.ServiceAccounts, 0)
+	for rows.Next() {
+		account, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch service accounts row: %v", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+func (s *Storage) fetchServiceAccount(ctx context.Context, query string, args ...interface{}) (*model.ServiceAccount, error) {
+	account, err := scanServiceAccount(s.db.QueryRowContext(ctx, query, args...))
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch service account: %v", err)
+	}
+
+	return account, nil
+}
+
+type serviceAccountScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanServiceAccount(row serviceAccountScanner) (*model.ServiceAccount, error) {
+	var account model.ServiceAccount
+	err := row.Scan(
+		&account.ID,
+		&account.UserID,
+		&account.Name,
+		pq.Array(&account.Scopes),
+		pq.Array(&account.CategoryIDs),
+		&account.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// CreateAPIKey stores a new key of a service account and returns its secret value,
+// the value cannot be retrieved later since only its hash is stored.
+func (s *Storage) CreateAPIKey(ctx context.Context, key *model.APIKey) (string, error) {
+	value := crypto.GenerateRandomString(32)
+	err := s.db.QueryRowContext(
+		ctx,
+		`INSERT INTO api_keys (service_account_id, description, hash)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`,
+		key.ServiceAccountID,
+		key.Description,
+		crypto.Hash(value),
+	).Scan(&key.ID, &key.CreatedAt)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to create API key: %v", err)
+	}
+
+	return value, nil
+}
+
+// APIKeys returns the keys of a service account, most recent first.
+func (s *Storage) APIKeys(ctx context.Context, accountID int64) (model.APIKeys, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, service_account_id, description, created_at, last_used_at
+		FROM api_keys
+		WHERE service_account_id=$1
+		ORDER BY created_at DESC`,
+		accountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch API keys: %v", err)
+	}
+	defer rows.Close()
+
+	keys := make(model.APIKeys, 0)
+	for rows.Next() {
+		var key model.APIKey
+		if err := rows.Scan(&key.ID, &key.ServiceAccountID, &key.Description, &key.CreatedAt, &key.LastUsedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch API keys row: %v", err)
+		}
+		keys = append(keys, &key)
+	}
+
+	return keys, nil
+}
+
+// RemoveAPIKey revokes a key of a service account, false is returned if the key does not exist.
+func (s *Storage) RemoveAPIKey(ctx context.Context, accountID, keyID int64) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM api_keys WHERE id=$1 AND service_account_id=$2`, keyID, accountID)
+	if err != nil {
+		return false, fmt.Errorf("unable to remove API key #%d: %v", keyID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to remove API key #%d: %v", keyID, err)
+	}
+
+	return count > 0, nil
+}
@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -13,8 +14,8 @@ import (
 )
 
 // CreateAppSessionWithUserPrefs creates a new application session with the given user preferences.
-func (s *Storage) CreateAppSessionWithUserPrefs(userID int64) (*model.Session, error) {
-	user, err := s.UserByID(userID)
+func (s *Storage) CreateAppSessionWithUserPrefs(ctx context.Context, userID int64) (*model.Session, error) {
+	user, err := s.UserByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -22,29 +23,26 @@ func (s *Storage) CreateAppSessionWithUserPrefs(userID int64) (*model.Session, e
 	session := model.Session{
 		ID: crypto.GenerateRandomString(32),
 		Data: &model.SessionData{
-			CSRF:     crypto.GenerateRandomString(64),
 			Theme:    user.Theme,
 			Language: user.Language,
 		},
 	}
 
-	return s.createAppSession(&session)
+	return s.createAppSession(ctx, &session)
 }
 
 // CreateAppSession creates a new application session.
-func (s *Storage) CreateAppSession() (*model.Session, error) {
+func (s *Storage) CreateAppSession(ctx context.Context) (*model.Session, error) {
 	session := model.Session{
-		ID: crypto.GenerateRandomString(32),
-		Data: &model.SessionData{
-			CSRF: crypto.GenerateRandomString(64),
-		},
+		ID:   crypto.GenerateRandomString(32),
+		Data: &model.SessionData{},
 	}
 
-	return s.createAppSession(&session)
+	return s.createAppSession(ctx, &session)
 }
 
-func (s *Storage) createAppSession(session *model.Session) (*model.Session, error) {
-	_, err := s.db.Exec(`INSERT INTO sessions (id, data) VALUES ($1, $2)`, session.ID, session.Data)
+func (s *Storage) createAppSession(ctx context.Context, session *model.Session) (*model.Session, error) {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO sessions (id, data) VALUES ($1, $2)`, session.ID, session.Data)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create app session: %v", err)
 	}
@@ -53,25 +51,29 @@ func (s *Storage) createAppSession(session *model.Session) (*model.Session, erro
 }
 
 // UpdateAppSessionField updates only one session field.
-func (s *Storage) UpdateAppSessionField(sessionID, field string, value interface{}) error {
+func (s *Storage) UpdateAppSessionField(ctx context.Context, sessionID, field string, value interface{}) error {
 	query := `UPDATE sessions
 		SET data = jsonb_set(data, '{%s}', to_jsonb($1::text), true)
 		WHERE id=$2`
 
-	_, err := s.db.Exec(fmt.Sprintf(query, field), value, sessionID)
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(query, field), value, sessionID)
 	if err != nil {
 		return fmt.Errorf("unable to update session field: %v", err)
 	}
 
+	s.sessions.Delete(appSessionCacheKey(sessionID))
 	return nil
 }
 
 // AppSession returns the given session.
-func (s *Storage) AppSession(id string) (*model.Session, error) {
+func (s *Storage) AppSession(ctx context.Context, id string) (*model.Session, error) {
 	var session model.Session
+	if cacheGet(s.sessions, appSessionCacheKey(id), &session) {
+		return &session, nil
+	}
 
 	query := "SELECT id, data FROM sessions WHERE id=$1"
-	err := s.db.QueryRow(query, id).Scan(
+	err := s.db.QueryRowContext(ctx, query, id).Scan(
 		&session.ID,
 		&session.Data,
 	)
@@ -82,30 +84,32 @@ func (s *Storage) AppSession(id string) (*model.Session, error) {
 		return nil, fmt.Errorf("unable to fetch session: %v", err)
 	}
 
+	cacheSet(s.sessions, appSessionCacheKey(id), &session, sessionCacheTTL)
 	return &session, nil
 }
 
 // FlushAllSessions removes all sessions from the database.
-func (s *Storage) FlushAllSessions() (err error) {
-	_, err = s.db.Exec(`DELETE FROM user_sessions`)
+func (s *Storage) FlushAllSessions(ctx context.Context) (err error) {
+	_, err = s.db.ExecContext(ctx, `DELETE FROM user_sessions`)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.db.Exec(`DELETE FROM sessions`)
+	_, err = s.db.ExecContext(ctx, `DELETE FROM sessions`)
 	if err != nil {
 		return err
 	}
 
+	s.sessions.Flush()
 	return nil
 }
 
 // CleanOldSessions removes sessions older than 30 days.
-func (s *Storage) CleanOldSessions() int64 {
+func (s *Storage) CleanOldSessions(ctx context.Context) int64 {
 	query := `DELETE FROM sessions
 		WHERE id IN (SELECT id FROM sessions WHERE created_at < now() - interval '30 days')`
 
-	result, err := s.db.Exec(query)
+	result, err := s.db.ExecContext(ctx, query)
 	if err != nil {
 		return 0
 	}
@@ -5,23 +5,158 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
-	
-	"miniflux.app/integration/gcppubsub"
+	"errors"
+	"time"
+
+	"miniflux.app/alert"
+	"miniflux.app/blob"
+	"miniflux.app/cache"
+	"miniflux.app/encryption"
+	"miniflux.app/eventbus"
+	"miniflux.app/hook"
+	"miniflux.app/integration"
+	"miniflux.app/model"
+	"miniflux.app/webhook"
 )
 
+// ErrConflict is returned when an update is based on a stale version of a feed or a category.
+var ErrConflict = errors.New("storage: the record has been changed by another request")
+
+// ErrUserNotVerified is returned when a user logs in before verifying the email address.
+var ErrUserNotVerified = errors.New("storage: the email address of the user is not verified")
+
+// ErrUserPending is returned when a user logs in before an administrator approves the account.
+var ErrUserPending = errors.New("storage: the account of the user is waiting for an approval")
+
 // Storage handles all operations related to the database.
 type Storage struct {
-	db *sql.DB
-	pub *gcppubsub.Publisher
+	db *database
+	queries *queryLog
+	pub eventbus.Publisher
+	fullEventPayloads bool
+	webhooks *webhook.Dispatcher
+	notifier *integration.Notifier
+	monitor *alert.Monitor
+	hooks *hook.Runner
+	cache cache.Cache
+	sessions cache.Cache
+	replica *replica
+	blobs blob.Store
+	keyring *encryption.Keyring
+
+	// Process-local caches of users, category lists and feeds.
+	users      *cache.LRU
+	categories *cache.LRU
+	feeds      *cache.LRU
+}
+
+// NewStorage returns a new Storage, the publisher receives the sync events of every change.
+// The events are dropped when the publisher is nil.
+func NewStorage(db *sql.DB, pub eventbus.Publisher) *Storage {
+	if pub == nil {
+		pub = eventbus.NewNoopPublisher()
+	}
+
+	queries := &queryLog{}
+	s := &Storage{
+		db:       &database{DB: db, log: queries},
+		queries:  queries,
+		pub:      pub,
+		cache:    cache.NewNullCache(),
+		sessions: cache.NewNullCache(),
+	}
+	s.EnableLocalCaches(0, 0)
+	return s
+}
+
+// AddWebhookDispatcher sets the dispatcher used to notify administrative events.
+// Feed events are held in the database during the quiet hours of the user.
+func (s *Storage) AddWebhookDispatcher(dispatcher *webhook.Dispatcher) {
+	s.webhooks = dispatcher
+	dispatcher.SetQuietHours(s.quietHoursEnd, s.holdWebhookEvent)
+}
+
+// quietHoursEnd returns the end of the quiet hours in progress for the user, or the zero time.
+func (s *Storage) quietHoursEnd(userID int64) time.Time {
+	user, err := s.UserByID(context.Background(), userID)
+	if err != nil || user == nil {
+		return time.Time{}
+	}
+
+	return user.QuietHoursEndAt(time.Now())
+}
+
+// AddNotifier sets the notifier routing starred entries, new entries and feed errors to the integrations of users.
+// Notifications are held in the database during the quiet hours of the user.
+func (s *Storage) AddNotifier(notifier *integration.Notifier) {
+	s.notifier = notifier
+	notifier.SetRecipient(s.notificationRecipient)
+	notifier.SetHolder(s.holdIntegrationNotification)
+}
+
+// notificationRecipient returns the settings of the user to notify.
+func (s *Storage) notificationRecipient(userID int64) (*model.User, *model.Integration, error) {
+	ctx := context.Background()
+	user, err := s.UserByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, nil, err
+	}
+
+	integration, err := s.Integration(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, integration, nil
+}
+
+// AddAlertMonitor sets the monitor counting the failures of feed refreshes and backend components.
+func (s *Storage) AddAlertMonitor(monitor *alert.Monitor) {
+	s.monitor = monitor
+}
+
+// AlertMonitor returns the alert monitor, nil when alerts are disabled.
+func (s *Storage) AlertMonitor() *alert.Monitor {
+	return s.monitor
+}
+
+// AddEntryHooks sets the hooks executed when an entry is starred.
+func (s *Storage) AddEntryHooks(runner *hook.Runner) {
+	s.hooks = runner
+}
+
+// AddCache sets the cache used for sessions, unread counters and icons.
+// Sessions are only cached when the cache is shared, another instance
+// could otherwise use a session removed or changed by this one.
+func (s *Storage) AddCache(c cache.Cache) {
+	s.cache = c
+	if cache.IsShared(c) {
+		s.sessions = c
+	} else {
+		s.sessions = cache.NewNullCache()
+	}
+}
+
+// EnableLocalCaches keeps up to size users, category lists and feeds in memory during ttl, a zero size disables them.
+func (s *Storage) EnableLocalCaches(size int, ttl time.Duration) {
+	s.users = cache.NewLRU(size, ttl)
+	s.categories = cache.NewLRU(size, ttl)
+	s.feeds = cache.NewLRU(size, ttl)
+}
+
+// AddBlobStore keeps the content of icons and entry snapshots outside of the database.
+func (s *Storage) AddBlobStore(store blob.Store) {
+	s.blobs = store
 }
 
-// NewStorage returns a new Storage.
-func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db: db}
+// BlobStore returns the store of binary objects, nil when they are kept in the database.
+func (s *Storage) BlobStore() blob.Store {
+	return s.blobs
 }
 
-// AddPubsubPublisher sets the pub to the Storage instance
-func (s *Storage) AddPubsubPublisher(pub *gcppubsub.Publisher) {
-	s.pub = pub
-}
\ No newline at end of file
+// Cache returns the cache shared with the other components of the application.
+func (s *Storage) Cache() cache.Cache {
+	return s.cache
+}
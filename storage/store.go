@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// UserStore gives access to the settings of a user.
+type UserStore interface {
+	UserLanguage(ctx context.Context, userID int64) string
+	UserIsAdmin(ctx context.Context, userID int64) bool
+	UserJunkThreshold(ctx context.Context, userID int64) int
+}
+
+// CategoryStore manages the categories of a user.
+type CategoryStore interface {
+	CategoryExists(ctx context.Context, userID, categoryID int64) bool
+	Category(ctx context.Context, userID, categoryID int64) (*model.Category, error)
+	FirstCategory(ctx context.Context, userID int64) (*model.Category, error)
+	CategoryByTitle(ctx context.Context, userID int64, title string) (*model.Category, error)
+	Categories(ctx context.Context, userID int64) (model.Categories, error)
+	CreateCategory(ctx context.Context, category *model.Category) error
+	UpdateCategory(ctx context.Context, category *model.Category) error
+	RemoveCategory(ctx context.Context, userID, categoryID int64) error
+}
+
+// FeedStore manages the subscriptions of a user.
+type FeedStore interface {
+	FeedExists(ctx context.Context, userID, feedID int64) bool
+	FeedURLExists(ctx context.Context, userID int64, feedURL string) bool
+	FeedIDByURL(ctx context.Context, userID int64, websiteURL string) int64
+	Feeds(ctx context.Context, userID int64) (model.Feeds, error)
+	FeedByID(ctx context.Context, userID, feedID int64) (*model.Feed, error)
+	CreateFeed(ctx context.Context, feed *model.Feed) error
+	UpdateFeed(ctx context.Context, feed *model.Feed) error
+	UpdateFeedRefresh(ctx context.Context, feed *model.Feed) error
+	UpdateFeedError(ctx context.Context, feed *model.Feed) error
+	RemoveFeed(ctx context.Context, userID, feedID int64) error
+	ArchiveFeedResponse(ctx context.Context, response *model.FeedResponse, keep int) error
+	RecordFeedFetch(ctx context.Context, feedID int64, success bool) error
+	WatchedContent(ctx context.Context, feedID int64) (string, error)
+	UpdateWatchedContent(ctx context.Context, feedID int64, content string) error
+}
+
+// IconStore manages the icons of feeds.
+type IconStore interface {
+	HasIcon(ctx context.Context, feedID int64) bool
+	IconByFeedID(ctx context.Context, userID, feedID int64) (*model.Icon, error)
+	CreateFeedIcon(ctx context.Context, feedID int64, icon *model.Icon) error
+}
+
+// EntryStore manages the entries of feeds.
+type EntryStore interface {
+	EntryURLExists(ctx context.Context, userID int64, entryURL string) bool
+	UpdateEntries(ctx context.Context, userID, feedID int64, entries model.Entries, updateExistingEntries bool) error
+	SaveEntry(ctx context.Context, entry *model.Entry) error
+	TrimFeedEntries(ctx context.Context, feed *model.Feed) (int, error)
+	SetEntriesStatus(ctx context.Context, userID int64, entryIDs []int64, status string) error
+	MarkAllAsRead(ctx context.Context, userID int64) error
+	MarkFeedAsRead(ctx context.Context, userID, feedID int64, before time.Time) error
+	CountUnreadEntries(ctx context.Context, userID int64) int
+}
+
+// Store is the set of operations used to subscribe to feeds and refresh them.
+// It is implemented by Storage and by the in-memory store of the storage/memory package.
+type Store interface {
+	UserStore
+	CategoryStore
+	FeedStore
+	IconStore
+	EntryStore
+}
+
+var _ Store = (*Storage)(nil)
@@ -0,0 +1,113 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+const (
+	// tombstoneRetention is the validity of sync tokens, older tokens require a full sync.
+	tombstoneRetention = 30 * 24 * time.Hour
+
+	// syncOverlap is subtracted from the final token of a sync, the entries changed by
+	// transactions still in progress during the sync are sent again with the next one.
+	syncOverlap = time.Minute
+)
+
+// EntryChanges returns the entries changed and deleted since the given token, a nil token returns all entries.
+func (s *Storage) EntryChanges(ctx context.Context, userID int64, token *model.SyncToken, limit int) (*model.SyncChanges, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start sync transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var now time.Time
+	if err := tx.QueryRowContext(ctx, `SELECT now()`).Scan(&now); err != nil {
+		return nil, fmt.Errorf("unable to start sync: %v", err)
+	}
+
+	changes := &model.SyncChanges{Entries: make([]*model.SyncEntry, 0), DeletedEntryIDs: make([]int64, 0)}
+	since := &model.SyncToken{}
+	if token != nil {
+		if token.ChangedAt.After(now.Add(-tombstoneRetention)) {
+			since = token
+		} else {
+			changes.Reset = true
+		}
+	}
+
+	query := `
+		SELECT id, feed_id, status, starred, changed_at
+		FROM entries
+		WHERE user_id=$1 AND (changed_at, id) > ($2, $3)
+		ORDER BY changed_at ASC, id ASC
+		LIMIT $4
+	`
+	rows, err := tx.QueryContext(ctx, query, userID, since.ChangedAt, since.EntryID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch changed entries: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry model.SyncEntry
+		if err := rows.Scan(&entry.ID, &entry.FeedID, &entry.Status, &entry.Starred, &entry.ChangedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch changed entry row: %v", err)
+		}
+		changes.Entries = append(changes.Entries, &entry)
+	}
+
+	// The transaction cannot run another query while the rows are open.
+	rows.Close()
+
+	next := &model.SyncToken{ChangedAt: now.Add(-syncOverlap)}
+	if len(changes.Entries) > limit {
+		changes.Entries = changes.Entries[:limit]
+		changes.HasMore = true
+
+		last := changes.Entries[limit-1]
+		next = &model.SyncToken{ChangedAt: last.ChangedAt, EntryID: last.ID}
+	}
+	changes.Token = next.String()
+
+	if token == nil || changes.Reset {
+		return changes, nil
+	}
+
+	rows, err = tx.QueryContext(ctx, `SELECT entry_id FROM entry_tombstones WHERE user_id=$1 AND deleted_at > $2`, userID, since.ChangedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch deleted entries: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, fmt.Errorf("unable to fetch deleted entry row: %v", err)
+		}
+		changes.DeletedEntryIDs = append(changes.DeletedEntryIDs, entryID)
+	}
+
+	return changes, nil
+}
+
+// CleanOldEntryTombstones removes the tombstones older than the validity of sync tokens.
+func (s *Storage) CleanOldEntryTombstones(ctx context.Context) int64 {
+	query := `DELETE FROM entry_tombstones WHERE deleted_at < now() - $1::interval`
+	result, err := s.db.ExecContext(ctx, query, fmt.Sprintf("%d hours", int(tombstoneRetention.Hours())))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
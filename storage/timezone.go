@@ -5,18 +5,15 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"fmt"
 	"strings"
-	"time"
-
-	"miniflux.app/timer"
 )
 
 // Timezones returns all timezones supported by the database.
-func (s *Storage) Timezones() (map[string]string, error) {
-	defer timer.ExecutionTime(time.Now(), "[Storage:Timezones]")
+func (s *Storage) Timezones(ctx context.Context) (map[string]string, error) {
 	timezones := make(map[string]string)
-	rows, err := s.db.Query(`SELECT name FROM pg_timezone_names() ORDER BY name ASC`)
+	rows, err := s.db.QueryContext(ctx, `SELECT name FROM pg_timezone_names() ORDER BY name ASC`)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch timezones: %v", err)
 	}
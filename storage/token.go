@@ -0,0 +1,138 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// CreateToken stores a new token valid for the given duration and returns its secret value,
+// the value cannot be retrieved later since only its hash is stored.
+func (s *Storage) CreateToken(ctx context.Context, token *model.Token, ttl time.Duration) (string, error) {
+	value := crypto.GenerateRandomString(32)
+	err := s.db.QueryRowContext(
+		ctx,
+		`INSERT INTO tokens (user_id, type, hash, email, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, expires_at`,
+		token.UserID,
+		token.Type,
+		crypto.Hash(value),
+		token.Email,
+		time.Now().Add(ttl),
+	).Scan(&token.ID, &token.CreatedAt, &token.ExpiresAt)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to create token: %v", err)
+	}
+
+	return value, nil
+}
+
+// TokenByValue returns the unused and unexpired token matching the secret value, nil is returned otherwise.
+func (s *Storage) TokenByValue(ctx context.Context, tokenType, value string) (*model.Token, error) {
+	return s.fetchToken(
+		ctx,
+		`SELECT id, user_id, type, email, created_at, expires_at, used_at
+		FROM tokens
+		WHERE hash=$1 AND type=$2 AND used_at IS NULL AND expires_at > now()`,
+		crypto.Hash(value),
+		tokenType,
+	)
+}
+
+// ConsumeToken marks the token matching the secret value as used, a token can be consumed only once.
+// Nil is returned if the token is unknown, expired or already used.
+func (s *Storage) ConsumeToken(ctx context.Context, tokenType, value string) (*model.Token, error) {
+	return s.fetchToken(
+		ctx,
+		`UPDATE tokens SET used_at=now()
+		WHERE hash=$1 AND type=$2 AND used_at IS NULL AND expires_at > now()
+		RETURNING id, user_id, type, email, created_at, expires_at, used_at`,
+		crypto.Hash(value),
+		tokenType,
+	)
+}
+
+func (s *Storage) fetchToken(ctx context.Context, query string, args ...interface{}) (*model.Token, error) {
+	var token model.Token
+	err := s.db.QueryRowContext(ctx, query, args...).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Type,
+		&token.Email,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch token: %v", err)
+	}
+
+	return &token, nil
+}
+
+// Tokens returns the unexpired tokens of the given type, most recent first.
+func (s *Storage) Tokens(ctx context.Context, tokenType string) (model.Tokens, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, user_id, type, email, created_at, expires_at, used_at
+		FROM tokens
+		WHERE type=$1 AND expires_at > now()
+		ORDER BY created_at DESC`,
+		tokenType,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch tokens: %v", err)
+	}
+	defer rows.Close()
+
+	tokens := make(model.Tokens, 0)
+	for rows.Next() {
+		var token model.Token
+		if err := rows.Scan(&token.ID, &token.UserID, &token.Type, &token.Email, &token.CreatedAt, &token.ExpiresAt, &token.UsedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch tokens row: %v", err)
+		}
+		tokens = append(tokens, &token)
+	}
+
+	return tokens, nil
+}
+
+// RemoveToken deletes a token of the given type, false is returned if the token does not exist.
+func (s *Storage) RemoveToken(ctx context.Context, tokenType string, tokenID int64) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM tokens WHERE id=$1 AND type=$2`, tokenID, tokenType)
+	if err != nil {
+		return false, fmt.Errorf("unable to remove token #%d: %v", tokenID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to remove token #%d: %v", tokenID, err)
+	}
+
+	return count > 0, nil
+}
+
+// CleanOldTokens removes the tokens that have been used or have expired.
+func (s *Storage) CleanOldTokens(ctx context.Context) int64 {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM tokens WHERE used_at IS NOT NULL OR expires_at < now()`)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,239 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/model"
+)
+
+// Trash returns the removed categories and feeds of a user.
+func (s *Storage) Trash(ctx context.Context, userID int64) (*model.Trash, error) {
+	trash := &model.Trash{Categories: make(model.Categories, 0), Feeds: make(model.Feeds, 0)}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, user_id, title, deleted_at FROM categories
+		WHERE user_id=$1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch trashed categories: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var category model.Category
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.DeletedAt); err != nil {
+			return nil, fmt.Errorf("unable to fetch trashed categories row: %v", err)
+		}
+
+		trash.Categories = append(trash.Categories, &category)
+	}
+
+	rows, err = s.db.QueryContext(
+		ctx,
+		`SELECT f.id, f.user_id, f.feed_url, f.site_url, f.title, f.category_id, c.title, f.deleted_at
+		FROM feeds f
+		LEFT JOIN categories c ON c.id=f.category_id
+		WHERE f.user_id=$1 AND f.deleted_at IS NOT NULL ORDER BY f.deleted_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch trashed feeds: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		feed := model.Feed{Category: &model.Category{UserID: userID}}
+		err := rows.Scan(
+			&feed.ID,
+			&feed.UserID,
+			&feed.FeedURL,
+			&feed.SiteURL,
+			&feed.Title,
+			&feed.Category.ID,
+			&feed.Category.Title,
+			&feed.DeletedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch trashed feeds row: %v", err)
+		}
+
+		trash.Feeds = append(trash.Feeds, &feed)
+	}
+
+	return trash, nil
+}
+
+// TrashedFeed returns a feed of the trash.
+func (s *Storage) TrashedFeed(ctx context.Context, userID, feedID int64) (*model.Feed, error) {
+	feed := model.Feed{Category: &model.Category{UserID: userID}}
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT id, user_id, feed_url, site_url, title, category_id, deleted_at
+		FROM feeds WHERE user_id=$1 AND id=$2 AND deleted_at IS NOT NULL`,
+		userID,
+		feedID,
+	).Scan(
+		&feed.ID,
+		&feed.UserID,
+		&feed.FeedURL,
+		&feed.SiteURL,
+		&feed.Title,
+		&feed.Category.ID,
+		&feed.DeletedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch trashed feed #%d: %v", feedID, err)
+	}
+
+	return &feed, nil
+}
+
+// TrashedCategory returns a category of the trash.
+func (s *Storage) TrashedCategory(ctx context.Context, userID, categoryID int64) (*model.Category, error) {
+	var category model.Category
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT id, user_id, title, deleted_at FROM categories WHERE user_id=$1 AND id=$2 AND deleted_at IS NOT NULL`,
+		userID,
+		categoryID,
+	).Scan(&category.ID, &category.UserID, &category.Title, &category.DeletedAt)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("unable to fetch trashed category #%d: %v", categoryID, err)
+	}
+
+	return &category, nil
+}
+
+// RestoreFeed moves a feed out of the trash.
+func (s *Storage) RestoreFeed(ctx context.Context, userID, feedID int64) error {
+	result, err := s.db.ExecContext(
+		ctx,
+		`UPDATE feeds SET deleted_at=NULL WHERE id=$1 AND user_id=$2 AND deleted_at IS NOT NULL`,
+		feedID,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to restore feed #%d: %v", feedID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to restore feed #%d: %v", feedID, err)
+	}
+
+	if count == 0 {
+		return errors.New("no feed has been restored")
+	}
+
+	s.entriesChanged(userID)
+	s.feeds.Remove(feedID)
+	s.publishEvent(ctx, gcppubsub.NewFeedEvent(feedID, gcppubsub.EntityOpWrite), userID, nil)
+
+	return nil
+}
+
+// RestoreCategory moves a category out of the trash with the feeds removed at the same time.
+// Feeds whose URL has been subscribed again in the meantime stay in the trash.
+func (s *Storage) RestoreCategory(ctx context.Context, userID, categoryID int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to start transaction: %v", err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE feeds f SET deleted_at=NULL
+		FROM categories c
+		WHERE c.id=f.category_id AND f.deleted_at=c.deleted_at AND f.category_id=$1 AND f.user_id=$2
+		AND NOT EXISTS (SELECT 1 FROM feeds a WHERE a.user_id=f.user_id AND a.feed_url=f.feed_url AND a.deleted_at IS NULL)`,
+		categoryID,
+		userID,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to restore the feeds of category #%d: %v", categoryID, err)
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE categories SET deleted_at=NULL WHERE id=$1 AND user_id=$2 AND deleted_at IS NOT NULL`,
+		categoryID,
+		userID,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to restore category #%d: %v", categoryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to restore category #%d: %v", categoryID, err)
+	}
+
+	if count == 0 {
+		tx.Rollback()
+		return errors.New("no category has been restored")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to restore category #%d: %v", categoryID, err)
+	}
+
+	s.entriesChanged(userID)
+	s.categories.Remove(userID)
+	s.feeds.Purge()
+	s.publishEvent(ctx, gcppubsub.NewCategoryEvent(categoryID, gcppubsub.EntityOpWrite), userID, nil)
+
+	return nil
+}
+
+// PurgeTrash deletes the feeds and categories removed more than the given number of days ago.
+func (s *Storage) PurgeTrash(ctx context.Context, days int) int64 {
+	interval := fmt.Sprintf("%d days", days)
+
+	// The entries are deleted before their feeds to keep tombstones for the sync API.
+	_, err := s.db.ExecContext(ctx, `
+		WITH deleted AS (
+			DELETE FROM entries
+			WHERE feed_id IN (SELECT id FROM feeds WHERE deleted_at < now() - $1::interval)
+			RETURNING user_id, id
+		)
+		INSERT INTO entry_tombstones (user_id, entry_id) SELECT user_id, id FROM deleted
+	`, interval)
+	if err != nil {
+		return 0
+	}
+
+	feeds, err := s.db.ExecContext(ctx, `DELETE FROM feeds WHERE deleted_at < now() - $1::interval`, interval)
+	if err != nil {
+		return 0
+	}
+
+	categories, err := s.db.ExecContext(ctx, `DELETE FROM categories WHERE deleted_at < now() - $1::interval`, interval)
+	if err != nil {
+		return 0
+	}
+
+	nbFeeds, _ := feeds.RowsAffected()
+	nbCategories, _ := categories.RowsAffected()
+	return nbFeeds + nbCategories
+}
@@ -5,52 +5,57 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"miniflux.app/integration/gcppubsub"
 	"miniflux.app/model"
-	"miniflux.app/timer"
 
 	"github.com/lib/pq/hstore"
 	"golang.org/x/crypto/bcrypt"
 )
 
 // SetLastLogin updates the last login date of a user.
-func (s *Storage) SetLastLogin(userID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:SetLastLogin] userID=%d", userID))
+func (s *Storage) SetLastLogin(ctx context.Context, userID int64) error {
 	query := "UPDATE users SET last_login_at=now() WHERE id=$1"
-	_, err := s.db.Exec(query, userID)
+	_, err := s.db.ExecContext(ctx, query, userID)
 	if err != nil {
 		return fmt.Errorf("unable to update last login date: %v", err)
 	}
 
+	s.users.Remove(userID)
 	return nil
 }
 
 // UserExists checks if a user exists by using the given username.
-func (s *Storage) UserExists(username string) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UserExists] username=%s", username))
-
+func (s *Storage) UserExists(ctx context.Context, username string) bool {
 	var result int
-	s.db.QueryRow(`SELECT count(*) as c FROM users WHERE username=LOWER($1)`, username).Scan(&result)
+	s.db.QueryRowContext(ctx, `SELECT count(*) as c FROM users WHERE username=LOWER($1)`, username).Scan(&result)
 	return result >= 1
 }
 
 // AnotherUserExists checks if another user exists with the given username.
-func (s *Storage) AnotherUserExists(userID int64, username string) bool {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:AnotherUserExists] userID=%d, username=%s", userID, username))
-
+func (s *Storage) AnotherUserExists(ctx context.Context, userID int64, username string) bool {
 	var result int
-	s.db.QueryRow(`SELECT count(*) as c FROM users WHERE id != $1 AND username=LOWER($2)`, userID, username).Scan(&result)
+	s.db.QueryRowContext(ctx, `SELECT count(*) as c FROM users WHERE id != $1 AND username=LOWER($2)`, userID, username).Scan(&result)
 	return result >= 1
 }
 
 // CreateUser creates a new user.
-func (s *Storage) CreateUser(user *model.User) (err error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:CreateUser] username=%s", user.Username))
+func (s *Storage) CreateUser(ctx context.Context, user *model.User) error {
+	return s.createUser(ctx, user, true)
+}
+
+// SignupUser creates an account registered from the signup page, it is disabled until the email address is verified.
+func (s *Storage) SignupUser(ctx context.Context, user *model.User) error {
+	return s.createUser(ctx, user, false)
+}
+
+func (s *Storage) createUser(ctx context.Context, user *model.User, verified bool) (err error) {
 	password := ""
 	extra := hstore.Hstore{Map: make(map[string]sql.NullString)}
 
@@ -68,12 +73,12 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 	}
 
 	query := `INSERT INTO users
-		(username, password, is_admin, extra)
+		(username, password, is_admin, extra, email, verified, pending)
 		VALUES
-		(LOWER($1), $2, $3, $4)
-		RETURNING id, username, is_admin, language, theme, timezone, entry_direction`
+		(LOWER($1), $2, $3, $4, NULLIF($5, ''), $6, $7)
+		RETURNING id, username, is_admin, language, theme, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, verified, pending`
 
-	err = s.db.QueryRow(query, user.Username, password, user.IsAdmin, extra).Scan(
+	err = s.db.QueryRowContext(ctx, query, user.Username, password, user.IsAdmin, extra, user.Email, verified, user.Pending).Scan(
 		&user.ID,
 		&user.Username,
 		&user.IsAdmin,
@@ -81,40 +86,54 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		&user.Theme,
 		&user.Timezone,
 		&user.EntryDirection,
+		&user.EntriesPerPage,
+		&user.ShowReadEntries,
+		&user.ShowAbsoluteTime,
+		&user.ImageDisplay,
+		&user.NSFWDisplay,
+		&user.JunkThreshold,
+		&user.QuietHoursStart,
+		&user.QuietHoursEnd,
+		&user.Verified,
+		&user.Pending,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to create user: %v", err)
 	}
 
-	s.CreateCategory(&model.Category{Title: "All", UserID: user.ID})
-	s.CreateIntegration(user.ID)
+	s.CreateCategory(ctx, &model.Category{Title: "All", UserID: user.ID})
+	s.CreateIntegration(ctx, user.ID)
+	s.webhooks.UserCreated(user)
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(user.ID, gcppubsub.EntityOpWrite), user.ID, user)
 	return nil
 }
 
 // UpdateExtraField updates an extra field of the given user.
-func (s *Storage) UpdateExtraField(userID int64, field, value string) error {
-	query := fmt.Sprintf(`UPDATE users SET extra = hstore('%s', $1) WHERE id=$2`, field)
-	_, err := s.db.Exec(query, value, userID)
+func (s *Storage) UpdateExtraField(ctx context.Context, userID int64, field, value string) error {
+	query := fmt.Sprintf(`UPDATE users SET extra = coalesce(extra, hstore('')) || hstore('%s', $1) WHERE id=$2`, field)
+	_, err := s.db.ExecContext(ctx, query, value, userID)
 	if err != nil {
 		return fmt.Errorf("unable to update user extra field: %v", err)
 	}
+
+	s.users.Remove(userID)
 	return nil
 }
 
 // RemoveExtraField deletes an extra field for the given user.
-func (s *Storage) RemoveExtraField(userID int64, field string) error {
+func (s *Storage) RemoveExtraField(ctx context.Context, userID int64, field string) error {
 	query := `UPDATE users SET extra = delete(extra, $1) WHERE id=$2`
-	_, err := s.db.Exec(query, field, userID)
+	_, err := s.db.ExecContext(ctx, query, field, userID)
 	if err != nil {
 		return fmt.Errorf("unable to remove user extra field: %v", err)
 	}
+
+	s.users.Remove(userID)
 	return nil
 }
 
 // UpdateUser updates a user.
-func (s *Storage) UpdateUser(user *model.User) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UpdateUser] userID=%d", user.ID))
-
+func (s *Storage) UpdateUser(ctx context.Context, user *model.User) error {
 	if user.Password != "" {
 		hashedPassword, err := hashPassword(user.Password)
 		if err != nil {
@@ -128,10 +147,20 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			theme=$4,
 			language=$5,
 			timezone=$6,
-			entry_direction=$7
-			WHERE id=$8`
-
-		_, err = s.db.Exec(
+			entry_direction=$7,
+			entries_per_page=$8,
+			show_read_entries=$9,
+			show_absolute_time=$10,
+			image_display=$11,
+			nsfw_display=$12,
+			junk_threshold=$13,
+			quiet_hours_start=$14,
+			quiet_hours_end=$15,
+			email=NULLIF($16, '')
+			WHERE id=$17`
+
+		_, err = s.db.ExecContext(
+			ctx,
 			query,
 			user.Username,
 			hashedPassword,
@@ -140,6 +169,15 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Language,
 			user.Timezone,
 			user.EntryDirection,
+			user.EntriesPerPage,
+			user.ShowReadEntries,
+			user.ShowAbsoluteTime,
+			user.ImageDisplay,
+			user.NSFWDisplay,
+			user.JunkThreshold,
+			user.QuietHoursStart,
+			user.QuietHoursEnd,
+			user.Email,
 			user.ID,
 		)
 		if err != nil {
@@ -152,10 +190,20 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			theme=$3,
 			language=$4,
 			timezone=$5,
-			entry_direction=$6
-			WHERE id=$7`
-
-		_, err := s.db.Exec(
+			entry_direction=$6,
+			entries_per_page=$7,
+			show_read_entries=$8,
+			show_absolute_time=$9,
+			image_display=$10,
+			nsfw_display=$11,
+			junk_threshold=$12,
+			quiet_hours_start=$13,
+			quiet_hours_end=$14,
+			email=NULLIF($15, '')
+			WHERE id=$16`
+
+		_, err := s.db.ExecContext(
+			ctx,
 			query,
 			user.Username,
 			user.IsAdmin,
@@ -163,6 +211,15 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Language,
 			user.Timezone,
 			user.EntryDirection,
+			user.EntriesPerPage,
+			user.ShowReadEntries,
+			user.ShowAbsoluteTime,
+			user.ImageDisplay,
+			user.NSFWDisplay,
+			user.JunkThreshold,
+			user.QuietHoursStart,
+			user.QuietHoursEnd,
+			user.Email,
 			user.ID,
 		)
 
@@ -171,13 +228,124 @@ func (s *Storage) UpdateUser(user *model.User) error {
 		}
 	}
 
+	// The timezone of the user is applied to the dates of cached feeds.
+	s.users.Remove(user.ID)
+	s.feeds.Purge()
+
+	// The unread counter depends on the NSFW display mode.
+	s.entriesChanged(user.ID)
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(user.ID, gcppubsub.EntityOpWrite), user.ID, user)
 	return nil
 }
 
+// UpdateKeyboardShortcuts replaces the custom keyboard shortcuts of the given user.
+func (s *Storage) UpdateKeyboardShortcuts(ctx context.Context, userID int64, shortcuts model.KeyboardShortcuts) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE users SET keyboard_shortcuts=$1 WHERE id=$2`, shortcuts, userID)
+	if err != nil {
+		return fmt.Errorf("unable to update keyboard shortcuts: %v", err)
+	}
+
+	s.users.Remove(userID)
+	return nil
+}
+
+// FreezeUser rejects the changes of a user until the given time, during a migration or an export.
+func (s *Storage) FreezeUser(ctx context.Context, userID int64, until time.Time, message string) error {
+	query := `UPDATE users SET frozen_until=$1, freeze_message=$2 WHERE id=$3`
+	if _, err := s.db.ExecContext(ctx, query, until, message, userID); err != nil {
+		return fmt.Errorf("unable to freeze user #%d: %v", userID, err)
+	}
+
+	s.users.Remove(userID)
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpWrite), userID, nil)
+	return nil
+}
+
+// UnfreezeUser ends the write freeze of a user.
+func (s *Storage) UnfreezeUser(ctx context.Context, userID int64) error {
+	query := `UPDATE users SET frozen_until=NULL, freeze_message='' WHERE id=$1`
+	if _, err := s.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("unable to unfreeze user #%d: %v", userID, err)
+	}
+
+	s.users.Remove(userID)
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpWrite), userID, nil)
+	return nil
+}
+
+// EmailExists checks if a user has the given email address.
+func (s *Storage) EmailExists(ctx context.Context, email string) bool {
+	var result bool
+	s.db.QueryRowContext(ctx, `SELECT true FROM users WHERE lower(email)=lower($1)`, email).Scan(&result)
+	return result
+}
+
+// AnotherEmailExists checks if another user has the given email address.
+func (s *Storage) AnotherEmailExists(ctx context.Context, userID int64, email string) bool {
+	var result bool
+	s.db.QueryRowContext(ctx, `SELECT true FROM users WHERE id != $1 AND lower(email)=lower($2)`, userID, email).Scan(&result)
+	return result
+}
+
+// VerifyUser marks the email address of a user as verified.
+func (s *Storage) VerifyUser(ctx context.Context, userID int64) error {
+	if _, err := s.db.ExecContext(ctx, `UPDATE users SET verified='t' WHERE id=$1`, userID); err != nil {
+		return fmt.Errorf("unable to verify user #%d: %v", userID, err)
+	}
+
+	s.users.Remove(userID)
+	return nil
+}
+
+// ApproveUser enables an account waiting for the approval of an administrator,
+// false is returned if the account is not pending.
+func (s *Storage) ApproveUser(ctx context.Context, userID int64) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE users SET pending='f' WHERE id=$1 AND pending`, userID)
+	if err != nil {
+		return false, fmt.Errorf("unable to approve user #%d: %v", userID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("unable to approve user #%d: %v", userID, err)
+	}
+
+	s.users.Remove(userID)
+	return count > 0, nil
+}
+
+// CleanUnverifiedUsers removes the accounts whose email address has not been verified before the expiration of the token.
+func (s *Storage) CleanUnverifiedUsers(ctx context.Context) int64 {
+	rows, err := s.db.QueryContext(
+		ctx,
+		`DELETE FROM users u
+		WHERE NOT u.verified AND NOT EXISTS (
+			SELECT 1 FROM tokens t WHERE t.user_id=u.id AND t.type=$1 AND t.expires_at > now()
+		)
+		RETURNING u.id`,
+		model.TokenTypeEmailVerification,
+	)
+	if err != nil {
+		return 0
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err == nil {
+			s.users.Remove(userID)
+			s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpDelete), userID, nil)
+			count++
+		}
+	}
+
+	return count
+}
+
 // UserLanguage returns the language of the given user.
-func (s *Storage) UserLanguage(userID int64) (language string) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UserLanguage] userID=%d", userID))
-	err := s.db.QueryRow(`SELECT language FROM users WHERE id = $1`, userID).Scan(&language)
+func (s *Storage) UserLanguage(ctx context.Context, userID int64) (language string) {
+	err := s.db.QueryRowContext(ctx, `SELECT language FROM users WHERE id = $1`, userID).Scan(&language)
 	if err != nil {
 		return "en_US"
 	}
@@ -185,44 +353,70 @@ func (s *Storage) UserLanguage(userID int64) (language string) {
 	return language
 }
 
+// UserJunkThreshold returns the junk score from which the new entries of the user are marked as read, zero disables it.
+func (s *Storage) UserJunkThreshold(ctx context.Context, userID int64) (threshold int) {
+	err := s.db.QueryRowContext(ctx, `SELECT junk_threshold FROM users WHERE id = $1`, userID).Scan(&threshold)
+	if err != nil {
+		return 0
+	}
+
+	return threshold
+}
+
 // UserByID finds a user by the ID.
-func (s *Storage) UserByID(userID int64) (*model.User, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UserByID] userID=%d", userID))
+func (s *Storage) UserByID(ctx context.Context, userID int64) (*model.User, error) {
+	if user := s.cachedUser(userID); user != nil {
+		return user, nil
+	}
+
 	query := `SELECT
-		id, username, is_admin, theme, language, timezone, entry_direction, last_login_at, extra
+		id, username, is_admin, theme, language, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, last_login_at, extra, keyboard_shortcuts, frozen_until, freeze_message, coalesce(email, ''), verified, pending
 		FROM users
 		WHERE id = $1`
 
-	return s.fetchUser(query, userID)
+	user, err := s.fetchUser(ctx, query, userID)
+	if err == nil && user != nil {
+		s.cacheUser(user)
+	}
+
+	return user, err
 }
 
 // UserByUsername finds a user by the username.
-func (s *Storage) UserByUsername(username string) (*model.User, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UserByUsername] username=%s", username))
+func (s *Storage) UserByUsername(ctx context.Context, username string) (*model.User, error) {
 	query := `SELECT
-		id, username, is_admin, theme, language, timezone, entry_direction, last_login_at, extra
+		id, username, is_admin, theme, language, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, last_login_at, extra, keyboard_shortcuts, frozen_until, freeze_message, coalesce(email, ''), verified, pending
 		FROM users
 		WHERE username=LOWER($1)`
 
-	return s.fetchUser(query, username)
+	return s.fetchUser(ctx, query, username)
+}
+
+// UserByEmail finds a user by the email address.
+func (s *Storage) UserByEmail(ctx context.Context, email string) (*model.User, error) {
+	query := `SELECT
+		id, username, is_admin, theme, language, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, last_login_at, extra, keyboard_shortcuts, frozen_until, freeze_message, coalesce(email, ''), verified, pending
+		FROM users
+		WHERE lower(email)=lower($1) AND deletion_requested_at IS NULL`
+
+	return s.fetchUser(ctx, query, email)
 }
 
 // UserByExtraField finds a user by an extra field value.
-func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:UserByExtraField] field=%s", field))
+func (s *Storage) UserByExtraField(ctx context.Context, field, value string) (*model.User, error) {
 	query := `SELECT
-		id, username, is_admin, theme, language, timezone, entry_direction, last_login_at, extra
+		id, username, is_admin, theme, language, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, last_login_at, extra, keyboard_shortcuts, frozen_until, freeze_message, coalesce(email, ''), verified, pending
 		FROM users
-		WHERE extra->$1=$2`
+		WHERE extra->$1=$2 AND deletion_requested_at IS NULL`
 
-	return s.fetchUser(query, field, value)
+	return s.fetchUser(ctx, query, field, value)
 }
 
-func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, error) {
+func (s *Storage) fetchUser(ctx context.Context, query string, args ...interface{}) (*model.User, error) {
 	var extra hstore.Hstore
 
 	user := model.NewUser()
-	err := s.db.QueryRow(query, args...).Scan(
+	err := s.db.QueryRowContext(ctx, query, args...).Scan(
 		&user.ID,
 		&user.Username,
 		&user.IsAdmin,
@@ -230,8 +424,22 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.Language,
 		&user.Timezone,
 		&user.EntryDirection,
+		&user.EntriesPerPage,
+		&user.ShowReadEntries,
+		&user.ShowAbsoluteTime,
+		&user.ImageDisplay,
+		&user.NSFWDisplay,
+		&user.JunkThreshold,
+		&user.QuietHoursStart,
+		&user.QuietHoursEnd,
 		&user.LastLoginAt,
 		&extra,
+		&user.KeyboardShortcuts,
+		&user.FrozenUntil,
+		&user.FreezeMessage,
+		&user.Email,
+		&user.Verified,
+		&user.Pending,
 	)
 
 	if err == sql.ErrNoRows {
@@ -250,10 +458,13 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 }
 
 // RemoveUser deletes a user.
-func (s *Storage) RemoveUser(userID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Storage:RemoveUser] userID=%d", userID))
+func (s *Storage) RemoveUser(ctx context.Context, userID int64) error {
+	sessions, err := s.UserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
 
-	result, err := s.db.Exec("DELETE FROM users WHERE id = $1", userID)
+	result, err := s.db.ExecContext(ctx, "DELETE FROM users WHERE id = $1", userID)
 	if err != nil {
 		return fmt.Errorf("unable to remove this user: %v", err)
 	}
@@ -267,19 +478,27 @@ func (s *Storage) RemoveUser(userID int64) error {
 		return errors.New("nothing has been removed")
 	}
 
+	for _, session := range sessions {
+		s.sessions.Delete(userSessionCacheKey(session.Token))
+	}
+
+	s.users.Remove(userID)
+	s.categories.Remove(userID)
+	s.feeds.Purge()
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpDelete), userID, nil)
+
 	return nil
 }
 
 // Users returns all users.
-func (s *Storage) Users() (model.Users, error) {
-	defer timer.ExecutionTime(time.Now(), "[Storage:Users]")
+func (s *Storage) Users(ctx context.Context) (model.Users, error) {
 	query := `
 		SELECT
-			id, username, is_admin, theme, language, timezone, entry_direction, last_login_at, extra
+			id, username, is_admin, theme, language, timezone, entry_direction, entries_per_page, show_read_entries, show_absolute_time, image_display, nsfw_display, junk_threshold, quiet_hours_start, quiet_hours_end, last_login_at, extra, keyboard_shortcuts, frozen_until, freeze_message, coalesce(email, ''), verified, pending
 		FROM users
 		ORDER BY username ASC`
 
-	rows, err := s.db.Query(query)
+	rows, err := s.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch users: %v", err)
 	}
@@ -297,8 +516,22 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.Language,
 			&user.Timezone,
 			&user.EntryDirection,
+			&user.EntriesPerPage,
+			&user.ShowReadEntries,
+			&user.ShowAbsoluteTime,
+			&user.ImageDisplay,
+			&user.NSFWDisplay,
+			&user.JunkThreshold,
+			&user.QuietHoursStart,
+			&user.QuietHoursEnd,
 			&user.LastLoginAt,
 			&extra,
+			&user.KeyboardShortcuts,
+			&user.FrozenUntil,
+			&user.FreezeMessage,
+			&user.Email,
+			&user.Verified,
+			&user.Pending,
 		)
 
 		if err != nil {
@@ -318,13 +551,12 @@ func (s *Storage) Users() (model.Users, error) {
 }
 
 // CheckPassword validate the hashed password.
-func (s *Storage) CheckPassword(username, password string) error {
-	defer timer.ExecutionTime(time.Now(), "[Storage:CheckPassword]")
-
+func (s *Storage) CheckPassword(ctx context.Context, username, password string) error {
 	var hash string
+	var verified, pending bool
 	username = strings.ToLower(username)
 
-	err := s.db.QueryRow("SELECT password FROM users WHERE username=$1", username).Scan(&hash)
+	err := s.db.QueryRowContext(ctx, "SELECT password, verified, pending FROM users WHERE username=$1 AND deletion_requested_at IS NULL", username).Scan(&hash, &verified, &pending)
 	if err == sql.ErrNoRows {
 		return fmt.Errorf("unable to find this user: %s", username)
 	} else if err != nil {
@@ -335,15 +567,74 @@ func (s *Storage) CheckPassword(username, password string) error {
 		return fmt.Errorf(`invalid password for "%s" (%v)`, username, err)
 	}
 
+	switch {
+	case !verified:
+		return ErrUserNotVerified
+	case pending:
+		return ErrUserPending
+	}
+
+	return nil
+}
+
+// ResetPassword changes the password of a user and signs out all the sessions,
+// the other password reset links sent to the user are revoked.
+func (s *Storage) ResetPassword(ctx context.Context, userID int64, password string) error {
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to reset password: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE users SET password=$1 WHERE id=$2`, hashedPassword, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to reset password: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tokens WHERE user_id=$1 AND type=$2`, userID, model.TokenTypePasswordReset); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to reset password: %v", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `DELETE FROM user_sessions WHERE user_id=$1 RETURNING token`, userID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to reset password: %v", err)
+	}
+
+	var tokens []string
+	for rows.Next() {
+		var token string
+		if err := rows.Scan(&token); err != nil {
+			rows.Close()
+			tx.Rollback()
+			return fmt.Errorf("unable to reset password: %v", err)
+		}
+		tokens = append(tokens, token)
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to reset password: %v", err)
+	}
+
+	for _, token := range tokens {
+		s.sessions.Delete(userSessionCacheKey(token))
+	}
+
 	return nil
 }
 
 // HasPassword returns true if the given user has a password defined.
-func (s *Storage) HasPassword(userID int64) (bool, error) {
+func (s *Storage) HasPassword(ctx context.Context, userID int64) (bool, error) {
 	var result bool
 	query := `SELECT true FROM users WHERE id=$1 AND password <> ''`
 
-	err := s.db.QueryRow(query, userID).Scan(&result)
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&result)
 	if err == sql.ErrNoRows {
 		return false, nil
 	} else if err != nil {
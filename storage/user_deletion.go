@@ -0,0 +1,161 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"miniflux.app/integration/gcppubsub"
+	"miniflux.app/logger"
+)
+
+// RequestUserDeletion closes the account of a user, the data is removed later by the purge job.
+// The sessions and the pending tokens are removed right away so the user cannot log in anymore.
+func (s *Storage) RequestUserDeletion(ctx context.Context, userID int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to request the deletion of user #%d: %v", userID, err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE users SET deletion_requested_at=now() WHERE id=$1 AND deletion_requested_at IS NULL`,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to request the deletion of user #%d: %v", userID, err)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 {
+		return errors.New("the deletion of this user is already requested")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tokens WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf("unable to remove the tokens of user #%d: %v", userID, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `DELETE FROM user_sessions WHERE user_id=$1 RETURNING token`, userID)
+	if err != nil {
+		return fmt.Errorf("unable to remove the sessions of user #%d: %v", userID, err)
+	}
+
+	var tokens []string
+	for rows.Next() {
+		var token string
+		if err := rows.Scan(&token); err != nil {
+			rows.Close()
+			return fmt.Errorf("unable to remove the sessions of user #%d: %v", userID, err)
+		}
+		tokens = append(tokens, token)
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to request the deletion of user #%d: %v", userID, err)
+	}
+
+	for _, token := range tokens {
+		s.sessions.Delete(userSessionCacheKey(token))
+	}
+
+	s.users.Remove(userID)
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpWrite), userID, nil)
+
+	return nil
+}
+
+// PurgeDeletedUsers removes the data of the accounts closed by their owner and returns the number of purged users.
+func (s *Storage) PurgeDeletedUsers(ctx context.Context) int64 {
+	rows, err := s.db.QueryContext(ctx, `SELECT id FROM users WHERE deletion_requested_at IS NOT NULL`)
+	if err != nil {
+		logger.Error("[Storage:PurgeDeletedUsers] %v", err)
+		return 0
+	}
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err == nil {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	rows.Close()
+
+	var count int64
+	for _, userID := range userIDs {
+		if err := s.purgeUser(ctx, userID); err != nil {
+			logger.Error("[Storage:PurgeDeletedUsers] %v", err)
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// purgeUser removes a user with the feeds, entries, integrations and icons nobody else uses.
+// The purge event is the tombstone of the user, downstream systems receive it once everything is removed.
+func (s *Storage) purgeUser(ctx context.Context, userID int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to purge user #%d: %v", userID, err)
+	}
+	defer tx.Rollback()
+
+	queries := []string{
+		`DELETE FROM entries WHERE user_id=$1`,
+		`DELETE FROM feeds WHERE user_id=$1`,
+		`DELETE FROM categories WHERE user_id=$1`,
+		`DELETE FROM integrations WHERE user_id=$1`,
+		`DELETE FROM users WHERE id=$1 AND deletion_requested_at IS NOT NULL`,
+	}
+
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("unable to purge user #%d: %v", userID, err)
+		}
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`DELETE FROM icons i WHERE NOT EXISTS (SELECT 1 FROM feed_icons fi WHERE fi.icon_id=i.id) RETURNING i.hash`,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to purge the icons of user #%d: %v", userID, err)
+	}
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err == nil {
+			hashes = append(hashes, hash)
+		}
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to purge user #%d: %v", userID, err)
+	}
+
+	if s.blobs != nil {
+		for _, hash := range hashes {
+			if err := s.blobs.Delete(iconBlobKey(hash)); err != nil {
+				logger.Error("[Storage:PurgeUser] %v", err)
+			}
+		}
+	}
+
+	s.users.Remove(userID)
+	s.categories.Remove(userID)
+	s.feeds.Purge()
+	s.publishEvent(ctx, gcppubsub.NewUserEvent(userID, gcppubsub.EntityOpPurge), userID, nil)
+
+	logger.Info("[Storage:PurgeUser] User #%d has been purged", userID)
+	return nil
+}
@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -13,12 +14,12 @@ import (
 )
 
 // UserSessions returns the list of sessions for the given user.
-func (s *Storage) UserSessions(userID int64) (model.UserSessions, error) {
+func (s *Storage) UserSessions(ctx context.Context, userID int64) (model.UserSessions, error) {
 	query := `SELECT
 		id, user_id, token, created_at, user_agent, ip
 		FROM user_sessions
 		WHERE user_id=$1 ORDER BY id DESC`
-	rows, err := s.db.Query(query, userID)
+	rows, err := s.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch user sessions: %v", err)
 	}
@@ -47,15 +48,15 @@ func (s *Storage) UserSessions(userID int64) (model.UserSessions, error) {
 }
 
 // CreateUserSession creates a new sessions.
-func (s *Storage) CreateUserSession(username, userAgent, ip string) (sessionID string, userID int64, err error) {
-	err = s.db.QueryRow("SELECT id FROM users WHERE username = LOWER($1)", username).Scan(&userID)
+func (s *Storage) CreateUserSession(ctx context.Context, username, userAgent, ip string) (sessionID string, userID int64, err error) {
+	err = s.db.QueryRowContext(ctx, "SELECT id FROM users WHERE username = LOWER($1)", username).Scan(&userID)
 	if err != nil {
 		return "", 0, fmt.Errorf("unable to fetch user ID: %v", err)
 	}
 
 	token := crypto.GenerateRandomString(64)
 	query := "INSERT INTO user_sessions (token, user_id, user_agent, ip) VALUES ($1, $2, $3, $4)"
-	_, err = s.db.Exec(query, token, userID, userAgent, ip)
+	_, err = s.db.ExecContext(ctx, query, token, userID, userAgent, ip)
 	if err != nil {
 		return "", 0, fmt.Errorf("unable to create user session: %v", err)
 	}
@@ -64,11 +65,14 @@ func (s *Storage) CreateUserSession(username, userAgent, ip string) (sessionID s
 }
 
 // UserSessionByToken finds a session by the token.
-func (s *Storage) UserSessionByToken(token string) (*model.UserSession, error) {
+func (s *Storage) UserSessionByToken(ctx context.Context, token string) (*model.UserSession, error) {
 	var session model.UserSession
+	if cacheGet(s.sessions, userSessionCacheKey(token), &session) {
+		return &session, nil
+	}
 
 	query := "SELECT id, user_id, token, created_at, user_agent, ip FROM user_sessions WHERE token = $1"
-	err := s.db.QueryRow(query, token).Scan(
+	err := s.db.QueryRowContext(ctx, query, token).Scan(
 		&session.ID,
 		&session.UserID,
 		&session.Token,
@@ -83,12 +87,13 @@ func (s *Storage) UserSessionByToken(token string) (*model.UserSession, error) {
 		return nil, fmt.Errorf("unable to fetch user session: %v", err)
 	}
 
+	cacheSet(s.sessions, userSessionCacheKey(token), &session, sessionCacheTTL)
 	return &session, nil
 }
 
 // RemoveUserSessionByToken remove a session by using the token.
-func (s *Storage) RemoveUserSessionByToken(userID int64, token string) error {
-	result, err := s.db.Exec(`DELETE FROM user_sessions WHERE user_id=$1 AND token=$2`, userID, token)
+func (s *Storage) RemoveUserSessionByToken(ctx context.Context, userID int64, token string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM user_sessions WHERE user_id=$1 AND token=$2`, userID, token)
 	if err != nil {
 		return fmt.Errorf("unable to remove this user session: %v", err)
 	}
@@ -102,34 +107,30 @@ func (s *Storage) RemoveUserSessionByToken(userID int64, token string) error {
 		return fmt.Errorf("nothing has been removed")
 	}
 
+	s.sessions.Delete(userSessionCacheKey(token))
 	return nil
 }
 
 // RemoveUserSessionByID remove a session by using the ID.
-func (s *Storage) RemoveUserSessionByID(userID, sessionID int64) error {
-	result, err := s.db.Exec(`DELETE FROM user_sessions WHERE user_id=$1 AND id=$2`, userID, sessionID)
-	if err != nil {
-		return fmt.Errorf("unable to remove this user session: %v", err)
-	}
-
-	count, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("unable to remove this user session: %v", err)
-	}
-
-	if count != 1 {
+func (s *Storage) RemoveUserSessionByID(ctx context.Context, userID, sessionID int64) error {
+	var token string
+	err := s.db.QueryRowContext(ctx, `DELETE FROM user_sessions WHERE user_id=$1 AND id=$2 RETURNING token`, userID, sessionID).Scan(&token)
+	if err == sql.ErrNoRows {
 		return fmt.Errorf("nothing has been removed")
+	} else if err != nil {
+		return fmt.Errorf("unable to remove this user session: %v", err)
 	}
 
+	s.sessions.Delete(userSessionCacheKey(token))
 	return nil
 }
 
 // CleanOldUserSessions removes user sessions older than 30 days.
-func (s *Storage) CleanOldUserSessions() int64 {
+func (s *Storage) CleanOldUserSessions(ctx context.Context) int64 {
 	query := `DELETE FROM user_sessions
 		WHERE id IN (SELECT id FROM user_sessions WHERE created_at < now() - interval '30 days')`
 
-	result, err := s.db.Exec(query)
+	result, err := s.db.ExecContext(ctx, query)
 	if err != nil {
 		return 0
 	}
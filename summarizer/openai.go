@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package summarizer // import "miniflux.app/summarizer"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model    string         `json:"model"`
+	Messages []*chatMessage `json:"messages"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// OpenAI generates the summaries with the chat completions API of OpenAI,
+// it's also implemented by local servers like Ollama or llama.cpp.
+type OpenAI struct {
+	endpoint string
+	apiKey   string
+	model    string
+}
+
+// Summarize returns the summary generated by the model.
+func (o *OpenAI) Summarize(title, text string) (string, error) {
+	clt := client.New(o.endpoint + "/chat/completions")
+	if o.apiKey != "" {
+		clt.WithAuthorization("Bearer " + o.apiKey)
+	}
+
+	response, err := clt.PostJSON(&chatRequest{
+		Model: o.model,
+		Messages: []*chatMessage{
+			{Role: "system", Content: instructions},
+			{Role: "user", Content: title + "\n\n" + text},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("summarizer: unable to generate summary: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return "", fmt.Errorf("summarizer: unable to generate summary, status=%d", response.StatusCode)
+	}
+
+	var result chatResponse
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("summarizer: unable to decode response: %v", err)
+	}
+
+	if len(result.Choices) == 0 || strings.TrimSpace(result.Choices[0].Message.Content) == "" {
+		return "", errors.New("summarizer: the model returned an empty summary")
+	}
+
+	return result.Choices[0].Message.Content, nil
+}
+
+// NewOpenAI returns a summarizer using the chat completions API available at the given base URL.
+func NewOpenAI(baseURL, apiKey, model string) *OpenAI {
+	return &OpenAI{endpoint: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, model: model}
+}
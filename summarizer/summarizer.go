@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package summarizer // import "miniflux.app/summarizer"
+
+import (
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+const (
+	// Long articles are cut, the beginning is enough to summarize them and keeps the requests cheap.
+	maxInputLength = 8000
+
+	maxSummaryLength = 1000
+
+	instructions = "Summarize the following article in 2 or 3 sentences, in the language of the article. Answer with the summary only, without any introduction."
+)
+
+// Summarizer writes a short summary of an article.
+type Summarizer interface {
+	Summarize(title, text string) (string, error)
+}
+
+// New returns the summarizer configured with SUMMARIZER_URL, or nil when the summaries are disabled.
+func New(cfg *config.Config) Summarizer {
+	if !cfg.HasSummarizer() {
+		return nil
+	}
+
+	return NewOpenAI(cfg.SummarizerURL(), cfg.SummarizerAPIKey(), cfg.SummarizerModel())
+}
+
+// SummarizeEntry returns the summary of an entry generated from its title and the text of its content.
+func SummarizeEntry(s Summarizer, entry *model.Entry) (string, error) {
+	summary, err := s.Summarize(entry.Title, plainText(entry.Content))
+	if err != nil {
+		return "", err
+	}
+
+	return truncate(strings.TrimSpace(summary), maxSummaryLength), nil
+}
+
+// plainText removes the HTML tags and the extra whitespace of the content.
+func plainText(content string) string {
+	text := strings.Join(strings.Fields(sanitizer.StripTags(content)), " ")
+	return truncate(text, maxInputLength)
+}
+
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+
+	return string(runes[:max])
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package summarizer // import "miniflux.app/summarizer"
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+type fakeSummarizer struct {
+	title string
+	text  string
+	err   error
+}
+
+func (f *fakeSummarizer) Summarize(title, text string) (string, error) {
+	f.title, f.text = title, text
+	return "  A short summary.\n", f.err
+}
+
+func TestSummarizeEntry(t *testing.T) {
+	s := &fakeSummarizer{}
+	entry := &model.Entry{Title: "Title", Content: "<p>Some\n\n<strong>content</strong>.</p>"}
+
+	summary, err := SummarizeEntry(s, entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary != "A short summary." {
+		t.Errorf(`Unexpected summary, got %q`, summary)
+	}
+
+	if s.title != "Title" || s.text != "Some content." {
+		t.Errorf(`Unexpected input, got %q and %q`, s.title, s.text)
+	}
+}
+
+func TestSummarizeEntryWithError(t *testing.T) {
+	s := &fakeSummarizer{err: errors.New("unavailable")}
+	if _, err := SummarizeEntry(s, &model.Entry{}); err == nil {
+		t.Fatal(`The error of the summarizer should be returned`)
+	}
+}
+
+func TestPlainTextIsTruncated(t *testing.T) {
+	text := plainText("<p>" + strings.Repeat("é", maxInputLength+10) + "</p>")
+	if len([]rune(text)) != maxInputLength {
+		t.Errorf(`The text should be truncated to %d characters, got %d`, maxInputLength, len([]rune(text)))
+	}
+}
+
+func TestOpenAI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" || r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		var request chatRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Model != "llama3" || len(request.Messages) != 2 {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "The summary."}}]}`))
+	}))
+	defer server.Close()
+
+	summary, err := NewOpenAI(server.URL+"/v1/", "secret", "llama3").Summarize("Title", "Text")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary != "The summary." {
+		t.Errorf(`Unexpected summary, got %q`, summary)
+	}
+
+	if _, err := NewOpenAI(server.URL+"/v1", "wrong", "llama3").Summarize("Title", "Text"); err == nil {
+		t.Error(`An error should be returned when the request is rejected`)
+	}
+}
+
+func TestOpenAIWithEmptyResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"choices": []}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewOpenAI(server.URL, "", "llama3").Summarize("Title", "Text"); err == nil {
+		t.Fatal(`An empty response should return an error`)
+	}
+}
@@ -3,6 +3,31 @@
 package template // import "miniflux.app/template"
 
 var templateCommonMap = map[string]string{
+	"bulk_actions": `{{ define "bulk_entries" }}
+<form id="bulk-entries" class="bulk-actions" method="post" action="{{ route "bulkUpdateEntries" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+    <input type="hidden" name="redirect" value="{{ .redirect }}">
+    <span class="bulk-actions-label">{{ t "form.bulk.selected_entries" }}</span>
+    <button type="submit" name="action" value="read" class="button">{{ t "form.bulk.action.read" }}</button>
+    <button type="submit" name="action" value="unread" class="button">{{ t "form.bulk.action.unread" }}</button>
+    <button type="submit" name="action" value="star" class="button">{{ t "form.bulk.action.star" }}</button>
+    <button type="submit" name="action" value="unstar" class="button">{{ t "form.bulk.action.unstar" }}</button>
+</form>
+{{ end }}
+
+{{ define "bulk_feeds" }}
+<form id="bulk-feeds" class="bulk-actions" method="post" action="{{ route "bulkUpdateFeeds" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+    <label for="bulk-category-id" class="bulk-actions-label">{{ t "form.bulk.move_feeds" }}</label>
+    <select id="bulk-category-id" name="category_id">
+    {{ range .categories }}
+        <option value="{{ .ID }}">{{ .Title }}</option>
+    {{ end }}
+    </select>
+    <button type="submit" class="button">{{ t "form.bulk.action.move" }}</button>
+</form>
+{{ end }}
+`,
 	"entry_pagination": `{{ define "entry_pagination" }}
 <div class="pagination">
     <div class="pagination-prev">
@@ -22,14 +47,37 @@ var templateCommonMap = map[string]string{
     </div>
 </div>
 {{ end }}`,
+	"integration_events": `{{ define "integration_events" }}
+<p class="form-help">{{ t "form.integration.events" }}</p>
+<label><input type="checkbox" name="{{ .name }}_events" value="starred" {{ if .filter.HasEvent "starred" }}checked{{ end }}> {{ t "form.integration.event_starred" }}</label>
+<label><input type="checkbox" name="{{ .name }}_events" value="new_entries" {{ if .filter.HasEvent "new_entries" }}checked{{ end }}> {{ t "form.integration.event_new_entries" }}</label>
+{{ if .notification }}
+<label><input type="checkbox" name="{{ .name }}_events" value="feed_error" {{ if .filter.HasEvent "feed_error" }}checked{{ end }}> {{ t "form.integration.event_feed_error" }}</label>
+{{ end }}
+{{ if .categories }}
+<label for="form-{{ .name }}-categories">{{ t "form.integration.event_categories" }}</label>
+<select id="form-{{ .name }}-categories" name="{{ .name }}_categories" multiple>
+{{ range .categories }}
+    <option value="{{ .ID }}" {{ if $.filter.HasCategory .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+{{ end }}
+</select>
+{{ end }}
+{{ end }}
+`,
 	"item_meta": `{{ define "item_meta" }}
+{{ if .entry.Summary }}
+<p class="item-summary">{{ .entry.Summary }}</p>
+{{ end }}
 <div class="item-meta">
     <ul>
+        <li>
+            <input type="checkbox" name="entry_id" value="{{ .entry.ID }}" form="bulk-entries" aria-label="{{ t "form.bulk.select_entry" }}">
+        </li>
         <li>
             <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}" title="{{ .entry.Feed.SiteURL }}">{{ truncate .entry.Feed.Title 35 }}</a>
         </li>
         <li>
-            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed .user.Timezone .entry.Date }}</time>
+            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ timestamp .user .entry.Date }}</time>
         </li>
         {{ if .hasSaveEntry }}
             <li>
@@ -89,30 +137,32 @@ var templateCommonMap = map[string]string{
     <meta name="referrer" content="no-referrer">
 
     <!-- Favicons -->
-    <link rel="icon" type="image/png" sizes="16x16" href="{{ route "appIcon" "filename" "favicon-16.png" }}">
-    <link rel="icon" type="image/png" sizes="32x32" href="{{ route "appIcon" "filename" "favicon-32.png" }}">
+    <link rel="icon" type="image/png" sizes="16x16" href="{{ asset "favicon-16.png" }}">
+    <link rel="icon" type="image/png" sizes="32x32" href="{{ asset "favicon-32.png" }}">
 
     <!-- Android icons -->
-    <link rel="icon" type="image/png" sizes="128x128" href="{{ route "appIcon" "filename" "icon-128.png" }}">
-    <link rel="icon" type="image/png" sizes="192x192" href="{{ route "appIcon" "filename" "icon-192.png" }}">
+    <link rel="icon" type="image/png" sizes="128x128" href="{{ asset "icon-128.png" }}">
+    <link rel="icon" type="image/png" sizes="192x192" href="{{ asset "icon-192.png" }}">
 
     <!-- iOS icons -->
-    <link rel="apple-touch-icon" sizes="120x120" href="{{ route "appIcon" "filename" "icon-120.png" }}">
-    <link rel="apple-touch-icon" sizes="152x152" href="{{ route "appIcon" "filename" "icon-152.png" }}">
-    <link rel="apple-touch-icon" sizes="167x167" href="{{ route "appIcon" "filename" "icon-167.png" }}">
-    <link rel="apple-touch-icon" sizes="180x180" href="{{ route "appIcon" "filename" "icon-180.png" }}">
+    <link rel="apple-touch-icon" sizes="120x120" href="{{ asset "icon-120.png" }}">
+    <link rel="apple-touch-icon" sizes="152x152" href="{{ asset "icon-152.png" }}">
+    <link rel="apple-touch-icon" sizes="167x167" href="{{ asset "icon-167.png" }}">
+    <link rel="apple-touch-icon" sizes="180x180" href="{{ asset "icon-180.png" }}">
 
     {{ if .csrf }}
         <meta name="X-CSRF-Token" value="{{ .csrf }}">
     {{ end }}
 
     <meta name="theme-color" content="{{ theme_color .theme }}">
-    <link rel="stylesheet" type="text/css" href="{{ route "stylesheet" "name" .theme }}?{{ .theme_checksum }}">
+    <link rel="stylesheet" type="text/css" href="{{ asset (printf "%s.css" .theme) }}">
 
-    <script type="text/javascript" src="{{ route "javascript" "name" "app" }}?{{ .app_js_checksum }}" defer></script>
-    <script type="text/javascript" src="{{ route "javascript" "name" "sw" }}?{{ .sw_js_checksum }}" defer id="service-worker-script"></script>
+    <script type="text/javascript" src="{{ asset "app.js" }}" defer></script>
+    <script type="text/javascript" src="{{ route "javascript" "name" "sw" }}" defer id="service-worker-script"></script>
 </head>
-<body data-entries-status-url="{{ route "updateEntriesStatus" }}">
+{{ $shortcuts := keyboard_shortcuts .user }}
+<body data-entries-status-url="{{ route "updateEntriesStatus" }}"
+      data-keyboard-shortcuts="{{ json $shortcuts }}">
     {{ if .user }}
     <header class="header">
         <nav>
@@ -120,30 +170,30 @@ var templateCommonMap = map[string]string{
                 <a href="{{ route "unread" }}">Mini<span>flux</span></a>
             </div>
             <ul>
-                <li {{ if eq .menu "unread" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g u" }}">
+                <li {{ if eq .menu "unread" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_unread") }}">
                     <a href="{{ route "unread" }}" data-page="unread">{{ t "menu.unread" }}
                       {{ if gt .countUnread 0 }}
                           <span class="unread-counter-wrapper">(<span class="unread-counter">{{ .countUnread }}</span>)</span>
                       {{ end }}
                     </a>
                 </li>
-                <li {{ if eq .menu "starred" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g b" }}">
+                <li {{ if eq .menu "starred" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_starred") }}">
                     <a href="{{ route "starred" }}" data-page="starred">{{ t "menu.starred" }}</a>
                 </li>
-                <li {{ if eq .menu "history" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g h" }}">
+                <li {{ if eq .menu "history" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_history") }}">
                     <a href="{{ route "history" }}" data-page="history">{{ t "menu.history" }}</a>
                 </li>
-                <li {{ if eq .menu "feeds" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g f" }}">
+                <li {{ if eq .menu "feeds" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_feeds") }}">
                     <a href="{{ route "feeds" }}" data-page="feeds">{{ t "menu.feeds" }}
                       {{ if gt .countErrorFeeds 0 }}
                           <span class="error-feeds-counter-wrapper">(<span class="error-feeds-counter">{{ .countErrorFeeds }}</span>)</span>
                       {{ end }}
                     </a>
                 </li>
-                <li {{ if eq .menu "categories" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g c" }}">
+                <li {{ if eq .menu "categories" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_categories") }}">
                     <a href="{{ route "categories" }}" data-page="categories">{{ t "menu.categories" }}</a>
                 </li>
-                <li {{ if eq .menu "settings" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g s" }}">
+                <li {{ if eq .menu "settings" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" ($shortcuts.Label "go_to_settings") }}">
                     <a href="{{ route "settings" }}" data-page="settings">{{ t "menu.settings" }}</a>
                 </li>
                 <li>
@@ -161,6 +211,12 @@ var templateCommonMap = map[string]string{
         </nav>
     </header>
     {{ end }}
+    {{ if isReadOnly }}
+        <div class="read-only-message alert alert-info">{{ t "alert.read_only" }}</div>
+    {{ end }}
+    {{ if and .user (isFrozen .user) }}
+        <div class="write-freeze-message alert alert-info">{{ t "alert.write_freeze" (isodate .user.FrozenUntil) }}{{ if .user.FreezeMessage }} {{ .user.FreezeMessage }}{{ end }}</div>
+    {{ end }}
     {{ if .flashMessage }}
         <div class="flash-message alert alert-success">{{ .flashMessage }}</div>
     {{ end }}
@@ -178,40 +234,40 @@ var templateCommonMap = map[string]string{
             <div class="keyboard-shortcuts">
                 <p>{{ t "page.keyboard_shortcuts.subtitle.sections" }}</p>
                 <ul>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_unread" }} = <strong>g + u</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_starred" }} = <strong>g + b</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_history" }} = <strong>g + h</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_feeds" }} = <strong>g + f</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_categories" }} = <strong>g + c</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_settings" }} = <strong>g + s</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.show_keyboard_shortcuts" }} = <strong>?</strong></li>
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_unread") "keys" ($shortcuts.Labels "go_to_unread") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_starred") "keys" ($shortcuts.Labels "go_to_starred") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_history") "keys" ($shortcuts.Labels "go_to_history") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_feeds") "keys" ($shortcuts.Labels "go_to_feeds") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_categories") "keys" ($shortcuts.Labels "go_to_categories") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_settings") "keys" ($shortcuts.Labels "go_to_settings") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.show_keyboard_shortcuts") "keys" ($shortcuts.Labels "show_keyboard_shortcuts") }}
                 </ul>
 
                 <p>{{ t "page.keyboard_shortcuts.subtitle.items" }}</p>
                 <ul>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_previous_item" }} = <strong>p</strong>, <strong>j</strong>, <strong>◄</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_next_item" }} = <strong>n</strong>, <strong>k</strong>, <strong>►</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_feed" }} = <strong>g + f</strong></li>
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_previous_item") "keys" ($shortcuts.Labels "go_to_previous_item") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_next_item") "keys" ($shortcuts.Labels "go_to_next_item") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_feed") "keys" ($shortcuts.Labels "go_to_feeds") }}
                 </ul>
 
                 <p>{{ t "page.keyboard_shortcuts.subtitle.pages" }}</p>
                 <ul>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_previous_page" }} = <strong>h</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_next_page" }} = <strong>l</strong></li>
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_previous_page") "keys" ($shortcuts.Labels "go_to_previous_page") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_next_page") "keys" ($shortcuts.Labels "go_to_next_page") }}
                 </ul>
 
                 <p>{{ t "page.keyboard_shortcuts.subtitle.actions" }}</p>
                 <ul>
-                    <li>{{ t "page.keyboard_shortcuts.open_item" }} = <strong>o</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.open_original" }} = <strong>v</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.toggle_read_status" }} = <strong>m</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.mark_page_as_read" }} = <strong>A</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.download_content" }} = <strong>d</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.toggle_bookmark_status" }} = <strong>f</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.save_article" }} = <strong>s</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.remove_feed" }} = <strong>#</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.go_to_search" }} = <strong>/</strong></li>
-                    <li>{{ t "page.keyboard_shortcuts.close_modal" }} = <strong>Esc</strong></li>
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.open_item") "keys" ($shortcuts.Labels "open_item") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.open_original") "keys" ($shortcuts.Labels "open_original") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.toggle_read_status") "keys" ($shortcuts.Labels "toggle_read_status") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.mark_page_as_read") "keys" ($shortcuts.Labels "mark_page_as_read") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.download_content") "keys" ($shortcuts.Labels "download_content") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.toggle_bookmark_status") "keys" ($shortcuts.Labels "toggle_bookmark_status") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.save_article") "keys" ($shortcuts.Labels "save_article") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.remove_feed") "keys" ($shortcuts.Labels "remove_feed") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.go_to_search") "keys" ($shortcuts.Labels "go_to_search") }}
+                    {{ template "keyboard_shortcut" dict "label" (t "page.keyboard_shortcuts.close_modal") "keys" ($shortcuts.Labels "close_modal") }}
                 </ul>
             </div>
         </div>
@@ -219,12 +275,16 @@ var templateCommonMap = map[string]string{
 </body>
 </html>
 {{ end }}
+
+{{ define "keyboard_shortcut" }}
+{{ if .keys }}<li>{{ .label }} = {{ range $i, $key := .keys }}{{ if $i }}, {{ end }}<strong>{{ $key }}</strong>{{ end }}</li>{{ end }}
+{{ end }}
 `,
 	"pagination": `{{ define "pagination" }}
 <div class="pagination">
     <div class="pagination-prev">
         {{ if .ShowPrev }}
-            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ end }}{{ end }}" data-page="previous">{{ t "pagination.previous" }}</a>
+            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .Status }}&amp;status={{ .Status }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ else if .Status }}?status={{ .Status }}{{ end }}{{ end }}" data-page="previous">{{ t "pagination.previous" }}</a>
         {{ else }}
             {{ t "pagination.previous" }}
         {{ end }}
@@ -232,7 +292,7 @@ var templateCommonMap = map[string]string{
 
     <div class="pagination-next">
         {{ if .ShowNext }}
-            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}" data-page="next">{{ t "pagination.next" }}</a>
+            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .Status }}&amp;status={{ .Status }}{{ end }}" data-page="next">{{ t "pagination.next" }}</a>
         {{ else }}
             {{ t "pagination.next" }}
         {{ end }}
@@ -243,8 +303,10 @@ var templateCommonMap = map[string]string{
 }
 
 var templateCommonMapChecksums = map[string]string{
-	"entry_pagination": "4faa91e2eae150c5e4eab4d258e039dfdd413bab7602f0009360e6d52898e353",
-	"item_meta":        "34deb081a054f2948ad808bdb2c8603d6ab00c58f2f50c4ead0b47ae092888eb",
-	"layout":           "4a5339267f67b5999a22ece7584df4c75785bc3bf95d44e1891da763aaea7991",
-	"pagination":       "3386e90c6e1230311459e9a484629bc5d5bf39514a75ef2e73bbbc61142f7abb",
+	"bulk_actions":       "1e6eaa83ea1c3716802e7dd1ce22f140f9747d24a1d6bcb371b3095c62ba0d10",
+	"entry_pagination":   "4faa91e2eae150c5e4eab4d258e039dfdd413bab7602f0009360e6d52898e353",
+	"integration_events": "605034b5ce0d0215601650e9fa297fa33af0da8cb5c3299ca923f5acbf21d1d5",
+	"item_meta":          "f8c8fc3e1d291870a75c58e934739971c74b580fd1b62a48cf3862e52b485b85",
+	"layout":             "0a28d02dc4a658d334e8772108e966a5b168bb5c2c1ff548e9f0ae4f5c96ddf2",
+	"pagination":         "0f985cd014c1e923b2c8cbed014bc8e1e182473ef8985bd0b35d2f13a275e162",
 }
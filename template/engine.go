@@ -9,10 +9,12 @@ import (
 	"html/template"
 	"time"
 
+	"miniflux.app/cache"
 	"miniflux.app/config"
 	"miniflux.app/errors"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 
 	"github.com/gorilla/mux"
 )
@@ -24,12 +26,10 @@ type Engine struct {
 }
 
 func (e *Engine) parseAll() {
-	commonTemplates := ""
-	for _, content := range templateCommonMap {
-		commonTemplates += content
-	}
+	views, common := templateSources()
+	commonTemplates := concat(common)
 
-	for name, content := range templateViewsMap {
+	for name, content := range views {
 		logger.Debug("[Template] Parsing: %s", name)
 		e.templates[name] = template.Must(template.New("main").Funcs(e.funcMap.Map()).Parse(commonTemplates + content))
 	}
@@ -47,7 +47,10 @@ func (e *Engine) Render(name, language string, data interface{}) []byte {
 	// Functions that need to be declared at runtime.
 	tpl.Funcs(template.FuncMap{
 		"elapsed": func(timezone string, t time.Time) string {
-			return elapsedTime(printer, timezone, t)
+			return printer.ElapsedTime(timezone, t)
+		},
+		"timestamp": func(user *model.User, t time.Time) string {
+			return formatTimestamp(printer, user, t)
 		},
 		"t": func(key interface{}, args ...interface{}) string {
 			switch k := key.(type) {
@@ -78,10 +81,10 @@ func (e *Engine) Render(name, language string, data interface{}) []byte {
 }
 
 // NewEngine returns a new template engine.
-func NewEngine(cfg *config.Config, router *mux.Router) *Engine {
+func NewEngine(cfg *config.Config, router *mux.Router, c cache.Cache) *Engine {
 	tpl := &Engine{
 		templates: make(map[string]*template.Template),
-		funcMap:   newFuncMap(cfg, router),
+		funcMap:   newFuncMap(cfg, router, c),
 	}
 
 	tpl.parseAll()
@@ -5,28 +5,35 @@
 package template // import "miniflux.app/template"
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
-	"math"
 	"html/template"
 	"net/mail"
+	"path"
 	"strings"
 	"time"
 
+	"miniflux.app/cache"
 	"miniflux.app/config"
 	"miniflux.app/http/route"
 	"miniflux.app/locale"
 	"miniflux.app/model"
-	"miniflux.app/timezone"
+	"miniflux.app/ui/static"
 	"miniflux.app/url"
 
 	"github.com/gorilla/mux"
 	"github.com/PuerkitoBio/goquery"
 )
 
+// Rendered entry contents are cached for one hour.
+const renderedContentCacheTTL = time.Hour
+
 type funcMap struct {
 	cfg    *config.Config
 	router *mux.Router
+	cache  cache.Cache
 }
 
 // Map returns a map of template functions that are compiled during template parsing.
@@ -36,23 +43,46 @@ func (f *funcMap) Map() template.FuncMap {
 		"hasKey":   hasKey,
 		"truncate": truncate,
 		"isEmail":  isEmail,
+		"json":     toJSON,
+		"fileSize": fileSize,
 		"baseURL": func() string {
 			return f.cfg.BaseURL()
 		},
 		"rootURL": func() string {
 			return f.cfg.RootURL()
 		},
+		"isReadOnly": func() bool {
+			return f.cfg.IsReadOnly()
+		},
+		"hasMailer": func() bool {
+			return f.cfg.HasMailer()
+		},
+		"hasSummarizer": func() bool {
+			return f.cfg.HasSummarizer()
+		},
+		"hasSignup": func() bool {
+			return f.cfg.HasSignup()
+		},
+		"isFrozen": func(user *model.User) bool {
+			return user.IsFrozen(time.Now())
+		},
 		"hasOAuth2Provider": func(provider string) bool {
 			return f.cfg.OAuth2Provider() == provider
 		},
 		"route": func(name string, args ...interface{}) string {
 			return route.Path(f.router, name, args...)
 		},
+		"asset": func(name string) string {
+			return route.Path(f.router, "asset", "filename", static.Manifest[name])
+		},
 		"noescape": func(str string) template.HTML {
 			return template.HTML(str)
 		},
 		"proxyFilter": func(data string) string {
-			return imageProxyFilter(f.router, f.cfg, data)
+			return f.cachedImageProxyFilter(data)
+		},
+		"imageFilter": func(user *model.User, feed *model.Feed, data string) string {
+			return f.cachedImageDisplayFilter(model.ResolveImageDisplay(user, feed), data)
 		},
 		"proxyURL": func(link string) string {
 			proxyImages := f.cfg.ProxyImages()
@@ -78,11 +108,20 @@ func (f *funcMap) Map() template.FuncMap {
 		"theme_color": func(theme string) string {
 			return model.ThemeColor(theme)
 		},
+		"keyboard_shortcuts": func(user *model.User) model.KeyboardShortcuts {
+			if user == nil {
+				return model.DefaultKeyboardShortcuts()
+			}
+			return user.KeyboardShortcuts.WithDefaults()
+		},
 
 		// These functions are overrided at runtime after the parsing.
 		"elapsed": func(timezone string, t time.Time) string {
 			return ""
 		},
+		"timestamp": func(user *model.User, t time.Time) string {
+			return ""
+		},
 		"t": func(key interface{}, args ...interface{}) string {
 			return ""
 		},
@@ -92,8 +131,41 @@ func (f *funcMap) Map() template.FuncMap {
 	}
 }
 
-func newFuncMap(cfg *config.Config, router *mux.Router) *funcMap {
-	return &funcMap{cfg, router}
+func newFuncMap(cfg *config.Config, router *mux.Router, c cache.Cache) *funcMap {
+	return &funcMap{cfg, router, c}
+}
+
+// cachedImageProxyFilter avoids parsing the same entry content on each page view.
+// The key is derived from the content, so it doesn't need to be invalidated.
+func (f *funcMap) cachedImageProxyFilter(data string) string {
+	if f.cfg.ProxyImages() == "none" {
+		return data
+	}
+
+	key := fmt.Sprintf("rendered_content:%s:%x", f.cfg.ProxyImages(), sha256.Sum256([]byte(data)))
+	if output, found := f.cache.Get(key); found {
+		return string(output)
+	}
+
+	output := imageProxyFilter(f.router, f.cfg, data)
+	f.cache.Set(key, []byte(output), renderedContentCacheTTL)
+	return output
+}
+
+// cachedImageDisplayFilter blurs or hides the images of the content according to the display mode of the feed.
+func (f *funcMap) cachedImageDisplayFilter(mode, data string) string {
+	if mode == model.ImageDisplayShow {
+		return data
+	}
+
+	key := fmt.Sprintf("rendered_images:%s:%x", mode, sha256.Sum256([]byte(data)))
+	if output, found := f.cache.Get(key); found {
+		return string(output)
+	}
+
+	output := imageDisplayFilter(mode, data)
+	f.cache.Set(key, []byte(output), renderedContentCacheTTL)
+	return output
 }
 
 func dict(values ...interface{}) (map[string]interface{}, error) {
@@ -118,6 +190,14 @@ func hasKey(dict map[string]string, key string) bool {
 	return false
 }
 
+func toJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 func truncate(str string, max int) string {
 	runes := 0
 	for i := range str {
@@ -129,6 +209,22 @@ func truncate(str string, max int) string {
 	return str
 }
 
+// fileSize returns a size in bytes with a binary unit, for example 1.5 MiB.
+func fileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
 func isEmail(str string) bool {
 	_, err := mail.ParseAddress(str)
 	if err != nil {
@@ -137,45 +233,17 @@ func isEmail(str string) bool {
 	return true
 }
 
-func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
-	if t.IsZero() {
-		return printer.Printf("time_elapsed.not_yet")
-	}
-
-	now := timezone.Now(tz)
-	t = timezone.Convert(tz, t)
-	if now.Before(t) {
-		return printer.Printf("time_elapsed.not_yet")
-	}
-
-	diff := now.Sub(t)
-	// Duration in seconds
-	s := diff.Seconds()
-	// Duration in days
-	d := int(s / 86400)
-	switch {
-	case s < 60:
-		return printer.Printf("time_elapsed.now")
-	case s < 3600:
-		minutes := int(diff.Minutes())
-		return printer.Plural("time_elapsed.minutes", minutes, minutes)
-	case s < 86400:
-		hours := int(diff.Hours())
-		return printer.Plural("time_elapsed.hours", hours, hours)
-	case d == 1:
-		return printer.Printf("time_elapsed.yesterday")
-	case d < 21:
-		return printer.Plural("time_elapsed.days", d, d)
-	case d < 31:
-		weeks := int(math.Round(float64(d) / 7))
-		return printer.Plural("time_elapsed.weeks", weeks, weeks)
-	case d < 365:
-		months := int(math.Round(float64(d) / 30))
-		return printer.Plural("time_elapsed.months", months, months)
-	default:
-		years := int(math.Round(float64(d) / 365))
-		return printer.Plural("time_elapsed.years", years, years)
+// formatTimestamp displays the time elapsed since t or the absolute time according to the user preferences.
+func formatTimestamp(printer *locale.Printer, user *model.User, t time.Time) string {
+	if user == nil {
+		return printer.ElapsedTime("", t)
+	}
+
+	if user.ShowAbsoluteTime {
+		return printer.AbsoluteTime(user.Timezone, t)
 	}
+
+	return printer.ElapsedTime(user.Timezone, t)
 }
 
 func imageProxyFilter(router *mux.Router, cfg *config.Config, data string) string {
@@ -201,6 +269,45 @@ func imageProxyFilter(router *mux.Router, cfg *config.Config, data string) strin
 	return output
 }
 
+func imageDisplayFilter(mode, data string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(data))
+	if err != nil {
+		return data
+	}
+
+	switch mode {
+	case model.ImageDisplayBlur:
+		doc.Find("img").AddClass("blurred-image")
+	case model.ImageDisplayHide:
+		// Images are replaced by links, nothing is downloaded until a link is followed.
+		doc.Find("picture").Each(func(i int, picture *goquery.Selection) {
+			picture.ReplaceWithSelection(picture.Find("img").First())
+		})
+
+		doc.Find("img").Each(func(i int, img *goquery.Selection) {
+			src := img.AttrOr("src", "")
+			if src == "" {
+				img.Remove()
+				return
+			}
+
+			label := strings.TrimSpace(img.AttrOr("alt", ""))
+			if label == "" {
+				label = path.Base(src)
+			}
+
+			img.ReplaceWithHtml(fmt.Sprintf(
+				`<a href="%s" class="hidden-image" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">%s</a>`,
+				template.HTMLEscapeString(src),
+				template.HTMLEscapeString(label),
+			))
+		})
+	}
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
 func proxify(router *mux.Router, link string) string {
 	// We use base64 url encoding to avoid slash in the URL.
 	return route.Path(router, "proxy", "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
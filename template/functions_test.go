@@ -12,6 +12,7 @@ import (
 
 	"miniflux.app/config"
 	"miniflux.app/locale"
+	"miniflux.app/model"
 
 	"github.com/gorilla/mux"
 )
@@ -61,6 +62,18 @@ func TestHasKey(t *testing.T) {
 	}
 }
 
+func TestToJSON(t *testing.T) {
+	output, err := toJSON(map[string][]string{"open_item": {"o"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"open_item":["o"]}`
+	if output != expected {
+		t.Fatalf(`Unexpected JSON, got %q instead of %q`, output, expected)
+	}
+}
+
 func TestTruncateWithShortTexts(t *testing.T) {
 	scenarios := []string{"Short text", "Короткий текст"}
 
@@ -101,36 +114,62 @@ func TestIsEmail(t *testing.T) {
 	}
 }
 
-func TestElapsedTime(t *testing.T) {
-	printer := locale.NewPrinter("en_US")
-	var dt = []struct {
-		in  time.Time
-		out string
-	}{
-		{time.Time{}, printer.Printf("time_elapsed.not_yet")},
-		{time.Now().Add(time.Hour), printer.Printf("time_elapsed.not_yet")},
-		{time.Now(), printer.Printf("time_elapsed.now")},
-		{time.Now().Add(-time.Minute), printer.Plural("time_elapsed.minutes", 1, 1)},
-		{time.Now().Add(-time.Minute * 40), printer.Plural("time_elapsed.minutes", 40, 40)},
-		{time.Now().Add(-time.Hour), printer.Plural("time_elapsed.hours", 1, 1)},
-		{time.Now().Add(-time.Hour * 3), printer.Plural("time_elapsed.hours", 3, 3)},
-		{time.Now().Add(-time.Hour * 32), printer.Printf("time_elapsed.yesterday")},
-		{time.Now().Add(-time.Hour * 24 * 3), printer.Plural("time_elapsed.days", 3, 3)},
-		{time.Now().Add(-time.Hour * 24 * 14), printer.Plural("time_elapsed.days", 14, 14)},
-		{time.Now().Add(-time.Hour * 24 * 15), printer.Plural("time_elapsed.days", 15, 15)},
-		{time.Now().Add(-time.Hour * 24 * 21), printer.Plural("time_elapsed.weeks", 3, 3)},
-		{time.Now().Add(-time.Hour * 24 * 32), printer.Plural("time_elapsed.months", 1, 1)},
-		{time.Now().Add(-time.Hour * 24 * 60), printer.Plural("time_elapsed.months", 2, 2)},
-		{time.Now().Add(-time.Hour * 24 * 366), printer.Plural("time_elapsed.years", 1, 1)},
-		{time.Now().Add(-time.Hour * 24 * 365 * 3), printer.Plural("time_elapsed.years", 3, 3)},
+func TestFileSize(t *testing.T) {
+	scenarios := map[int64]string{
+		0:                      "0 B",
+		1023:                   "1023 B",
+		1536:                   "1.5 KiB",
+		250 * 1024 * 1024:      "250.0 MiB",
+		3 * 1024 * 1024 * 1024: "3.0 GiB",
 	}
-	for i, tt := range dt {
-		if out := elapsedTime(printer, "Local", tt.in); out != tt.out {
-			t.Errorf(`%d. content mismatch for "%v": expected=%q got=%q`, i, tt.in, tt.out, out)
+
+	for size, expected := range scenarios {
+		if result := fileSize(size); result != expected {
+			t.Errorf(`Unexpected size for %d, got %q instead of %q`, size, result, expected)
 		}
 	}
 }
 
+func TestFormatTimestamp(t *testing.T) {
+	printer := locale.NewPrinter("en_US")
+	date := time.Now().Add(-3 * time.Hour)
+
+	user := &model.User{Timezone: "UTC"}
+	if result := formatTimestamp(printer, user, date); result != printer.ElapsedTime("UTC", date) {
+		t.Errorf(`Unexpected relative timestamp, got %q`, result)
+	}
+
+	user.ShowAbsoluteTime = true
+	if result := formatTimestamp(printer, user, date); result != printer.AbsoluteTime("UTC", date) {
+		t.Errorf(`Unexpected absolute timestamp, got %q`, result)
+	}
+
+	if result := formatTimestamp(printer, nil, date); result != printer.ElapsedTime("", date) {
+		t.Errorf(`Unexpected timestamp without user, got %q`, result)
+	}
+}
+
+func TestImageDisplayFilterWithBlur(t *testing.T) {
+	input := `<p><img src="https://website/image.png" alt="Test"/></p>`
+	output := imageDisplayFilter(model.ImageDisplayBlur, input)
+	expected := `<p><img src="https://website/image.png" alt="Test" class="blurred-image"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestImageDisplayFilterWithHide(t *testing.T) {
+	input := `<p><img src="https://website/image.png"/><picture><source srcset="https://website/large.webp"/><img src="https://website/small.jpg" alt="A &lt;chart&gt;"/></picture></p>`
+	output := imageDisplayFilter(model.ImageDisplayHide, input)
+	expected := `<p><a href="https://website/image.png" class="hidden-image" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">image.png</a>` +
+		`<a href="https://website/small.jpg" class="hidden-image" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">A &lt;chart&gt;</a></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
 func TestProxyFilterWithHttpDefault(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "http-only")
@@ -0,0 +1,124 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package template // import "miniflux.app/template"
+
+import (
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"miniflux.app/logger"
+)
+
+var (
+	mutex           sync.RWMutex
+	viewOverrides   = make(map[string]string)
+	commonOverrides = make(map[string]string)
+)
+
+// LoadDirectory reads the templates overriding the built-in ones.
+// Views are stored at the root of the directory and common templates in the "common" subdirectory,
+// each file must have the name of an existing template, for example "entry.html" or "common/layout.html".
+func LoadDirectory(directory string) error {
+	views, err := readOverrides(filepath.Join(directory, "*.html"), templateViewsMap)
+	if err != nil {
+		return err
+	}
+
+	common, err := readOverrides(filepath.Join(directory, "common", "*.html"), templateCommonMap)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTemplates(merge(templateViewsMap, views), merge(templateCommonMap, common)); err != nil {
+		return err
+	}
+
+	mutex.Lock()
+	viewOverrides = views
+	commonOverrides = common
+	mutex.Unlock()
+
+	return nil
+}
+
+func readOverrides(pattern string, builtin map[string]string) (map[string]string, error) {
+	filenames, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]string)
+	for _, filename := range filenames {
+		name := strings.TrimSuffix(filepath.Base(filename), ".html")
+		if _, found := builtin[name]; !found {
+			return nil, fmt.Errorf("unable to override the template %q: there is no built-in template with this name", filename)
+		}
+
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the template %q: %v", filename, err)
+		}
+
+		logger.Info("[Template] Loaded override %s", filename)
+		overrides[name] = string(content)
+	}
+
+	return overrides, nil
+}
+
+// validateTemplates makes sure that every view can be parsed and rendered by the engine.
+func validateTemplates(views, common map[string]string) error {
+	funcs := newFuncMap(nil, nil, nil).Map()
+	commonTemplates := concat(common)
+
+	for name, content := range views {
+		tpl, err := template.New("main").Funcs(funcs).Parse(commonTemplates + content)
+		if err != nil {
+			return fmt.Errorf("unable to parse the template %q: %v", name, err)
+		}
+
+		for _, required := range []string{"base", "content"} {
+			if tpl.Lookup(required) == nil {
+				return fmt.Errorf("the template %q doesn't define %q", name, required)
+			}
+		}
+	}
+
+	return nil
+}
+
+// templateSources returns the views and common templates with the overrides applied.
+func templateSources() (views, common map[string]string) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return merge(templateViewsMap, viewOverrides), merge(templateCommonMap, commonOverrides)
+}
+
+func merge(builtin, overrides map[string]string) map[string]string {
+	result := make(map[string]string, len(builtin))
+	for name, content := range builtin {
+		result[name] = content
+	}
+
+	for name, content := range overrides {
+		result[name] = content
+	}
+
+	return result
+}
+
+func concat(templates map[string]string) string {
+	var b strings.Builder
+	for _, content := range templates {
+		b.WriteString(content)
+	}
+
+	return b.String()
+}
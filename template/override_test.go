@@ -0,0 +1,120 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package template // import "miniflux.app/template"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func createOverrideDirectory(t *testing.T, files map[string]string) string {
+	directory, err := ioutil.TempDir("", "miniflux-templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(filepath.Join(directory, "common"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return directory
+}
+
+func resetOverrides() {
+	viewOverrides = make(map[string]string)
+	commonOverrides = make(map[string]string)
+}
+
+func TestLoadDirectory(t *testing.T) {
+	defer resetOverrides()
+
+	directory := createOverrideDirectory(t, map[string]string{
+		"about.html":             `{{ define "title" }}About{{ end }}{{ define "content" }}Custom about page{{ end }}`,
+		"common/pagination.html": `{{ define "pagination" }}Custom pagination{{ end }}`,
+	})
+	defer os.RemoveAll(directory)
+
+	if err := LoadDirectory(directory); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	views, common := templateSources()
+	if !strings.Contains(views["about"], "Custom about page") {
+		t.Errorf(`The view has not been overridden`)
+	}
+
+	if !strings.Contains(common["pagination"], "Custom pagination") {
+		t.Errorf(`The common template has not been overridden`)
+	}
+
+	if views["entry"] != templateViewsMap["entry"] {
+		t.Errorf(`Views without override must not be modified`)
+	}
+}
+
+func TestLoadDirectoryWithUnknownTemplate(t *testing.T) {
+	defer resetOverrides()
+
+	directory := createOverrideDirectory(t, map[string]string{
+		"unknown.html": `{{ define "content" }}{{ end }}`,
+	})
+	defer os.RemoveAll(directory)
+
+	if err := LoadDirectory(directory); err == nil {
+		t.Fatal(`An unknown template should be rejected`)
+	}
+
+	if len(viewOverrides) != 0 {
+		t.Errorf(`No override should be registered`)
+	}
+}
+
+func TestLoadDirectoryWithInvalidSyntax(t *testing.T) {
+	defer resetOverrides()
+
+	directory := createOverrideDirectory(t, map[string]string{
+		"about.html": `{{ define "content" }}{{ if }}{{ end }}`,
+	})
+	defer os.RemoveAll(directory)
+
+	if err := LoadDirectory(directory); err == nil {
+		t.Fatal(`A template with a syntax error should be rejected`)
+	}
+}
+
+func TestLoadDirectoryWithUnknownFunction(t *testing.T) {
+	defer resetOverrides()
+
+	directory := createOverrideDirectory(t, map[string]string{
+		"about.html": `{{ define "content" }}{{ unknownFunction }}{{ end }}`,
+	})
+	defer os.RemoveAll(directory)
+
+	if err := LoadDirectory(directory); err == nil {
+		t.Fatal(`A template calling an undefined function should be rejected`)
+	}
+}
+
+func TestLoadDirectoryWithoutContent(t *testing.T) {
+	defer resetOverrides()
+
+	directory := createOverrideDirectory(t, map[string]string{
+		"about.html": `{{ define "title" }}About{{ end }}`,
+	})
+	defer os.RemoveAll(directory)
+
+	if err := LoadDirectory(directory); err == nil {
+		t.Fatal(`A view without content block should be rejected`)
+	}
+}
@@ -102,6 +102,10 @@ var templateViewsMap = map[string]string{
                     - Using a different input name doesn't change anything
                 -->
                 <input type="text" name="feed_password" id="form-feed-password" value="{{ .form.Password }}">
+
+                <label for="form-watch-selector">{{ t "form.feed.label.watch_selector" }}</label>
+                <input type="text" name="watch_selector" id="form-watch-selector" placeholder="#content" value="{{ .form.WatchSelector }}">
+                <p class="form-help">{{ t "page.add_feed.watch_selector_help" }}</p>
             </div>
         </details>
 
@@ -118,20 +122,35 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.starred.title" }} ({{ .total }})</h1>
+    {{ if .entries }}
+    <ul>
+        <li>
+            <a href="{{ route "exportStarred" "format" "epub" }}">{{ t "menu.export_epub" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "exportStarred" "format" "html" }}" target="_blank">{{ t "menu.export_printable" }}</a>
+        </li>
+    </ul>
+    {{ end }}
 </section>
 
 {{ if not .entries }}
     <p class="alert alert-info">{{ t "alert.no_bookmark" }}</p>
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "starredEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "starredEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -181,6 +200,9 @@ var templateViewsMap = map[string]string{
                     <li>
                         <a href="{{ route "editCategory" "categoryID" .ID }}">{{ t "menu.edit_category" }}</a>
                     </li>
+                    <li>
+                        <a href="{{ route "categoryShares" "categoryID" .ID }}">{{ t "menu.share_category" }}</a>
+                    </li>
                     {{ if eq .FeedCount 0 }}
                     <li>
                         <a href="#"
@@ -199,6 +221,30 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ if .sharedCategories }}
+<section class="page-header">
+    <h2>{{ t "page.categories.shared_with_me" }}</h2>
+</section>
+<div class="items">
+    {{ range .sharedCategories }}
+    <article class="item">
+        <div class="item-header">
+            <span class="item-title">
+                <a href="{{ route "sharedCategoryEntries" "categoryID" .Category.ID }}">{{ .Category.Title }}</a>
+            </span>
+        </div>
+        <div class="item-meta">
+            <ul>
+                <li>
+                    {{ t "page.shared_category_entries.shared_by" .OwnerUsername }}
+                </li>
+            </ul>
+        </div>
+    </article>
+    {{ end }}
+</div>
+{{ end }}
+
 {{ end }}
 `,
 	"category_entries": `{{ define "title"}}{{ .category.Title }} ({{ .total }}){{ end }}
@@ -218,7 +264,7 @@ var templateViewsMap = map[string]string{
         </li>
     {{ else }}
         <li>
-            <a href="{{ route "categoryEntries" "categoryID" .category.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
+            <a href="{{ route "categoryEntries" "categoryID" .category.ID }}{{ if .user.ShowReadEntries }}?status=unread{{ end }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
     {{ end }}
     </ul>
@@ -227,15 +273,20 @@ var templateViewsMap = map[string]string{
 {{ if not .entries }}
     <p class="alert">{{ t "alert.no_category_entry" }}</p>
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "categoryEntry" "categoryID" .Feed.Category.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "categoryEntry" "categoryID" .Feed.Category.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -255,6 +306,67 @@ var templateViewsMap = map[string]string{
     {{ template "pagination" .pagination }}
 {{ end }}
 
+{{ end }}
+`,
+	"category_shares": `{{ define "title"}}{{ t "page.category_shares.title" .category.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.category_shares.title" .category.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "categories" }}">{{ t "menu.categories" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "editCategory" "categoryID" .category.ID }}">{{ t "menu.edit_category" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "shareCategory" "categoryID" .category.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-username">{{ t "form.category_share.label.username" }}</label>
+    <input type="text" name="username" id="form-username" required autofocus>
+    <p class="form-help">{{ t "form.category_share.help.username" }}</p>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.share" }}</button>
+    </div>
+</form>
+
+{{ if not .shares }}
+    <p class="alert">{{ t "alert.no_category_share" }}</p>
+{{ else }}
+    <table>
+        <tr>
+            <th class="column-20">{{ t "page.users.username" }}</th>
+            <th>{{ t "page.category_shares.since" }}</th>
+            <th>{{ t "page.users.actions" }}</th>
+        </tr>
+        {{ range .shares }}
+        <tr>
+            <td>{{ .Username }}</td>
+            <td><time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ timestamp $.user .CreatedAt }}</time></td>
+            <td>
+                <a href="#"
+                    data-confirm="true"
+                    data-label-question="{{ t "confirm.question" }}"
+                    data-label-yes="{{ t "confirm.yes" }}"
+                    data-label-no="{{ t "confirm.no" }}"
+                    data-label-loading="{{ t "confirm.loading" }}"
+                    data-url="{{ route "unshareCategory" "categoryID" .CategoryID "userID" .UserID }}"
+                    data-redirect-url="{{ route "categoryShares" "categoryID" .CategoryID }}">{{ t "action.remove" }}</a>
+            </td>
+        </tr>
+        {{ end }}
+    </table>
+{{ end }}
+
 {{ end }}
 `,
 	"choose_subscription": `{{ define "title"}}{{ t "page.add_feed.title" }}{{ end }}
@@ -322,6 +434,10 @@ var templateViewsMap = map[string]string{
     <label for="form-title">{{ t "form.category.label.title" }}</label>
     <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
 
+    <label for="form-mark-read-after-days">{{ t "form.category.label.mark_read_after_days" }}</label>
+    <input type="number" name="mark_read_after_days" id="form-mark-read-after-days" value="{{ .form.MarkReadAfterDays }}" min="0">
+    <p class="form-help">{{ t "form.category.help.mark_read_after_days" }}</p>
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "categories" }}">{{ t "action.cancel" }}</a>
     </div>
@@ -375,6 +491,39 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"delete_account": `{{ define "title"}}{{ t "page.delete_account.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.delete_account.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form method="post" autocomplete="off" action="{{ route "submitDeleteAccount" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <p class="alert">{{ t "page.delete_account.description" }}</p>
+
+    {{ if .hasPassword }}
+    <label for="form-password">{{ t "form.user.label.password" }}</label>
+    <input type="password" name="password" id="form-password" autocomplete="current-password" required autofocus>
+    <p class="form-help">{{ t "form.delete_account.help.password" }}</p>
+    {{ end }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.delete_account" }}</button> {{ t "action.or" }} <a href="{{ route "settings" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
 `,
 	"edit_category": `{{ define "title"}}{{ t "page.edit_category.title" .category.Title }}{{ end }}
 
@@ -393,6 +542,7 @@ var templateViewsMap = map[string]string{
 
 <form action="{{ route "updateCategory" "categoryID" .category.ID }}" method="post" autocomplete="off">
     <input type="hidden" name="csrf" value="{{ .csrf }}">
+    <input type="hidden" name="version" value="{{ .form.Version }}">
 
     {{ if .errorMessage }}
         <div class="alert alert-error">{{ t .errorMessage }}</div>
@@ -401,6 +551,21 @@ var templateViewsMap = map[string]string{
     <label for="form-title">{{ t "form.category.label.title" }}</label>
     <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
 
+    <label for="form-mark-read-after-days">{{ t "form.category.label.mark_read_after_days" }}</label>
+    <input type="number" name="mark_read_after_days" id="form-mark-read-after-days" value="{{ .form.MarkReadAfterDays }}" min="0">
+    <p class="form-help">{{ t "form.category.help.mark_read_after_days" }}</p>
+
+    <label for="form-image-display">{{ t "form.prefs.label.image_display" }}</label>
+    <select id="form-image-display" name="image_display">
+        <option value="">{{ t "form.prefs.select.image_display_inherit" }}</option>
+    {{ range $key, $value := .imageDisplays }}
+        <option value="{{ $key }}" {{ if eq $key $.form.ImageDisplay }}selected="selected"{{ end }}>{{ t $value }}</option>
+    {{ end }}
+    </select>
+    <p class="form-help">{{ t "form.category.help.image_display" }}</p>
+
+    <label><input type="checkbox" name="nsfw" value="1" {{ if .form.NSFW }}checked{{ end }}> {{ t "form.category.label.nsfw" }}</label>
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "categories" }}">{{ t "action.cancel" }}</a>
     </div>
@@ -440,6 +605,7 @@ var templateViewsMap = map[string]string{
 
     <form action="{{ route "updateFeed" "feedID" .feed.ID }}" method="post" autocomplete="off">
         <input type="hidden" name="csrf" value="{{ .csrf }}">
+        <input type="hidden" name="version" value="{{ .form.Version }}">
 
         {{ if .errorMessage }}
             <div class="alert alert-error">{{ t .errorMessage }}</div>
@@ -470,12 +636,26 @@ var templateViewsMap = map[string]string{
 	    <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
 	    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}">
 
+        {{ if .feed.IsPageWatch }}
+        <label for="form-watch-selector">{{ t "form.feed.label.watch_selector" }}</label>
+        <input type="text" name="watch_selector" id="form-watch-selector" value="{{ .form.WatchSelector }}" required>
+
+        {{ end }}
         <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
         <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}">
 
+        <label for="form-scraper-max-pages">{{ t "form.feed.label.scraper_max_pages" }}</label>
+        <input type="number" name="scraper_max_pages" id="form-scraper-max-pages" value="{{ .form.ScraperMaxPages }}" min="1" max="{{ .maxScraperPages }}">
+        <p class="form-help">{{ t "form.feed.help.scraper_max_pages" }}</p>
+
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
         <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
 
+        {{ if .user.IsAdmin }}
+        <label for="form-script">{{ t "form.feed.label.script" }}</label>
+        <textarea name="script" id="form-script" spellcheck="false">{{ .form.Script }}</textarea>
+        {{ end }}
+
         <label for="form-category">{{ t "form.feed.label.category" }}</label>
         <select id="form-category" name="category_id">
         {{ range .categories }}
@@ -483,7 +663,65 @@ var templateViewsMap = map[string]string{
         {{ end }}
         </select>
 
+        <label for="form-entry-open-mode">{{ t "form.feed.label.entry_open_mode" }}</label>
+        <select id="form-entry-open-mode" name="entry_open_mode">
+        {{ range $key, $value := .entryOpenModes }}
+            <option value="{{ $key }}" {{ if eq $key $.form.EntryOpenMode }}selected="selected"{{ end }}>{{ t $value }}</option>
+        {{ end }}
+        </select>
+
+        <label for="form-image-display">{{ t "form.prefs.label.image_display" }}</label>
+        <select id="form-image-display" name="image_display">
+            <option value="">{{ t "form.prefs.select.image_display_inherit" }}</option>
+        {{ range $key, $value := .imageDisplays }}
+            <option value="{{ $key }}" {{ if eq $key $.form.ImageDisplay }}selected="selected"{{ end }}>{{ t $value }}</option>
+        {{ end }}
+        </select>
+        <p class="form-help">{{ t "form.feed.help.image_display" }}</p>
+
+        <label for="form-summary-words">{{ t "form.feed.label.summary_words" }}</label>
+        <input type="number" name="summary_words" id="form-summary-words" value="{{ .form.SummaryWords }}" min="0" max="{{ .maxSummaryWords }}">
+        <p class="form-help">{{ t "form.feed.help.summary_words" }}</p>
+
+        <label for="form-priority">{{ t "form.feed.label.priority" }}</label>
+        <select id="form-priority" name="priority">
+        {{ range $key, $value := .feedPriorities }}
+            <option value="{{ $key }}" {{ if eq $key $.form.Priority }}selected="selected"{{ end }}>{{ t $value }}</option>
+        {{ end }}
+        </select>
+
+        <label for="form-muted-until">{{ t "form.feed.label.muted_until" }}</label>
+        <input type="date" name="muted_until" id="form-muted-until" value="{{ .form.MutedUntil }}" placeholder="YYYY-MM-DD">
+        <p class="form-help">{{ t "form.feed.help.muted_until" }}</p>
+
+        <label for="form-mark-read-after-days">{{ t "form.feed.label.mark_read_after_days" }}</label>
+        <input type="number" name="mark_read_after_days" id="form-mark-read-after-days" value="{{ .form.MarkReadAfter }}" min="0">
+        <p class="form-help">{{ t "form.feed.help.mark_read_after_days" }}</p>
+
+        <label for="form-max-entries">{{ t "form.feed.label.max_entries" }}</label>
+        <input type="number" name="max_entries" id="form-max-entries" value="{{ .form.MaxEntries }}" min="0">
+        <p class="form-help">{{ t "form.feed.help.max_entries" }}</p>
+
+        <label for="form-overflow-policy">{{ t "form.feed.label.overflow_policy" }}</label>
+        <select id="form-overflow-policy" name="overflow_policy">
+        {{ range $key, $value := .overflowPolicies }}
+            <option value="{{ $key }}" {{ if eq $key $.form.OverflowPolicy }}selected="selected"{{ end }}>{{ t $value }}</option>
+        {{ end }}
+        </select>
+
+        <label for="form-entry-matching">{{ t "form.feed.label.entry_matching" }}</label>
+        <select id="form-entry-matching" name="entry_matching">
+        {{ range $key, $value := .entryMatchings }}
+            <option value="{{ $key }}" {{ if eq $key $.form.EntryMatching }}selected="selected"{{ end }}>{{ t $value }}</option>
+        {{ end }}
+        </select>
+        <p class="form-help">{{ t "form.feed.help.entry_matching" }}</p>
+
         <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+        <label><input type="checkbox" name="nsfw" value="1" {{ if .form.NSFW }}checked{{ end }}> {{ t "form.feed.label.nsfw" }}</label>
+        {{ if hasSummarizer }}
+            <label><input type="checkbox" name="summarize_entries" value="1" {{ if .form.Summarize }}checked{{ end }}> {{ t "form.feed.label.summarize_entries" }}</label>
+        {{ end }}
 
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
@@ -492,7 +730,7 @@ var templateViewsMap = map[string]string{
 
     <div class="panel">
         <ul>
-            <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ elapsed $.user.Timezone .feed.CheckedAt }}</time></li>
+            <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ timestamp $.user .feed.CheckedAt }}</time></li>
             <li><strong>{{ t "page.edit_feed.etag_header" }} </strong>{{ if .feed.EtagHeader }}{{ .feed.EtagHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
             <li><strong>{{ t "page.edit_feed.last_modified_header" }} </strong>{{ if .feed.LastModifiedHeader }}{{ .feed.LastModifiedHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
         </ul>
@@ -604,6 +842,17 @@ var templateViewsMap = map[string]string{
                             >{{ t "entry.save.title" }}</a>
                     </li>
                 {{ end }}
+                {{ if .hasKindle }}
+                    <li>
+                        <a href="#"
+                            title="{{ t "entry.kindle.title" }}"
+                            data-send-to-kindle="true"
+                            data-save-url="{{ route "sendToKindle" "entryID" .entry.ID }}"
+                            data-label-loading="{{ t "entry.state.sending" }}"
+                            data-label-done="{{ t "entry.kindle.completed" }}"
+                            >{{ t "entry.kindle.label" }}</a>
+                    </li>
+                {{ end }}
                 <li>
                     <a href="#"
                         title="{{ t "entry.scraper.title" }}"
@@ -613,6 +862,22 @@ var templateViewsMap = map[string]string{
                         data-label-done="{{ t "entry.scraper.completed" }}"
                         >{{ t "entry.scraper.label" }}</a>
                 </li>
+                {{ if hasSummarizer }}
+                    <li>
+                        <a href="#"
+                            title="{{ t "entry.summarize.title" }}"
+                            data-summarize-entry="true"
+                            data-summarize-url="{{ route "summarizeEntry" "entryID" .entry.ID }}"
+                            data-label-loading="{{ t "entry.state.loading" }}"
+                            data-label-done="{{ t "entry.summarize.completed" }}"
+                            >{{ t "entry.summarize.label" }}</a>
+                    </li>
+                {{ end }}
+                {{ if .entry.Starred }}
+                    <li>
+                        <a href="{{ route "entrySnapshot" "entryID" .entry.ID }}" title="{{ t "entry.snapshot.title" }}">{{ t "entry.snapshot.label" }}</a>
+                    </li>
+                {{ end }}
                 {{ if .entry.CommentsURL }}
                     <li>
                         <a href="{{ .entry.CommentsURL }}" title="{{ t "entry.comments.title" }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ t "entry.comments.label" }}</a>
@@ -641,7 +906,7 @@ var templateViewsMap = map[string]string{
             </span>
         </div>
         <div class="entry-date">
-            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed $.user.Timezone .entry.Date }}</time>
+            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ timestamp $.user .entry.Date }}</time>
         </div>
     </header>
     {{ if gt (len .entry.Content) 120 }}
@@ -649,8 +914,9 @@ var templateViewsMap = map[string]string{
         {{ template "entry_pagination" . }}
     </div>
     {{ end }}
-    <article class="entry-content">
-        {{ noescape (proxyFilter .entry.Content) }}
+    <p class="entry-summary"{{ if not .entry.Summary }} hidden{{ end }}>{{ .entry.Summary }}</p>
+    <article class="entry-content{{ if .user.BlurNSFW .entry }} entry-nsfw{{ end }}">
+        {{ noescape (imageFilter .user .entry.Feed (proxyFilter .entry.Content)) }}
     </article>
     {{ if .entry.Enclosures }}
     <aside class="entry-enclosures">
@@ -689,6 +955,41 @@ var templateViewsMap = map[string]string{
     {{ template "entry_pagination" . }}
 </div>
 {{ end }}
+`,
+	"entry_snapshot": `{{ define "title"}}{{ .entry.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="entry" data-id="{{ .entry.ID }}">
+    <header class="entry-header">
+        <h1>
+            <a href="{{ route "starredEntry" "entryID" .entry.ID }}">{{ .entry.Title }}</a>
+        </h1>
+        <div class="entry-meta">
+            {{ if and .snapshot .snapshot.Size }}
+                {{ t "page.entry_snapshot.taken" }}
+                <time datetime="{{ isodate .snapshot.CreatedAt }}" title="{{ isodate .snapshot.CreatedAt }}">{{ timestamp $.user .snapshot.CreatedAt }}</time>
+                – <a href="{{ .snapshot.URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ domain .snapshot.URL }}</a>
+            {{ end }}
+        </div>
+        <form method="post" action="{{ route "refreshEntrySnapshot" "entryID" .entry.ID }}">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <div class="buttons">
+                <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "page.entry_snapshot.refresh" }}</button>
+            </div>
+        </form>
+    </header>
+    {{ if and .snapshot .snapshot.Error }}
+        <p class="alert alert-error">{{ t "page.entry_snapshot.last_error" }} {{ .snapshot.Error }}</p>
+    {{ end }}
+    {{ if and .snapshot .snapshot.Size }}
+    <article class="entry-content">
+        {{ noescape (proxyFilter .snapshot.Content) }}
+    </article>
+    {{ else }}
+        <p class="alert">{{ t "page.entry_snapshot.empty" }}</p>
+    {{ end }}
+</section>
+{{ end }}
 `,
 	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
 
@@ -707,7 +1008,7 @@ var templateViewsMap = map[string]string{
         </li>
         {{ else }}
         <li>
-            <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
+            <a href="{{ route "feedEntries" "feedID" .feed.ID }}{{ if .user.ShowReadEntries }}?status=unread{{ end }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
         {{ end }}
         <li>
@@ -742,15 +1043,20 @@ var templateViewsMap = map[string]string{
         <p class="alert">{{ t "alert.no_feed_entry" }}</p>
     {{ end }}
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -790,12 +1096,21 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "refreshAllFeeds" }}">{{ t "menu.refresh_all_feeds" }}</a>
         </li>
+        <li>
+            <a href="{{ route "leastReadFeeds" }}">{{ t "menu.least_read_feeds" }}</a>
+        </li>
+        {{ if and .user.IsAdmin .hasSubscriptionApproval }}
+        <li>
+            <a href="{{ route "pendingFeeds" }}">{{ t "menu.pending_feeds" }}</a>
+        </li>
+        {{ end }}
     </ul>
 </section>
 
 {{ if not .feeds }}
     <p class="alert">{{ t "alert.no_feed" }}</p>
 {{ else }}
+    {{ template "bulk_feeds" dict "csrf" .csrf "categories" .categories }}
     <div class="items">
         {{ range .feeds }}
         <article class="item {{ if ne .ParsingErrorCount 0 }}feed-parsing-error{{ end }}">
@@ -812,12 +1127,25 @@ var templateViewsMap = map[string]string{
             </div>
             <div class="item-meta">
                 <ul>
+                    <li>
+                        <input type="checkbox" name="feed_id" value="{{ .ID }}" form="bulk-feeds" aria-label="{{ t "form.bulk.select_feed" }}">
+                    </li>
                     <li>
                         <a href="{{ .SiteURL }}" title="{{ .SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ domain .SiteURL }}</a>
                     </li>
                     <li>
-                        {{ t "page.feeds.last_check" }} <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
+                        {{ t "page.feeds.last_check" }} <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ timestamp $.user .CheckedAt }}</time>
+                    </li>
+                    {{ if .Pending }}
+                    <li>
+                        <strong>{{ t "page.feeds.pending" }}</strong>
+                    </li>
+                    {{ end }}
+                    {{ if .IsMuted }}
+                    <li>
+                        {{ t "page.feeds.muted_until" }} <time datetime="{{ isodate .MutedUntil }}" title="{{ isodate .MutedUntil }}">{{ timestamp $.user .MutedUntil }}</time>
                     </li>
+                    {{ end }}
                 </ul>
                 <ul>
                     <li>
@@ -848,6 +1176,28 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"forgot_password": `{{ define "title"}}{{ t "page.forgot_password.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "submitForgotPassword" }}" method="post">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-email">{{ t "form.user.label.email" }}</label>
+        <input type="email" name="email" id="form-email" required autofocus>
+        <p class="form-help">{{ t "form.password_reset.help.email" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.send_reset_link" }}</button> {{ t "action.or" }} <a href="{{ route "login" }}">{{ t "action.cancel" }}</a>
+        </div>
+    </form>
+</section>
 {{ end }}
 `,
 	"history_entries": `{{ define "title"}}{{ t "page.history.title" }} ({{ .total }}){{ end }}
@@ -855,27 +1205,38 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.history.title" }} ({{ .total }})</h1>
-    {{ if .entries }}
     <ul>
+        <li>
+            <a href="{{ route "recentlyRead" }}">{{ t "menu.recently_read" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "junkEntries" }}">{{ t "menu.junk" }}</a>
+        </li>
+        {{ if .entries }}
         <li>
             <a href="{{ route "flushHistory" }}">{{ t "menu.flush_history" }}</a>
         </li>
+        {{ end }}
     </ul>
-    {{ end }}
 </section>
 
 {{ if not .entries }}
     <p class="alert alert-info">{{ t "alert.no_history" }}</p>
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -921,6 +1282,74 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 
+{{ end }}
+`,
+	"instance_stats": `{{ define "title"}}{{ t "page.statistics.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.statistics.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "about" }}">{{ t "menu.about" }}</a>
+        </li>
+    </ul>
+</section>
+
+<div class="panel">
+    <ul>
+        <li><strong>{{ t "page.statistics.users" }}</strong>: {{ .stats.Users }}</li>
+        <li><strong>{{ t "page.statistics.feeds" }}</strong>: {{ .stats.Feeds }}</li>
+        <li><strong>{{ t "page.statistics.entries" }}</strong>: {{ .stats.Entries }}</li>
+        <li><strong>{{ t "page.statistics.database_size" }}</strong>: {{ fileSize .stats.DatabaseSize }}</li>
+        <li><strong>{{ t "page.statistics.fetches_last_hour" }}</strong>: {{ .stats.FetchesLastHour }}</li>
+        <li><strong>{{ t "page.statistics.fetches_last_day" }}</strong>: {{ .stats.FetchesLastDay }}</li>
+        <li><strong>{{ t "page.statistics.fetch_success_rate" }}</strong>: {{ .fetchSuccessRate }}%</li>
+    </ul>
+</div>
+
+<h3>{{ t "page.statistics.shared_feeds" }} ({{ .stats.SharedFeedURLs }})</h3>
+{{ if not .stats.SharedFeeds }}
+    <p class="alert">{{ t "alert.no_shared_feed" }}</p>
+{{ else }}
+    <table>
+        <tr>
+            <th>{{ t "page.statistics.feed_url" }}</th>
+            <th>{{ t "page.statistics.subscribers" }}</th>
+        </tr>
+        {{ range .stats.SharedFeeds }}
+        <tr>
+            <td>{{ .FeedURL }}</td>
+            <td>{{ .Subscribers }}</td>
+        </tr>
+        {{ end }}
+    </table>
+{{ end }}
+
+<h3>{{ t "page.statistics.error_domains" }}</h3>
+{{ if not .stats.ErrorDomains }}
+    <p class="alert alert-success">{{ t "alert.no_error_domain" }}</p>
+{{ else }}
+    <table>
+        <tr>
+            <th>{{ t "page.statistics.domain" }}</th>
+            <th>{{ t "page.statistics.error_feeds" }}</th>
+        </tr>
+        {{ range .stats.ErrorDomains }}
+        <tr>
+            <td>{{ .Domain }}</td>
+            <td>{{ .ErrorFeeds }} / {{ .Feeds }}</td>
+        </tr>
+        {{ end }}
+    </table>
+{{ end }}
+
 {{ end }}
 `,
 	"integrations": `{{ define "title"}}{{ t "page.integrations.title" }}{{ end }}
@@ -983,6 +1412,8 @@ var templateViewsMap = map[string]string{
         <label>
             <input type="checkbox" name="pinboard_mark_as_unread" value="1" {{ if .form.PinboardMarkAsUnread }}checked{{ end }}> {{ t "form.integration.pinboard_bookmark" }}
         </label>
+
+        {{ template "integration_events" dict "name" "pinboard" "filter" (.form.Filters.Filter "pinboard") "categories" .categories }}
     </div>
 
     <h3>Instapaper</h3>
@@ -996,6 +1427,8 @@ var templateViewsMap = map[string]string{
 
         <label for="form-instapaper-password">{{ t "form.integration.instapaper_password" }}</label>
         <input type="password" name="instapaper_password" id="form-instapaper-password" value="{{ .form.InstapaperPassword }}" autocomplete="new-password">
+
+        {{ template "integration_events" dict "name" "instapaper" "filter" (.form.Filters.Filter "instapaper") "categories" .categories }}
     </div>
 
     <h3>Pocket</h3>
@@ -1015,6 +1448,8 @@ var templateViewsMap = map[string]string{
         {{ if not .form.PocketAccessToken }}
             <p><a href="{{ route "pocketAuthorize" }}">{{ t "form.integration.pocket_connect_link" }}</a></p>
         {{ end }}
+
+        {{ template "integration_events" dict "name" "pocket" "filter" (.form.Filters.Filter "pocket") "categories" .categories }}
     </div>
 
     <h3>Wallabag</h3>
@@ -1037,6 +1472,8 @@ var templateViewsMap = map[string]string{
 
         <label for="form-wallabag-password">{{ t "form.integration.wallabag_password" }}</label>
         <input type="password" name="wallabag_password" id="form-wallabag-password" value="{{ .form.WallabagPassword }}" autocomplete="new-password">
+
+        {{ template "integration_events" dict "name" "wallabag" "filter" (.form.Filters.Filter "wallabag") "categories" .categories }}
     </div>
 
     <h3>Nunux Keeper</h3>
@@ -1050,16 +1487,113 @@ var templateViewsMap = map[string]string{
 
         <label for="form-nunux-keeper-api-key">{{ t "form.integration.nunux_keeper_api_key" }}</label>
         <input type="text" name="nunux_keeper_api_key" id="form-nunux-keeper-api-key" value="{{ .form.NunuxKeeperAPIKey }}">
-    </div>
 
-    <div class="buttons">
-        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        {{ template "integration_events" dict "name" "nunux_keeper" "filter" (.form.Filters.Filter "nunux_keeper") "categories" .categories }}
     </div>
-</form>
 
-<h3>{{ t "page.integration.miniflux_api" }}</h3>
-<div class="panel">
-    <ul>
+    <h3>Readeck</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="readeck_enabled" value="1" {{ if .form.ReadeckEnabled }}checked{{ end }}> {{ t "form.integration.readeck_activate" }}
+        </label>
+
+        <label for="form-readeck-url">{{ t "form.integration.readeck_endpoint" }}</label>
+        <input type="url" name="readeck_url" id="form-readeck-url" value="{{ .form.ReadeckURL }}" placeholder="https://readeck.example.org">
+
+        <label for="form-readeck-api-key">{{ t "form.integration.readeck_api_key" }}</label>
+        <input type="password" name="readeck_api_key" id="form-readeck-api-key" value="{{ .form.ReadeckAPIKey }}" autocomplete="new-password">
+
+        <label for="form-readeck-labels">{{ t "form.integration.readeck_labels" }}</label>
+        <input type="text" name="readeck_labels" id="form-readeck-labels" value="{{ .form.ReadeckLabels }}">
+
+        {{ template "integration_events" dict "name" "readeck" "filter" (.form.Filters.Filter "readeck") "categories" .categories }}
+    </div>
+
+    <h3>Omnivore</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="omnivore_enabled" value="1" {{ if .form.OmnivoreEnabled }}checked{{ end }}> {{ t "form.integration.omnivore_activate" }}
+        </label>
+
+        <label for="form-omnivore-url">{{ t "form.integration.omnivore_endpoint" }}</label>
+        <input type="url" name="omnivore_url" id="form-omnivore-url" value="{{ .form.OmnivoreURL }}" placeholder="https://api-prod.omnivore.app/api/graphql">
+
+        <label for="form-omnivore-api-key">{{ t "form.integration.omnivore_api_key" }}</label>
+        <input type="password" name="omnivore_api_key" id="form-omnivore-api-key" value="{{ .form.OmnivoreAPIKey }}" autocomplete="new-password">
+
+        {{ template "integration_events" dict "name" "omnivore" "filter" (.form.Filters.Filter "omnivore") "categories" .categories }}
+    </div>
+
+    <h3>ntfy</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="ntfy_enabled" value="1" {{ if .form.NtfyEnabled }}checked{{ end }}> {{ t "form.integration.ntfy_activate" }}
+        </label>
+
+        <label for="form-ntfy-url">{{ t "form.integration.ntfy_url" }}</label>
+        <input type="url" name="ntfy_url" id="form-ntfy-url" value="{{ .form.NtfyURL }}" placeholder="https://ntfy.sh">
+
+        <label for="form-ntfy-topic">{{ t "form.integration.ntfy_topic" }}</label>
+        <input type="text" name="ntfy_topic" id="form-ntfy-topic" value="{{ .form.NtfyTopic }}">
+
+        <label for="form-ntfy-token">{{ t "form.integration.ntfy_token" }}</label>
+        <input type="password" name="ntfy_token" id="form-ntfy-token" value="{{ .form.NtfyToken }}" autocomplete="new-password">
+
+        {{ template "integration_events" dict "name" "ntfy" "filter" (.form.Filters.Filter "ntfy") "categories" .categories "notification" true }}
+    </div>
+
+    <h3>Pushover</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="pushover_enabled" value="1" {{ if .form.PushoverEnabled }}checked{{ end }}> {{ t "form.integration.pushover_activate" }}
+        </label>
+
+        <label for="form-pushover-token">{{ t "form.integration.pushover_token" }}</label>
+        <input type="password" name="pushover_token" id="form-pushover-token" value="{{ .form.PushoverToken }}" autocomplete="new-password">
+
+        <label for="form-pushover-user">{{ t "form.integration.pushover_user" }}</label>
+        <input type="text" name="pushover_user" id="form-pushover-user" value="{{ .form.PushoverUser }}">
+
+        {{ template "integration_events" dict "name" "pushover" "filter" (.form.Filters.Filter "pushover") "categories" .categories "notification" true }}
+    </div>
+
+    <h3>Apprise</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="apprise_enabled" value="1" {{ if .form.AppriseEnabled }}checked{{ end }}> {{ t "form.integration.apprise_activate" }}
+        </label>
+
+        <label for="form-apprise-url">{{ t "form.integration.apprise_url" }}</label>
+        <input type="url" name="apprise_url" id="form-apprise-url" value="{{ .form.AppriseURL }}" placeholder="http://apprise:8000/notify/apprise">
+
+        <label for="form-apprise-service-urls">{{ t "form.integration.apprise_service_urls" }}</label>
+        <input type="text" name="apprise_service_urls" id="form-apprise-service-urls" value="{{ .form.AppriseServiceURLs }}">
+
+        {{ template "integration_events" dict "name" "apprise" "filter" (.form.Filters.Filter "apprise") "categories" .categories "notification" true }}
+    </div>
+
+    {{ if .hasMailer }}
+    <h3>Kindle</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="kindle_enabled" value="1" {{ if .form.KindleEnabled }}checked{{ end }}> {{ t "form.integration.kindle_activate" }}
+        </label>
+
+        <label for="form-kindle-email">{{ t "form.integration.kindle_email" }}</label>
+        <input type="email" name="kindle_email" id="form-kindle-email" value="{{ .form.KindleEmail }}" placeholder="name@kindle.com">
+
+        <p class="form-help">{{ t "form.integration.kindle_help" .mailFrom }}</p>
+    </div>
+    {{ end }}
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+    </div>
+</form>
+
+<h3>{{ t "page.integration.miniflux_api" }}</h3>
+<div class="panel">
+    <ul>
         <li>
             {{ t "page.integration.miniflux_api_endpoint" }} = <strong>{{ baseURL }}/v1/</strong>
         </li>
@@ -1072,6 +1606,25 @@ var templateViewsMap = map[string]string{
     </ul>
 </div>
 
+<h3>{{ t "page.integration.browser_extension" }}</h3>
+<div class="panel">
+    <ul>
+        <li>
+            {{ t "page.integration.browser_extension_endpoint" }} = <strong>{{ rootURL }}{{ route "extensionEndpoint" }}</strong>
+        </li>
+        <li>
+            {{ t "page.integration.browser_extension_token" }} = <strong>{{ if hasKey .user.Extra "extension_token" }}{{ index .user.Extra "extension_token" }}{{ else }}{{ t "page.integration.browser_extension_no_token" }}{{ end }}</strong>
+        </li>
+    </ul>
+
+    <form method="post" action="{{ route "generateExtensionToken" }}">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+        <div class="buttons">
+            <button type="submit" class="button button-primary">{{ t "page.integration.browser_extension_generate_token" }}</button>
+        </div>
+    </form>
+</div>
+
 <h3>{{ t "page.integration.bookmarklet" }}</h3>
 <div class="panel">
     <p>{{ t "page.integration.bookmarklet.help" }}</p>
@@ -1083,6 +1636,200 @@ var templateViewsMap = map[string]string{
     <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
 </div>
 
+{{ end }}
+`,
+	"invitations": `{{ define "title"}}{{ t "page.invitations.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.invitations.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "about" }}">{{ t "menu.about" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "createInvitation" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    <label for="form-email">{{ t "form.user.label.email" }}</label>
+    <input type="email" name="email" id="form-email">
+    <p class="form-help">{{ t "form.invitation.help.email" }}</p>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.invite" }}</button>
+    </div>
+</form>
+
+{{ if not .invitations }}
+    <p class="alert">{{ t "alert.no_invitation" }}</p>
+{{ else }}
+    <table>
+        <tr>
+            <th>{{ t "form.user.label.email" }}</th>
+            <th>{{ t "page.invitations.expires" }}</th>
+            <th>{{ t "page.invitations.status" }}</th>
+            <th>{{ t "page.users.actions" }}</th>
+        </tr>
+        {{ range .invitations }}
+        <tr>
+            <td>{{ if .Email }}{{ .Email }}{{ else }}-{{ end }}</td>
+            <td><time datetime="{{ isodate .ExpiresAt }}" title="{{ isodate .ExpiresAt }}">{{ timestamp $.user .ExpiresAt }}</time></td>
+            <td>{{ if .UsedAt }}{{ t "page.invitations.used" }}{{ else }}{{ t "page.invitations.unused" }}{{ end }}</td>
+            <td>
+                <a href="#"
+                    data-confirm="true"
+                    data-label-question="{{ t "confirm.question" }}"
+                    data-label-yes="{{ t "confirm.yes" }}"
+                    data-label-no="{{ t "confirm.no" }}"
+                    data-label-loading="{{ t "confirm.loading" }}"
+                    data-url="{{ route "removeInvitation" "invitationID" .ID }}">{{ t "action.remove" }}</a>
+            </td>
+        </tr>
+        {{ end }}
+    </table>
+{{ end }}
+
+{{ end }}
+`,
+	"junk_entries": `{{ define "title"}}{{ t "page.junk.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.junk.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "history" }}">{{ t "menu.history" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "settings" }}">{{ t "menu.settings" }}</a>
+        </li>
+    </ul>
+</section>
+
+<p class="alert">{{ t "page.junk.help" .junkScore }}</p>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_junk" }}</p>
+{{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            <div class="item-meta">
+                {{ t "page.junk.score" .JunkScore }}
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry  }}
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ end }}
+`,
+	"least_read_feeds": `{{ define "title"}}{{ t "page.least_read_feeds.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.least_read_feeds.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "feeds" }}">{{ t "menu.feeds" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form method="get" action="{{ route "leastReadFeeds" }}">
+    <label for="form-months">{{ t "form.least_read_feeds.label.months" }}</label>
+    <select id="form-months" name="months">
+    {{ range .periods }}
+        <option value="{{ . }}" {{ if eq . $.months }}selected="selected"{{ end }}>{{ plural "form.least_read_feeds.months" . . }}</option>
+    {{ end }}
+    </select>
+    <div class="buttons">
+        <button type="submit" class="button button-primary">{{ t "action.show" }}</button>
+    </div>
+</form>
+
+{{ if not .feeds }}
+    <p class="alert">{{ t "alert.no_least_read_feed" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .feeds }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if .Feed.Icon }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "feedEntries" "feedID" .Feed.ID }}">{{ .Feed.Title }}</a>
+                </span>
+                <span class="category">
+                    <a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li>
+                        {{ if .LastReadAt }}
+                            {{ t "page.least_read_feeds.last_read" }} <time datetime="{{ isodate .LastReadAt }}" title="{{ isodate .LastReadAt }}">{{ timestamp $.user .LastReadAt }}</time>
+                        {{ else }}
+                            {{ t "page.least_read_feeds.never_read" }}
+                        {{ end }}
+                    </li>
+                    <li>
+                        {{ plural "page.least_read_feeds.unread_count" .UnreadCount .UnreadCount }}
+                    </li>
+                    {{ if .Feed.IsMuted }}
+                    <li>
+                        {{ t "page.feeds.muted_until" }} <time datetime="{{ isodate .Feed.MutedUntil }}" title="{{ isodate .Feed.MutedUntil }}">{{ timestamp $.user .Feed.MutedUntil }}</time>
+                    </li>
+                    {{ end }}
+                </ul>
+                <ul>
+                    {{ if not .Feed.IsMuted }}
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "muteFeed" "feedID" .Feed.ID }}">{{ t "action.mute_for_a_month" }}</a>
+                    </li>
+                    {{ end }}
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeFeed" "feedID" .Feed.ID }}">{{ t "action.unsubscribe" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
@@ -1106,6 +1853,12 @@ var templateViewsMap = map[string]string{
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.login" }}</button>
         </div>
     </form>
+    {{ if hasMailer }}
+    <p><a href="{{ route "forgotPassword" }}">{{ t "page.login.forgot_password" }}</a></p>
+    {{ end }}
+    {{ if hasSignup }}
+    <p>{{ t "page.login.no_account" }} <a href="{{ route "signup" }}">{{ t "action.signup" }}</a></p>
+    {{ end }}
     {{ if hasOAuth2Provider "google" }}
     <div class="oauth2">
         <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
@@ -1113,6 +1866,142 @@ var templateViewsMap = map[string]string{
     {{ end }}
 </section>
 {{ end }}
+`,
+	"pending_feeds": `{{ define "title"}}{{ t "page.pending_feeds.title" }} ({{ len .pendingFeeds }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.pending_feeds.title" }} ({{ len .pendingFeeds }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "feeds" }}">{{ t "menu.feeds" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .pendingFeeds }}
+    <p class="alert">{{ t "alert.no_pending_feed" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .pendingFeeds }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">{{ .Feed.Title }}</span>
+                <span class="category">{{ .Feed.Category.Title }}</span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li>
+                        {{ t "page.pending_feeds.requested_by" .Username }}
+                    </li>
+                    <li>
+                        <a href="{{ .Feed.FeedURL }}" title="{{ .Feed.FeedURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Feed.FeedURL }}</a>
+                    </li>
+                    <li>
+                        <a href="{{ .Feed.SiteURL }}" title="{{ .Feed.SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ domain .Feed.SiteURL }}</a>
+                    </li>
+                </ul>
+                <ul>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "approveFeed" "feedID" .Feed.ID }}"
+                            data-redirect-url="{{ route "pendingFeeds" }}">{{ t "action.approve" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "rejectFeed" "feedID" .Feed.ID }}"
+                            data-redirect-url="{{ route "pendingFeeds" }}">{{ t "action.reject" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ end }}
+`,
+	"recently_read": `{{ define "title"}}{{ t "page.recently_read.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.recently_read.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "history" }}">{{ t "menu.history" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_history" }}</p>
+{{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ if .ReadAt }}
+            <div class="item-meta">
+                {{ t "page.recently_read.read_at" }} <time datetime="{{ isodate .ReadAt }}" title="{{ isodate .ReadAt }}">{{ timestamp $.user .ReadAt }}</time>
+            </div>
+            {{ end }}
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry  }}
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ end }}
+`,
+	"reset_password": `{{ define "title"}}{{ t "page.reset_password.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "submitResetPassword" "token" .token }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-password">{{ t "form.user.label.password" }}</label>
+        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" autocomplete="new-password" required autofocus>
+
+        <label for="form-confirmation">{{ t "form.user.label.confirmation" }}</label>
+        <input type="password" name="confirmation" id="form-confirmation" value="{{ .form.Confirmation }}" required>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button>
+        </div>
+    </form>
+</section>
+{{ end }}
 `,
 	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
 
@@ -1124,15 +2013,20 @@ var templateViewsMap = map[string]string{
 {{ if not .entries }}
     <p class="alert alert-info">{{ t "alert.no_search_result" }}</p>
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "searchEntry" "entryID" .ID }}?q={{ $.searchQuery }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "searchEntry" "entryID" .ID }}?q={{ $.searchQuery }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -1180,7 +2074,7 @@ var templateViewsMap = map[string]string{
     </tr>
     {{ range .sessions }}
     <tr {{ if eq .Token $.currentSessionToken }}class="row-highlighted"{{ end }}>
-        <td class="column-20" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</td>
+        <td class="column-20" title="{{ isodate .CreatedAt }}">{{ timestamp $.user .CreatedAt }}</td>
         <td class="column-20" title="{{ .IP }}">{{ .IP }}</td>
         <td title="{{ .UserAgent }}">{{ .UserAgent }}</td>
         <td class="column-20">
@@ -1235,6 +2129,10 @@ var templateViewsMap = map[string]string{
     <label for="form-username">{{ t "form.user.label.username" }}</label>
     <input type="text" name="username" id="form-username" value="{{ .form.Username }}" required>
 
+    <label for="form-email">{{ t "form.user.label.email" }}</label>
+    <input type="email" name="email" id="form-email" value="{{ .form.Email }}">
+    <p class="form-help">{{ t "form.prefs.help.email" }}</p>
+
     <label for="form-password">{{ t "form.user.label.password" }}</label>
     <input type="password" name="password" id="form-password" value="{{ .form.Password }}" autocomplete="new-password">
 
@@ -1268,6 +2166,39 @@ var templateViewsMap = map[string]string{
         <option value="desc" {{ if eq "desc" $.form.EntryDirection }}selected="selected"{{ end }}>{{ t "form.prefs.select.recent_first" }}</option>
     </select>
 
+    <label for="form-entries-per-page">{{ t "form.prefs.label.entries_per_page" }}</label>
+    <input type="number" name="entries_per_page" id="form-entries-per-page" value="{{ .form.EntriesPerPage }}" min="1" max="1000" required>
+
+    <label><input type="checkbox" name="show_read_entries" value="1" {{ if .form.ShowReadEntries }}checked{{ end }}> {{ t "form.prefs.label.show_read_entries" }}</label>
+
+    <label><input type="checkbox" name="show_absolute_time" value="1" {{ if .form.ShowAbsoluteTime }}checked{{ end }}> {{ t "form.prefs.label.show_absolute_time" }}</label>
+
+    <label for="form-image-display">{{ t "form.prefs.label.image_display" }}</label>
+    <select id="form-image-display" name="image_display">
+    {{ range $key, $value := .imageDisplays }}
+        <option value="{{ $key }}" {{ if eq $key $.form.ImageDisplay }}selected="selected"{{ end }}>{{ t $value }}</option>
+    {{ end }}
+    </select>
+
+    <label for="form-nsfw-display">{{ t "form.prefs.label.nsfw_display" }}</label>
+    <select id="form-nsfw-display" name="nsfw_display">
+    {{ range $key, $value := .nsfwDisplays }}
+        <option value="{{ $key }}" {{ if eq $key $.form.NSFWDisplay }}selected="selected"{{ end }}>{{ t $value }}</option>
+    {{ end }}
+    </select>
+    <p class="form-help">{{ t "form.prefs.help.nsfw_display" }}</p>
+
+    <label for="form-junk-threshold">{{ t "form.prefs.label.junk_threshold" }}</label>
+    <input type="number" name="junk_threshold" id="form-junk-threshold" value="{{ .form.JunkThreshold }}" min="0" max="{{ .maxJunkScore }}">
+    <p class="form-help">{{ t "form.prefs.help.junk_threshold" }} <a href="{{ route "junkEntries" }}">{{ t "menu.junk" }}</a></p>
+
+    <label for="form-quiet-hours-start">{{ t "form.prefs.label.quiet_hours_start" }}</label>
+    <input type="time" name="quiet_hours_start" id="form-quiet-hours-start" value="{{ .form.QuietHoursStart }}">
+
+    <label for="form-quiet-hours-end">{{ t "form.prefs.label.quiet_hours_end" }}</label>
+    <input type="time" name="quiet_hours_end" id="form-quiet-hours-end" value="{{ .form.QuietHoursEnd }}">
+    <p class="form-help">{{ t "form.prefs.help.quiet_hours" }}</p>
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
     </div>
@@ -1283,6 +2214,92 @@ var templateViewsMap = map[string]string{
 </div>
 {{ end }}
 
+{{ if not .user.IsAdmin }}
+<div class="panel">
+    <a href="{{ route "deleteAccount" }}">{{ t "menu.delete_account" }}</a>
+</div>
+{{ end }}
+
+{{ end }}
+`,
+	"shared_category_entries": `{{ define "title"}}{{ .sharedCategory.Category.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .sharedCategory.Category.Title }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            {{ t "page.shared_category_entries.shared_by" .sharedCategory.OwnerUsername }}
+        </li>
+        <li>
+            <a href="{{ route "categories" }}">{{ t "menu.categories" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert">{{ t "alert.no_category_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item item-status-{{ .Status }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li>
+                        <span title="{{ .Feed.SiteURL }}">{{ truncate .Feed.Title 35 }}</span>
+                    </li>
+                    <li>
+                        <time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ timestamp $.user .Date }}</time>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"signup": `{{ define "title"}}{{ t "page.signup.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "submitSignup" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-username">{{ t "form.user.label.username" }}</label>
+        <input type="text" name="username" id="form-username" value="{{ .form.Username }}" autocomplete="new-password" required autofocus>
+
+        <label for="form-email">{{ t "form.user.label.email" }}</label>
+        <input type="email" name="email" id="form-email" value="{{ .form.Email }}" required>
+
+        <label for="form-password">{{ t "form.user.label.password" }}</label>
+        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" autocomplete="new-password" required>
+
+        <label for="form-confirmation">{{ t "form.user.label.confirmation" }}</label>
+        <input type="password" name="confirmation" id="form-confirmation" value="{{ .form.Confirmation }}" required>
+
+        <label for="form-invitation">{{ t "form.user.label.invitation" }}</label>
+        <input type="text" name="invitation" id="form-invitation" value="{{ .form.Invitation }}" {{ if .invitationOnly }}required{{ end }}>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.signup" }}</button> {{ t "action.or" }} <a href="{{ route "login" }}">{{ t "action.login" }}</a>
+        </div>
+    </form>
+</section>
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1290,8 +2307,9 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.unread.title" }} (<span class="unread-counter">{{ .countUnread }}</span>)</h1>
-    {{ if .entries }}
+    {{ if or .entries .canUndoMarkAsRead }}
     <ul>
+        {{ if .entries }}
         <li>
             <a href="#" data-on-click="markPageAsRead">{{ t "menu.mark_page_as_read" }}</a>
         </li>
@@ -1300,6 +2318,12 @@ var templateViewsMap = map[string]string{
                data-label-new-state="{{ t "menu.mark_all_as_read_wip" }}"
                href="{{ route "markAllAsRead" }}">{{ t "menu.mark_all_as_read" }}</a>
         </li>
+        {{ end }}
+        {{ if .canUndoMarkAsRead }}
+        <li>
+            <a href="{{ route "undoMarkAllAsRead" }}">{{ t "menu.undo_mark_all_as_read" }}</a>
+        </li>
+        {{ end }}
     </ul>
     {{ end }}
 </section>
@@ -1307,15 +2331,20 @@ var templateViewsMap = map[string]string{
 {{ if not .entries }}
     <p class="alert">{{ t "alert.no_unread_entry" }}</p>
 {{ else }}
+    {{ template "bulk_entries" dict "csrf" .csrf "redirect" .currentPath }}
     <div class="items hide-read-items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        <article class="item touch-item item-status-{{ .Status }}{{ if $.user.BlurNSFW . }} item-nsfw{{ end }}" data-id="{{ .ID }}">
             <div class="item-header">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
                         <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" alt="{{ .Feed.Title }}">
                     {{ end }}
-                    <a href="{{ route "unreadEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ if eq .Feed.EntryOpenMode "original" }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "unreadEntry" "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -1354,6 +2383,14 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "createUser" }}">{{ t "menu.add_user" }}</a>
         </li>
+        {{ if hasSignup }}
+        <li>
+            <a href="{{ route "invitations" }}">{{ t "menu.invitations" }}</a>
+        </li>
+        {{ end }}
+        <li>
+            <a href="{{ route "instanceStats" }}">{{ t "menu.statistics" }}</a>
+        </li>
         <li>
             <a href="{{ route "about" }}">{{ t "menu.about" }}</a>
         </li>
@@ -1373,16 +2410,30 @@ var templateViewsMap = map[string]string{
         {{ range .users }}
             {{ if ne .ID $.user.ID }}
             <tr>
-                <td>{{ .Username }}</td>
+                <td>
+                    {{ .Username }}
+                    {{ if .Email }}<br><small>{{ .Email }}</small>{{ end }}
+                    {{ if not .Verified }}<strong>{{ t "page.users.not_verified" }}</strong>{{ end }}
+                    {{ if .Pending }}<strong>{{ t "page.users.pending" }}</strong>{{ end }}
+                </td>
                 <td>{{ if eq .IsAdmin true }}{{ t "page.users.admin.yes" }}{{ else }}{{ t "page.users.admin.no" }}{{ end }}</td>
                 <td>
                     {{ if .LastLoginAt }}
-                        <time datetime="{{ isodate .LastLoginAt }}" title="{{ isodate .LastLoginAt }}">{{ elapsed $.user.Timezone .LastLoginAt }}</time>
+                        <time datetime="{{ isodate .LastLoginAt }}" title="{{ isodate .LastLoginAt }}">{{ timestamp $.user .LastLoginAt }}</time>
                     {{ else }}
                         {{ t "page.users.never_logged" }}
                     {{ end }}
                 </td>
                 <td>
+                    {{ if .Pending }}
+                    <a href="#"
+                        data-confirm="true"
+                        data-label-question="{{ t "confirm.question" }}"
+                        data-label-yes="{{ t "confirm.yes" }}"
+                        data-label-no="{{ t "confirm.no" }}"
+                        data-label-loading="{{ t "confirm.loading" }}"
+                        data-url="{{ route "approveUser" "userID" .ID }}">{{ t "action.approve" }}</a>,
+                    {{ end }}
                     <a href="{{ route "editUser" "userID" .ID }}">{{ t "action.edit" }}</a>,
                     <a href="#"
                         data-confirm="true"
@@ -1403,27 +2454,40 @@ var templateViewsMap = map[string]string{
 }
 
 var templateViewsMapChecksums = map[string]string{
-	"about":               "844e3313c33ae31a74b904f6ef5d60299773620d8450da6f760f9f317217c51e",
-	"add_subscription":    "a0f1d2bc02b6adc83dbeae593f74d9b936102cd6dd73302cdbec2137cafdcdd9",
-	"bookmark_entries":    "609f4b2342152fe495a219a32f17a4528b01807d61f53cee0cbebf728be73c42",
-	"categories":          "642ee3cddbd825ee6ab5a77caa0d371096b55de0f1bd4ae3055b8c8a70507d8d",
-	"category_entries":    "07ff798025f8527de5351a89fd5fc51973c1ea6c56710b4f703cbae183fbcbb6",
-	"choose_subscription": "33c04843d7c1b608d034e605e52681822fc6d79bc6b900c04915dd9ebae584e2",
-	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
-	"create_user":         "1e940be3afefc0a5c6273bbadcddc1e29811e9548e5227ac2adfe697ca5ce081",
-	"edit_category":       "daf073d2944a180ce5aaeb80b597eb69597a50dff55a9a1d6cf7938b48d768cb",
-	"edit_feed":           "ab30c31a4385a7b16c54baa78bdcb93a57181ed1c5018ce097d7eb50673bb995",
-	"edit_user":           "f4f99412ba771cfca2a2a42778b023b413c5494e9a287053ba8cf380c2865c5f",
-	"entry":               "2ea9fee1ae5513ef1abb5923221c2ef1212e26d3bb651da66069ce8a336cbb7c",
-	"feed_entries":        "ba6a764d2784797629103500cc099178f29856dcfc95e59f0d134c32951cd3a4",
-	"feeds":               "31acc253c547a6cce5710d72a6f6b3b396162ecd5e5af295b2cf47c1ff55bd06",
-	"history_entries":     "b65ca1d85615caa7c314a33f1cb997aa3477a79e66b9894b2fd387271ad467d2",
-	"import":              "8349e47a783bb40d8e9248b4771656e5f006185e11079e1c4680dd52633420ed",
-	"integrations":        "f85b4a48ab1fc13b8ca94bfbbc44bd5e8784f35b26a63ec32cbe82b96b45e008",
-	"login":               "f9e6714d34fdce82266c8b23b0ff449d05ba71e474d26f711da66f8c4fdc076a",
-	"search_entries":      "d71849a4f2b0573c7c76ad0ea941812009e9f022de60895987a781d3e6f08a01",
-	"sessions":            "1b3ec0970a4111b81f86d6ed187bb410f88972e2ede6723b9febcc4c7e5fc921",
-	"settings":            "bc04faf83dd977306825973375954600bd014619340188e1243fd9e2f5d5e1a9",
-	"unread_entries":      "880018cbc59ec09b23dd800c4010fadad944d7023e0d36a3872c09b5d4952799",
-	"users":               "4b56cc76fbcc424e7c870d0efca93bb44dbfcc2a08b685cf799c773fbb8dfb2f",
+	"about":                   "844e3313c33ae31a74b904f6ef5d60299773620d8450da6f760f9f317217c51e",
+	"add_subscription":        "24a05bbc4e836d51b4108c49f8f74c3fef4f85cc8be4ee6c0568476217b0b0f2",
+	"bookmark_entries":        "74601202c10fccdb8ae37fb68c789dc45a9e48896169f3505496d629bbccede7",
+	"categories":              "882cf7818565e369ab3dfdd58267c5e44328c5248cfda0def0667600af59b6b0",
+	"category_entries":        "bb99141a1b56e8e00afb130cee868a2266983e9f319ed34844d626e488b42d1d",
+	"category_shares":         "ea39afd845a2f105c5158cdc368d3c11795230f19568c220db2eeb9c43ecbe9a",
+	"choose_subscription":     "33c04843d7c1b608d034e605e52681822fc6d79bc6b900c04915dd9ebae584e2",
+	"create_category":         "487be5a99c5f846052ca14b30efea058c681c651f824a5ac1651f418e5f5c399",
+	"create_user":             "1e940be3afefc0a5c6273bbadcddc1e29811e9548e5227ac2adfe697ca5ce081",
+	"delete_account":          "ff6019c9608c4376e2f19859293a7e7598a956ec87650e871ba425c99ca5851c",
+	"edit_category":           "e00e89fe00f38d746f5344c088407091788dd501c012eb516496690bad655cc9",
+	"edit_feed":               "5ba1c6a1aa60edd1ede577174be37b7dc8d1e3645ea735e83d0c75db5fcf962b",
+	"edit_user":               "f4f99412ba771cfca2a2a42778b023b413c5494e9a287053ba8cf380c2865c5f",
+	"entry":                   "d6ba7885bfce7213d31095f94a3dfbf74b7c9e2e4c2636e56aa9ccb4dec1d143",
+	"entry_snapshot":          "0a8c84667b9b0bf2aa62a0df387dbe827a460b535aaf09dcfbc07bdc2090a066",
+	"feed_entries":            "96c64005d421f285dc6df79660b0aa4a6cfd642b3a0bda794436d0bf20998dc4",
+	"feeds":                   "5b7c4ce00246b11b3b0482c2de9700224aabe72464dd22af0df46aba29f740e7",
+	"forgot_password":         "cf37c067255be3b276f645802479bfab4787780ad3b0962ccea367f404ea63e8",
+	"history_entries":         "0b27b8e4f4d4ee071440b598a80c6fb13a1b658c600d70f66bc6a2c50fefbdef",
+	"import":                  "8349e47a783bb40d8e9248b4771656e5f006185e11079e1c4680dd52633420ed",
+	"instance_stats":          "e10e0dfd4b3d45d3fb17e543d94d9fcb8824e89b298de8876d11bdafb4b1f780",
+	"integrations":            "336458d07dde0b081c85a66447ed7168f2c733ea934806ef15059a2b4d6b187c",
+	"invitations":             "7a603070193f9f1d878b79ceb9a8b3af4d7f50393025340af7c19209773375fc",
+	"junk_entries":            "ede92a011e805cc475c22af0a3911eb983a71a72ba44d952889dbe27cd73da1f",
+	"least_read_feeds":        "e3fcc9124292c659342bb0727142855f40ec30de5ddec8599519f57c12de0e10",
+	"login":                   "75dfea930f391b9ba3d42e5e22944300904f5bdd909a5a091ee1d8652aac1459",
+	"pending_feeds":           "7455ab620822aa082730460010102f70913abb08be10ec43a9b2d6e9b8c09f3e",
+	"recently_read":           "44d397336a0f6621273d6176ef9c68db24f5a68f2e53b9ff0e8f5ea2351218bb",
+	"reset_password":          "9bfd8984b2f6497b65eb2c987ac68f46be04fa6d208083f8f889065f308b0eac",
+	"search_entries":          "67e9a71ef6b1f88993f4154f96a68a5bae46dc6853a1573ba457a1ef78e18df4",
+	"sessions":                "1c08110b2a306cdab559449285989a5432caa3651214e8c165399fd344d4300d",
+	"settings":                "198317578f94e09b9de52a7c5a479a9679a3617650e3a6f38ebb4e28b2561775",
+	"shared_category_entries": "404ca61e0f14974c25e2af4775087c258e93d45438405a7cedcc54838e8f2056",
+	"signup":                  "df813d56d0aa2c68d2c70bfc6bc62ee0ae2afcae6e13c7a700bd50674305f6ca",
+	"unread_entries":          "570730ba9c351db541d36e9c32dfc4ac6250cb082ac347ade5ad8623f9a56519",
+	"users":                   "95f2c5a6e44692552ff4b59d4a876a7853a970e958ccd0e0a843f54cc355c8c5",
 }
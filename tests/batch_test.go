@@ -0,0 +1,66 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestBatch(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	responses, err := client.Batch([]*miniflux.BatchRequest{
+		{Method: "GET", Path: fmt.Sprintf("/v1/feeds/%d", feed.ID)},
+		{Method: "PUT", Path: fmt.Sprintf("/v1/feeds/%d", feed.ID), Body: map[string]string{"title": "Batched title"}},
+		{Method: "GET", Path: "/v1/feeds/999999999"},
+		{Method: "GET", Path: fmt.Sprintf("/v1/feeds/%d", feed.ID)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(responses) != 4 {
+		t.Fatalf(`Unexpected number of responses, got %d instead of 4`, len(responses))
+	}
+
+	expectedStatuses := []int{200, 201, 404, 200}
+	for i, response := range responses {
+		if response.Status != expectedStatuses[i] {
+			t.Errorf(`Unexpected status for the request #%d, got %d instead of %d`, i, response.Status, expectedStatuses[i])
+		}
+	}
+
+	// The requests are executed in order.
+	var updatedFeed miniflux.Feed
+	if err := json.Unmarshal(responses[3].Body, &updatedFeed); err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Title != "Batched title" {
+		t.Fatalf(`The update should be visible to the next request, got %q`, updatedFeed.Title)
+	}
+}
+
+func TestBatchCannotBeNested(t *testing.T) {
+	client := createClient(t)
+
+	responses, err := client.Batch([]*miniflux.BatchRequest{
+		{Method: "POST", Path: "/v1/batch", Body: []interface{}{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if responses[0].Status != 400 {
+		t.Fatalf(`A nested batch should be rejected, got %d`, responses[0].Status)
+	}
+}
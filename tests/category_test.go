@@ -8,6 +8,8 @@ package tests
 
 import (
 	"testing"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestCreateCategory(t *testing.T) {
@@ -54,6 +56,101 @@ func TestCannotCreateDuplicatedCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateCategoryMarkReadAfterDays(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Deals")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	days := 2
+	category, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{MarkReadAfterDays: &days})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if category.MarkReadAfterDays != days {
+		t.Fatalf(`Invalid number of days, got %d instead of %d`, category.MarkReadAfterDays, days)
+	}
+
+	if category.Title != "Deals" {
+		t.Fatalf(`The title should be kept, got %q`, category.Title)
+	}
+
+	days = -1
+	_, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{MarkReadAfterDays: &days})
+	if err == nil {
+		t.Fatal(`A negative number of days should raise an error`)
+	}
+}
+
+func TestUpdateCategoryImageDisplay(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Comics")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mode := "hide"
+	category, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{ImageDisplay: &mode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if category.ImageDisplay != mode {
+		t.Fatalf(`Invalid image display mode, got %q instead of %q`, category.ImageDisplay, mode)
+	}
+
+	mode = "invalid"
+	_, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{ImageDisplay: &mode})
+	if err == nil {
+		t.Fatal(`An invalid image display mode should raise an error`)
+	}
+}
+
+func TestUpdateCategoryNSFW(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Art")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nsfw := true
+	category, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{NSFW: &nsfw})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !category.NSFW {
+		t.Fatal(`The category should be flagged as NSFW`)
+	}
+}
+
+func TestUpdateCategoryWithStaleVersion(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Versioned")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version := category.Version
+	title := "First edit"
+	updatedCategory, err := client.ModifyCategory(category.ID, &miniflux.CategoryModification{Title: &title, Version: &version})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedCategory.Version != version+1 {
+		t.Fatalf(`Wrong version, got %d instead of %d`, updatedCategory.Version, version+1)
+	}
+
+	title = "Second edit"
+	_, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{Title: &title, Version: &version})
+	if err != miniflux.ErrConflict {
+		t.Fatalf(`Updating a stale version should return a conflict, got %v`, err)
+	}
+}
+
 func TestUpdateCategory(t *testing.T) {
 	categoryName := "My category"
 	client := createClient(t)
@@ -138,6 +235,42 @@ func TestDeleteCategory(t *testing.T) {
 	}
 }
 
+func TestRefreshCategory(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	jobID, err := client.RefreshCategory(category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refreshJob, err := client.RefreshJob(jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if refreshJob.Total != 1 {
+		t.Fatalf(`Invalid number of feeds, got %d instead of 1`, refreshJob.Total)
+	}
+
+	if refreshJob.Feeds[0].FeedID != feed.ID {
+		t.Fatalf(`Invalid feed ID, got %d instead of %d`, refreshJob.Feeds[0].FeedID, feed.ID)
+	}
+}
+
+func TestCannotRefreshCategoryOfAnotherUser(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = createClient(t)
+	if _, err := client.RefreshCategory(categories[0].ID); err == nil {
+		t.Fatal(`Refreshing a category that belongs to another user should be forbidden`)
+	}
+}
+
 func TestCannotDeleteCategoryOfAnotherUser(t *testing.T) {
 	client := createClient(t)
 	categories, err := client.Categories()
@@ -151,3 +284,82 @@ func TestCannotDeleteCategoryOfAnotherUser(t *testing.T) {
 		t.Fatal(`Removing a category that belongs to another user should be forbidden`)
 	}
 }
+
+func TestShareCategory(t *testing.T) {
+	owner := createClient(t)
+	feed, category := createFeed(t, owner)
+
+	grantee := createClient(t)
+	user, err := grantee.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := owner.ShareCategory(category.ID, user.Username); err != nil {
+		t.Fatal(err)
+	}
+
+	shares, err := owner.CategoryShares(category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(shares) != 1 || shares[0].UserID != user.ID || shares[0].Username != user.Username {
+		t.Fatalf(`Invalid shares, got %+v`, shares)
+	}
+
+	categories, err := grantee.SharedCategories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(categories) != 1 || categories[0].Category.ID != category.ID {
+		t.Fatalf(`Invalid shared categories, got %+v`, categories)
+	}
+
+	result, err := grantee.SharedCategoryEntries(category.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 || result.Entries[0].FeedID != feed.ID {
+		t.Fatalf(`The entries of the shared category should be visible, got %+v`, result)
+	}
+
+	if _, err := grantee.Entry(result.Entries[0].ID); err == nil {
+		t.Fatal(`The entries of a shared category should only be available through the shared category`)
+	}
+
+	if err := owner.UnshareCategory(category.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := grantee.SharedCategoryEntries(category.ID, nil); err != miniflux.ErrNotFound {
+		t.Fatalf(`The access should be revoked, got %v`, err)
+	}
+}
+
+func TestCannotShareCategoryOfAnotherUser(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = createClient(t)
+	if err := client.ShareCategory(categories[0].ID, testAdminUsername); err == nil {
+		t.Fatal(`Sharing a category that belongs to another user should be forbidden`)
+	}
+}
+
+func TestCannotShareCategoryWithUnknownUser(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ShareCategory(categories[0].ID, getRandomUsername()); err == nil {
+		t.Fatal(`Sharing a category with an unknown user should fail`)
+	}
+}
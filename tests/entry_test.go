@@ -7,7 +7,12 @@
 package tests
 
 import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/client"
 )
@@ -52,6 +57,44 @@ func TestGetAllFeedEntries(t *testing.T) {
 	}
 }
 
+func TestHideNSFWEntries(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	nsfw := true
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{NSFW: &nsfw}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total == 0 {
+		t.Fatal(`The NSFW entries should be listed when they are blurred`)
+	}
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mode := "hide"
+	if _, err := client.UpdateUser(user.ID, &miniflux.UserModification{NSFWDisplay: &mode}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err = client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`The NSFW entries should be hidden, got %d entries`, results.Total)
+	}
+}
+
 func TestGetAllEntries(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -93,6 +136,235 @@ func TestGetAllEntries(t *testing.T) {
 	}
 }
 
+func TestGetAllEntriesWithRandomOrder(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	firstResult, err := client.Entries(&miniflux.Filter{Order: "random", Seed: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secondResult, err := client.Entries(&miniflux.Filter{Order: "random", Seed: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range firstResult.Entries {
+		if firstResult.Entries[i].ID != secondResult.Entries[i].ID {
+			t.Fatal(`The same seed should return entries in the same order`)
+		}
+	}
+
+	if _, err := client.Entries(&miniflux.Filter{Order: "feed_title"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Entries(&miniflux.Filter{Order: "score", Direction: "desc"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFilterEntriesByMultipleStatuses(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	allEntries, err := client.Entries(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Entries(&miniflux.Filter{Status: "unread,read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != allEntries.Total {
+		t.Fatalf(`Invalid number of entries, got %d instead of %d`, result.Total, allEntries.Total)
+	}
+
+	result, err = client.Entries(&miniflux.Filter{Status: "unread,read", Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 0 {
+		t.Fatalf(`We are not supposed to have starred entries yet`)
+	}
+
+	if _, err := client.Entries(&miniflux.Filter{Status: "unread,invalid"}); err == nil {
+		t.Fatal(`Using an invalid status should raise an error`)
+	}
+}
+
+func TestFilterEntriesWithStatusList(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	allEntries, err := client.Entries(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Entries(&miniflux.Filter{Statuses: []string{miniflux.EntryStatusUnread, miniflux.EntryStatusRead}, Unstarred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != allEntries.Total {
+		t.Fatalf(`Invalid number of entries, got %d instead of %d`, result.Total, allEntries.Total)
+	}
+}
+
+func TestIterateEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	allEntries, err := client.Entries(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := client.IterateEntries(&miniflux.Filter{Limit: 3, Order: "id"})
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Entry().ID)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ids) != allEntries.Total || it.Total() != allEntries.Total {
+		t.Fatalf(`Invalid number of entries, got %d instead of %d`, len(ids), allEntries.Total)
+	}
+
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Fatalf(`Entries are not sorted or contain duplicates: %v`, ids)
+		}
+	}
+}
+
+func TestSyncEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	entries, timestamp, err := client.SyncEntries(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) == 0 {
+		t.Fatal(`The first synchronization should return all entries`)
+	}
+
+	time.Sleep(time.Second)
+
+	if err := client.UpdateEntries([]int64{entries[0].ID}, miniflux.EntryStatusRead); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, _, err := client.SyncEntries(timestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 1 || changes[0].ID != entries[0].ID {
+		t.Fatalf(`Only the modified entry should be returned, got %d entries`, len(changes))
+	}
+}
+
+func TestEntryChanges(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	var entries []*miniflux.SyncEntry
+	token := ""
+	for {
+		changes, err := client.EntryChanges(token, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(changes.Entries) > 2 {
+			t.Fatalf(`The limit should be respected, got %d entries`, len(changes.Entries))
+		}
+
+		entries = append(entries, changes.Entries...)
+		token = changes.Token
+		if !changes.HasMore {
+			break
+		}
+	}
+
+	result, err := client.Entries(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != result.Total {
+		t.Fatalf(`The first synchronization should return all entries, got %d instead of %d`, len(entries), result.Total)
+	}
+
+	if err := client.UpdateEntries([]int64{entries[0].ID}, miniflux.EntryStatusRead); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := client.EntryChanges(token, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, entry := range changes.Entries {
+		if entry.ID == entries[0].ID {
+			found = entry.Status == miniflux.EntryStatusRead
+		}
+	}
+
+	if !found {
+		t.Fatal(`The modified entry should be returned with its new status`)
+	}
+
+	if _, err := client.EntryChanges("invalid", 100); err == nil {
+		t.Fatal(`An invalid token should be rejected`)
+	}
+}
+
+func TestFilterEntriesByDate(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{PublishedAfter: time.Now().Add(24 * time.Hour).Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 0 {
+		t.Fatalf(`We should not have entries published in the future, got %d`, result.Total)
+	}
+
+	result, err = client.Entries(&miniflux.Filter{PublishedBefore: time.Now().Add(24 * time.Hour).Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatal(`We should have entries published before tomorrow`)
+	}
+
+	changedAfter := time.Now().Add(-time.Minute).Unix()
+	result, err = client.Entries(&miniflux.Filter{ChangedAfter: changedAfter})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatal(`Newly created entries should be returned when filtering by change date`)
+	}
+}
+
 func TestSearchEntries(t *testing.T) {
 	client := createClient(t)
 	categories, err := client.Categories()
@@ -167,6 +439,297 @@ func TestGetEntry(t *testing.T) {
 	}
 }
 
+func TestSummarizeEntryWithoutSummarizer(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Entries[0].Summary != "" {
+		t.Fatalf(`The entries should not have a summary by default, got %q`, result.Entries[0].Summary)
+	}
+
+	if _, err := client.SummarizeEntry(result.Entries[0].ID); err == nil {
+		t.Fatal(`Summarizing an entry without summarizer should raise an error`)
+	}
+}
+
+func TestSemanticSearchWithoutEmbeddings(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.SemanticSearch("postgres vacuum tuning", 10); err == nil {
+		t.Fatal(`The semantic search should raise an error when it's not configured`)
+	}
+}
+
+func TestGetEntryLocalizedDates(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Entries[0].DateRelative == "" || result.Entries[0].DateAbsolute == "" {
+		t.Fatalf(`Entries should have localized dates, got %q and %q`, result.Entries[0].DateRelative, result.Entries[0].DateAbsolute)
+	}
+
+	entry, err := client.Entry(result.Entries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.DateRelative == "" || entry.DateAbsolute == "" {
+		t.Fatalf(`The entry should have localized dates, got %q and %q`, entry.DateRelative, entry.DateAbsolute)
+	}
+}
+
+func TestGetEntriesGroupedByDay(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.EntryDays(&miniflux.Filter{Limit: 5, Order: "published_at", Direction: "desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Days) == 0 {
+		t.Fatal(`The entries should be grouped in at least one day`)
+	}
+
+	entries := 0
+	for _, day := range result.Days {
+		if day.Count < len(day.Entries) {
+			t.Fatalf(`The day %s has %d entries on this page but only %d in total`, day.Day, len(day.Entries), day.Count)
+		}
+		entries += len(day.Entries)
+	}
+
+	expected := 5
+	if result.Total < expected {
+		expected = result.Total
+	}
+
+	if entries != expected {
+		t.Fatalf(`Unexpected number of entries, got %d instead of %d`, entries, expected)
+	}
+
+	if _, err := client.EntryDays(&miniflux.Filter{Order: "score"}); err == nil {
+		t.Fatal(`Entries grouped by day sorted by score should be rejected`)
+	}
+}
+
+func TestGetEntriesGroupedByStory(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.EntryClusters(&miniflux.Filter{Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Clusters) == 0 || len(result.Clusters) > 5 {
+		t.Fatalf(`Unexpected number of clusters, got %d`, len(result.Clusters))
+	}
+
+	for _, cluster := range result.Clusters {
+		if cluster.Representative == nil || cluster.Representative.ClusterID != cluster.ClusterID {
+			t.Fatalf(`The cluster %d should have a representative entry of the same story`, cluster.ClusterID)
+		}
+
+		for _, entry := range cluster.Entries {
+			if entry.ClusterID != cluster.ClusterID {
+				t.Fatalf(`The entry %d belongs to the story %d instead of %d`, entry.ID, entry.ClusterID, cluster.ClusterID)
+			}
+		}
+	}
+}
+
+func TestUpdateEntriesScore(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scores := []*miniflux.EntryScore{
+		{EntryID: result.Entries[0].ID, Score: 0.75},
+		{EntryID: result.Entries[1].ID, Score: -1},
+	}
+	if err := client.UpdateEntriesScore(scores); err != nil {
+		t.Fatal(err)
+	}
+
+	minScore := 0.5
+	ranked, err := client.Entries(&miniflux.Filter{MinScore: &minScore, Order: "score", Direction: "desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ranked.Total != 1 || ranked.Entries[0].ID != result.Entries[0].ID || ranked.Entries[0].Score != 0.75 {
+		t.Fatalf(`Unexpected entries with a score of at least %v: %+v`, minScore, ranked.Entries)
+	}
+
+	maxScore := -0.5
+	ranked, err = client.Entries(&miniflux.Filter{MaxScore: &maxScore})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ranked.Total != 1 || ranked.Entries[0].ID != result.Entries[1].ID {
+		t.Fatalf(`Unexpected entries with a score of at most %v: %+v`, maxScore, ranked.Entries)
+	}
+}
+
+func TestRecentlyReadEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range result.Entries {
+		if err := client.UpdateEntries([]int64{entry.ID}, "read"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history, err := client.RecentlyReadEntries(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history.Entries) != 2 {
+		t.Fatalf(`Unexpected number of recently read entries, got %d instead of 2`, len(history.Entries))
+	}
+
+	if history.Entries[0].ID != result.Entries[1].ID {
+		t.Fatalf(`The last read entry should be first, got #%d instead of #%d`, history.Entries[0].ID, result.Entries[1].ID)
+	}
+
+	if history.Entries[0].ReadAt == nil {
+		t.Fatal(`Read entries should have a read date`)
+	}
+
+	if err := client.UpdateEntries([]int64{result.Entries[1].ID}, "unread"); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err = client.RecentlyReadEntries(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history.Entries) != 1 || history.Entries[0].ID != result.Entries[0].ID {
+		t.Fatalf(`Unread entries should be removed from the recently read entries`)
+	}
+
+	if _, err := client.RecentlyReadEntries(0); err == nil {
+		t.Fatal(`An invalid limit should be rejected`)
+	}
+}
+
+func TestExportEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ToggleBookmark(result.Entries[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	book, err := client.ExportEntries("epub", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(book), int64(len(book)))
+	if err != nil {
+		t.Fatalf(`The export is not a valid EPUB file: %v`, err)
+	}
+
+	if archive.File[0].Name != "mimetype" {
+		t.Fatalf(`The first file of the EPUB archive must be the mimetype, got %q`, archive.File[0].Name)
+	}
+
+	chapters := 0
+	for _, file := range archive.File {
+		if strings.HasSuffix(file.Name, ".xhtml") && file.Name != "OEBPS/nav.xhtml" {
+			chapters++
+		}
+	}
+
+	if chapters != 1 {
+		t.Fatalf(`Only the starred entry should be exported, got %d entries`, chapters)
+	}
+
+	document, err := client.ExportEntries("html", []int64{result.Entries[1].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(document), fmt.Sprintf(`id="entry-%d"`, result.Entries[1].ID)) {
+		t.Fatalf(`The selected entry is missing from the document`)
+	}
+
+	if _, err := client.ExportEntries("pdf", nil); err == nil {
+		t.Fatal(`An invalid format should be rejected`)
+	}
+}
+
+func TestUpdateEntriesBookmark(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIDs := []int64{result.Entries[0].ID, result.Entries[1].ID}
+	if err := client.UpdateEntriesBookmark(entryIDs, true); err != nil {
+		t.Fatal(err)
+	}
+
+	starred, err := client.Entries(&miniflux.Filter{Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if starred.Total != 2 {
+		t.Fatalf(`Unexpected number of starred entries, got %d instead of 2`, starred.Total)
+	}
+
+	if err := client.UpdateEntriesBookmark(entryIDs, false); err != nil {
+		t.Fatal(err)
+	}
+
+	starred, err = client.Entries(&miniflux.Filter{Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if starred.Total != 0 {
+		t.Fatalf(`The entries should be unstarred, got %d starred entries`, starred.Total)
+	}
+
+	if err := client.UpdateEntriesBookmark([]int64{}, true); err == nil {
+		t.Fatal(`An empty list of entries should be rejected`)
+	}
+}
+
 func TestUpdateStatus(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -223,3 +786,120 @@ func TestToggleBookmark(t *testing.T) {
 		t.Fatal("The entry should be starred")
 	}
 }
+
+func TestUndoMarkAsReadWithoutOperation(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	count, err := client.UndoMarkAsRead()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 0 {
+		t.Fatalf(`No entry should be restored without a mark all as read operation, got %d`, count)
+	}
+}
+
+func TestSaveURL(t *testing.T) {
+	client := createClient(t)
+
+	entry, err := client.SaveURL(testWebsiteURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.ID == 0 {
+		t.Fatal(`Invalid entry ID`)
+	}
+
+	if entry.URL != testWebsiteURL {
+		t.Fatalf(`Invalid entry URL, got %q`, entry.URL)
+	}
+
+	if entry.Feed == nil || entry.Feed.FeedURL != "miniflux:saved-pages" {
+		t.Fatalf(`The entry should belong to the saved pages feed, got %v`, entry.Feed)
+	}
+
+	again, err := client.SaveURL(testWebsiteURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again.ID != entry.ID {
+		t.Fatalf(`Saving the same URL twice should update the existing entry, got %d instead of %d`, again.ID, entry.ID)
+	}
+}
+
+func TestSaveURLWithoutURL(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.SaveURL(""); err == nil {
+		t.Fatal(`Saving an empty URL should not be accepted`)
+	}
+}
+
+func TestRefreshEntrySnapshot(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if _, err := client.EntrySnapshot(entryID); err == nil {
+		t.Fatal(`The entry should not have a snapshot yet`)
+	}
+
+	snapshot, err := client.RefreshEntrySnapshot(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot.EntryID != entryID || snapshot.Size == 0 || snapshot.Content == "" {
+		t.Fatalf(`Invalid snapshot: %+v`, snapshot)
+	}
+
+	saved, err := client.EntrySnapshot(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.Content != snapshot.Content {
+		t.Fatal(`The saved snapshot should have the same content`)
+	}
+}
+
+func TestRelatedEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	related, err := client.RelatedEntries(result.Entries[0].ID, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(related.Entries) > 5 || related.Total != len(related.Entries) {
+		t.Fatalf(`Unexpected number of related entries, got %d entries and a total of %d`, len(related.Entries), related.Total)
+	}
+
+	for _, entry := range related.Entries {
+		if entry.ID == result.Entries[0].ID {
+			t.Fatal(`An entry should not be related to itself`)
+		}
+	}
+}
+
+func TestRelatedEntriesNotFound(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.RelatedEntries(123456789, 5); err != miniflux.ErrNotFound {
+		t.Fatalf(`A "Not Found" error should be raised, got %v`, err)
+	}
+}
@@ -9,6 +9,7 @@ package tests
 import (
 	"strings"
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/client"
 )
@@ -116,6 +117,341 @@ func TestUpdateFeedTitle(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedWithStaleVersion(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	version := feed.Version
+	newTitle := "First edit"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{Title: &newTitle, Version: &version})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Version != version+1 {
+		t.Fatalf(`Wrong version, got %d instead of %d`, updatedFeed.Version, version+1)
+	}
+
+	newTitle = "Second edit"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{Title: &newTitle, Version: &version})
+	if err != miniflux.ErrConflict {
+		t.Fatalf(`Updating a stale version should return a conflict, got %v`, err)
+	}
+
+	updatedFeed, err = client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Title != "First edit" {
+		t.Fatalf(`The first edit should be kept, got %q`, updatedFeed.Title)
+	}
+}
+
+func TestUpdateFeedEntryOpenMode(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.EntryOpenMode != "content" {
+		t.Fatalf(`Wrong default entry open mode, got "%v"`, feed.EntryOpenMode)
+	}
+
+	mode := "original"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryOpenMode: &mode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.EntryOpenMode != mode {
+		t.Fatalf(`Wrong entry open mode, got "%v" instead of "%v"`, updatedFeed.EntryOpenMode, mode)
+	}
+
+	mode = "invalid"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryOpenMode: &mode})
+	if err == nil {
+		t.Fatal(`Updating a feed with an invalid entry open mode should raise an error`)
+	}
+}
+
+func TestUpdateFeedPriority(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.Priority != "normal" {
+		t.Fatalf(`Wrong default priority, got "%v"`, feed.Priority)
+	}
+
+	priority := "high"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{Priority: &priority})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Priority != priority {
+		t.Fatalf(`Wrong priority, got "%v" instead of "%v"`, updatedFeed.Priority, priority)
+	}
+
+	priority = "urgent"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{Priority: &priority})
+	if err == nil {
+		t.Fatal(`Updating a feed with an invalid priority should raise an error`)
+	}
+}
+
+func TestUpdateFeedScraperMaxPages(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.ScraperMaxPages != 1 {
+		t.Fatalf(`Wrong default number of pages, got %d`, feed.ScraperMaxPages)
+	}
+
+	pages := 5
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ScraperMaxPages: &pages})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ScraperMaxPages != pages {
+		t.Fatalf(`Wrong number of pages, got %d`, updatedFeed.ScraperMaxPages)
+	}
+
+	pages = 0
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{ScraperMaxPages: &pages})
+	if err == nil {
+		t.Fatal(`Updating a feed without any page should raise an error`)
+	}
+}
+
+func TestUpdateFeedImageDisplay(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.ImageDisplay != "" {
+		t.Fatalf(`Feeds should inherit the image display mode by default, got %q`, feed.ImageDisplay)
+	}
+
+	mode := "blur"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ImageDisplay: &mode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ImageDisplay != mode {
+		t.Fatalf(`Wrong image display mode, got %q`, updatedFeed.ImageDisplay)
+	}
+
+	mode = "invalid"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{ImageDisplay: &mode})
+	if err == nil {
+		t.Fatal(`An invalid image display mode should raise an error`)
+	}
+}
+
+func TestUpdateFeedNSFW(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.NSFW {
+		t.Fatal(`Feeds should not be flagged as NSFW by default`)
+	}
+
+	nsfw := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{NSFW: &nsfw})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updatedFeed.NSFW {
+		t.Fatal(`The feed should be flagged as NSFW`)
+	}
+}
+
+func TestUpdateFeedSummaryWords(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.SummaryWords != 0 {
+		t.Fatalf(`The full content should be kept by default, got %d words`, feed.SummaryWords)
+	}
+
+	words := 50
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SummaryWords: &words})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.SummaryWords != words {
+		t.Fatalf(`Wrong number of words, got %d`, updatedFeed.SummaryWords)
+	}
+
+	words = -1
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{SummaryWords: &words})
+	if err == nil {
+		t.Fatal(`Updating a feed with a negative number of words should raise an error`)
+	}
+}
+
+func TestUpdateFeedSummarizeEntries(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.SummarizeEntries {
+		t.Fatal(`The entries should not be summarized by default`)
+	}
+
+	enabled := true
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SummarizeEntries: &enabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updatedFeed.SummarizeEntries {
+		t.Fatal(`The entries of the feed should be summarized`)
+	}
+}
+
+func TestUpdateFeedEntryLimit(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.MaxEntries != 0 || feed.OverflowPolicy != "archive" {
+		t.Fatalf(`Wrong default entry limit, got %d and "%v"`, feed.MaxEntries, feed.OverflowPolicy)
+	}
+
+	maxEntries := 10
+	policy := "delete"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{MaxEntries: &maxEntries, OverflowPolicy: &policy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MaxEntries != maxEntries || updatedFeed.OverflowPolicy != policy {
+		t.Fatalf(`Wrong entry limit, got %d and "%v"`, updatedFeed.MaxEntries, updatedFeed.OverflowPolicy)
+	}
+
+	maxEntries = -1
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{MaxEntries: &maxEntries})
+	if err == nil {
+		t.Fatal(`Updating a feed with a negative entry limit should raise an error`)
+	}
+}
+
+func TestUpdateFeedEntryMatching(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.EntryMatching != "guid" {
+		t.Fatalf(`Wrong default entry matching, got "%v"`, feed.EntryMatching)
+	}
+
+	matching := "title"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryMatching: &matching})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.EntryMatching != matching {
+		t.Fatalf(`Wrong entry matching, got "%v" instead of "%v"`, updatedFeed.EntryMatching, matching)
+	}
+
+	matching = "content"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{EntryMatching: &matching})
+	if err == nil {
+		t.Fatal(`Updating a feed with an invalid entry matching should raise an error`)
+	}
+}
+
+func TestMuteFeed(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.MutedUntil != nil {
+		t.Fatalf(`A new feed should not be muted`)
+	}
+
+	mutedUntil := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{MutedUntil: &mutedUntil})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MutedUntil == nil {
+		t.Fatal(`The feed should be muted`)
+	}
+
+	mutedUntil = ""
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{MutedUntil: &mutedUntil})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MutedUntil != nil {
+		t.Fatal(`The feed should not be muted anymore`)
+	}
+
+	mutedUntil = "tomorrow"
+	_, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{MutedUntil: &mutedUntil})
+	if err == nil {
+		t.Fatal(`Muting a feed with an invalid date should raise an error`)
+	}
+}
+
+func TestCreatePageWatchFeed(t *testing.T) {
+	client := createClient(t)
+
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreatePageWatchFeed(testWebsiteURL, categories[0].ID, "body")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.WatchSelector != "body" {
+		t.Fatalf(`Wrong watch selector, got %q`, feed.WatchSelector)
+	}
+
+	entries, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entries.Total != 1 {
+		t.Fatalf(`The current content of the page should be stored as an entry, got %d entries`, entries.Total)
+	}
+
+	selector := "main"
+	updatedFeed, err := client.UpdateFeed(feedID, &miniflux.FeedModification{WatchSelector: &selector})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.WatchSelector != selector {
+		t.Fatalf(`Wrong watch selector, got %q instead of %q`, updatedFeed.WatchSelector, selector)
+	}
+}
+
+func TestCreatePageWatchFeedWithoutMatchingElement(t *testing.T) {
+	client := createClient(t)
+
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreatePageWatchFeed(testWebsiteURL, categories[0].ID, "#miniflux-missing-element"); err == nil {
+		t.Fatal(`A selector without matching element should raise an error`)
+	}
+}
+
 func TestUpdateFeedCrawler(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -195,6 +531,42 @@ func TestUpdateFeedRewriteRules(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedScript(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	feedScript := "def process(entry):\n    entry[\"title\"] = entry[\"title\"].upper()\n"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{Script: &feedScript})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Script != feedScript {
+		t.Fatalf(`Wrong Script value, got "%v" instead of "%v"`, updatedFeed.Script, feedScript)
+	}
+
+	feedScript = ""
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{Script: &feedScript})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Script != feedScript {
+		t.Fatalf(`Wrong Script value, got "%v" instead of "%v"`, updatedFeed.Script, feedScript)
+	}
+}
+
+func TestUpdateFeedWithInvalidScript(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	feedScript := "def transform(entry):\n    return True\n"
+	_, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{Script: &feedScript})
+	if err == nil {
+		t.Fatal(`Updating a feed with an invalid script should not be possible`)
+	}
+}
+
 func TestUpdateFeedUserAgent(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -307,6 +679,38 @@ func TestDeleteFeed(t *testing.T) {
 	}
 }
 
+func TestPreviewFeed(t *testing.T) {
+	client := createClient(t)
+	preview, err := client.PreviewFeed(testFeedURL, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.Title != testFeedTitle {
+		t.Fatalf(`Invalid feed title, got "%v" instead of "%v"`, preview.Title, testFeedTitle)
+	}
+
+	if len(preview.Entries) != 2 {
+		t.Fatalf(`Invalid number of entries, got %d instead of 2`, len(preview.Entries))
+	}
+
+	feeds, err := client.Feeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 0 {
+		t.Fatalf(`A preview should not create any feed`)
+	}
+}
+
+func TestPreviewFeedWithInvalidLimit(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.PreviewFeed(testFeedURL, -1); err == nil {
+		t.Fatal(`Using a negative limit should raise an error`)
+	}
+}
+
 func TestRefreshFeed(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -315,6 +719,33 @@ func TestRefreshFeed(t *testing.T) {
 	}
 }
 
+func TestRefreshAllFeeds(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	jobID, err := client.RefreshAllFeeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refreshJob, err := client.RefreshJob(jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if refreshJob.ID != jobID {
+		t.Fatalf(`Invalid job ID, got %d instead of %d`, refreshJob.ID, jobID)
+	}
+
+	if refreshJob.Total != 1 {
+		t.Fatalf(`Invalid number of feeds, got %d instead of 1`, refreshJob.Total)
+	}
+
+	if refreshJob.Feeds[0].FeedID != feed.ID {
+		t.Fatalf(`Invalid feed ID, got %d instead of %d`, refreshJob.Feeds[0].FeedID, feed.ID)
+	}
+}
+
 func TestGetFeed(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -372,6 +803,123 @@ func TestGetFeedIconNotFound(t *testing.T) {
 	}
 }
 
+func TestGetFeedStats(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.RefreshFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := client.FeedStats(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.FeedID != feed.ID {
+		t.Fatalf(`Wrong feed ID, got %d instead of %d`, stats.FeedID, feed.ID)
+	}
+
+	if stats.AverageEntrySize == 0 {
+		t.Fatal(`The average entry size should not be zero`)
+	}
+
+	if stats.ReadRatio != 0 {
+		t.Fatalf(`The read ratio should be zero, got %v`, stats.ReadRatio)
+	}
+
+	if stats.FetchCount != 1 || stats.FetchSuccessRate != 1 {
+		t.Fatalf(`Wrong fetch statistics, got %d fetches and a success rate of %v`, stats.FetchCount, stats.FetchSuccessRate)
+	}
+}
+
+func TestGetFeedStatsNotFound(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.FeedStats(42); err != miniflux.ErrNotFound {
+		t.Fatalf(`A "Not Found" error should be raised, got %v`, err)
+	}
+}
+
+func TestGetLeastReadFeeds(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	feeds, err := client.LeastReadFeeds(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 0 {
+		t.Fatalf(`A feed subscribed during the period should not be listed, got %d feeds`, len(feeds))
+	}
+}
+
+func TestGetLeastReadFeedsWithInvalidPeriod(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.LeastReadFeeds(0); err == nil {
+		t.Fatal(`An invalid number of months should raise an error`)
+	}
+}
+
+func TestGetFeedResponsesAsRegularUser(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if _, err := client.FeedResponses(feed.ID); err == nil {
+		t.Fatal(`Regular users should not be able to get archived feed responses`)
+	}
+}
+
+func TestGetFeedResponsesWhenArchiveIsDisabled(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	adminClient := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	responses, err := adminClient.FeedResponses(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(responses) != 0 {
+		t.Fatalf(`No response should be archived, got %d`, len(responses))
+	}
+
+	if _, err := adminClient.FeedResponseContent(feed.ID, 42); err == nil {
+		t.Fatal(`Missing responses should return an error`)
+	}
+}
+
+func TestPendingFeedsAsRegularUser(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if _, err := client.PendingFeeds(); err != miniflux.ErrForbidden {
+		t.Fatal(`Regular users should not be able to list pending feeds`)
+	}
+
+	if err := client.ApproveFeed(feed.ID); err != miniflux.ErrForbidden {
+		t.Fatal(`Regular users should not be able to approve feeds`)
+	}
+}
+
+func TestApproveFeedWithoutApproval(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.Pending {
+		t.Fatal(`The feeds should not be pending when the approval is disabled`)
+	}
+
+	adminClient := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	if err := adminClient.ApproveFeed(feed.ID); err != miniflux.ErrNotFound {
+		t.Fatalf(`Approving a feed that is not pending should return a not found error, got %v`, err)
+	}
+
+	if err := adminClient.RejectFeed(feed.ID); err != miniflux.ErrNotFound {
+		t.Fatalf(`Rejecting a feed that is not pending should return a not found error, got %v`, err)
+	}
+}
+
 func TestGetFeeds(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -413,3 +961,30 @@ func TestGetFeeds(t *testing.T) {
 		t.Fatalf(`Invalid feed category title, got "%v" instead of "%v"`, feeds[0].Category.Title, category.Title)
 	}
 }
+
+func TestUpdateFeedsCategory(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	category, err := client.CreateCategory("batch category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UpdateFeedsCategory([]int64{feed.ID}, category.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	updatedFeed, err := client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Category.ID != category.ID {
+		t.Fatalf(`The feed should be moved to the category #%d, got #%d`, category.ID, updatedFeed.Category.ID)
+	}
+
+	if err := client.UpdateFeedsCategory([]int64{feed.ID}, -1); err == nil {
+		t.Fatal(`An invalid category should be rejected`)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateFeedWithIdempotencyKey(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	retryingClient := client.WithIdempotencyKey(getRandomUsername())
+	feedID, err := retryingClient.CreateFeed(testFeedURL, categories[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without the key, the second request would fail because the feed already exists.
+	retriedFeedID, err := retryingClient.CreateFeed(testFeedURL, categories[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if retriedFeedID != feedID {
+		t.Fatalf(`The retry should receive the feed ID of the first request, got %d instead of %d`, retriedFeedID, feedID)
+	}
+}
+
+func TestIdempotencyKeyReusedForAnotherRequest(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	results, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIDs := []int64{results.Entries[0].ID}
+	retryingClient := client.WithIdempotencyKey(getRandomUsername())
+	if err := retryingClient.UpdateEntries(entryIDs, "read"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := retryingClient.UpdateEntries(entryIDs, "unread"); err == nil {
+		t.Fatal(`An idempotency key should not be used for another request`)
+	}
+}
+
+func TestMarkAllAsReadWithIdempotencyKey(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	retryingClient := client.WithIdempotencyKey(getRandomUsername())
+	for i := 0; i < 2; i++ {
+		if err := retryingClient.MarkAllAsRead(user.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`All entries should be read, got %d unread entries`, results.Total)
+	}
+}
+
+func TestMarkAllAsReadOfAnotherUser(t *testing.T) {
+	client := createClient(t)
+	adminClient := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	admin, err := adminClient.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.MarkAllAsRead(admin.ID); err != miniflux.ErrForbidden {
+		t.Fatalf(`Users should not mark as read the entries of other users, got %v`, err)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestGetInstanceStatsAsRegularUser(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.InstanceStats(); err == nil {
+		t.Fatal(`Regular users should not be able to get the instance statistics`)
+	}
+}
+
+func TestGetInstanceStats(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	admin := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	stats, err := admin.InstanceStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Users < 2 || stats.Feeds < 1 {
+		t.Fatalf(`Unexpected number of users and feeds: %d and %d`, stats.Users, stats.Feeds)
+	}
+
+	if stats.DatabaseSize <= 0 {
+		t.Fatalf(`The database size should be returned, got %d`, stats.DatabaseSize)
+	}
+
+	if stats.FetchesLastDay < stats.FetchesLastHour || stats.FetchSuccessRate < 0 || stats.FetchSuccessRate > 1 {
+		t.Fatalf(`Unexpected refresh throughput: %+v`, stats)
+	}
+
+	if stats.SharedFeeds == nil || stats.ErrorDomains == nil {
+		t.Fatal(`The lists should not be null`)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestGetOutboxAsRegularUser(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.Outbox("failed", 10); err == nil {
+		t.Fatal(`Regular users should not be able to get the outbox`)
+	}
+}
+
+func TestGetOutbox(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	result, err := client.Outbox("failed", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Backlog == nil {
+		t.Fatal(`The backlog should be returned`)
+	}
+
+	if len(result.Events) != result.Backlog.Failed && len(result.Events) != 10 {
+		t.Fatalf(`Unexpected number of events, got %d for a backlog of %d`, len(result.Events), result.Backlog.Failed)
+	}
+}
+
+func TestGetOutboxWithInvalidStatus(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+
+	if _, err := client.Outbox("invalid", 10); err == nil {
+		t.Fatal(`An invalid status should raise an error`)
+	}
+}
+
+func TestDiscardOutboxWithoutEvents(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+
+	if err := client.DiscardOutbox(nil); err == nil {
+		t.Fatal(`Discarding without event IDs should raise an error`)
+	}
+}
+
+func TestDiscardOutboxAsRegularUser(t *testing.T) {
+	client := createClient(t)
+
+	if err := client.DiscardOutbox([]int64{1}); err == nil {
+		t.Fatal(`Regular users should not be able to discard events`)
+	}
+}
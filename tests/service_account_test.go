@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestServiceAccountWithScopes(t *testing.T) {
+	client := createClient(t)
+	account, err := client.CreateServiceAccount("reader", []string{"entries:read", "categories:read"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, key, err := client.CreateAPIKey(account.ID, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceClient := miniflux.NewWithAPIKey(testBaseURL, key)
+	if _, err := serviceClient.Entries(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := serviceClient.Categories(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := serviceClient.CreateCategory("Forbidden"); err != miniflux.ErrForbidden {
+		t.Fatalf(`A service account without the categories:write scope should not create categories, got %v`, err)
+	}
+
+	if _, err := serviceClient.ServiceAccounts(); err != miniflux.ErrForbidden {
+		t.Fatalf(`A service account should not manage service accounts, got %v`, err)
+	}
+
+	if _, err := client.Me(); err != nil {
+		t.Fatal(`The basic authentication should still work`)
+	}
+}
+
+func TestServiceAccountRestrictedToCategories(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := client.CreateServiceAccount("restricted", []string{"categories:read"}, []int64{category.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, key, err := client.CreateAPIKey(account.ID, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	categories, err := miniflux.NewWithAPIKey(testBaseURL, key).Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(categories) != 1 || categories[0].ID != category.ID {
+		t.Fatalf(`The service account should only see its category, got %v`, categories)
+	}
+}
+
+func TestCannotCreateServiceAccountWithInvalidScope(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.CreateServiceAccount("invalid", []string{"users:write"}, nil); err == nil {
+		t.Fatal(`An invalid scope should be rejected`)
+	}
+}
+
+func TestRevokedAPIKey(t *testing.T) {
+	client := createClient(t)
+	account, err := client.CreateServiceAccount("revoked", []string{"entries:read"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKey, key, err := client.CreateAPIKey(account.ID, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteAPIKey(account.ID, apiKey.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := miniflux.NewWithAPIKey(testBaseURL, key).Entries(nil); err != miniflux.ErrNotAuthorized {
+		t.Fatalf(`A revoked key should not be accepted, got %v`, err)
+	}
+}
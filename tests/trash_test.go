@@ -0,0 +1,108 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+)
+
+func TestRestoreFeed(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.DeleteFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Feed(feed.ID); err == nil {
+		t.Fatal(`A removed feed should not be returned`)
+	}
+
+	trash, err := client.Trash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(trash.Feeds) != 1 || trash.Feeds[0].ID != feed.ID {
+		t.Fatalf(`The removed feed should be in the trash, got %v`, trash.Feeds)
+	}
+
+	if trash.Feeds[0].DeletedAt == nil {
+		t.Fatal(`The removed feed should have a deletion date`)
+	}
+
+	if err := client.RestoreFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Feed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRestoreFeedWhenSubscribedAgain(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.DeleteFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	createFeed(t, client)
+
+	if err := client.RestoreFeed(feed.ID); err == nil {
+		t.Fatal(`A feed should not be restored when its URL is already subscribed`)
+	}
+}
+
+func TestRestoreCategory(t *testing.T) {
+	client := createClient(t)
+
+	category, err := client.CreateCategory("My category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(testFeedURL, category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteCategory(category.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Feed(feedID); err == nil {
+		t.Fatal(`The feeds of a removed category should not be returned`)
+	}
+
+	if err := client.RestoreFeed(feedID); err == nil {
+		t.Fatal(`A feed should not be restored while its category is in the trash`)
+	}
+
+	if err := client.RestoreCategory(category.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Category.ID != category.ID {
+		t.Fatalf(`Invalid category, got %d instead of %d`, feed.Category.ID, category.ID)
+	}
+}
+
+func TestRestoreFeedNotInTrash(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.RestoreFeed(feed.ID); err == nil {
+		t.Fatal(`Restoring a feed that is not in the trash should raise an error`)
+	}
+}
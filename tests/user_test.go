@@ -8,6 +8,7 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/client"
 )
@@ -270,6 +271,197 @@ func TestUpdateUserThemeWithInvalidValue(t *testing.T) {
 	}
 }
 
+func TestUpdateUserViewPreferences(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.EntriesPerPage != 100 || user.ShowReadEntries {
+		t.Fatalf(`Unexpected default view preferences: %d, %v`, user.EntriesPerPage, user.ShowReadEntries)
+	}
+
+	entriesPerPage := 25
+	showReadEntries := true
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{EntriesPerPage: &entriesPerPage, ShowReadEntries: &showReadEntries})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.EntriesPerPage != entriesPerPage {
+		t.Fatalf(`Unable to update user EntriesPerPage: got "%v" instead of "%v"`, user.EntriesPerPage, entriesPerPage)
+	}
+
+	if !user.ShowReadEntries {
+		t.Fatal(`Unable to update user ShowReadEntries`)
+	}
+}
+
+func TestUpdateUserShowAbsoluteTime(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.ShowAbsoluteTime {
+		t.Fatal(`Relative timestamps should be displayed by default`)
+	}
+
+	showAbsoluteTime := true
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{ShowAbsoluteTime: &showAbsoluteTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !user.ShowAbsoluteTime {
+		t.Fatal(`Unable to update user ShowAbsoluteTime`)
+	}
+}
+
+func TestUpdateUserQuietHours(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := "22:00"
+	end := "07:00"
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{QuietHoursStart: &start, QuietHoursEnd: &end})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.QuietHoursStart != start || user.QuietHoursEnd != end {
+		t.Fatalf(`Unable to update user quiet hours: got "%s-%s"`, user.QuietHoursStart, user.QuietHoursEnd)
+	}
+
+	invalid := "25:00"
+	if _, err = client.UpdateUser(user.ID, &miniflux.UserModification{QuietHoursStart: &invalid}); err == nil {
+		t.Fatal(`Updating the quiet hours with an invalid value should raise an error`)
+	}
+}
+
+func TestUpdateUserJunkThreshold(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.JunkThreshold != 0 {
+		t.Fatalf(`The junk threshold should be disabled by default, got %d`, user.JunkThreshold)
+	}
+
+	threshold := 60
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{JunkThreshold: &threshold})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.JunkThreshold != threshold {
+		t.Fatalf(`Unable to update user junk threshold, got %d`, user.JunkThreshold)
+	}
+
+	threshold = 101
+	if _, err = client.UpdateUser(user.ID, &miniflux.UserModification{JunkThreshold: &threshold}); err == nil {
+		t.Fatal(`Updating the junk threshold with an invalid value should raise an error`)
+	}
+}
+
+func TestUpdateUserNSFWDisplay(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.NSFWDisplay != "blur" {
+		t.Fatalf(`NSFW entries should be blurred by default, got %q`, user.NSFWDisplay)
+	}
+
+	mode := "hide"
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{NSFWDisplay: &mode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.NSFWDisplay != mode {
+		t.Fatalf(`Unable to update user NSFW display mode, got %q`, user.NSFWDisplay)
+	}
+
+	mode = "invalid"
+	if _, err = client.UpdateUser(user.ID, &miniflux.UserModification{NSFWDisplay: &mode}); err == nil {
+		t.Fatal(`Updating the NSFW display mode with an invalid value should raise an error`)
+	}
+}
+
+func TestUpdateUserEntriesPerPageWithInvalidValue(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entriesPerPage := 0
+	_, err = client.UpdateUser(user.ID, &miniflux.UserModification{EntriesPerPage: &entriesPerPage})
+	if err == nil {
+		t.Fatal(`Updating a user EntriesPerPage with an invalid value should raise an error`)
+	}
+}
+
+func TestUpdateKeyboardShortcuts(t *testing.T) {
+	client := createClient(t)
+
+	shortcuts, err := client.KeyboardShortcuts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(shortcuts["open_item"]) != 1 || shortcuts["open_item"][0] != "o" {
+		t.Fatalf(`Unexpected default keys for open_item: %v`, shortcuts["open_item"])
+	}
+
+	shortcuts, err = client.UpdateKeyboardShortcuts(miniflux.KeyboardShortcuts{"open_item": {"Enter"}, "remove_feed": {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(shortcuts["open_item"]) != 1 || shortcuts["open_item"][0] != "Enter" {
+		t.Fatalf(`Unable to update the keys of open_item: %v`, shortcuts["open_item"])
+	}
+
+	if len(shortcuts["remove_feed"]) != 0 {
+		t.Fatalf(`The remove_feed action should be disabled: %v`, shortcuts["remove_feed"])
+	}
+
+	shortcuts, err = client.KeyboardShortcuts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(shortcuts["open_item"]) != 1 || shortcuts["open_item"][0] != "Enter" {
+		t.Fatalf(`The keyboard shortcuts have not been saved: %v`, shortcuts["open_item"])
+	}
+}
+
+func TestUpdateKeyboardShortcutsWithConflict(t *testing.T) {
+	client := createClient(t)
+
+	_, err := client.UpdateKeyboardShortcuts(miniflux.KeyboardShortcuts{"open_item": {"m"}})
+	if err == nil {
+		t.Fatal(`A key assigned to two actions should raise an error`)
+	}
+}
+
 func TestCannotCreateDuplicateUser(t *testing.T) {
 	username := getRandomUsername()
 	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
@@ -378,3 +570,141 @@ func TestCannotDeleteUserAsNonAdmin(t *testing.T) {
 		t.Fatal(`A "Forbidden" error should be raised`)
 	}
 }
+
+func TestFreezeUser(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err = client.FreezeUser(user.ID, 3600, "Migration in progress")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.FrozenUntil == nil || user.FreezeMessage != "Migration in progress" {
+		t.Fatalf(`Invalid freeze, got %v and %q`, user.FrozenUntil, user.FreezeMessage)
+	}
+
+	userClient := miniflux.New(testBaseURL, username, testStandardPassword)
+	if _, err := userClient.Categories(); err != nil {
+		t.Fatal(`Frozen users should be able to read their data`)
+	}
+
+	if _, err := userClient.CreateCategory("frozen"); err != miniflux.ErrServiceUnavailable {
+		t.Fatalf(`A "Service Unavailable" error should be raised, got %v`, err)
+	}
+
+	if err := client.UnfreezeUser(user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := userClient.CreateCategory("frozen"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCannotFreezeUserAsNonAdmin(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = miniflux.New(testBaseURL, username, testStandardPassword)
+	if _, err := client.FreezeUser(user.ID, 3600, ""); err != miniflux.ErrForbidden {
+		t.Fatal(`A "Forbidden" error should be raised`)
+	}
+}
+
+func TestCreatedUserIsVerified(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !user.Verified || user.Pending {
+		t.Fatalf(`The users created by an administrator should be enabled, got %+v`, user)
+	}
+
+	if err := client.ApproveUser(user.ID); err != miniflux.ErrNotFound {
+		t.Fatalf(`Only the pending users can be approved, got %v`, err)
+	}
+}
+
+func TestInvitations(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	invitation, code, err := client.CreateInvitation("invited@example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code == "" || invitation.ID == 0 || invitation.Email != "invited@example.org" {
+		t.Fatalf(`Invalid invitation, got %+v with code %q`, invitation, code)
+	}
+
+	if !invitation.ExpiresAt.After(time.Now()) {
+		t.Fatalf(`The invitation should not be expired, got %v`, invitation.ExpiresAt)
+	}
+
+	invitations, err := client.Invitations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, i := range invitations {
+		if i.ID == invitation.ID {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf(`The invitation should be listed, got %+v`, invitations)
+	}
+
+	if err := client.DeleteInvitation(invitation.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteInvitation(invitation.ID); err != miniflux.ErrNotFound {
+		t.Fatalf(`The invitation should be removed, got %v`, err)
+	}
+}
+
+func TestCannotCreateInvitationWithInvalidEmail(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	if _, _, err := client.CreateInvitation("invalid"); err == nil {
+		t.Fatal(`An invalid email address should be rejected`)
+	}
+}
+
+func TestCannotCreateInvitationAsNonAdmin(t *testing.T) {
+	client := createClient(t)
+	if _, _, err := client.CreateInvitation(""); err != miniflux.ErrForbidden {
+		t.Fatal(`A "Forbidden" error should be raised`)
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	client := createClient(t)
+	if err := client.DeleteAccount(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Me(); err != miniflux.ErrNotAuthorized {
+		t.Fatal(`A closed account should not be able to log in`)
+	}
+}
+
+func TestCannotDeleteAdminAccount(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	if err := client.DeleteAccount(); err != miniflux.ErrForbidden {
+		t.Fatal(`A "Forbidden" error should be raised`)
+	}
+}
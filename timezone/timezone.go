@@ -38,6 +38,11 @@ func Now(tz string) time.Time {
 	return time.Now().In(getLocation(tz))
 }
 
+// Parse parses a formatted date in the given timezone.
+func Parse(tz, layout, value string) (time.Time, error) {
+	return time.ParseInLocation(layout, value, getLocation(tz))
+}
+
 func getLocation(tz string) *time.Location {
 	loc, err := time.LoadLocation(tz)
 	if err != nil {
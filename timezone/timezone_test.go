@@ -73,3 +73,20 @@ func TestConvertTimeWithIdenticalTimezone(t *testing.T) {
 		t.Fatalf(`Unexpected time, got hours=%d, minutes=%d, secs=%d`, hours, minutes, secs)
 	}
 }
+
+func TestParse(t *testing.T) {
+	tz := "America/Los_Angeles"
+	date, err := Parse(tz, "2006-01-02", "2019-03-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "2019-03-10T08:00:00Z"
+	if date.UTC().Format(time.RFC3339) != expected {
+		t.Fatalf(`Unexpected date, got %q instead of %q`, date.UTC().Format(time.RFC3339), expected)
+	}
+
+	if _, err := Parse(tz, "2006-01-02", "invalid"); err == nil {
+		t.Fatal(`An invalid date should generate an error`)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/cookie"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showDeleteAccountPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.renderDeleteAccountPage(w, r, user, "")
+}
+
+// submitDeleteAccount closes the account of the user, the data is purged by the cleanup job.
+// Users having a password must type it again, the accounts linked to an OAuth2 provider only confirm.
+func (h *handler) submitDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user.IsAdmin {
+		h.renderDeleteAccountPage(w, r, user, "error.account_deletion_admin")
+		return
+	}
+
+	hasPassword, err := h.store.HasPassword(r.Context(), user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if hasPassword {
+		if err := h.store.CheckPassword(r.Context(), user.Username, r.FormValue("password")); err != nil {
+			logger.Error("[UI:DeleteAccount] %v", err)
+			h.renderDeleteAccountPage(w, r, user, "error.invalid_password")
+			return
+		}
+	}
+
+	if err := h.store.RequestUserDeletion(r.Context(), user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:DeleteAccount] username=%s closed the account", user.Username)
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	printer := locale.NewPrinter(user.Language)
+	sess.NewFlashMessage(printer.Printf("alert.account_deletion_requested"))
+
+	http.SetCookie(w, cookie.Expired(
+		cookie.CookieUserSessionID,
+		h.cfg.IsHTTPS,
+		h.cfg.BasePath(),
+	))
+
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
+
+func (h *handler) renderDeleteAccountPage(w http.ResponseWriter, r *http.Request, user *model.User, errorMessage string) {
+	hasPassword, err := h.store.HasPassword(r.Context(), user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("hasPassword", hasPassword)
+	view.Set("errorMessage", errorMessage)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("delete_account"))
+}
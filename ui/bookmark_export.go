@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"bytes"
+	"net/http"
+
+	"miniflux.app/ebook"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/locale"
+	"miniflux.app/mediaproxy"
+	"miniflux.app/model"
+)
+
+// exportStarredEntries downloads the starred entries as an EPUB book or a printable HTML document.
+func (h *handler) exportStarredEntries(w http.ResponseWriter, r *http.Request) {
+	format := request.RouteStringParam(r, "format")
+	if err := ebook.ValidateFormat(format); err != nil {
+		html.BadRequest(w, r, err)
+		return
+	}
+
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithStarred()
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(user.EntryDirection)
+	builder.WithLimit(model.MaxEntriesPerPage)
+
+	entries, err := builder.GetEntries(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	book := ebook.New(printer.Printf("page.starred.title"), user.Language, mediaproxy.Loader(h.store.BlobStore()))
+	for _, entry := range entries {
+		if err := book.AddEntry(entry); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer, format); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).
+		WithHeader("Content-Type", ebook.ContentType(format)).
+		WithAttachment(book.Filename(format)).
+		WithBody(buffer.Bytes()).
+		Write()
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) bulkUpdateEntries(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryIDs := request.FormInt64Values(r, "entry_id")
+	redirectURL := localRedirect(r.FormValue("redirect"), route.Path(h.router, "unread"))
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	if len(entryIDs) == 0 {
+		sess.NewFlashErrorMessage(printer.Printf("error.bulk_empty_selection"))
+		html.Redirect(w, r, redirectURL)
+		return
+	}
+
+	var err error
+	switch r.FormValue("action") {
+	case "read":
+		err = h.store.SetEntriesStatus(r.Context(), userID, entryIDs, model.EntryStatusRead)
+	case "unread":
+		err = h.store.SetEntriesStatus(r.Context(), userID, entryIDs, model.EntryStatusUnread)
+	case "star":
+		err = h.store.SetEntriesStarred(r.Context(), userID, entryIDs, true)
+	case "unstar":
+		err = h.store.SetEntriesStarred(r.Context(), userID, entryIDs, false)
+	default:
+		html.BadRequest(w, r, errors.New("Invalid bulk action"))
+		return
+	}
+
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, redirectURL)
+}
+
+func (h *handler) bulkUpdateFeeds(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedIDs := request.FormInt64Values(r, "feed_id")
+	categoryID := request.FormInt64Value(r, "category_id")
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	if len(feedIDs) == 0 {
+		sess.NewFlashErrorMessage(printer.Printf("error.bulk_empty_selection"))
+		html.Redirect(w, r, route.Path(h.router, "feeds"))
+		return
+	}
+
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		sess.NewFlashErrorMessage(printer.Printf("error.category_not_found"))
+		html.Redirect(w, r, route.Path(h.router, "feeds"))
+		return
+	}
+
+	if err := h.store.SetFeedsCategory(r.Context(), userID, feedIDs, categoryID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "feeds"))
+}
+
+// localRedirect returns the given URL if it points to a page of this application, otherwise the fallback.
+func localRedirect(redirectURL, fallback string) string {
+	if !strings.HasPrefix(redirectURL, "/") || strings.HasPrefix(redirectURL, "//") || strings.HasPrefix(redirectURL, "/\\") {
+		return fallback
+	}
+	return redirectURL
+}
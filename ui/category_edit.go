@@ -9,23 +9,24 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
 
 func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	categoryID := request.RouteInt64Param(r, "categoryID")
-	category, err := h.store.Category(request.UserID(r), categoryID)
+	category, err := h.store.Category(r.Context(), request.UserID(r), categoryID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -37,15 +38,20 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryForm := form.CategoryForm{
-		Title: category.Title,
+		Title:             category.Title,
+		MarkReadAfterDays: category.MarkReadAfterDays,
+		ImageDisplay:      category.ImageDisplay,
+		NSFW:              category.NSFW,
+		Version:           category.Version,
 	}
 
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("imageDisplays", model.ImageDisplays())
 	view.Set("menu", "categories")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	html.OK(w, r, view.Render("edit_category"))
 }
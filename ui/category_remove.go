@@ -13,14 +13,14 @@ import (
 )
 
 func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	categoryID := request.RouteInt64Param(r, "categoryID")
-	category, err := h.store.Category(request.UserID(r), categoryID)
+	category, err := h.store.Category(r.Context(), request.UserID(r), categoryID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -31,7 +31,7 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.RemoveCategory(user.ID, category.ID); err != nil {
+	if err := h.store.RemoveCategory(r.Context(), user.ID, category.ID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
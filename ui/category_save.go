@@ -18,7 +18,7 @@ import (
 )
 
 func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -26,13 +26,13 @@ func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
 
 	categoryForm := form.NewCategoryForm(r)
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", categoryForm)
 	view.Set("menu", "categories")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	if err := categoryForm.Validate(); err != nil {
 		view.Set("errorMessage", err.Error())
@@ -40,7 +40,7 @@ func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	duplicateCategory, err := h.store.CategoryByTitle(user.ID, categoryForm.Title)
+	duplicateCategory, err := h.store.CategoryByTitle(r.Context(), user.ID, categoryForm.Title)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -53,11 +53,12 @@ func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	category := model.Category{
-		Title:  categoryForm.Title,
-		UserID: user.ID,
+		Title:             categoryForm.Title,
+		UserID:            user.ID,
+		MarkReadAfterDays: categoryForm.MarkReadAfterDays,
 	}
 
-	if err = h.store.CreateCategory(&category); err != nil {
+	if err = h.store.CreateCategory(r.Context(), &category); err != nil {
 		logger.Error("[UI:SaveCategory] %v", err)
 		view.Set("errorMessage", "error.unable_to_create_category")
 		html.OK(w, r, view.Render("create_category"))
@@ -0,0 +1,156 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showCategorySharesPage(w http.ResponseWriter, r *http.Request) {
+	h.renderCategoryShares(w, r, "")
+}
+
+func (h *handler) shareCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	username := strings.TrimSpace(r.FormValue("username"))
+	grantee, err := h.store.UserByUsername(r.Context(), username)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if grantee == nil || grantee.ID == userID {
+		h.renderCategoryShares(w, r, "error.category_share_user_not_found")
+		return
+	}
+
+	if err := h.store.ShareCategory(r.Context(), userID, categoryID, grantee.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "categoryShares", "categoryID", categoryID))
+}
+
+func (h *handler) unshareCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(r.Context(), userID, categoryID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.UnshareCategory(r.Context(), userID, categoryID, request.RouteInt64Param(r, "userID")); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "categoryShares", "categoryID", categoryID))
+}
+
+func (h *handler) renderCategoryShares(w http.ResponseWriter, r *http.Request, errorMessage string) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	category, err := h.store.Category(r.Context(), user.ID, request.RouteInt64Param(r, "categoryID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	shares, err := h.store.CategoryShares(r.Context(), user.ID, category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("category", category)
+	view.Set("shares", shares)
+	view.Set("errorMessage", errorMessage)
+	view.Set("menu", "categories")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("category_shares"))
+}
+
+// showSharedCategoryEntriesPage shows the entries of a category shared by another user, they cannot be changed.
+func (h *handler) showSharedCategoryEntriesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	shared, err := h.store.SharedCategory(r.Context(), user.ID, request.RouteInt64Param(r, "categoryID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if shared == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	offset := request.QueryIntParam(r, "offset", 0)
+	builder := h.store.NewEntryQueryBuilder(shared.Category.UserID)
+	builder.WithCategoryID(shared.Category.ID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(user.EntryDirection)
+	builder.WithOffset(offset)
+	builder.WithLimit(user.EntriesPerPage)
+
+	entries, err := builder.GetEntries(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	count, err := builder.CountEntries(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("sharedCategory", shared)
+	view.Set("total", count)
+	view.Set("entries", entries)
+	view.Set("pagination", getPagination(route.Path(h.router, "sharedCategoryEntries", "categoryID", shared.Category.ID), count, offset, user.EntriesPerPage))
+	view.Set("menu", "categories")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("shared_category_entries"))
+}
@@ -11,20 +11,22 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
 
 func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	categoryID := request.RouteInt64Param(r, "categoryID")
-	category, err := h.store.Category(request.UserID(r), categoryID)
+	category, err := h.store.Category(r.Context(), request.UserID(r), categoryID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -37,14 +39,15 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 
 	categoryForm := form.NewCategoryForm(r)
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("imageDisplays", model.ImageDisplays())
 	view.Set("menu", "categories")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	if err := categoryForm.Validate(); err != nil {
 		view.Set("errorMessage", err.Error())
@@ -52,13 +55,22 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if h.store.AnotherCategoryExists(user.ID, category.ID, categoryForm.Title) {
+	if h.store.AnotherCategoryExists(r.Context(), user.ID, category.ID, categoryForm.Title) {
 		view.Set("errorMessage", "error.category_already_exists")
 		html.OK(w, r, view.Render("edit_category"))
 		return
 	}
 
-	err = h.store.UpdateCategory(categoryForm.Merge(category))
+	currentVersion := category.Version
+	err = h.store.UpdateCategory(r.Context(), categoryForm.Merge(category))
+	if err == storage.ErrConflict {
+		// Submitting the form again overwrites the changes made in the meantime.
+		categoryForm.Version = currentVersion
+		view.Set("errorMessage", "error.category_changed")
+		html.OK(w, r, view.Render("edit_category"))
+		return
+	}
+
 	if err != nil {
 		logger.Error("[UI:UpdateCategory] %v", err)
 		view.Set("errorMessage", "error.unable_to_update_category")
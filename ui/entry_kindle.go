@@ -0,0 +1,55 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/mediaproxy"
+	"miniflux.app/model"
+)
+
+func (h *handler) sendEntryToKindle(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	settings, err := h.store.Integration(r.Context(), request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !h.cfg.HasMailer() || !settings.KindleEnabled {
+		json.Forbidden(w, r)
+		return
+	}
+
+	language := request.UserLanguage(r)
+	loader := mediaproxy.Loader(h.store.BlobStore())
+	go func() {
+		if err := integration.SendToKindle(h.cfg, entry, settings, language, loader); err != nil {
+			logger.Error("[UI:SendToKindle] UserID #%d: %v", settings.UserID, err)
+		}
+	}()
+
+	json.Created(w, r, map[string]string{"message": "sent"})
+}
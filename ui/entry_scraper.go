@@ -5,10 +5,12 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"context"
 	"net/http"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/processor"
 )
@@ -19,7 +21,7 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 	builder.WithEntryID(entryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
 
-	entry, err := builder.GetEntry()
+	entry, err := builder.GetEntry(r.Context())
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -35,7 +37,30 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.store.UpdateEntryContent(entry)
+	h.store.UpdateEntryContent(r.Context(), entry)
 
 	json.OK(w, r, map[string]string{"content": entry.Content})
 }
+
+// fetchFullContent replaces the content of an unread entry by the scraped web page when its feed
+// is configured to open the full content. Feeds using the crawler already store the scraped page.
+// On failure the original feed content is kept.
+func (h *handler) fetchFullContent(ctx context.Context, entry *model.Entry) {
+	if entry.Feed.EntryOpenMode != model.EntryOpenModeFullContent || entry.Feed.Crawler || entry.Status != model.EntryStatusUnread {
+		return
+	}
+
+	if err := processor.ProcessEntryWebPage(entry); err != nil {
+		logger.Error("[UI:FetchFullContent] entryID=%d: %v", entry.ID, err)
+		return
+	}
+
+	// The content is only displayed on read-only instances.
+	if h.cfg.IsReadOnly() {
+		return
+	}
+
+	if err := h.store.UpdateEntryContent(ctx, entry); err != nil {
+		logger.Error("[UI:FetchFullContent] entryID=%d: %v", entry.ID, err)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/processor"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showEntrySnapshot(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	snapshot, err := h.store.EntrySnapshot(r.Context(), user.ID, entry.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("entry", entry)
+	view.Set("snapshot", snapshot)
+	view.Set("menu", "starred")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("entry_snapshot"))
+}
+
+// refreshEntrySnapshot saves a new copy of the entry web page, the previous copy is kept when the page cannot be downloaded.
+func (h *handler) refreshEntrySnapshot(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	snapshot, fetchErr := processor.SnapshotEntryWebPage(entry)
+	if err := h.store.SaveEntrySnapshot(r.Context(), snapshot); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if fetchErr != nil {
+		logger.Error("[UI:RefreshEntrySnapshot] entryID=%d: %v", entry.ID, fetchErr)
+		sess.NewFlashErrorMessage(printer.Printf("error.entry_snapshot_failed"))
+	} else {
+		sess.NewFlashMessage(printer.Printf("alert.entry_snapshot_saved"))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "entrySnapshot", "entryID", entry.ID))
+}
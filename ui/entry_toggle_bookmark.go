@@ -13,7 +13,7 @@ import (
 
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
-	if err := h.store.ToggleBookmark(request.UserID(r), entryID); err != nil {
+	if err := h.store.ToggleBookmark(r.Context(), request.UserID(r), entryID); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -24,7 +24,7 @@ func (h *handler) updateEntriesStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.store.SetEntriesStatus(request.UserID(r), entryIDs, status)
+	err = h.store.SetEntriesStatus(r.Context(), request.UserID(r), entryIDs, status)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
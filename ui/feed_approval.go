@@ -0,0 +1,113 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showPendingFeedsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	feeds, err := h.store.PendingFeeds(r.Context())
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("pendingFeeds", feeds)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("pending_feeds"))
+}
+
+func (h *handler) approveFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID, err := h.store.ApproveFeed(r.Context(), feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if userID == 0 {
+		html.NotFound(w, r)
+		return
+	}
+
+	h.pool.Push(model.JobList{{UserID: userID, FeedID: feedID}})
+	html.Redirect(w, r, route.Path(h.router, "pendingFeeds"))
+}
+
+func (h *handler) rejectFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	userID, err := h.store.RejectFeed(r.Context(), request.RouteInt64Param(r, "feedID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if userID == 0 {
+		html.NotFound(w, r)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "pendingFeeds"))
+}
+
+// redirectToNewFeed shows the entries of a new feed, or the list of feeds when the feed waits for an approval.
+func (h *handler) redirectToNewFeed(w http.ResponseWriter, r *http.Request, feed *model.Feed) {
+	if !feed.Pending {
+		html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+		return
+	}
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	sess.NewFlashMessage(printer.Printf("alert.feed_pending"))
+	html.Redirect(w, r, route.Path(h.router, "feeds"))
+}
@@ -10,20 +10,21 @@ import (
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
 
 func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	feedID := request.RouteInt64Param(r, "feedID")
-	feed, err := h.store.FeedByID(user.ID, feedID)
+	feed, err := h.store.FeedByID(r.Context(), user.ID, feedID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -34,34 +35,59 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	categories, err := h.store.Categories(user.ID)
+	categories, err := h.store.Categories(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	feedForm := form.FeedForm{
-		SiteURL:      feed.SiteURL,
-		FeedURL:      feed.FeedURL,
-		Title:        feed.Title,
-		ScraperRules: feed.ScraperRules,
-		RewriteRules: feed.RewriteRules,
-		Crawler:      feed.Crawler,
-		UserAgent:    feed.UserAgent,
-		CategoryID:   feed.Category.ID,
-		Username:     feed.Username,
-		Password:     feed.Password,
+		SiteURL:         feed.SiteURL,
+		FeedURL:         feed.FeedURL,
+		Title:           feed.Title,
+		ScraperRules:    feed.ScraperRules,
+		RewriteRules:    feed.RewriteRules,
+		Script:          feed.Script,
+		Crawler:         feed.Crawler,
+		ScraperMaxPages: feed.ScraperMaxPages,
+		EntryOpenMode:   feed.EntryOpenMode,
+		ImageDisplay:    feed.ImageDisplay,
+		SummaryWords:    feed.SummaryWords,
+		Summarize:       feed.SummarizeEntries,
+		NSFW:            feed.NSFW,
+		Priority:        feed.Priority,
+		MarkReadAfter:   feed.MarkReadAfterDays,
+		MaxEntries:      feed.MaxEntries,
+		OverflowPolicy:  feed.OverflowPolicy,
+		EntryMatching:   feed.EntryMatching,
+		WatchSelector:   feed.WatchSelector,
+		UserAgent:       feed.UserAgent,
+		CategoryID:      feed.Category.ID,
+		Username:        feed.Username,
+		Password:        feed.Password,
+		Version:         feed.Version,
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	if feed.IsMuted() {
+		feedForm.MutedUntil = feed.MutedUntil.Format("2006-01-02")
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
+	view.Set("entryOpenModes", model.EntryOpenModes())
+	view.Set("imageDisplays", model.ImageDisplays())
+	view.Set("feedPriorities", model.FeedPriorities())
+	view.Set("overflowPolicies", model.OverflowPolicies())
+	view.Set("maxScraperPages", model.MaxScraperPages)
+	view.Set("maxSummaryWords", model.MaxSummaryWords)
+	view.Set("entryMatchings", model.EntryMatchings())
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 	view.Set("defaultUserAgent", client.DefaultUserAgent)
 
 	html.OK(w, r, view.Render("edit_feed"))
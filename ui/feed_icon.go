@@ -15,7 +15,7 @@ import (
 
 func (h *handler) showIcon(w http.ResponseWriter, r *http.Request) {
 	iconID := request.RouteInt64Param(r, "iconID")
-	icon, err := h.store.IconByID(iconID)
+	icon, err := h.store.IconByID(r.Context(), iconID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// showLeastReadFeedsPage lists the feeds without any entry read for a few months,
+// they are good candidates to unsubscribe from or to mute.
+func (h *handler) showLeastReadFeedsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	months := request.QueryIntParam(r, "months", model.DefaultLeastReadMonths)
+	if model.ValidateLeastReadMonths(months) != nil {
+		months = model.DefaultLeastReadMonths
+	}
+
+	feeds, err := h.store.LeastReadFeeds(r.Context(), user.ID, time.Now().AddDate(0, -months, 0))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("feeds", feeds)
+	view.Set("total", len(feeds))
+	view.Set("months", months)
+	view.Set("periods", []int{1, 3, 6, 12, model.MaxLeastReadMonths})
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("least_read_feeds"))
+}
@@ -14,26 +14,34 @@ import (
 )
 
 func (h *handler) showFeedsPage(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	feeds, err := h.store.Feeds(user.ID)
+	feeds, err := h.store.Feeds(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	categories, err := h.store.Categories(r.Context(), user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("feeds", feeds)
 	view.Set("total", len(feeds))
+	view.Set("categories", categories)
+	view.Set("hasSubscriptionApproval", h.cfg.HasSubscriptionApproval())
 	view.Set("menu", "feeds")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	html.OK(w, r, view.Render("feeds"))
 }
@@ -0,0 +1,37 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+)
+
+// muteFeed mutes a feed for one month, it is the quick action of the least read feeds page.
+func (h *handler) muteFeed(w http.ResponseWriter, r *http.Request) {
+	feed, err := h.store.FeedByID(r.Context(), request.UserID(r), request.RouteInt64Param(r, "feedID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	mutedUntil := time.Now().AddDate(0, 1, 0)
+	feed.MutedUntil = &mutedUntil
+	if err := h.store.UpdateFeed(r.Context(), feed); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "leastReadFeeds"))
+}
@@ -15,7 +15,7 @@ import (
 
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	if err := h.feedHandler.RefreshFeed(request.UserID(r), feedID); err != nil {
+	if err := h.feedHandler.RefreshFeed(r.Context(), request.UserID(r), feedID); err != nil {
 		logger.Error("[UI:RefreshFeed] %v", err)
 	}
 
@@ -24,7 +24,7 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
-	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
+	jobs, err := h.store.NewUserBatch(r.Context(), userID, h.store.CountFeeds(r.Context(), userID))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
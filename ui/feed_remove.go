@@ -14,7 +14,7 @@ import (
 
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	if err := h.store.RemoveFeed(request.UserID(r), feedID); err != nil {
+	if err := h.store.RemoveFeed(r.Context(), request.UserID(r), feedID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
@@ -12,20 +12,22 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
 
 func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	feedID := request.RouteInt64Param(r, "feedID")
-	feed, err := h.store.FeedByID(user.ID, feedID)
+	feed, err := h.store.FeedByID(r.Context(), user.ID, feedID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -36,7 +38,7 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	categories, err := h.store.Categories(user.ID)
+	categories, err := h.store.Categories(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -44,24 +46,54 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 
 	feedForm := form.NewFeedForm(r)
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
 	view.Set("categories", categories)
+	view.Set("entryOpenModes", model.EntryOpenModes())
+	view.Set("imageDisplays", model.ImageDisplays())
+	view.Set("feedPriorities", model.FeedPriorities())
+	view.Set("overflowPolicies", model.OverflowPolicies())
+	view.Set("maxScraperPages", model.MaxScraperPages)
+	view.Set("maxSummaryWords", model.MaxSummaryWords)
+	view.Set("entryMatchings", model.EntryMatchings())
 	view.Set("feed", feed)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 	view.Set("defaultUserAgent", client.DefaultUserAgent)
 
+	if !user.IsAdmin {
+		// The form of the other users doesn't have the script field, the script runs on the server.
+		if _, found := r.PostForm["script"]; found && feedForm.Script != feed.Script {
+			view.Set("errorMessage", "error.feed_script_not_allowed")
+			html.OK(w, r, view.Render("edit_feed"))
+			return
+		}
+
+		feedForm.Script = feed.Script
+	}
+
 	if err := feedForm.ValidateModification(); err != nil {
 		view.Set("errorMessage", err.Error())
 		html.OK(w, r, view.Render("edit_feed"))
 		return
 	}
 
-	err = h.store.UpdateFeed(feedForm.Merge(feed))
+	currentVersion := feed.Version
+	feed = feedForm.Merge(feed)
+	feed.MutedUntil = feedForm.MutedUntilTime(user.Timezone)
+
+	err = h.store.UpdateFeed(r.Context(), feed)
+	if err == storage.ErrConflict {
+		// Submitting the form again overwrites the changes made in the meantime.
+		feedForm.Version = currentVersion
+		view.Set("errorMessage", "error.feed_changed")
+		html.OK(w, r, view.Render("edit_feed"))
+		return
+	}
+
 	if err != nil {
 		logger.Error("[UI:UpdateFeed] %v", err)
 		view.Set("errorMessage", "error.unable_to_update_feed")
@@ -6,6 +6,7 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
@@ -13,7 +14,11 @@ import (
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title string
+	Title             string
+	MarkReadAfterDays int
+	ImageDisplay      string
+	NSFW              bool
+	Version           int
 }
 
 // Validate makes sure the form values are valid.
@@ -21,18 +26,44 @@ func (c CategoryForm) Validate() error {
 	if c.Title == "" {
 		return errors.NewLocalizedError("error.title_required")
 	}
+
+	if c.MarkReadAfterDays < 0 {
+		return errors.NewLocalizedError("error.mark_read_after_days_invalid")
+	}
+
+	if model.ValidateImageDisplay(c.ImageDisplay) != nil {
+		return errors.NewLocalizedError("error.image_display_invalid")
+	}
 	return nil
 }
 
 // Merge update the given category fields.
 func (c CategoryForm) Merge(category *model.Category) *model.Category {
 	category.Title = c.Title
+	category.MarkReadAfterDays = c.MarkReadAfterDays
+	category.ImageDisplay = c.ImageDisplay
+	category.NSFW = c.NSFW
+	category.Version = c.Version
 	return category
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
+	markReadAfterDays, err := strconv.Atoi(r.FormValue("mark_read_after_days"))
+	if err != nil {
+		markReadAfterDays = 0
+	}
+
+	version, err := strconv.Atoi(r.FormValue("version"))
+	if err != nil {
+		version = 0
+	}
+
 	return &CategoryForm{
-		Title: r.FormValue("title"),
+		Title:             r.FormValue("title"),
+		MarkReadAfterDays: markReadAfterDays,
+		ImageDisplay:      r.FormValue("image_display"),
+		NSFW:              r.FormValue("nsfw") == "1",
+		Version:           version,
 	}
 }
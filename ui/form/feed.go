@@ -7,23 +7,44 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/reader/script"
+	"miniflux.app/timezone"
 )
 
+// mutedUntilLayout is the format of the date input used to mute a feed.
+const mutedUntilLayout = "2006-01-02"
+
 // FeedForm represents a feed form in the UI
 type FeedForm struct {
-	FeedURL      string
-	SiteURL      string
-	Title        string
-	ScraperRules string
-	RewriteRules string
-	Crawler      bool
-	UserAgent    string
-	CategoryID   int64
-	Username     string
-	Password     string
+	FeedURL         string
+	SiteURL         string
+	Title           string
+	ScraperRules    string
+	RewriteRules    string
+	Script          string
+	Crawler         bool
+	ScraperMaxPages int
+	EntryOpenMode   string
+	ImageDisplay    string
+	SummaryWords    int
+	Summarize       bool
+	NSFW            bool
+	Priority        string
+	MutedUntil      string
+	MarkReadAfter   int
+	MaxEntries      int
+	OverflowPolicy  string
+	EntryMatching   string
+	WatchSelector   string
+	UserAgent       string
+	CategoryID      int64
+	Username        string
+	Password        string
+	Version         int
 }
 
 // ValidateModification validates FeedForm fields
@@ -31,6 +52,49 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
+
+	if err := script.Validate(f.Script); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_script", err)
+	}
+
+	if err := model.ValidateEntryOpenMode(f.EntryOpenMode); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_entry_open_mode")
+	}
+
+	if err := model.ValidateImageDisplay(f.ImageDisplay); err != nil {
+		return errors.NewLocalizedError("error.image_display_invalid")
+	}
+
+	if err := model.ValidateFeedPriority(f.Priority); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_priority")
+	}
+
+	if f.MarkReadAfter < 0 {
+		return errors.NewLocalizedError("error.mark_read_after_days_invalid")
+	}
+
+	if err := model.ValidateEntryLimit(f.MaxEntries, f.OverflowPolicy); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_entry_limit")
+	}
+
+	if err := model.ValidateScraperMaxPages(f.ScraperMaxPages); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_scraper_max_pages", model.MaxScraperPages)
+	}
+
+	if err := model.ValidateSummaryWords(f.SummaryWords); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_summary_words", model.MaxSummaryWords)
+	}
+
+	if err := model.ValidateEntryMatching(f.EntryMatching); err != nil {
+		return errors.NewLocalizedError("error.feed_invalid_entry_matching")
+	}
+
+	if f.MutedUntil != "" {
+		if _, err := time.Parse(mutedUntilLayout, f.MutedUntil); err != nil {
+			return errors.NewLocalizedError("error.feed_invalid_muted_until")
+		}
+	}
+
 	return nil
 }
 
@@ -42,15 +106,46 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.FeedURL = f.FeedURL
 	feed.ScraperRules = f.ScraperRules
 	feed.RewriteRules = f.RewriteRules
+	feed.Script = f.Script
 	feed.Crawler = f.Crawler
+	feed.ScraperMaxPages = f.ScraperMaxPages
+	feed.EntryOpenMode = f.EntryOpenMode
+	feed.ImageDisplay = f.ImageDisplay
+	feed.SummaryWords = f.SummaryWords
+	feed.SummarizeEntries = f.Summarize
+	feed.NSFW = f.NSFW
+	feed.Priority = f.Priority
+	feed.MarkReadAfterDays = f.MarkReadAfter
+	feed.MaxEntries = f.MaxEntries
+	feed.OverflowPolicy = f.OverflowPolicy
+	feed.EntryMatching = f.EntryMatching
+	if feed.IsPageWatch() && f.WatchSelector != "" {
+		feed.WatchSelector = f.WatchSelector
+	}
 	feed.UserAgent = f.UserAgent
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
 	feed.Username = f.Username
 	feed.Password = f.Password
+	feed.Version = f.Version
 	return feed
 }
 
+// MutedUntilTime returns the start of the selected day in the given timezone,
+// nil is returned when the feed must not be muted.
+func (f FeedForm) MutedUntilTime(tz string) *time.Time {
+	if f.MutedUntil == "" {
+		return nil
+	}
+
+	mutedUntil, err := timezone.Parse(tz, mutedUntilLayout, f.MutedUntil)
+	if err != nil || !mutedUntil.After(time.Now()) {
+		return nil
+	}
+
+	return &mutedUntil
+}
+
 // NewFeedForm parses the HTTP request and returns a FeedForm
 func NewFeedForm(r *http.Request) *FeedForm {
 	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
@@ -58,16 +153,56 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		categoryID = 0
 	}
 
+	markReadAfter, err := strconv.Atoi(r.FormValue("mark_read_after_days"))
+	if err != nil {
+		markReadAfter = 0
+	}
+
+	maxEntries, err := strconv.Atoi(r.FormValue("max_entries"))
+	if err != nil {
+		maxEntries = 0
+	}
+
+	scraperMaxPages, err := strconv.Atoi(r.FormValue("scraper_max_pages"))
+	if err != nil {
+		scraperMaxPages = 1
+	}
+
+	summaryWords, err := strconv.Atoi(r.FormValue("summary_words"))
+	if err != nil {
+		summaryWords = 0
+	}
+
+	version, err := strconv.Atoi(r.FormValue("version"))
+	if err != nil {
+		version = 0
+	}
+
 	return &FeedForm{
-		FeedURL:      r.FormValue("feed_url"),
-		SiteURL:      r.FormValue("site_url"),
-		Title:        r.FormValue("title"),
-		ScraperRules: r.FormValue("scraper_rules"),
-		UserAgent:    r.FormValue("user_agent"),
-		RewriteRules: r.FormValue("rewrite_rules"),
-		Crawler:      r.FormValue("crawler") == "1",
-		CategoryID:   int64(categoryID),
-		Username:     r.FormValue("feed_username"),
-		Password:     r.FormValue("feed_password"),
+		FeedURL:         r.FormValue("feed_url"),
+		SiteURL:         r.FormValue("site_url"),
+		Title:           r.FormValue("title"),
+		ScraperRules:    r.FormValue("scraper_rules"),
+		UserAgent:       r.FormValue("user_agent"),
+		RewriteRules:    r.FormValue("rewrite_rules"),
+		Script:          r.FormValue("script"),
+		Crawler:         r.FormValue("crawler") == "1",
+		ScraperMaxPages: scraperMaxPages,
+		EntryOpenMode:   r.FormValue("entry_open_mode"),
+		ImageDisplay:    r.FormValue("image_display"),
+		SummaryWords:    summaryWords,
+		Summarize:       r.FormValue("summarize_entries") == "1",
+		NSFW:            r.FormValue("nsfw") == "1",
+		Priority:        r.FormValue("priority"),
+		MutedUntil:      r.FormValue("muted_until"),
+		MarkReadAfter:   markReadAfter,
+		MaxEntries:      maxEntries,
+		OverflowPolicy:  r.FormValue("overflow_policy"),
+		EntryMatching:   r.FormValue("entry_matching"),
+		WatchSelector:   r.FormValue("watch_selector"),
+		CategoryID:      int64(categoryID),
+		Username:        r.FormValue("feed_username"),
+		Password:        r.FormValue("feed_password"),
+		Version:         version,
 	}
 }
@@ -6,6 +6,7 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/model"
 )
@@ -34,6 +35,26 @@ type IntegrationForm struct {
 	PocketEnabled        bool
 	PocketAccessToken    string
 	PocketConsumerKey    string
+	NtfyEnabled          bool
+	NtfyURL              string
+	NtfyTopic            string
+	NtfyToken            string
+	PushoverEnabled      bool
+	PushoverToken        string
+	PushoverUser         string
+	AppriseEnabled       bool
+	AppriseURL           string
+	AppriseServiceURLs   string
+	KindleEnabled        bool
+	KindleEmail          string
+	ReadeckEnabled       bool
+	ReadeckURL           string
+	ReadeckAPIKey        string
+	ReadeckLabels        string
+	OmnivoreEnabled      bool
+	OmnivoreURL          string
+	OmnivoreAPIKey       string
+	Filters              model.IntegrationFilters
 }
 
 // Merge copy form values to the model.
@@ -60,6 +81,26 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.PocketEnabled = i.PocketEnabled
 	integration.PocketAccessToken = i.PocketAccessToken
 	integration.PocketConsumerKey = i.PocketConsumerKey
+	integration.NtfyEnabled = i.NtfyEnabled
+	integration.NtfyURL = i.NtfyURL
+	integration.NtfyTopic = i.NtfyTopic
+	integration.NtfyToken = i.NtfyToken
+	integration.PushoverEnabled = i.PushoverEnabled
+	integration.PushoverToken = i.PushoverToken
+	integration.PushoverUser = i.PushoverUser
+	integration.AppriseEnabled = i.AppriseEnabled
+	integration.AppriseURL = i.AppriseURL
+	integration.AppriseServiceURLs = i.AppriseServiceURLs
+	integration.KindleEnabled = i.KindleEnabled
+	integration.KindleEmail = i.KindleEmail
+	integration.ReadeckEnabled = i.ReadeckEnabled
+	integration.ReadeckURL = i.ReadeckURL
+	integration.ReadeckAPIKey = i.ReadeckAPIKey
+	integration.ReadeckLabels = i.ReadeckLabels
+	integration.OmnivoreEnabled = i.OmnivoreEnabled
+	integration.OmnivoreURL = i.OmnivoreURL
+	integration.OmnivoreAPIKey = i.OmnivoreAPIKey
+	integration.Filters = i.Filters
 }
 
 // NewIntegrationForm returns a new AuthForm.
@@ -87,5 +128,48 @@ func NewIntegrationForm(r *http.Request) *IntegrationForm {
 		PocketEnabled:        r.FormValue("pocket_enabled") == "1",
 		PocketAccessToken:    r.FormValue("pocket_access_token"),
 		PocketConsumerKey:    r.FormValue("pocket_consumer_key"),
+		NtfyEnabled:          r.FormValue("ntfy_enabled") == "1",
+		NtfyURL:              r.FormValue("ntfy_url"),
+		NtfyTopic:            r.FormValue("ntfy_topic"),
+		NtfyToken:            r.FormValue("ntfy_token"),
+		PushoverEnabled:      r.FormValue("pushover_enabled") == "1",
+		PushoverToken:        r.FormValue("pushover_token"),
+		PushoverUser:         r.FormValue("pushover_user"),
+		AppriseEnabled:       r.FormValue("apprise_enabled") == "1",
+		AppriseURL:           r.FormValue("apprise_url"),
+		AppriseServiceURLs:   r.FormValue("apprise_service_urls"),
+		KindleEnabled:        r.FormValue("kindle_enabled") == "1",
+		KindleEmail:          r.FormValue("kindle_email"),
+		ReadeckEnabled:       r.FormValue("readeck_enabled") == "1",
+		ReadeckURL:           r.FormValue("readeck_url"),
+		ReadeckAPIKey:        r.FormValue("readeck_api_key"),
+		ReadeckLabels:        r.FormValue("readeck_labels"),
+		OmnivoreEnabled:      r.FormValue("omnivore_enabled") == "1",
+		OmnivoreURL:          r.FormValue("omnivore_url"),
+		OmnivoreAPIKey:       r.FormValue("omnivore_api_key"),
+		Filters:              newIntegrationFilters(r),
 	}
 }
+
+// newIntegrationFilters reads the events and the categories selected for each integration.
+func newIntegrationFilters(r *http.Request) model.IntegrationFilters {
+	r.ParseForm()
+
+	var services []string
+	services = append(services, model.BookmarkServices()...)
+	services = append(services, model.NotificationServices()...)
+
+	filters := make(model.IntegrationFilters, len(services))
+	for _, service := range services {
+		filter := &model.IntegrationFilter{Events: r.Form[service+"_events"]}
+		for _, value := range r.Form[service+"_categories"] {
+			if categoryID, err := strconv.ParseInt(value, 10, 64); err == nil {
+				filter.CategoryIDs = append(filter.CategoryIDs, categoryID)
+			}
+		}
+
+		filters[service] = filter
+	}
+
+	return filters
+}
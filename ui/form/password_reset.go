@@ -0,0 +1,42 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+
+	"miniflux.app/errors"
+)
+
+// PasswordResetForm represents the form choosing a new password.
+type PasswordResetForm struct {
+	Password     string
+	Confirmation string
+}
+
+// Validate makes sure the form values are valid.
+func (p PasswordResetForm) Validate() error {
+	if p.Password == "" || p.Confirmation == "" {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if p.Password != p.Confirmation {
+		return errors.NewLocalizedError("error.different_passwords")
+	}
+
+	if len(p.Password) < 6 {
+		return errors.NewLocalizedError("error.password_min_length")
+	}
+
+	return nil
+}
+
+// NewPasswordResetForm returns a new PasswordResetForm.
+func NewPasswordResetForm(r *http.Request) *PasswordResetForm {
+	return &PasswordResetForm{
+		Password:     r.FormValue("password"),
+		Confirmation: r.FormValue("confirmation"),
+	}
+}
@@ -6,6 +6,8 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
@@ -13,22 +15,43 @@ import (
 
 // SettingsForm represents the settings form.
 type SettingsForm struct {
-	Username       string
-	Password       string
-	Confirmation   string
-	Theme          string
-	Language       string
-	Timezone       string
-	EntryDirection string
+	Username         string
+	Email            string
+	Password         string
+	Confirmation     string
+	Theme            string
+	Language         string
+	Timezone         string
+	EntryDirection   string
+	EntriesPerPage   int
+	ShowReadEntries  bool
+	ShowAbsoluteTime bool
+	ImageDisplay     string
+	NSFWDisplay      string
+	JunkThreshold    int
+	QuietHoursStart  string
+	QuietHoursEnd    string
 }
 
 // Merge updates the fields of the given user.
 func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.Username = s.Username
+	user.Email = s.Email
 	user.Theme = s.Theme
 	user.Language = s.Language
 	user.Timezone = s.Timezone
 	user.EntryDirection = s.EntryDirection
+	user.ShowReadEntries = s.ShowReadEntries
+	user.ShowAbsoluteTime = s.ShowAbsoluteTime
+	user.ImageDisplay = s.ImageDisplay
+	user.NSFWDisplay = s.NSFWDisplay
+	user.JunkThreshold = s.JunkThreshold
+	user.QuietHoursStart = s.QuietHoursStart
+	user.QuietHoursEnd = s.QuietHoursEnd
+
+	if s.EntriesPerPage != 0 {
+		user.EntriesPerPage = s.EntriesPerPage
+	}
 
 	if s.Password != "" {
 		user.Password = s.Password
@@ -43,6 +66,30 @@ func (s *SettingsForm) Validate() error {
 		return errors.NewLocalizedError("error.settings_mandatory_fields")
 	}
 
+	if s.EntriesPerPage != 0 && model.ValidateEntriesPerPage(s.EntriesPerPage) != nil {
+		return errors.NewLocalizedError("error.entries_per_page_invalid", model.MaxEntriesPerPage)
+	}
+
+	if s.Email != "" && model.ValidateEmail(s.Email) != nil {
+		return errors.NewLocalizedError("error.invalid_email")
+	}
+
+	if model.ValidateImageDisplay(s.ImageDisplay) != nil {
+		return errors.NewLocalizedError("error.image_display_invalid")
+	}
+
+	if model.ValidateNSFWDisplay(s.NSFWDisplay) != nil {
+		return errors.NewLocalizedError("error.nsfw_display_invalid")
+	}
+
+	if model.ValidateJunkThreshold(s.JunkThreshold) != nil {
+		return errors.NewLocalizedError("error.junk_threshold_invalid", model.MaxJunkScore)
+	}
+
+	if model.ValidateQuietHours(s.QuietHoursStart, s.QuietHoursEnd) != nil {
+		return errors.NewLocalizedError("error.quiet_hours_invalid")
+	}
+
 	if s.Confirmation == "" {
 		// Firefox insists on auto-completing the password field.
 		// If the confirmation field is blank, the user probably
@@ -63,13 +110,32 @@ func (s *SettingsForm) Validate() error {
 
 // NewSettingsForm returns a new SettingsForm.
 func NewSettingsForm(r *http.Request) *SettingsForm {
+	entriesPerPage, err := strconv.Atoi(r.FormValue("entries_per_page"))
+	if err != nil {
+		entriesPerPage = -1
+	}
+
+	junkThreshold, err := strconv.Atoi(r.FormValue("junk_threshold"))
+	if err != nil {
+		junkThreshold = 0
+	}
+
 	return &SettingsForm{
-		Username:       r.FormValue("username"),
-		Password:       r.FormValue("password"),
-		Confirmation:   r.FormValue("confirmation"),
-		Theme:          r.FormValue("theme"),
-		Language:       r.FormValue("language"),
-		Timezone:       r.FormValue("timezone"),
-		EntryDirection: r.FormValue("entry_direction"),
+		Username:         r.FormValue("username"),
+		Email:            strings.TrimSpace(r.FormValue("email")),
+		Password:         r.FormValue("password"),
+		Confirmation:     r.FormValue("confirmation"),
+		Theme:            r.FormValue("theme"),
+		Language:         r.FormValue("language"),
+		Timezone:         r.FormValue("timezone"),
+		EntryDirection:   r.FormValue("entry_direction"),
+		EntriesPerPage:   entriesPerPage,
+		ShowReadEntries:  r.FormValue("show_read_entries") == "1",
+		ShowAbsoluteTime: r.FormValue("show_absolute_time") == "1",
+		ImageDisplay:     r.FormValue("image_display"),
+		NSFWDisplay:      r.FormValue("nsfw_display"),
+		JunkThreshold:    junkThreshold,
+		QuietHoursStart:  strings.TrimSpace(r.FormValue("quiet_hours_start")),
+		QuietHoursEnd:    strings.TrimSpace(r.FormValue("quiet_hours_end")),
 	}
 }
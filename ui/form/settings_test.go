@@ -58,3 +58,67 @@ func TestConfirmationIncorrect(t *testing.T) {
 		t.Error("Validate should return an error")
 	}
 }
+
+func TestEntriesPerPageOutOfRange(t *testing.T) {
+	settings := &SettingsForm{
+		Username:       "user",
+		Theme:          "default",
+		Language:       "en_US",
+		Timezone:       "UTC",
+		EntryDirection: "asc",
+		EntriesPerPage: -1,
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestInvalidQuietHours(t *testing.T) {
+	settings := &SettingsForm{
+		Username:        "user",
+		Theme:           "default",
+		Language:        "en_US",
+		Timezone:        "UTC",
+		EntryDirection:  "asc",
+		QuietHoursStart: "22:00",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestInvalidJunkThreshold(t *testing.T) {
+	settings := &SettingsForm{
+		Username:       "user",
+		Theme:          "default",
+		Language:       "en_US",
+		Timezone:       "UTC",
+		EntryDirection: "asc",
+		JunkThreshold:  101,
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestInvalidNSFWDisplay(t *testing.T) {
+	settings := &SettingsForm{
+		Username:       "user",
+		Theme:          "default",
+		Language:       "en_US",
+		Timezone:       "UTC",
+		EntryDirection: "asc",
+		NSFWDisplay:    "invalid",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// SignupForm represents the signup form.
+type SignupForm struct {
+	Username     string
+	Email        string
+	Password     string
+	Confirmation string
+	Invitation   string
+}
+
+// Validate makes sure the form values are valid, the email domain must be in the list when it is not empty.
+func (s SignupForm) Validate(allowedDomains []string) error {
+	if s.Username == "" || s.Email == "" || s.Password == "" || s.Confirmation == "" {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if model.ValidateEmail(s.Email) != nil {
+		return errors.NewLocalizedError("error.invalid_email")
+	}
+
+	if len(allowedDomains) > 0 && !isAllowedDomain(s.Email, allowedDomains) {
+		return errors.NewLocalizedError("error.email_domain_not_allowed")
+	}
+
+	if s.Password != s.Confirmation {
+		return errors.NewLocalizedError("error.different_passwords")
+	}
+
+	if len(s.Password) < 6 {
+		return errors.NewLocalizedError("error.password_min_length")
+	}
+
+	return nil
+}
+
+// ToUser returns a User from the form values.
+func (s SignupForm) ToUser() *model.User {
+	return &model.User{
+		Username: s.Username,
+		Password: s.Password,
+		Email:    s.Email,
+	}
+}
+
+func isAllowedDomain(email string, domains []string) bool {
+	domain := strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+	for _, allowed := range domains {
+		if domain == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSignupForm returns a new SignupForm.
+func NewSignupForm(r *http.Request) *SignupForm {
+	return &SignupForm{
+		Username:     strings.TrimSpace(r.FormValue("username")),
+		Email:        strings.TrimSpace(r.FormValue("email")),
+		Password:     r.FormValue("password"),
+		Confirmation: r.FormValue("confirmation"),
+		Invitation:   strings.TrimSpace(r.FormValue("invitation")),
+	}
+}
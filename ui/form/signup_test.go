@@ -0,0 +1,39 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+
+	"miniflux.app/errors"
+)
+
+func TestSignupFormValidate(t *testing.T) {
+	scenarios := []struct {
+		form     SignupForm
+		domains  []string
+		expected string
+	}{
+		{SignupForm{Username: "user", Email: "user@example.org", Password: "hunter2", Confirmation: "hunter2"}, nil, ""},
+		{SignupForm{Username: "user", Email: "user@Example.org", Password: "hunter2", Confirmation: "hunter2"}, []string{"example.org"}, ""},
+		{SignupForm{Username: "user", Email: "user@example.com", Password: "hunter2", Confirmation: "hunter2"}, []string{"example.org"}, "error.email_domain_not_allowed"},
+		{SignupForm{Username: "user", Email: "user@sub.example.org", Password: "hunter2", Confirmation: "hunter2"}, []string{"example.org"}, "error.email_domain_not_allowed"},
+		{SignupForm{Username: "user", Email: "User <user@example.org>", Password: "hunter2", Confirmation: "hunter2"}, nil, "error.invalid_email"},
+		{SignupForm{Username: "user", Email: "invalid", Password: "hunter2", Confirmation: "hunter2"}, nil, "error.invalid_email"},
+		{SignupForm{Username: "user", Email: "user@example.org", Password: "hunter2", Confirmation: "hunter3"}, nil, "error.different_passwords"},
+		{SignupForm{Username: "user", Email: "user@example.org", Password: "test", Confirmation: "test"}, nil, "error.password_min_length"},
+		{SignupForm{Username: "", Email: "user@example.org", Password: "hunter2", Confirmation: "hunter2"}, nil, "error.fields_mandatory"},
+	}
+
+	for _, scenario := range scenarios {
+		err := scenario.form.Validate(scenario.domains)
+		switch {
+		case scenario.expected == "" && err != nil:
+			t.Errorf(`Unexpected error for %+v: %v`, scenario.form, err)
+		case scenario.expected != "" && (err == nil || err.(*errors.LocalizedError).Error() != scenario.expected):
+			t.Errorf(`Expected %q for %+v, got %v`, scenario.expected, scenario.form, err)
+		}
+	}
+}
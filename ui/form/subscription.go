@@ -7,18 +7,20 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 )
 
 // SubscriptionForm represents the subscription form.
 type SubscriptionForm struct {
-	URL        string
-	CategoryID int64
-	Crawler    bool
-	UserAgent  string
-	Username   string
-	Password   string
+	URL           string
+	CategoryID    int64
+	Crawler       bool
+	UserAgent     string
+	Username      string
+	Password      string
+	WatchSelector string
 }
 
 // Validate makes sure the form values are valid.
@@ -38,11 +40,12 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 	}
 
 	return &SubscriptionForm{
-		URL:        r.FormValue("url"),
-		Crawler:    r.FormValue("crawler") == "1",
-		CategoryID: int64(categoryID),
-		UserAgent:  r.FormValue("user_agent"),
-		Username:   r.FormValue("feed_username"),
-		Password:   r.FormValue("feed_password"),
+		URL:           r.FormValue("url"),
+		Crawler:       r.FormValue("crawler") == "1",
+		CategoryID:    int64(categoryID),
+		UserAgent:     r.FormValue("user_agent"),
+		Username:      r.FormValue("feed_username"),
+		Password:      r.FormValue("feed_password"),
+		WatchSelector: strings.TrimSpace(r.FormValue("watch_selector")),
 	}
 }
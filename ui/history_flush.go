@@ -13,7 +13,7 @@ import (
 )
 
 func (h *handler) flushHistory(w http.ResponseWriter, r *http.Request) {
-	err := h.store.FlushHistory(request.UserID(r))
+	err := h.store.FlushHistory(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
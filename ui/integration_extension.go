@@ -0,0 +1,32 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/crypto"
+	"miniflux.app/extension"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/ui/session"
+)
+
+// generateExtensionToken replaces the token of the browser extension, the previous one stops working.
+func (h *handler) generateExtensionToken(w http.ResponseWriter, r *http.Request) {
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	token := crypto.GenerateRandomString(32)
+
+	if err := h.store.UpdateExtraField(r.Context(), request.UserID(r), extension.TokenExtraField, token); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	sess.NewFlashMessage(printer.Printf("alert.extension_token_generated"))
+	html.Redirect(w, r, route.Path(h.router, "integrations"))
+}
@@ -18,19 +18,19 @@ import (
 
 func (h *handler) pocketAuthorize(w http.ResponseWriter, r *http.Request) {
 	printer := locale.NewPrinter(request.UserLanguage(r))
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	integration, err := h.store.Integration(user.ID)
+	integration, err := h.store.Integration(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	connector := pocket.NewConnector(h.cfg.PocketConsumerKey(integration.PocketConsumerKey))
 	redirectURL := h.cfg.BaseURL() + route.Path(h.router, "pocketCallback")
 	requestToken, err := connector.RequestToken(redirectURL)
@@ -47,15 +47,15 @@ func (h *handler) pocketAuthorize(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) pocketCallback(w http.ResponseWriter, r *http.Request) {
 	printer := locale.NewPrinter(request.UserLanguage(r))
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	integration, err := h.store.Integration(user.ID)
+	integration, err := h.store.Integration(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -73,7 +73,7 @@ func (h *handler) pocketCallback(w http.ResponseWriter, r *http.Request) {
 	sess.SetPocketRequestToken("")
 	integration.PocketAccessToken = accessToken
 
-	err = h.store.UpdateIntegration(integration)
+	err = h.store.UpdateIntegration(r.Context(), integration)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -15,13 +15,13 @@ import (
 )
 
 func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	integration, err := h.store.Integration(user.ID)
+	integration, err := h.store.Integration(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -50,16 +50,45 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 		PocketEnabled:        integration.PocketEnabled,
 		PocketAccessToken:    integration.PocketAccessToken,
 		PocketConsumerKey:    integration.PocketConsumerKey,
+		NtfyEnabled:          integration.NtfyEnabled,
+		NtfyURL:              integration.NtfyURL,
+		NtfyTopic:            integration.NtfyTopic,
+		NtfyToken:            integration.NtfyToken,
+		PushoverEnabled:      integration.PushoverEnabled,
+		PushoverToken:        integration.PushoverToken,
+		PushoverUser:         integration.PushoverUser,
+		AppriseEnabled:       integration.AppriseEnabled,
+		AppriseURL:           integration.AppriseURL,
+		AppriseServiceURLs:   integration.AppriseServiceURLs,
+		KindleEnabled:        integration.KindleEnabled,
+		KindleEmail:          integration.KindleEmail,
+		ReadeckEnabled:       integration.ReadeckEnabled,
+		ReadeckURL:           integration.ReadeckURL,
+		ReadeckAPIKey:        integration.ReadeckAPIKey,
+		ReadeckLabels:        integration.ReadeckLabels,
+		OmnivoreEnabled:      integration.OmnivoreEnabled,
+		OmnivoreURL:          integration.OmnivoreURL,
+		OmnivoreAPIKey:       integration.OmnivoreAPIKey,
+		Filters:              integration.Filters,
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	categories, err := h.store.Categories(r.Context(), user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", integrationForm)
+	view.Set("categories", categories)
 	view.Set("menu", "settings")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 	view.Set("hasPocketConsumerKeyConfigured", h.cfg.PocketConsumerKey("") != "")
+	view.Set("hasMailer", h.cfg.HasMailer())
+	view.Set("mailFrom", h.cfg.MailFrom())
 
 	html.OK(w, r, view.Render("integrations"))
 }
@@ -8,6 +8,7 @@ import (
 	"crypto/md5"
 	"fmt"
 	"net/http"
+	"net/mail"
 
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/request"
@@ -19,14 +20,14 @@ import (
 
 func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 	printer := locale.NewPrinter(request.UserLanguage(r))
-	sess := session.New(h.store, request.SessionID(r))
-	user, err := h.store.UserByID(request.UserID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	integration, err := h.store.Integration(user.ID)
+	integration, err := h.store.Integration(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -35,19 +36,33 @@ func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 	integrationForm := form.NewIntegrationForm(r)
 	integrationForm.Merge(integration)
 
-	if integration.FeverUsername != "" && h.store.HasDuplicateFeverUsername(user.ID, integration.FeverUsername) {
+	if integration.FeverUsername != "" && h.store.HasDuplicateFeverUsername(r.Context(), user.ID, integration.FeverUsername) {
 		sess.NewFlashErrorMessage(printer.Printf("error.duplicate_fever_username"))
 		html.Redirect(w, r, route.Path(h.router, "integrations"))
 		return
 	}
 
+	if err := integration.Filters.Validate(); err != nil {
+		sess.NewFlashErrorMessage(printer.Printf("error.integration_filters_invalid"))
+		html.Redirect(w, r, route.Path(h.router, "integrations"))
+		return
+	}
+
+	if integration.KindleEnabled {
+		if _, err := mail.ParseAddress(integration.KindleEmail); err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.kindle_email_invalid"))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
 	if integration.FeverEnabled {
 		integration.FeverToken = fmt.Sprintf("%x", md5.Sum([]byte(integration.FeverUsername+":"+integration.FeverPassword)))
 	} else {
 		integration.FeverToken = ""
 	}
 
-	err = h.store.UpdateIntegration(integration)
+	err = h.store.UpdateIntegration(r.Context(), integration)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -0,0 +1,121 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showInvitationsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	invitations, err := h.store.Tokens(r.Context(), model.TokenTypeInvitation)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("invitations", invitations)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
+
+	html.OK(w, r, view.Render("invitations"))
+}
+
+// createInvitation creates an invitation code, the link is emailed when an address is given.
+// The link is displayed once since only the hash of the code is stored.
+func (h *handler) createInvitation(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	email := strings.TrimSpace(r.FormValue("email"))
+	if email != "" {
+		if model.ValidateEmail(email) != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.invalid_email"))
+			html.Redirect(w, r, route.Path(h.router, "invitations"))
+			return
+		}
+	}
+
+	invitation := &model.Token{UserID: user.ID, Type: model.TokenTypeInvitation, Email: email}
+	value, err := h.store.CreateToken(r.Context(), invitation, model.InvitationTokenTTL)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	link := h.cfg.RootURL() + route.Path(h.router, "signup") + "?invitation=" + url.QueryEscape(value)
+	sess.NewFlashMessage(printer.Printf("alert.invitation_created", link))
+
+	if email != "" {
+		if err := h.sendEmail(request.UserLanguage(r), email, "email.invitation.subject", "email.invitation.body", user.Username, link); err != nil {
+			logger.Error("[UI:CreateInvitation] %v", err)
+			sess.NewFlashErrorMessage(printer.Printf("error.unable_to_send_email"))
+		}
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "invitations"))
+}
+
+func (h *handler) removeInvitation(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	removed, err := h.store.RemoveToken(r.Context(), model.TokenTypeInvitation, request.RouteInt64Param(r, "invitationID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !removed {
+		html.NotFound(w, r)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "invitations"))
+}
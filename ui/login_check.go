@@ -8,6 +8,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/storage"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -15,7 +16,7 @@ import (
 
 func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 	clientIP := request.ClientIP(r)
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	authForm := form.NewAuthForm(r)
 
 	view := view.New(h.tpl, r, sess)
@@ -28,22 +29,28 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.CheckPassword(authForm.Username, authForm.Password); err != nil {
+	if err := h.store.CheckPassword(r.Context(), authForm.Username, authForm.Password); err != nil {
 		logger.Error("[UI:CheckLogin] [ClientIP=%s] %v", clientIP, err)
+		switch err {
+		case storage.ErrUserNotVerified:
+			view.Set("errorMessage", "error.user_not_verified")
+		case storage.ErrUserPending:
+			view.Set("errorMessage", "error.user_pending")
+		}
 		html.OK(w, r, view.Render("login"))
 		return
 	}
 
-	sessionToken, userID, err := h.store.CreateUserSession(authForm.Username, r.UserAgent(), clientIP)
+	sessionToken, userID, err := h.store.CreateUserSession(r.Context(), authForm.Username, r.UserAgent(), clientIP)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	logger.Info("[UI:CheckLogin] username=%s just logged in", authForm.Username)
-	h.store.SetLastLogin(userID)
+	h.store.SetLastLogin(r.Context(), userID)
 
-	user, err := h.store.UserByID(userID)
+	user, err := h.store.UserByID(r.Context(), userID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -20,7 +20,7 @@ func (h *handler) showLoginPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	html.OK(w, r, view.Render("login"))
 }
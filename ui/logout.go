@@ -16,8 +16,8 @@ import (
 )
 
 func (h *handler) logout(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
-	user, err := h.store.UserByID(request.UserID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -26,7 +26,7 @@ func (h *handler) logout(w http.ResponseWriter, r *http.Request) {
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
-	if err := h.store.RemoveUserSessionByToken(user.ID, request.UserSessionToken(r)); err != nil {
+	if err := h.store.RemoveUserSessionByToken(r.Context(), user.ID, request.UserSessionToken(r)); err != nil {
 		logger.Error("[UI:Logout] %v", err)
 	}
 
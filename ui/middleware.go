@@ -6,17 +6,24 @@ package ui // import "miniflux.app/ui"
 
 import (
 	"context"
+	"crypto/hmac"
 	"errors"
 	"net/http"
+	"net/url"
+	"time"
 
 	"miniflux.app/config"
+	"miniflux.app/crypto"
 	"miniflux.app/http/cookie"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/http/response/json"
 	"miniflux.app/http/route"
 	"miniflux.app/storage"
 	"miniflux.app/logger"
+	"miniflux.app/locale"
 	"miniflux.app/model"
+	"miniflux.app/ui/session"
 
 	"github.com/gorilla/mux"
 )
@@ -25,10 +32,11 @@ type middleware struct {
 	router *mux.Router
 	cfg *config.Config
 	store *storage.Storage
+	csrfSecret []byte
 }
 
-func newMiddleware(router *mux.Router, cfg *config.Config, store *storage.Storage) *middleware {
-	return &middleware{router, cfg, store}
+func newMiddleware(router *mux.Router, cfg *config.Config, store *storage.Storage, csrfSecret []byte) *middleware {
+	return &middleware{router, cfg, store, csrfSecret}
 }
 
 func (m *middleware) handleUserSession(next http.Handler) http.Handler {
@@ -64,14 +72,14 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 			if (request.IsAuthenticated(r)) {
 				userID := request.UserID(r)
 				logger.Debug("[UI:AppSession] Cookie expired but user #%d is logged: creating a new session", userID)
-				session, err = m.store.CreateAppSessionWithUserPrefs(userID)
+				session, err = m.store.CreateAppSessionWithUserPrefs(r.Context(), userID)
 				if err != nil {
 					html.ServerError(w, r, err)
 					return
 				}
 			} else {
 				logger.Debug("[UI:AppSession] Session not found, creating a new one")
-				session, err = m.store.CreateAppSession()
+				session, err = m.store.CreateAppSession(r.Context())
 				if err != nil {
 					html.ServerError(w, r, err)
 					return
@@ -83,11 +91,14 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 			logger.Debug("[UI:AppSession] %s", session)
 		}
 
+		// The token is derived from the session ID, any instance can check it without storing it.
+		csrf := crypto.HMAC(m.csrfSecret, session.ID)
+
 		if r.Method == "POST" {
 			formValue := r.FormValue("csrf")
 			headerValue := r.Header.Get("X-Csrf-Token")
 
-			if session.Data.CSRF != formValue && session.Data.CSRF != headerValue {
+			if !hmac.Equal([]byte(csrf), []byte(formValue)) && !hmac.Equal([]byte(csrf), []byte(headerValue)) {
 				logger.Error(`[UI:AppSession] Invalid or missing CSRF token: Form="%s", Header="%s"`, formValue, headerValue)
 				html.BadRequest(w, r, errors.New("Invalid or missing CSRF"))
 				return
@@ -96,7 +107,7 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.SessionIDContextKey, session.ID)
-		ctx = context.WithValue(ctx, request.CSRFContextKey, session.Data.CSRF)
+		ctx = context.WithValue(ctx, request.CSRFContextKey, csrf)
 		ctx = context.WithValue(ctx, request.OAuth2StateContextKey, session.Data.OAuth2State)
 		ctx = context.WithValue(ctx, request.FlashMessageContextKey, session.Data.FlashMessage)
 		ctx = context.WithValue(ctx, request.FlashErrorMessageContextKey, session.Data.FlashErrorMessage)
@@ -107,13 +118,106 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 	})
 }
 
+// handleReadOnly rejects the changes on read-only instances, the pages can still be browsed.
+func (m *middleware) handleReadOnly(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.isMutatingRoute(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The requests sent by the JavaScript code expect a JSON response.
+		if r.Header.Get("X-Csrf-Token") != "" {
+			json.ForbiddenError(w, r, config.ErrReadOnly)
+			return
+		}
+
+		m.redirectWithError(w, r, "error.read_only")
+	})
+}
+
+// handleWriteFreeze rejects the changes of the users frozen by an administrator,
+// the administrators can still manage the users.
+func (m *middleware) handleWriteFreeze(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !request.IsAuthenticated(r) || !m.isMutatingRoute(r) || m.isUserManagementRoute(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := m.store.UserByID(r.Context(), request.UserID(r))
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		now := time.Now()
+		if user == nil || !user.IsFrozen(now) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The requests sent by the JavaScript code expect a JSON response.
+		if r.Header.Get("X-Csrf-Token") != "" {
+			printer := locale.NewPrinter(request.UserLanguage(r))
+			json.ServiceUnavailable(w, r, errors.New(printer.Printf("error.write_freeze")), user.FreezeRetryAfter(now))
+			return
+		}
+
+		m.redirectWithError(w, r, "error.write_freeze")
+	})
+}
+
+// redirectWithError goes back to the previous page of the same site with a flash error message.
+func (m *middleware) redirectWithError(w http.ResponseWriter, r *http.Request, key string) {
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), m.store, request.SessionID(r))
+	sess.NewFlashErrorMessage(printer.Printf(key))
+
+	redirectURL := route.Path(m.router, "unread")
+	if referer, err := url.Parse(r.Referer()); err == nil && referer.Host == r.Host {
+		redirectURL = localRedirect(referer.RequestURI(), redirectURL)
+	}
+	html.Redirect(w, r, redirectURL)
+}
+
+// isUserManagementRoute returns true if the route is used by the administrators to manage the users and their subscriptions.
+func (m *middleware) isUserManagementRoute(r *http.Request) bool {
+	switch mux.CurrentRoute(r).GetName() {
+	case "saveUser", "updateUser", "removeUser", "approveUser", "createInvitation", "removeInvitation", "approveFeed", "rejectFeed":
+		return true
+	default:
+		return false
+	}
+}
+
+// isMutatingRoute returns true if the route changes data, the login is still allowed on read-only instances.
+func (m *middleware) isMutatingRoute(r *http.Request) bool {
+	name := mux.CurrentRoute(r).GetName()
+	switch name {
+	case "checkLogin":
+		return false
+	case "markAllAsRead",
+		"undoMarkAllAsRead",
+		"flushHistory",
+		"refreshAllFeeds",
+		"refreshFeed",
+		"oauth2Unlink",
+		"pocketAuthorize",
+		"pocketCallback":
+		return true
+	default:
+		return r.Method != "GET"
+	}
+}
+
 func (m *middleware) getAppSessionValueFromCookie(r *http.Request) *model.Session {
 	cookieValue := request.CookieValue(r, cookie.CookieAppSessionID)
 	if cookieValue == "" {
 		return nil
 	}
 
-	session, err := m.store.AppSession(cookieValue)
+	session, err := m.store.AppSession(r.Context(), cookieValue)
 	if err != nil {
 		logger.Error("[UI:AppSession] %v", err)
 		return nil
@@ -127,6 +231,14 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 	switch route.GetName() {
 	case "login",
 		"checkLogin",
+		"signup",
+		"submitSignup",
+		"verifySignup",
+		"forgotPassword",
+		"submitForgotPassword",
+		"resetPassword",
+		"submitResetPassword",
+		"asset",
 		"stylesheet",
 		"javascript",
 		"oauth2Redirect",
@@ -148,7 +260,7 @@ func (m *middleware) getUserSessionFromCookie(r *http.Request) *model.UserSessio
 		return nil
 	}
 
-	session, err := m.store.UserSessionByToken(cookieValue)
+	session, err := m.store.UserSessionByToken(r.Context(), cookieValue)
 	if err != nil {
 		logger.Error("[UI:UserSession] %v", err)
 		return nil
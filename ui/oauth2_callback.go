@@ -20,7 +20,7 @@ import (
 func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 	clientIP := request.ClientIP(r)
 	printer := locale.NewPrinter(request.UserLanguage(r))
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 
 	provider := request.RouteStringParam(r, "provider")
 	if provider == "" {
@@ -60,7 +60,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 	logger.Info("[OAuth2] [ClientIP=%s] Successful auth for %s", clientIP, profile)
 
 	if request.IsAuthenticated(r) {
-		user, err := h.store.UserByExtraField(profile.Key, profile.ID)
+		user, err := h.store.UserByExtraField(r.Context(), profile.Key, profile.ID)
 		if err != nil {
 			html.ServerError(w, r, err)
 			return
@@ -73,7 +73,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		if err := h.store.UpdateExtraField(request.UserID(r), profile.Key, profile.ID); err != nil {
+		if err := h.store.UpdateExtraField(r.Context(), request.UserID(r), profile.Key, profile.ID); err != nil {
 			html.ServerError(w, r, err)
 			return
 		}
@@ -83,7 +83,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.store.UserByExtraField(profile.Key, profile.ID)
+	user, err := h.store.UserByExtraField(r.Context(), profile.Key, profile.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -100,13 +100,13 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		user.IsAdmin = false
 		user.Extra[profile.Key] = profile.ID
 
-		if err := h.store.CreateUser(user); err != nil {
+		if err := h.store.CreateUser(r.Context(), user); err != nil {
 			html.ServerError(w, r, err)
 			return
 		}
 	}
 
-	sessionToken, _, err := h.store.CreateUserSession(user.Username, r.UserAgent(), clientIP)
+	sessionToken, _, err := h.store.CreateUserSession(r.Context(), user.Username, r.UserAgent(), clientIP)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -114,7 +114,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("[OAuth2] [ClientIP=%s] username=%s (%s) just logged in", clientIP, user.Username, profile)
 
-	h.store.SetLastLogin(user.ID)
+	h.store.SetLastLogin(r.Context(), user.ID)
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
@@ -15,7 +15,7 @@ import (
 )
 
 func (h *handler) oauth2Redirect(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 
 	provider := request.RouteStringParam(r, "provider")
 	if provider == "" {
@@ -31,9 +31,9 @@ func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 
-	hasPassword, err := h.store.HasPassword(request.UserID(r))
+	hasPassword, err := h.store.HasPassword(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -45,7 +45,7 @@ func (h *handler) oauth2Unlink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.RemoveExtraField(request.UserID(r), authProvider.GetUserExtraKey()); err != nil {
+	if err := h.store.RemoveExtraField(r.Context(), request.UserID(r), authProvider.GetUserExtraKey()); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
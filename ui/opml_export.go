@@ -14,7 +14,7 @@ import (
 )
 
 func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
-	opml, err := opml.NewHandler(h.store).Export(request.UserID(r))
+	opml, err := opml.NewHandler(h.store).Export(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
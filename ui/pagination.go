@@ -4,10 +4,6 @@
 
 package ui // import "miniflux.app/ui"
 
-const (
-	nbItemsPerPage = 100
-)
-
 type pagination struct {
 	Route        string
 	Total        int
@@ -18,27 +14,28 @@ type pagination struct {
 	NextOffset   int
 	PrevOffset   int
 	SearchQuery  string
+	Status       string
 }
 
-func getPagination(route string, total, offset int) pagination {
+func getPagination(route string, total, offset, itemsPerPage int) pagination {
 	nextOffset := 0
 	prevOffset := 0
-	showNext := (total - offset) > nbItemsPerPage
+	showNext := (total - offset) > itemsPerPage
 	showPrev := offset > 0
 
 	if showNext {
-		nextOffset = offset + nbItemsPerPage
+		nextOffset = offset + itemsPerPage
 	}
 
 	if showPrev {
-		prevOffset = offset - nbItemsPerPage
+		prevOffset = offset - itemsPerPage
 	}
 
 	return pagination{
 		Route:        route,
 		Total:        total,
 		Offset:       offset,
-		ItemsPerPage: nbItemsPerPage,
+		ItemsPerPage: itemsPerPage,
 		ShowNext:     showNext,
 		NextOffset:   nextOffset,
 		ShowPrev:     showPrev,
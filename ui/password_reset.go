@@ -0,0 +1,138 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showForgotPasswordPage(w http.ResponseWriter, r *http.Request) {
+	if !h.cfg.HasMailer() {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	html.OK(w, r, view.Render("forgot_password"))
+}
+
+// submitForgotPassword emails a password reset link, the same message is displayed
+// whether the address belongs to a user or not to avoid disclosing the accounts.
+func (h *handler) submitForgotPassword(w http.ResponseWriter, r *http.Request) {
+	if !h.cfg.HasMailer() {
+		html.NotFound(w, r)
+		return
+	}
+
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	email := strings.TrimSpace(r.FormValue("email"))
+	if model.ValidateEmail(email) != nil {
+		view := view.New(h.tpl, r, sess)
+		view.Set("errorMessage", "error.invalid_email")
+		html.OK(w, r, view.Render("forgot_password"))
+		return
+	}
+
+	user, err := h.store.UserByEmail(r.Context(), email)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user != nil {
+		if err := h.sendPasswordResetEmail(r, user); err != nil {
+			logger.Error("[UI:ForgotPassword] %v", err)
+		} else {
+			logger.Info("[UI:ForgotPassword] [ClientIP=%s] password reset requested for username=%s", request.ClientIP(r), user.Username)
+		}
+	}
+
+	sess.NewFlashMessage(printer.Printf("alert.password_reset_sent", email))
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
+
+func (h *handler) showResetPasswordPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	token, err := h.store.TokenByValue(r.Context(), model.TokenTypePasswordReset, request.RouteStringParam(r, "token"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if token == nil {
+		sess.NewFlashErrorMessage(locale.NewPrinter(request.UserLanguage(r)).Printf("error.invalid_password_reset_link"))
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.PasswordResetForm{})
+	view.Set("token", request.RouteStringParam(r, "token"))
+	html.OK(w, r, view.Render("reset_password"))
+}
+
+func (h *handler) submitResetPassword(w http.ResponseWriter, r *http.Request) {
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	value := request.RouteStringParam(r, "token")
+
+	resetForm := form.NewPasswordResetForm(r)
+	if err := resetForm.Validate(); err != nil {
+		view := view.New(h.tpl, r, sess)
+		view.Set("form", resetForm)
+		view.Set("token", value)
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("reset_password"))
+		return
+	}
+
+	token, err := h.store.ConsumeToken(r.Context(), model.TokenTypePasswordReset, value)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if token == nil {
+		sess.NewFlashErrorMessage(printer.Printf("error.invalid_password_reset_link"))
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	if err := h.store.ResetPassword(r.Context(), token.UserID, resetForm.Password); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:ResetPassword] [ClientIP=%s] password of user #%d has been reset", request.ClientIP(r), token.UserID)
+	sess.NewFlashMessage(printer.Printf("alert.password_reset"))
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
+
+// sendPasswordResetEmail sends a link valid for a limited time to choose a new password.
+func (h *handler) sendPasswordResetEmail(r *http.Request, user *model.User) error {
+	token := &model.Token{UserID: user.ID, Type: model.TokenTypePasswordReset, Email: user.Email}
+	value, err := h.store.CreateToken(r.Context(), token, model.PasswordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := h.cfg.RootURL() + route.Path(h.router, "resetPassword", "token", value)
+	return h.sendEmail(user.Language, user.Email, "email.password_reset.subject", "email.password_reset.body", user.Username, link)
+}
@@ -7,15 +7,14 @@ package ui // import "miniflux.app/ui"
 import (
 	"encoding/base64"
 	"errors"
-	"io/ioutil"
 	"net/http"
 	"time"
 
 	"miniflux.app/crypto"
-	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
+	"miniflux.app/mediaproxy"
 )
 
 func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
@@ -37,23 +36,38 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	clt := client.New(string(decodedURL))
-	resp, err := clt.Get()
-	if err != nil {
+	// Images are fetched only once when a blob store is configured.
+	image, err := mediaproxy.FetchImage(h.store.BlobStore(), string(decodedURL))
+	switch {
+	case err == mediaproxy.ErrNotFound:
+		html.NotFound(w, r)
+		return
+	case err != nil:
 		html.ServerError(w, r, err)
 		return
 	}
 
-	if resp.HasServerFailure() {
-		html.NotFound(w, r)
-		return
+	compressor := mediaproxy.NewCompressor(
+		h.cfg.ProxyImageMaxWidth(),
+		h.cfg.ProxyImageQuality(),
+		h.cfg.ProxyImageAVIFCommand(),
+		h.cfg.ProxyImageWebPCommand(),
+	)
+
+	// The converted images are cached as well, the format depends on the formats accepted by the browser.
+	image = compressor.Compress(h.store.BlobStore(), string(decodedURL), image, r.Header.Get("Accept"))
+	if compressor.Vary() {
+		w.Header().Set("Vary", "Accept")
 	}
 
-	body, _ := ioutil.ReadAll(resp.Body)
+	writeProxiedImage(w, r, image.ContentType, image.Content)
+}
+
+func writeProxiedImage(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
 	etag := crypto.HashFromBytes(body)
 
 	response.New(w ,r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
-		b.WithHeader("Content-Type", resp.ContentType)
+		b.WithHeader("Content-Type", contentType)
 		b.WithBody(body)
 		b.WithoutCompression()
 		b.Write()
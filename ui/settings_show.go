@@ -17,24 +17,33 @@ import (
 )
 
 func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	settingsForm := form.SettingsForm{
-		Username:       user.Username,
-		Theme:          user.Theme,
-		Language:       user.Language,
-		Timezone:       user.Timezone,
-		EntryDirection: user.EntryDirection,
+		Username:         user.Username,
+		Email:            user.Email,
+		Theme:            user.Theme,
+		Language:         user.Language,
+		Timezone:         user.Timezone,
+		EntryDirection:   user.EntryDirection,
+		EntriesPerPage:   user.EntriesPerPage,
+		ShowReadEntries:  user.ShowReadEntries,
+		ShowAbsoluteTime: user.ShowAbsoluteTime,
+		ImageDisplay:     user.ImageDisplay,
+		NSFWDisplay:      user.NSFWDisplay,
+		JunkThreshold:    user.JunkThreshold,
+		QuietHoursStart:  user.QuietHoursStart,
+		QuietHoursEnd:    user.QuietHoursEnd,
 	}
 
-	timezones, err := h.store.Timezones()
+	timezones, err := h.store.Timezones(r.Context())
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -42,12 +51,15 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 
 	view.Set("form", settingsForm)
 	view.Set("themes", model.Themes())
+	view.Set("imageDisplays", model.ImageDisplays())
+	view.Set("nsfwDisplays", model.NSFWDisplays())
+	view.Set("maxJunkScore", model.MaxJunkScore)
 	view.Set("languages", locale.AvailableLanguages())
 	view.Set("timezones", timezones)
 	view.Set("menu", "settings")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	html.OK(w, r, view.Render("settings"))
 }
@@ -19,16 +19,16 @@ import (
 )
 
 func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	timezones, err := h.store.Timezones()
+	timezones, err := h.store.Timezones(r.Context())
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -38,12 +38,15 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 
 	view.Set("form", settingsForm)
 	view.Set("themes", model.Themes())
+	view.Set("imageDisplays", model.ImageDisplays())
+	view.Set("nsfwDisplays", model.NSFWDisplays())
+	view.Set("maxJunkScore", model.MaxJunkScore)
 	view.Set("languages", locale.AvailableLanguages())
 	view.Set("timezones", timezones)
 	view.Set("menu", "settings")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 	if err := settingsForm.Validate(); err != nil {
 		view.Set("errorMessage", err.Error())
@@ -51,13 +54,19 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if h.store.AnotherUserExists(user.ID, settingsForm.Username) {
+	if h.store.AnotherUserExists(r.Context(), user.ID, settingsForm.Username) {
 		view.Set("errorMessage", "error.user_already_exists")
 		html.OK(w, r, view.Render("settings"))
 		return
 	}
 
-	err = h.store.UpdateUser(settingsForm.Merge(user))
+	if settingsForm.Email != "" && h.store.AnotherEmailExists(r.Context(), user.ID, settingsForm.Email) {
+		view.Set("errorMessage", "error.email_already_exists")
+		html.OK(w, r, view.Render("settings"))
+		return
+	}
+
+	err = h.store.UpdateUser(r.Context(), settingsForm.Merge(user))
 	if err != nil {
 		logger.Error("[UI:UpdateSettings] %v", err)
 		view.Set("errorMessage", "error.unable_to_update_user")
@@ -0,0 +1,184 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/mailer"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showSignupPage(w http.ResponseWriter, r *http.Request) {
+	if !h.cfg.HasSignup() {
+		html.NotFound(w, r)
+		return
+	}
+
+	if request.IsAuthenticated(r) {
+		html.Redirect(w, r, route.Path(h.router, "unread"))
+		return
+	}
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.SignupForm{Invitation: request.QueryStringParam(r, "invitation", "")})
+	view.Set("invitationOnly", h.cfg.IsSignupInvitationOnly())
+
+	html.OK(w, r, view.Render("signup"))
+}
+
+func (h *handler) submitSignup(w http.ResponseWriter, r *http.Request) {
+	if !h.cfg.HasSignup() {
+		html.NotFound(w, r)
+		return
+	}
+
+	signupForm := form.NewSignupForm(r)
+
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", signupForm)
+	view.Set("invitationOnly", h.cfg.IsSignupInvitationOnly())
+
+	if err := signupForm.Validate(h.cfg.SignupAllowedDomains()); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("signup"))
+		return
+	}
+
+	if h.store.UserExists(r.Context(), signupForm.Username) {
+		view.Set("errorMessage", "error.user_already_exists")
+		html.OK(w, r, view.Render("signup"))
+		return
+	}
+
+	if h.store.EmailExists(r.Context(), signupForm.Email) {
+		view.Set("errorMessage", "error.email_already_exists")
+		html.OK(w, r, view.Render("signup"))
+		return
+	}
+
+	// The invited users are approved by the administrator who created the invitation.
+	invited := false
+	if signupForm.Invitation != "" || h.cfg.IsSignupInvitationOnly() {
+		invitation, err := h.store.TokenByValue(r.Context(), model.TokenTypeInvitation, signupForm.Invitation)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		if invitation == nil || (invitation.Email != "" && !strings.EqualFold(invitation.Email, signupForm.Email)) {
+			view.Set("errorMessage", "error.invalid_invitation")
+			html.OK(w, r, view.Render("signup"))
+			return
+		}
+
+		if invitation, err = h.store.ConsumeToken(r.Context(), model.TokenTypeInvitation, signupForm.Invitation); err != nil {
+			html.ServerError(w, r, err)
+			return
+		} else if invitation == nil {
+			view.Set("errorMessage", "error.invalid_invitation")
+			html.OK(w, r, view.Render("signup"))
+			return
+		}
+
+		invited = true
+	}
+
+	user := signupForm.ToUser()
+	user.Pending = h.cfg.HasSignupModeration() && !invited
+	if err := h.store.SignupUser(r.Context(), user); err != nil {
+		logger.Error("[UI:Signup] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_user")
+		html.OK(w, r, view.Render("signup"))
+		return
+	}
+
+	if err := h.sendVerificationEmail(r, user); err != nil {
+		logger.Error("[UI:Signup] %v", err)
+		h.store.RemoveUser(r.Context(), user.ID)
+		view.Set("errorMessage", "error.unable_to_send_email")
+		html.OK(w, r, view.Render("signup"))
+		return
+	}
+
+	logger.Info("[UI:Signup] username=%s just signed up", user.Username)
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess.NewFlashMessage(printer.Printf("alert.signup_verification_sent", user.Email))
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
+
+func (h *handler) verifySignup(w http.ResponseWriter, r *http.Request) {
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
+
+	token, err := h.store.ConsumeToken(r.Context(), model.TokenTypeEmailVerification, request.RouteStringParam(r, "token"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if token == nil {
+		sess.NewFlashErrorMessage(printer.Printf("error.invalid_verification_link"))
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	if err := h.store.VerifyUser(r.Context(), token.UserID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	user, err := h.store.UserByID(r.Context(), token.UserID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if user.Pending {
+		sess.NewFlashMessage(printer.Printf("alert.account_verified_pending"))
+	} else {
+		sess.NewFlashMessage(printer.Printf("alert.account_verified"))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "login"))
+}
+
+// sendVerificationEmail sends the link verifying the email address of a new user.
+func (h *handler) sendVerificationEmail(r *http.Request, user *model.User) error {
+	token := &model.Token{UserID: user.ID, Type: model.TokenTypeEmailVerification, Email: user.Email}
+	value, err := h.store.CreateToken(r.Context(), token, model.EmailVerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := h.cfg.RootURL() + route.Path(h.router, "verifySignup", "token", value)
+	return h.sendEmail(request.UserLanguage(r), user.Email, "email.verification.subject", "email.verification.body", user.Username, link)
+}
+
+// sendEmail delivers a message translated in the given language.
+func (h *handler) sendEmail(language, to, subjectKey, bodyKey string, args ...interface{}) error {
+	printer := locale.NewPrinter(language)
+	return mailer.New(h.cfg).Send(&mailer.Message{
+		To:      to,
+		Subject: printer.Printf(subjectKey),
+		Body:    printer.Printf(bodyKey, args...),
+	})
+}
@@ -6,31 +6,29 @@ document.addEventListener("DOMContentLoaded", function() {
 
     let navHandler = new NavHandler();
     let keyboardHandler = new KeyboardHandler();
-    keyboardHandler.on("g u", () => navHandler.goToPage("unread"));
-    keyboardHandler.on("g b", () => navHandler.goToPage("starred"));
-    keyboardHandler.on("g h", () => navHandler.goToPage("history"));
-    keyboardHandler.on("g f", () => navHandler.goToFeedOrFeeds());
-    keyboardHandler.on("g c", () => navHandler.goToPage("categories"));
-    keyboardHandler.on("g s", () => navHandler.goToPage("settings"));
-    keyboardHandler.on("ArrowLeft", () => navHandler.goToPrevious());
-    keyboardHandler.on("ArrowRight", () => navHandler.goToNext());
-    keyboardHandler.on("j", () => navHandler.goToPrevious());
-    keyboardHandler.on("p", () => navHandler.goToPrevious());
-    keyboardHandler.on("k", () => navHandler.goToNext());
-    keyboardHandler.on("n", () => navHandler.goToNext());
-    keyboardHandler.on("h", () => navHandler.goToPage("previous"));
-    keyboardHandler.on("l", () => navHandler.goToPage("next"));
-    keyboardHandler.on("o", () => navHandler.openSelectedItem());
-    keyboardHandler.on("v", () => navHandler.openOriginalLink());
-    keyboardHandler.on("m", () => navHandler.toggleEntryStatus());
-    keyboardHandler.on("A", () => navHandler.markPageAsRead());
-    keyboardHandler.on("s", () => navHandler.saveEntry());
-    keyboardHandler.on("d", () => navHandler.fetchOriginalContent());
-    keyboardHandler.on("f", () => navHandler.toggleBookmark());
-    keyboardHandler.on("?", () => navHandler.showKeyboardShortcuts());
-    keyboardHandler.on("#", () => navHandler.unsubscribeFromFeed());
-    keyboardHandler.on("/", (e) => navHandler.setFocusToSearchInput(e));
-    keyboardHandler.on("Escape", () => ModalHandler.close());
+    keyboardHandler.bind({
+        "go_to_unread": () => navHandler.goToPage("unread"),
+        "go_to_starred": () => navHandler.goToPage("starred"),
+        "go_to_history": () => navHandler.goToPage("history"),
+        "go_to_feeds": () => navHandler.goToFeedOrFeeds(),
+        "go_to_categories": () => navHandler.goToPage("categories"),
+        "go_to_settings": () => navHandler.goToPage("settings"),
+        "go_to_previous_item": () => navHandler.goToPrevious(),
+        "go_to_next_item": () => navHandler.goToNext(),
+        "go_to_previous_page": () => navHandler.goToPage("previous"),
+        "go_to_next_page": () => navHandler.goToPage("next"),
+        "open_item": () => navHandler.openSelectedItem(),
+        "open_original": () => navHandler.openOriginalLink(),
+        "toggle_read_status": () => navHandler.toggleEntryStatus(),
+        "mark_page_as_read": () => navHandler.markPageAsRead(),
+        "save_article": () => navHandler.saveEntry(),
+        "download_content": () => navHandler.fetchOriginalContent(),
+        "toggle_bookmark_status": () => navHandler.toggleBookmark(),
+        "show_keyboard_shortcuts": () => navHandler.showKeyboardShortcuts(),
+        "remove_feed": () => navHandler.unsubscribeFromFeed(),
+        "go_to_search": (e) => navHandler.setFocusToSearchInput(e),
+        "close_modal": () => ModalHandler.close()
+    }, JSON.parse(document.body.dataset.keyboardShortcuts));
     keyboardHandler.listen();
 
     let mouseHandler = new MouseHandler();
@@ -38,6 +36,10 @@ document.addEventListener("DOMContentLoaded", function() {
         EntryHandler.saveEntry(event.target);
     });
 
+    mouseHandler.onClick("a[data-send-to-kindle]", (event) => {
+        EntryHandler.saveEntry(event.target);
+    });
+
     mouseHandler.onClick("a[data-toggle-bookmark]", (event) => {
         EntryHandler.toggleBookmark(event.target);
     });
@@ -57,6 +59,20 @@ document.addEventListener("DOMContentLoaded", function() {
         EntryHandler.fetchOriginalContent(event.target);
     });
 
+    mouseHandler.onClick("a[data-summarize-entry]", (event) => {
+        EntryHandler.summarizeEntry(event.target);
+    });
+
+    mouseHandler.onClick(".entry-content img.blurred-image", (event) => {
+        event.target.classList.remove("blurred-image");
+        event.target.onclick = null;
+    });
+
+    mouseHandler.onClick(".entry-content.entry-nsfw", (event) => {
+        event.currentTarget.classList.remove("entry-nsfw");
+        event.currentTarget.onclick = null;
+    });
+
     mouseHandler.onClick("a[data-on-click=markPageAsRead]", () => navHandler.markPageAsRead());
 
     mouseHandler.onClick("a[data-confirm]", (event) => {
@@ -97,4 +97,27 @@ class EntryHandler {
         });
         request.execute();
     }
+
+    static summarizeEntry(element) {
+        if (element.dataset.completed) {
+            return;
+        }
+
+        element.innerHTML = element.dataset.labelLoading;
+
+        let request = new RequestBuilder(element.dataset.summarizeUrl);
+        request.withCallback((response) => {
+            element.innerHTML = element.dataset.labelDone;
+            element.dataset.completed = true;
+
+            response.json().then((data) => {
+                if (data.hasOwnProperty("summary")) {
+                    let summaryElement = document.querySelector(".entry-summary");
+                    summaryElement.textContent = data.summary;
+                    summaryElement.hidden = false;
+                }
+            });
+        });
+        request.execute();
+    }
 }
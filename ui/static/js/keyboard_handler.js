@@ -8,6 +8,14 @@ class KeyboardHandler {
         this.shortcuts[combination] = callback;
     }
 
+    bind(actions, shortcuts) {
+        for (let action in shortcuts) {
+            if (shortcuts.hasOwnProperty(action) && actions.hasOwnProperty(action)) {
+                shortcuts[action].forEach((combination) => this.on(combination, actions[action]));
+            }
+        }
+    }
+
     listen() {
         document.onkeydown = (event) => {
             if (this.isEventIgnored(event) || this.isModifierKeyDown(event)) {
@@ -17,7 +25,9 @@ class KeyboardHandler {
             let key = this.getKey(event);
             this.queue.push(key);
 
-            for (let combination in this.shortcuts) {
+            // Key sequences are checked first, otherwise a single key would hide the sequences ending with it.
+            let combinations = Object.keys(this.shortcuts).sort((a, b) => b.split(" ").length - a.split(" ").length);
+            for (let combination of combinations) {
                 let keys = combination.split(" ");
 
                 if (keys.every((value, index) => value === this.queue[index])) {
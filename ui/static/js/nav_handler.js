@@ -125,6 +125,12 @@ class NavHandler {
     openSelectedItem() {
         let currentItemLink = document.querySelector(".current-item .item-title a");
         if (currentItemLink !== null) {
+            // Feeds configured to open the original website link their titles directly to it.
+            if (currentItemLink.dataset.originalLink) {
+                this.openOriginalLink();
+                return;
+            }
+
             window.location.href = currentItemLink.getAttribute("href");
         }
     }
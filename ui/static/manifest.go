@@ -0,0 +1,84 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package static // import "miniflux.app/ui/static"
+
+import (
+	"encoding/base64"
+	"path"
+	"strings"
+)
+
+// Number of characters of the checksum included in fingerprinted filenames.
+const fingerprintLength = 12
+
+// Asset represents a static file served under a fingerprinted filename.
+type Asset struct {
+	Filename    string
+	ContentType string
+	Checksum    string
+	Content     []byte
+}
+
+// Manifest maps the original asset names, for example "app.js" or "default.css",
+// to their fingerprinted version, for example "app.0123456789ab.js".
+var Manifest = make(map[string]string)
+
+var assets = make(map[string]*Asset)
+
+func init() {
+	for name, content := range Stylesheets {
+		addAsset(name+".css", "text/css; charset=utf-8", StylesheetsChecksums[name], []byte(content))
+	}
+
+	for name, content := range Javascripts {
+		addAsset(name+".js", "text/javascript; charset=utf-8", JavascriptsChecksums[name], []byte(content))
+	}
+
+	for name, content := range Binaries {
+		blob, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			panic(err)
+		}
+
+		addAsset(name, binaryContentType(name), BinariesChecksums[name], blob)
+	}
+}
+
+func addAsset(name, contentType, checksum string, content []byte) {
+	filename := fingerprint(name, checksum)
+	Manifest[name] = filename
+	assets[filename] = &Asset{
+		Filename:    filename,
+		ContentType: contentType,
+		Checksum:    checksum,
+		Content:     content,
+	}
+}
+
+// FingerprintedAsset returns the asset matching a fingerprinted filename.
+func FingerprintedAsset(filename string) (*Asset, bool) {
+	asset, found := assets[filename]
+	return asset, found
+}
+
+func fingerprint(name, checksum string) string {
+	if len(checksum) > fingerprintLength {
+		checksum = checksum[:fingerprintLength]
+	}
+
+	extension := path.Ext(name)
+	return strings.TrimSuffix(name, extension) + "." + checksum + extension
+}
+
+func binaryContentType(name string) string {
+	switch path.Ext(name) {
+	case ".png":
+		return "image/png"
+	case ".ico":
+		return "image/x-icon"
+	default:
+		return "application/octet-stream"
+	}
+}
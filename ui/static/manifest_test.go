@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package static // import "miniflux.app/ui/static"
+
+import (
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	scenarios := map[string]string{
+		"app.js":       "app.0123456789ab.js",
+		"default.css":  "default.0123456789ab.css",
+		"icon-120.png": "icon-120.0123456789ab.png",
+	}
+
+	for name, expected := range scenarios {
+		result := fingerprint(name, "0123456789abcdef0123456789abcdef")
+		if result != expected {
+			t.Errorf(`Unexpected fingerprinted filename, got %q instead of %q`, result, expected)
+		}
+	}
+}
+
+func TestManifest(t *testing.T) {
+	filename, found := Manifest["app.js"]
+	if !found {
+		t.Fatal(`The manifest should contain the application script`)
+	}
+
+	asset, found := FingerprintedAsset(filename)
+	if !found {
+		t.Fatalf(`Unable to find the asset %q`, filename)
+	}
+
+	if asset.Checksum != JavascriptsChecksums["app"] {
+		t.Errorf(`Unexpected checksum, got %q instead of %q`, asset.Checksum, JavascriptsChecksums["app"])
+	}
+
+	if string(asset.Content) != Javascripts["app"] {
+		t.Errorf(`The asset content doesn't match the bundle`)
+	}
+
+	if asset.ContentType != "text/javascript; charset=utf-8" {
+		t.Errorf(`Unexpected content type, got %q`, asset.ContentType)
+	}
+}
+
+func TestManifestWithBinaries(t *testing.T) {
+	for name := range Binaries {
+		asset, found := FingerprintedAsset(Manifest[name])
+		if !found {
+			t.Fatalf(`Unable to find the asset %q`, name)
+		}
+
+		if len(asset.Content) == 0 {
+			t.Errorf(`The binary asset %q should be decoded`, name)
+		}
+	}
+}
+
+func TestUnknownFingerprintedAsset(t *testing.T) {
+	if _, found := FingerprintedAsset("app.js"); found {
+		t.Error(`Assets must only be served with their fingerprinted filename`)
+	}
+}
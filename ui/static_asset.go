@@ -0,0 +1,33 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/static"
+)
+
+// showAsset serves fingerprinted assets, their content never changes for a given filename.
+func (h *handler) showAsset(w http.ResponseWriter, r *http.Request) {
+	asset, found := static.FingerprintedAsset(request.RouteStringParam(r, "filename"))
+	if !found {
+		html.NotFound(w, r)
+		return
+	}
+
+	response.New(w, r).WithImmutableCaching(asset.Checksum, func(b *response.Builder) {
+		b.WithHeader("Content-Type", asset.ContentType)
+		if strings.HasPrefix(asset.ContentType, "image/") {
+			b.WithoutCompression()
+		}
+		b.WithBody(asset.Content)
+		b.Write()
+	})
+}
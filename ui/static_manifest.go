@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/response/json"
 	"miniflux.app/http/route"
 	"miniflux.app/model"
+	"miniflux.app/ui/static"
 )
 
 func (h *handler) showWebManifest(w http.ResponseWriter, r *http.Request) {
@@ -41,9 +42,9 @@ func (h *handler) showWebManifest(w http.ResponseWriter, r *http.Request) {
 		ThemeColor:      themeColor,
 		BackgroundColor: themeColor,
 		Icons: []webManifestIcon{
-			webManifestIcon{Source: route.Path(h.router, "appIcon", "filename", "icon-120.png"), Sizes: "120x120", Type: "image/png"},
-			webManifestIcon{Source: route.Path(h.router, "appIcon", "filename", "icon-192.png"), Sizes: "192x192", Type: "image/png"},
-			webManifestIcon{Source: route.Path(h.router, "appIcon", "filename", "icon-512.png"), Sizes: "512x512", Type: "image/png"},
+			webManifestIcon{Source: route.Path(h.router, "asset", "filename", static.Manifest["icon-120.png"]), Sizes: "120x120", Type: "image/png"},
+			webManifestIcon{Source: route.Path(h.router, "asset", "filename", static.Manifest["icon-192.png"]), Sizes: "192x192", Type: "image/png"},
+			webManifestIcon{Source: route.Path(h.router, "asset", "filename", static.Manifest["icon-512.png"]), Sizes: "512x512", Type: "image/png"},
 		},
 	}
 
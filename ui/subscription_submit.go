@@ -10,7 +10,6 @@ import (
 	"miniflux.app/http/client"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/request"
-	"miniflux.app/http/route"
 	"miniflux.app/logger"
 	"miniflux.app/reader/subscription"
 	"miniflux.app/ui/form"
@@ -19,16 +18,16 @@ import (
 )
 
 func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
-	sess := session.New(h.store, request.SessionID(r))
+	sess := session.New(r.Context(), h.store, request.SessionID(r))
 	v := view.New(h.tpl, r, sess)
 
-	user, err := h.store.UserByID(request.UserID(r))
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	categories, err := h.store.Categories(user.ID)
+	categories, err := h.store.Categories(r.Context(), user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -37,8 +36,8 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 	v.Set("categories", categories)
 	v.Set("menu", "feeds")
 	v.Set("user", user)
-	v.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	v.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	v.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+	v.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 	v.Set("defaultUserAgent", client.DefaultUserAgent)
 
 	subscriptionForm := form.NewSubscriptionForm(r)
@@ -49,6 +48,28 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if subscriptionForm.WatchSelector != "" {
+		feed, err := h.feedHandler.CreatePageWatchFeed(
+			r.Context(),
+			user.ID,
+			subscriptionForm.CategoryID,
+			subscriptionForm.URL,
+			subscriptionForm.WatchSelector,
+			subscriptionForm.UserAgent,
+			subscriptionForm.Username,
+			subscriptionForm.Password,
+		)
+		if err != nil {
+			v.Set("form", subscriptionForm)
+			v.Set("errorMessage", err)
+			html.OK(w, r, v.Render("add_subscription"))
+			return
+		}
+
+		h.redirectToNewFeed(w, r, feed)
+		return
+	}
+
 	subscriptions, findErr := subscription.FindSubscriptions(
 		subscriptionForm.URL,
 		subscriptionForm.UserAgent,
@@ -73,6 +94,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		html.OK(w, r, v.Render("add_subscription"))
 	case n == 1:
 		feed, err := h.feedHandler.CreateFeed(
+			r.Context(),
 			user.ID,
 			subscriptionForm.CategoryID,
 			subscriptions[0].URL,
@@ -88,15 +110,15 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+		h.redirectToNewFeed(w, r, feed)
 	case n > 1:
 		v := view.New(h.tpl, r, sess)
 		v.Set("subscriptions", subscriptions)
 		v.Set("form", subscriptionForm)
 		v.Set("menu", "feeds")
 		v.Set("user", user)
-		v.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-		v.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+		v.Set("countUnread", h.store.CountUnreadEntries(r.Context(), user.ID))
+		v.Set("countErrorFeeds", h.store.CountErrorFeeds(r.Context(), user.ID))
 
 		html.OK(w, r, v.Render("choose_subscription"))
 	}
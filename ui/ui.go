@@ -5,9 +5,11 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"context"
 	"net/http"
 
 	"miniflux.app/config"
+	"miniflux.app/logger"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/template"
@@ -18,14 +20,24 @@ import (
 
 // Serve declares all routes for the user interface.
 func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
-	middleware := newMiddleware(router, cfg, store)
-	handler := &handler{router, cfg, store, template.NewEngine(cfg, router), pool, feedHandler}
+	csrfSecret, err := store.Secret(context.Background(), "csrf")
+	if err != nil {
+		logger.Fatal("[UI] %v", err)
+	}
+
+	middleware := newMiddleware(router, cfg, store, csrfSecret)
+	handler := &handler{router, cfg, store, template.NewEngine(cfg, router, store.Cache()), pool, feedHandler}
 
 	uiRouter := router.NewRoute().Subrouter()
 	uiRouter.Use(middleware.handleUserSession)
 	uiRouter.Use(middleware.handleAppSession)
+	uiRouter.Use(middleware.handleWriteFreeze)
+	if cfg.IsReadOnly() {
+		uiRouter.Use(middleware.handleReadOnly)
+	}
 
 	// Static assets.
+	uiRouter.HandleFunc("/assets/{filename}", handler.showAsset).Name("asset").Methods("GET")
 	uiRouter.HandleFunc("/stylesheets/{name}.css", handler.showStylesheet).Name("stylesheet").Methods("GET")
 	uiRouter.HandleFunc("/{name}.js", handler.showJavascript).Name("javascript").Methods("GET")
 	uiRouter.HandleFunc("/favicon.ico", handler.showFavicon).Name("favicon").Methods("GET")
@@ -40,17 +52,24 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool
 
 	// Unread page.
 	uiRouter.HandleFunc("/mark-all-as-read", handler.markAllAsRead).Name("markAllAsRead").Methods("GET")
+	uiRouter.HandleFunc("/undo-mark-all-as-read", handler.undoMarkAllAsRead).Name("undoMarkAllAsRead").Methods("GET")
 	uiRouter.HandleFunc("/unread", handler.showUnreadPage).Name("unread").Methods("GET")
 	uiRouter.HandleFunc("/unread/entry/{entryID}", handler.showUnreadEntryPage).Name("unreadEntry").Methods("GET")
 
+	// Bulk actions on entry lists.
+	uiRouter.HandleFunc("/entries/bulk", handler.bulkUpdateEntries).Name("bulkUpdateEntries").Methods("POST")
+
 	// History pages.
 	uiRouter.HandleFunc("/history", handler.showHistoryPage).Name("history").Methods("GET")
 	uiRouter.HandleFunc("/history/entry/{entryID}", handler.showReadEntryPage).Name("readEntry").Methods("GET")
 	uiRouter.HandleFunc("/history/flush", handler.flushHistory).Name("flushHistory").Methods("GET")
+	uiRouter.HandleFunc("/history/recent", handler.showRecentlyReadPage).Name("recentlyRead").Methods("GET")
+	uiRouter.HandleFunc("/history/junk", handler.showJunkEntriesPage).Name("junkEntries").Methods("GET")
 
 	// Bookmark pages.
 	uiRouter.HandleFunc("/starred", handler.showStarredPage).Name("starred").Methods("GET")
 	uiRouter.HandleFunc("/starred/entry/{entryID}", handler.showStarredEntryPage).Name("starredEntry").Methods("GET")
+	uiRouter.HandleFunc("/starred/export/{format}", handler.exportStarredEntries).Name("exportStarred").Methods("GET")
 
 	// Search pages.
 	uiRouter.HandleFunc("/search", handler.showSearchEntriesPage).Name("searchEntries").Methods("GET")
@@ -59,11 +78,17 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods("GET")
 	uiRouter.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Name("refreshAllFeeds").Methods("GET")
+	uiRouter.HandleFunc("/feeds/bulk", handler.bulkUpdateFeeds).Name("bulkUpdateFeeds").Methods("POST")
+	uiRouter.HandleFunc("/feeds/least-read", handler.showLeastReadFeedsPage).Name("leastReadFeeds").Methods("GET")
+	uiRouter.HandleFunc("/feeds/pending", handler.showPendingFeedsPage).Name("pendingFeeds").Methods("GET")
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/edit", handler.showEditFeedPage).Name("editFeed").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/remove", handler.removeFeed).Name("removeFeed").Methods("POST")
+	uiRouter.HandleFunc("/feed/{feedID}/mute", handler.muteFeed).Name("muteFeed").Methods("POST")
+	uiRouter.HandleFunc("/feed/{feedID}/approve", handler.approveFeed).Name("approveFeed").Methods("POST")
+	uiRouter.HandleFunc("/feed/{feedID}/reject", handler.rejectFeed).Name("rejectFeed").Methods("POST")
 	uiRouter.HandleFunc("/feed/{feedID}/update", handler.updateFeed).Name("updateFeed").Methods("POST")
 	uiRouter.HandleFunc("/feed/{feedID}/entries", handler.showFeedEntriesPage).Name("feedEntries").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/entries/all", handler.showFeedEntriesAllPage).Name("feedEntriesAll").Methods("GET")
@@ -80,13 +105,21 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool
 	uiRouter.HandleFunc("/category/{categoryID}/edit", handler.showEditCategoryPage).Name("editCategory").Methods("GET")
 	uiRouter.HandleFunc("/category/{categoryID}/update", handler.updateCategory).Name("updateCategory").Methods("POST")
 	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.removeCategory).Name("removeCategory").Methods("POST")
+	uiRouter.HandleFunc("/category/{categoryID}/shares", handler.showCategorySharesPage).Name("categoryShares").Methods("GET")
+	uiRouter.HandleFunc("/category/{categoryID}/shares", handler.shareCategory).Name("shareCategory").Methods("POST")
+	uiRouter.HandleFunc("/category/{categoryID}/shares/{userID}/remove", handler.unshareCategory).Name("unshareCategory").Methods("POST")
+	uiRouter.HandleFunc("/shared/category/{categoryID}/entries", handler.showSharedCategoryEntriesPage).Name("sharedCategoryEntries").Methods("GET")
 
 	// Entry pages.
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods("POST")
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods("POST")
+	uiRouter.HandleFunc("/entry/kindle/{entryID}", handler.sendEntryToKindle).Name("sendToKindle").Methods("POST")
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods("POST")
+	uiRouter.HandleFunc("/entry/summarize/{entryID}", handler.summarizeEntry).Name("summarizeEntry").Methods("POST")
 	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods("POST")
+	uiRouter.HandleFunc("/entry/snapshot/{entryID}", handler.showEntrySnapshot).Name("entrySnapshot").Methods("GET")
+	uiRouter.HandleFunc("/entry/snapshot/{entryID}", handler.refreshEntrySnapshot).Name("refreshEntrySnapshot").Methods("POST")
 
 	// User pages.
 	uiRouter.HandleFunc("/users", handler.showUsersPage).Name("users").Methods("GET")
@@ -95,15 +128,23 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool
 	uiRouter.HandleFunc("/users/{userID}/edit", handler.showEditUserPage).Name("editUser").Methods("GET")
 	uiRouter.HandleFunc("/users/{userID}/update", handler.updateUser).Name("updateUser").Methods("POST")
 	uiRouter.HandleFunc("/users/{userID}/remove", handler.removeUser).Name("removeUser").Methods("POST")
+	uiRouter.HandleFunc("/users/{userID}/approve", handler.approveUser).Name("approveUser").Methods("POST")
+	uiRouter.HandleFunc("/invitations", handler.showInvitationsPage).Name("invitations").Methods("GET")
+	uiRouter.HandleFunc("/invitations", handler.createInvitation).Name("createInvitation").Methods("POST")
+	uiRouter.HandleFunc("/invitations/{invitationID}/remove", handler.removeInvitation).Name("removeInvitation").Methods("POST")
+	uiRouter.HandleFunc("/statistics", handler.showInstanceStatsPage).Name("instanceStats").Methods("GET")
 
 	// Settings pages.
 	uiRouter.HandleFunc("/settings", handler.showSettingsPage).Name("settings").Methods("GET")
 	uiRouter.HandleFunc("/settings", handler.updateSettings).Name("updateSettings").Methods("POST")
 	uiRouter.HandleFunc("/integrations", handler.showIntegrationPage).Name("integrations").Methods("GET")
 	uiRouter.HandleFunc("/integration", handler.updateIntegration).Name("updateIntegration").Methods("POST")
+	uiRouter.HandleFunc("/integration/extension/token", handler.generateExtensionToken).Name("generateExtensionToken").Methods("POST")
 	uiRouter.HandleFunc("/integration/pocket/authorize", handler.pocketAuthorize).Name("pocketAuthorize").Methods("GET")
 	uiRouter.HandleFunc("/integration/pocket/callback", handler.pocketCallback).Name("pocketCallback").Methods("GET")
 	uiRouter.HandleFunc("/about", handler.showAboutPage).Name("about").Methods("GET")
+	uiRouter.HandleFunc("/account/delete", handler.showDeleteAccountPage).Name("deleteAccount").Methods("GET")
+	uiRouter.HandleFunc("/account/delete", handler.submitDeleteAccount).Name("submitDeleteAccount").Methods("POST")
 
 	// Session pages.
 	uiRouter.HandleFunc("/sessions", handler.showSessionsPage).Name("sessions").Methods("GET")
@@ -122,6 +163,13 @@ func Serve(router *mux.Router, cfg *config.Config, store *storage.Storage, pool
 	// Authentication pages.
 	uiRouter.HandleFunc("/login", handler.checkLogin).Name("checkLogin").Methods("POST")
 	uiRouter.HandleFunc("/logout", handler.logout).Name("logout").Methods("GET")
+	uiRouter.HandleFunc("/signup", handler.showSignupPage).Name("signup").Methods("GET")
+	uiRouter.HandleFunc("/signup", handler.submitSignup).Name("submitSignup").Methods("POST")
+	uiRouter.HandleFunc("/signup/verify/{token}", handler.verifySignup).Name("verifySignup").Methods("GET")
+	uiRouter.HandleFunc("/password/forgot", handler.showForgotPasswordPage).Name("forgotPassword").Methods("GET")
+	uiRouter.HandleFunc("/password/forgot", handler.submitForgotPassword).Name("submitForgotPassword").Methods("POST")
+	uiRouter.HandleFunc("/password/reset/{token}", handler.showResetPasswordPage).Name("resetPassword").Methods("GET")
+	uiRouter.HandleFunc("/password/reset/{token}", handler.submitResetPassword).Name("submitResetPassword").Methods("POST")
 	uiRouter.HandleFunc("/", handler.showLoginPage).Name("login").Methods("GET")
 
 	router.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
@@ -14,9 +14,17 @@ import (
 )
 
 func (h *handler) markAllAsRead(w http.ResponseWriter, r *http.Request) {
-	if err := h.store.MarkAllAsRead(request.UserID(r)); err != nil {
+	if err := h.store.MarkAllAsRead(r.Context(), request.UserID(r)); err != nil {
 		logger.Error("[MarkAllAsRead] %v", err)
 	}
 
 	html.Redirect(w, r, route.Path(h.router, "unread"))
 }
+
+func (h *handler) undoMarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	if _, err := h.store.UndoMarkAsRead(r.Context(), request.UserID(r)); err != nil {
+		logger.Error("[UndoMarkAllAsRead] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "unread"))
+}
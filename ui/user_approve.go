@@ -0,0 +1,39 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+)
+
+func (h *handler) approveUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(r.Context(), request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	approved, err := h.store.ApproveUser(r.Context(), request.RouteInt64Param(r, "userID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !approved {
+		html.NotFound(w, r)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "users"))
+}
@@ -10,7 +10,6 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/template"
 	"miniflux.app/ui/session"
-	"miniflux.app/ui/static"
 )
 
 // View wraps template argument building.
@@ -37,11 +36,9 @@ func New(tpl *template.Engine, r *http.Request, sess *session.Session) *View {
 	theme := request.UserTheme(r)
 	b.params["menu"] = ""
 	b.params["csrf"] = request.CSRF(r)
+	b.params["currentPath"] = r.URL.RequestURI()
 	b.params["flashMessage"] = sess.FlashMessage(request.FlashMessage(r))
 	b.params["flashErrorMessage"] = sess.FlashErrorMessage(request.FlashErrorMessage(r))
 	b.params["theme"] = theme
-	b.params["theme_checksum"] = static.StylesheetsChecksums[theme]
-	b.params["app_js_checksum"] = static.JavascriptsChecksums["app"]
-	b.params["sw_js_checksum"] = static.JavascriptsChecksums["sw"]
 	return b
 }
@@ -0,0 +1,251 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/webhook"
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	queueSize      = 100
+	requestTimeout = 10 * time.Second
+	userAgent      = "Miniflux Webhook"
+
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of the request body, prefixed by "sha256=".
+	SignatureHeader = "X-Miniflux-Signature"
+
+	// EventHeader contains the event type.
+	EventHeader = "X-Miniflux-Event"
+
+	// DeliveryHeader contains the event ID, it stays the same across retries.
+	DeliveryHeader = "X-Miniflux-Delivery"
+)
+
+// Holder keeps an event until the end of the quiet hours of the user.
+type Holder func(userID int64, event *Event, until time.Time) error
+
+// Dispatcher sends events to the configured endpoints in the background.
+// A nil Dispatcher discards all events.
+type Dispatcher struct {
+	urls       []string
+	secret     string
+	events     map[string]bool
+	maxRetries int
+	retryDelay time.Duration
+	client     *http.Client
+	queue      chan *Event
+
+	// Events of users in quiet hours are given to the holder and sent by Flush at the end of their quiet hours.
+	quietHours func(userID int64) time.Time
+	holder     Holder
+}
+
+// NewDispatcher returns a Dispatcher and starts the delivery worker.
+// All events are sent when the list of events is empty.
+func NewDispatcher(urls []string, secret string, events []string, maxRetries int) *Dispatcher {
+	d := &Dispatcher{
+		urls:       urls,
+		secret:     secret,
+		events:     make(map[string]bool),
+		maxRetries: maxRetries,
+		retryDelay: time.Second,
+		client:     &http.Client{Timeout: requestTimeout},
+		queue:      make(chan *Event, queueSize),
+	}
+
+	for _, event := range events {
+		d.events[event] = true
+	}
+
+	go d.run()
+	return d
+}
+
+// SetQuietHours sets the function returning the end of the quiet hours in progress for a user
+// and the function keeping the events until then, the zero time means that the events of the user
+// are delivered right away.
+func (d *Dispatcher) SetQuietHours(quietHours func(userID int64) time.Time, holder Holder) {
+	if d == nil {
+		return
+	}
+
+	d.quietHours = quietHours
+	d.holder = holder
+}
+
+// FeedCreated sends the feed.created event.
+func (d *Dispatcher) FeedCreated(feed *model.Feed) {
+	d.sendToUser(feed.UserID, EventFeedCreated, &FeedData{FeedID: feed.ID, UserID: feed.UserID, FeedURL: feed.FeedURL, Title: feed.Title})
+}
+
+// FeedRemoved sends the feed.removed event.
+func (d *Dispatcher) FeedRemoved(userID, feedID int64) {
+	d.sendToUser(userID, EventFeedRemoved, &FeedData{FeedID: feedID, UserID: userID})
+}
+
+// FeedError sends the feed.error event.
+func (d *Dispatcher) FeedError(feed *model.Feed) {
+	d.sendToUser(feed.UserID, EventFeedError, &FeedData{
+		FeedID:              feed.ID,
+		UserID:              feed.UserID,
+		FeedURL:             feed.FeedURL,
+		Title:               feed.Title,
+		ParsingErrorMessage: feed.ParsingErrorMsg,
+		ParsingErrorCount:   feed.ParsingErrorCount,
+	})
+}
+
+// UserCreated sends the user.created event.
+func (d *Dispatcher) UserCreated(user *model.User) {
+	d.Send(EventUserCreated, &UserData{UserID: user.ID, Username: user.Username, IsAdmin: user.IsAdmin})
+}
+
+// Send queues an event, the event is dropped if the queue is full.
+func (d *Dispatcher) Send(eventType string, data interface{}) {
+	if event := d.newEvent(eventType, data); event != nil {
+		d.enqueue(event)
+	}
+}
+
+// sendToUser holds the event while the user is in quiet hours.
+func (d *Dispatcher) sendToUser(userID int64, eventType string, data interface{}) {
+	event := d.newEvent(eventType, data)
+	if event == nil {
+		return
+	}
+
+	if d.quietHours != nil && d.holder != nil {
+		if until := d.quietHours(userID); !until.IsZero() && until.After(event.CreatedAt) {
+			if err := d.holder(userID, event, until); err != nil {
+				logger.Error("[Webhook] Unable to hold event %s (%s) of user #%d: %v", event.ID, event.Type, userID, err)
+			}
+			return
+		}
+	}
+
+	d.enqueue(event)
+}
+
+func (d *Dispatcher) newEvent(eventType string, data interface{}) *Event {
+	if d == nil || len(d.urls) == 0 {
+		return nil
+	}
+
+	if len(d.events) > 0 && !d.events[eventType] {
+		return nil
+	}
+
+	return &Event{
+		ID:        fmt.Sprintf("%x", crypto.GenerateRandomBytes(16)),
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data:      data,
+	}
+}
+
+// Flush sends the events held during the quiet hours of a user as a single batch event.
+func (d *Dispatcher) Flush(userID int64, events []*Event) {
+	if d == nil || len(events) == 0 {
+		return
+	}
+
+	d.enqueue(&Event{
+		ID:        fmt.Sprintf("%x", crypto.GenerateRandomBytes(16)),
+		Type:      EventBatch,
+		CreatedAt: time.Now(),
+		Data:      &BatchData{UserID: userID, Events: events},
+	})
+}
+
+func (d *Dispatcher) enqueue(event *Event) {
+	select {
+	case d.queue <- event:
+	default:
+		logger.Error("[Webhook] Queue is full, dropping event %s (%s)", event.ID, event.Type)
+	}
+}
+
+func (d *Dispatcher) run() {
+	for event := range d.queue {
+		body, err := json.Marshal(event)
+		if err != nil {
+			logger.Error("[Webhook] Unable to encode event %s: %v", event.ID, err)
+			continue
+		}
+
+		for _, url := range d.urls {
+			d.deliver(url, event, body)
+		}
+	}
+}
+
+// deliver retries with an exponential backoff when the endpoint is unreachable or returns a server error.
+func (d *Dispatcher) deliver(url string, event *Event, body []byte) {
+	delay := d.retryDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := d.post(url, event, body)
+		if err == nil {
+			logger.Debug("[Webhook] Event %s (%s) delivered to %s", event.ID, event.Type, url)
+			return
+		}
+
+		if !retry || attempt >= d.maxRetries {
+			logger.Error("[Webhook] Unable to deliver event %s (%s) to %s: %v", event.ID, event.Type, url, err)
+			return
+		}
+
+		logger.Debug("[Webhook] Delivery of event %s to %s failed, retrying in %v: %v", event.ID, url, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (d *Dispatcher) post(url string, event *Event, body []byte) (retry bool, err error) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(EventHeader, event.Type)
+	request.Header.Set(DeliveryHeader, event.ID)
+	if d.secret != "" {
+		request.Header.Set(SignatureHeader, "sha256="+Sign(d.secret, body))
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return false, nil
+	case response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests:
+		return true, fmt.Errorf("status code %d", response.StatusCode)
+	default:
+		return false, fmt.Errorf("status code %d", response.StatusCode)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,244 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/webhook"
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestSign(t *testing.T) {
+	// Test vector from RFC 4231, test case 2.
+	expected := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+	result := Sign("Jefe", []byte("what do ya want for nothing?"))
+
+	if result != expected {
+		t.Errorf(`Unexpected signature, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestNilDispatcher(t *testing.T) {
+	var d *Dispatcher
+	d.FeedCreated(&model.Feed{ID: 1})
+	d.FeedRemoved(1, 1)
+	d.FeedError(&model.Feed{ID: 1})
+	d.UserCreated(&model.User{ID: 1})
+}
+
+func TestDelivery(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	d := NewDispatcher([]string{server.URL}, "secret", nil, 0)
+	d.UserCreated(&model.User{ID: 42, Username: "alice"})
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		if r.Header.Get(EventHeader) != EventUserCreated {
+			t.Errorf(`Unexpected event header: %q`, r.Header.Get(EventHeader))
+		}
+
+		if r.Header.Get(SignatureHeader) != "sha256="+Sign("secret", body) {
+			t.Errorf(`Invalid signature: %q`, r.Header.Get(SignatureHeader))
+		}
+
+		var event struct {
+			ID   string   `json:"id"`
+			Type string   `json:"type"`
+			Data UserData `json:"data"`
+		}
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Fatal(err)
+		}
+
+		if event.ID == "" || event.ID != r.Header.Get(DeliveryHeader) {
+			t.Errorf(`Unexpected event ID: %q`, event.ID)
+		}
+
+		if event.Type != EventUserCreated || event.Data.UserID != 42 || event.Data.Username != "alice" {
+			t.Errorf(`Unexpected event: %+v`, event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal(`The event has not been delivered`)
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	d := NewDispatcher([]string{server.URL}, "", []string{EventFeedRemoved}, 0)
+	d.FeedCreated(&model.Feed{ID: 1})
+
+	if len(d.queue) != 0 {
+		t.Error(`Events not listed in the configuration should not be queued`)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls int32
+	done := make(chan bool, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		done <- true
+	}))
+	defer server.Close()
+
+	d := &Dispatcher{
+		urls:       []string{server.URL},
+		events:     make(map[string]bool),
+		maxRetries: 3,
+		retryDelay: time.Millisecond,
+		client:     http.DefaultClient,
+		queue:      make(chan *Event, 1),
+	}
+	go d.run()
+	d.FeedRemoved(1, 2)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf(`The event has not been delivered after %d attempts`, atomic.LoadInt32(&calls))
+	}
+}
+
+func TestNoRetryOnClientError(t *testing.T) {
+	d := &Dispatcher{maxRetries: 3, retryDelay: time.Millisecond, client: http.DefaultClient}
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	d.deliver(server.URL, &Event{ID: "1", Type: EventFeedRemoved}, []byte(`{}`))
+
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf(`Client errors should not be retried, got %d attempts`, calls)
+	}
+}
+
+func TestQuietHours(t *testing.T) {
+	bodies := make(chan []byte, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	until := time.Now().Add(time.Hour)
+	var held []*Event
+	d := NewDispatcher([]string{server.URL}, "", nil, 0)
+	d.SetQuietHours(func(userID int64) time.Time {
+		if userID == 1 {
+			return until
+		}
+		return time.Time{}
+	}, func(userID int64, event *Event, releaseAt time.Time) error {
+		if userID != 1 || !releaseAt.Equal(until) {
+			t.Errorf(`Unexpected held event of user #%d until %v`, userID, releaseAt)
+		}
+		held = append(held, event)
+		return nil
+	})
+
+	d.FeedCreated(&model.Feed{ID: 10, UserID: 1})
+	d.FeedRemoved(1, 11)
+
+	select {
+	case <-bodies:
+		t.Fatal(`Events should be held during quiet hours`)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if len(held) != 2 {
+		t.Fatalf(`Events should be given to the holder, got %d`, len(held))
+	}
+
+	// The held events are stored as JSON until the end of the quiet hours, the payload is kept as is.
+	var events []*Event
+	for _, event := range held {
+		data, err := json.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stored := &Event{Data: new(json.RawMessage)}
+		if err := json.Unmarshal(data, stored); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, stored)
+	}
+
+	d.Flush(1, events)
+
+	select {
+	case body := <-bodies:
+		var event struct {
+			Type string `json:"type"`
+			Data struct {
+				UserID int64 `json:"user_id"`
+				Events []struct {
+					Type string `json:"type"`
+					Data struct {
+						FeedID int64 `json:"feed_id"`
+					} `json:"data"`
+				} `json:"events"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Fatal(err)
+		}
+
+		if event.Type != EventBatch || event.Data.UserID != 1 || len(event.Data.Events) != 2 {
+			t.Fatalf(`Unexpected batch: %s`, body)
+		}
+
+		if event.Data.Events[0].Type != EventFeedCreated || event.Data.Events[1].Type != EventFeedRemoved {
+			t.Errorf(`Held events are not in order: %s`, body)
+		}
+
+		if event.Data.Events[0].Data.FeedID != 10 || event.Data.Events[1].Data.FeedID != 11 {
+			t.Errorf(`The payload of the held events should be kept: %s`, body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal(`The held events have not been delivered`)
+	}
+}
+
+func TestQuietHoursDoNotDelayUserCreated(t *testing.T) {
+	d := &Dispatcher{urls: []string{"http://localhost"}, queue: make(chan *Event, 1)}
+	d.SetQuietHours(func(userID int64) time.Time { return time.Now().Add(time.Hour) }, func(userID int64, event *Event, until time.Time) error {
+		t.Error(`Administrative events should not be held`)
+		return nil
+	})
+
+	d.UserCreated(&model.User{ID: 1})
+
+	if len(d.queue) != 1 {
+		t.Error(`Administrative events should not be held`)
+	}
+}
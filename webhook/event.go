@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/webhook"
+
+import (
+	"time"
+)
+
+// Event types.
+const (
+	EventFeedCreated = "feed.created"
+	EventFeedRemoved = "feed.removed"
+	EventFeedError   = "feed.error"
+	EventUserCreated = "user.created"
+	EventAlert       = "alert"
+
+	// EventBatch contains the events held during the quiet hours of a user.
+	EventBatch = "batch"
+)
+
+// Event is the payload sent to webhook endpoints.
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// FeedData describes the feed related to an event.
+type FeedData struct {
+	FeedID              int64  `json:"feed_id"`
+	UserID              int64  `json:"user_id"`
+	FeedURL             string `json:"feed_url,omitempty"`
+	Title               string `json:"title,omitempty"`
+	ParsingErrorMessage string `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount   int    `json:"parsing_error_count,omitempty"`
+}
+
+// UserData describes the user related to an event.
+type UserData struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+	IsAdmin  bool   `json:"is_admin"`
+}
+
+// BatchData contains the events held during the quiet hours of a user.
+type BatchData struct {
+	UserID int64    `json:"user_id"`
+	Events []*Event `json:"events"`
+}
+
+// Alert types.
+const (
+	AlertFeedErrors      = "feed_errors"
+	AlertComponentErrors = "component_errors"
+)
+
+// AlertData describes an alert sent to the administrators.
+type AlertData struct {
+	Type              string `json:"type"`
+	Message           string `json:"message"`
+	Component         string `json:"component,omitempty"`
+	ConsecutiveErrors int    `json:"consecutive_errors,omitempty"`
+	FeedsRefreshed    int    `json:"feeds_refreshed,omitempty"`
+	FeedsFailed       int    `json:"feeds_failed,omitempty"`
+}
@@ -5,14 +5,17 @@
 package worker // import "miniflux.app/worker"
 
 import (
+	"context"
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/feed"
+	"miniflux.app/storage"
 )
 
 // Worker refreshes a feed in the background.
 type Worker struct {
 	id          int
+	store       *storage.Storage
 	feedHandler *feed.Handler
 }
 
@@ -20,13 +23,26 @@ type Worker struct {
 func (w *Worker) Run(c chan model.Job) {
 	logger.Debug("[Worker] #%d started", w.id)
 
+	ctx := context.Background()
 	for {
 		job := <-c
 		logger.Debug("[Worker #%d] got userID=%d, feedID=%d", w.id, job.UserID, job.FeedID)
 
-		err := w.feedHandler.RefreshFeed(job.UserID, job.FeedID)
+		err := w.feedHandler.RefreshFeed(ctx, job.UserID, job.FeedID)
 		if err != nil {
 			logger.Error("[Worker] %v", err)
 		}
+		w.store.AlertMonitor().FeedRefreshed(err)
+
+		if job.RefreshJobID != 0 {
+			errorMsg := ""
+			if err != nil {
+				errorMsg = err.Error()
+			}
+
+			if err := w.store.UpdateRefreshJobFeed(ctx, job.RefreshJobID, job.FeedID, errorMsg); err != nil {
+				logger.Error("[Worker] %v", err)
+			}
+		}
 	}
 }